@@ -304,6 +304,54 @@ func (_c *MockQuerier_CreateAccountUserAssociation_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// CreateAuditEvent provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateAuditEvent(ctx context.Context, db DBTX, arg CreateAuditEventParams) error {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateAuditEventParams) error); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CreateAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuditEvent'
+type MockQuerier_CreateAuditEvent_Call struct {
+	*mock.Call
+}
+
+// CreateAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateAuditEventParams
+func (_e *MockQuerier_Expecter) CreateAuditEvent(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateAuditEvent_Call {
+	return &MockQuerier_CreateAuditEvent_Call{Call: _e.mock.On("CreateAuditEvent", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateAuditEvent_Call) Run(run func(ctx context.Context, db DBTX, arg CreateAuditEventParams)) *MockQuerier_CreateAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateAuditEventParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateAuditEvent_Call) Return(_a0 error) *MockQuerier_CreateAuditEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CreateAuditEvent_Call) RunAndReturn(run func(context.Context, DBTX, CreateAuditEventParams) error) *MockQuerier_CreateAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateConnection provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) CreateConnection(ctx context.Context, db DBTX, arg CreateConnectionParams) (NeosyncApiConnection, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -708,6 +756,122 @@ func (_c *MockQuerier_CreateNonMachineUser_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// CreateNotificationDelivery provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateNotificationDelivery(ctx context.Context, db DBTX, arg CreateNotificationDeliveryParams) (NeosyncApiNotificationDelivery, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotificationDelivery")
+	}
+
+	var r0 NeosyncApiNotificationDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateNotificationDeliveryParams) (NeosyncApiNotificationDelivery, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateNotificationDeliveryParams) NeosyncApiNotificationDelivery); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiNotificationDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateNotificationDeliveryParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateNotificationDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotificationDelivery'
+type MockQuerier_CreateNotificationDelivery_Call struct {
+	*mock.Call
+}
+
+// CreateNotificationDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateNotificationDeliveryParams
+func (_e *MockQuerier_Expecter) CreateNotificationDelivery(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateNotificationDelivery_Call {
+	return &MockQuerier_CreateNotificationDelivery_Call{Call: _e.mock.On("CreateNotificationDelivery", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateNotificationDelivery_Call) Run(run func(ctx context.Context, db DBTX, arg CreateNotificationDeliveryParams)) *MockQuerier_CreateNotificationDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateNotificationDeliveryParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateNotificationDelivery_Call) Return(_a0 NeosyncApiNotificationDelivery, _a1 error) *MockQuerier_CreateNotificationDelivery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateNotificationDelivery_Call) RunAndReturn(run func(context.Context, DBTX, CreateNotificationDeliveryParams) (NeosyncApiNotificationDelivery, error)) *MockQuerier_CreateNotificationDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNotificationRule provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreateNotificationRule(ctx context.Context, db DBTX, arg CreateNotificationRuleParams) (NeosyncApiNotificationRule, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotificationRule")
+	}
+
+	var r0 NeosyncApiNotificationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateNotificationRuleParams) (NeosyncApiNotificationRule, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreateNotificationRuleParams) NeosyncApiNotificationRule); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiNotificationRule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreateNotificationRuleParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreateNotificationRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotificationRule'
+type MockQuerier_CreateNotificationRule_Call struct {
+	*mock.Call
+}
+
+// CreateNotificationRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreateNotificationRuleParams
+func (_e *MockQuerier_Expecter) CreateNotificationRule(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreateNotificationRule_Call {
+	return &MockQuerier_CreateNotificationRule_Call{Call: _e.mock.On("CreateNotificationRule", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreateNotificationRule_Call) Run(run func(ctx context.Context, db DBTX, arg CreateNotificationRuleParams)) *MockQuerier_CreateNotificationRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreateNotificationRuleParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreateNotificationRule_Call) Return(_a0 NeosyncApiNotificationRule, _a1 error) *MockQuerier_CreateNotificationRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreateNotificationRule_Call) RunAndReturn(run func(context.Context, DBTX, CreateNotificationRuleParams) (NeosyncApiNotificationRule, error)) *MockQuerier_CreateNotificationRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePersonalAccount provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) CreatePersonalAccount(ctx context.Context, db DBTX, arg CreatePersonalAccountParams) (NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -766,6 +930,64 @@ func (_c *MockQuerier_CreatePersonalAccount_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// CreatePipeline provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) CreatePipeline(ctx context.Context, db DBTX, arg CreatePipelineParams) (NeosyncApiPipeline, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePipeline")
+	}
+
+	var r0 NeosyncApiPipeline
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreatePipelineParams) (NeosyncApiPipeline, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, CreatePipelineParams) NeosyncApiPipeline); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiPipeline)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, CreatePipelineParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CreatePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePipeline'
+type MockQuerier_CreatePipeline_Call struct {
+	*mock.Call
+}
+
+// CreatePipeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg CreatePipelineParams
+func (_e *MockQuerier_Expecter) CreatePipeline(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_CreatePipeline_Call {
+	return &MockQuerier_CreatePipeline_Call{Call: _e.mock.On("CreatePipeline", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_CreatePipeline_Call) Run(run func(ctx context.Context, db DBTX, arg CreatePipelineParams)) *MockQuerier_CreatePipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(CreatePipelineParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CreatePipeline_Call) Return(_a0 NeosyncApiPipeline, _a1 error) *MockQuerier_CreatePipeline_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CreatePipeline_Call) RunAndReturn(run func(context.Context, DBTX, CreatePipelineParams) (NeosyncApiPipeline, error)) *MockQuerier_CreatePipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTeamAccount provides a mock function with given fields: ctx, db, accountSlug
 func (_m *MockQuerier) CreateTeamAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, accountSlug)
@@ -1446,6 +1668,124 @@ func (_c *MockQuerier_GetAccountUserAssociation_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetAccountUserAssociations provides a mock function with given fields: ctx, db, accountid
+func (_m *MockQuerier) GetAccountUserAssociations(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiAccountUserAssociation, error) {
+	ret := _m.Called(ctx, db, accountid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountUserAssociations")
+	}
+
+	var r0 []NeosyncApiAccountUserAssociation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccountUserAssociation, error)); ok {
+		return rf(ctx, db, accountid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiAccountUserAssociation); ok {
+		r0 = rf(ctx, db, accountid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiAccountUserAssociation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAccountUserAssociations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountUserAssociations'
+type MockQuerier_GetAccountUserAssociations_Call struct {
+	*mock.Call
+}
+
+// GetAccountUserAssociations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountid pgtype.UUID
+func (_e *MockQuerier_Expecter) GetAccountUserAssociations(ctx interface{}, db interface{}, accountid interface{}) *MockQuerier_GetAccountUserAssociations_Call {
+	return &MockQuerier_GetAccountUserAssociations_Call{Call: _e.mock.On("GetAccountUserAssociations", ctx, db, accountid)}
+}
+
+func (_c *MockQuerier_GetAccountUserAssociations_Call) Run(run func(ctx context.Context, db DBTX, accountid pgtype.UUID)) *MockQuerier_GetAccountUserAssociations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountUserAssociations_Call) Return(_a0 []NeosyncApiAccountUserAssociation, _a1 error) *MockQuerier_GetAccountUserAssociations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountUserAssociations_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiAccountUserAssociation, error)) *MockQuerier_GetAccountUserAssociations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountUserCountByRole provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetAccountUserCountByRole(ctx context.Context, db DBTX, arg GetAccountUserCountByRoleParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountUserCountByRole")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserCountByRoleParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAccountUserCountByRoleParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetAccountUserCountByRoleParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAccountUserCountByRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountUserCountByRole'
+type MockQuerier_GetAccountUserCountByRole_Call struct {
+	*mock.Call
+}
+
+// GetAccountUserCountByRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetAccountUserCountByRoleParams
+func (_e *MockQuerier_Expecter) GetAccountUserCountByRole(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetAccountUserCountByRole_Call {
+	return &MockQuerier_GetAccountUserCountByRole_Call{Call: _e.mock.On("GetAccountUserCountByRole", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetAccountUserCountByRole_Call) Run(run func(ctx context.Context, db DBTX, arg GetAccountUserCountByRoleParams)) *MockQuerier_GetAccountUserCountByRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetAccountUserCountByRoleParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountUserCountByRole_Call) Return(_a0 int64, _a1 error) *MockQuerier_GetAccountUserCountByRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAccountUserCountByRole_Call) RunAndReturn(run func(context.Context, DBTX, GetAccountUserCountByRoleParams) (int64, error)) *MockQuerier_GetAccountUserCountByRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAccountsByUser provides a mock function with given fields: ctx, db, id
 func (_m *MockQuerier) GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, id)
@@ -1566,23 +1906,25 @@ func (_c *MockQuerier_GetActiveAccountInvites_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// GetAnonymousUser provides a mock function with given fields: ctx, db
-func (_m *MockQuerier) GetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
+// GetAllConnections provides a mock function with given fields: ctx, db
+func (_m *MockQuerier) GetAllConnections(ctx context.Context, db DBTX) ([]NeosyncApiConnection, error) {
 	ret := _m.Called(ctx, db)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAnonymousUser")
+		panic("no return value specified for GetAllConnections")
 	}
 
-	var r0 NeosyncApiUser
+	var r0 []NeosyncApiConnection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX) (NeosyncApiUser, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) ([]NeosyncApiConnection, error)); ok {
 		return rf(ctx, db)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX) NeosyncApiUser); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) []NeosyncApiConnection); ok {
 		r0 = rf(ctx, db)
 	} else {
-		r0 = ret.Get(0).(NeosyncApiUser)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiConnection)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
@@ -1594,15 +1936,131 @@ func (_m *MockQuerier) GetAnonymousUser(ctx context.Context, db DBTX) (NeosyncAp
 	return r0, r1
 }
 
-// MockQuerier_GetAnonymousUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnonymousUser'
-type MockQuerier_GetAnonymousUser_Call struct {
+// MockQuerier_GetAllConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllConnections'
+type MockQuerier_GetAllConnections_Call struct {
 	*mock.Call
 }
 
-// GetAnonymousUser is a helper method to define mock.On call
+// GetAllConnections is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-func (_e *MockQuerier_Expecter) GetAnonymousUser(ctx interface{}, db interface{}) *MockQuerier_GetAnonymousUser_Call {
+func (_e *MockQuerier_Expecter) GetAllConnections(ctx interface{}, db interface{}) *MockQuerier_GetAllConnections_Call {
+	return &MockQuerier_GetAllConnections_Call{Call: _e.mock.On("GetAllConnections", ctx, db)}
+}
+
+func (_c *MockQuerier_GetAllConnections_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_GetAllConnections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAllConnections_Call) Return(_a0 []NeosyncApiConnection, _a1 error) *MockQuerier_GetAllConnections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAllConnections_Call) RunAndReturn(run func(context.Context, DBTX) ([]NeosyncApiConnection, error)) *MockQuerier_GetAllConnections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllNotificationRules provides a mock function with given fields: ctx, db
+func (_m *MockQuerier) GetAllNotificationRules(ctx context.Context, db DBTX) ([]NeosyncApiNotificationRule, error) {
+	ret := _m.Called(ctx, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllNotificationRules")
+	}
+
+	var r0 []NeosyncApiNotificationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) ([]NeosyncApiNotificationRule, error)); ok {
+		return rf(ctx, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) []NeosyncApiNotificationRule); ok {
+		r0 = rf(ctx, db)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiNotificationRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
+		r1 = rf(ctx, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAllNotificationRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllNotificationRules'
+type MockQuerier_GetAllNotificationRules_Call struct {
+	*mock.Call
+}
+
+// GetAllNotificationRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+func (_e *MockQuerier_Expecter) GetAllNotificationRules(ctx interface{}, db interface{}) *MockQuerier_GetAllNotificationRules_Call {
+	return &MockQuerier_GetAllNotificationRules_Call{Call: _e.mock.On("GetAllNotificationRules", ctx, db)}
+}
+
+func (_c *MockQuerier_GetAllNotificationRules_Call) Run(run func(ctx context.Context, db DBTX)) *MockQuerier_GetAllNotificationRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAllNotificationRules_Call) Return(_a0 []NeosyncApiNotificationRule, _a1 error) *MockQuerier_GetAllNotificationRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAllNotificationRules_Call) RunAndReturn(run func(context.Context, DBTX) ([]NeosyncApiNotificationRule, error)) *MockQuerier_GetAllNotificationRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAnonymousUser provides a mock function with given fields: ctx, db
+func (_m *MockQuerier) GetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error) {
+	ret := _m.Called(ctx, db)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnonymousUser")
+	}
+
+	var r0 NeosyncApiUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) (NeosyncApiUser, error)); ok {
+		return rf(ctx, db)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX) NeosyncApiUser); ok {
+		r0 = rf(ctx, db)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX) error); ok {
+		r1 = rf(ctx, db)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAnonymousUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAnonymousUser'
+type MockQuerier_GetAnonymousUser_Call struct {
+	*mock.Call
+}
+
+// GetAnonymousUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+func (_e *MockQuerier_Expecter) GetAnonymousUser(ctx interface{}, db interface{}) *MockQuerier_GetAnonymousUser_Call {
 	return &MockQuerier_GetAnonymousUser_Call{Call: _e.mock.On("GetAnonymousUser", ctx, db)}
 }
 
@@ -1623,6 +2081,66 @@ func (_c *MockQuerier_GetAnonymousUser_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetAuditEvents provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetAuditEvents(ctx context.Context, db DBTX, arg GetAuditEventsParams) ([]NeosyncApiAuditEvent, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditEvents")
+	}
+
+	var r0 []NeosyncApiAuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAuditEventsParams) ([]NeosyncApiAuditEvent, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetAuditEventsParams) []NeosyncApiAuditEvent); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiAuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetAuditEventsParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditEvents'
+type MockQuerier_GetAuditEvents_Call struct {
+	*mock.Call
+}
+
+// GetAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetAuditEventsParams
+func (_e *MockQuerier_Expecter) GetAuditEvents(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetAuditEvents_Call {
+	return &MockQuerier_GetAuditEvents_Call{Call: _e.mock.On("GetAuditEvents", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetAuditEvents_Call) Run(run func(ctx context.Context, db DBTX, arg GetAuditEventsParams)) *MockQuerier_GetAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetAuditEventsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetAuditEvents_Call) Return(_a0 []NeosyncApiAuditEvent, _a1 error) *MockQuerier_GetAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetAuditEvents_Call) RunAndReturn(run func(context.Context, DBTX, GetAuditEventsParams) ([]NeosyncApiAuditEvent, error)) *MockQuerier_GetAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBilledAccounts provides a mock function with given fields: ctx, db, accountids
 func (_m *MockQuerier) GetBilledAccounts(ctx context.Context, db DBTX, accountids []pgtype.UUID) ([]NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, accountids)
@@ -2273,6 +2791,244 @@ func (_c *MockQuerier_GetJobsByAccount_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetNotificationDeliveries provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetNotificationDeliveries(ctx context.Context, db DBTX, arg GetNotificationDeliveriesParams) ([]NeosyncApiNotificationDelivery, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationDeliveries")
+	}
+
+	var r0 []NeosyncApiNotificationDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetNotificationDeliveriesParams) ([]NeosyncApiNotificationDelivery, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetNotificationDeliveriesParams) []NeosyncApiNotificationDelivery); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiNotificationDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetNotificationDeliveriesParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetNotificationDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationDeliveries'
+type MockQuerier_GetNotificationDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetNotificationDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetNotificationDeliveriesParams
+func (_e *MockQuerier_Expecter) GetNotificationDeliveries(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetNotificationDeliveries_Call {
+	return &MockQuerier_GetNotificationDeliveries_Call{Call: _e.mock.On("GetNotificationDeliveries", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetNotificationDeliveries_Call) Run(run func(ctx context.Context, db DBTX, arg GetNotificationDeliveriesParams)) *MockQuerier_GetNotificationDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetNotificationDeliveriesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationDeliveries_Call) Return(_a0 []NeosyncApiNotificationDelivery, _a1 error) *MockQuerier_GetNotificationDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationDeliveries_Call) RunAndReturn(run func(context.Context, DBTX, GetNotificationDeliveriesParams) ([]NeosyncApiNotificationDelivery, error)) *MockQuerier_GetNotificationDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationRuleById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetNotificationRuleById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiNotificationRule, error) {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationRuleById")
+	}
+
+	var r0 NeosyncApiNotificationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiNotificationRule, error)); ok {
+		return rf(ctx, db, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiNotificationRule); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiNotificationRule)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetNotificationRuleById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationRuleById'
+type MockQuerier_GetNotificationRuleById_Call struct {
+	*mock.Call
+}
+
+// GetNotificationRuleById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetNotificationRuleById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetNotificationRuleById_Call {
+	return &MockQuerier_GetNotificationRuleById_Call{Call: _e.mock.On("GetNotificationRuleById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_GetNotificationRuleById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetNotificationRuleById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationRuleById_Call) Return(_a0 NeosyncApiNotificationRule, _a1 error) *MockQuerier_GetNotificationRuleById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationRuleById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiNotificationRule, error)) *MockQuerier_GetNotificationRuleById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationRulesByAccount provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetNotificationRulesByAccount(ctx context.Context, db DBTX, arg GetNotificationRulesByAccountParams) ([]NeosyncApiNotificationRule, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationRulesByAccount")
+	}
+
+	var r0 []NeosyncApiNotificationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetNotificationRulesByAccountParams) ([]NeosyncApiNotificationRule, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetNotificationRulesByAccountParams) []NeosyncApiNotificationRule); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiNotificationRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetNotificationRulesByAccountParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetNotificationRulesByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationRulesByAccount'
+type MockQuerier_GetNotificationRulesByAccount_Call struct {
+	*mock.Call
+}
+
+// GetNotificationRulesByAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetNotificationRulesByAccountParams
+func (_e *MockQuerier_Expecter) GetNotificationRulesByAccount(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetNotificationRulesByAccount_Call {
+	return &MockQuerier_GetNotificationRulesByAccount_Call{Call: _e.mock.On("GetNotificationRulesByAccount", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetNotificationRulesByAccount_Call) Run(run func(ctx context.Context, db DBTX, arg GetNotificationRulesByAccountParams)) *MockQuerier_GetNotificationRulesByAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetNotificationRulesByAccountParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationRulesByAccount_Call) Return(_a0 []NeosyncApiNotificationRule, _a1 error) *MockQuerier_GetNotificationRulesByAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationRulesByAccount_Call) RunAndReturn(run func(context.Context, DBTX, GetNotificationRulesByAccountParams) ([]NeosyncApiNotificationRule, error)) *MockQuerier_GetNotificationRulesByAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationRulesForJobEvent provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) GetNotificationRulesForJobEvent(ctx context.Context, db DBTX, arg GetNotificationRulesForJobEventParams) ([]NeosyncApiNotificationRule, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationRulesForJobEvent")
+	}
+
+	var r0 []NeosyncApiNotificationRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetNotificationRulesForJobEventParams) ([]NeosyncApiNotificationRule, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, GetNotificationRulesForJobEventParams) []NeosyncApiNotificationRule); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiNotificationRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, GetNotificationRulesForJobEventParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetNotificationRulesForJobEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationRulesForJobEvent'
+type MockQuerier_GetNotificationRulesForJobEvent_Call struct {
+	*mock.Call
+}
+
+// GetNotificationRulesForJobEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg GetNotificationRulesForJobEventParams
+func (_e *MockQuerier_Expecter) GetNotificationRulesForJobEvent(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_GetNotificationRulesForJobEvent_Call {
+	return &MockQuerier_GetNotificationRulesForJobEvent_Call{Call: _e.mock.On("GetNotificationRulesForJobEvent", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_GetNotificationRulesForJobEvent_Call) Run(run func(ctx context.Context, db DBTX, arg GetNotificationRulesForJobEventParams)) *MockQuerier_GetNotificationRulesForJobEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(GetNotificationRulesForJobEventParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationRulesForJobEvent_Call) Return(_a0 []NeosyncApiNotificationRule, _a1 error) *MockQuerier_GetNotificationRulesForJobEvent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetNotificationRulesForJobEvent_Call) RunAndReturn(run func(context.Context, DBTX, GetNotificationRulesForJobEventParams) ([]NeosyncApiNotificationRule, error)) *MockQuerier_GetNotificationRulesForJobEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPersonalAccountByUserId provides a mock function with given fields: ctx, db, userid
 func (_m *MockQuerier) GetPersonalAccountByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) (NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, userid)
@@ -2331,6 +3087,124 @@ func (_c *MockQuerier_GetPersonalAccountByUserId_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetPipelineById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) GetPipelineById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiPipeline, error) {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelineById")
+	}
+
+	var r0 NeosyncApiPipeline
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) (NeosyncApiPipeline, error)); ok {
+		return rf(ctx, db, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) NeosyncApiPipeline); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiPipeline)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetPipelineById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineById'
+type MockQuerier_GetPipelineById_Call struct {
+	*mock.Call
+}
+
+// GetPipelineById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) GetPipelineById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_GetPipelineById_Call {
+	return &MockQuerier_GetPipelineById_Call{Call: _e.mock.On("GetPipelineById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_GetPipelineById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_GetPipelineById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetPipelineById_Call) Return(_a0 NeosyncApiPipeline, _a1 error) *MockQuerier_GetPipelineById_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetPipelineById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) (NeosyncApiPipeline, error)) *MockQuerier_GetPipelineById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPipelinesByAccount provides a mock function with given fields: ctx, db, accountID
+func (_m *MockQuerier) GetPipelinesByAccount(ctx context.Context, db DBTX, accountID pgtype.UUID) ([]NeosyncApiPipeline, error) {
+	ret := _m.Called(ctx, db, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelinesByAccount")
+	}
+
+	var r0 []NeosyncApiPipeline
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiPipeline, error)); ok {
+		return rf(ctx, db, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) []NeosyncApiPipeline); ok {
+		r0 = rf(ctx, db, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]NeosyncApiPipeline)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r1 = rf(ctx, db, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetPipelinesByAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelinesByAccount'
+type MockQuerier_GetPipelinesByAccount_Call struct {
+	*mock.Call
+}
+
+// GetPipelinesByAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - accountID pgtype.UUID
+func (_e *MockQuerier_Expecter) GetPipelinesByAccount(ctx interface{}, db interface{}, accountID interface{}) *MockQuerier_GetPipelinesByAccount_Call {
+	return &MockQuerier_GetPipelinesByAccount_Call{Call: _e.mock.On("GetPipelinesByAccount", ctx, db, accountID)}
+}
+
+func (_c *MockQuerier_GetPipelinesByAccount_Call) Run(run func(ctx context.Context, db DBTX, accountID pgtype.UUID)) *MockQuerier_GetPipelinesByAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetPipelinesByAccount_Call) Return(_a0 []NeosyncApiPipeline, _a1 error) *MockQuerier_GetPipelinesByAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetPipelinesByAccount_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) ([]NeosyncApiPipeline, error)) *MockQuerier_GetPipelinesByAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRunContextByKey provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) GetRunContextByKey(ctx context.Context, db DBTX, arg GetRunContextByKeyParams) (NeosyncApiRuncontext, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -3155,26 +4029,84 @@ func (_c *MockQuerier_IsConnectionNameAvailable_Call) RunAndReturn(run func(cont
 	return _c
 }
 
-// IsJobNameAvailable provides a mock function with given fields: ctx, db, arg
-func (_m *MockQuerier) IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJobNameAvailableParams) (int64, error) {
+// IsJobNameAvailable provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJobNameAvailableParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsJobNameAvailable")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsJobNameAvailableParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
+type MockQuerier_IsJobNameAvailable_Call struct {
+	*mock.Call
+}
+
+// IsJobNameAvailable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg IsJobNameAvailableParams
+func (_e *MockQuerier_Expecter) IsJobNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsJobNameAvailable_Call {
+	return &MockQuerier_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_IsJobNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsJobNameAvailableParams)) *MockQuerier_IsJobNameAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsJobNameAvailableParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_IsJobNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsJobNameAvailable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)) *MockQuerier_IsJobNameAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsPipelineNameAvailable provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) IsPipelineNameAvailable(ctx context.Context, db DBTX, arg IsPipelineNameAvailableParams) (int64, error) {
 	ret := _m.Called(ctx, db, arg)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsJobNameAvailable")
+		panic("no return value specified for IsPipelineNameAvailable")
 	}
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsPipelineNameAvailableParams) (int64, error)); ok {
 		return rf(ctx, db, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsJobNameAvailableParams) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, IsPipelineNameAvailableParams) int64); ok {
 		r0 = rf(ctx, db, arg)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsJobNameAvailableParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, IsPipelineNameAvailableParams) error); ok {
 		r1 = rf(ctx, db, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -3183,32 +4115,32 @@ func (_m *MockQuerier) IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJo
 	return r0, r1
 }
 
-// MockQuerier_IsJobNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsJobNameAvailable'
-type MockQuerier_IsJobNameAvailable_Call struct {
+// MockQuerier_IsPipelineNameAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsPipelineNameAvailable'
+type MockQuerier_IsPipelineNameAvailable_Call struct {
 	*mock.Call
 }
 
-// IsJobNameAvailable is a helper method to define mock.On call
+// IsPipelineNameAvailable is a helper method to define mock.On call
 //   - ctx context.Context
 //   - db DBTX
-//   - arg IsJobNameAvailableParams
-func (_e *MockQuerier_Expecter) IsJobNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsJobNameAvailable_Call {
-	return &MockQuerier_IsJobNameAvailable_Call{Call: _e.mock.On("IsJobNameAvailable", ctx, db, arg)}
+//   - arg IsPipelineNameAvailableParams
+func (_e *MockQuerier_Expecter) IsPipelineNameAvailable(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_IsPipelineNameAvailable_Call {
+	return &MockQuerier_IsPipelineNameAvailable_Call{Call: _e.mock.On("IsPipelineNameAvailable", ctx, db, arg)}
 }
 
-func (_c *MockQuerier_IsJobNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsJobNameAvailableParams)) *MockQuerier_IsJobNameAvailable_Call {
+func (_c *MockQuerier_IsPipelineNameAvailable_Call) Run(run func(ctx context.Context, db DBTX, arg IsPipelineNameAvailableParams)) *MockQuerier_IsPipelineNameAvailable_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsJobNameAvailableParams))
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(IsPipelineNameAvailableParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_IsJobNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsJobNameAvailable_Call {
+func (_c *MockQuerier_IsPipelineNameAvailable_Call) Return(_a0 int64, _a1 error) *MockQuerier_IsPipelineNameAvailable_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_IsJobNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsJobNameAvailableParams) (int64, error)) *MockQuerier_IsJobNameAvailable_Call {
+func (_c *MockQuerier_IsPipelineNameAvailable_Call) RunAndReturn(run func(context.Context, DBTX, IsPipelineNameAvailableParams) (int64, error)) *MockQuerier_IsPipelineNameAvailable_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3771,6 +4703,102 @@ func (_c *MockQuerier_RemoveJobConnectionDestinations_Call) RunAndReturn(run fun
 	return _c
 }
 
+// RemoveNotificationRuleById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemoveNotificationRuleById(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveNotificationRuleById")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemoveNotificationRuleById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveNotificationRuleById'
+type MockQuerier_RemoveNotificationRuleById_Call struct {
+	*mock.Call
+}
+
+// RemoveNotificationRuleById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemoveNotificationRuleById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemoveNotificationRuleById_Call {
+	return &MockQuerier_RemoveNotificationRuleById_Call{Call: _e.mock.On("RemoveNotificationRuleById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemoveNotificationRuleById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemoveNotificationRuleById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemoveNotificationRuleById_Call) Return(_a0 error) *MockQuerier_RemoveNotificationRuleById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemoveNotificationRuleById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemoveNotificationRuleById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemovePipelineById provides a mock function with given fields: ctx, db, id
+func (_m *MockQuerier) RemovePipelineById(ctx context.Context, db DBTX, id pgtype.UUID) error {
+	ret := _m.Called(ctx, db, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePipelineById")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, pgtype.UUID) error); ok {
+		r0 = rf(ctx, db, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_RemovePipelineById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePipelineById'
+type MockQuerier_RemovePipelineById_Call struct {
+	*mock.Call
+}
+
+// RemovePipelineById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - id pgtype.UUID
+func (_e *MockQuerier_Expecter) RemovePipelineById(ctx interface{}, db interface{}, id interface{}) *MockQuerier_RemovePipelineById_Call {
+	return &MockQuerier_RemovePipelineById_Call{Call: _e.mock.On("RemovePipelineById", ctx, db, id)}
+}
+
+func (_c *MockQuerier_RemovePipelineById_Call) Run(run func(ctx context.Context, db DBTX, id pgtype.UUID)) *MockQuerier_RemovePipelineById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(pgtype.UUID))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_RemovePipelineById_Call) Return(_a0 error) *MockQuerier_RemovePipelineById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_RemovePipelineById_Call) RunAndReturn(run func(context.Context, DBTX, pgtype.UUID) error) *MockQuerier_RemovePipelineById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetAccountMaxAllowedRecords provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) SetAccountMaxAllowedRecords(ctx context.Context, db DBTX, arg SetAccountMaxAllowedRecordsParams) (NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -4282,6 +5310,64 @@ func (_c *MockQuerier_UpdateAccountOnboardingConfig_Call) RunAndReturn(run func(
 	return _c
 }
 
+// UpdateAccountUserRole provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateAccountUserRole(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccountUserRole")
+	}
+
+	var r0 NeosyncApiAccountUserAssociation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateAccountUserRoleParams) NeosyncApiAccountUserAssociation); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiAccountUserAssociation)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateAccountUserRoleParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateAccountUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccountUserRole'
+type MockQuerier_UpdateAccountUserRole_Call struct {
+	*mock.Call
+}
+
+// UpdateAccountUserRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateAccountUserRoleParams
+func (_e *MockQuerier_Expecter) UpdateAccountUserRole(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateAccountUserRole_Call {
+	return &MockQuerier_UpdateAccountUserRole_Call{Call: _e.mock.On("UpdateAccountUserRole", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateAccountUserRole_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams)) *MockQuerier_UpdateAccountUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateAccountUserRoleParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountUserRole_Call) Return(_a0 NeosyncApiAccountUserAssociation, _a1 error) *MockQuerier_UpdateAccountUserRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateAccountUserRole_Call) RunAndReturn(run func(context.Context, DBTX, UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error)) *MockQuerier_UpdateAccountUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateActiveAccountInvitesToExpired provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) UpdateActiveAccountInvitesToExpired(ctx context.Context, db DBTX, arg UpdateActiveAccountInvitesToExpiredParams) (NeosyncApiAccountInvite, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -4398,6 +5484,64 @@ func (_c *MockQuerier_UpdateConnection_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// UpdateConnectionConfig provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateConnectionConfig(ctx context.Context, db DBTX, arg UpdateConnectionConfigParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConnectionConfig")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateConnectionConfigParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateConnectionConfigParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateConnectionConfigParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateConnectionConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConnectionConfig'
+type MockQuerier_UpdateConnectionConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConnectionConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateConnectionConfigParams
+func (_e *MockQuerier_Expecter) UpdateConnectionConfig(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateConnectionConfig_Call {
+	return &MockQuerier_UpdateConnectionConfig_Call{Call: _e.mock.On("UpdateConnectionConfig", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateConnectionConfig_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateConnectionConfigParams)) *MockQuerier_UpdateConnectionConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateConnectionConfigParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateConnectionConfig_Call) Return(_a0 int64, _a1 error) *MockQuerier_UpdateConnectionConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateConnectionConfig_Call) RunAndReturn(run func(context.Context, DBTX, UpdateConnectionConfigParams) (int64, error)) *MockQuerier_UpdateConnectionConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateJobConnectionDestination provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) UpdateJobConnectionDestination(ctx context.Context, db DBTX, arg UpdateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -4688,6 +5832,122 @@ func (_c *MockQuerier_UpdateJobVirtualForeignKeys_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// UpdateNotificationRuleSigningSecret provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdateNotificationRuleSigningSecret(ctx context.Context, db DBTX, arg UpdateNotificationRuleSigningSecretParams) (int64, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationRuleSigningSecret")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateNotificationRuleSigningSecretParams) (int64, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdateNotificationRuleSigningSecretParams) int64); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdateNotificationRuleSigningSecretParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdateNotificationRuleSigningSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotificationRuleSigningSecret'
+type MockQuerier_UpdateNotificationRuleSigningSecret_Call struct {
+	*mock.Call
+}
+
+// UpdateNotificationRuleSigningSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdateNotificationRuleSigningSecretParams
+func (_e *MockQuerier_Expecter) UpdateNotificationRuleSigningSecret(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdateNotificationRuleSigningSecret_Call {
+	return &MockQuerier_UpdateNotificationRuleSigningSecret_Call{Call: _e.mock.On("UpdateNotificationRuleSigningSecret", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdateNotificationRuleSigningSecret_Call) Run(run func(ctx context.Context, db DBTX, arg UpdateNotificationRuleSigningSecretParams)) *MockQuerier_UpdateNotificationRuleSigningSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdateNotificationRuleSigningSecretParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdateNotificationRuleSigningSecret_Call) Return(_a0 int64, _a1 error) *MockQuerier_UpdateNotificationRuleSigningSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdateNotificationRuleSigningSecret_Call) RunAndReturn(run func(context.Context, DBTX, UpdateNotificationRuleSigningSecretParams) (int64, error)) *MockQuerier_UpdateNotificationRuleSigningSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePipeline provides a mock function with given fields: ctx, db, arg
+func (_m *MockQuerier) UpdatePipeline(ctx context.Context, db DBTX, arg UpdatePipelineParams) (NeosyncApiPipeline, error) {
+	ret := _m.Called(ctx, db, arg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePipeline")
+	}
+
+	var r0 NeosyncApiPipeline
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdatePipelineParams) (NeosyncApiPipeline, error)); ok {
+		return rf(ctx, db, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DBTX, UpdatePipelineParams) NeosyncApiPipeline); ok {
+		r0 = rf(ctx, db, arg)
+	} else {
+		r0 = ret.Get(0).(NeosyncApiPipeline)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DBTX, UpdatePipelineParams) error); ok {
+		r1 = rf(ctx, db, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_UpdatePipeline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePipeline'
+type MockQuerier_UpdatePipeline_Call struct {
+	*mock.Call
+}
+
+// UpdatePipeline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db DBTX
+//   - arg UpdatePipelineParams
+func (_e *MockQuerier_Expecter) UpdatePipeline(ctx interface{}, db interface{}, arg interface{}) *MockQuerier_UpdatePipeline_Call {
+	return &MockQuerier_UpdatePipeline_Call{Call: _e.mock.On("UpdatePipeline", ctx, db, arg)}
+}
+
+func (_c *MockQuerier_UpdatePipeline_Call) Run(run func(ctx context.Context, db DBTX, arg UpdatePipelineParams)) *MockQuerier_UpdatePipeline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DBTX), args[2].(UpdatePipelineParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_UpdatePipeline_Call) Return(_a0 NeosyncApiPipeline, _a1 error) *MockQuerier_UpdatePipeline_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_UpdatePipeline_Call) RunAndReturn(run func(context.Context, DBTX, UpdatePipelineParams) (NeosyncApiPipeline, error)) *MockQuerier_UpdatePipeline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTemporalConfigByAccount provides a mock function with given fields: ctx, db, arg
 func (_m *MockQuerier) UpdateTemporalConfigByAccount(ctx context.Context, db DBTX, arg UpdateTemporalConfigByAccountParams) (NeosyncApiAccount, error) {
 	ret := _m.Called(ctx, db, arg)
@@ -32,6 +32,8 @@ type NeosyncApiAccountApiKey struct {
 	ExpiresAt   pgtype.Timestamp
 	KeyName     string
 	UserID      pgtype.UUID
+	Scopes      []string
+	JobIds      []pgtype.UUID
 }
 
 type NeosyncApiAccountInvite struct {
@@ -44,6 +46,7 @@ type NeosyncApiAccountInvite struct {
 	CreatedAt    pgtype.Timestamp
 	UpdatedAt    pgtype.Timestamp
 	ExpiresAt    pgtype.Timestamp
+	Role         int16
 }
 
 type NeosyncApiAccountUserAssociation struct {
@@ -52,6 +55,21 @@ type NeosyncApiAccountUserAssociation struct {
 	UserID    pgtype.UUID
 	CreatedAt pgtype.Timestamp
 	UpdatedAt pgtype.Timestamp
+	Role      int16
+}
+
+type NeosyncApiAuditEvent struct {
+	ID            pgtype.UUID
+	AccountID     pgtype.UUID
+	ActorType     int16
+	ActorUserID   pgtype.UUID
+	ActorApiKeyID pgtype.UUID
+	Procedure     string
+	ResourceIds   []string
+	Request       []byte
+	StatusCode    string
+	CreatedAt     pgtype.Timestamp
+	Changes       []byte
 }
 
 type NeosyncApiConnection struct {
@@ -91,6 +109,45 @@ type NeosyncApiJobDestinationConnectionAssociation struct {
 	Options      *pg_models.JobDestinationOptions
 }
 
+type NeosyncApiNotificationDelivery struct {
+	ID                 pgtype.UUID
+	RuleID             pgtype.UUID
+	AccountID          pgtype.UUID
+	JobID              pgtype.UUID
+	JobRunID           string
+	EventType          int16
+	Succeeded          bool
+	Attempts           int32
+	ResponseStatusCode pgtype.Int4
+	Error              pgtype.Text
+	CreatedAt          pgtype.Timestamp
+}
+
+type NeosyncApiNotificationRule struct {
+	ID            pgtype.UUID
+	AccountID     pgtype.UUID
+	JobID         pgtype.UUID
+	Name          string
+	Url           string
+	SigningSecret *pg_models.NotificationSigningSecret
+	EventTypes    []int16
+	PayloadFormat int16
+	CreatedAt     pgtype.Timestamp
+	UpdatedAt     pgtype.Timestamp
+}
+
+type NeosyncApiPipeline struct {
+	ID           pgtype.UUID
+	AccountID    pgtype.UUID
+	Name         string
+	Steps        []*pg_models.PipelineStep
+	CronSchedule pgtype.Text
+	CreatedByID  pgtype.UUID
+	UpdatedByID  pgtype.UUID
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+}
+
 type NeosyncApiRuncontext struct {
 	WorkflowID  string
 	ExternalID  string
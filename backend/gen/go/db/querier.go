@@ -17,6 +17,7 @@ type Querier interface {
 	CreateAccountApiKey(ctx context.Context, db DBTX, arg CreateAccountApiKeyParams) (NeosyncApiAccountApiKey, error)
 	CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAccountInviteParams) (NeosyncApiAccountInvite, error)
 	CreateAccountUserAssociation(ctx context.Context, db DBTX, arg CreateAccountUserAssociationParams) error
+	CreateAuditEvent(ctx context.Context, db DBTX, arg CreateAuditEventParams) error
 	CreateConnection(ctx context.Context, db DBTX, arg CreateConnectionParams) (NeosyncApiConnection, error)
 	CreateIdentityProviderAssociation(ctx context.Context, db DBTX, arg CreateIdentityProviderAssociationParams) (NeosyncApiUserIdentityProviderAssociation, error)
 	CreateJob(ctx context.Context, db DBTX, arg CreateJobParams) (NeosyncApiJob, error)
@@ -24,7 +25,10 @@ type Querier interface {
 	CreateJobConnectionDestinations(ctx context.Context, db DBTX, arg []CreateJobConnectionDestinationsParams) (int64, error)
 	CreateMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
 	CreateNonMachineUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
+	CreateNotificationDelivery(ctx context.Context, db DBTX, arg CreateNotificationDeliveryParams) (NeosyncApiNotificationDelivery, error)
+	CreateNotificationRule(ctx context.Context, db DBTX, arg CreateNotificationRuleParams) (NeosyncApiNotificationRule, error)
 	CreatePersonalAccount(ctx context.Context, db DBTX, arg CreatePersonalAccountParams) (NeosyncApiAccount, error)
+	CreatePipeline(ctx context.Context, db DBTX, arg CreatePipelineParams) (NeosyncApiPipeline, error)
 	CreateTeamAccount(ctx context.Context, db DBTX, accountSlug string) (NeosyncApiAccount, error)
 	CreateUserDefinedTransformer(ctx context.Context, db DBTX, arg CreateUserDefinedTransformerParams) (NeosyncApiTransformer, error)
 	DeleteJob(ctx context.Context, db DBTX, id pgtype.UUID) error
@@ -37,9 +41,14 @@ type Querier interface {
 	GetAccountInviteByToken(ctx context.Context, db DBTX, token string) (NeosyncApiAccountInvite, error)
 	GetAccountOnboardingConfig(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.AccountOnboardingConfig, error)
 	GetAccountUserAssociation(ctx context.Context, db DBTX, arg GetAccountUserAssociationParams) (NeosyncApiAccountUserAssociation, error)
+	GetAccountUserAssociations(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiAccountUserAssociation, error)
+	GetAccountUserCountByRole(ctx context.Context, db DBTX, arg GetAccountUserCountByRoleParams) (int64, error)
 	GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiAccount, error)
 	GetActiveAccountInvites(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiAccountInvite, error)
+	GetAllConnections(ctx context.Context, db DBTX) ([]NeosyncApiConnection, error)
+	GetAllNotificationRules(ctx context.Context, db DBTX) ([]NeosyncApiNotificationRule, error)
 	GetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
+	GetAuditEvents(ctx context.Context, db DBTX, arg GetAuditEventsParams) ([]NeosyncApiAuditEvent, error)
 	GetBilledAccounts(ctx context.Context, db DBTX, accountids []pgtype.UUID) ([]NeosyncApiAccount, error)
 	GetConnectionById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiConnection, error)
 	GetConnectionByNameAndAccount(ctx context.Context, db DBTX, arg GetConnectionByNameAndAccountParams) (NeosyncApiConnection, error)
@@ -51,7 +60,13 @@ type Querier interface {
 	GetJobConnectionDestinations(ctx context.Context, db DBTX, id pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)
 	GetJobConnectionDestinationsByJobIds(ctx context.Context, db DBTX, jobids []pgtype.UUID) ([]NeosyncApiJobDestinationConnectionAssociation, error)
 	GetJobsByAccount(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiJob, error)
+	GetNotificationDeliveries(ctx context.Context, db DBTX, arg GetNotificationDeliveriesParams) ([]NeosyncApiNotificationDelivery, error)
+	GetNotificationRuleById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiNotificationRule, error)
+	GetNotificationRulesByAccount(ctx context.Context, db DBTX, arg GetNotificationRulesByAccountParams) ([]NeosyncApiNotificationRule, error)
+	GetNotificationRulesForJobEvent(ctx context.Context, db DBTX, arg GetNotificationRulesForJobEventParams) ([]NeosyncApiNotificationRule, error)
 	GetPersonalAccountByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) (NeosyncApiAccount, error)
+	GetPipelineById(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiPipeline, error)
+	GetPipelinesByAccount(ctx context.Context, db DBTX, accountID pgtype.UUID) ([]NeosyncApiPipeline, error)
 	GetRunContextByKey(ctx context.Context, db DBTX, arg GetRunContextByKeyParams) (NeosyncApiRuncontext, error)
 	GetTeamAccountsByUserId(ctx context.Context, db DBTX, userid pgtype.UUID) ([]NeosyncApiAccount, error)
 	GetTemporalConfigByAccount(ctx context.Context, db DBTX, id pgtype.UUID) (*pg_models.TemporalConfig, error)
@@ -67,6 +82,7 @@ type Querier interface {
 	IsConnectionInAccount(ctx context.Context, db DBTX, arg IsConnectionInAccountParams) (int64, error)
 	IsConnectionNameAvailable(ctx context.Context, db DBTX, arg IsConnectionNameAvailableParams) (int64, error)
 	IsJobNameAvailable(ctx context.Context, db DBTX, arg IsJobNameAvailableParams) (int64, error)
+	IsPipelineNameAvailable(ctx context.Context, db DBTX, arg IsPipelineNameAvailableParams) (int64, error)
 	IsTransformerNameAvailable(ctx context.Context, db DBTX, arg IsTransformerNameAvailableParams) (int64, error)
 	IsUserInAccount(ctx context.Context, db DBTX, arg IsUserInAccountParams) (int64, error)
 	IsUserInAccountApiKey(ctx context.Context, db DBTX, arg IsUserInAccountApiKeyParams) (int64, error)
@@ -78,6 +94,8 @@ type Querier interface {
 	RemoveJobById(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveJobConnectionDestination(ctx context.Context, db DBTX, id pgtype.UUID) error
 	RemoveJobConnectionDestinations(ctx context.Context, db DBTX, jobids []pgtype.UUID) error
+	RemoveNotificationRuleById(ctx context.Context, db DBTX, id pgtype.UUID) error
+	RemovePipelineById(ctx context.Context, db DBTX, id pgtype.UUID) error
 	SetAccountMaxAllowedRecords(ctx context.Context, db DBTX, arg SetAccountMaxAllowedRecordsParams) (NeosyncApiAccount, error)
 	SetAnonymousUser(ctx context.Context, db DBTX) (NeosyncApiUser, error)
 	SetJobSyncOptions(ctx context.Context, db DBTX, arg SetJobSyncOptionsParams) (NeosyncApiJob, error)
@@ -87,13 +105,17 @@ type Querier interface {
 	UpdateAccountApiKeyValue(ctx context.Context, db DBTX, arg UpdateAccountApiKeyValueParams) (NeosyncApiAccountApiKey, error)
 	UpdateAccountInviteToAccepted(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountInvite, error)
 	UpdateAccountOnboardingConfig(ctx context.Context, db DBTX, arg UpdateAccountOnboardingConfigParams) (NeosyncApiAccount, error)
+	UpdateAccountUserRole(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error)
 	UpdateActiveAccountInvitesToExpired(ctx context.Context, db DBTX, arg UpdateActiveAccountInvitesToExpiredParams) (NeosyncApiAccountInvite, error)
 	UpdateConnection(ctx context.Context, db DBTX, arg UpdateConnectionParams) (NeosyncApiConnection, error)
+	UpdateConnectionConfig(ctx context.Context, db DBTX, arg UpdateConnectionConfigParams) (int64, error)
 	UpdateJobConnectionDestination(ctx context.Context, db DBTX, arg UpdateJobConnectionDestinationParams) (NeosyncApiJobDestinationConnectionAssociation, error)
 	UpdateJobMappings(ctx context.Context, db DBTX, arg UpdateJobMappingsParams) (NeosyncApiJob, error)
 	UpdateJobSchedule(ctx context.Context, db DBTX, arg UpdateJobScheduleParams) (NeosyncApiJob, error)
 	UpdateJobSource(ctx context.Context, db DBTX, arg UpdateJobSourceParams) (NeosyncApiJob, error)
 	UpdateJobVirtualForeignKeys(ctx context.Context, db DBTX, arg UpdateJobVirtualForeignKeysParams) (NeosyncApiJob, error)
+	UpdateNotificationRuleSigningSecret(ctx context.Context, db DBTX, arg UpdateNotificationRuleSigningSecretParams) (int64, error)
+	UpdatePipeline(ctx context.Context, db DBTX, arg UpdatePipelineParams) (NeosyncApiPipeline, error)
 	UpdateTemporalConfigByAccount(ctx context.Context, db DBTX, arg UpdateTemporalConfigByAccountParams) (NeosyncApiAccount, error)
 	UpdateUserDefinedTransformer(ctx context.Context, db DBTX, arg UpdateUserDefinedTransformerParams) (NeosyncApiTransformer, error)
 }
@@ -45,11 +45,11 @@ func (q *Queries) ConvertPersonalAccountToTeam(ctx context.Context, db DBTX, arg
 
 const createAccountInvite = `-- name: CreateAccountInvite :one
 INSERT INTO neosync_api.account_invites (
-  account_id, sender_user_id, email, expires_at
+  account_id, sender_user_id, email, expires_at, role
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 )
-RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at
+RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role
 `
 
 type CreateAccountInviteParams struct {
@@ -57,6 +57,7 @@ type CreateAccountInviteParams struct {
 	SenderUserID pgtype.UUID
 	Email        string
 	ExpiresAt    pgtype.Timestamp
+	Role         int16
 }
 
 func (q *Queries) CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAccountInviteParams) (NeosyncApiAccountInvite, error) {
@@ -65,6 +66,7 @@ func (q *Queries) CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAc
 		arg.SenderUserID,
 		arg.Email,
 		arg.ExpiresAt,
+		arg.Role,
 	)
 	var i NeosyncApiAccountInvite
 	err := row.Scan(
@@ -77,15 +79,16 @@ func (q *Queries) CreateAccountInvite(ctx context.Context, db DBTX, arg CreateAc
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
 
 const createAccountUserAssociation = `-- name: CreateAccountUserAssociation :exec
 INSERT INTO neosync_api.account_user_associations (
-  account_id, user_id
+  account_id, user_id, role
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 )
 ON CONFLICT (account_id, user_id) DO NOTHING
 `
@@ -93,10 +96,11 @@ ON CONFLICT (account_id, user_id) DO NOTHING
 type CreateAccountUserAssociationParams struct {
 	AccountID pgtype.UUID
 	UserID    pgtype.UUID
+	Role      int16
 }
 
 func (q *Queries) CreateAccountUserAssociation(ctx context.Context, db DBTX, arg CreateAccountUserAssociationParams) error {
-	_, err := db.Exec(ctx, createAccountUserAssociation, arg.AccountID, arg.UserID)
+	_, err := db.Exec(ctx, createAccountUserAssociation, arg.AccountID, arg.UserID, arg.Role)
 	return err
 }
 
@@ -249,7 +253,7 @@ func (q *Queries) GetAccount(ctx context.Context, db DBTX, id pgtype.UUID) (Neos
 }
 
 const getAccountInvite = `-- name: GetAccountInvite :one
-SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at FROM neosync_api.account_invites
+SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role FROM neosync_api.account_invites
 WHERE id = $1
 `
 
@@ -266,12 +270,13 @@ func (q *Queries) GetAccountInvite(ctx context.Context, db DBTX, id pgtype.UUID)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
 
 const getAccountInviteByToken = `-- name: GetAccountInviteByToken :one
-SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at FROM neosync_api.account_invites
+SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role FROM neosync_api.account_invites
 WHERE token = $1
 `
 
@@ -288,6 +293,7 @@ func (q *Queries) GetAccountInviteByToken(ctx context.Context, db DBTX, token st
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
@@ -306,7 +312,7 @@ func (q *Queries) GetAccountOnboardingConfig(ctx context.Context, db DBTX, id pg
 }
 
 const getAccountUserAssociation = `-- name: GetAccountUserAssociation :one
-SELECT aua.id, aua.account_id, aua.user_id, aua.created_at, aua.updated_at from neosync_api.account_user_associations aua
+SELECT aua.id, aua.account_id, aua.user_id, aua.created_at, aua.updated_at, aua.role from neosync_api.account_user_associations aua
 INNER JOIN neosync_api.accounts a ON a.id = aua.account_id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
 WHERE a.id = $1 AND u.id = $2
@@ -326,10 +332,60 @@ func (q *Queries) GetAccountUserAssociation(ctx context.Context, db DBTX, arg Ge
 		&i.UserID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Role,
 	)
 	return i, err
 }
 
+const getAccountUserAssociations = `-- name: GetAccountUserAssociations :many
+SELECT aua.id, aua.account_id, aua.user_id, aua.created_at, aua.updated_at, aua.role from neosync_api.account_user_associations aua
+WHERE aua.account_id = $1
+`
+
+func (q *Queries) GetAccountUserAssociations(ctx context.Context, db DBTX, accountid pgtype.UUID) ([]NeosyncApiAccountUserAssociation, error) {
+	rows, err := db.Query(ctx, getAccountUserAssociations, accountid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NeosyncApiAccountUserAssociation
+	for rows.Next() {
+		var i NeosyncApiAccountUserAssociation
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.UserID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccountUserCountByRole = `-- name: GetAccountUserCountByRole :one
+SELECT count(aua.id) from neosync_api.account_user_associations aua
+WHERE aua.account_id = $1 AND aua.role = $2
+`
+
+type GetAccountUserCountByRoleParams struct {
+	AccountId pgtype.UUID
+	Role      int16
+}
+
+func (q *Queries) GetAccountUserCountByRole(ctx context.Context, db DBTX, arg GetAccountUserCountByRoleParams) (int64, error) {
+	row := db.QueryRow(ctx, getAccountUserCountByRole, arg.AccountId, arg.Role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getAccountsByUser = `-- name: GetAccountsByUser :many
 SELECT a.id, a.created_at, a.updated_at, a.account_type, a.account_slug, a.temporal_config, a.onboarding_config, a.max_allowed_records, a.stripe_customer_id
 FROM neosync_api.accounts a
@@ -377,7 +433,7 @@ func (q *Queries) GetAccountsByUser(ctx context.Context, db DBTX, id pgtype.UUID
 }
 
 const getActiveAccountInvites = `-- name: GetActiveAccountInvites :many
-SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at FROM neosync_api.account_invites
+SELECT id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role FROM neosync_api.account_invites
 WHERE account_id = $1 AND expires_at > CURRENT_TIMESTAMP AND accepted = false
 `
 
@@ -400,6 +456,7 @@ func (q *Queries) GetActiveAccountInvites(ctx context.Context, db DBTX, accounti
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.ExpiresAt,
+			&i.Role,
 		); err != nil {
 			return nil, err
 		}
@@ -823,7 +880,7 @@ const updateAccountInviteToAccepted = `-- name: UpdateAccountInviteToAccepted :o
 UPDATE neosync_api.account_invites
 SET accepted = true
 WHERE id = $1
-RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at
+RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role
 `
 
 func (q *Queries) UpdateAccountInviteToAccepted(ctx context.Context, db DBTX, id pgtype.UUID) (NeosyncApiAccountInvite, error) {
@@ -839,6 +896,7 @@ func (q *Queries) UpdateAccountInviteToAccepted(ctx context.Context, db DBTX, id
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
@@ -872,11 +930,38 @@ func (q *Queries) UpdateAccountOnboardingConfig(ctx context.Context, db DBTX, ar
 	return i, err
 }
 
+const updateAccountUserRole = `-- name: UpdateAccountUserRole :one
+UPDATE neosync_api.account_user_associations
+SET role = $1
+WHERE account_id = $2 AND user_id = $3
+RETURNING id, account_id, user_id, created_at, updated_at, role
+`
+
+type UpdateAccountUserRoleParams struct {
+	Role      int16
+	AccountId pgtype.UUID
+	UserId    pgtype.UUID
+}
+
+func (q *Queries) UpdateAccountUserRole(ctx context.Context, db DBTX, arg UpdateAccountUserRoleParams) (NeosyncApiAccountUserAssociation, error) {
+	row := db.QueryRow(ctx, updateAccountUserRole, arg.Role, arg.AccountId, arg.UserId)
+	var i NeosyncApiAccountUserAssociation
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.UserID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+	)
+	return i, err
+}
+
 const updateActiveAccountInvitesToExpired = `-- name: UpdateActiveAccountInvitesToExpired :one
 UPDATE neosync_api.account_invites
 SET expires_at = CURRENT_TIMESTAMP
 WHERE account_id = $1 AND email = $2 AND expires_at > CURRENT_TIMESTAMP
-RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at
+RETURNING id, account_id, sender_user_id, email, token, accepted, created_at, updated_at, expires_at, role
 `
 
 type UpdateActiveAccountInvitesToExpiredParams struct {
@@ -897,6 +982,7 @@ func (q *Queries) UpdateActiveAccountInvitesToExpired(ctx context.Context, db DB
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ExpiresAt,
+		&i.Role,
 	)
 	return i, err
 }
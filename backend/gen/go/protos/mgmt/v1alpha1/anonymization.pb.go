@@ -452,6 +452,116 @@ func (x *AnonymizeSingleResponse) GetOutputData() string {
 	return ""
 }
 
+type DecryptFpeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique account identifier whose key was used to encrypt the values
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The format-preserving encryption config that the values were encrypted with
+	Config *TransformFpe `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// The encrypted values (up to 100 items)
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *DecryptFpeRequest) Reset() {
+	*x = DecryptFpeRequest{}
+	mi := &file_mgmt_v1alpha1_anonymization_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecryptFpeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptFpeRequest) ProtoMessage() {}
+
+func (x *DecryptFpeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_anonymization_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptFpeRequest.ProtoReflect.Descriptor instead.
+func (*DecryptFpeRequest) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_anonymization_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DecryptFpeRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *DecryptFpeRequest) GetConfig() *TransformFpe {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *DecryptFpeRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type DecryptFpeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The decrypted values, in the same order as the request
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *DecryptFpeResponse) Reset() {
+	*x = DecryptFpeResponse{}
+	mi := &file_mgmt_v1alpha1_anonymization_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecryptFpeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecryptFpeResponse) ProtoMessage() {}
+
+func (x *DecryptFpeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_v1alpha1_anonymization_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecryptFpeResponse.ProtoReflect.Descriptor instead.
+func (*DecryptFpeResponse) Descriptor() ([]byte, []int) {
+	return file_mgmt_v1alpha1_anonymization_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DecryptFpeResponse) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 var File_mgmt_v1alpha1_anonymization_proto protoreflect.FileDescriptor
 
 var file_mgmt_v1alpha1_anonymization_proto_rawDesc = []byte{
@@ -541,34 +651,52 @@ var file_mgmt_v1alpha1_anonymization_proto_rawDesc = []byte{
 	0x79, 0x6d, 0x69, 0x7a, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x61,
 	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x32, 0xd8, 0x01, 0x0a, 0x14, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a,
-	0x0d, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x23,
-	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
-	0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x6e,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x41,
-	0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x25,
-	0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41,
-	0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0xce, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x12, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x50, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x75, 0x63, 0x6c, 0x65, 0x75, 0x73, 0x63,
-	0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x6e, 0x65, 0x6f, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x3b, 0x6d, 0x67, 0x6d, 0x74, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xa2, 0x02, 0x03,
-	0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x2e, 0x56, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0xca, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0xe2, 0x02, 0x19, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56, 0x31, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0e, 0x4d, 0x67, 0x6d, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x46, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x70, 0x65,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x22, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x64, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46,
+	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x32, 0xad, 0x02, 0x0a, 0x14, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x41,
+	0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x23, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x6e, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x6e, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x0f, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x6d,
+	0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a,
+	0x0a, 0x44, 0x65, 0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x70, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x46, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x46, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0xce, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x42, 0x12, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x50,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x75, 0x63, 0x6c, 0x65,
+	0x75, 0x73, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x6e, 0x65, 0x6f, 0x73, 0x79, 0x6e, 0x63, 0x2f,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x3b, 0x6d, 0x67, 0x6d, 0x74, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0xa2, 0x02, 0x03, 0x4d, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x2e, 0x56, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xca, 0x02, 0x0d, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0xe2, 0x02, 0x19, 0x4d, 0x67, 0x6d, 0x74, 0x5c, 0x56, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0e, 0x4d, 0x67, 0x6d, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -583,7 +711,7 @@ func file_mgmt_v1alpha1_anonymization_proto_rawDescGZIP() []byte {
 	return file_mgmt_v1alpha1_anonymization_proto_rawDescData
 }
 
-var file_mgmt_v1alpha1_anonymization_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_mgmt_v1alpha1_anonymization_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_mgmt_v1alpha1_anonymization_proto_goTypes = []any{
 	(*AnonymizeManyRequest)(nil),      // 0: mgmt.v1alpha1.AnonymizeManyRequest
 	(*AnonymizeManyResponse)(nil),     // 1: mgmt.v1alpha1.AnonymizeManyResponse
@@ -592,27 +720,33 @@ var file_mgmt_v1alpha1_anonymization_proto_goTypes = []any{
 	(*AnonymizeManyErrors)(nil),       // 4: mgmt.v1alpha1.AnonymizeManyErrors
 	(*AnonymizeSingleRequest)(nil),    // 5: mgmt.v1alpha1.AnonymizeSingleRequest
 	(*AnonymizeSingleResponse)(nil),   // 6: mgmt.v1alpha1.AnonymizeSingleResponse
-	(*TransformerConfig)(nil),         // 7: mgmt.v1alpha1.TransformerConfig
+	(*DecryptFpeRequest)(nil),         // 7: mgmt.v1alpha1.DecryptFpeRequest
+	(*DecryptFpeResponse)(nil),        // 8: mgmt.v1alpha1.DecryptFpeResponse
+	(*TransformerConfig)(nil),         // 9: mgmt.v1alpha1.TransformerConfig
+	(*TransformFpe)(nil),              // 10: mgmt.v1alpha1.TransformFpe
 }
 var file_mgmt_v1alpha1_anonymization_proto_depIdxs = []int32{
 	2,  // 0: mgmt.v1alpha1.AnonymizeManyRequest.transformer_mappings:type_name -> mgmt.v1alpha1.TransformerMapping
 	3,  // 1: mgmt.v1alpha1.AnonymizeManyRequest.default_transformers:type_name -> mgmt.v1alpha1.DefaultTransformersConfig
 	4,  // 2: mgmt.v1alpha1.AnonymizeManyResponse.errors:type_name -> mgmt.v1alpha1.AnonymizeManyErrors
-	7,  // 3: mgmt.v1alpha1.TransformerMapping.transformer:type_name -> mgmt.v1alpha1.TransformerConfig
-	7,  // 4: mgmt.v1alpha1.DefaultTransformersConfig.boolean:type_name -> mgmt.v1alpha1.TransformerConfig
-	7,  // 5: mgmt.v1alpha1.DefaultTransformersConfig.n:type_name -> mgmt.v1alpha1.TransformerConfig
-	7,  // 6: mgmt.v1alpha1.DefaultTransformersConfig.s:type_name -> mgmt.v1alpha1.TransformerConfig
+	9,  // 3: mgmt.v1alpha1.TransformerMapping.transformer:type_name -> mgmt.v1alpha1.TransformerConfig
+	9,  // 4: mgmt.v1alpha1.DefaultTransformersConfig.boolean:type_name -> mgmt.v1alpha1.TransformerConfig
+	9,  // 5: mgmt.v1alpha1.DefaultTransformersConfig.n:type_name -> mgmt.v1alpha1.TransformerConfig
+	9,  // 6: mgmt.v1alpha1.DefaultTransformersConfig.s:type_name -> mgmt.v1alpha1.TransformerConfig
 	2,  // 7: mgmt.v1alpha1.AnonymizeSingleRequest.transformer_mappings:type_name -> mgmt.v1alpha1.TransformerMapping
 	3,  // 8: mgmt.v1alpha1.AnonymizeSingleRequest.default_transformers:type_name -> mgmt.v1alpha1.DefaultTransformersConfig
-	0,  // 9: mgmt.v1alpha1.AnonymizationService.AnonymizeMany:input_type -> mgmt.v1alpha1.AnonymizeManyRequest
-	5,  // 10: mgmt.v1alpha1.AnonymizationService.AnonymizeSingle:input_type -> mgmt.v1alpha1.AnonymizeSingleRequest
-	1,  // 11: mgmt.v1alpha1.AnonymizationService.AnonymizeMany:output_type -> mgmt.v1alpha1.AnonymizeManyResponse
-	6,  // 12: mgmt.v1alpha1.AnonymizationService.AnonymizeSingle:output_type -> mgmt.v1alpha1.AnonymizeSingleResponse
-	11, // [11:13] is the sub-list for method output_type
-	9,  // [9:11] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	10, // 9: mgmt.v1alpha1.DecryptFpeRequest.config:type_name -> mgmt.v1alpha1.TransformFpe
+	0,  // 10: mgmt.v1alpha1.AnonymizationService.AnonymizeMany:input_type -> mgmt.v1alpha1.AnonymizeManyRequest
+	5,  // 11: mgmt.v1alpha1.AnonymizationService.AnonymizeSingle:input_type -> mgmt.v1alpha1.AnonymizeSingleRequest
+	7,  // 12: mgmt.v1alpha1.AnonymizationService.DecryptFpe:input_type -> mgmt.v1alpha1.DecryptFpeRequest
+	1,  // 13: mgmt.v1alpha1.AnonymizationService.AnonymizeMany:output_type -> mgmt.v1alpha1.AnonymizeManyResponse
+	6,  // 14: mgmt.v1alpha1.AnonymizationService.AnonymizeSingle:output_type -> mgmt.v1alpha1.AnonymizeSingleResponse
+	8,  // 15: mgmt.v1alpha1.AnonymizationService.DecryptFpe:output_type -> mgmt.v1alpha1.DecryptFpeResponse
+	13, // [13:16] is the sub-list for method output_type
+	10, // [10:13] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_mgmt_v1alpha1_anonymization_proto_init() }
@@ -629,7 +763,7 @@ func file_mgmt_v1alpha1_anonymization_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mgmt_v1alpha1_anonymization_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -39,6 +39,9 @@ const (
 	// AnonymizationServiceAnonymizeSingleProcedure is the fully-qualified name of the
 	// AnonymizationService's AnonymizeSingle RPC.
 	AnonymizationServiceAnonymizeSingleProcedure = "/mgmt.v1alpha1.AnonymizationService/AnonymizeSingle"
+	// AnonymizationServiceDecryptFpeProcedure is the fully-qualified name of the AnonymizationService's
+	// DecryptFpe RPC.
+	AnonymizationServiceDecryptFpeProcedure = "/mgmt.v1alpha1.AnonymizationService/DecryptFpe"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -46,6 +49,7 @@ var (
 	anonymizationServiceServiceDescriptor               = v1alpha1.File_mgmt_v1alpha1_anonymization_proto.Services().ByName("AnonymizationService")
 	anonymizationServiceAnonymizeManyMethodDescriptor   = anonymizationServiceServiceDescriptor.Methods().ByName("AnonymizeMany")
 	anonymizationServiceAnonymizeSingleMethodDescriptor = anonymizationServiceServiceDescriptor.Methods().ByName("AnonymizeSingle")
+	anonymizationServiceDecryptFpeMethodDescriptor      = anonymizationServiceServiceDescriptor.Methods().ByName("DecryptFpe")
 )
 
 // AnonymizationServiceClient is a client for the mgmt.v1alpha1.AnonymizationService service.
@@ -54,6 +58,8 @@ type AnonymizationServiceClient interface {
 	AnonymizeMany(context.Context, *connect.Request[v1alpha1.AnonymizeManyRequest]) (*connect.Response[v1alpha1.AnonymizeManyResponse], error)
 	// Anonymizes a single JSON strings by applying specified transformation mappings.
 	AnonymizeSingle(context.Context, *connect.Request[v1alpha1.AnonymizeSingleRequest]) (*connect.Response[v1alpha1.AnonymizeSingleResponse], error)
+	// Decrypts values that were encrypted by the format-preserving encryption transformer.
+	DecryptFpe(context.Context, *connect.Request[v1alpha1.DecryptFpeRequest]) (*connect.Response[v1alpha1.DecryptFpeResponse], error)
 }
 
 // NewAnonymizationServiceClient constructs a client for the mgmt.v1alpha1.AnonymizationService
@@ -78,6 +84,12 @@ func NewAnonymizationServiceClient(httpClient connect.HTTPClient, baseURL string
 			connect.WithSchema(anonymizationServiceAnonymizeSingleMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		decryptFpe: connect.NewClient[v1alpha1.DecryptFpeRequest, v1alpha1.DecryptFpeResponse](
+			httpClient,
+			baseURL+AnonymizationServiceDecryptFpeProcedure,
+			connect.WithSchema(anonymizationServiceDecryptFpeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -85,6 +97,7 @@ func NewAnonymizationServiceClient(httpClient connect.HTTPClient, baseURL string
 type anonymizationServiceClient struct {
 	anonymizeMany   *connect.Client[v1alpha1.AnonymizeManyRequest, v1alpha1.AnonymizeManyResponse]
 	anonymizeSingle *connect.Client[v1alpha1.AnonymizeSingleRequest, v1alpha1.AnonymizeSingleResponse]
+	decryptFpe      *connect.Client[v1alpha1.DecryptFpeRequest, v1alpha1.DecryptFpeResponse]
 }
 
 // AnonymizeMany calls mgmt.v1alpha1.AnonymizationService.AnonymizeMany.
@@ -97,6 +110,11 @@ func (c *anonymizationServiceClient) AnonymizeSingle(ctx context.Context, req *c
 	return c.anonymizeSingle.CallUnary(ctx, req)
 }
 
+// DecryptFpe calls mgmt.v1alpha1.AnonymizationService.DecryptFpe.
+func (c *anonymizationServiceClient) DecryptFpe(ctx context.Context, req *connect.Request[v1alpha1.DecryptFpeRequest]) (*connect.Response[v1alpha1.DecryptFpeResponse], error) {
+	return c.decryptFpe.CallUnary(ctx, req)
+}
+
 // AnonymizationServiceHandler is an implementation of the mgmt.v1alpha1.AnonymizationService
 // service.
 type AnonymizationServiceHandler interface {
@@ -104,6 +122,8 @@ type AnonymizationServiceHandler interface {
 	AnonymizeMany(context.Context, *connect.Request[v1alpha1.AnonymizeManyRequest]) (*connect.Response[v1alpha1.AnonymizeManyResponse], error)
 	// Anonymizes a single JSON strings by applying specified transformation mappings.
 	AnonymizeSingle(context.Context, *connect.Request[v1alpha1.AnonymizeSingleRequest]) (*connect.Response[v1alpha1.AnonymizeSingleResponse], error)
+	// Decrypts values that were encrypted by the format-preserving encryption transformer.
+	DecryptFpe(context.Context, *connect.Request[v1alpha1.DecryptFpeRequest]) (*connect.Response[v1alpha1.DecryptFpeResponse], error)
 }
 
 // NewAnonymizationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -124,12 +144,20 @@ func NewAnonymizationServiceHandler(svc AnonymizationServiceHandler, opts ...con
 		connect.WithSchema(anonymizationServiceAnonymizeSingleMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	anonymizationServiceDecryptFpeHandler := connect.NewUnaryHandler(
+		AnonymizationServiceDecryptFpeProcedure,
+		svc.DecryptFpe,
+		connect.WithSchema(anonymizationServiceDecryptFpeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mgmt.v1alpha1.AnonymizationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AnonymizationServiceAnonymizeManyProcedure:
 			anonymizationServiceAnonymizeManyHandler.ServeHTTP(w, r)
 		case AnonymizationServiceAnonymizeSingleProcedure:
 			anonymizationServiceAnonymizeSingleHandler.ServeHTTP(w, r)
+		case AnonymizationServiceDecryptFpeProcedure:
+			anonymizationServiceDecryptFpeHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -146,3 +174,7 @@ func (UnimplementedAnonymizationServiceHandler) AnonymizeMany(context.Context, *
 func (UnimplementedAnonymizationServiceHandler) AnonymizeSingle(context.Context, *connect.Request[v1alpha1.AnonymizeSingleRequest]) (*connect.Response[v1alpha1.AnonymizeSingleResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.AnonymizationService.AnonymizeSingle is not implemented"))
 }
+
+func (UnimplementedAnonymizationServiceHandler) DecryptFpe(context.Context, *connect.Request[v1alpha1.DecryptFpeRequest]) (*connect.Response[v1alpha1.DecryptFpeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.AnonymizationService.DecryptFpe is not implemented"))
+}
@@ -103,6 +103,8 @@ const (
 	// JobServiceValidateJobMappingsProcedure is the fully-qualified name of the JobService's
 	// ValidateJobMappings RPC.
 	JobServiceValidateJobMappingsProcedure = "/mgmt.v1alpha1.JobService/ValidateJobMappings"
+	// JobServiceGetJobPlanProcedure is the fully-qualified name of the JobService's GetJobPlan RPC.
+	JobServiceGetJobPlanProcedure = "/mgmt.v1alpha1.JobService/GetJobPlan"
 	// JobServiceGetRunContextProcedure is the fully-qualified name of the JobService's GetRunContext
 	// RPC.
 	JobServiceGetRunContextProcedure = "/mgmt.v1alpha1.JobService/GetRunContext"
@@ -144,6 +146,7 @@ var (
 	jobServiceSetJobWorkflowOptionsMethodDescriptor            = jobServiceServiceDescriptor.Methods().ByName("SetJobWorkflowOptions")
 	jobServiceSetJobSyncOptionsMethodDescriptor                = jobServiceServiceDescriptor.Methods().ByName("SetJobSyncOptions")
 	jobServiceValidateJobMappingsMethodDescriptor              = jobServiceServiceDescriptor.Methods().ByName("ValidateJobMappings")
+	jobServiceGetJobPlanMethodDescriptor                       = jobServiceServiceDescriptor.Methods().ByName("GetJobPlan")
 	jobServiceGetRunContextMethodDescriptor                    = jobServiceServiceDescriptor.Methods().ByName("GetRunContext")
 	jobServiceSetRunContextMethodDescriptor                    = jobServiceServiceDescriptor.Methods().ByName("SetRunContext")
 	jobServiceSetRunContextsMethodDescriptor                   = jobServiceServiceDescriptor.Methods().ByName("SetRunContexts")
@@ -186,6 +189,8 @@ type JobServiceClient interface {
 	SetJobSyncOptions(context.Context, *connect.Request[v1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[v1alpha1.SetJobSyncOptionsResponse], error)
 	// validates that the jobmapping configured can run with table constraints
 	ValidateJobMappings(context.Context, *connect.Request[v1alpha1.ValidateJobMappingsRequest]) (*connect.Response[v1alpha1.ValidateJobMappingsResponse], error)
+	// Returns the plan of what a run of the job would do without executing anything
+	GetJobPlan(context.Context, *connect.Request[v1alpha1.GetJobPlanRequest]) (*connect.Response[v1alpha1.GetJobPlanResponse], error)
 	// Gets a run context to be used by a workflow run
 	GetRunContext(context.Context, *connect.Request[v1alpha1.GetRunContextRequest]) (*connect.Response[v1alpha1.GetRunContextResponse], error)
 	// Sets a run context to be used by a workflow run
@@ -366,6 +371,12 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(jobServiceValidateJobMappingsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getJobPlan: connect.NewClient[v1alpha1.GetJobPlanRequest, v1alpha1.GetJobPlanResponse](
+			httpClient,
+			baseURL+JobServiceGetJobPlanProcedure,
+			connect.WithSchema(jobServiceGetJobPlanMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getRunContext: connect.NewClient[v1alpha1.GetRunContextRequest, v1alpha1.GetRunContextResponse](
 			httpClient,
 			baseURL+JobServiceGetRunContextProcedure,
@@ -416,6 +427,7 @@ type jobServiceClient struct {
 	setJobWorkflowOptions            *connect.Client[v1alpha1.SetJobWorkflowOptionsRequest, v1alpha1.SetJobWorkflowOptionsResponse]
 	setJobSyncOptions                *connect.Client[v1alpha1.SetJobSyncOptionsRequest, v1alpha1.SetJobSyncOptionsResponse]
 	validateJobMappings              *connect.Client[v1alpha1.ValidateJobMappingsRequest, v1alpha1.ValidateJobMappingsResponse]
+	getJobPlan                       *connect.Client[v1alpha1.GetJobPlanRequest, v1alpha1.GetJobPlanResponse]
 	getRunContext                    *connect.Client[v1alpha1.GetRunContextRequest, v1alpha1.GetRunContextResponse]
 	setRunContext                    *connect.Client[v1alpha1.SetRunContextRequest, v1alpha1.SetRunContextResponse]
 	setRunContexts                   *connect.Client[v1alpha1.SetRunContextsRequest, v1alpha1.SetRunContextsResponse]
@@ -556,6 +568,11 @@ func (c *jobServiceClient) ValidateJobMappings(ctx context.Context, req *connect
 	return c.validateJobMappings.CallUnary(ctx, req)
 }
 
+// GetJobPlan calls mgmt.v1alpha1.JobService.GetJobPlan.
+func (c *jobServiceClient) GetJobPlan(ctx context.Context, req *connect.Request[v1alpha1.GetJobPlanRequest]) (*connect.Response[v1alpha1.GetJobPlanResponse], error) {
+	return c.getJobPlan.CallUnary(ctx, req)
+}
+
 // GetRunContext calls mgmt.v1alpha1.JobService.GetRunContext.
 func (c *jobServiceClient) GetRunContext(ctx context.Context, req *connect.Request[v1alpha1.GetRunContextRequest]) (*connect.Response[v1alpha1.GetRunContextResponse], error) {
 	return c.getRunContext.CallUnary(ctx, req)
@@ -608,6 +625,8 @@ type JobServiceHandler interface {
 	SetJobSyncOptions(context.Context, *connect.Request[v1alpha1.SetJobSyncOptionsRequest]) (*connect.Response[v1alpha1.SetJobSyncOptionsResponse], error)
 	// validates that the jobmapping configured can run with table constraints
 	ValidateJobMappings(context.Context, *connect.Request[v1alpha1.ValidateJobMappingsRequest]) (*connect.Response[v1alpha1.ValidateJobMappingsResponse], error)
+	// Returns the plan of what a run of the job would do without executing anything
+	GetJobPlan(context.Context, *connect.Request[v1alpha1.GetJobPlanRequest]) (*connect.Response[v1alpha1.GetJobPlanResponse], error)
 	// Gets a run context to be used by a workflow run
 	GetRunContext(context.Context, *connect.Request[v1alpha1.GetRunContextRequest]) (*connect.Response[v1alpha1.GetRunContextResponse], error)
 	// Sets a run context to be used by a workflow run
@@ -784,6 +803,12 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(jobServiceValidateJobMappingsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	jobServiceGetJobPlanHandler := connect.NewUnaryHandler(
+		JobServiceGetJobPlanProcedure,
+		svc.GetJobPlan,
+		connect.WithSchema(jobServiceGetJobPlanMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	jobServiceGetRunContextHandler := connect.NewUnaryHandler(
 		JobServiceGetRunContextProcedure,
 		svc.GetRunContext,
@@ -858,6 +883,8 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 			jobServiceSetJobSyncOptionsHandler.ServeHTTP(w, r)
 		case JobServiceValidateJobMappingsProcedure:
 			jobServiceValidateJobMappingsHandler.ServeHTTP(w, r)
+		case JobServiceGetJobPlanProcedure:
+			jobServiceGetJobPlanHandler.ServeHTTP(w, r)
 		case JobServiceGetRunContextProcedure:
 			jobServiceGetRunContextHandler.ServeHTTP(w, r)
 		case JobServiceSetRunContextProcedure:
@@ -981,6 +1008,10 @@ func (UnimplementedJobServiceHandler) ValidateJobMappings(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.ValidateJobMappings is not implemented"))
 }
 
+func (UnimplementedJobServiceHandler) GetJobPlan(context.Context, *connect.Request[v1alpha1.GetJobPlanRequest]) (*connect.Response[v1alpha1.GetJobPlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetJobPlan is not implemented"))
+}
+
 func (UnimplementedJobServiceHandler) GetRunContext(context.Context, *connect.Request[v1alpha1.GetRunContextRequest]) (*connect.Response[v1alpha1.GetRunContextResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.JobService.GetRunContext is not implemented"))
 }
@@ -555,6 +555,65 @@ func (_c *MockJobServiceClient_GetJobNextRuns_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetJobPlan provides a mock function with given fields: _a0, _a1
+func (_m *MockJobServiceClient) GetJobPlan(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobPlanRequest]) (*connect.Response[mgmtv1alpha1.GetJobPlanResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobPlan")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetJobPlanResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobPlanRequest]) (*connect.Response[mgmtv1alpha1.GetJobPlanResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobPlanRequest]) *connect.Response[mgmtv1alpha1.GetJobPlanResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetJobPlanResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetJobPlanRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobServiceClient_GetJobPlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobPlan'
+type MockJobServiceClient_GetJobPlan_Call struct {
+	*mock.Call
+}
+
+// GetJobPlan is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetJobPlanRequest]
+func (_e *MockJobServiceClient_Expecter) GetJobPlan(_a0 interface{}, _a1 interface{}) *MockJobServiceClient_GetJobPlan_Call {
+	return &MockJobServiceClient_GetJobPlan_Call{Call: _e.mock.On("GetJobPlan", _a0, _a1)}
+}
+
+func (_c *MockJobServiceClient_GetJobPlan_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobPlanRequest])) *MockJobServiceClient_GetJobPlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetJobPlanRequest]))
+	})
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobPlan_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetJobPlanResponse], _a1 error) *MockJobServiceClient_GetJobPlan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobServiceClient_GetJobPlan_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetJobPlanRequest]) (*connect.Response[mgmtv1alpha1.GetJobPlanResponse], error)) *MockJobServiceClient_GetJobPlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetJobRecentRuns provides a mock function with given fields: _a0, _a1
 func (_m *MockJobServiceClient) GetJobRecentRuns(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetJobRecentRunsRequest]) (*connect.Response[mgmtv1alpha1.GetJobRecentRunsResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -378,6 +378,65 @@ func (_c *MockUserAccountServiceClient_GetAccountOnboardingConfig_Call) RunAndRe
 	return _c
 }
 
+// GetAccountRole provides a mock function with given fields: _a0, _a1
+func (_m *MockUserAccountServiceClient) GetAccountRole(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetAccountRoleRequest]) (*connect.Response[mgmtv1alpha1.GetAccountRoleResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountRole")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.GetAccountRoleResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountRoleRequest]) (*connect.Response[mgmtv1alpha1.GetAccountRoleResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountRoleRequest]) *connect.Response[mgmtv1alpha1.GetAccountRoleResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.GetAccountRoleResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountRoleRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserAccountServiceClient_GetAccountRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountRole'
+type MockUserAccountServiceClient_GetAccountRole_Call struct {
+	*mock.Call
+}
+
+// GetAccountRole is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.GetAccountRoleRequest]
+func (_e *MockUserAccountServiceClient_Expecter) GetAccountRole(_a0 interface{}, _a1 interface{}) *MockUserAccountServiceClient_GetAccountRole_Call {
+	return &MockUserAccountServiceClient_GetAccountRole_Call{Call: _e.mock.On("GetAccountRole", _a0, _a1)}
+}
+
+func (_c *MockUserAccountServiceClient_GetAccountRole_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetAccountRoleRequest])) *MockUserAccountServiceClient_GetAccountRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.GetAccountRoleRequest]))
+	})
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_GetAccountRole_Call) Return(_a0 *connect.Response[mgmtv1alpha1.GetAccountRoleResponse], _a1 error) *MockUserAccountServiceClient_GetAccountRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_GetAccountRole_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.GetAccountRoleRequest]) (*connect.Response[mgmtv1alpha1.GetAccountRoleResponse], error)) *MockUserAccountServiceClient_GetAccountRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAccountStatus provides a mock function with given fields: _a0, _a1
 func (_m *MockUserAccountServiceClient) GetAccountStatus(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.GetAccountStatusRequest]) (*connect.Response[mgmtv1alpha1.GetAccountStatusResponse], error) {
 	ret := _m.Called(_a0, _a1)
@@ -1381,6 +1440,65 @@ func (_c *MockUserAccountServiceClient_SetPersonalAccount_Call) RunAndReturn(run
 	return _c
 }
 
+// SetTeamAccountMemberRole provides a mock function with given fields: _a0, _a1
+func (_m *MockUserAccountServiceClient) SetTeamAccountMemberRole(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse], error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTeamAccountMemberRole")
+	}
+
+	var r0 *connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse], error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]) *connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse]); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserAccountServiceClient_SetTeamAccountMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTeamAccountMemberRole'
+type MockUserAccountServiceClient_SetTeamAccountMemberRole_Call struct {
+	*mock.Call
+}
+
+// SetTeamAccountMemberRole is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]
+func (_e *MockUserAccountServiceClient_Expecter) SetTeamAccountMemberRole(_a0 interface{}, _a1 interface{}) *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call {
+	return &MockUserAccountServiceClient_SetTeamAccountMemberRole_Call{Call: _e.mock.On("SetTeamAccountMemberRole", _a0, _a1)}
+}
+
+func (_c *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call) Run(run func(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest])) *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]))
+	})
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call) Return(_a0 *connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse], _a1 error) *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call) RunAndReturn(run func(context.Context, *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse], error)) *MockUserAccountServiceClient_SetTeamAccountMemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetUser provides a mock function with given fields: _a0, _a1
 func (_m *MockUserAccountServiceClient) SetUser(_a0 context.Context, _a1 *connect.Request[mgmtv1alpha1.SetUserRequest]) (*connect.Response[mgmtv1alpha1.SetUserResponse], error) {
 	ret := _m.Called(_a0, _a1)
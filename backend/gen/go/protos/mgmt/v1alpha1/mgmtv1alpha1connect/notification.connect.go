@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mgmt/v1alpha1/notification.proto
+
+package mgmtv1alpha1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// NotificationServiceName is the fully-qualified name of the NotificationService service.
+	NotificationServiceName = "mgmt.v1alpha1.NotificationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// NotificationServiceCreateNotificationRuleProcedure is the fully-qualified name of the
+	// NotificationService's CreateNotificationRule RPC.
+	NotificationServiceCreateNotificationRuleProcedure = "/mgmt.v1alpha1.NotificationService/CreateNotificationRule"
+	// NotificationServiceGetNotificationRulesProcedure is the fully-qualified name of the
+	// NotificationService's GetNotificationRules RPC.
+	NotificationServiceGetNotificationRulesProcedure = "/mgmt.v1alpha1.NotificationService/GetNotificationRules"
+	// NotificationServiceDeleteNotificationRuleProcedure is the fully-qualified name of the
+	// NotificationService's DeleteNotificationRule RPC.
+	NotificationServiceDeleteNotificationRuleProcedure = "/mgmt.v1alpha1.NotificationService/DeleteNotificationRule"
+	// NotificationServiceGetNotificationDeliveriesProcedure is the fully-qualified name of the
+	// NotificationService's GetNotificationDeliveries RPC.
+	NotificationServiceGetNotificationDeliveriesProcedure = "/mgmt.v1alpha1.NotificationService/GetNotificationDeliveries"
+	// NotificationServicePublishJobRunEventProcedure is the fully-qualified name of the
+	// NotificationService's PublishJobRunEvent RPC.
+	NotificationServicePublishJobRunEventProcedure = "/mgmt.v1alpha1.NotificationService/PublishJobRunEvent"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	notificationServiceServiceDescriptor                         = v1alpha1.File_mgmt_v1alpha1_notification_proto.Services().ByName("NotificationService")
+	notificationServiceCreateNotificationRuleMethodDescriptor    = notificationServiceServiceDescriptor.Methods().ByName("CreateNotificationRule")
+	notificationServiceGetNotificationRulesMethodDescriptor      = notificationServiceServiceDescriptor.Methods().ByName("GetNotificationRules")
+	notificationServiceDeleteNotificationRuleMethodDescriptor    = notificationServiceServiceDescriptor.Methods().ByName("DeleteNotificationRule")
+	notificationServiceGetNotificationDeliveriesMethodDescriptor = notificationServiceServiceDescriptor.Methods().ByName("GetNotificationDeliveries")
+	notificationServicePublishJobRunEventMethodDescriptor        = notificationServiceServiceDescriptor.Methods().ByName("PublishJobRunEvent")
+)
+
+// NotificationServiceClient is a client for the mgmt.v1alpha1.NotificationService service.
+type NotificationServiceClient interface {
+	// Creates a rule that delivers job run events to a webhook
+	CreateNotificationRule(context.Context, *connect.Request[v1alpha1.CreateNotificationRuleRequest]) (*connect.Response[v1alpha1.CreateNotificationRuleResponse], error)
+	// Returns the notification rules of an account
+	GetNotificationRules(context.Context, *connect.Request[v1alpha1.GetNotificationRulesRequest]) (*connect.Response[v1alpha1.GetNotificationRulesResponse], error)
+	// Deletes a notification rule
+	DeleteNotificationRule(context.Context, *connect.Request[v1alpha1.DeleteNotificationRuleRequest]) (*connect.Response[v1alpha1.DeleteNotificationRuleResponse], error)
+	// Returns the delivery history of an account's notification rules
+	GetNotificationDeliveries(context.Context, *connect.Request[v1alpha1.GetNotificationDeliveriesRequest]) (*connect.Response[v1alpha1.GetNotificationDeliveriesResponse], error)
+	// Delivers a job run event to every rule that matches it. Called by the worker as a job run progresses
+	PublishJobRunEvent(context.Context, *connect.Request[v1alpha1.PublishJobRunEventRequest]) (*connect.Response[v1alpha1.PublishJobRunEventResponse], error)
+}
+
+// NewNotificationServiceClient constructs a client for the mgmt.v1alpha1.NotificationService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NotificationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &notificationServiceClient{
+		createNotificationRule: connect.NewClient[v1alpha1.CreateNotificationRuleRequest, v1alpha1.CreateNotificationRuleResponse](
+			httpClient,
+			baseURL+NotificationServiceCreateNotificationRuleProcedure,
+			connect.WithSchema(notificationServiceCreateNotificationRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getNotificationRules: connect.NewClient[v1alpha1.GetNotificationRulesRequest, v1alpha1.GetNotificationRulesResponse](
+			httpClient,
+			baseURL+NotificationServiceGetNotificationRulesProcedure,
+			connect.WithSchema(notificationServiceGetNotificationRulesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		deleteNotificationRule: connect.NewClient[v1alpha1.DeleteNotificationRuleRequest, v1alpha1.DeleteNotificationRuleResponse](
+			httpClient,
+			baseURL+NotificationServiceDeleteNotificationRuleProcedure,
+			connect.WithSchema(notificationServiceDeleteNotificationRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getNotificationDeliveries: connect.NewClient[v1alpha1.GetNotificationDeliveriesRequest, v1alpha1.GetNotificationDeliveriesResponse](
+			httpClient,
+			baseURL+NotificationServiceGetNotificationDeliveriesProcedure,
+			connect.WithSchema(notificationServiceGetNotificationDeliveriesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		publishJobRunEvent: connect.NewClient[v1alpha1.PublishJobRunEventRequest, v1alpha1.PublishJobRunEventResponse](
+			httpClient,
+			baseURL+NotificationServicePublishJobRunEventProcedure,
+			connect.WithSchema(notificationServicePublishJobRunEventMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// notificationServiceClient implements NotificationServiceClient.
+type notificationServiceClient struct {
+	createNotificationRule    *connect.Client[v1alpha1.CreateNotificationRuleRequest, v1alpha1.CreateNotificationRuleResponse]
+	getNotificationRules      *connect.Client[v1alpha1.GetNotificationRulesRequest, v1alpha1.GetNotificationRulesResponse]
+	deleteNotificationRule    *connect.Client[v1alpha1.DeleteNotificationRuleRequest, v1alpha1.DeleteNotificationRuleResponse]
+	getNotificationDeliveries *connect.Client[v1alpha1.GetNotificationDeliveriesRequest, v1alpha1.GetNotificationDeliveriesResponse]
+	publishJobRunEvent        *connect.Client[v1alpha1.PublishJobRunEventRequest, v1alpha1.PublishJobRunEventResponse]
+}
+
+// CreateNotificationRule calls mgmt.v1alpha1.NotificationService.CreateNotificationRule.
+func (c *notificationServiceClient) CreateNotificationRule(ctx context.Context, req *connect.Request[v1alpha1.CreateNotificationRuleRequest]) (*connect.Response[v1alpha1.CreateNotificationRuleResponse], error) {
+	return c.createNotificationRule.CallUnary(ctx, req)
+}
+
+// GetNotificationRules calls mgmt.v1alpha1.NotificationService.GetNotificationRules.
+func (c *notificationServiceClient) GetNotificationRules(ctx context.Context, req *connect.Request[v1alpha1.GetNotificationRulesRequest]) (*connect.Response[v1alpha1.GetNotificationRulesResponse], error) {
+	return c.getNotificationRules.CallUnary(ctx, req)
+}
+
+// DeleteNotificationRule calls mgmt.v1alpha1.NotificationService.DeleteNotificationRule.
+func (c *notificationServiceClient) DeleteNotificationRule(ctx context.Context, req *connect.Request[v1alpha1.DeleteNotificationRuleRequest]) (*connect.Response[v1alpha1.DeleteNotificationRuleResponse], error) {
+	return c.deleteNotificationRule.CallUnary(ctx, req)
+}
+
+// GetNotificationDeliveries calls mgmt.v1alpha1.NotificationService.GetNotificationDeliveries.
+func (c *notificationServiceClient) GetNotificationDeliveries(ctx context.Context, req *connect.Request[v1alpha1.GetNotificationDeliveriesRequest]) (*connect.Response[v1alpha1.GetNotificationDeliveriesResponse], error) {
+	return c.getNotificationDeliveries.CallUnary(ctx, req)
+}
+
+// PublishJobRunEvent calls mgmt.v1alpha1.NotificationService.PublishJobRunEvent.
+func (c *notificationServiceClient) PublishJobRunEvent(ctx context.Context, req *connect.Request[v1alpha1.PublishJobRunEventRequest]) (*connect.Response[v1alpha1.PublishJobRunEventResponse], error) {
+	return c.publishJobRunEvent.CallUnary(ctx, req)
+}
+
+// NotificationServiceHandler is an implementation of the mgmt.v1alpha1.NotificationService service.
+type NotificationServiceHandler interface {
+	// Creates a rule that delivers job run events to a webhook
+	CreateNotificationRule(context.Context, *connect.Request[v1alpha1.CreateNotificationRuleRequest]) (*connect.Response[v1alpha1.CreateNotificationRuleResponse], error)
+	// Returns the notification rules of an account
+	GetNotificationRules(context.Context, *connect.Request[v1alpha1.GetNotificationRulesRequest]) (*connect.Response[v1alpha1.GetNotificationRulesResponse], error)
+	// Deletes a notification rule
+	DeleteNotificationRule(context.Context, *connect.Request[v1alpha1.DeleteNotificationRuleRequest]) (*connect.Response[v1alpha1.DeleteNotificationRuleResponse], error)
+	// Returns the delivery history of an account's notification rules
+	GetNotificationDeliveries(context.Context, *connect.Request[v1alpha1.GetNotificationDeliveriesRequest]) (*connect.Response[v1alpha1.GetNotificationDeliveriesResponse], error)
+	// Delivers a job run event to every rule that matches it. Called by the worker as a job run progresses
+	PublishJobRunEvent(context.Context, *connect.Request[v1alpha1.PublishJobRunEventRequest]) (*connect.Response[v1alpha1.PublishJobRunEventResponse], error)
+}
+
+// NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	notificationServiceCreateNotificationRuleHandler := connect.NewUnaryHandler(
+		NotificationServiceCreateNotificationRuleProcedure,
+		svc.CreateNotificationRule,
+		connect.WithSchema(notificationServiceCreateNotificationRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceGetNotificationRulesHandler := connect.NewUnaryHandler(
+		NotificationServiceGetNotificationRulesProcedure,
+		svc.GetNotificationRules,
+		connect.WithSchema(notificationServiceGetNotificationRulesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceDeleteNotificationRuleHandler := connect.NewUnaryHandler(
+		NotificationServiceDeleteNotificationRuleProcedure,
+		svc.DeleteNotificationRule,
+		connect.WithSchema(notificationServiceDeleteNotificationRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceGetNotificationDeliveriesHandler := connect.NewUnaryHandler(
+		NotificationServiceGetNotificationDeliveriesProcedure,
+		svc.GetNotificationDeliveries,
+		connect.WithSchema(notificationServiceGetNotificationDeliveriesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServicePublishJobRunEventHandler := connect.NewUnaryHandler(
+		NotificationServicePublishJobRunEventProcedure,
+		svc.PublishJobRunEvent,
+		connect.WithSchema(notificationServicePublishJobRunEventMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgmt.v1alpha1.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case NotificationServiceCreateNotificationRuleProcedure:
+			notificationServiceCreateNotificationRuleHandler.ServeHTTP(w, r)
+		case NotificationServiceGetNotificationRulesProcedure:
+			notificationServiceGetNotificationRulesHandler.ServeHTTP(w, r)
+		case NotificationServiceDeleteNotificationRuleProcedure:
+			notificationServiceDeleteNotificationRuleHandler.ServeHTTP(w, r)
+		case NotificationServiceGetNotificationDeliveriesProcedure:
+			notificationServiceGetNotificationDeliveriesHandler.ServeHTTP(w, r)
+		case NotificationServicePublishJobRunEventProcedure:
+			notificationServicePublishJobRunEventHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedNotificationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedNotificationServiceHandler struct{}
+
+func (UnimplementedNotificationServiceHandler) CreateNotificationRule(context.Context, *connect.Request[v1alpha1.CreateNotificationRuleRequest]) (*connect.Response[v1alpha1.CreateNotificationRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.NotificationService.CreateNotificationRule is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) GetNotificationRules(context.Context, *connect.Request[v1alpha1.GetNotificationRulesRequest]) (*connect.Response[v1alpha1.GetNotificationRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.NotificationService.GetNotificationRules is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) DeleteNotificationRule(context.Context, *connect.Request[v1alpha1.DeleteNotificationRuleRequest]) (*connect.Response[v1alpha1.DeleteNotificationRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.NotificationService.DeleteNotificationRule is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) GetNotificationDeliveries(context.Context, *connect.Request[v1alpha1.GetNotificationDeliveriesRequest]) (*connect.Response[v1alpha1.GetNotificationDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.NotificationService.GetNotificationDeliveries is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) PublishJobRunEvent(context.Context, *connect.Request[v1alpha1.PublishJobRunEventRequest]) (*connect.Response[v1alpha1.PublishJobRunEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.NotificationService.PublishJobRunEvent is not implemented"))
+}
@@ -0,0 +1,345 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mgmt/v1alpha1/pipeline.proto
+
+package mgmtv1alpha1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PipelineServiceName is the fully-qualified name of the PipelineService service.
+	PipelineServiceName = "mgmt.v1alpha1.PipelineService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PipelineServiceCreatePipelineProcedure is the fully-qualified name of the PipelineService's
+	// CreatePipeline RPC.
+	PipelineServiceCreatePipelineProcedure = "/mgmt.v1alpha1.PipelineService/CreatePipeline"
+	// PipelineServiceGetPipelinesProcedure is the fully-qualified name of the PipelineService's
+	// GetPipelines RPC.
+	PipelineServiceGetPipelinesProcedure = "/mgmt.v1alpha1.PipelineService/GetPipelines"
+	// PipelineServiceGetPipelineProcedure is the fully-qualified name of the PipelineService's
+	// GetPipeline RPC.
+	PipelineServiceGetPipelineProcedure = "/mgmt.v1alpha1.PipelineService/GetPipeline"
+	// PipelineServiceUpdatePipelineProcedure is the fully-qualified name of the PipelineService's
+	// UpdatePipeline RPC.
+	PipelineServiceUpdatePipelineProcedure = "/mgmt.v1alpha1.PipelineService/UpdatePipeline"
+	// PipelineServiceDeletePipelineProcedure is the fully-qualified name of the PipelineService's
+	// DeletePipeline RPC.
+	PipelineServiceDeletePipelineProcedure = "/mgmt.v1alpha1.PipelineService/DeletePipeline"
+	// PipelineServiceCreatePipelineRunProcedure is the fully-qualified name of the PipelineService's
+	// CreatePipelineRun RPC.
+	PipelineServiceCreatePipelineRunProcedure = "/mgmt.v1alpha1.PipelineService/CreatePipelineRun"
+	// PipelineServiceGetPipelineRunsProcedure is the fully-qualified name of the PipelineService's
+	// GetPipelineRuns RPC.
+	PipelineServiceGetPipelineRunsProcedure = "/mgmt.v1alpha1.PipelineService/GetPipelineRuns"
+	// PipelineServiceCancelPipelineRunProcedure is the fully-qualified name of the PipelineService's
+	// CancelPipelineRun RPC.
+	PipelineServiceCancelPipelineRunProcedure = "/mgmt.v1alpha1.PipelineService/CancelPipelineRun"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	pipelineServiceServiceDescriptor                 = v1alpha1.File_mgmt_v1alpha1_pipeline_proto.Services().ByName("PipelineService")
+	pipelineServiceCreatePipelineMethodDescriptor    = pipelineServiceServiceDescriptor.Methods().ByName("CreatePipeline")
+	pipelineServiceGetPipelinesMethodDescriptor      = pipelineServiceServiceDescriptor.Methods().ByName("GetPipelines")
+	pipelineServiceGetPipelineMethodDescriptor       = pipelineServiceServiceDescriptor.Methods().ByName("GetPipeline")
+	pipelineServiceUpdatePipelineMethodDescriptor    = pipelineServiceServiceDescriptor.Methods().ByName("UpdatePipeline")
+	pipelineServiceDeletePipelineMethodDescriptor    = pipelineServiceServiceDescriptor.Methods().ByName("DeletePipeline")
+	pipelineServiceCreatePipelineRunMethodDescriptor = pipelineServiceServiceDescriptor.Methods().ByName("CreatePipelineRun")
+	pipelineServiceGetPipelineRunsMethodDescriptor   = pipelineServiceServiceDescriptor.Methods().ByName("GetPipelineRuns")
+	pipelineServiceCancelPipelineRunMethodDescriptor = pipelineServiceServiceDescriptor.Methods().ByName("CancelPipelineRun")
+)
+
+// PipelineServiceClient is a client for the mgmt.v1alpha1.PipelineService service.
+type PipelineServiceClient interface {
+	// Creates a pipeline
+	CreatePipeline(context.Context, *connect.Request[v1alpha1.CreatePipelineRequest]) (*connect.Response[v1alpha1.CreatePipelineResponse], error)
+	// Returns the pipelines of an account
+	GetPipelines(context.Context, *connect.Request[v1alpha1.GetPipelinesRequest]) (*connect.Response[v1alpha1.GetPipelinesResponse], error)
+	// Returns a single pipeline
+	GetPipeline(context.Context, *connect.Request[v1alpha1.GetPipelineRequest]) (*connect.Response[v1alpha1.GetPipelineResponse], error)
+	// Replaces the name, steps, and schedule of a pipeline
+	UpdatePipeline(context.Context, *connect.Request[v1alpha1.UpdatePipelineRequest]) (*connect.Response[v1alpha1.UpdatePipelineResponse], error)
+	// Deletes a pipeline and its schedule. The jobs of the pipeline are not deleted
+	DeletePipeline(context.Context, *connect.Request[v1alpha1.DeletePipelineRequest]) (*connect.Response[v1alpha1.DeletePipelineResponse], error)
+	// Starts a run of the pipeline
+	CreatePipelineRun(context.Context, *connect.Request[v1alpha1.CreatePipelineRunRequest]) (*connect.Response[v1alpha1.CreatePipelineRunResponse], error)
+	// Returns the runs of a pipeline
+	GetPipelineRuns(context.Context, *connect.Request[v1alpha1.GetPipelineRunsRequest]) (*connect.Response[v1alpha1.GetPipelineRunsResponse], error)
+	// Cancels a pipeline run. The job run of the current step is canceled as well
+	CancelPipelineRun(context.Context, *connect.Request[v1alpha1.CancelPipelineRunRequest]) (*connect.Response[v1alpha1.CancelPipelineRunResponse], error)
+}
+
+// NewPipelineServiceClient constructs a client for the mgmt.v1alpha1.PipelineService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPipelineServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PipelineServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &pipelineServiceClient{
+		createPipeline: connect.NewClient[v1alpha1.CreatePipelineRequest, v1alpha1.CreatePipelineResponse](
+			httpClient,
+			baseURL+PipelineServiceCreatePipelineProcedure,
+			connect.WithSchema(pipelineServiceCreatePipelineMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getPipelines: connect.NewClient[v1alpha1.GetPipelinesRequest, v1alpha1.GetPipelinesResponse](
+			httpClient,
+			baseURL+PipelineServiceGetPipelinesProcedure,
+			connect.WithSchema(pipelineServiceGetPipelinesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPipeline: connect.NewClient[v1alpha1.GetPipelineRequest, v1alpha1.GetPipelineResponse](
+			httpClient,
+			baseURL+PipelineServiceGetPipelineProcedure,
+			connect.WithSchema(pipelineServiceGetPipelineMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updatePipeline: connect.NewClient[v1alpha1.UpdatePipelineRequest, v1alpha1.UpdatePipelineResponse](
+			httpClient,
+			baseURL+PipelineServiceUpdatePipelineProcedure,
+			connect.WithSchema(pipelineServiceUpdatePipelineMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deletePipeline: connect.NewClient[v1alpha1.DeletePipelineRequest, v1alpha1.DeletePipelineResponse](
+			httpClient,
+			baseURL+PipelineServiceDeletePipelineProcedure,
+			connect.WithSchema(pipelineServiceDeletePipelineMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createPipelineRun: connect.NewClient[v1alpha1.CreatePipelineRunRequest, v1alpha1.CreatePipelineRunResponse](
+			httpClient,
+			baseURL+PipelineServiceCreatePipelineRunProcedure,
+			connect.WithSchema(pipelineServiceCreatePipelineRunMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getPipelineRuns: connect.NewClient[v1alpha1.GetPipelineRunsRequest, v1alpha1.GetPipelineRunsResponse](
+			httpClient,
+			baseURL+PipelineServiceGetPipelineRunsProcedure,
+			connect.WithSchema(pipelineServiceGetPipelineRunsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		cancelPipelineRun: connect.NewClient[v1alpha1.CancelPipelineRunRequest, v1alpha1.CancelPipelineRunResponse](
+			httpClient,
+			baseURL+PipelineServiceCancelPipelineRunProcedure,
+			connect.WithSchema(pipelineServiceCancelPipelineRunMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// pipelineServiceClient implements PipelineServiceClient.
+type pipelineServiceClient struct {
+	createPipeline    *connect.Client[v1alpha1.CreatePipelineRequest, v1alpha1.CreatePipelineResponse]
+	getPipelines      *connect.Client[v1alpha1.GetPipelinesRequest, v1alpha1.GetPipelinesResponse]
+	getPipeline       *connect.Client[v1alpha1.GetPipelineRequest, v1alpha1.GetPipelineResponse]
+	updatePipeline    *connect.Client[v1alpha1.UpdatePipelineRequest, v1alpha1.UpdatePipelineResponse]
+	deletePipeline    *connect.Client[v1alpha1.DeletePipelineRequest, v1alpha1.DeletePipelineResponse]
+	createPipelineRun *connect.Client[v1alpha1.CreatePipelineRunRequest, v1alpha1.CreatePipelineRunResponse]
+	getPipelineRuns   *connect.Client[v1alpha1.GetPipelineRunsRequest, v1alpha1.GetPipelineRunsResponse]
+	cancelPipelineRun *connect.Client[v1alpha1.CancelPipelineRunRequest, v1alpha1.CancelPipelineRunResponse]
+}
+
+// CreatePipeline calls mgmt.v1alpha1.PipelineService.CreatePipeline.
+func (c *pipelineServiceClient) CreatePipeline(ctx context.Context, req *connect.Request[v1alpha1.CreatePipelineRequest]) (*connect.Response[v1alpha1.CreatePipelineResponse], error) {
+	return c.createPipeline.CallUnary(ctx, req)
+}
+
+// GetPipelines calls mgmt.v1alpha1.PipelineService.GetPipelines.
+func (c *pipelineServiceClient) GetPipelines(ctx context.Context, req *connect.Request[v1alpha1.GetPipelinesRequest]) (*connect.Response[v1alpha1.GetPipelinesResponse], error) {
+	return c.getPipelines.CallUnary(ctx, req)
+}
+
+// GetPipeline calls mgmt.v1alpha1.PipelineService.GetPipeline.
+func (c *pipelineServiceClient) GetPipeline(ctx context.Context, req *connect.Request[v1alpha1.GetPipelineRequest]) (*connect.Response[v1alpha1.GetPipelineResponse], error) {
+	return c.getPipeline.CallUnary(ctx, req)
+}
+
+// UpdatePipeline calls mgmt.v1alpha1.PipelineService.UpdatePipeline.
+func (c *pipelineServiceClient) UpdatePipeline(ctx context.Context, req *connect.Request[v1alpha1.UpdatePipelineRequest]) (*connect.Response[v1alpha1.UpdatePipelineResponse], error) {
+	return c.updatePipeline.CallUnary(ctx, req)
+}
+
+// DeletePipeline calls mgmt.v1alpha1.PipelineService.DeletePipeline.
+func (c *pipelineServiceClient) DeletePipeline(ctx context.Context, req *connect.Request[v1alpha1.DeletePipelineRequest]) (*connect.Response[v1alpha1.DeletePipelineResponse], error) {
+	return c.deletePipeline.CallUnary(ctx, req)
+}
+
+// CreatePipelineRun calls mgmt.v1alpha1.PipelineService.CreatePipelineRun.
+func (c *pipelineServiceClient) CreatePipelineRun(ctx context.Context, req *connect.Request[v1alpha1.CreatePipelineRunRequest]) (*connect.Response[v1alpha1.CreatePipelineRunResponse], error) {
+	return c.createPipelineRun.CallUnary(ctx, req)
+}
+
+// GetPipelineRuns calls mgmt.v1alpha1.PipelineService.GetPipelineRuns.
+func (c *pipelineServiceClient) GetPipelineRuns(ctx context.Context, req *connect.Request[v1alpha1.GetPipelineRunsRequest]) (*connect.Response[v1alpha1.GetPipelineRunsResponse], error) {
+	return c.getPipelineRuns.CallUnary(ctx, req)
+}
+
+// CancelPipelineRun calls mgmt.v1alpha1.PipelineService.CancelPipelineRun.
+func (c *pipelineServiceClient) CancelPipelineRun(ctx context.Context, req *connect.Request[v1alpha1.CancelPipelineRunRequest]) (*connect.Response[v1alpha1.CancelPipelineRunResponse], error) {
+	return c.cancelPipelineRun.CallUnary(ctx, req)
+}
+
+// PipelineServiceHandler is an implementation of the mgmt.v1alpha1.PipelineService service.
+type PipelineServiceHandler interface {
+	// Creates a pipeline
+	CreatePipeline(context.Context, *connect.Request[v1alpha1.CreatePipelineRequest]) (*connect.Response[v1alpha1.CreatePipelineResponse], error)
+	// Returns the pipelines of an account
+	GetPipelines(context.Context, *connect.Request[v1alpha1.GetPipelinesRequest]) (*connect.Response[v1alpha1.GetPipelinesResponse], error)
+	// Returns a single pipeline
+	GetPipeline(context.Context, *connect.Request[v1alpha1.GetPipelineRequest]) (*connect.Response[v1alpha1.GetPipelineResponse], error)
+	// Replaces the name, steps, and schedule of a pipeline
+	UpdatePipeline(context.Context, *connect.Request[v1alpha1.UpdatePipelineRequest]) (*connect.Response[v1alpha1.UpdatePipelineResponse], error)
+	// Deletes a pipeline and its schedule. The jobs of the pipeline are not deleted
+	DeletePipeline(context.Context, *connect.Request[v1alpha1.DeletePipelineRequest]) (*connect.Response[v1alpha1.DeletePipelineResponse], error)
+	// Starts a run of the pipeline
+	CreatePipelineRun(context.Context, *connect.Request[v1alpha1.CreatePipelineRunRequest]) (*connect.Response[v1alpha1.CreatePipelineRunResponse], error)
+	// Returns the runs of a pipeline
+	GetPipelineRuns(context.Context, *connect.Request[v1alpha1.GetPipelineRunsRequest]) (*connect.Response[v1alpha1.GetPipelineRunsResponse], error)
+	// Cancels a pipeline run. The job run of the current step is canceled as well
+	CancelPipelineRun(context.Context, *connect.Request[v1alpha1.CancelPipelineRunRequest]) (*connect.Response[v1alpha1.CancelPipelineRunResponse], error)
+}
+
+// NewPipelineServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPipelineServiceHandler(svc PipelineServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	pipelineServiceCreatePipelineHandler := connect.NewUnaryHandler(
+		PipelineServiceCreatePipelineProcedure,
+		svc.CreatePipeline,
+		connect.WithSchema(pipelineServiceCreatePipelineMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	pipelineServiceGetPipelinesHandler := connect.NewUnaryHandler(
+		PipelineServiceGetPipelinesProcedure,
+		svc.GetPipelines,
+		connect.WithSchema(pipelineServiceGetPipelinesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	pipelineServiceGetPipelineHandler := connect.NewUnaryHandler(
+		PipelineServiceGetPipelineProcedure,
+		svc.GetPipeline,
+		connect.WithSchema(pipelineServiceGetPipelineMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	pipelineServiceUpdatePipelineHandler := connect.NewUnaryHandler(
+		PipelineServiceUpdatePipelineProcedure,
+		svc.UpdatePipeline,
+		connect.WithSchema(pipelineServiceUpdatePipelineMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	pipelineServiceDeletePipelineHandler := connect.NewUnaryHandler(
+		PipelineServiceDeletePipelineProcedure,
+		svc.DeletePipeline,
+		connect.WithSchema(pipelineServiceDeletePipelineMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	pipelineServiceCreatePipelineRunHandler := connect.NewUnaryHandler(
+		PipelineServiceCreatePipelineRunProcedure,
+		svc.CreatePipelineRun,
+		connect.WithSchema(pipelineServiceCreatePipelineRunMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	pipelineServiceGetPipelineRunsHandler := connect.NewUnaryHandler(
+		PipelineServiceGetPipelineRunsProcedure,
+		svc.GetPipelineRuns,
+		connect.WithSchema(pipelineServiceGetPipelineRunsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	pipelineServiceCancelPipelineRunHandler := connect.NewUnaryHandler(
+		PipelineServiceCancelPipelineRunProcedure,
+		svc.CancelPipelineRun,
+		connect.WithSchema(pipelineServiceCancelPipelineRunMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mgmt.v1alpha1.PipelineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PipelineServiceCreatePipelineProcedure:
+			pipelineServiceCreatePipelineHandler.ServeHTTP(w, r)
+		case PipelineServiceGetPipelinesProcedure:
+			pipelineServiceGetPipelinesHandler.ServeHTTP(w, r)
+		case PipelineServiceGetPipelineProcedure:
+			pipelineServiceGetPipelineHandler.ServeHTTP(w, r)
+		case PipelineServiceUpdatePipelineProcedure:
+			pipelineServiceUpdatePipelineHandler.ServeHTTP(w, r)
+		case PipelineServiceDeletePipelineProcedure:
+			pipelineServiceDeletePipelineHandler.ServeHTTP(w, r)
+		case PipelineServiceCreatePipelineRunProcedure:
+			pipelineServiceCreatePipelineRunHandler.ServeHTTP(w, r)
+		case PipelineServiceGetPipelineRunsProcedure:
+			pipelineServiceGetPipelineRunsHandler.ServeHTTP(w, r)
+		case PipelineServiceCancelPipelineRunProcedure:
+			pipelineServiceCancelPipelineRunHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPipelineServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPipelineServiceHandler struct{}
+
+func (UnimplementedPipelineServiceHandler) CreatePipeline(context.Context, *connect.Request[v1alpha1.CreatePipelineRequest]) (*connect.Response[v1alpha1.CreatePipelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.PipelineService.CreatePipeline is not implemented"))
+}
+
+func (UnimplementedPipelineServiceHandler) GetPipelines(context.Context, *connect.Request[v1alpha1.GetPipelinesRequest]) (*connect.Response[v1alpha1.GetPipelinesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.PipelineService.GetPipelines is not implemented"))
+}
+
+func (UnimplementedPipelineServiceHandler) GetPipeline(context.Context, *connect.Request[v1alpha1.GetPipelineRequest]) (*connect.Response[v1alpha1.GetPipelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.PipelineService.GetPipeline is not implemented"))
+}
+
+func (UnimplementedPipelineServiceHandler) UpdatePipeline(context.Context, *connect.Request[v1alpha1.UpdatePipelineRequest]) (*connect.Response[v1alpha1.UpdatePipelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.PipelineService.UpdatePipeline is not implemented"))
+}
+
+func (UnimplementedPipelineServiceHandler) DeletePipeline(context.Context, *connect.Request[v1alpha1.DeletePipelineRequest]) (*connect.Response[v1alpha1.DeletePipelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.PipelineService.DeletePipeline is not implemented"))
+}
+
+func (UnimplementedPipelineServiceHandler) CreatePipelineRun(context.Context, *connect.Request[v1alpha1.CreatePipelineRunRequest]) (*connect.Response[v1alpha1.CreatePipelineRunResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.PipelineService.CreatePipelineRun is not implemented"))
+}
+
+func (UnimplementedPipelineServiceHandler) GetPipelineRuns(context.Context, *connect.Request[v1alpha1.GetPipelineRunsRequest]) (*connect.Response[v1alpha1.GetPipelineRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.PipelineService.GetPipelineRuns is not implemented"))
+}
+
+func (UnimplementedPipelineServiceHandler) CancelPipelineRun(context.Context, *connect.Request[v1alpha1.CancelPipelineRunRequest]) (*connect.Response[v1alpha1.CancelPipelineRunResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.PipelineService.CancelPipelineRun is not implemented"))
+}
@@ -66,6 +66,12 @@ const (
 	// UserAccountServiceRemoveTeamAccountMemberProcedure is the fully-qualified name of the
 	// UserAccountService's RemoveTeamAccountMember RPC.
 	UserAccountServiceRemoveTeamAccountMemberProcedure = "/mgmt.v1alpha1.UserAccountService/RemoveTeamAccountMember"
+	// UserAccountServiceSetTeamAccountMemberRoleProcedure is the fully-qualified name of the
+	// UserAccountService's SetTeamAccountMemberRole RPC.
+	UserAccountServiceSetTeamAccountMemberRoleProcedure = "/mgmt.v1alpha1.UserAccountService/SetTeamAccountMemberRole"
+	// UserAccountServiceGetAccountRoleProcedure is the fully-qualified name of the UserAccountService's
+	// GetAccountRole RPC.
+	UserAccountServiceGetAccountRoleProcedure = "/mgmt.v1alpha1.UserAccountService/GetAccountRole"
 	// UserAccountServiceInviteUserToTeamAccountProcedure is the fully-qualified name of the
 	// UserAccountService's InviteUserToTeamAccount RPC.
 	UserAccountServiceInviteUserToTeamAccountProcedure = "/mgmt.v1alpha1.UserAccountService/InviteUserToTeamAccount"
@@ -121,6 +127,8 @@ var (
 	userAccountServiceSetAccountTemporalConfigMethodDescriptor         = userAccountServiceServiceDescriptor.Methods().ByName("SetAccountTemporalConfig")
 	userAccountServiceGetTeamAccountMembersMethodDescriptor            = userAccountServiceServiceDescriptor.Methods().ByName("GetTeamAccountMembers")
 	userAccountServiceRemoveTeamAccountMemberMethodDescriptor          = userAccountServiceServiceDescriptor.Methods().ByName("RemoveTeamAccountMember")
+	userAccountServiceSetTeamAccountMemberRoleMethodDescriptor         = userAccountServiceServiceDescriptor.Methods().ByName("SetTeamAccountMemberRole")
+	userAccountServiceGetAccountRoleMethodDescriptor                   = userAccountServiceServiceDescriptor.Methods().ByName("GetAccountRole")
 	userAccountServiceInviteUserToTeamAccountMethodDescriptor          = userAccountServiceServiceDescriptor.Methods().ByName("InviteUserToTeamAccount")
 	userAccountServiceGetTeamAccountInvitesMethodDescriptor            = userAccountServiceServiceDescriptor.Methods().ByName("GetTeamAccountInvites")
 	userAccountServiceRemoveTeamAccountInviteMethodDescriptor          = userAccountServiceServiceDescriptor.Methods().ByName("RemoveTeamAccountInvite")
@@ -151,6 +159,10 @@ type UserAccountServiceClient interface {
 	SetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.SetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.SetAccountTemporalConfigResponse], error)
 	GetTeamAccountMembers(context.Context, *connect.Request[v1alpha1.GetTeamAccountMembersRequest]) (*connect.Response[v1alpha1.GetTeamAccountMembersResponse], error)
 	RemoveTeamAccountMember(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountMemberRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountMemberResponse], error)
+	// Changes the role of a member of a team account
+	SetTeamAccountMemberRole(context.Context, *connect.Request[v1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamAccountMemberRoleResponse], error)
+	// Returns the role that the requesting user has in the account
+	GetAccountRole(context.Context, *connect.Request[v1alpha1.GetAccountRoleRequest]) (*connect.Response[v1alpha1.GetAccountRoleResponse], error)
 	InviteUserToTeamAccount(context.Context, *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error)
 	GetTeamAccountInvites(context.Context, *connect.Request[v1alpha1.GetTeamAccountInvitesRequest]) (*connect.Response[v1alpha1.GetTeamAccountInvitesResponse], error)
 	RemoveTeamAccountInvite(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountInviteRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountInviteResponse], error)
@@ -248,6 +260,19 @@ func NewUserAccountServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(userAccountServiceRemoveTeamAccountMemberMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		setTeamAccountMemberRole: connect.NewClient[v1alpha1.SetTeamAccountMemberRoleRequest, v1alpha1.SetTeamAccountMemberRoleResponse](
+			httpClient,
+			baseURL+UserAccountServiceSetTeamAccountMemberRoleProcedure,
+			connect.WithSchema(userAccountServiceSetTeamAccountMemberRoleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountRole: connect.NewClient[v1alpha1.GetAccountRoleRequest, v1alpha1.GetAccountRoleResponse](
+			httpClient,
+			baseURL+UserAccountServiceGetAccountRoleProcedure,
+			connect.WithSchema(userAccountServiceGetAccountRoleMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 		inviteUserToTeamAccount: connect.NewClient[v1alpha1.InviteUserToTeamAccountRequest, v1alpha1.InviteUserToTeamAccountResponse](
 			httpClient,
 			baseURL+UserAccountServiceInviteUserToTeamAccountProcedure,
@@ -346,6 +371,8 @@ type userAccountServiceClient struct {
 	setAccountTemporalConfig         *connect.Client[v1alpha1.SetAccountTemporalConfigRequest, v1alpha1.SetAccountTemporalConfigResponse]
 	getTeamAccountMembers            *connect.Client[v1alpha1.GetTeamAccountMembersRequest, v1alpha1.GetTeamAccountMembersResponse]
 	removeTeamAccountMember          *connect.Client[v1alpha1.RemoveTeamAccountMemberRequest, v1alpha1.RemoveTeamAccountMemberResponse]
+	setTeamAccountMemberRole         *connect.Client[v1alpha1.SetTeamAccountMemberRoleRequest, v1alpha1.SetTeamAccountMemberRoleResponse]
+	getAccountRole                   *connect.Client[v1alpha1.GetAccountRoleRequest, v1alpha1.GetAccountRoleResponse]
 	inviteUserToTeamAccount          *connect.Client[v1alpha1.InviteUserToTeamAccountRequest, v1alpha1.InviteUserToTeamAccountResponse]
 	getTeamAccountInvites            *connect.Client[v1alpha1.GetTeamAccountInvitesRequest, v1alpha1.GetTeamAccountInvitesResponse]
 	removeTeamAccountInvite          *connect.Client[v1alpha1.RemoveTeamAccountInviteRequest, v1alpha1.RemoveTeamAccountInviteResponse]
@@ -416,6 +443,16 @@ func (c *userAccountServiceClient) RemoveTeamAccountMember(ctx context.Context,
 	return c.removeTeamAccountMember.CallUnary(ctx, req)
 }
 
+// SetTeamAccountMemberRole calls mgmt.v1alpha1.UserAccountService.SetTeamAccountMemberRole.
+func (c *userAccountServiceClient) SetTeamAccountMemberRole(ctx context.Context, req *connect.Request[v1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamAccountMemberRoleResponse], error) {
+	return c.setTeamAccountMemberRole.CallUnary(ctx, req)
+}
+
+// GetAccountRole calls mgmt.v1alpha1.UserAccountService.GetAccountRole.
+func (c *userAccountServiceClient) GetAccountRole(ctx context.Context, req *connect.Request[v1alpha1.GetAccountRoleRequest]) (*connect.Response[v1alpha1.GetAccountRoleResponse], error) {
+	return c.getAccountRole.CallUnary(ctx, req)
+}
+
 // InviteUserToTeamAccount calls mgmt.v1alpha1.UserAccountService.InviteUserToTeamAccount.
 func (c *userAccountServiceClient) InviteUserToTeamAccount(ctx context.Context, req *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error) {
 	return c.inviteUserToTeamAccount.CallUnary(ctx, req)
@@ -498,6 +535,10 @@ type UserAccountServiceHandler interface {
 	SetAccountTemporalConfig(context.Context, *connect.Request[v1alpha1.SetAccountTemporalConfigRequest]) (*connect.Response[v1alpha1.SetAccountTemporalConfigResponse], error)
 	GetTeamAccountMembers(context.Context, *connect.Request[v1alpha1.GetTeamAccountMembersRequest]) (*connect.Response[v1alpha1.GetTeamAccountMembersResponse], error)
 	RemoveTeamAccountMember(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountMemberRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountMemberResponse], error)
+	// Changes the role of a member of a team account
+	SetTeamAccountMemberRole(context.Context, *connect.Request[v1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamAccountMemberRoleResponse], error)
+	// Returns the role that the requesting user has in the account
+	GetAccountRole(context.Context, *connect.Request[v1alpha1.GetAccountRoleRequest]) (*connect.Response[v1alpha1.GetAccountRoleResponse], error)
 	InviteUserToTeamAccount(context.Context, *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error)
 	GetTeamAccountInvites(context.Context, *connect.Request[v1alpha1.GetTeamAccountInvitesRequest]) (*connect.Response[v1alpha1.GetTeamAccountInvitesResponse], error)
 	RemoveTeamAccountInvite(context.Context, *connect.Request[v1alpha1.RemoveTeamAccountInviteRequest]) (*connect.Response[v1alpha1.RemoveTeamAccountInviteResponse], error)
@@ -591,6 +632,19 @@ func NewUserAccountServiceHandler(svc UserAccountServiceHandler, opts ...connect
 		connect.WithSchema(userAccountServiceRemoveTeamAccountMemberMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	userAccountServiceSetTeamAccountMemberRoleHandler := connect.NewUnaryHandler(
+		UserAccountServiceSetTeamAccountMemberRoleProcedure,
+		svc.SetTeamAccountMemberRole,
+		connect.WithSchema(userAccountServiceSetTeamAccountMemberRoleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userAccountServiceGetAccountRoleHandler := connect.NewUnaryHandler(
+		UserAccountServiceGetAccountRoleProcedure,
+		svc.GetAccountRole,
+		connect.WithSchema(userAccountServiceGetAccountRoleMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	userAccountServiceInviteUserToTeamAccountHandler := connect.NewUnaryHandler(
 		UserAccountServiceInviteUserToTeamAccountProcedure,
 		svc.InviteUserToTeamAccount,
@@ -697,6 +751,10 @@ func NewUserAccountServiceHandler(svc UserAccountServiceHandler, opts ...connect
 			userAccountServiceGetTeamAccountMembersHandler.ServeHTTP(w, r)
 		case UserAccountServiceRemoveTeamAccountMemberProcedure:
 			userAccountServiceRemoveTeamAccountMemberHandler.ServeHTTP(w, r)
+		case UserAccountServiceSetTeamAccountMemberRoleProcedure:
+			userAccountServiceSetTeamAccountMemberRoleHandler.ServeHTTP(w, r)
+		case UserAccountServiceGetAccountRoleProcedure:
+			userAccountServiceGetAccountRoleHandler.ServeHTTP(w, r)
 		case UserAccountServiceInviteUserToTeamAccountProcedure:
 			userAccountServiceInviteUserToTeamAccountHandler.ServeHTTP(w, r)
 		case UserAccountServiceGetTeamAccountInvitesProcedure:
@@ -776,6 +834,14 @@ func (UnimplementedUserAccountServiceHandler) RemoveTeamAccountMember(context.Co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.RemoveTeamAccountMember is not implemented"))
 }
 
+func (UnimplementedUserAccountServiceHandler) SetTeamAccountMemberRole(context.Context, *connect.Request[v1alpha1.SetTeamAccountMemberRoleRequest]) (*connect.Response[v1alpha1.SetTeamAccountMemberRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.SetTeamAccountMemberRole is not implemented"))
+}
+
+func (UnimplementedUserAccountServiceHandler) GetAccountRole(context.Context, *connect.Request[v1alpha1.GetAccountRoleRequest]) (*connect.Response[v1alpha1.GetAccountRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.GetAccountRole is not implemented"))
+}
+
 func (UnimplementedUserAccountServiceHandler) InviteUserToTeamAccount(context.Context, *connect.Request[v1alpha1.InviteUserToTeamAccountRequest]) (*connect.Response[v1alpha1.InviteUserToTeamAccountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mgmt.v1alpha1.UserAccountService.InviteUserToTeamAccount is not implemented"))
 }
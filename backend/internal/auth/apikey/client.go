@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/subtle"
 	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -70,6 +71,10 @@ func (c *Client) InjectTokenCtx(ctx context.Context, header http.Header, spec co
 			return nil, ApiKeyExpiredErr
 		}
 
+		if !isProcedureAllowedForScopes(apiKey.Scopes, spec.Procedure) {
+			return nil, nucleuserrors.NewForbidden(fmt.Sprintf("api key does not have a scope that allows it to call %s", spec.Procedure))
+		}
+
 		return SetTokenData(ctx, &TokenContextData{
 			RawToken:   token,
 			ApiKey:     &apiKey,
@@ -0,0 +1,310 @@
+package auth_apikey
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+)
+
+type Scope string
+
+const (
+	// View jobs, their runs, and their statuses
+	ScopeJobsRead Scope = "jobs:read"
+	// Create, update, and delete jobs
+	ScopeJobsWrite Scope = "jobs:write"
+	// Trigger, cancel, and terminate job runs
+	ScopeJobsTrigger Scope = "jobs:trigger"
+	// View connections and their schemas
+	ScopeConnectionsRead Scope = "connections:read"
+	// Create, update, and delete connections
+	ScopeConnectionsWrite Scope = "connections:write"
+	// View connections and stream data out of them. Used by neosync sync
+	ScopeConnectionsData Scope = "connections:data"
+	// View system and user defined transformers
+	ScopeTransformersRead Scope = "transformers:read"
+	// Create, update, and delete user defined transformers
+	ScopeTransformersWrite Scope = "transformers:write"
+	// Call the anonymization endpoints
+	ScopeAnonymize Scope = "anonymize"
+	// View usage metrics
+	ScopeMetricsRead Scope = "metrics:read"
+)
+
+var allScopes = []Scope{
+	ScopeJobsRead,
+	ScopeJobsWrite,
+	ScopeJobsTrigger,
+	ScopeConnectionsRead,
+	ScopeConnectionsWrite,
+	ScopeConnectionsData,
+	ScopeTransformersRead,
+	ScopeTransformersWrite,
+	ScopeAnonymize,
+	ScopeMetricsRead,
+}
+
+// The scopes that allow a scoped API key to call each procedure. Any one of the listed scopes is sufficient.
+// Procedures with an empty list may be called by any scoped API key.
+// Procedures that are not listed may only be called by API keys without scopes.
+var procedureScopes = map[string][]Scope{
+	mgmtv1alpha1connect.UserAccountServiceGetUserProcedure:              {},
+	mgmtv1alpha1connect.UserAccountServiceGetUserAccountsProcedure:      {},
+	mgmtv1alpha1connect.UserAccountServiceIsUserInAccountProcedure:      {},
+	mgmtv1alpha1connect.UserAccountServiceGetAccountRoleProcedure:       {},
+	mgmtv1alpha1connect.UserAccountServiceGetSystemInformationProcedure: {},
+	mgmtv1alpha1connect.UserAccountServiceGetAccountStatusProcedure:     {},
+	mgmtv1alpha1connect.UserAccountServiceIsAccountStatusValidProcedure: {},
+
+	mgmtv1alpha1connect.JobServiceGetJobsProcedure:                          {ScopeJobsRead, ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceGetJobProcedure:                           {ScopeJobsRead, ScopeJobsWrite, ScopeJobsTrigger},
+	mgmtv1alpha1connect.JobServiceIsJobNameAvailableProcedure:               {ScopeJobsRead, ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceGetJobRecentRunsProcedure:                 {ScopeJobsRead, ScopeJobsWrite, ScopeJobsTrigger},
+	mgmtv1alpha1connect.JobServiceGetJobNextRunsProcedure:                   {ScopeJobsRead, ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceGetJobStatusProcedure:                     {ScopeJobsRead, ScopeJobsWrite, ScopeJobsTrigger},
+	mgmtv1alpha1connect.JobServiceGetJobStatusesProcedure:                   {ScopeJobsRead, ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceGetJobRunsProcedure:                       {ScopeJobsRead, ScopeJobsWrite, ScopeJobsTrigger},
+	mgmtv1alpha1connect.JobServiceGetJobRunEventsProcedure:                  {ScopeJobsRead, ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceGetJobRunProcedure:                        {ScopeJobsRead, ScopeJobsWrite, ScopeJobsTrigger},
+	mgmtv1alpha1connect.JobServiceGetJobRunLogsStreamProcedure:              {ScopeJobsRead, ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceGetJobPlanProcedure:                       {ScopeJobsRead, ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceCreateJobProcedure:                        {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceDeleteJobProcedure:                        {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceUpdateJobScheduleProcedure:                {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure:        {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceSetJobSourceSqlConnectionSubsetsProcedure: {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceUpdateJobDestinationConnectionProcedure:   {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceDeleteJobDestinationConnectionProcedure:   {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceCreateJobDestinationConnectionsProcedure:  {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServicePauseJobProcedure:                         {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceDeleteJobRunProcedure:                     {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceSetJobWorkflowOptionsProcedure:            {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceSetJobSyncOptionsProcedure:                {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceValidateJobMappingsProcedure:              {ScopeJobsWrite},
+	mgmtv1alpha1connect.JobServiceCreateJobRunProcedure:                     {ScopeJobsTrigger},
+	mgmtv1alpha1connect.JobServiceCancelJobRunProcedure:                     {ScopeJobsTrigger},
+	mgmtv1alpha1connect.JobServiceTerminateJobRunProcedure:                  {ScopeJobsTrigger},
+
+	mgmtv1alpha1connect.NotificationServiceGetNotificationRulesProcedure:      {ScopeJobsRead, ScopeJobsWrite},
+	mgmtv1alpha1connect.NotificationServiceGetNotificationDeliveriesProcedure: {ScopeJobsRead, ScopeJobsWrite},
+	mgmtv1alpha1connect.NotificationServiceCreateNotificationRuleProcedure:    {ScopeJobsWrite},
+	mgmtv1alpha1connect.NotificationServiceDeleteNotificationRuleProcedure:    {ScopeJobsWrite},
+
+	mgmtv1alpha1connect.PipelineServiceGetPipelinesProcedure:      {ScopeJobsRead, ScopeJobsWrite},
+	mgmtv1alpha1connect.PipelineServiceGetPipelineProcedure:       {ScopeJobsRead, ScopeJobsWrite, ScopeJobsTrigger},
+	mgmtv1alpha1connect.PipelineServiceGetPipelineRunsProcedure:   {ScopeJobsRead, ScopeJobsWrite, ScopeJobsTrigger},
+	mgmtv1alpha1connect.PipelineServiceCreatePipelineProcedure:    {ScopeJobsWrite},
+	mgmtv1alpha1connect.PipelineServiceUpdatePipelineProcedure:    {ScopeJobsWrite},
+	mgmtv1alpha1connect.PipelineServiceDeletePipelineProcedure:    {ScopeJobsWrite},
+	mgmtv1alpha1connect.PipelineServiceCreatePipelineRunProcedure: {ScopeJobsTrigger},
+	mgmtv1alpha1connect.PipelineServiceCancelPipelineRunProcedure: {ScopeJobsTrigger},
+
+	mgmtv1alpha1connect.ConnectionServiceGetConnectionsProcedure:                      {ScopeConnectionsRead, ScopeConnectionsWrite, ScopeConnectionsData},
+	mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure:                       {ScopeConnectionsRead, ScopeConnectionsWrite, ScopeConnectionsData},
+	mgmtv1alpha1connect.ConnectionServiceIsConnectionNameAvailableProcedure:           {ScopeConnectionsRead, ScopeConnectionsWrite},
+	mgmtv1alpha1connect.ConnectionServiceCheckConnectionConfigByIdProcedure:           {ScopeConnectionsRead, ScopeConnectionsWrite},
+	mgmtv1alpha1connect.ConnectionServiceCreateConnectionProcedure:                    {ScopeConnectionsWrite},
+	mgmtv1alpha1connect.ConnectionServiceUpdateConnectionProcedure:                    {ScopeConnectionsWrite},
+	mgmtv1alpha1connect.ConnectionServiceDeleteConnectionProcedure:                    {ScopeConnectionsWrite},
+	mgmtv1alpha1connect.ConnectionServiceCheckConnectionConfigProcedure:               {ScopeConnectionsWrite},
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaProcedure:             {ScopeConnectionsRead, ScopeConnectionsWrite, ScopeConnectionsData},
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaMapProcedure:          {ScopeConnectionsRead, ScopeConnectionsWrite, ScopeConnectionsData},
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaMapsProcedure:         {ScopeConnectionsRead, ScopeConnectionsWrite, ScopeConnectionsData},
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionTableConstraintsProcedure:   {ScopeConnectionsRead, ScopeConnectionsWrite, ScopeConnectionsData},
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionForeignConstraintsProcedure: {ScopeConnectionsRead, ScopeConnectionsWrite, ScopeConnectionsData},
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionPrimaryConstraintsProcedure: {ScopeConnectionsRead, ScopeConnectionsWrite, ScopeConnectionsData},
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionUniqueConstraintsProcedure:  {ScopeConnectionsRead, ScopeConnectionsWrite, ScopeConnectionsData},
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionInitStatementsProcedure:     {ScopeConnectionsRead, ScopeConnectionsWrite, ScopeConnectionsData},
+	mgmtv1alpha1connect.ConnectionDataServiceGetTableRowCountProcedure:                {ScopeConnectionsRead, ScopeConnectionsWrite},
+	mgmtv1alpha1connect.ConnectionDataServiceScanConnectionForPiiProcedure:            {ScopeConnectionsData},
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionDataStreamProcedure:         {ScopeConnectionsData},
+
+	mgmtv1alpha1connect.TransformersServiceGetSystemTransformersProcedure:         {ScopeTransformersRead, ScopeTransformersWrite},
+	mgmtv1alpha1connect.TransformersServiceGetSystemTransformerBySourceProcedure:  {ScopeTransformersRead, ScopeTransformersWrite},
+	mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformersProcedure:    {ScopeTransformersRead, ScopeTransformersWrite},
+	mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformerByIdProcedure: {ScopeTransformersRead, ScopeTransformersWrite},
+	mgmtv1alpha1connect.TransformersServiceIsTransformerNameAvailableProcedure:    {ScopeTransformersRead, ScopeTransformersWrite},
+	mgmtv1alpha1connect.TransformersServiceCreateUserDefinedTransformerProcedure:  {ScopeTransformersWrite},
+	mgmtv1alpha1connect.TransformersServiceUpdateUserDefinedTransformerProcedure:  {ScopeTransformersWrite},
+	mgmtv1alpha1connect.TransformersServiceDeleteUserDefinedTransformerProcedure:  {ScopeTransformersWrite},
+	mgmtv1alpha1connect.TransformersServiceValidateUserJavascriptCodeProcedure:    {ScopeTransformersWrite},
+	mgmtv1alpha1connect.TransformersServiceValidateUserRegexCodeProcedure:         {ScopeTransformersWrite},
+
+	mgmtv1alpha1connect.AnonymizationServiceAnonymizeManyProcedure:   {ScopeAnonymize},
+	mgmtv1alpha1connect.AnonymizationServiceAnonymizeSingleProcedure: {ScopeAnonymize},
+
+	mgmtv1alpha1connect.MetricsServiceGetDailyMetricCountProcedure: {ScopeMetricsRead},
+	mgmtv1alpha1connect.MetricsServiceGetMetricCountProcedure:      {ScopeMetricsRead},
+}
+
+// Returns an error if any of the scopes are not known
+func ValidateScopes(scopes []string) error {
+	for _, scope := range scopes {
+		if !slices.Contains(allScopes, Scope(scope)) {
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("unknown api key scope %q. valid scopes are: %s", scope, strings.Join(GetScopes(), ", ")))
+		}
+	}
+	return nil
+}
+
+// Returns all of the scopes that may be assigned to an API key
+func GetScopes() []string {
+	scopes := make([]string, 0, len(allScopes))
+	for _, scope := range allScopes {
+		scopes = append(scopes, string(scope))
+	}
+	return scopes
+}
+
+// Returns true if the API key has full access to its account
+func IsUnscoped(apiKeyScopes []string) bool {
+	return len(apiKeyScopes) == 0
+}
+
+// Returns true if the API key has been granted the scope, either explicitly or by having full access
+func HasScope(apiKeyScopes []string, scope Scope) bool {
+	return IsUnscoped(apiKeyScopes) || slices.Contains(apiKeyScopes, string(scope))
+}
+
+func isProcedureAllowedForScopes(apiKeyScopes []string, procedure string) bool {
+	if IsUnscoped(apiKeyScopes) {
+		return true
+	}
+	requiredScopes, ok := procedureScopes[procedure]
+	if !ok {
+		return false
+	}
+	if len(requiredScopes) == 0 {
+		return true
+	}
+	for _, scope := range requiredScopes {
+		if slices.Contains(apiKeyScopes, string(scope)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the job id that the request message is operating on, if it can be determined from the request.
+var jobIdExtractors = map[string]func(msg any) string{
+	mgmtv1alpha1connect.JobServiceGetJobProcedure:                           getJobIdFromId,
+	mgmtv1alpha1connect.JobServiceDeleteJobProcedure:                        getJobIdFromId,
+	mgmtv1alpha1connect.JobServiceUpdateJobScheduleProcedure:                getJobIdFromId,
+	mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure:        getJobIdFromId,
+	mgmtv1alpha1connect.JobServiceSetJobSourceSqlConnectionSubsetsProcedure: getJobIdFromId,
+	mgmtv1alpha1connect.JobServicePauseJobProcedure:                         getJobIdFromId,
+	mgmtv1alpha1connect.JobServiceSetJobWorkflowOptionsProcedure:            getJobIdFromId,
+	mgmtv1alpha1connect.JobServiceSetJobSyncOptionsProcedure:                getJobIdFromId,
+	mgmtv1alpha1connect.JobServiceUpdateJobDestinationConnectionProcedure:   getJobIdFromJobId,
+	mgmtv1alpha1connect.JobServiceCreateJobDestinationConnectionsProcedure:  getJobIdFromJobId,
+	mgmtv1alpha1connect.JobServiceGetJobRecentRunsProcedure:                 getJobIdFromJobId,
+	mgmtv1alpha1connect.JobServiceGetJobNextRunsProcedure:                   getJobIdFromJobId,
+	mgmtv1alpha1connect.JobServiceGetJobStatusProcedure:                     getJobIdFromJobId,
+	mgmtv1alpha1connect.JobServiceGetJobRunsProcedure:                       getJobIdFromJobId,
+	mgmtv1alpha1connect.JobServiceCreateJobRunProcedure:                     getJobIdFromJobId,
+	mgmtv1alpha1connect.JobServiceGetJobPlanProcedure:                       getJobIdFromJobId,
+
+	mgmtv1alpha1connect.NotificationServiceCreateNotificationRuleProcedure: getJobIdFromJobId,
+	mgmtv1alpha1connect.NotificationServiceGetNotificationRulesProcedure:   getJobIdFromJobId,
+	mgmtv1alpha1connect.NotificationServicePublishJobRunEventProcedure:     getJobIdFromJobId,
+}
+
+// Procedures that identify a job run rather than a job. The job is looked up from the run before the key's restriction is checked.
+var jobRunIdExtractors = map[string]func(msg any) string{
+	mgmtv1alpha1connect.JobServiceGetJobRunProcedure:       getJobRunId,
+	mgmtv1alpha1connect.JobServiceGetJobRunEventsProcedure: getJobRunId,
+	mgmtv1alpha1connect.JobServiceCancelJobRunProcedure:    getJobRunId,
+	mgmtv1alpha1connect.JobServiceTerminateJobRunProcedure: getJobRunId,
+	mgmtv1alpha1connect.JobServiceDeleteJobRunProcedure:    getJobRunId,
+}
+
+// Looks up the job that a job run belongs to
+type JobRunResolver interface {
+	GetJobIdByRunId(ctx context.Context, accountId, jobRunId string) (string, error)
+}
+
+func getJobIdFromId(msg any) string {
+	if m, ok := msg.(interface{ GetId() string }); ok {
+		return m.GetId()
+	}
+	return ""
+}
+
+func getJobIdFromJobId(msg any) string {
+	if m, ok := msg.(interface{ GetJobId() string }); ok {
+		return m.GetJobId()
+	}
+	return ""
+}
+
+func getJobRunId(msg any) string {
+	if m, ok := msg.(interface{ GetJobRunId() string }); ok {
+		return m.GetJobRunId()
+	}
+	return ""
+}
+
+// Verifies that an API key that has been restricted to specific jobs is only used with those jobs.
+// Job and notification service procedures that do not identify a single job from their request are denied for restricted API keys.
+// Job run procedures are checked against the job of the run, which is looked up in the API key's account with the resolver.
+// Pipelines run and reference any job in the account, so the pipeline service is denied for restricted API keys.
+// Requests that were not authenticated with an account API key are always allowed.
+func VerifyJobAccess(ctx context.Context, procedure string, msg any, jobRuns JobRunResolver) error {
+	data, err := GetTokenDataFromCtx(ctx)
+	if err != nil || data.ApiKeyType != apikey.AccountApiKey || data.ApiKey == nil || len(data.ApiKey.JobIds) == 0 {
+		return nil
+	}
+	if strings.HasPrefix(procedure, fmt.Sprintf("/%s/", mgmtv1alpha1connect.PipelineServiceName)) {
+		return nucleuserrors.NewForbidden("api key is restricted to specific jobs and can not be used with pipelines")
+	}
+	if !strings.HasPrefix(procedure, fmt.Sprintf("/%s/", mgmtv1alpha1connect.JobServiceName)) &&
+		!strings.HasPrefix(procedure, fmt.Sprintf("/%s/", mgmtv1alpha1connect.NotificationServiceName)) {
+		return nil
+	}
+	jobId, err := getRequestJobId(ctx, procedure, msg, data.ApiKey, jobRuns)
+	if err != nil {
+		return err
+	}
+	for _, allowedJobId := range data.ApiKey.JobIds {
+		if jobId != "" && neosyncdb.UUIDString(allowedJobId) == jobId {
+			return nil
+		}
+	}
+	return nucleuserrors.NewForbidden("api key is not allowed to access the requested job")
+}
+
+func getRequestJobId(
+	ctx context.Context,
+	procedure string,
+	msg any,
+	apiKey *db_queries.NeosyncApiAccountApiKey,
+	jobRuns JobRunResolver,
+) (string, error) {
+	if extractor, ok := jobIdExtractors[procedure]; ok {
+		return extractor(msg), nil
+	}
+	extractor, ok := jobRunIdExtractors[procedure]
+	if !ok || jobRuns == nil {
+		return "", nucleuserrors.NewForbidden("api key is restricted to specific jobs and can not be used with this procedure")
+	}
+	jobRunId := extractor(msg)
+	if jobRunId == "" {
+		return "", nil
+	}
+	// the run is looked up in the key's own account so that runs of other accounts are never resolved
+	jobId, err := jobRuns.GetJobIdByRunId(ctx, neosyncdb.UUIDString(apiKey.AccountID), jobRunId)
+	if err != nil {
+		if nucleuserrors.IsNotFound(err) {
+			return "", nucleuserrors.NewForbidden("api key is not allowed to access the requested job run")
+		}
+		return "", fmt.Errorf("unable to retrieve the job of the job run: %w", err)
+	}
+	return jobId, nil
+}
@@ -0,0 +1,192 @@
+package auth_apikey
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func Test_ProcedureScopes_ValidProcedures(t *testing.T) {
+	// ensures the mgmt protos are registered
+	_ = mgmtv1alpha1.File_mgmt_v1alpha1_user_account_proto
+
+	procedures := map[string]struct{}{}
+	protoregistry.GlobalFiles.RangeFilesByPackage("mgmt.v1alpha1", func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				procedures[fmt.Sprintf("/%s/%s", services.Get(i).FullName(), methods.Get(j).Name())] = struct{}{}
+			}
+		}
+		return true
+	})
+
+	for procedure, scopes := range procedureScopes {
+		_, ok := procedures[procedure]
+		require.True(t, ok, "procedure %s does not exist", procedure)
+		for _, scope := range scopes {
+			require.NoError(t, ValidateScopes([]string{string(scope)}))
+		}
+	}
+	for procedure := range jobIdExtractors {
+		_, ok := procedures[procedure]
+		require.True(t, ok, "procedure %s does not exist", procedure)
+	}
+}
+
+func Test_ValidateScopes(t *testing.T) {
+	require.NoError(t, ValidateScopes(nil))
+	require.NoError(t, ValidateScopes(GetScopes()))
+
+	err := ValidateScopes([]string{"jobs:read", "jobs:all"})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_isProcedureAllowedForScopes(t *testing.T) {
+	require.True(t, isProcedureAllowedForScopes(nil, mgmtv1alpha1connect.ApiKeyServiceCreateAccountApiKeyProcedure))
+
+	scopes := []string{string(ScopeJobsTrigger)}
+	require.True(t, isProcedureAllowedForScopes(scopes, mgmtv1alpha1connect.JobServiceCreateJobRunProcedure))
+	require.True(t, isProcedureAllowedForScopes(scopes, mgmtv1alpha1connect.JobServiceGetJobProcedure))
+	require.True(t, isProcedureAllowedForScopes(scopes, mgmtv1alpha1connect.UserAccountServiceGetUserProcedure))
+	require.False(t, isProcedureAllowedForScopes(scopes, mgmtv1alpha1connect.JobServiceDeleteJobProcedure))
+	require.False(t, isProcedureAllowedForScopes(scopes, mgmtv1alpha1connect.AnonymizationServiceAnonymizeManyProcedure))
+	require.False(t, isProcedureAllowedForScopes(scopes, mgmtv1alpha1connect.ApiKeyServiceCreateAccountApiKeyProcedure))
+
+	require.False(t, isProcedureAllowedForScopes([]string{string(ScopeConnectionsRead)}, mgmtv1alpha1connect.ConnectionDataServiceScanConnectionForPiiProcedure))
+	require.True(t, isProcedureAllowedForScopes([]string{string(ScopeConnectionsData)}, mgmtv1alpha1connect.ConnectionDataServiceScanConnectionForPiiProcedure))
+}
+
+func Test_HasScope(t *testing.T) {
+	require.True(t, HasScope(nil, ScopeConnectionsWrite))
+	require.True(t, HasScope([]string{"connections:write"}, ScopeConnectionsWrite))
+	require.False(t, HasScope([]string{"connections:read"}, ScopeConnectionsWrite))
+}
+
+func Test_VerifyJobAccess(t *testing.T) {
+	jobId := uuid.NewString()
+	jobUuid, err := neosyncdb.ToUuid(jobId)
+	require.NoError(t, err)
+
+	ctx := SetTokenData(context.Background(), &TokenContextData{
+		ApiKeyType: apikey.AccountApiKey,
+		ApiKey:     &db_queries.NeosyncApiAccountApiKey{JobIds: []pgtype.UUID{jobUuid}},
+	})
+
+	require.NoError(t, VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceCreateJobRunProcedure, &mgmtv1alpha1.CreateJobRunRequest{JobId: jobId}, nil))
+	require.NoError(t, VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceGetJobProcedure, &mgmtv1alpha1.GetJobRequest{Id: jobId}, nil))
+	require.NoError(t, VerifyJobAccess(ctx, mgmtv1alpha1connect.AnonymizationServiceAnonymizeManyProcedure, &mgmtv1alpha1.AnonymizeManyRequest{}, nil))
+
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceCreateJobRunProcedure, &mgmtv1alpha1.CreateJobRunRequest{JobId: uuid.NewString()}, nil)
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceGetJobRunsProcedure, &mgmtv1alpha1.GetJobRunsRequest{Id: &mgmtv1alpha1.GetJobRunsRequest_AccountId{AccountId: uuid.NewString()}}, nil)
+	require.Error(t, err)
+
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceGetJobsProcedure, &mgmtv1alpha1.GetJobsRequest{}, nil)
+	require.Error(t, err)
+
+	// pipelines can run jobs that the key is not restricted to
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.PipelineServiceCreatePipelineProcedure, &mgmtv1alpha1.CreatePipelineRequest{
+		Steps: []*mgmtv1alpha1.PipelineStep{{JobId: jobId}, {JobId: uuid.NewString()}},
+	}, nil)
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.PipelineServiceCreatePipelineRunProcedure, &mgmtv1alpha1.CreatePipelineRunRequest{}, nil)
+	require.Error(t, err)
+
+	require.NoError(t, VerifyJobAccess(ctx, mgmtv1alpha1connect.NotificationServiceCreateNotificationRuleProcedure, &mgmtv1alpha1.CreateNotificationRuleRequest{JobId: &jobId}, nil))
+	require.NoError(t, VerifyJobAccess(ctx, mgmtv1alpha1connect.NotificationServiceGetNotificationRulesProcedure, &mgmtv1alpha1.GetNotificationRulesRequest{JobId: &jobId}, nil))
+	require.NoError(t, VerifyJobAccess(ctx, mgmtv1alpha1connect.NotificationServicePublishJobRunEventProcedure, &mgmtv1alpha1.PublishJobRunEventRequest{JobId: jobId}, nil))
+
+	// account wide rules notify about every job in the account
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.NotificationServiceCreateNotificationRuleProcedure, &mgmtv1alpha1.CreateNotificationRuleRequest{}, nil)
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.NotificationServiceGetNotificationRulesProcedure, &mgmtv1alpha1.GetNotificationRulesRequest{}, nil)
+	require.Error(t, err)
+	otherJobId := uuid.NewString()
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.NotificationServiceGetNotificationRulesProcedure, &mgmtv1alpha1.GetNotificationRulesRequest{JobId: &otherJobId}, nil)
+	require.Error(t, err)
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.NotificationServiceDeleteNotificationRuleProcedure, &mgmtv1alpha1.DeleteNotificationRuleRequest{Id: uuid.NewString()}, nil)
+	require.Error(t, err)
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.NotificationServiceGetNotificationDeliveriesProcedure, &mgmtv1alpha1.GetNotificationDeliveriesRequest{}, nil)
+	require.Error(t, err)
+
+	require.NoError(t, VerifyJobAccess(context.Background(), mgmtv1alpha1connect.JobServiceGetJobsProcedure, &mgmtv1alpha1.GetJobsRequest{}, nil))
+	require.NoError(t, VerifyJobAccess(context.Background(), mgmtv1alpha1connect.PipelineServiceCreatePipelineRunProcedure, &mgmtv1alpha1.CreatePipelineRunRequest{}, nil))
+}
+
+type testJobRunResolver map[string]string
+
+func (r testJobRunResolver) GetJobIdByRunId(ctx context.Context, accountId, jobRunId string) (string, error) {
+	jobId, ok := r[accountId+"/"+jobRunId]
+	if !ok {
+		return "", nucleuserrors.NewNotFound("job run not found")
+	}
+	return jobId, nil
+}
+
+func Test_VerifyJobAccess_JobRuns(t *testing.T) {
+	accountId := uuid.NewString()
+	accountUuid, err := neosyncdb.ToUuid(accountId)
+	require.NoError(t, err)
+	jobId := uuid.NewString()
+	jobUuid, err := neosyncdb.ToUuid(jobId)
+	require.NoError(t, err)
+
+	ctx := SetTokenData(context.Background(), &TokenContextData{
+		ApiKeyType: apikey.AccountApiKey,
+		ApiKey:     &db_queries.NeosyncApiAccountApiKey{AccountID: accountUuid, JobIds: []pgtype.UUID{jobUuid}},
+	})
+	jobRuns := testJobRunResolver{
+		accountId + "/own-run":   jobId,
+		accountId + "/other-run": uuid.NewString(),
+	}
+
+	require.NoError(t, VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceGetJobRunProcedure, &mgmtv1alpha1.GetJobRunRequest{JobRunId: "own-run", AccountId: accountId}, jobRuns))
+	require.NoError(t, VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceCancelJobRunProcedure, &mgmtv1alpha1.CancelJobRunRequest{JobRunId: "own-run", AccountId: accountId}, jobRuns))
+	require.NoError(t, VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceTerminateJobRunProcedure, &mgmtv1alpha1.TerminateJobRunRequest{JobRunId: "own-run", AccountId: accountId}, jobRuns))
+
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceGetJobRunProcedure, &mgmtv1alpha1.GetJobRunRequest{JobRunId: "other-run", AccountId: accountId}, jobRuns)
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceCancelJobRunProcedure, &mgmtv1alpha1.CancelJobRunRequest{JobRunId: "other-run", AccountId: accountId}, jobRuns)
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceTerminateJobRunProcedure, &mgmtv1alpha1.TerminateJobRunRequest{JobRunId: "other-run", AccountId: accountId}, jobRuns)
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// runs are only looked up in the api key's own account
+	otherAccountId := uuid.NewString()
+	jobRuns[otherAccountId+"/foreign-run"] = jobId
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceGetJobRunProcedure, &mgmtv1alpha1.GetJobRunRequest{JobRunId: "foreign-run", AccountId: otherAccountId}, jobRuns)
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceCancelJobRunProcedure, &mgmtv1alpha1.CancelJobRunRequest{AccountId: accountId}, jobRuns)
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	// job runs can not be checked without a resolver
+	err = VerifyJobAccess(ctx, mgmtv1alpha1connect.JobServiceGetJobRunProcedure, &mgmtv1alpha1.GetJobRunRequest{JobRunId: "own-run", AccountId: accountId}, nil)
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
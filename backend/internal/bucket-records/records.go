@@ -0,0 +1,198 @@
+package bucketrecords
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+type Format int
+
+const (
+	// Gzipped JSON lines, the format that bucket destinations write by default
+	FormatJsonLines Format = iota
+	// Gzipped CSV with a header row
+	FormatCsv
+	// Parquet
+	FormatParquet
+)
+
+// Returns the format of a bucket object based on its file extension.
+// Objects without a known extension are assumed to be gzipped JSON lines
+func GetFormatFromKey(key string) Format {
+	switch {
+	case strings.HasSuffix(key, ".parquet"):
+		return FormatParquet
+	case strings.HasSuffix(key, ".csv.gz"):
+		return FormatCsv
+	default:
+		return FormatJsonLines
+	}
+}
+
+// Reads each record of a bucket object. The format is detected from the key of the object
+func StreamRecords(key string, reader io.Reader, onRecord func(record map[string]any) error) error {
+	switch GetFormatFromKey(key) {
+	case FormatParquet:
+		return streamParquetRecords(reader, onRecord)
+	case FormatCsv:
+		return streamCsvRecords(reader, onRecord)
+	default:
+		return streamJsonLinesRecords(reader, onRecord)
+	}
+}
+
+var errStopStream = errors.New("stop stream")
+
+// Returns the first record of a bucket object, nil if the object contains no records
+func GetFirstRecord(key string, reader io.Reader) (map[string]any, error) {
+	var first map[string]any
+	err := StreamRecords(key, reader, func(record map[string]any) error {
+		first = record
+		return errStopStream
+	})
+	if err != nil && !errors.Is(err, errStopStream) {
+		return nil, err
+	}
+	return first, nil
+}
+
+// Converts a record into the map[string][]byte format used by the connection data stream.
+// Strings are converted directly to bytes, all other values are JSON encoded
+func ToByteRecord(input map[string]any) (map[string][]byte, error) {
+	output := make(map[string][]byte)
+	for key, value := range input {
+		var byteValue []byte
+		if str, ok := value.(string); ok {
+			// try converting string directly to []byte
+			// prevents quoted strings
+			byteValue = []byte(str)
+		} else {
+			// if not a string use JSON encoding
+			bits, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			if string(bits) == "null" {
+				byteValue = nil
+			} else {
+				byteValue = bits
+			}
+		}
+		output[key] = byteValue
+	}
+	return output, nil
+}
+
+func streamJsonLinesRecords(reader io.Reader, onRecord func(record map[string]any) error) error {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+	defer gzipReader.Close()
+
+	scanner := bufio.NewScanner(gzipReader)
+	for scanner.Scan() {
+		var result map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &result); err != nil {
+			return fmt.Errorf("failed to unmarshal JSON: %w", err)
+		}
+		if err := onRecord(result); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading from gzip reader: %w", err)
+	}
+	return nil
+}
+
+// Empty fields are read as null values
+func streamCsvRecords(reader io.Reader, onRecord func(record map[string]any) error) error {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+	defer gzipReader.Close()
+
+	csvReader := csv.NewReader(gzipReader)
+	header, err := csvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return fmt.Errorf("unable to read csv header: %w", err)
+	}
+	for {
+		row, err := csvReader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("unable to read csv row: %w", err)
+		}
+		result := make(map[string]any, len(header))
+		for idx, col := range header {
+			if idx >= len(row) || row[idx] == "" {
+				result[col] = nil
+				continue
+			}
+			result[col] = row[idx]
+		}
+		if err := onRecord(result); err != nil {
+			return err
+		}
+	}
+}
+
+func streamParquetRecords(reader io.Reader, onRecord func(record map[string]any) error) error {
+	// parquet metadata lives at the end of the file so the whole object must be read first
+	bits, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("unable to read parquet file: %w", err)
+	}
+	file, err := parquet.OpenFile(bytes.NewReader(bits), int64(len(bits)))
+	if err != nil {
+		return fmt.Errorf("unable to open parquet file: %w", err)
+	}
+	parquetReader := parquet.NewGenericReader[any](file)
+	defer parquetReader.Close()
+
+	rows := make([]any, 100)
+	for {
+		n, err := readParquetRows(parquetReader, rows)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("unable to read parquet rows: %w", err)
+		}
+		for _, row := range rows[:n] {
+			record, ok := row.(map[string]any)
+			if !ok {
+				return fmt.Errorf("unexpected parquet row type: %T", row)
+			}
+			if err := onRecord(record); err != nil {
+				return err
+			}
+		}
+		if n == 0 || errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+}
+
+// the parquet reader panics on malformed files
+func readParquetRows(reader *parquet.GenericReader[any], rows []any) (n int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("parquet read panic: %v", r)
+		}
+	}()
+	return reader.Read(rows)
+}
@@ -0,0 +1,96 @@
+package bucketrecords
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetFormatFromKey(t *testing.T) {
+	require.Equal(t, FormatJsonLines, GetFormatFromKey("workflows/1/activities/public.users/data/records-1-2.jsonl.gz"))
+	require.Equal(t, FormatJsonLines, GetFormatFromKey("workflows/1/activities/public.users/data/1.txt.gz"))
+	require.Equal(t, FormatCsv, GetFormatFromKey("workflows/1/activities/public.users/data/1.csv.gz"))
+	require.Equal(t, FormatParquet, GetFormatFromKey("workflows/1/activities/public.users/data/1.parquet"))
+}
+
+func Test_StreamRecords_JsonLines(t *testing.T) {
+	data := gzipBytes(t, "{\"id\":1,\"name\":\"nick\"}\n{\"id\":2,\"name\":null}\n")
+
+	records := streamAll(t, "1.jsonl.gz", data)
+	require.Equal(t, []map[string]any{
+		{"id": float64(1), "name": "nick"},
+		{"id": float64(2), "name": nil},
+	}, records)
+}
+
+func Test_StreamRecords_Csv(t *testing.T) {
+	data := gzipBytes(t, "id,name\n1,\"Smith, John\"\n2,\n")
+
+	records := streamAll(t, "1.csv.gz", data)
+	require.Equal(t, []map[string]any{
+		{"id": "1", "name": "Smith, John"},
+		{"id": "2", "name": nil},
+	}, records)
+}
+
+func Test_StreamRecords_Parquet(t *testing.T) {
+	schema := parquet.NewSchema("", parquet.Group{
+		"id":   parquet.Leaf(parquet.Int64Type),
+		"name": parquet.Optional(parquet.String()),
+	})
+	buf := &bytes.Buffer{}
+	writer := parquet.NewGenericWriter[any](buf, schema)
+	_, err := writer.Write([]any{
+		map[string]any{"id": int64(1), "name": "nick"},
+		map[string]any{"id": int64(2), "name": nil},
+	})
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	records := streamAll(t, "1.parquet", buf.Bytes())
+	require.Equal(t, []map[string]any{
+		{"id": int64(1), "name": "nick"},
+		{"id": int64(2), "name": nil},
+	}, records)
+}
+
+func Test_GetFirstRecord(t *testing.T) {
+	data := gzipBytes(t, "id,name\n1,nick\n2,alisha\n")
+	record, err := GetFirstRecord("1.csv.gz", bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"id": "1", "name": "nick"}, record)
+
+	record, err = GetFirstRecord("1.jsonl.gz", bytes.NewReader(gzipBytes(t, "")))
+	require.NoError(t, err)
+	require.Nil(t, record)
+}
+
+func Test_ToByteRecord(t *testing.T) {
+	record, err := ToByteRecord(map[string]any{"id": int64(1), "name": "nick", "email": nil})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"id": []byte("1"), "name": []byte("nick"), "email": nil}, record)
+}
+
+func streamAll(t *testing.T, key string, data []byte) []map[string]any {
+	t.Helper()
+	records := []map[string]any{}
+	err := StreamRecords(key, bytes.NewReader(data), func(record map[string]any) error {
+		records = append(records, record)
+		return nil
+	})
+	require.NoError(t, err)
+	return records
+}
+
+func gzipBytes(t *testing.T, input string) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	_, err := gzw.Write([]byte(input))
+	require.NoError(t, err)
+	require.NoError(t, gzw.Close())
+	return buf.Bytes()
+}
@@ -0,0 +1,176 @@
+package run_rotate_connection_keys_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	"github.com/nucleuscloud/neosync/backend/internal/envelope"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	neosynclogger "github.com/nucleuscloud/neosync/backend/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-connection-keys",
+		Short: "Encrypts all stored connection configs and notification signing secrets with the active connection encryption key",
+		Long: "Re-encrypts connection configs and notification signing secrets whose data keys were wrapped with a retired master key, and encrypts those that were stored before encryption was enabled.\n" +
+			"Retired keys must remain in CONNECTION_ENCRYPTION_KEYS until this command has completed.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+			return run(cmd.Context(), dryRun)
+		},
+	}
+	cmd.Flags().Bool("dry-run", false, "report the connections and notification rules that would be rotated without updating them")
+	return cmd
+}
+
+func run(ctx context.Context, dryRun bool) error {
+	slogger, _ := neosynclogger.NewLoggers()
+	slog.SetDefault(slogger)
+
+	encryptor, err := envelope.NewFromEnvironment()
+	if err != nil {
+		return fmt.Errorf("unable to configure connection encryption: %w", err)
+	}
+	if encryptor == nil {
+		return errors.New("must provide CONNECTION_ENCRYPTION_KEYS in environment")
+	}
+
+	dbconfig, err := neosyncdb.NewConnectConfigFromEnvironment()
+	if err != nil {
+		return err
+	}
+	db, err := neosyncdb.NewFromConfig(dbconfig)
+	if err != nil {
+		return err
+	}
+
+	rotated, err := rotateConnections(ctx, db, encryptor, dryRun, slogger)
+	if err != nil {
+		return err
+	}
+	rotatedRules, err := rotateNotificationRules(ctx, db, encryptor, dryRun, slogger)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		slogger.InfoContext(ctx, fmt.Sprintf("%d connections and %d notification rules would be rotated", rotated, rotatedRules))
+		return nil
+	}
+	slogger.InfoContext(ctx, fmt.Sprintf("rotated %d connections and %d notification rules", rotated, rotatedRules))
+	return nil
+}
+
+// Returns the number of connections that were rotated
+func rotateConnections(
+	ctx context.Context,
+	db *neosyncdb.NeosyncDb,
+	encryptor *envelope.Encryptor,
+	dryRun bool,
+	logger *slog.Logger,
+) (int, error) {
+	connections, err := db.Q.GetAllConnections(ctx, db.Db)
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve connections: %w", err)
+	}
+
+	rotated := 0
+	for idx := range connections {
+		connection := connections[idx]
+		if !neosyncdb.ConnectionConfigNeedsRotation(encryptor, connection.ConnectionConfig) {
+			continue
+		}
+		connectionId := neosyncdb.UUIDString(connection.ID)
+		if dryRun {
+			logger.InfoContext(ctx, "connection would be rotated", "connectionId", connectionId)
+			rotated++
+			continue
+		}
+
+		plaintext, err := neosyncdb.DecryptConnectionConfig(ctx, encryptor, connection.AccountID, connection.ConnectionConfig)
+		if err != nil {
+			return rotated, fmt.Errorf("unable to decrypt connection %s: %w", connectionId, err)
+		}
+		encrypted, err := neosyncdb.EncryptConnectionConfig(ctx, encryptor, connection.AccountID, plaintext)
+		if err != nil {
+			return rotated, fmt.Errorf("unable to encrypt connection %s: %w", connectionId, err)
+		}
+		updated, err := db.Q.UpdateConnectionConfig(ctx, db.Db, db_queries.UpdateConnectionConfigParams{
+			ConnectionConfig:         encrypted,
+			ID:                       connection.ID,
+			PreviousConnectionConfig: connection.ConnectionConfig,
+		})
+		if err != nil {
+			return rotated, fmt.Errorf("unable to update connection %s: %w", connectionId, err)
+		}
+		if updated == 0 {
+			// the connection was updated or removed since it was read, which means it has already been stored with the active key
+			logger.WarnContext(ctx, "connection was modified during rotation, skipping", "connectionId", connectionId)
+			continue
+		}
+		logger.DebugContext(ctx, "rotated connection", "connectionId", connectionId)
+		rotated++
+	}
+	return rotated, nil
+}
+
+// Returns the number of notification rules whose signing secret was rotated
+func rotateNotificationRules(
+	ctx context.Context,
+	db *neosyncdb.NeosyncDb,
+	encryptor *envelope.Encryptor,
+	dryRun bool,
+	logger *slog.Logger,
+) (int, error) {
+	rules, err := db.Q.GetAllNotificationRules(ctx, db.Db)
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve notification rules: %w", err)
+	}
+
+	rotated := 0
+	for idx := range rules {
+		rule := rules[idx]
+		if !neosyncdb.SigningSecretNeedsRotation(encryptor, rule.SigningSecret) {
+			continue
+		}
+		ruleId := neosyncdb.UUIDString(rule.ID)
+		if dryRun {
+			logger.InfoContext(ctx, "notification rule would be rotated", "ruleId", ruleId)
+			rotated++
+			continue
+		}
+
+		plaintext, err := neosyncdb.DecryptSigningSecret(ctx, encryptor, rule.AccountID, rule.SigningSecret)
+		if err != nil {
+			return rotated, fmt.Errorf("unable to decrypt signing secret of notification rule %s: %w", ruleId, err)
+		}
+		encrypted, err := neosyncdb.EncryptSigningSecret(ctx, encryptor, rule.AccountID, plaintext)
+		if err != nil {
+			return rotated, fmt.Errorf("unable to encrypt signing secret of notification rule %s: %w", ruleId, err)
+		}
+		updated, err := db.Q.UpdateNotificationRuleSigningSecret(ctx, db.Db, db_queries.UpdateNotificationRuleSigningSecretParams{
+			SigningSecret:         encrypted,
+			ID:                    rule.ID,
+			PreviousSigningSecret: rule.SigningSecret,
+		})
+		if err != nil {
+			return rotated, fmt.Errorf("unable to update notification rule %s: %w", ruleId, err)
+		}
+		if updated == 0 {
+			// the rule was removed or rotated by another process since it was read
+			logger.WarnContext(ctx, "notification rule was modified during rotation, skipping", "ruleId", ruleId)
+			continue
+		}
+		logger.DebugContext(ctx, "rotated notification rule", "ruleId", ruleId)
+		rotated++
+	}
+	return rotated, nil
+}
@@ -1,6 +1,7 @@
 package run_cmd
 
 import (
+	run_rotate_connection_keys_cmd "github.com/nucleuscloud/neosync/backend/internal/cmds/mgmt/run/rotate-connection-keys"
 	run_stripe_usage_cmd "github.com/nucleuscloud/neosync/backend/internal/cmds/mgmt/run/stripe-usage"
 	"github.com/spf13/cobra"
 )
@@ -15,5 +16,6 @@ func NewCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(run_stripe_usage_cmd.NewCmd())
+	cmd.AddCommand(run_rotate_connection_keys_cmd.NewCmd())
 	return cmd
 }
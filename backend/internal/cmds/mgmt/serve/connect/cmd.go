@@ -37,13 +37,16 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/authmgmt"
 	"github.com/nucleuscloud/neosync/backend/internal/authmgmt/auth0"
 	"github.com/nucleuscloud/neosync/backend/internal/authmgmt/keycloak"
+	audit_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/audit"
 	auth_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/auth"
 	authlogging_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/auth_logging"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
 	logging_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logging"
+	"github.com/nucleuscloud/neosync/backend/internal/envelope"
 	neosync_gcp "github.com/nucleuscloud/neosync/backend/internal/gcp"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
+	"github.com/nucleuscloud/neosync/backend/pkg/kafkaconnect"
 	neosynclogger "github.com/nucleuscloud/neosync/backend/pkg/logger"
 	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
@@ -51,11 +54,14 @@ import (
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	v1alpha1_anonymizationservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/anonymization-service"
 	v1alpha1_apikeyservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/api-key-service"
+	v1alpha1_auditservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/audit-service"
 	v1alpha1_authservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/auth-service"
 	v1alpha1_connectiondataservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/connection-data-service"
 	v1alpha1_connectionservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/connection-service"
 	v1alpha1_jobservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/job-service"
 	v1alpha1_metricsservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/metrics-service"
+	v1alpha1_notificationservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/notification-service"
+	v1alpha1_pipelineservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/pipeline-service"
 	v1alpha1_transformerservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/transformers-service"
 	v1alpha1_useraccountservice "github.com/nucleuscloud/neosync/backend/services/mgmt/v1alpha1/user-account-service"
 	awsmanager "github.com/nucleuscloud/neosync/internal/aws"
@@ -63,6 +69,7 @@ import (
 	presidioapi "github.com/nucleuscloud/neosync/internal/ee/presidio"
 	neomigrate "github.com/nucleuscloud/neosync/internal/migrate"
 	neosyncotel "github.com/nucleuscloud/neosync/internal/otel"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -138,7 +145,7 @@ func serve(ctx context.Context) error {
 		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("panic: %v", r))
 	}
 
-	dbconfig, err := getDbConfig()
+	dbconfig, err := neosyncdb.NewConnectConfigFromEnvironment()
 	if err != nil {
 		return err
 	}
@@ -266,6 +273,19 @@ func serve(ctx context.Context) error {
 		loggingInterceptor,
 	)
 
+	authcerts, err := getTemporalAuthCertificate()
+	if err != nil {
+		return err
+	}
+	tfwfmgr := clientmanager.New(&clientmanager.Config{
+		AuthCertificates: authcerts,
+		DefaultTemporalConfig: &clientmanager.DefaultTemporalConfig{
+			Url:              getDefaultTemporalUrl(),
+			Namespace:        getDefaultTemporalNamespace(),
+			SyncJobQueueName: getDefaultTemporalSyncJobQueue(),
+		},
+	}, db.Q, db.Db)
+
 	// standard auth interceptors that should be applied to most services
 	stdAuthInterceptors := []connect.Interceptor{}
 	// this will only authenticate jwts, not api keys. Mostly used by just the api key service
@@ -300,6 +320,7 @@ func serve(ctx context.Context) error {
 			mgmtv1alpha1connect.UserAccountServiceSetBillingMeterEventProcedure,
 			mgmtv1alpha1connect.MetricsServiceGetDailyMetricCountProcedure,
 			mgmtv1alpha1connect.AnonymizationServiceAnonymizeManyProcedure,
+			mgmtv1alpha1connect.NotificationServicePublishJobRunEventProcedure,
 		})
 		stdAuthInterceptors = append(
 			stdAuthInterceptors,
@@ -310,6 +331,7 @@ func serve(ctx context.Context) error {
 				).InjectTokenCtx,
 			),
 			authlogging_interceptor.NewInterceptor(db),
+			auth_interceptor.NewRbacInterceptor(v1alpha1_jobservice.NewJobRunResolver(tfwfmgr)),
 		)
 		jwtOnlyAuthInterceptors = append(
 			jwtOnlyAuthInterceptors,
@@ -317,6 +339,7 @@ func serve(ctx context.Context) error {
 				jwtclient.InjectTokenCtx,
 			),
 			authlogging_interceptor.NewInterceptor(db),
+			auth_interceptor.NewRbacInterceptor(v1alpha1_jobservice.NewJobRunResolver(tfwfmgr)),
 		)
 		authSvcInterceptors = append(
 			authSvcInterceptors,
@@ -333,6 +356,14 @@ func serve(ctx context.Context) error {
 			authlogging_interceptor.NewInterceptor(db),
 		)
 	}
+	connectionEncryptor, err := envelope.NewFromEnvironment()
+	if err != nil {
+		return fmt.Errorf("unable to configure connection encryption: %w", err)
+	}
+
+	// records mutations to the audit log. Must come after the auth interceptors so the actor is known
+	stdAuthInterceptors = append(stdAuthInterceptors, audit_interceptor.NewInterceptor(db, connectionEncryptor))
+	jwtOnlyAuthInterceptors = append(jwtOnlyAuthInterceptors, audit_interceptor.NewInterceptor(db, connectionEncryptor))
 
 	api := http.NewServeMux()
 
@@ -364,19 +395,6 @@ func serve(ctx context.Context) error {
 		),
 	)
 
-	authcerts, err := getTemporalAuthCertificate()
-	if err != nil {
-		return err
-	}
-	tfwfmgr := clientmanager.New(&clientmanager.Config{
-		AuthCertificates: authcerts,
-		DefaultTemporalConfig: &clientmanager.DefaultTemporalConfig{
-			Url:              getDefaultTemporalUrl(),
-			Namespace:        getDefaultTemporalNamespace(),
-			SyncJobQueueName: getDefaultTemporalSyncJobQueue(),
-		},
-	}, db.Q, db.Db)
-
 	authadminclient, err := getAuthAdminClient(ctx, authclient, slogger)
 	if err != nil {
 		return err
@@ -426,18 +444,52 @@ func serve(ctx context.Context) error {
 		),
 	)
 
-	awsManager := awsmanager.New()
+	auditService := v1alpha1_auditservice.New(&v1alpha1_auditservice.Config{}, db, useraccountService)
+	api.Handle(
+		mgmtv1alpha1connect.NewAuditServiceHandler(
+			auditService,
+			connect.WithInterceptors(stdInterceptors...),
+			connect.WithInterceptors(stdAuthInterceptors...),
+			connect.WithRecover(recoverHandler),
+		),
+	)
+
+	notificationService := v1alpha1_notificationservice.New(
+		&v1alpha1_notificationservice.Config{
+			IsAuthEnabled:                isAuthEnabled,
+			IsNeosyncCloud:               getIsNeosyncCloud(),
+			AllowPrivateWebhookAddresses: viper.GetBool("NOTIFICATIONS_ALLOW_PRIVATE_WEBHOOK_ADDRESSES"),
+		},
+		db,
+		useraccountService,
+		connectionEncryptor,
+	)
+	api.Handle(
+		mgmtv1alpha1connect.NewNotificationServiceHandler(
+			notificationService,
+			connect.WithInterceptors(stdInterceptors...),
+			connect.WithInterceptors(stdAuthInterceptors...),
+			connect.WithRecover(recoverHandler),
+		),
+	)
+
+	secretResolver := secrets.NewFromEnvironment()
+	awsManager := awsmanager.New(secretResolver)
 	pgquerier := pg_queries.New()
 	mysqlquerier := mysql_queries.New()
-	sqlConnector := &sqlconnect.SqlOpenConnector{}
+	sqlConnector := &sqlconnect.SqlOpenConnector{SecretResolver: secretResolver, SqliteAllowedDir: viper.GetString("SQLITE_ALLOWED_DIR")}
 	pgpoolmap := &sync.Map{}
 	mysqlpoolmap := &sync.Map{}
 	mssqlpoolmap := &sync.Map{}
 	mssqlquerier := mssql_queries.New()
 	sqlmanager := sql_manager.NewSqlManager(pgpoolmap, pgquerier, mysqlpoolmap, mysqlquerier, mssqlpoolmap, mssqlquerier, sqlConnector)
-	mongoconnector := mongoconnect.NewConnector()
-	connectionService := v1alpha1_connectionservice.New(&v1alpha1_connectionservice.Config{}, db, useraccountService, sqlConnector, pgquerier,
-		mysqlquerier, mssqlquerier, mongoconnector, awsManager)
+	mongoconnector := mongoconnect.NewConnector(secretResolver)
+	kafkaconnector := kafkaconnect.NewConnector(secretResolver)
+	connectionService := v1alpha1_connectionservice.New(&v1alpha1_connectionservice.Config{
+		SecretsEnvPrefix:       viper.GetString("SECRETS_ENV_PREFIX"),
+		VaultAccountPathPrefix: viper.GetString("SECRETS_VAULT_ACCOUNT_PATH_PREFIX"),
+	}, db, useraccountService, sqlConnector, pgquerier,
+		mysqlquerier, mssqlquerier, mongoconnector, kafkaconnector, awsManager, connectionEncryptor)
 	api.Handle(
 		mgmtv1alpha1connect.NewConnectionServiceHandler(
 			connectionService,
@@ -474,6 +526,16 @@ func serve(ctx context.Context) error {
 		),
 	)
 
+	pipelineService := v1alpha1_pipelineservice.New(&v1alpha1_pipelineservice.Config{}, db, tfwfmgr, useraccountService)
+	api.Handle(
+		mgmtv1alpha1connect.NewPipelineServiceHandler(
+			pipelineService,
+			connect.WithInterceptors(stdInterceptors...),
+			connect.WithInterceptors(stdAuthInterceptors...),
+			connect.WithRecover(recoverHandler),
+		),
+	)
+
 	transformerService := v1alpha1_transformerservice.New(&v1alpha1_transformerservice.Config{}, db, useraccountService)
 	api.Handle(
 		mgmtv1alpha1connect.NewTransformersServiceHandler(
@@ -507,6 +569,7 @@ func serve(ctx context.Context) error {
 
 	anonymizationService := v1alpha1_anonymizationservice.New(&v1alpha1_anonymizationservice.Config{
 		IsPresidioEnabled: getIsNeosyncCloud(),
+		TransformerSecret: getTransformerSecret(),
 	}, anonymizerMeter, useraccountService, presAnalyzeClient, presAnonClient)
 	api.Handle(
 		mgmtv1alpha1connect.NewAnonymizationServiceHandler(
@@ -517,9 +580,12 @@ func serve(ctx context.Context) error {
 		),
 	)
 
-	gcpmanager := neosync_gcp.NewManager()
+	gcpmanager := neosync_gcp.NewManager(secretResolver)
 	connectionDataService := v1alpha1_connectiondataservice.New(
-		&v1alpha1_connectiondataservice.Config{},
+		&v1alpha1_connectiondataservice.Config{
+			IsPresidioEnabled:        getIsNeosyncCloud(),
+			LocalDirectoryAllowedDir: viper.GetString("LOCAL_DIRECTORY_ALLOWED_DIR"),
+		},
 		useraccountService,
 		connectionService,
 		jobService,
@@ -528,8 +594,11 @@ func serve(ctx context.Context) error {
 		pgquerier,
 		mysqlquerier,
 		mongoconnector,
+		kafkaconnector,
 		sqlmanager,
 		gcpmanager,
+		secretResolver,
+		presAnalyzeClient,
 	)
 	api.Handle(
 		mgmtv1alpha1connect.NewConnectionDataServiceHandler(
@@ -585,54 +654,6 @@ func getPromClientFromEnvironment() (promapi.Client, error) {
 	})
 }
 
-func getDbConfig() (*neosyncdb.ConnectConfig, error) {
-	dbHost := viper.GetString("DB_HOST")
-	if dbHost == "" {
-		return nil, fmt.Errorf("must provide DB_HOST in environment")
-	}
-
-	dbPort := viper.GetInt("DB_PORT")
-	if dbPort == 0 {
-		return nil, fmt.Errorf("must provide DB_PORT in environment")
-	}
-
-	dbName := viper.GetString("DB_NAME")
-	if dbName == "" {
-		return nil, fmt.Errorf("must provide DB_NAME in environment")
-	}
-
-	dbUser := viper.GetString("DB_USER")
-	if dbUser == "" {
-		return nil, fmt.Errorf("must provide DB_USER in environment")
-	}
-
-	dbPass := viper.GetString("DB_PASS")
-	if dbPass == "" {
-		return nil, fmt.Errorf("must provide DB_PASS in environment")
-	}
-
-	sslMode := "require"
-	if viper.IsSet("DB_SSL_DISABLE") && viper.GetBool("DB_SSL_DISABLE") {
-		sslMode = "disable"
-	}
-
-	var dbOptions *string
-	if viper.IsSet("DB_OPTIONS") {
-		val := viper.GetString("DB_OPTIONS")
-		dbOptions = &val
-	}
-
-	return &neosyncdb.ConnectConfig{
-		Host:     dbHost,
-		Port:     dbPort,
-		Database: dbName,
-		User:     dbUser,
-		Pass:     dbPass,
-		SslMode:  &sslMode,
-		Options:  dbOptions,
-	}, nil
-}
-
 func getDbMigrationConfig() (*neosyncdb.ConnectConfig, error) {
 	dbHost := viper.GetString("DB_HOST")
 	if dbHost == "" {
@@ -839,6 +860,11 @@ func getIsNeosyncCloud() bool {
 	return viper.GetBool("NEOSYNC_CLOUD")
 }
 
+// Secret used to derive account-scoped keys for deterministic transformers. Must match the worker's value.
+func getTransformerSecret() string {
+	return viper.GetString("TRANSFORMER_SECRET")
+}
+
 func getAllowedWorkerApiKeys(isNeosyncCloud bool) []string {
 	if isNeosyncCloud {
 		return viper.GetStringSlice("NEOSYNC_CLOUD_ALLOWED_WORKER_API_KEYS")
@@ -0,0 +1,686 @@
+package audit_interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"connectrpc.com/connect"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/auth/tokenctx"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	"github.com/nucleuscloud/neosync/backend/internal/envelope"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	"github.com/nucleuscloud/neosync/backend/internal/rbac"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	redactedValue = "[REDACTED]"
+	statusCodeOk  = "ok"
+)
+
+// The procedures that mutate account resources and are recorded to the audit log.
+// Run context updates are intentionally left out as they are internal worker bookkeeping.
+var auditedProcedures = map[string]struct{}{
+	mgmtv1alpha1connect.AnonymizationServiceDecryptFpeProcedure: {},
+
+	mgmtv1alpha1connect.ApiKeyServiceCreateAccountApiKeyProcedure:     {},
+	mgmtv1alpha1connect.ApiKeyServiceRegenerateAccountApiKeyProcedure: {},
+	mgmtv1alpha1connect.ApiKeyServiceDeleteAccountApiKeyProcedure:     {},
+
+	mgmtv1alpha1connect.ConnectionServiceCreateConnectionProcedure: {},
+	mgmtv1alpha1connect.ConnectionServiceUpdateConnectionProcedure: {},
+	mgmtv1alpha1connect.ConnectionServiceDeleteConnectionProcedure: {},
+
+	mgmtv1alpha1connect.JobServiceCreateJobProcedure:                        {},
+	mgmtv1alpha1connect.JobServiceDeleteJobProcedure:                        {},
+	mgmtv1alpha1connect.JobServiceUpdateJobScheduleProcedure:                {},
+	mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure:        {},
+	mgmtv1alpha1connect.JobServiceSetJobSourceSqlConnectionSubsetsProcedure: {},
+	mgmtv1alpha1connect.JobServiceUpdateJobDestinationConnectionProcedure:   {},
+	mgmtv1alpha1connect.JobServiceDeleteJobDestinationConnectionProcedure:   {},
+	mgmtv1alpha1connect.JobServiceCreateJobDestinationConnectionsProcedure:  {},
+	mgmtv1alpha1connect.JobServicePauseJobProcedure:                         {},
+	mgmtv1alpha1connect.JobServiceSetJobWorkflowOptionsProcedure:            {},
+	mgmtv1alpha1connect.JobServiceSetJobSyncOptionsProcedure:                {},
+	mgmtv1alpha1connect.JobServiceCreateJobRunProcedure:                     {},
+	mgmtv1alpha1connect.JobServiceCancelJobRunProcedure:                     {},
+	mgmtv1alpha1connect.JobServiceTerminateJobRunProcedure:                  {},
+	mgmtv1alpha1connect.JobServiceDeleteJobRunProcedure:                     {},
+
+	mgmtv1alpha1connect.NotificationServiceCreateNotificationRuleProcedure: {},
+	mgmtv1alpha1connect.NotificationServiceDeleteNotificationRuleProcedure: {},
+
+	mgmtv1alpha1connect.PipelineServiceCreatePipelineProcedure:    {},
+	mgmtv1alpha1connect.PipelineServiceUpdatePipelineProcedure:    {},
+	mgmtv1alpha1connect.PipelineServiceDeletePipelineProcedure:    {},
+	mgmtv1alpha1connect.PipelineServiceCreatePipelineRunProcedure: {},
+	mgmtv1alpha1connect.PipelineServiceCancelPipelineRunProcedure: {},
+
+	mgmtv1alpha1connect.TransformersServiceCreateUserDefinedTransformerProcedure: {},
+	mgmtv1alpha1connect.TransformersServiceUpdateUserDefinedTransformerProcedure: {},
+	mgmtv1alpha1connect.TransformersServiceDeleteUserDefinedTransformerProcedure: {},
+
+	mgmtv1alpha1connect.UserAccountServiceConvertPersonalToTeamAccountProcedure: {},
+	mgmtv1alpha1connect.UserAccountServiceCreateTeamAccountProcedure:            {},
+	mgmtv1alpha1connect.UserAccountServiceSetAccountTemporalConfigProcedure:     {},
+	mgmtv1alpha1connect.UserAccountServiceSetAccountOnboardingConfigProcedure:   {},
+	mgmtv1alpha1connect.UserAccountServiceRemoveTeamAccountMemberProcedure:      {},
+	mgmtv1alpha1connect.UserAccountServiceSetTeamAccountMemberRoleProcedure:     {},
+	mgmtv1alpha1connect.UserAccountServiceInviteUserToTeamAccountProcedure:      {},
+	mgmtv1alpha1connect.UserAccountServiceRemoveTeamAccountInviteProcedure:      {},
+	mgmtv1alpha1connect.UserAccountServiceAcceptTeamAccountInviteProcedure:      {},
+}
+
+// Loads the current state of the resource that is changed by an update procedure
+type snapshotFn func(i *Interceptor, ctx context.Context, reqMsg proto.Message) (proto.Message, error)
+
+// The update procedures that record the fields they changed.
+// The resource is loaded before and after the handler runs and the redacted difference is stored with the audit event.
+var updateSnapshots = map[string]snapshotFn{
+	mgmtv1alpha1connect.ConnectionServiceUpdateConnectionProcedure: (*Interceptor).getConnectionSnapshot,
+
+	mgmtv1alpha1connect.JobServiceUpdateJobScheduleProcedure:                (*Interceptor).getJobSnapshot,
+	mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure:        (*Interceptor).getJobSnapshot,
+	mgmtv1alpha1connect.JobServiceSetJobSourceSqlConnectionSubsetsProcedure: (*Interceptor).getJobSnapshot,
+	mgmtv1alpha1connect.JobServiceUpdateJobDestinationConnectionProcedure:   (*Interceptor).getJobSnapshot,
+	mgmtv1alpha1connect.JobServicePauseJobProcedure:                         (*Interceptor).getJobSnapshot,
+	mgmtv1alpha1connect.JobServiceSetJobWorkflowOptionsProcedure:            (*Interceptor).getJobSnapshot,
+	mgmtv1alpha1connect.JobServiceSetJobSyncOptionsProcedure:                (*Interceptor).getJobSnapshot,
+
+	mgmtv1alpha1connect.PipelineServiceUpdatePipelineProcedure: (*Interceptor).getPipelineSnapshot,
+
+	mgmtv1alpha1connect.TransformersServiceUpdateUserDefinedTransformerProcedure: (*Interceptor).getTransformerSnapshot,
+
+	mgmtv1alpha1connect.UserAccountServiceSetTeamAccountMemberRoleProcedure:   (*Interceptor).getAccountUserSnapshot,
+	mgmtv1alpha1connect.UserAccountServiceSetAccountTemporalConfigProcedure:   (*Interceptor).getTemporalConfigSnapshot,
+	mgmtv1alpha1connect.UserAccountServiceSetAccountOnboardingConfigProcedure: (*Interceptor).getOnboardingConfigSnapshot,
+}
+
+// Bookkeeping fields that change on every update and are left out of the recorded changes
+var ignoredChangeFields = map[string]struct{}{
+	"updatedAt":       {},
+	"updatedByUserId": {},
+}
+
+// Field names whose string or bytes values are never written to the audit log
+var sensitiveFieldNames = map[string]struct{}{
+	"url":         {},
+	"pass":        {},
+	"password":    {},
+	"passphrase":  {},
+	"token":       {},
+	"secret":      {},
+	"key":         {},
+	"values":      {},
+	"credentials": {},
+}
+
+var sensitiveFieldSuffixes = []string{
+	"_key",
+	"_secret",
+	"_token",
+	"_cert",
+	"_credentials",
+	"_url",
+}
+
+// Messages where every string or bytes field is redacted
+var sensitiveMessages = map[protoreflect.FullName]struct{}{
+	"mgmt.v1alpha1.SSHPassphrase": {},
+	"mgmt.v1alpha1.SSHPrivateKey": {},
+}
+
+// Records an audit event for every mutating procedure that is handled by the server.
+// Must be placed after the auth interceptors so that the actor can be resolved from the context.
+// The encryptor is used to decrypt connection configs so that changes to them can be recorded.
+type Interceptor struct {
+	db        *neosyncdb.NeosyncDb
+	encryptor *envelope.Encryptor
+}
+
+func NewInterceptor(db *neosyncdb.NeosyncDb, encryptor *envelope.Encryptor) connect.Interceptor {
+	return &Interceptor{db: db, encryptor: encryptor}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if request.Spec().IsClient || !IsAuditedProcedure(request.Spec().Procedure) {
+			return next(ctx, request)
+		}
+		procedure := request.Spec().Procedure
+		reqMsg, _ := request.Any().(proto.Message)
+
+		// the snapshot is loaded prior to the handler authorizing the caller,
+		// but it is only ever written to the audit log if the handler succeeds
+		before := i.getSnapshot(ctx, procedure, reqMsg)
+		response, err := next(ctx, request)
+
+		// the audit event is still recorded if the client has gone away
+		ctx = context.WithoutCancel(ctx)
+		var changes []byte
+		if before != nil && err == nil {
+			changes = i.getChanges(ctx, before, i.getSnapshot(ctx, procedure, reqMsg))
+		}
+		i.recordEvent(ctx, request, response, err, changes)
+		return response, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, conn)
+	}
+}
+
+// Returns true if the procedure is recorded to the audit log
+func IsAuditedProcedure(procedure string) bool {
+	_, ok := auditedProcedures[procedure]
+	return ok
+}
+
+func (i *Interceptor) recordEvent(
+	ctx context.Context,
+	request connect.AnyRequest,
+	response connect.AnyResponse,
+	handlerErr error,
+	changes []byte,
+) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+
+	reqMsg, _ := request.Any().(proto.Message)
+	var respMsg proto.Message
+	// the response may be a typed nil when the handler returns an error
+	if handlerErr == nil && response != nil {
+		respMsg, _ = response.Any().(proto.Message)
+	}
+
+	event, err := getAuditEvent(ctx, i.db, request.Spec().Procedure, reqMsg, respMsg, handlerErr)
+	if err != nil {
+		logger.Error(fmt.Sprintf("unable to build audit event: %s", err.Error()))
+		return
+	}
+	event.Changes = changes
+	err = i.db.Q.CreateAuditEvent(ctx, i.db.Db, *event)
+	if err != nil {
+		logger.Error(fmt.Sprintf("unable to record audit event: %s", err.Error()))
+	}
+}
+
+func getAuditEvent(
+	ctx context.Context,
+	db *neosyncdb.NeosyncDb,
+	procedure string,
+	reqMsg, respMsg proto.Message,
+	handlerErr error,
+) (*db_queries.CreateAuditEventParams, error) {
+	event := &db_queries.CreateAuditEventParams{
+		Procedure:   procedure,
+		ResourceIds: getResourceIds(reqMsg, respMsg),
+		Request:     []byte("{}"),
+		StatusCode:  statusCodeOk,
+	}
+	if handlerErr != nil {
+		event.StatusCode = connect.CodeOf(handlerErr).String()
+	}
+
+	setActor(ctx, db, event)
+
+	accountId := getAccountId(ctx, reqMsg, respMsg)
+	if accountId != "" {
+		accountUuid, err := neosyncdb.ToUuid(accountId)
+		if err == nil {
+			event.AccountID = accountUuid
+		}
+	}
+
+	if reqMsg != nil {
+		bits, err := getRedactedRequest(reqMsg)
+		if err != nil {
+			return nil, err
+		}
+		event.Request = bits
+	}
+	return event, nil
+}
+
+func setActor(ctx context.Context, db *neosyncdb.NeosyncDb, event *db_queries.CreateAuditEventParams) {
+	tokenCtxResp, err := tokenctx.GetTokenCtx(ctx)
+	if err != nil {
+		event.ActorType = int16(neosyncdb.AuditActorType_Anonymous)
+		return
+	}
+
+	if tokenCtxResp.JwtContextData != nil {
+		event.ActorType = int16(neosyncdb.AuditActorType_User)
+		user, err := db.Q.GetUserByProviderSub(ctx, db.Db, tokenCtxResp.JwtContextData.AuthUserId)
+		if err == nil {
+			event.ActorUserID = user.ID
+		}
+	} else if tokenCtxResp.ApiKeyContextData != nil {
+		event.ActorType = int16(neosyncdb.AuditActorType_ApiKey)
+		if tokenCtxResp.ApiKeyContextData.ApiKey != nil {
+			event.ActorApiKeyID = tokenCtxResp.ApiKeyContextData.ApiKey.ID
+			event.ActorUserID = tokenCtxResp.ApiKeyContextData.ApiKey.UserID
+		}
+	}
+}
+
+// Resolves the account the mutation was made against.
+// Falls back to the account the caller was authorized against for requests that only reference a resource by its id
+func getAccountId(ctx context.Context, reqMsg, respMsg proto.Message) string {
+	if accountId := getStringField(reqMsg, "account_id"); accountId != "" {
+		return accountId
+	}
+	if respMsg != nil {
+		if accountId := getStringField(respMsg, "account_id"); accountId != "" {
+			return accountId
+		}
+		for _, nested := range getNestedMessages(respMsg) {
+			if accountId := getStringField(nested, "account_id"); accountId != "" {
+				return accountId
+			}
+		}
+	}
+
+	if authz, ok := rbac.GetAuthorization(ctx); ok {
+		accountIds := authz.AccountIds()
+		if len(accountIds) == 1 {
+			return accountIds[0]
+		}
+	}
+
+	tokenCtxResp, err := tokenctx.GetTokenCtx(ctx)
+	if err == nil && tokenCtxResp.ApiKeyContextData != nil && tokenCtxResp.ApiKeyContextData.ApiKey != nil {
+		return neosyncdb.UUIDString(tokenCtxResp.ApiKeyContextData.ApiKey.AccountID)
+	}
+	return ""
+}
+
+// Returns the ids referenced by the request and the ids of any resources returned in the response
+func getResourceIds(reqMsg, respMsg proto.Message) []string {
+	resourceIds := []string{}
+	seen := map[string]struct{}{}
+	add := func(id string) {
+		if id == "" {
+			return
+		}
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+		resourceIds = append(resourceIds, id)
+	}
+
+	if reqMsg != nil {
+		msg := reqMsg.ProtoReflect()
+		fields := msg.Descriptor().Fields()
+		for idx := 0; idx < fields.Len(); idx++ {
+			fd := fields.Get(idx)
+			name := string(fd.Name())
+			if fd.Kind() != protoreflect.StringKind || fd.IsMap() || name == "account_id" {
+				continue
+			}
+			if fd.IsList() {
+				if strings.HasSuffix(name, "_ids") {
+					list := msg.Get(fd).List()
+					for j := 0; j < list.Len(); j++ {
+						add(list.Get(j).String())
+					}
+				}
+				continue
+			}
+			if name == "id" || strings.HasSuffix(name, "_id") {
+				add(msg.Get(fd).String())
+			}
+		}
+	}
+
+	if respMsg != nil {
+		for _, nested := range getNestedMessages(respMsg) {
+			add(getStringField(nested, "id"))
+		}
+	}
+	return resourceIds
+}
+
+func getStringField(msg proto.Message, name protoreflect.Name) string {
+	if msg == nil {
+		return ""
+	}
+	reflected := msg.ProtoReflect()
+	fd := reflected.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() || fd.IsMap() {
+		return ""
+	}
+	return reflected.Get(fd).String()
+}
+
+// Returns the singular message fields that are set on the top level of the message
+func getNestedMessages(msg proto.Message) []proto.Message {
+	nested := []proto.Message{}
+	msg.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			nested = append(nested, v.Message().Interface())
+		}
+		return true
+	})
+	return nested
+}
+
+// Returns the JSON encoded request with all sensitive values redacted
+func getRedactedRequest(reqMsg proto.Message) ([]byte, error) {
+	clone := proto.Clone(reqMsg)
+	redactMessage(clone.ProtoReflect(), false)
+	return protojson.Marshal(clone)
+}
+
+// Returns the redacted state of the resource changed by the update procedure.
+// Returns nil if the procedure does not record its changes or if the resource could not be loaded
+func (i *Interceptor) getSnapshot(ctx context.Context, procedure string, reqMsg proto.Message) proto.Message {
+	getSnapshot, ok := updateSnapshots[procedure]
+	if !ok || reqMsg == nil {
+		return nil
+	}
+	snapshot, err := getSnapshot(i, ctx, reqMsg)
+	if err != nil {
+		logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+		logger.Warn(fmt.Sprintf("unable to load resource to record audit changes: %s", err.Error()))
+		return nil
+	}
+	return snapshot
+}
+
+// Returns the JSON encoded changes between the snapshots, or nil if they could not be computed
+func (i *Interceptor) getChanges(ctx context.Context, before, after proto.Message) []byte {
+	if before == nil || after == nil {
+		return nil
+	}
+	changes, err := getChanges(before, after)
+	if err != nil {
+		logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+		logger.Warn(fmt.Sprintf("unable to compute audit changes: %s", err.Error()))
+		return nil
+	}
+	return changes
+}
+
+func (i *Interceptor) getConnectionSnapshot(ctx context.Context, reqMsg proto.Message) (proto.Message, error) {
+	connectionUuid, err := neosyncdb.ToUuid(getStringField(reqMsg, "id"))
+	if err != nil {
+		return nil, err
+	}
+	connection, err := i.db.Q.GetConnectionById(ctx, i.db.Db, connectionUuid)
+	if err != nil {
+		return nil, err
+	}
+	connection.ConnectionConfig, err = neosyncdb.DecryptConnectionConfig(ctx, i.encryptor, connection.AccountID, connection.ConnectionConfig)
+	if err != nil {
+		return nil, err
+	}
+	return dtomaps.ToConnectionDto(&connection)
+}
+
+func (i *Interceptor) getJobSnapshot(ctx context.Context, reqMsg proto.Message) (proto.Message, error) {
+	jobId := getStringField(reqMsg, "job_id")
+	if jobId == "" {
+		jobId = getStringField(reqMsg, "id")
+	}
+	jobUuid, err := neosyncdb.ToUuid(jobId)
+	if err != nil {
+		return nil, err
+	}
+	job, err := i.db.Q.GetJobById(ctx, i.db.Db, jobUuid)
+	if err != nil {
+		return nil, err
+	}
+	destinations, err := i.db.Q.GetJobConnectionDestinations(ctx, i.db.Db, jobUuid)
+	if err != nil {
+		return nil, err
+	}
+	return dtomaps.ToJobDto(&job, destinations), nil
+}
+
+func (i *Interceptor) getPipelineSnapshot(ctx context.Context, reqMsg proto.Message) (proto.Message, error) {
+	pipelineUuid, err := neosyncdb.ToUuid(getStringField(reqMsg, "id"))
+	if err != nil {
+		return nil, err
+	}
+	pipeline, err := i.db.Q.GetPipelineById(ctx, i.db.Db, pipelineUuid)
+	if err != nil {
+		return nil, err
+	}
+	return dtomaps.ToPipelineDto(&pipeline), nil
+}
+
+func (i *Interceptor) getTransformerSnapshot(ctx context.Context, reqMsg proto.Message) (proto.Message, error) {
+	transformerUuid, err := neosyncdb.ToUuid(getStringField(reqMsg, "transformer_id"))
+	if err != nil {
+		return nil, err
+	}
+	transformer, err := i.db.Q.GetUserDefinedTransformerById(ctx, i.db.Db, transformerUuid)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmtv1alpha1.UserDefinedTransformer{
+		Id:          neosyncdb.UUIDString(transformer.ID),
+		Name:        transformer.Name,
+		Description: transformer.Description,
+		Source:      mgmtv1alpha1.TransformerSource(transformer.Source),
+		Config:      transformer.TransformerConfig.ToTransformerConfigDto(),
+		AccountId:   neosyncdb.UUIDString(transformer.AccountID),
+	}, nil
+}
+
+func (i *Interceptor) getAccountUserSnapshot(ctx context.Context, reqMsg proto.Message) (proto.Message, error) {
+	accountUuid, err := neosyncdb.ToUuid(getStringField(reqMsg, "account_id"))
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := neosyncdb.ToUuid(getStringField(reqMsg, "user_id"))
+	if err != nil {
+		return nil, err
+	}
+	association, err := i.db.Q.GetAccountUserAssociation(ctx, i.db.Db, db_queries.GetAccountUserAssociationParams{
+		AccountId: accountUuid,
+		UserId:    userUuid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mgmtv1alpha1.AccountUser{
+		Id:   neosyncdb.UUIDString(association.UserID),
+		Role: mgmtv1alpha1.AccountRole(association.Role),
+	}, nil
+}
+
+func (i *Interceptor) getTemporalConfigSnapshot(ctx context.Context, reqMsg proto.Message) (proto.Message, error) {
+	accountUuid, err := neosyncdb.ToUuid(getStringField(reqMsg, "account_id"))
+	if err != nil {
+		return nil, err
+	}
+	config, err := i.db.Q.GetTemporalConfigByAccount(ctx, i.db.Db, accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return &mgmtv1alpha1.AccountTemporalConfig{}, nil
+	}
+	return config.ToDto(), nil
+}
+
+func (i *Interceptor) getOnboardingConfigSnapshot(ctx context.Context, reqMsg proto.Message) (proto.Message, error) {
+	accountUuid, err := neosyncdb.ToUuid(getStringField(reqMsg, "account_id"))
+	if err != nil {
+		return nil, err
+	}
+	config, err := i.db.Q.GetAccountOnboardingConfig(ctx, i.db.Db, accountUuid)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return &mgmtv1alpha1.AccountOnboardingConfig{}, nil
+	}
+	return config.ToDto(), nil
+}
+
+type fieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// Returns the JSON encoded fields that differ between the two snapshots, keyed by their dot separated field path.
+// Fields are compared on their unredacted values so that changes to sensitive fields are still recorded,
+// but only the redacted values are written.
+func getChanges(before, after proto.Message) ([]byte, error) {
+	rawBefore, redactedBefore, err := toJsonValues(before)
+	if err != nil {
+		return nil, err
+	}
+	rawAfter, redactedAfter, err := toJsonValues(after)
+	if err != nil {
+		return nil, err
+	}
+	changes := map[string]fieldChange{}
+	diffJsonValues("", rawBefore, rawAfter, redactedBefore, redactedAfter, changes)
+	return json.Marshal(changes)
+}
+
+// Returns the decoded JSON of the message, both as-is and with all sensitive values redacted
+func toJsonValues(msg proto.Message) (raw, redacted any, err error) {
+	rawBits, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	redactedBits, err := getRedactedRequest(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := json.Unmarshal(rawBits, &raw); err != nil {
+		return nil, nil, err
+	}
+	if err := json.Unmarshal(redactedBits, &redacted); err != nil {
+		return nil, nil, err
+	}
+	return raw, redacted, nil
+}
+
+func diffJsonValues(path string, rawBefore, rawAfter, redactedBefore, redactedAfter any, changes map[string]fieldChange) {
+	beforeObj, beforeIsObj := rawBefore.(map[string]any)
+	afterObj, afterIsObj := rawAfter.(map[string]any)
+	if beforeIsObj && afterIsObj {
+		redactedBeforeObj, _ := redactedBefore.(map[string]any)
+		redactedAfterObj, _ := redactedAfter.(map[string]any)
+		keys := map[string]struct{}{}
+		for key := range beforeObj {
+			keys[key] = struct{}{}
+		}
+		for key := range afterObj {
+			keys[key] = struct{}{}
+		}
+		for key := range keys {
+			if _, ok := ignoredChangeFields[key]; ok && path == "" {
+				continue
+			}
+			diffJsonValues(
+				joinFieldPath(path, key),
+				beforeObj[key], afterObj[key],
+				redactedBeforeObj[key], redactedAfterObj[key],
+				changes,
+			)
+		}
+		return
+	}
+	if reflect.DeepEqual(rawBefore, rawAfter) {
+		return
+	}
+	changes[path] = fieldChange{Before: redactedBefore, After: redactedAfter}
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func redactMessage(msg protoreflect.Message, redactAll bool) {
+	if _, ok := sensitiveMessages[msg.Descriptor().FullName()]; ok {
+		redactAll = true
+	}
+
+	// fields are collected first as the message may not be mutated while ranging over it
+	type setField struct {
+		fd    protoreflect.FieldDescriptor
+		value protoreflect.Value
+	}
+	fields := []setField{}
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields = append(fields, setField{fd: fd, value: v})
+		return true
+	})
+
+	for _, field := range fields {
+		fd := field.fd
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				field.value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					redactMessage(v.Message(), redactAll)
+					return true
+				})
+			}
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			if fd.IsList() {
+				list := field.value.List()
+				for idx := 0; idx < list.Len(); idx++ {
+					redactMessage(list.Get(idx).Message(), redactAll)
+				}
+			} else {
+				redactMessage(field.value.Message(), redactAll)
+			}
+		case fd.Kind() == protoreflect.StringKind || fd.Kind() == protoreflect.BytesKind:
+			if !redactAll && !isSensitiveField(string(fd.Name())) {
+				continue
+			}
+			if fd.Kind() == protoreflect.BytesKind {
+				msg.Clear(fd)
+				continue
+			}
+			if fd.IsList() {
+				list := field.value.List()
+				for idx := 0; idx < list.Len(); idx++ {
+					list.Set(idx, protoreflect.ValueOfString(redactedValue))
+				}
+				continue
+			}
+			msg.Set(fd, protoreflect.ValueOfString(redactedValue))
+		}
+	}
+}
+
+func isSensitiveField(name string) bool {
+	if _, ok := sensitiveFieldNames[name]; ok {
+		return true
+	}
+	for _, suffix := range sensitiveFieldSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
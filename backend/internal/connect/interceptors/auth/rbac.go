@@ -0,0 +1,182 @@
+package auth_interceptor
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/rbac"
+)
+
+// Attaches the permission required by each procedure to the request context.
+// The permission is enforced against the caller's role each time their membership of an account is verified.
+// Procedures without a defined permission are denied.
+// Procedures that require a permission fail closed: the response is rejected unless the caller was authorized against an account while it was being handled.
+// Handlers must authorize the caller before making any changes, as rejecting the response can not undo them.
+// A handler that returns without authorizing is a bug, which is logged and panics when running under go test.
+// Connection credentials are redacted from responses unless the caller's role allows them to be viewed.
+// Webhook urls commonly embed a secret, so they are redacted down to their host unless the caller's role can manage notification rules.
+// API keys that are restricted to specific jobs are checked against the job in the request, with job runs resolved to their job through jobRuns.
+// Must be placed after the interceptor that authenticates the request.
+type RbacInterceptor struct {
+	jobRuns auth_apikey.JobRunResolver
+}
+
+func NewRbacInterceptor(jobRuns auth_apikey.JobRunResolver) connect.Interceptor {
+	return &RbacInterceptor{jobRuns: jobRuns}
+}
+
+func (i *RbacInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, request connect.AnyRequest) (connect.AnyResponse, error) {
+		if isWorkerApiKey(ctx) {
+			return next(ctx, request)
+		}
+		permission, ok := rbac.GetProcedurePermission(request.Spec().Procedure)
+		if !ok {
+			return nil, nucleuserrors.NewForbidden(fmt.Sprintf("no permission has been defined for %s", request.Spec().Procedure))
+		}
+		if err := auth_apikey.VerifyJobAccess(ctx, request.Spec().Procedure, request.Any(), i.jobRuns); err != nil {
+			return nil, err
+		}
+		newCtx, authz := rbac.WithAuthorization(ctx, permission)
+		resp, err := next(newCtx, request)
+		if err != nil {
+			return nil, err
+		}
+		if !authz.IsAuthorized() {
+			return nil, handleUnauthorizedProcedure(ctx, request.Spec().Procedure)
+		}
+		redactResponse(resp.Any(), authz)
+		return resp, nil
+	}
+}
+
+func (i *RbacInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+func (i *RbacInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if isWorkerApiKey(ctx) {
+			return next(ctx, conn)
+		}
+		permission, ok := rbac.GetProcedurePermission(conn.Spec().Procedure)
+		if !ok {
+			return nucleuserrors.NewForbidden(fmt.Sprintf("no permission has been defined for %s", conn.Spec().Procedure))
+		}
+		// the request message has not been received yet, so restricted api keys are checked against the procedure alone
+		if err := auth_apikey.VerifyJobAccess(ctx, conn.Spec().Procedure, nil, i.jobRuns); err != nil {
+			return err
+		}
+		newCtx, authz := rbac.WithAuthorization(ctx, permission)
+		err := next(newCtx, &authorizedStreamingHandlerConn{StreamingHandlerConn: conn, authz: authz})
+		if err != nil {
+			return err
+		}
+		if !authz.IsAuthorized() {
+			return handleUnauthorizedProcedure(ctx, conn.Spec().Procedure)
+		}
+		return nil
+	}
+}
+
+// Rejects any message sent to the client before the caller has been authorized against an account
+type authorizedStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	authz *rbac.Authorization
+}
+
+func (c *authorizedStreamingHandlerConn) Send(msg any) error {
+	if !c.authz.IsAuthorized() {
+		return newUnauthorizedProcedureError(c.Spec().Procedure)
+	}
+	return c.StreamingHandlerConn.Send(msg)
+}
+
+func newUnauthorizedProcedureError(procedure string) error {
+	return nucleuserrors.NewForbidden(fmt.Sprintf("the caller was not authorized against an account for %s", procedure))
+}
+
+func handleUnauthorizedProcedure(ctx context.Context, procedure string) error {
+	msg := fmt.Sprintf("%s returned without authorizing the caller against an account, any changes it made were not authorized", procedure)
+	logger_interceptor.GetLoggerFromContextOrDefault(ctx).Error(msg)
+	if testing.Testing() {
+		panic(msg)
+	}
+	return newUnauthorizedProcedureError(procedure)
+}
+
+func isWorkerApiKey(ctx context.Context) bool {
+	data, err := auth_apikey.GetTokenDataFromCtx(ctx)
+	if err != nil {
+		return false
+	}
+	return data.ApiKeyType == apikey.WorkerApiKey
+}
+
+func redactResponse(msg any, authz *rbac.Authorization) {
+	switch resp := msg.(type) {
+	case *mgmtv1alpha1.GetConnectionResponse:
+		redactConnection(resp.GetConnection(), authz)
+	case *mgmtv1alpha1.GetConnectionsResponse:
+		for _, connection := range resp.GetConnections() {
+			redactConnection(connection, authz)
+		}
+	case *mgmtv1alpha1.GetNotificationRulesResponse:
+		for _, rule := range resp.GetRules() {
+			redactNotificationRule(rule, authz)
+		}
+	}
+}
+
+func redactNotificationRule(rule *mgmtv1alpha1.NotificationRule, authz *rbac.Authorization) {
+	if rule == nil {
+		return
+	}
+	role, ok := authz.GetRole(rule.GetAccountId())
+	if ok && rbac.HasPermission(role, rbac.PermissionJobsWrite) {
+		return
+	}
+	rule.Url = toRedactedUrl(rule.GetUrl())
+}
+
+// Keeps the scheme and host so that callers can still tell where the webhook is delivered
+func toRedactedUrl(rawUrl string) string {
+	parsed, err := url.Parse(rawUrl)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return ""
+	}
+	return (&url.URL{Scheme: parsed.Scheme, Host: parsed.Host}).String()
+}
+
+func redactConnection(connection *mgmtv1alpha1.Connection, authz *rbac.Authorization) {
+	if connection == nil || connection.GetConnectionConfig() == nil {
+		return
+	}
+	role, ok := authz.GetRole(connection.GetAccountId())
+	if ok && rbac.HasPermission(role, rbac.PermissionConnectionCredentialsRead) {
+		return
+	}
+	connection.ConnectionConfig = toRedactedConnectionConfig(connection.GetConnectionConfig())
+}
+
+// Returns an empty connection config of the same type so that callers can still tell what kind of connection it is
+func toRedactedConnectionConfig(cc *mgmtv1alpha1.ConnectionConfig) *mgmtv1alpha1.ConnectionConfig {
+	redacted := &mgmtv1alpha1.ConnectionConfig{}
+	msg := cc.ProtoReflect()
+	field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("config"))
+	if field != nil {
+		redactedMsg := redacted.ProtoReflect()
+		redactedMsg.Set(field, redactedMsg.NewField(field))
+	}
+	return redacted
+}
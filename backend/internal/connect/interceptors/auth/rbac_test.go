@@ -0,0 +1,252 @@
+package auth_interceptor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/rbac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func Test_RbacInterceptor_WrapUnary_UnknownProcedure(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/unknown.v1.Service/Method", connect.NewUnaryHandler(
+		"/unknown.v1.Service/Method",
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetUserRequest]) (*connect.Response[mgmtv1alpha1.GetUserResponse], error) {
+			return connect.NewResponse(&mgmtv1alpha1.GetUserResponse{}), nil
+		},
+		connect.WithInterceptors(NewRbacInterceptor(nil)),
+	))
+	srv := startHTTPServer(t, mux)
+
+	client := connect.NewClient[mgmtv1alpha1.GetUserRequest, mgmtv1alpha1.GetUserResponse](srv.Client(), srv.URL+"/unknown.v1.Service/Method")
+	resp, err := client.CallUnary(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetUserRequest{}))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
+func Test_RbacInterceptor_WrapUnary_EnforcesPermission(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.JobServiceCreateJobRunProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.JobServiceCreateJobRunProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.CreateJobRunRequest]) (*connect.Response[mgmtv1alpha1.CreateJobRunResponse], error) {
+			authz, ok := rbac.GetAuthorization(ctx)
+			if !ok {
+				return nil, connect.NewError(connect.CodeInternal, nil)
+			}
+			if err := authz.Authorize("account-id", mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER); err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(&mgmtv1alpha1.CreateJobRunResponse{}), nil
+		},
+		connect.WithInterceptors(NewRbacInterceptor(nil)),
+	))
+	srv := startHTTPServer(t, mux)
+
+	client := mgmtv1alpha1connect.NewJobServiceClient(srv.Client(), srv.URL)
+	resp, err := client.CreateJobRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateJobRunRequest{}))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
+func Test_RbacInterceptor_WrapUnary_RedactsConnectionCredentials(t *testing.T) {
+	newHandler := func(role mgmtv1alpha1.AccountRole) connect.UnaryFunc {
+		return func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+			authz, ok := rbac.GetAuthorization(ctx)
+			require.True(t, ok)
+			require.NoError(t, authz.Authorize("account-id", role))
+			return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+				Connection: &mgmtv1alpha1.Connection{
+					AccountId: "account-id",
+					ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+						Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+							PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+								ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{Url: "postgres://prod"},
+							},
+						},
+					},
+				},
+			}), nil
+		}
+	}
+	req := connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{})
+
+	t.Run("executor", func(t *testing.T) {
+		handler := NewRbacInterceptor(nil).WrapUnary(newHandler(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EXECUTOR))
+		resp, err := handler(context.Background(), &procedureRequest{Request: req, procedure: mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure})
+		require.NoError(t, err)
+		cc := resp.Any().(*mgmtv1alpha1.GetConnectionResponse).GetConnection().GetConnectionConfig()
+		require.NotNil(t, cc.GetPgConfig())
+		require.Empty(t, cc.GetPgConfig().GetUrl())
+	})
+
+	t.Run("admin", func(t *testing.T) {
+		handler := NewRbacInterceptor(nil).WrapUnary(newHandler(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN))
+		resp, err := handler(context.Background(), &procedureRequest{Request: req, procedure: mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure})
+		require.NoError(t, err)
+		cc := resp.Any().(*mgmtv1alpha1.GetConnectionResponse).GetConnection().GetConnectionConfig()
+		require.Equal(t, "postgres://prod", cc.GetPgConfig().GetUrl())
+	})
+}
+
+func Test_RbacInterceptor_WrapUnary_RedactsWebhookUrls(t *testing.T) {
+	newHandler := func(role mgmtv1alpha1.AccountRole) connect.UnaryFunc {
+		return func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+			authz, ok := rbac.GetAuthorization(ctx)
+			require.True(t, ok)
+			require.NoError(t, authz.Authorize("account-id", role))
+			return connect.NewResponse(&mgmtv1alpha1.GetNotificationRulesResponse{
+				Rules: []*mgmtv1alpha1.NotificationRule{
+					{AccountId: "account-id", Url: "https://hooks.example.com/services/T000/B000/secret-token?key=abc"},
+				},
+			}), nil
+		}
+	}
+	req := connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{})
+
+	t.Run("viewer", func(t *testing.T) {
+		handler := NewRbacInterceptor(nil).WrapUnary(newHandler(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER))
+		resp, err := handler(context.Background(), &procedureRequest{Request: req, procedure: mgmtv1alpha1connect.NotificationServiceGetNotificationRulesProcedure})
+		require.NoError(t, err)
+		require.Equal(t, "https://hooks.example.com", resp.Any().(*mgmtv1alpha1.GetNotificationRulesResponse).GetRules()[0].GetUrl())
+	})
+
+	t.Run("job developer", func(t *testing.T) {
+		handler := NewRbacInterceptor(nil).WrapUnary(newHandler(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_DEVELOPER))
+		resp, err := handler(context.Background(), &procedureRequest{Request: req, procedure: mgmtv1alpha1connect.NotificationServiceGetNotificationRulesProcedure})
+		require.NoError(t, err)
+		require.Equal(t, "https://hooks.example.com/services/T000/B000/secret-token?key=abc", resp.Any().(*mgmtv1alpha1.GetNotificationRulesResponse).GetRules()[0].GetUrl())
+	})
+}
+
+func Test_RbacInterceptor_WrapUnary_RunContextRequiresCredentialsPermission(t *testing.T) {
+	// generated benthos configs are stored as run contexts and may contain connection credentials
+	newHandler := func(role mgmtv1alpha1.AccountRole) connect.UnaryFunc {
+		return func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+			authz, ok := rbac.GetAuthorization(ctx)
+			require.True(t, ok)
+			if err := authz.Authorize("account-id", role); err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(&mgmtv1alpha1.GetRunContextResponse{Value: []byte("secret_access_key: abc")}), nil
+		}
+	}
+	req := connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{})
+
+	for _, role := range []mgmtv1alpha1.AccountRole{
+		mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER,
+		mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EXECUTOR,
+		mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_DEVELOPER,
+	} {
+		t.Run(role.String(), func(t *testing.T) {
+			handler := NewRbacInterceptor(nil).WrapUnary(newHandler(role))
+			resp, err := handler(context.Background(), &procedureRequest{Request: req, procedure: mgmtv1alpha1connect.JobServiceGetRunContextProcedure})
+			require.Error(t, err)
+			require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			require.Nil(t, resp)
+		})
+	}
+
+	t.Run("admin", func(t *testing.T) {
+		handler := NewRbacInterceptor(nil).WrapUnary(newHandler(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN))
+		resp, err := handler(context.Background(), &procedureRequest{Request: req, procedure: mgmtv1alpha1connect.JobServiceGetRunContextProcedure})
+		require.NoError(t, err)
+		require.Equal(t, []byte("secret_access_key: abc"), resp.Any().(*mgmtv1alpha1.GetRunContextResponse).GetValue())
+	})
+}
+
+func Test_RbacInterceptor_FailsClosedForEveryProcedure(t *testing.T) {
+	procedureCount := 0
+	protoregistry.GlobalFiles.RangeFilesByPackage("mgmt.v1alpha1", func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				procedure := fmt.Sprintf("/%s/%s", services.Get(i).FullName(), method.Name())
+				procedureCount++
+
+				t.Run(procedure, func(t *testing.T) {
+					permission, ok := rbac.GetProcedurePermission(procedure)
+					require.True(t, ok, "no permission has been defined for %s", procedure)
+
+					if method.IsStreamingClient() || method.IsStreamingServer() {
+						handler := NewRbacInterceptor(nil).WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+							return conn.Send(&mgmtv1alpha1.GetConnectionResponse{})
+						})
+						err := handler(context.Background(), &procedureStreamingConn{procedure: procedure})
+						if permission == rbac.PermissionNone {
+							require.NoError(t, err)
+							return
+						}
+						require.Error(t, err)
+						require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+						return
+					}
+
+					handler := NewRbacInterceptor(nil).WrapUnary(func(ctx context.Context, ar connect.AnyRequest) (connect.AnyResponse, error) {
+						return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{}), nil
+					})
+					call := func() {
+						_, err := handler(context.Background(), &procedureRequest{Request: connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{}), procedure: procedure})
+						require.NoError(t, err)
+					}
+					if permission == rbac.PermissionNone {
+						call()
+						return
+					}
+					// a handler that returns without authorizing the caller is a bug
+					require.Panics(t, call)
+				})
+			}
+		}
+		return true
+	})
+	require.NotZero(t, procedureCount)
+}
+
+func Test_RbacInterceptor_WrapStreamingHandler_Authorized(t *testing.T) {
+	handler := NewRbacInterceptor(nil).WrapStreamingHandler(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		authz, ok := rbac.GetAuthorization(ctx)
+		require.True(t, ok)
+		require.NoError(t, authz.Authorize("account-id", mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_DEVELOPER))
+		return conn.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{})
+	})
+	conn := &procedureStreamingConn{procedure: mgmtv1alpha1connect.ConnectionDataServiceGetConnectionDataStreamProcedure}
+	require.NoError(t, handler(context.Background(), conn))
+	require.Equal(t, 1, conn.sent)
+}
+
+type procedureStreamingConn struct {
+	connect.StreamingHandlerConn
+	procedure string
+	sent      int
+}
+
+func (c *procedureStreamingConn) Spec() connect.Spec {
+	return connect.Spec{Procedure: c.procedure}
+}
+
+func (c *procedureStreamingConn) Send(msg any) error {
+	c.sent++
+	return nil
+}
+
+type procedureRequest struct {
+	*connect.Request[mgmtv1alpha1.GetConnectionRequest]
+	procedure string
+}
+
+func (r *procedureRequest) Spec() connect.Spec {
+	return connect.Spec{Procedure: r.procedure}
+}
@@ -22,5 +22,7 @@ func ToAccountApiKeyDto(
 		KeyValue:    cleartextKeyValue,
 		UserId:      neosyncdb.UUIDString(input.UserID),
 		ExpiresAt:   timestamppb.New(input.ExpiresAt.Time),
+		Scopes:      input.Scopes,
+		JobIds:      neosyncdb.UUIDStrings(input.JobIds),
 	}
 }
@@ -0,0 +1,43 @@
+package dtomaps
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToAuditEventDto(
+	input *db_queries.NeosyncApiAuditEvent,
+) *mgmtv1alpha1.AuditEvent {
+	return &mgmtv1alpha1.AuditEvent{
+		Id:            neosyncdb.UUIDString(input.ID),
+		AccountId:     toNullableUUIDString(input.AccountID),
+		ActorType:     mgmtv1alpha1.AuditActorType(input.ActorType),
+		ActorUserId:   toNullableUUIDString(input.ActorUserID),
+		ActorApiKeyId: toNullableUUIDString(input.ActorApiKeyID),
+		Procedure:     input.Procedure,
+		ResourceIds:   input.ResourceIds,
+		RequestJson:   string(input.Request),
+		StatusCode:    input.StatusCode,
+		CreatedAt:     timestamppb.New(input.CreatedAt.Time),
+		ChangesJson:   toNullableJsonString(input.Changes),
+	}
+}
+
+func toNullableUUIDString(value pgtype.UUID) *string {
+	if !value.Valid {
+		return nil
+	}
+	str := neosyncdb.UUIDString(value)
+	return &str
+}
+
+func toNullableJsonString(value []byte) *string {
+	if value == nil {
+		return nil
+	}
+	str := string(value)
+	return &str
+}
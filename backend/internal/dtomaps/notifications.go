@@ -0,0 +1,50 @@
+package dtomaps
+
+import (
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToNotificationRuleDto(
+	input *db_queries.NeosyncApiNotificationRule,
+) *mgmtv1alpha1.NotificationRule {
+	eventTypes := make([]mgmtv1alpha1.JobRunEventType, 0, len(input.EventTypes))
+	for _, eventType := range input.EventTypes {
+		eventTypes = append(eventTypes, mgmtv1alpha1.JobRunEventType(eventType))
+	}
+	return &mgmtv1alpha1.NotificationRule{
+		Id:            neosyncdb.UUIDString(input.ID),
+		AccountId:     neosyncdb.UUIDString(input.AccountID),
+		JobId:         toNullableUUIDString(input.JobID),
+		Name:          input.Name,
+		Url:           input.Url,
+		EventTypes:    eventTypes,
+		PayloadFormat: mgmtv1alpha1.NotificationPayloadFormat(input.PayloadFormat),
+		CreatedAt:     timestamppb.New(input.CreatedAt.Time),
+		UpdatedAt:     timestamppb.New(input.UpdatedAt.Time),
+	}
+}
+
+func ToNotificationDeliveryDto(
+	input *db_queries.NeosyncApiNotificationDelivery,
+) *mgmtv1alpha1.NotificationDelivery {
+	dto := &mgmtv1alpha1.NotificationDelivery{
+		Id:        neosyncdb.UUIDString(input.ID),
+		RuleId:    neosyncdb.UUIDString(input.RuleID),
+		JobId:     neosyncdb.UUIDString(input.JobID),
+		JobRunId:  input.JobRunID,
+		EventType: mgmtv1alpha1.JobRunEventType(input.EventType),
+		Succeeded: input.Succeeded,
+		Attempts:  uint32(input.Attempts), //nolint:gosec
+		CreatedAt: timestamppb.New(input.CreatedAt.Time),
+	}
+	if input.ResponseStatusCode.Valid {
+		dto.ResponseStatusCode = &input.ResponseStatusCode.Int32
+	}
+	if input.Error.Valid {
+		dto.Error = &input.Error.String
+	}
+	return dto
+}
@@ -0,0 +1,41 @@
+package dtomaps
+
+import (
+	"log/slog"
+
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ToPipelineDto(
+	input *db_queries.NeosyncApiPipeline,
+) *mgmtv1alpha1.Pipeline {
+	steps := make([]*mgmtv1alpha1.PipelineStep, 0, len(input.Steps))
+	for _, step := range input.Steps {
+		steps = append(steps, step.ToDto())
+	}
+	return &mgmtv1alpha1.Pipeline{
+		Id:              neosyncdb.UUIDString(input.ID),
+		AccountId:       neosyncdb.UUIDString(input.AccountID),
+		Name:            input.Name,
+		Steps:           steps,
+		CronSchedule:    neosyncdb.ToNullableString(input.CronSchedule),
+		CreatedByUserId: neosyncdb.UUIDString(input.CreatedByID),
+		CreatedAt:       timestamppb.New(input.CreatedAt.Time),
+		UpdatedByUserId: neosyncdb.UUIDString(input.UpdatedByID),
+		UpdatedAt:       timestamppb.New(input.UpdatedAt.Time),
+	}
+}
+
+func ToPipelineRunDto(workflow *workflowpb.WorkflowExecutionInfo, logger *slog.Logger) *mgmtv1alpha1.PipelineRun {
+	return &mgmtv1alpha1.PipelineRun{
+		Id:          workflow.GetExecution().GetWorkflowId(),
+		PipelineId:  GetJobIdFromWorkflow(logger, workflow.GetSearchAttributes()),
+		Status:      toWorfklowStatus(workflow.GetStatus()),
+		StartedAt:   workflow.GetStartTime(),
+		CompletedAt: workflow.GetCloseTime(),
+	}
+}
@@ -32,6 +32,7 @@ func ToAccountInviteDto(input *db_queries.NeosyncApiAccountInvite) *mgmtv1alpha1
 		CreatedAt:    timestamppb.New(input.CreatedAt.Time),
 		UpdatedAt:    timestamppb.New(input.UpdatedAt.Time),
 		ExpiresAt:    timestamppb.New(input.ExpiresAt.Time),
+		Role:         mgmtv1alpha1.AccountRole(input.Role),
 	}
 }
 
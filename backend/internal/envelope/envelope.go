@@ -0,0 +1,115 @@
+package envelope
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const dataKeyLength = 32
+
+// Wraps and unwraps data keys with a master key that never leaves the provider.
+// Implementations may be backed by a KMS. LocalKeyProvider is a stand-in that holds the master keys in memory.
+type KeyProvider interface {
+	// The id of the master key that new data keys are wrapped with
+	ActiveKeyId() string
+	WrapKey(ctx context.Context, keyId string, dataKey []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error)
+}
+
+// The result of encrypting a value with a unique data key
+type Envelope struct {
+	// The id of the master key that the data key was wrapped with
+	KeyId string
+	// The data key, wrapped by the master key
+	EncryptedDataKey []byte
+	// The nonce followed by the AES-GCM sealed value
+	Ciphertext []byte
+}
+
+type Encryptor struct {
+	keys KeyProvider
+}
+
+func New(keys KeyProvider) *Encryptor {
+	return &Encryptor{keys: keys}
+}
+
+// Encrypts the plaintext with a newly generated data key that is wrapped with the active master key.
+// The additional data is authenticated but not encrypted, and the same value must be provided to decrypt.
+func (e *Encryptor) Encrypt(ctx context.Context, plaintext, additionalData []byte) (*Envelope, error) {
+	dataKey := make([]byte, dataKeyLength)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, fmt.Errorf("unable to generate data key: %w", err)
+	}
+	ciphertext, err := seal(dataKey, plaintext, additionalData)
+	if err != nil {
+		return nil, err
+	}
+	keyId := e.keys.ActiveKeyId()
+	wrappedKey, err := e.keys.WrapKey(ctx, keyId, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to wrap data key: %w", err)
+	}
+	return &Envelope{
+		KeyId:            keyId,
+		EncryptedDataKey: wrappedKey,
+		Ciphertext:       ciphertext,
+	}, nil
+}
+
+func (e *Encryptor) Decrypt(ctx context.Context, env *Envelope, additionalData []byte) ([]byte, error) {
+	if env == nil {
+		return nil, errors.New("envelope was nil")
+	}
+	dataKey, err := e.keys.UnwrapKey(ctx, env.KeyId, env.EncryptedDataKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unwrap data key: %w", err)
+	}
+	return open(dataKey, env.Ciphertext, additionalData)
+}
+
+// Returns true if the envelope's data key was wrapped with a master key other than the active one
+func (e *Encryptor) NeedsRotation(env *Envelope) bool {
+	return env != nil && env.KeyId != e.keys.ActiveKeyId()
+}
+
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("unable to generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(key, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newAead(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,118 @@
+package envelope
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Encryptor_RoundTrip(t *testing.T) {
+	encryptor := New(newTestKeyProvider(t, "key1", "key1"))
+
+	env, err := encryptor.Encrypt(context.Background(), []byte("hunter2"), []byte("account"))
+	require.NoError(t, err)
+	require.Equal(t, "key1", env.KeyId)
+	require.False(t, bytes.Contains(env.Ciphertext, []byte("hunter2")))
+
+	plaintext, err := encryptor.Decrypt(context.Background(), env, []byte("account"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("hunter2"), plaintext)
+
+	_, err = encryptor.Decrypt(context.Background(), env, []byte("other-account"))
+	require.Error(t, err, "additional data must match")
+
+	_, err = encryptor.Decrypt(context.Background(), nil, []byte("account"))
+	require.Error(t, err)
+}
+
+func Test_Encryptor_UniqueDataKeys(t *testing.T) {
+	encryptor := New(newTestKeyProvider(t, "key1", "key1"))
+
+	env1, err := encryptor.Encrypt(context.Background(), []byte("hunter2"), nil)
+	require.NoError(t, err)
+	env2, err := encryptor.Encrypt(context.Background(), []byte("hunter2"), nil)
+	require.NoError(t, err)
+	require.NotEqual(t, env1.EncryptedDataKey, env2.EncryptedDataKey)
+	require.NotEqual(t, env1.Ciphertext, env2.Ciphertext)
+}
+
+func Test_Encryptor_Rotation(t *testing.T) {
+	keys := map[string][]byte{
+		"key1": bytes.Repeat([]byte{1}, dataKeyLength),
+		"key2": bytes.Repeat([]byte{2}, dataKeyLength),
+	}
+	oldProvider, err := NewLocalKeyProvider("key1", keys)
+	require.NoError(t, err)
+	newProvider, err := NewLocalKeyProvider("key2", keys)
+	require.NoError(t, err)
+
+	env, err := New(oldProvider).Encrypt(context.Background(), []byte("hunter2"), nil)
+	require.NoError(t, err)
+
+	rotated := New(newProvider)
+	require.True(t, rotated.NeedsRotation(env))
+	plaintext, err := rotated.Decrypt(context.Background(), env, nil)
+	require.NoError(t, err, "retired keys must still be able to decrypt")
+	require.Equal(t, []byte("hunter2"), plaintext)
+
+	env, err = rotated.Encrypt(context.Background(), plaintext, nil)
+	require.NoError(t, err)
+	require.Equal(t, "key2", env.KeyId)
+	require.False(t, rotated.NeedsRotation(env))
+
+	// a wrapped key can not be unwrapped under a different key id
+	env.KeyId = "key1"
+	_, err = rotated.Decrypt(context.Background(), env, nil)
+	require.Error(t, err)
+}
+
+func Test_NewLocalKeyProvider(t *testing.T) {
+	_, err := NewLocalKeyProvider("key1", nil)
+	require.Error(t, err)
+
+	_, err = NewLocalKeyProvider("key1", map[string][]byte{"key1": []byte("short")})
+	require.Error(t, err)
+
+	_, err = NewLocalKeyProvider("key2", map[string][]byte{"key1": bytes.Repeat([]byte{1}, dataKeyLength)})
+	require.Error(t, err)
+}
+
+func Test_NewFromEnvironment(t *testing.T) {
+	t.Cleanup(viper.Reset)
+
+	encryptor, err := NewFromEnvironment()
+	require.NoError(t, err)
+	require.Nil(t, encryptor)
+
+	key1 := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, dataKeyLength))
+	key2 := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, dataKeyLength))
+
+	viper.Set("CONNECTION_ENCRYPTION_KEYS", "key1:"+key1+", key2:"+key2)
+	encryptor, err = NewFromEnvironment()
+	require.NoError(t, err)
+	require.Equal(t, "key1", encryptor.keys.ActiveKeyId())
+
+	viper.Set("CONNECTION_ENCRYPTION_ACTIVE_KEY_ID", "key2")
+	encryptor, err = NewFromEnvironment()
+	require.NoError(t, err)
+	require.Equal(t, "key2", encryptor.keys.ActiveKeyId())
+
+	viper.Set("CONNECTION_ENCRYPTION_KEYS", "key1")
+	_, err = NewFromEnvironment()
+	require.Error(t, err)
+}
+
+func newTestKeyProvider(t *testing.T, activeKeyId string, keyIds ...string) *LocalKeyProvider {
+	t.Helper()
+	keys := map[string][]byte{}
+	for i, keyId := range keyIds {
+		keys[keyId] = bytes.Repeat([]byte{byte(i + 1)}, dataKeyLength)
+	}
+	provider, err := NewLocalKeyProvider(activeKeyId, keys)
+	require.NoError(t, err)
+	return provider
+}
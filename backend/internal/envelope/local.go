@@ -0,0 +1,97 @@
+package envelope
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// A KeyProvider that wraps data keys with AES-256 master keys held in memory.
+// Stands in for a KMS when the master keys are provided through the environment.
+type LocalKeyProvider struct {
+	activeKeyId string
+	keys        map[string][]byte
+}
+
+var _ KeyProvider = &LocalKeyProvider{}
+
+// Each master key must be 32 bytes. The active key is used to wrap all new data keys,
+// while the remaining keys are only used to unwrap data keys that were created before a rotation.
+func NewLocalKeyProvider(activeKeyId string, keys map[string][]byte) (*LocalKeyProvider, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("must provide at least one master key")
+	}
+	for keyId, key := range keys {
+		if keyId == "" {
+			return nil, errors.New("master key id must not be empty")
+		}
+		if len(key) != dataKeyLength {
+			return nil, fmt.Errorf("master key %s must be %d bytes, got %d", keyId, dataKeyLength, len(key))
+		}
+	}
+	if _, ok := keys[activeKeyId]; !ok {
+		return nil, fmt.Errorf("active master key %s was not provided", activeKeyId)
+	}
+	return &LocalKeyProvider{activeKeyId: activeKeyId, keys: keys}, nil
+}
+
+func (p *LocalKeyProvider) ActiveKeyId() string {
+	return p.activeKeyId
+}
+
+func (p *LocalKeyProvider) WrapKey(ctx context.Context, keyId string, dataKey []byte) ([]byte, error) {
+	key, ok := p.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("unknown master key: %s", keyId)
+	}
+	// the key id is bound to the wrapped key so that it can not be unwrapped under a different id
+	return seal(key, dataKey, []byte(keyId))
+}
+
+func (p *LocalKeyProvider) UnwrapKey(ctx context.Context, keyId string, wrappedKey []byte) ([]byte, error) {
+	key, ok := p.keys[keyId]
+	if !ok {
+		return nil, fmt.Errorf("unknown master key: %s", keyId)
+	}
+	return open(key, wrappedKey, []byte(keyId))
+}
+
+// Builds an encryptor from the master keys in the environment. Returns nil if no keys have been configured.
+// CONNECTION_ENCRYPTION_KEYS is a comma separated list of <keyId>:<base64 encoded 32 byte key>.
+// CONNECTION_ENCRYPTION_ACTIVE_KEY_ID selects the key that new data keys are wrapped with and defaults to the first key in the list.
+func NewFromEnvironment() (*Encryptor, error) {
+	rawKeys := viper.GetString("CONNECTION_ENCRYPTION_KEYS")
+	if rawKeys == "" {
+		return nil, nil
+	}
+	activeKeyId := viper.GetString("CONNECTION_ENCRYPTION_ACTIVE_KEY_ID")
+
+	keys := map[string][]byte{}
+	for _, rawKey := range strings.Split(rawKeys, ",") {
+		keyId, encodedKey, ok := strings.Cut(strings.TrimSpace(rawKey), ":")
+		if !ok {
+			return nil, errors.New("CONNECTION_ENCRYPTION_KEYS must be a comma separated list of <keyId>:<base64 key>")
+		}
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode master key %s: %w", keyId, err)
+		}
+		if _, ok := keys[keyId]; ok {
+			return nil, fmt.Errorf("master key %s was provided more than once", keyId)
+		}
+		keys[keyId] = key
+		if activeKeyId == "" {
+			activeKeyId = keyId
+		}
+	}
+
+	provider, err := NewLocalKeyProvider(activeKeyId, keys)
+	if err != nil {
+		return nil, err
+	}
+	return New(provider), nil
+}
@@ -1,19 +1,16 @@
 package neosync_gcp
 
 import (
-	"bufio"
-	"compress/gzip"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"log/slog"
 	"strings"
 	"sync"
 
 	"cloud.google.com/go/storage"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	bucketrecords "github.com/nucleuscloud/neosync/backend/internal/bucket-records"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/api/iterator"
@@ -144,7 +141,13 @@ func (c *Client) GetRecordStreamFromPrefix(
 			if err != nil {
 				return err
 			}
-			err = streamRecordsFromReader(reader, onRecord)
+			err = bucketrecords.StreamRecords(objAttrs.Name, reader, func(record map[string]any) error {
+				byteRecord, err := bucketrecords.ToByteRecord(record)
+				if err != nil {
+					return fmt.Errorf("unable to convert record from map[string]any to map[string][]byte: %w", err)
+				}
+				return onRecord(byteRecord)
+			})
 			if closeErr := reader.Close(); closeErr != nil {
 				c.logger.Warn(fmt.Sprintf("failed to close reader while streaming records from prefix: %s", closeErr.Error()))
 			}
@@ -190,7 +193,7 @@ func (c *Client) getTableColumnsFromFile(
 		}
 	}()
 
-	firstRecord, err := getFirstRecordFromReader(reader)
+	firstRecord, err := bucketrecords.GetFirstRecord(firstFile.Name, reader)
 	if err != nil {
 		return nil, err
 	}
@@ -225,82 +228,3 @@ func GetWorkflowActivityPrefix(runId string, prefixPath *string) string {
 func GetWorkflowActivityDataPrefix(runId, table string, prefixPath *string) string {
 	return fmt.Sprintf("%s/%s/data", GetWorkflowActivityPrefix(runId, prefixPath), table)
 }
-
-func getFirstRecordFromReader(reader io.Reader) (map[string]any, error) {
-	var result map[string]any
-
-	gzipReader, err := gzip.NewReader(reader)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create gzip reader: %w", err)
-	}
-	defer gzipReader.Close()
-
-	scanner := bufio.NewScanner(gzipReader)
-	if scanner.Scan() {
-		line := scanner.Text()
-		if err := json.Unmarshal([]byte(line), &result); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal JSON: %w", err)
-		}
-	}
-
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("error reading from gzip reader: %w", err)
-	}
-
-	return result, nil
-}
-
-func streamRecordsFromReader(reader io.Reader, onRecord func(record map[string][]byte) error) error {
-	gzipReader, err := gzip.NewReader(reader)
-	if err != nil {
-		return fmt.Errorf("failed to create gzip reader: %w", err)
-	}
-	defer gzipReader.Close()
-
-	scanner := bufio.NewScanner(gzipReader)
-	if scanner.Scan() {
-		line := scanner.Bytes()
-		var result map[string]any
-		if err := json.Unmarshal(line, &result); err != nil {
-			return fmt.Errorf("failed to unmarshal JSON: %w", err)
-		}
-		record, err := valToRecord(result)
-		if err != nil {
-			return fmt.Errorf("unable to convert record from map[string]any to map[string][]byte: %w", err)
-		}
-		err = onRecord(record)
-		if err != nil {
-			return err
-		}
-	}
-	if err := scanner.Err(); err != nil {
-		return fmt.Errorf("error reading from gzip reader: %w", err)
-	}
-
-	return nil
-}
-
-func valToRecord(input map[string]any) (map[string][]byte, error) {
-	output := make(map[string][]byte)
-	for key, value := range input {
-		var byteValue []byte
-		if str, ok := value.(string); ok {
-			// try converting string directly to []byte
-			// prevents quoted strings
-			byteValue = []byte(str)
-		} else {
-			// if not a string use JSON encoding
-			bits, err := json.Marshal(value)
-			if err != nil {
-				return nil, err
-			}
-			if string(bits) == "null" {
-				byteValue = nil
-			} else {
-				byteValue = bits
-			}
-		}
-		output[key] = byteValue
-	}
-	return output, nil
-}
@@ -2,25 +2,44 @@ package neosync_gcp
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"cloud.google.com/go/storage"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/internal/secrets"
+	"google.golang.org/api/option"
 )
 
 type ManagerInterface interface {
-	GetClient(ctx context.Context, logger *slog.Logger) (ClientInterface, error)
+	GetClient(ctx context.Context, config *mgmtv1alpha1.GcpCloudStorageConnectionConfig, logger *slog.Logger) (ClientInterface, error)
 }
 
-type Manager struct{}
+type Manager struct {
+	secretResolver secrets.Resolver
+}
 
 var _ ManagerInterface = &Manager{}
 
-func NewManager() *Manager {
-	return &Manager{}
+// The secret resolver is optional and is used to resolve a service account credentials secret reference
+func NewManager(secretResolver secrets.Resolver) *Manager {
+	return &Manager{secretResolver: secretResolver}
 }
 
-func (m *Manager) GetClient(ctx context.Context, logger *slog.Logger) (ClientInterface, error) {
-	sc, err := storage.NewClient(ctx)
+// Returns a client that authenticates with the connection's service account credentials if they are present,
+// otherwise falls back to the application default credentials
+func (m *Manager) GetClient(ctx context.Context, config *mgmtv1alpha1.GcpCloudStorageConnectionConfig, logger *slog.Logger) (ClientInterface, error) {
+	opts := []option.ClientOption{}
+	if config.GetServiceAccountCredentials() != "" {
+		resolved, err := secrets.ResolveConnectionConfig(ctx, m.secretResolver, &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig{GcpCloudstorageConfig: config},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve gcp credential secrets: %w", err)
+		}
+		opts = append(opts, option.WithCredentialsJSON([]byte(resolved.GetGcpCloudstorageConfig().GetServiceAccountCredentials())))
+	}
+	sc, err := storage.NewClient(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@ import (
 	context "context"
 	slog "log/slog"
 
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,9 +23,9 @@ func (_m *MockManagerInterface) EXPECT() *MockManagerInterface_Expecter {
 	return &MockManagerInterface_Expecter{mock: &_m.Mock}
 }
 
-// GetClient provides a mock function with given fields: ctx, logger
-func (_m *MockManagerInterface) GetClient(ctx context.Context, logger *slog.Logger) (ClientInterface, error) {
-	ret := _m.Called(ctx, logger)
+// GetClient provides a mock function with given fields: ctx, config, logger
+func (_m *MockManagerInterface) GetClient(ctx context.Context, config *mgmtv1alpha1.GcpCloudStorageConnectionConfig, logger *slog.Logger) (ClientInterface, error) {
+	ret := _m.Called(ctx, config, logger)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetClient")
@@ -32,19 +33,19 @@ func (_m *MockManagerInterface) GetClient(ctx context.Context, logger *slog.Logg
 
 	var r0 ClientInterface
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *slog.Logger) (ClientInterface, error)); ok {
-		return rf(ctx, logger)
+	if rf, ok := ret.Get(0).(func(context.Context, *mgmtv1alpha1.GcpCloudStorageConnectionConfig, *slog.Logger) (ClientInterface, error)); ok {
+		return rf(ctx, config, logger)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *slog.Logger) ClientInterface); ok {
-		r0 = rf(ctx, logger)
+	if rf, ok := ret.Get(0).(func(context.Context, *mgmtv1alpha1.GcpCloudStorageConnectionConfig, *slog.Logger) ClientInterface); ok {
+		r0 = rf(ctx, config, logger)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(ClientInterface)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *slog.Logger) error); ok {
-		r1 = rf(ctx, logger)
+	if rf, ok := ret.Get(1).(func(context.Context, *mgmtv1alpha1.GcpCloudStorageConnectionConfig, *slog.Logger) error); ok {
+		r1 = rf(ctx, config, logger)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,14 +60,15 @@ type MockManagerInterface_GetClient_Call struct {
 
 // GetClient is a helper method to define mock.On call
 //   - ctx context.Context
+//   - config *mgmtv1alpha1.GcpCloudStorageConnectionConfig
 //   - logger *slog.Logger
-func (_e *MockManagerInterface_Expecter) GetClient(ctx interface{}, logger interface{}) *MockManagerInterface_GetClient_Call {
-	return &MockManagerInterface_GetClient_Call{Call: _e.mock.On("GetClient", ctx, logger)}
+func (_e *MockManagerInterface_Expecter) GetClient(ctx interface{}, config interface{}, logger interface{}) *MockManagerInterface_GetClient_Call {
+	return &MockManagerInterface_GetClient_Call{Call: _e.mock.On("GetClient", ctx, config, logger)}
 }
 
-func (_c *MockManagerInterface_GetClient_Call) Run(run func(ctx context.Context, logger *slog.Logger)) *MockManagerInterface_GetClient_Call {
+func (_c *MockManagerInterface_GetClient_Call) Run(run func(ctx context.Context, config *mgmtv1alpha1.GcpCloudStorageConnectionConfig, logger *slog.Logger)) *MockManagerInterface_GetClient_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*slog.Logger))
+		run(args[0].(context.Context), args[1].(*mgmtv1alpha1.GcpCloudStorageConnectionConfig), args[2].(*slog.Logger))
 	})
 	return _c
 }
@@ -76,7 +78,7 @@ func (_c *MockManagerInterface_GetClient_Call) Return(_a0 ClientInterface, _a1 e
 	return _c
 }
 
-func (_c *MockManagerInterface_GetClient_Call) RunAndReturn(run func(context.Context, *slog.Logger) (ClientInterface, error)) *MockManagerInterface_GetClient_Call {
+func (_c *MockManagerInterface_GetClient_Call) RunAndReturn(run func(context.Context, *mgmtv1alpha1.GcpCloudStorageConnectionConfig, *slog.Logger) (ClientInterface, error)) *MockManagerInterface_GetClient_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -13,12 +13,24 @@ type CreateAccountApiKeyRequest struct {
 	AccountUuid       pgtype.UUID
 	CreatedByUserUuid pgtype.UUID
 	ExpiresAt         pgtype.Timestamp
+	Scopes            []string
+	JobIds            []pgtype.UUID
 }
 
 func (d *NeosyncDb) CreateAccountApikey(
 	ctx context.Context,
 	req *CreateAccountApiKeyRequest,
 ) (*db_queries.NeosyncApiAccountApiKey, error) {
+	// the columns are not nullable, so nil slices must not be sent as null
+	scopes := req.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	jobIds := req.JobIds
+	if jobIds == nil {
+		jobIds = []pgtype.UUID{}
+	}
+
 	var createdApiKey *db_queries.NeosyncApiAccountApiKey
 	if err := d.WithTx(ctx, nil, func(tx BaseDBTX) error {
 		// create machine user
@@ -37,6 +49,8 @@ func (d *NeosyncDb) CreateAccountApikey(
 				CreatedByID: req.CreatedByUserUuid,
 				UpdatedByID: req.CreatedByUserUuid,
 				UserID:      user.ID,
+				Scopes:      scopes,
+				JobIds:      jobIds,
 			},
 		)
 		if err != nil {
@@ -0,0 +1,88 @@
+package neosyncdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nucleuscloud/neosync/backend/internal/envelope"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+// Encrypts the connection config so that it can be stored at rest.
+// The stored config retains the connection type so that it can be determined without decrypting.
+// The config is returned as-is if encryption has not been configured.
+func EncryptConnectionConfig(
+	ctx context.Context,
+	encryptor *envelope.Encryptor,
+	accountId pgtype.UUID,
+	cc *pg_models.ConnectionConfig,
+) (*pg_models.ConnectionConfig, error) {
+	if encryptor == nil || cc == nil {
+		return cc, nil
+	}
+	if cc.Encrypted != nil {
+		return nil, errors.New("connection config has already been encrypted")
+	}
+	plaintext, err := json.Marshal(cc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal connection config: %w", err)
+	}
+	// the config is bound to the account so that it can not be moved to a different account
+	env, err := encryptor.Encrypt(ctx, plaintext, accountId.Bytes[:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to encrypt connection config: %w", err)
+	}
+	encrypted := cc.TypeOnly()
+	encrypted.Encrypted = &pg_models.EncryptedConnectionConfig{
+		KeyId:            env.KeyId,
+		EncryptedDataKey: env.EncryptedDataKey,
+		Ciphertext:       env.Ciphertext,
+	}
+	return encrypted, nil
+}
+
+// Decrypts a stored connection config. Configs that were stored prior to encryption being enabled are returned as-is.
+func DecryptConnectionConfig(
+	ctx context.Context,
+	encryptor *envelope.Encryptor,
+	accountId pgtype.UUID,
+	cc *pg_models.ConnectionConfig,
+) (*pg_models.ConnectionConfig, error) {
+	if cc == nil || cc.Encrypted == nil {
+		return cc, nil
+	}
+	if encryptor == nil {
+		return nil, errors.New("connection config is encrypted but connection encryption has not been configured")
+	}
+	plaintext, err := encryptor.Decrypt(ctx, toEnvelope(cc.Encrypted), accountId.Bytes[:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt connection config: %w", err)
+	}
+	var decrypted pg_models.ConnectionConfig
+	if err := json.Unmarshal(plaintext, &decrypted); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal connection config: %w", err)
+	}
+	return &decrypted, nil
+}
+
+// Returns true if the stored connection config should be rewritten to be encrypted with the active master key
+func ConnectionConfigNeedsRotation(encryptor *envelope.Encryptor, cc *pg_models.ConnectionConfig) bool {
+	if encryptor == nil || cc == nil {
+		return false
+	}
+	if cc.Encrypted == nil {
+		return true
+	}
+	return encryptor.NeedsRotation(toEnvelope(cc.Encrypted))
+}
+
+func toEnvelope(encrypted *pg_models.EncryptedConnectionConfig) *envelope.Envelope {
+	return &envelope.Envelope{
+		KeyId:            encrypted.KeyId,
+		EncryptedDataKey: encrypted.EncryptedDataKey,
+		Ciphertext:       encrypted.Ciphertext,
+	}
+}
@@ -7,3 +7,24 @@ const (
 	AccountType_Team
 	AccountType_Enterprise
 )
+
+// Mirrors the values of mgmtv1alpha1.AccountRole
+type AccountRole int16
+
+const (
+	AccountRole_Unspecified AccountRole = iota
+	AccountRole_Admin
+	AccountRole_JobDeveloper
+	AccountRole_JobExecutor
+	AccountRole_Viewer
+)
+
+// Mirrors the values of mgmtv1alpha1.AuditActorType
+type AuditActorType int16
+
+const (
+	AuditActorType_Unspecified AuditActorType = iota
+	AuditActorType_User
+	AuditActorType_ApiKey
+	AuditActorType_Anonymous
+)
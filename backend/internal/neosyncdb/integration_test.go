@@ -318,15 +318,15 @@ func (s *IntegrationTestSuite) Test_CreateTeamAccountInvite() {
 	requireNoErrResp(t, account, err)
 
 	t.Run("new invite", func(t *testing.T) {
-		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo2@example.com", getFutureTs(t, 1*time.Hour))
+		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo2@example.com", getFutureTs(t, 1*time.Hour), AccountRole_Admin)
 		requireNoErrResp(t, invite, err)
 	})
 
 	t.Run("expire old invites", func(t *testing.T) {
-		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo2@example.com", getFutureTs(t, 48*time.Hour))
+		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo2@example.com", getFutureTs(t, 48*time.Hour), AccountRole_Admin)
 		requireNoErrResp(t, invite, err)
 
-		invite2, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo2@example.com", getFutureTs(t, 48*time.Hour))
+		invite2, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo2@example.com", getFutureTs(t, 48*time.Hour), AccountRole_Admin)
 		requireNoErrResp(t, invite2, err)
 		// Add time here as the expired invites as updated to CURRENT_TIMESTAMP, so this reduces flakiness
 		now := time.Now().Add(5 * time.Second)
@@ -340,7 +340,7 @@ func (s *IntegrationTestSuite) Test_CreateTeamAccountInvite() {
 		account, err := s.db.SetPersonalAccount(s.ctx, user.ID, nil)
 		requireNoErrResp(t, account, err)
 
-		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo@example.com", getFutureTs(t, 1*time.Hour))
+		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo@example.com", getFutureTs(t, 1*time.Hour), AccountRole_Admin)
 		requireErrResp(t, invite, err)
 		forbiddin := nucleuserrors.NewForbidden("")
 		require.ErrorAs(t, err, &forbiddin)
@@ -355,7 +355,7 @@ func (s *IntegrationTestSuite) Test_ValidateInviteAddUserToAccount() {
 	requireNoErrResp(t, account, err)
 
 	t.Run("accept invite", func(t *testing.T) {
-		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo2@example.com", getFutureTs(t, 24*time.Hour))
+		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo2@example.com", getFutureTs(t, 24*time.Hour), AccountRole_Admin)
 		requireNoErrResp(t, invite, err)
 
 		user2 := s.setUser(t, s.ctx, "foo2")
@@ -365,7 +365,7 @@ func (s *IntegrationTestSuite) Test_ValidateInviteAddUserToAccount() {
 	})
 
 	t.Run("expired invite", func(t *testing.T) {
-		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo3@example.com", getFutureTs(t, -1*time.Hour))
+		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo3@example.com", getFutureTs(t, -1*time.Hour), AccountRole_Admin)
 		requireNoErrResp(t, invite, err)
 
 		user3 := s.setUser(t, s.ctx, "foo3")
@@ -378,7 +378,7 @@ func (s *IntegrationTestSuite) Test_ValidateInviteAddUserToAccount() {
 	})
 
 	t.Run("incorrect email", func(t *testing.T) {
-		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo4@example.com", getFutureTs(t, -1*time.Hour))
+		invite, err := s.db.CreateTeamAccountInvite(s.ctx, account.ID, user.ID, "foo4@example.com", getFutureTs(t, -1*time.Hour), AccountRole_Admin)
 		requireNoErrResp(t, invite, err)
 
 		user4 := s.setUser(t, s.ctx, "foo3")
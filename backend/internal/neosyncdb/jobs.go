@@ -79,11 +79,22 @@ func (d *NeosyncDb) SetSourceSubsets(
 			}
 			dbjob.ConnectionOptions.MssqlOptions.Schemas = pg_models.FromDtoMssqlSourceSchemaOptions(s.MssqlSubset.GetMssqlSchemas())
 			dbjob.ConnectionOptions.MssqlOptions.SubsetByForeignKeyConstraints = subsetByForeignKeyConstraints
+		case *mgmtv1alpha1.JobSourceSqlSubetSchemas_SqliteSubset:
+			if dbjob.ConnectionOptions.SqliteOptions == nil {
+				dbjob.ConnectionOptions.SqliteOptions = &pg_models.SqliteSourceOptions{}
+			}
+			dbjob.ConnectionOptions.SqliteOptions.Schemas = pg_models.FromDtoSqliteSourceSchemaOptions(s.SqliteSubset.GetSqliteSchemas())
+			dbjob.ConnectionOptions.SqliteOptions.SubsetByForeignKeyConstraints = subsetByForeignKeyConstraints
 		case *mgmtv1alpha1.JobSourceSqlSubetSchemas_DynamodbSubset:
 			if dbjob.ConnectionOptions.DynamoDBOptions == nil {
 				dbjob.ConnectionOptions.DynamoDBOptions = &pg_models.DynamoDBSourceOptions{}
 			}
 			dbjob.ConnectionOptions.DynamoDBOptions.Tables = pg_models.FromDtoDynamoDBSourceTableOptions(s.DynamodbSubset.GetTables())
+		case *mgmtv1alpha1.JobSourceSqlSubetSchemas_MongodbSubset:
+			if dbjob.ConnectionOptions.MongoDbOptions == nil {
+				dbjob.ConnectionOptions.MongoDbOptions = &pg_models.MongoDbSourceOptions{}
+			}
+			dbjob.ConnectionOptions.MongoDbOptions.Collections = pg_models.FromDtoMongoDbSourceCollectionOptions(s.MongodbSubset.GetCollections())
 		default:
 			return fmt.Errorf("this connection config is not currently supported: %T", s)
 		}
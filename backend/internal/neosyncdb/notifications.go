@@ -0,0 +1,81 @@
+package neosyncdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nucleuscloud/neosync/backend/internal/envelope"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+)
+
+// Encrypts a notification rule's signing secret so that it can be stored at rest.
+// The secret is stored as plaintext if encryption has not been configured.
+func EncryptSigningSecret(
+	ctx context.Context,
+	encryptor *envelope.Encryptor,
+	accountId pgtype.UUID,
+	secret string,
+) (*pg_models.NotificationSigningSecret, error) {
+	if encryptor == nil {
+		return &pg_models.NotificationSigningSecret{Value: &secret}, nil
+	}
+	// the secret is bound to the account so that it can not be moved to a different account
+	env, err := encryptor.Encrypt(ctx, []byte(secret), accountId.Bytes[:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to encrypt signing secret: %w", err)
+	}
+	return &pg_models.NotificationSigningSecret{
+		Encrypted: &pg_models.EncryptedSecret{
+			KeyId:            env.KeyId,
+			EncryptedDataKey: env.EncryptedDataKey,
+			Ciphertext:       env.Ciphertext,
+		},
+	}, nil
+}
+
+// Decrypts a stored signing secret. Secrets that were stored prior to encryption being enabled are returned as-is.
+func DecryptSigningSecret(
+	ctx context.Context,
+	encryptor *envelope.Encryptor,
+	accountId pgtype.UUID,
+	secret *pg_models.NotificationSigningSecret,
+) (string, error) {
+	if secret == nil {
+		return "", errors.New("signing secret was nil")
+	}
+	if secret.Encrypted == nil {
+		if secret.Value == nil {
+			return "", errors.New("signing secret has no value")
+		}
+		return *secret.Value, nil
+	}
+	if encryptor == nil {
+		return "", errors.New("signing secret is encrypted but connection encryption has not been configured")
+	}
+	plaintext, err := encryptor.Decrypt(ctx, toSecretEnvelope(secret.Encrypted), accountId.Bytes[:])
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt signing secret: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// Returns true if the stored signing secret should be rewritten to be encrypted with the active master key
+func SigningSecretNeedsRotation(encryptor *envelope.Encryptor, secret *pg_models.NotificationSigningSecret) bool {
+	if encryptor == nil || secret == nil {
+		return false
+	}
+	if secret.Encrypted == nil {
+		return true
+	}
+	return encryptor.NeedsRotation(toSecretEnvelope(secret.Encrypted))
+}
+
+func toSecretEnvelope(encrypted *pg_models.EncryptedSecret) *envelope.Envelope {
+	return &envelope.Envelope{
+		KeyId:            encrypted.KeyId,
+		EncryptedDataKey: encrypted.EncryptedDataKey,
+		Ciphertext:       encrypted.Ciphertext,
+	}
+}
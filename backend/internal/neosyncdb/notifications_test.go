@@ -0,0 +1,67 @@
+package neosyncdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EncryptSigningSecret(t *testing.T) {
+	encryptor := newTestEncryptor(t, "key1")
+	accountId, err := ToUuid(uuid.NewString())
+	require.NoError(t, err)
+
+	encrypted, err := EncryptSigningSecret(context.Background(), encryptor, accountId, "whsec_hunter2")
+	require.NoError(t, err)
+	require.NotNil(t, encrypted.Encrypted)
+	require.Nil(t, encrypted.Value)
+
+	stored, err := json.Marshal(encrypted)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(stored, []byte("hunter2")))
+
+	decrypted, err := DecryptSigningSecret(context.Background(), encryptor, accountId, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, "whsec_hunter2", decrypted)
+
+	otherAccountId, err := ToUuid(uuid.NewString())
+	require.NoError(t, err)
+	_, err = DecryptSigningSecret(context.Background(), encryptor, otherAccountId, encrypted)
+	require.Error(t, err)
+
+	_, err = DecryptSigningSecret(context.Background(), nil, accountId, encrypted)
+	require.Error(t, err)
+}
+
+func Test_EncryptSigningSecret_NotConfigured(t *testing.T) {
+	stored, err := EncryptSigningSecret(context.Background(), nil, pgtype.UUID{}, "whsec_test")
+	require.NoError(t, err)
+	require.Nil(t, stored.Encrypted)
+	require.Equal(t, "whsec_test", *stored.Value)
+
+	decrypted, err := DecryptSigningSecret(context.Background(), nil, pgtype.UUID{}, stored)
+	require.NoError(t, err)
+	require.Equal(t, "whsec_test", decrypted)
+}
+
+func Test_SigningSecretNeedsRotation(t *testing.T) {
+	accountId, err := ToUuid(uuid.NewString())
+	require.NoError(t, err)
+	plaintext, err := EncryptSigningSecret(context.Background(), nil, accountId, "whsec_test")
+	require.NoError(t, err)
+
+	require.False(t, SigningSecretNeedsRotation(nil, plaintext))
+
+	encryptor := newTestEncryptor(t, "key1")
+	require.True(t, SigningSecretNeedsRotation(encryptor, plaintext), "plaintext secrets must be encrypted")
+
+	encrypted, err := EncryptSigningSecret(context.Background(), encryptor, accountId, "whsec_test")
+	require.NoError(t, err)
+	require.False(t, SigningSecretNeedsRotation(encryptor, encrypted))
+	require.True(t, SigningSecretNeedsRotation(newTestEncryptor(t, "key2"), encrypted))
+}
@@ -115,6 +115,7 @@ func upsertPersonalAccount(ctx context.Context, q db_queries.Querier, dbtx BaseD
 	err = q.CreateAccountUserAssociation(ctx, dbtx, db_queries.CreateAccountUserAssociationParams{
 		AccountID: account.ID,
 		UserID:    req.UserId,
+		Role:      int16(AccountRole_Admin),
 	})
 	if err != nil {
 		return nil, err
@@ -160,6 +161,7 @@ func (d *NeosyncDb) CreateTeamAccount(
 		err = d.Q.CreateAccountUserAssociation(ctx, dbtx, db_queries.CreateAccountUserAssociationParams{
 			AccountID: account.ID,
 			UserID:    userId,
+			Role:      int16(AccountRole_Admin),
 		})
 		if err != nil {
 			return fmt.Errorf("unable to associate user to newly created team account: %w", err)
@@ -315,6 +317,7 @@ func (d *NeosyncDb) CreateTeamAccountInvite(
 	userId pgtype.UUID,
 	email string,
 	expiresAt pgtype.Timestamp,
+	role AccountRole,
 ) (*db_queries.NeosyncApiAccountInvite, error) {
 	var accountInvite *db_queries.NeosyncApiAccountInvite
 	if err := d.WithTx(ctx, nil, func(dbtx BaseDBTX) error {
@@ -341,6 +344,7 @@ func (d *NeosyncDb) CreateTeamAccountInvite(
 			SenderUserID: userId,
 			Email:        email,
 			ExpiresAt:    expiresAt,
+			Role:         int16(role),
 		})
 		if err != nil {
 			return err
@@ -396,6 +400,7 @@ func (d *NeosyncDb) ValidateInviteAddUserToAccount(
 			err = d.Q.CreateAccountUserAssociation(ctx, dbtx, db_queries.CreateAccountUserAssociationParams{
 				AccountID: invite.AccountID,
 				UserID:    userId,
+				Role:      invite.Role,
 			})
 			if err != nil {
 				return err
@@ -409,3 +414,88 @@ func (d *NeosyncDb) ValidateInviteAddUserToAccount(
 	}
 	return accountId, nil
 }
+
+// Updates the role of an account member. Returns a bad request if the change would leave the account without an admin
+func (d *NeosyncDb) SetAccountUserRole(
+	ctx context.Context,
+	accountId pgtype.UUID,
+	userId pgtype.UUID,
+	role AccountRole,
+) (*db_queries.NeosyncApiAccountUserAssociation, error) {
+	var association *db_queries.NeosyncApiAccountUserAssociation
+	if err := d.WithTx(ctx, &pgx.TxOptions{IsoLevel: pgx.Serializable}, func(dbtx BaseDBTX) error {
+		existing, err := d.Q.GetAccountUserAssociation(ctx, dbtx, db_queries.GetAccountUserAssociationParams{
+			AccountId: accountId,
+			UserId:    userId,
+		})
+		if err != nil && !IsNoRows(err) {
+			return err
+		} else if err != nil && IsNoRows(err) {
+			return nucleuserrors.NewNotFound("user is not a member of the account")
+		}
+
+		if existing.Role == int16(AccountRole_Admin) && role != AccountRole_Admin {
+			if err := d.verifyAnotherAdminExists(ctx, dbtx, accountId); err != nil {
+				return err
+			}
+		}
+
+		updated, err := d.Q.UpdateAccountUserRole(ctx, dbtx, db_queries.UpdateAccountUserRoleParams{
+			Role:      int16(role),
+			AccountId: accountId,
+			UserId:    userId,
+		})
+		if err != nil {
+			return err
+		}
+		association = &updated
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return association, nil
+}
+
+// Removes a member from the account. Returns a bad request if the member is the last admin of the account
+func (d *NeosyncDb) RemoveAccountUser(
+	ctx context.Context,
+	accountId pgtype.UUID,
+	userId pgtype.UUID,
+) error {
+	return d.WithTx(ctx, &pgx.TxOptions{IsoLevel: pgx.Serializable}, func(dbtx BaseDBTX) error {
+		existing, err := d.Q.GetAccountUserAssociation(ctx, dbtx, db_queries.GetAccountUserAssociationParams{
+			AccountId: accountId,
+			UserId:    userId,
+		})
+		if err != nil && !IsNoRows(err) {
+			return err
+		} else if err != nil && IsNoRows(err) {
+			return nil
+		}
+
+		if existing.Role == int16(AccountRole_Admin) {
+			if err := d.verifyAnotherAdminExists(ctx, dbtx, accountId); err != nil {
+				return err
+			}
+		}
+
+		return d.Q.RemoveAccountUser(ctx, dbtx, db_queries.RemoveAccountUserParams{
+			AccountId: accountId,
+			UserId:    userId,
+		})
+	})
+}
+
+func (d *NeosyncDb) verifyAnotherAdminExists(ctx context.Context, dbtx BaseDBTX, accountId pgtype.UUID) error {
+	adminCount, err := d.Q.GetAccountUserCountByRole(ctx, dbtx, db_queries.GetAccountUserCountByRoleParams{
+		AccountId: accountId,
+		Role:      int16(AccountRole_Admin),
+	})
+	if err != nil {
+		return err
+	}
+	if adminCount <= 1 {
+		return nucleuserrors.NewBadRequest("an account must have at least one admin")
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package rbac
+
+import (
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+)
+
+// The permission required to call each procedure.
+// Every procedure must be listed here, procedures without an entry are denied.
+var procedurePermissions = map[string]Permission{
+	mgmtv1alpha1connect.AnonymizationServiceAnonymizeManyProcedure:   PermissionAccountRead,
+	mgmtv1alpha1connect.AnonymizationServiceAnonymizeSingleProcedure: PermissionAccountRead,
+	mgmtv1alpha1connect.AnonymizationServiceDecryptFpeProcedure:      PermissionDataDecrypt,
+
+	mgmtv1alpha1connect.ApiKeyServiceGetAccountApiKeysProcedure:       PermissionAccountManage,
+	mgmtv1alpha1connect.ApiKeyServiceGetAccountApiKeyProcedure:        PermissionAccountManage,
+	mgmtv1alpha1connect.ApiKeyServiceCreateAccountApiKeyProcedure:     PermissionAccountManage,
+	mgmtv1alpha1connect.ApiKeyServiceRegenerateAccountApiKeyProcedure: PermissionAccountManage,
+	mgmtv1alpha1connect.ApiKeyServiceDeleteAccountApiKeyProcedure:     PermissionAccountManage,
+
+	mgmtv1alpha1connect.AuditServiceListAuditEventsProcedure: PermissionAccountManage,
+
+	mgmtv1alpha1connect.AuthServiceLoginCliProcedure:        PermissionNone,
+	mgmtv1alpha1connect.AuthServiceRefreshCliProcedure:      PermissionNone,
+	mgmtv1alpha1connect.AuthServiceCheckTokenProcedure:      PermissionNone,
+	mgmtv1alpha1connect.AuthServiceGetCliIssuerProcedure:    PermissionNone,
+	mgmtv1alpha1connect.AuthServiceGetAuthorizeUrlProcedure: PermissionNone,
+	mgmtv1alpha1connect.AuthServiceGetAuthStatusProcedure:   PermissionNone,
+
+	mgmtv1alpha1connect.ConnectionServiceGetConnectionsProcedure:            PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure:             PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionServiceCreateConnectionProcedure:          PermissionConnectionsWrite,
+	mgmtv1alpha1connect.ConnectionServiceUpdateConnectionProcedure:          PermissionConnectionsWrite,
+	mgmtv1alpha1connect.ConnectionServiceDeleteConnectionProcedure:          PermissionConnectionsWrite,
+	mgmtv1alpha1connect.ConnectionServiceIsConnectionNameAvailableProcedure: PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionServiceCheckConnectionConfigProcedure:     PermissionConnectionsWrite,
+	mgmtv1alpha1connect.ConnectionServiceCheckConnectionConfigByIdProcedure: PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionServiceCheckSqlQueryProcedure:             PermissionJobsWrite,
+
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionDataStreamProcedure:         PermissionJobsWrite,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaProcedure:             PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaMapProcedure:          PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionSchemaMapsProcedure:         PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionTableConstraintsProcedure:   PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionForeignConstraintsProcedure: PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionPrimaryConstraintsProcedure: PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionInitStatementsProcedure:     PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionDataServiceGetConnectionUniqueConstraintsProcedure:  PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionDataServiceGetAiGeneratedDataProcedure:              PermissionJobsWrite,
+	mgmtv1alpha1connect.ConnectionDataServiceGetTableRowCountProcedure:                PermissionAccountRead,
+	mgmtv1alpha1connect.ConnectionDataServiceScanConnectionForPiiProcedure:            PermissionJobsWrite,
+
+	mgmtv1alpha1connect.JobServiceGetJobsProcedure:                          PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceGetJobProcedure:                           PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceCreateJobProcedure:                        PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServiceDeleteJobProcedure:                        PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServiceIsJobNameAvailableProcedure:               PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceUpdateJobScheduleProcedure:                PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServiceUpdateJobSourceConnectionProcedure:        PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServiceSetJobSourceSqlConnectionSubsetsProcedure: PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServiceUpdateJobDestinationConnectionProcedure:   PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServiceDeleteJobDestinationConnectionProcedure:   PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServiceCreateJobDestinationConnectionsProcedure:  PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServicePauseJobProcedure:                         PermissionJobsExecute,
+	mgmtv1alpha1connect.JobServiceGetJobRecentRunsProcedure:                 PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceGetJobNextRunsProcedure:                   PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceGetJobStatusProcedure:                     PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceGetJobStatusesProcedure:                   PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceGetJobRunsProcedure:                       PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceGetJobRunEventsProcedure:                  PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceGetJobRunProcedure:                        PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceDeleteJobRunProcedure:                     PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServiceCreateJobRunProcedure:                     PermissionJobsExecute,
+	mgmtv1alpha1connect.JobServiceCancelJobRunProcedure:                     PermissionJobsExecute,
+	mgmtv1alpha1connect.JobServiceTerminateJobRunProcedure:                  PermissionJobsExecute,
+	mgmtv1alpha1connect.JobServiceGetJobRunLogsStreamProcedure:              PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceSetJobWorkflowOptionsProcedure:            PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServiceSetJobSyncOptionsProcedure:                PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServiceValidateJobMappingsProcedure:              PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceGetJobPlanProcedure:                       PermissionAccountRead,
+	mgmtv1alpha1connect.JobServiceGetRunContextProcedure:                    PermissionConnectionCredentialsRead,
+	mgmtv1alpha1connect.JobServiceSetRunContextProcedure:                    PermissionJobsWrite,
+	mgmtv1alpha1connect.JobServiceSetRunContextsProcedure:                   PermissionJobsWrite,
+
+	mgmtv1alpha1connect.MetricsServiceGetDailyMetricCountProcedure: PermissionAccountRead,
+	mgmtv1alpha1connect.MetricsServiceGetMetricCountProcedure:      PermissionAccountRead,
+
+	mgmtv1alpha1connect.NotificationServiceCreateNotificationRuleProcedure:    PermissionJobsWrite,
+	mgmtv1alpha1connect.NotificationServiceGetNotificationRulesProcedure:      PermissionAccountRead,
+	mgmtv1alpha1connect.NotificationServiceDeleteNotificationRuleProcedure:    PermissionJobsWrite,
+	mgmtv1alpha1connect.NotificationServiceGetNotificationDeliveriesProcedure: PermissionAccountRead,
+	mgmtv1alpha1connect.NotificationServicePublishJobRunEventProcedure:        PermissionJobsExecute,
+
+	mgmtv1alpha1connect.PipelineServiceCreatePipelineProcedure:    PermissionJobsWrite,
+	mgmtv1alpha1connect.PipelineServiceGetPipelinesProcedure:      PermissionAccountRead,
+	mgmtv1alpha1connect.PipelineServiceGetPipelineProcedure:       PermissionAccountRead,
+	mgmtv1alpha1connect.PipelineServiceUpdatePipelineProcedure:    PermissionJobsWrite,
+	mgmtv1alpha1connect.PipelineServiceDeletePipelineProcedure:    PermissionJobsWrite,
+	mgmtv1alpha1connect.PipelineServiceCreatePipelineRunProcedure: PermissionJobsExecute,
+	mgmtv1alpha1connect.PipelineServiceGetPipelineRunsProcedure:   PermissionAccountRead,
+	mgmtv1alpha1connect.PipelineServiceCancelPipelineRunProcedure: PermissionJobsExecute,
+
+	mgmtv1alpha1connect.TransformersServiceGetSystemTransformersProcedure:         PermissionNone,
+	mgmtv1alpha1connect.TransformersServiceGetSystemTransformerBySourceProcedure:  PermissionNone,
+	mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformersProcedure:    PermissionAccountRead,
+	mgmtv1alpha1connect.TransformersServiceGetUserDefinedTransformerByIdProcedure: PermissionAccountRead,
+	mgmtv1alpha1connect.TransformersServiceCreateUserDefinedTransformerProcedure:  PermissionJobsWrite,
+	mgmtv1alpha1connect.TransformersServiceDeleteUserDefinedTransformerProcedure:  PermissionJobsWrite,
+	mgmtv1alpha1connect.TransformersServiceUpdateUserDefinedTransformerProcedure:  PermissionJobsWrite,
+	mgmtv1alpha1connect.TransformersServiceIsTransformerNameAvailableProcedure:    PermissionAccountRead,
+	mgmtv1alpha1connect.TransformersServiceValidateUserJavascriptCodeProcedure:    PermissionNone,
+	mgmtv1alpha1connect.TransformersServiceValidateUserRegexCodeProcedure:         PermissionNone,
+
+	mgmtv1alpha1connect.UserAccountServiceGetUserProcedure:                          PermissionNone,
+	mgmtv1alpha1connect.UserAccountServiceSetUserProcedure:                          PermissionNone,
+	mgmtv1alpha1connect.UserAccountServiceGetUserAccountsProcedure:                  PermissionNone,
+	mgmtv1alpha1connect.UserAccountServiceSetPersonalAccountProcedure:               PermissionNone,
+	mgmtv1alpha1connect.UserAccountServiceConvertPersonalToTeamAccountProcedure:     PermissionAccountManage,
+	mgmtv1alpha1connect.UserAccountServiceCreateTeamAccountProcedure:                PermissionNone,
+	mgmtv1alpha1connect.UserAccountServiceIsUserInAccountProcedure:                  PermissionNone,
+	mgmtv1alpha1connect.UserAccountServiceGetAccountTemporalConfigProcedure:         PermissionAccountRead,
+	mgmtv1alpha1connect.UserAccountServiceSetAccountTemporalConfigProcedure:         PermissionAccountManage,
+	mgmtv1alpha1connect.UserAccountServiceGetTeamAccountMembersProcedure:            PermissionAccountRead,
+	mgmtv1alpha1connect.UserAccountServiceRemoveTeamAccountMemberProcedure:          PermissionAccountManage,
+	mgmtv1alpha1connect.UserAccountServiceSetTeamAccountMemberRoleProcedure:         PermissionAccountManage,
+	mgmtv1alpha1connect.UserAccountServiceGetAccountRoleProcedure:                   PermissionAccountRead,
+	mgmtv1alpha1connect.UserAccountServiceInviteUserToTeamAccountProcedure:          PermissionAccountManage,
+	mgmtv1alpha1connect.UserAccountServiceGetTeamAccountInvitesProcedure:            PermissionAccountManage,
+	mgmtv1alpha1connect.UserAccountServiceRemoveTeamAccountInviteProcedure:          PermissionAccountManage,
+	mgmtv1alpha1connect.UserAccountServiceAcceptTeamAccountInviteProcedure:          PermissionNone,
+	mgmtv1alpha1connect.UserAccountServiceGetSystemInformationProcedure:             PermissionNone,
+	mgmtv1alpha1connect.UserAccountServiceGetAccountOnboardingConfigProcedure:       PermissionAccountRead,
+	mgmtv1alpha1connect.UserAccountServiceSetAccountOnboardingConfigProcedure:       PermissionAccountManage,
+	mgmtv1alpha1connect.UserAccountServiceGetAccountStatusProcedure:                 PermissionAccountRead,
+	mgmtv1alpha1connect.UserAccountServiceIsAccountStatusValidProcedure:             PermissionAccountRead,
+	mgmtv1alpha1connect.UserAccountServiceGetAccountBillingCheckoutSessionProcedure: PermissionAccountManage,
+	mgmtv1alpha1connect.UserAccountServiceGetAccountBillingPortalSessionProcedure:   PermissionAccountManage,
+	mgmtv1alpha1connect.UserAccountServiceGetBillingAccountsProcedure:               PermissionNone,
+	mgmtv1alpha1connect.UserAccountServiceSetBillingMeterEventProcedure:             PermissionNone,
+}
+
+// Returns the permission required to call the procedure, false if the procedure has no permission defined
+func GetProcedurePermission(procedure string) (Permission, bool) {
+	permission, ok := procedurePermissions[procedure]
+	return permission, ok
+}
@@ -0,0 +1,163 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+)
+
+type Permission string
+
+const (
+	// Any authenticated caller. The handler is responsible for any further checks
+	PermissionNone Permission = "none"
+	// View jobs, runs, transformers, connections (without credentials), and connection schemas
+	PermissionAccountRead Permission = "account:read"
+	// Trigger, cancel, and terminate job runs
+	PermissionJobsExecute Permission = "jobs:execute"
+	// Create, update, and delete jobs and transformers, and read data from connections
+	PermissionJobsWrite Permission = "jobs:write"
+	// Create, update, and delete connections
+	PermissionConnectionsWrite Permission = "connections:write"
+	// View the credentials of a connection
+	PermissionConnectionCredentialsRead Permission = "connections:credentials:read"
+	// Reverse anonymized values
+	PermissionDataDecrypt Permission = "data:decrypt"
+	// Manage members, invites, api keys, billing, and account configuration
+	PermissionAccountManage Permission = "account:manage"
+)
+
+var rolePermissions = map[mgmtv1alpha1.AccountRole]map[Permission]struct{}{
+	mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN: toSet(
+		PermissionNone,
+		PermissionAccountRead,
+		PermissionJobsExecute,
+		PermissionJobsWrite,
+		PermissionConnectionsWrite,
+		PermissionConnectionCredentialsRead,
+		PermissionDataDecrypt,
+		PermissionAccountManage,
+	),
+	mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_DEVELOPER: toSet(
+		PermissionNone,
+		PermissionAccountRead,
+		PermissionJobsExecute,
+		PermissionJobsWrite,
+	),
+	mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EXECUTOR: toSet(
+		PermissionNone,
+		PermissionAccountRead,
+		PermissionJobsExecute,
+	),
+	mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER: toSet(
+		PermissionNone,
+		PermissionAccountRead,
+	),
+}
+
+func toSet(permissions ...Permission) map[Permission]struct{} {
+	set := make(map[Permission]struct{}, len(permissions))
+	for _, permission := range permissions {
+		set[permission] = struct{}{}
+	}
+	return set
+}
+
+// Returns true if the role has been granted the permission
+func HasPermission(role mgmtv1alpha1.AccountRole, permission Permission) bool {
+	permissions, ok := rolePermissions[role]
+	if !ok {
+		return false
+	}
+	_, ok = permissions[permission]
+	return ok
+}
+
+type authorizationContextKey struct{}
+
+// Holds the permission required by the procedure being served and the roles that the caller was found to have.
+// The required permission is checked each time the caller's membership of an account is verified.
+type Authorization struct {
+	permission Permission
+
+	mu    sync.RWMutex
+	roles map[string]mgmtv1alpha1.AccountRole
+}
+
+func WithAuthorization(ctx context.Context, permission Permission) (context.Context, *Authorization) {
+	authz := &Authorization{permission: permission, roles: map[string]mgmtv1alpha1.AccountRole{}}
+	return context.WithValue(ctx, authorizationContextKey{}, authz), authz
+}
+
+func GetAuthorization(ctx context.Context) (*Authorization, bool) {
+	authz, ok := ctx.Value(authorizationContextKey{}).(*Authorization)
+	return authz, ok
+}
+
+func (a *Authorization) Permission() Permission {
+	return a.permission
+}
+
+// Records the caller's role in the account and returns a permission denied error if the role does not grant the required permission
+func (a *Authorization) Authorize(accountId string, role mgmtv1alpha1.AccountRole) error {
+	a.mu.Lock()
+	a.roles[accountId] = role
+	a.mu.Unlock()
+
+	if !HasPermission(role, a.permission) {
+		return nucleuserrors.NewForbidden(fmt.Sprintf("role %s does not have the %s permission required for this request", toRoleName(role), a.permission))
+	}
+	return nil
+}
+
+// Returns the role the caller was found to have in the account during the request
+func (a *Authorization) GetRole(accountId string) (mgmtv1alpha1.AccountRole, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	role, ok := a.roles[accountId]
+	return role, ok
+}
+
+// Returns true if the procedure requires no permission, or the caller was found to have the required permission in at least one account during the request
+func (a *Authorization) IsAuthorized() bool {
+	if a.permission == PermissionNone {
+		return true
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, role := range a.roles {
+		if HasPermission(role, a.permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the accounts the caller was authorized against during the request
+func (a *Authorization) AccountIds() []string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	accountIds := make([]string, 0, len(a.roles))
+	for accountId := range a.roles {
+		accountIds = append(accountIds, accountId)
+	}
+	return accountIds
+}
+
+func toRoleName(role mgmtv1alpha1.AccountRole) string {
+	switch role {
+	case mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN:
+		return "admin"
+	case mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_DEVELOPER:
+		return "job-developer"
+	case mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EXECUTOR:
+		return "job-executor"
+	case mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER:
+		return "viewer"
+	default:
+		return "unspecified"
+	}
+}
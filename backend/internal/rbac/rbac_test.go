@@ -0,0 +1,90 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func Test_ProcedurePermissions_AllProceduresDefined(t *testing.T) {
+	// ensures the mgmt protos are registered
+	_ = mgmtv1alpha1.File_mgmt_v1alpha1_user_account_proto
+
+	procedures := []string{}
+	protoregistry.GlobalFiles.RangeFilesByPackage("mgmt.v1alpha1", func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				procedures = append(procedures, fmt.Sprintf("/%s/%s", services.Get(i).FullName(), methods.Get(j).Name()))
+			}
+		}
+		return true
+	})
+	require.NotEmpty(t, procedures)
+
+	for _, procedure := range procedures {
+		_, ok := GetProcedurePermission(procedure)
+		require.True(t, ok, "procedure %s must have a permission defined", procedure)
+	}
+	require.Len(t, procedurePermissions, len(procedures), "permissions are defined for procedures that no longer exist")
+}
+
+func Test_HasPermission(t *testing.T) {
+	require.True(t, HasPermission(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN, PermissionConnectionCredentialsRead))
+	require.True(t, HasPermission(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN, PermissionAccountManage))
+
+	require.True(t, HasPermission(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_DEVELOPER, PermissionJobsWrite))
+	require.False(t, HasPermission(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_DEVELOPER, PermissionConnectionCredentialsRead))
+
+	require.True(t, HasPermission(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EXECUTOR, PermissionJobsExecute))
+	require.False(t, HasPermission(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EXECUTOR, PermissionJobsWrite))
+	require.False(t, HasPermission(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EXECUTOR, PermissionConnectionCredentialsRead))
+
+	require.True(t, HasPermission(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER, PermissionAccountRead))
+	require.False(t, HasPermission(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER, PermissionJobsExecute))
+
+	require.False(t, HasPermission(mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED, PermissionNone))
+}
+
+func Test_Authorization(t *testing.T) {
+	ctx, authz := WithAuthorization(context.Background(), PermissionJobsExecute)
+	fromCtx, ok := GetAuthorization(ctx)
+	require.True(t, ok)
+	require.Equal(t, authz, fromCtx)
+
+	require.NoError(t, authz.Authorize("account-1", mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EXECUTOR))
+	role, ok := authz.GetRole("account-1")
+	require.True(t, ok)
+	require.Equal(t, mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EXECUTOR, role)
+
+	err := authz.Authorize("account-2", mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER)
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	_, ok = authz.GetRole("account-3")
+	require.False(t, ok)
+
+	_, ok = GetAuthorization(context.Background())
+	require.False(t, ok)
+}
+
+func Test_Authorization_IsAuthorized(t *testing.T) {
+	_, authz := WithAuthorization(context.Background(), PermissionJobsExecute)
+	require.False(t, authz.IsAuthorized())
+
+	require.Error(t, authz.Authorize("account-1", mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER))
+	require.False(t, authz.IsAuthorized())
+
+	require.NoError(t, authz.Authorize("account-2", mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_EXECUTOR))
+	require.True(t, authz.IsAuthorized())
+
+	_, authz = WithAuthorization(context.Background(), PermissionNone)
+	require.True(t, authz.IsAuthorized())
+}
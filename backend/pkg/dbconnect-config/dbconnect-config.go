@@ -9,6 +9,7 @@ const (
 	mysqlDriver    = "mysql"
 	postgresDriver = "postgres"
 	mssqlDriver    = "sqlserver"
+	sqliteDriver   = "sqlite"
 )
 
 type GeneralDbConnectConfig struct {
@@ -17,6 +18,7 @@ type GeneralDbConnectConfig struct {
 	host string
 	port *int32
 	// For mssql this is actually the path..the database is provided as a query parameter
+	// For sqlite this is the path to the database file
 	database *string
 	user     string
 	pass     string
@@ -85,6 +87,17 @@ func (g *GeneralDbConnectConfig) String() string {
 		u.RawQuery = g.queryParams.Encode()
 		return u.String()
 	}
+	if g.driver == sqliteDriver {
+		// the sqlite driver reads its query parameters from everything after the last ?, the path itself is not escaped
+		dsn := ""
+		if g.database != nil {
+			dsn = *g.database
+		}
+		if len(g.queryParams) > 0 {
+			dsn += "?" + g.queryParams.Encode()
+		}
+		return dsn
+	}
 	return ""
 }
 
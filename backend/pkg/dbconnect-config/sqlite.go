@@ -0,0 +1,109 @@
+package dbconnectconfig
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+)
+
+const (
+	// default amount of time in milliseconds that sqlite will wait on a locked database file before returning SQLITE_BUSY
+	defaultSqliteBusyTimeoutMs = 5000
+)
+
+// Returns the directory that the sqlite database files of the account must be located within, <allowedDir>/<accountId>.
+// Sqlite connections are disabled when allowedDir is empty.
+func GetSqliteAccountDir(allowedDir, accountId string) (string, error) {
+	if strings.TrimSpace(allowedDir) == "" {
+		return "", nucleuserrors.NewBadRequest("sqlite connections have not been enabled, an allowed sqlite directory must be configured")
+	}
+	if accountId == "" || accountId == "." || accountId == ".." || strings.ContainsAny(accountId, `/\`+"\x00") {
+		return "", nucleuserrors.NewBadRequest(fmt.Sprintf("account id must be a single path segment: %q", accountId))
+	}
+	return filepath.Join(allowedDir, accountId), nil
+}
+
+// The database file must be located within allowedDir. Relative paths are resolved against it.
+// The returned config points at the symlink resolved path of the file.
+// Sqlite connections are disabled when allowedDir is empty.
+func NewFromSqliteConnection(config *mgmtv1alpha1.ConnectionConfig_SqliteConfig, connectionTimeout *uint32, allowedDir string) (*GeneralDbConnectConfig, error) {
+	path := strings.TrimSpace(config.SqliteConfig.GetPath())
+	if path == "" {
+		return nil, nucleuserrors.NewBadRequest("must provide a path to the sqlite database file")
+	}
+	path, err := getSqlitePath(path, allowedDir)
+	if err != nil {
+		return nil, err
+	}
+
+	busyTimeoutMs := uint32(defaultSqliteBusyTimeoutMs)
+	if connectionTimeout != nil {
+		busyTimeoutMs = *connectionTimeout * 1000
+	}
+
+	query := url.Values{}
+	query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeoutMs))
+	query.Add("_pragma", "foreign_keys(1)")
+
+	return &GeneralDbConnectConfig{
+		driver:      sqliteDriver,
+		database:    &path,
+		queryParams: query,
+	}, nil
+}
+
+func getSqlitePath(path, allowedDir string) (string, error) {
+	if strings.TrimSpace(allowedDir) == "" {
+		return "", nucleuserrors.NewBadRequest("sqlite connections have not been enabled, an allowed sqlite directory must be configured")
+	}
+	// the driver reads its options from everything after the ?, so it can't be part of the file name
+	if strings.Contains(path, "?") || strings.HasPrefix(path, "file:") || strings.HasPrefix(path, ":memory:") {
+		return "", nucleuserrors.NewBadRequest("sqlite path must be a plain file path")
+	}
+
+	dir, err := filepath.Abs(allowedDir)
+	if err != nil {
+		return "", fmt.Errorf("unable to determine allowed sqlite directory: %w", err)
+	}
+	fullpath := filepath.Clean(path)
+	if !filepath.IsAbs(fullpath) {
+		fullpath = filepath.Join(dir, fullpath)
+	}
+	if !isWithinDir(dir, fullpath) {
+		return "", nucleuserrors.NewBadRequest(fmt.Sprintf("sqlite path %s must be located within the allowed sqlite directory", path))
+	}
+
+	// guard against symlinks within the directory that point outside of it.
+	// the database file may not exist yet, so its parent directory is resolved when it is missing
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("unable to determine allowed sqlite directory: %w", err)
+	}
+	resolved, err := filepath.EvalSymlinks(fullpath)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("unable to resolve sqlite path: %w", err)
+		}
+		resolvedParent, err := filepath.EvalSymlinks(filepath.Dir(fullpath))
+		if err != nil {
+			return "", fmt.Errorf("unable to resolve sqlite path: %w", err)
+		}
+		resolved = filepath.Join(resolvedParent, filepath.Base(fullpath))
+	}
+	if !isWithinDir(resolvedDir, resolved) {
+		return "", nucleuserrors.NewBadRequest(fmt.Sprintf("sqlite path %s must be located within the allowed sqlite directory", path))
+	}
+	// the resolved path is returned so that a symlink that is swapped in after this check is not followed
+	return resolved, nil
+}
+
+func isWithinDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,125 @@
+package dbconnectconfig
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/zeebo/assert"
+)
+
+func Test_NewFromSqliteConnection(t *testing.T) {
+	dir := t.TempDir()
+	dbpath := filepath.Join(dir, "dev.db")
+
+	t.Run("path", func(t *testing.T) {
+		out, err := NewFromSqliteConnection(&mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+			SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{
+				Path: dbpath,
+			},
+		}, nil, dir)
+
+		assert.NoError(t, err)
+		assert.Equal(t, out, &GeneralDbConnectConfig{
+			driver:      "sqlite",
+			database:    ptr(dbpath),
+			queryParams: url.Values{"_pragma": []string{"busy_timeout(5000)", "foreign_keys(1)"}},
+		})
+		assert.Equal(t, out.String(), dbpath+"?_pragma=busy_timeout%285000%29&_pragma=foreign_keys%281%29")
+	})
+
+	t.Run("relative path", func(t *testing.T) {
+		out, err := NewFromSqliteConnection(&mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+			SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{
+				Path: "dev.db",
+			},
+		}, nil, dir)
+
+		assert.NoError(t, err)
+		assert.Equal(t, *out.database, dbpath)
+	})
+
+	t.Run("connection timeout", func(t *testing.T) {
+		out, err := NewFromSqliteConnection(&mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+			SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{
+				Path: "dev.db",
+			},
+		}, ptr(uint32(10)), dir)
+
+		assert.NoError(t, err)
+		assert.Equal(t, out.queryParams["_pragma"], []string{"busy_timeout(10000)", "foreign_keys(1)"})
+	})
+
+	t.Run("empty path", func(t *testing.T) {
+		_, err := NewFromSqliteConnection(&mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+			SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{},
+		}, nil, dir)
+		assert.Error(t, err)
+	})
+
+	t.Run("no allowed directory", func(t *testing.T) {
+		_, err := NewFromSqliteConnection(&mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+			SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{Path: dbpath},
+		}, nil, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects paths outside of the allowed directory", func(t *testing.T) {
+		for _, path := range []string{"/etc/passwd", "../dev.db", "sub/../../dev.db", dir, filepath.Join(dir, "..", "dev.db")} {
+			_, err := NewFromSqliteConnection(&mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+				SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{Path: path},
+			}, nil, dir)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("rejects query parameters", func(t *testing.T) {
+		for _, path := range []string{"dev.db?_pragma=foreign_keys(0)", "file:dev.db", ":memory:"} {
+			_, err := NewFromSqliteConnection(&mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+				SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{Path: path},
+			}, nil, dir)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("rejects symlinks that escape the allowed directory", func(t *testing.T) {
+		outside := t.TempDir()
+		assert.NoError(t, os.Symlink(outside, filepath.Join(dir, "link")))
+		_, err := NewFromSqliteConnection(&mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+			SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{Path: "link/dev.db"},
+		}, nil, dir)
+		assert.Error(t, err)
+	})
+
+	t.Run("returns the resolved path of symlinks within the allowed directory", func(t *testing.T) {
+		assert.NoError(t, os.Mkdir(filepath.Join(dir, "data"), 0o755))
+		assert.NoError(t, os.Symlink(filepath.Join(dir, "data"), filepath.Join(dir, "inner")))
+		out, err := NewFromSqliteConnection(&mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+			SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{Path: "inner/dev.db"},
+		}, nil, dir)
+		assert.NoError(t, err)
+		assert.Equal(t, *out.database, filepath.Join(dir, "data", "dev.db"))
+	})
+}
+
+func Test_GetSqliteAccountDir(t *testing.T) {
+	t.Run("account directory", func(t *testing.T) {
+		dir, err := GetSqliteAccountDir("/data/sqlite", "88d1e1a7-6c29-4d0c-8a4b-0b8f4e3e0a7f")
+		assert.NoError(t, err)
+		assert.Equal(t, dir, "/data/sqlite/88d1e1a7-6c29-4d0c-8a4b-0b8f4e3e0a7f")
+	})
+
+	t.Run("no allowed directory", func(t *testing.T) {
+		_, err := GetSqliteAccountDir("", "88d1e1a7-6c29-4d0c-8a4b-0b8f4e3e0a7f")
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects account ids that are not a single path segment", func(t *testing.T) {
+		for _, accountId := range []string{"", ".", "..", "../other", "a/b"} {
+			_, err := GetSqliteAccountDir("/data/sqlite", accountId)
+			assert.Error(t, err)
+		}
+	})
+}
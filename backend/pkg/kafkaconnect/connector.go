@@ -0,0 +1,198 @@
+package kafkaconnect
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/internal/secrets"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+)
+
+const (
+	SaslMechanismPlain       = "PLAIN"
+	SaslMechanismScramSha256 = "SCRAM-SHA-256"
+	SaslMechanismScramSha512 = "SCRAM-SHA-512"
+)
+
+type Interface interface {
+	NewFromConnectionConfig(ctx context.Context, cc *mgmtv1alpha1.ConnectionConfig) (Client, error)
+}
+
+type Client interface {
+	Ping(ctx context.Context) error
+	ListTopics(ctx context.Context) ([]string, error)
+	Close()
+}
+
+var _ Interface = &Connector{}
+
+type Connector struct {
+	secretResolver secrets.Resolver
+}
+
+// The secret resolver is optional and is used to resolve any secret references in the connection config
+func NewConnector(secretResolver secrets.Resolver) *Connector {
+	return &Connector{secretResolver: secretResolver}
+}
+
+func (c *Connector) NewFromConnectionConfig(ctx context.Context, cc *mgmtv1alpha1.ConnectionConfig) (Client, error) {
+	if cc == nil {
+		return nil, errors.New("cc was nil, expected *mgmtv1alpha1.ConnectionConfig")
+	}
+	cc, err := secrets.ResolveConnectionConfig(ctx, c.secretResolver, cc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve connection config secrets: %w", err)
+	}
+	kafkaConfig := cc.GetKafkaConfig()
+	if kafkaConfig == nil {
+		return nil, errors.New("kafka config was nil, expected ConnectionConfig to contain valid KafkaConfig")
+	}
+	opts, err := NewClientOpts(FromKafkaConnectionConfig(kafkaConfig))
+	if err != nil {
+		return nil, err
+	}
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create kafka client: %w", err)
+	}
+	return &wrappedClient{client: client}, nil
+}
+
+// Broker configuration shared between the management api and the worker's kafka plugins
+type ClientConfig struct {
+	Brokers []string
+
+	SaslMechanism string
+	Username      string
+	Password      string
+
+	EnableTls  bool
+	RootCert   string
+	ClientCert string
+	ClientKey  string
+}
+
+func FromKafkaConnectionConfig(cfg *mgmtv1alpha1.KafkaConnectionConfig) *ClientConfig {
+	output := &ClientConfig{
+		Brokers:   cfg.GetBrokers(),
+		EnableTls: cfg.GetEnableTls(),
+	}
+	if cfg.GetSasl() != nil {
+		output.SaslMechanism = ToSaslMechanism(cfg.GetSasl().GetMechanism())
+		output.Username = cfg.GetSasl().GetUsername()
+		output.Password = cfg.GetSasl().GetPassword()
+	}
+	if cfg.GetClientTls() != nil {
+		output.RootCert = cfg.GetClientTls().GetRootCert()
+		output.ClientCert = cfg.GetClientTls().GetClientCert()
+		output.ClientKey = cfg.GetClientTls().GetClientKey()
+	}
+	return output
+}
+
+func ToSaslMechanism(mechanism mgmtv1alpha1.KafkaSaslMechanism) string {
+	switch mechanism {
+	case mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_SCRAM_SHA_256:
+		return SaslMechanismScramSha256
+	case mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_SCRAM_SHA_512:
+		return SaslMechanismScramSha512
+	default:
+		return SaslMechanismPlain
+	}
+}
+
+// Builds the franz-go client options that connect to the configured brokers
+func NewClientOpts(cfg *ClientConfig) ([]kgo.Opt, error) {
+	if cfg == nil || len(cfg.Brokers) == 0 {
+		return nil, errors.New("must provide at least one kafka broker")
+	}
+	opts := []kgo.Opt{kgo.SeedBrokers(cfg.Brokers...)}
+
+	if cfg.Username != "" {
+		switch cfg.SaslMechanism {
+		case "", SaslMechanismPlain:
+			opts = append(opts, kgo.SASL(plain.Auth{User: cfg.Username, Pass: cfg.Password}.AsMechanism()))
+		case SaslMechanismScramSha256:
+			opts = append(opts, kgo.SASL(scram.Auth{User: cfg.Username, Pass: cfg.Password}.AsSha256Mechanism()))
+		case SaslMechanismScramSha512:
+			opts = append(opts, kgo.SASL(scram.Auth{User: cfg.Username, Pass: cfg.Password}.AsSha512Mechanism()))
+		default:
+			return nil, fmt.Errorf("unsupported kafka sasl mechanism: %q", cfg.SaslMechanism)
+		}
+	}
+
+	if cfg.EnableTls {
+		tlsConfig, err := getTlsConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.DialTLSConfig(tlsConfig))
+	}
+	return opts, nil
+}
+
+func getTlsConfig(cfg *ClientConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.RootCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cfg.RootCert)) {
+			return nil, errors.New("unable to parse kafka root certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.ClientCert != "" || cfg.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCert), []byte(cfg.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse kafka client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+type wrappedClient struct {
+	client *kgo.Client
+}
+
+func (w *wrappedClient) Ping(ctx context.Context) error {
+	return w.client.Ping(ctx)
+}
+
+func (w *wrappedClient) ListTopics(ctx context.Context) ([]string, error) {
+	return ListTopics(ctx, w.client)
+}
+
+func (w *wrappedClient) Close() {
+	w.client.Close()
+}
+
+// Returns the names of all non-internal topics, sorted by name
+func ListTopics(ctx context.Context, client *kgo.Client) ([]string, error) {
+	req := kmsg.NewPtrMetadataRequest()
+	resp, err := req.RequestWith(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve kafka metadata: %w", err)
+	}
+	topics := []string{}
+	for _, topic := range resp.Topics {
+		if topic.IsInternal || topic.Topic == nil || strings.HasPrefix(*topic.Topic, "__") {
+			continue
+		}
+		if err := kerr.ErrorForCode(topic.ErrorCode); err != nil {
+			return nil, fmt.Errorf("unable to retrieve metadata for kafka topic %q: %w", *topic.Topic, err)
+		}
+		topics = append(topics, *topic.Topic)
+	}
+	sort.Strings(topics)
+	return topics, nil
+}
@@ -0,0 +1,101 @@
+package kafkaconnect
+
+import (
+	"context"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FromKafkaConnectionConfig(t *testing.T) {
+	actual := FromKafkaConnectionConfig(&mgmtv1alpha1.KafkaConnectionConfig{
+		Brokers: []string{"localhost:9092", "localhost:9093"},
+		Sasl: &mgmtv1alpha1.KafkaSaslConfig{
+			Mechanism: mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_SCRAM_SHA_512,
+			Username:  "neosync",
+			Password:  "hunter2",
+		},
+		EnableTls: true,
+		ClientTls: &mgmtv1alpha1.ClientTlsConfig{
+			RootCert: ptr("root"),
+		},
+	})
+	require.Equal(t, &ClientConfig{
+		Brokers:       []string{"localhost:9092", "localhost:9093"},
+		SaslMechanism: SaslMechanismScramSha512,
+		Username:      "neosync",
+		Password:      "hunter2",
+		EnableTls:     true,
+		RootCert:      "root",
+	}, actual)
+
+	actual = FromKafkaConnectionConfig(&mgmtv1alpha1.KafkaConnectionConfig{Brokers: []string{"localhost:9092"}})
+	require.Equal(t, &ClientConfig{Brokers: []string{"localhost:9092"}}, actual)
+}
+
+func Test_ToSaslMechanism(t *testing.T) {
+	require.Equal(t, SaslMechanismPlain, ToSaslMechanism(mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_UNSPECIFIED))
+	require.Equal(t, SaslMechanismPlain, ToSaslMechanism(mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_PLAIN))
+	require.Equal(t, SaslMechanismScramSha256, ToSaslMechanism(mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_SCRAM_SHA_256))
+	require.Equal(t, SaslMechanismScramSha512, ToSaslMechanism(mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_SCRAM_SHA_512))
+}
+
+func Test_NewClientOpts(t *testing.T) {
+	_, err := NewClientOpts(nil)
+	require.Error(t, err)
+	_, err = NewClientOpts(&ClientConfig{})
+	require.Error(t, err)
+
+	opts, err := NewClientOpts(&ClientConfig{Brokers: []string{"localhost:9092"}})
+	require.NoError(t, err)
+	require.Len(t, opts, 1)
+
+	opts, err = NewClientOpts(&ClientConfig{
+		Brokers:       []string{"localhost:9092"},
+		SaslMechanism: SaslMechanismScramSha256,
+		Username:      "neosync",
+		Password:      "hunter2",
+		EnableTls:     true,
+	})
+	require.NoError(t, err)
+	require.Len(t, opts, 3)
+
+	_, err = NewClientOpts(&ClientConfig{
+		Brokers:       []string{"localhost:9092"},
+		SaslMechanism: "GSSAPI",
+		Username:      "neosync",
+	})
+	require.Error(t, err)
+
+	_, err = NewClientOpts(&ClientConfig{
+		Brokers:   []string{"localhost:9092"},
+		EnableTls: true,
+		RootCert:  "not a cert",
+	})
+	require.Error(t, err)
+}
+
+func Test_Connector_NewFromConnectionConfig(t *testing.T) {
+	connector := NewConnector(nil)
+
+	_, err := connector.NewFromConnectionConfig(context.Background(), nil)
+	require.Error(t, err)
+
+	_, err = connector.NewFromConnectionConfig(context.Background(), &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{},
+	})
+	require.Error(t, err)
+
+	client, err := connector.NewFromConnectionConfig(context.Background(), &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_KafkaConfig{
+			KafkaConfig: &mgmtv1alpha1.KafkaConnectionConfig{Brokers: []string{"localhost:9092"}},
+		},
+	})
+	require.NoError(t, err)
+	client.Close()
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
@@ -0,0 +1,170 @@
+package localdirectory
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+)
+
+// Returns the absolute, symlink resolved, root path of a local directory connection.
+// Each account is confined to <allowedDir>/<accountId>, the root must be located within it, and relative paths are resolved against it.
+// Local directory connections are disabled when allowedDir is empty.
+func GetRootPath(root, allowedDir, accountId string) (string, error) {
+	if strings.TrimSpace(allowedDir) == "" {
+		return "", nucleuserrors.NewBadRequest("local directory connections have not been enabled, an allowed local directory must be configured")
+	}
+	root = strings.TrimSpace(root)
+	if root == "" {
+		return "", nucleuserrors.NewBadRequest("must provide a path to the local directory")
+	}
+	if err := ValidatePathSegment("account id", accountId); err != nil {
+		return "", err
+	}
+	allowedAbs, err := filepath.Abs(allowedDir)
+	if err != nil {
+		return "", fmt.Errorf("unable to determine allowed local directory: %w", err)
+	}
+	dir := filepath.Join(allowedAbs, accountId)
+	fullpath := filepath.Clean(root)
+	if !filepath.IsAbs(fullpath) {
+		fullpath = filepath.Join(dir, fullpath)
+	}
+	if !IsWithinDir(dir, fullpath) {
+		return "", nucleuserrors.NewBadRequest(fmt.Sprintf("local directory %s must be located within the account's allowed local directory %s", root, dir))
+	}
+
+	// guard against symlinks within the directory that point outside of it.
+	// destination directories are created on the first write, so the closest existing parent is resolved when it is missing
+	resolvedDir, err := evalExistingSymlinks(dir)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := evalExistingSymlinks(fullpath)
+	if err != nil {
+		return "", err
+	}
+	if !IsWithinDir(resolvedDir, resolved) {
+		return "", nucleuserrors.NewBadRequest(fmt.Sprintf("local directory %s must be located within the account's allowed local directory %s", root, dir))
+	}
+	// the resolved path is returned so that a symlink that is swapped in after this check is not followed
+	return resolved, nil
+}
+
+// Returns true if the path is the directory or is nested within it
+func IsWithinDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func evalExistingSymlinks(path string) (string, error) {
+	missing := []string{}
+	for {
+		resolved, err := filepath.EvalSymlinks(path)
+		if err == nil {
+			return filepath.Join(append([]string{resolved}, missing...)...), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("unable to resolve local directory: %w", err)
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			return "", fmt.Errorf("unable to resolve local directory: %w", err)
+		}
+		missing = append([]string{filepath.Base(path)}, missing...)
+		path = parent
+	}
+}
+
+// Job run ids, job ids, and tables are used as single path segments and may not traverse out of their parent directory
+func ValidatePathSegment(name, value string) error {
+	if value == "" || value == "." || value == ".." || strings.ContainsAny(value, `/\`+"\x00") {
+		return nucleuserrors.NewBadRequest(fmt.Sprintf("%s must be a single path segment: %q", name, value))
+	}
+	return nil
+}
+
+// Returns the directory that contains a folder per table for the given job run.
+// This mirrors the default layout that bucket destinations write to
+func GetWorkflowActivityPath(root, jobRunId string) string {
+	return filepath.Join(root, "workflows", jobRunId, "activities")
+}
+
+// Returns the directory that contains the exported files of a table for the given job run
+func GetTableDataPath(root, jobRunId, table string) string {
+	return filepath.Join(GetWorkflowActivityPath(root, jobRunId), table, "data")
+}
+
+// Returns the most recent run of the job that has been exported to the directory.
+// Run ids are prefixed with the job id and suffixed with the time they were started, so the greatest run id is the latest
+func GetLatestJobRunId(root, jobId string) (string, error) {
+	if err := ValidatePathSegment("job id", jobId); err != nil {
+		return "", err
+	}
+	entries, err := os.ReadDir(filepath.Join(root, "workflows"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("unable to list job runs in local directory: %w", err)
+	}
+	runIds := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), jobId) {
+			runIds = append(runIds, entry.Name())
+		}
+	}
+	if len(runIds) == 0 {
+		return "", fmt.Errorf("unable to find latest job run for job in local directory: %s", jobId)
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(runIds)))
+	return runIds[0], nil
+}
+
+// Returns the tables, in schema.table form, that were exported for the given job run
+func ListTables(root, jobRunId string) ([]string, error) {
+	if err := ValidatePathSegment("job run id", jobRunId); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(GetWorkflowActivityPath(root, jobRunId))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []string{}, nil
+		}
+		return nil, fmt.Errorf("unable to list tables in local directory: %w", err)
+	}
+	tables := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && strings.Contains(entry.Name(), ".") {
+			tables = append(tables, entry.Name())
+		}
+	}
+	return tables, nil
+}
+
+// Returns the sorted paths of the files that were exported for a table in the given job run
+func ListTableDataFiles(root, jobRunId, table string) ([]string, error) {
+	if err := ValidatePathSegment("job run id", jobRunId); err != nil {
+		return nil, err
+	}
+	if err := ValidatePathSegment("table", table); err != nil {
+		return nil, err
+	}
+	dataPath := GetTableDataPath(root, jobRunId, table)
+	entries, err := os.ReadDir(dataPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []string{}, nil
+		}
+		return nil, fmt.Errorf("unable to list files for table %s in local directory: %w", table, err)
+	}
+	files := []string{}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() {
+			files = append(files, filepath.Join(dataPath, entry.Name()))
+		}
+	}
+	return files, nil
+}
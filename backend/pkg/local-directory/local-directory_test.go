@@ -0,0 +1,97 @@
+package localdirectory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetTableDataPath(t *testing.T) {
+	require.Equal(t, "/exports/workflows/job-run-1/activities/public.users/data", GetTableDataPath("/exports", "job-run-1", "public.users"))
+}
+
+func Test_GetLatestJobRunId(t *testing.T) {
+	root := t.TempDir()
+	for _, runId := range []string{"job-1-2024-01-01T00:00:00Z", "job-1-2024-02-01T00:00:00Z", "job-2-2024-03-01T00:00:00Z"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(root, "workflows", runId), 0o755))
+	}
+
+	runId, err := GetLatestJobRunId(root, "job-1")
+	require.NoError(t, err)
+	require.Equal(t, "job-1-2024-02-01T00:00:00Z", runId)
+
+	_, err = GetLatestJobRunId(root, "job-3")
+	require.Error(t, err)
+
+	_, err = GetLatestJobRunId(t.TempDir(), "job-1")
+	require.Error(t, err)
+}
+
+func Test_ListTables_ListTableDataFiles(t *testing.T) {
+	root := t.TempDir()
+	dataPath := GetTableDataPath(root, "run-1", "public.users")
+	require.NoError(t, os.MkdirAll(dataPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dataPath, "2.jsonl.gz"), []byte{}, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dataPath, "1.jsonl.gz"), []byte{}, 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dataPath, "nested"), 0o755))
+
+	tables, err := ListTables(root, "run-1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"public.users"}, tables)
+
+	files, err := ListTableDataFiles(root, "run-1", "public.users")
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dataPath, "1.jsonl.gz"), filepath.Join(dataPath, "2.jsonl.gz")}, files)
+
+	tables, err = ListTables(root, "run-2")
+	require.NoError(t, err)
+	require.Empty(t, tables)
+
+	files, err = ListTableDataFiles(root, "run-1", "public.accounts")
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func Test_GetRootPath(t *testing.T) {
+	allowedDir := t.TempDir()
+	accountId := "4b1c6f3e-2d5a-4c8b-9e7f-1a2b3c4d5e6f"
+	accountDir := filepath.Join(allowedDir, accountId)
+
+	root, err := GetRootPath("exports", allowedDir, accountId)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(accountDir, "exports"), root)
+
+	root, err = GetRootPath(accountDir, allowedDir, accountId)
+	require.NoError(t, err)
+	require.Equal(t, accountDir, root)
+
+	_, err = GetRootPath(accountDir, "", accountId)
+	require.Error(t, err)
+	_, err = GetRootPath("", allowedDir, accountId)
+	require.Error(t, err)
+	_, err = GetRootPath("exports", allowedDir, "")
+	require.Error(t, err)
+	_, err = GetRootPath("/etc", allowedDir, accountId)
+	require.Error(t, err)
+	_, err = GetRootPath("exports/../..", allowedDir, accountId)
+	require.Error(t, err)
+	// other accounts' directories are not reachable
+	_, err = GetRootPath(allowedDir, allowedDir, accountId)
+	require.Error(t, err)
+	_, err = GetRootPath("../other-account/exports", allowedDir, accountId)
+	require.Error(t, err)
+
+	require.NoError(t, os.MkdirAll(accountDir, 0o755))
+	require.NoError(t, os.Symlink(t.TempDir(), filepath.Join(accountDir, "link")))
+	_, err = GetRootPath("link/exports", allowedDir, accountId)
+	require.Error(t, err)
+
+	// symlinks that stay within the account's directory are resolved
+	require.NoError(t, os.MkdirAll(filepath.Join(accountDir, "target"), 0o755))
+	require.NoError(t, os.Symlink(filepath.Join(accountDir, "target"), filepath.Join(accountDir, "inner")))
+	root, err = GetRootPath("inner/exports", allowedDir, accountId)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(accountDir, "target", "exports"), root)
+}
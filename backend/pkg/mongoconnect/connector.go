@@ -10,6 +10,7 @@ import (
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/pkg/clienttls"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
@@ -67,10 +68,13 @@ func (w *WrappedMongoClient) Close(ctx context.Context) error {
 
 var _ Interface = &Connector{}
 
-type Connector struct{}
+type Connector struct {
+	secretResolver secrets.Resolver
+}
 
-func NewConnector() *Connector {
-	return &Connector{}
+// The secret resolver is optional and is used to resolve any secret references in the connection config
+func NewConnector(secretResolver secrets.Resolver) *Connector {
+	return &Connector{secretResolver: secretResolver}
 }
 
 func (c *Connector) NewFromConnectionConfig(
@@ -80,6 +84,10 @@ func (c *Connector) NewFromConnectionConfig(
 	if cc == nil {
 		return nil, errors.New("cc was nil, expected *mgmtv1alpha1.ConnectionConfig")
 	}
+	cc, err := secrets.ResolveConnectionConfig(context.Background(), c.secretResolver, cc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve connection config secrets: %w", err)
+	}
 
 	details, err := getConnectionDetails(cc, clienttls.UpsertClientTlsFileSingleClient)
 	if err != nil {
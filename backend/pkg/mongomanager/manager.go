@@ -1 +1,188 @@
 package mongomanager
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	idIndexName = "_id_"
+
+	collectionTypeView = "view"
+)
+
+// The collection options that may be changed with collMod on a collection that already exists
+var collModValidationOptions = []string{"validator", "validationLevel", "validationAction"}
+
+// The definition of a collection that is needed to recreate it in another database
+type CollectionSchema struct {
+	Name string
+	// The collection type. Either collection or timeseries
+	Type string
+	// The options that the collection was created with, as returned by listCollections.
+	// This includes the capped, time-series, validator and collation options.
+	Options bson.D
+	// The index specifications of the collection, excluding the _id index
+	Indexes []bson.D
+}
+
+type listCollectionsResult struct {
+	Name    string `bson:"name"`
+	Type    string `bson:"type"`
+	Options bson.D `bson:"options"`
+}
+
+// Returns the schema of each of the given collections that exist in the database. Views are skipped.
+func GetCollectionSchemas(ctx context.Context, db *mongo.Database, collections []string) ([]*CollectionSchema, error) {
+	cursor, err := db.ListCollections(ctx, bson.D{{Key: "name", Value: bson.D{{Key: "$in", Value: collections}}}})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list collections of %s: %w", db.Name(), err)
+	}
+	var results []*listCollectionsResult
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, fmt.Errorf("unable to decode collections of %s: %w", db.Name(), err)
+	}
+
+	schemas := make([]*CollectionSchema, 0, len(results))
+	for _, result := range results {
+		if result.Type == collectionTypeView {
+			continue
+		}
+		indexCursor, err := db.Collection(result.Name).Indexes().List(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list indexes of %s.%s: %w", db.Name(), result.Name, err)
+		}
+		var indexes []bson.D
+		if err := indexCursor.All(ctx, &indexes); err != nil {
+			return nil, fmt.Errorf("unable to decode indexes of %s.%s: %w", db.Name(), result.Name, err)
+		}
+		schemas = append(schemas, &CollectionSchema{
+			Name:    result.Name,
+			Type:    result.Type,
+			Options: result.Options,
+			Indexes: toIndexSpecs(indexes),
+		})
+	}
+	return schemas, nil
+}
+
+// Returns a random sample of at most limit documents of the collection.
+// Documents are unmarshaled into standard go types with UnmarshalPrimitives.
+func GetCollectionSample(ctx context.Context, db *mongo.Database, collection string, limit int64) ([]map[string]any, error) {
+	cursor, err := db.Collection(collection).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$sample", Value: bson.D{{Key: "size", Value: limit}}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to sample documents of %s.%s: %w", db.Name(), collection, err)
+	}
+	defer cursor.Close(ctx)
+
+	docs := []map[string]any{}
+	for cursor.Next(ctx) {
+		var decoded map[string]any
+		if err := cursor.Decode(&decoded); err != nil {
+			return nil, fmt.Errorf("unable to decode document of %s.%s: %w", db.Name(), collection, err)
+		}
+		doc, _ := UnmarshalPrimitives(decoded)
+		docs = append(docs, doc)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("unable to sample documents of %s.%s: %w", db.Name(), collection, err)
+	}
+	return docs, nil
+}
+
+// Creates the collection with its options if it does not exist yet and creates any of its indexes.
+// The validator of a collection that already exists is updated to match the schema.
+func InitCollection(ctx context.Context, db *mongo.Database, schema *CollectionSchema) error {
+	existing, err := db.ListCollectionNames(ctx, bson.D{{Key: "name", Value: schema.Name}})
+	if err != nil {
+		return fmt.Errorf("unable to check if %s.%s exists: %w", db.Name(), schema.Name, err)
+	}
+	if len(existing) == 0 {
+		if err := db.RunCommand(ctx, buildCreateCollectionCommand(schema)).Err(); err != nil {
+			return fmt.Errorf("unable to create collection %s.%s: %w", db.Name(), schema.Name, err)
+		}
+	} else if cmd, ok := buildCollModValidationCommand(schema); ok {
+		if err := db.RunCommand(ctx, cmd).Err(); err != nil {
+			return fmt.Errorf("unable to update validator of collection %s.%s: %w", db.Name(), schema.Name, err)
+		}
+	}
+
+	if len(schema.Indexes) == 0 {
+		return nil
+	}
+	indexes := make(bson.A, 0, len(schema.Indexes))
+	for _, index := range schema.Indexes {
+		indexes = append(indexes, index)
+	}
+	// indexes that already exist with the same specification are left as is
+	err = db.RunCommand(ctx, bson.D{{Key: "createIndexes", Value: schema.Name}, {Key: "indexes", Value: indexes}}).Err()
+	if err != nil {
+		return fmt.Errorf("unable to create indexes of %s.%s: %w", db.Name(), schema.Name, err)
+	}
+	return nil
+}
+
+// Removes every document from the collection. Dropping the collection also removes its indexes and options.
+func TruncateCollection(ctx context.Context, db *mongo.Database, collection string, drop bool) error {
+	if drop {
+		if err := db.Collection(collection).Drop(ctx); err != nil {
+			return fmt.Errorf("unable to drop collection %s.%s: %w", db.Name(), collection, err)
+		}
+		return nil
+	}
+	if _, err := db.Collection(collection).DeleteMany(ctx, bson.D{}); err != nil {
+		return fmt.Errorf("unable to delete documents of %s.%s: %w", db.Name(), collection, err)
+	}
+	return nil
+}
+
+func buildCreateCollectionCommand(schema *CollectionSchema) bson.D {
+	cmd := bson.D{{Key: "create", Value: schema.Name}}
+	return append(cmd, schema.Options...)
+}
+
+func buildCollModValidationCommand(schema *CollectionSchema) (bson.D, bool) {
+	cmd := bson.D{{Key: "collMod", Value: schema.Name}}
+	for _, opt := range schema.Options {
+		for _, key := range collModValidationOptions {
+			if opt.Key == key {
+				cmd = append(cmd, opt)
+			}
+		}
+	}
+	return cmd, len(cmd) > 1
+}
+
+// Strips the fields of the listIndexes output that are not accepted by createIndexes.
+// The _id index and clustered indexes are created along with the collection.
+func toIndexSpecs(indexes []bson.D) []bson.D {
+	specs := make([]bson.D, 0, len(indexes))
+	for _, index := range indexes {
+		isBuiltIn := false
+		spec := bson.D{}
+		for _, field := range index {
+			switch field.Key {
+			case "name":
+				if field.Value == idIndexName {
+					isBuiltIn = true
+				}
+			case "clustered":
+				if clustered, ok := field.Value.(bool); ok && clustered {
+					isBuiltIn = true
+				}
+			case "v", "ns":
+				continue
+			}
+			spec = append(spec, field)
+		}
+		if !isBuiltIn {
+			specs = append(specs, spec)
+		}
+	}
+	return specs
+}
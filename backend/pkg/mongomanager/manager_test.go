@@ -0,0 +1,60 @@
+package mongomanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func Test_toIndexSpecs(t *testing.T) {
+	indexes := []bson.D{
+		{{Key: "v", Value: int32(2)}, {Key: "key", Value: bson.D{{Key: "_id", Value: int32(1)}}}, {Key: "name", Value: "_id_"}},
+		{{Key: "v", Value: int32(2)}, {Key: "key", Value: bson.D{{Key: "email", Value: int32(1)}}}, {Key: "name", Value: "email_1"}, {Key: "unique", Value: true}},
+		{{Key: "v", Value: int32(2)}, {Key: "key", Value: bson.D{{Key: "createdAt", Value: int32(1)}}}, {Key: "name", Value: "createdAt_1"}, {Key: "expireAfterSeconds", Value: int32(3600)}, {Key: "ns", Value: "shop.sessions"}},
+		{{Key: "v", Value: int32(2)}, {Key: "key", Value: bson.D{{Key: "_id", Value: int32(1)}}}, {Key: "name", Value: "_id_clustered"}, {Key: "clustered", Value: true}},
+	}
+
+	require.Equal(t, []bson.D{
+		{{Key: "key", Value: bson.D{{Key: "email", Value: int32(1)}}}, {Key: "name", Value: "email_1"}, {Key: "unique", Value: true}},
+		{{Key: "key", Value: bson.D{{Key: "createdAt", Value: int32(1)}}}, {Key: "name", Value: "createdAt_1"}, {Key: "expireAfterSeconds", Value: int32(3600)}},
+	}, toIndexSpecs(indexes))
+}
+
+func Test_buildCreateCollectionCommand(t *testing.T) {
+	schema := &CollectionSchema{
+		Name: "events",
+		Options: bson.D{
+			{Key: "capped", Value: true},
+			{Key: "size", Value: int64(1048576)},
+			{Key: "collation", Value: bson.D{{Key: "locale", Value: "fr"}}},
+		},
+	}
+	require.Equal(t, bson.D{
+		{Key: "create", Value: "events"},
+		{Key: "capped", Value: true},
+		{Key: "size", Value: int64(1048576)},
+		{Key: "collation", Value: bson.D{{Key: "locale", Value: "fr"}}},
+	}, buildCreateCollectionCommand(schema))
+}
+
+func Test_buildCollModValidationCommand(t *testing.T) {
+	validator := bson.D{{Key: "$jsonSchema", Value: bson.D{{Key: "required", Value: bson.A{"email"}}}}}
+	cmd, ok := buildCollModValidationCommand(&CollectionSchema{
+		Name: "users",
+		Options: bson.D{
+			{Key: "validator", Value: validator},
+			{Key: "validationLevel", Value: "strict"},
+			{Key: "collation", Value: bson.D{{Key: "locale", Value: "fr"}}},
+		},
+	})
+	require.True(t, ok)
+	require.Equal(t, bson.D{
+		{Key: "collMod", Value: "users"},
+		{Key: "validator", Value: validator},
+		{Key: "validationLevel", Value: "strict"},
+	}, cmd)
+
+	_, ok = buildCollModValidationCommand(&CollectionSchema{Name: "users", Options: bson.D{{Key: "capped", Value: true}}})
+	require.False(t, ok)
+}
@@ -22,6 +22,126 @@ func (_m *MockQuerier) EXPECT() *MockQuerier_Expecter {
 	return &MockQuerier_Expecter{mock: &_m.Mock}
 }
 
+// GetCustomSequencesBySchemas provides a mock function with given fields: ctx, db, schemas
+func (_m *MockQuerier) GetCustomSequencesBySchemas(ctx context.Context, db mysql_queries.DBTX, schemas []string) ([]*GetCustomSequencesBySchemasRow, error) {
+	ret := _m.Called(ctx, db, schemas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomSequencesBySchemas")
+	}
+
+	var r0 []*GetCustomSequencesBySchemasRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mysql_queries.DBTX, []string) ([]*GetCustomSequencesBySchemasRow, error)); ok {
+		return rf(ctx, db, schemas)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mysql_queries.DBTX, []string) []*GetCustomSequencesBySchemasRow); ok {
+		r0 = rf(ctx, db, schemas)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*GetCustomSequencesBySchemasRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mysql_queries.DBTX, []string) error); ok {
+		r1 = rf(ctx, db, schemas)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetCustomSequencesBySchemas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomSequencesBySchemas'
+type MockQuerier_GetCustomSequencesBySchemas_Call struct {
+	*mock.Call
+}
+
+// GetCustomSequencesBySchemas is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db mysql_queries.DBTX
+//   - schemas []string
+func (_e *MockQuerier_Expecter) GetCustomSequencesBySchemas(ctx interface{}, db interface{}, schemas interface{}) *MockQuerier_GetCustomSequencesBySchemas_Call {
+	return &MockQuerier_GetCustomSequencesBySchemas_Call{Call: _e.mock.On("GetCustomSequencesBySchemas", ctx, db, schemas)}
+}
+
+func (_c *MockQuerier_GetCustomSequencesBySchemas_Call) Run(run func(ctx context.Context, db mysql_queries.DBTX, schemas []string)) *MockQuerier_GetCustomSequencesBySchemas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(mysql_queries.DBTX), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetCustomSequencesBySchemas_Call) Return(_a0 []*GetCustomSequencesBySchemasRow, _a1 error) *MockQuerier_GetCustomSequencesBySchemas_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetCustomSequencesBySchemas_Call) RunAndReturn(run func(context.Context, mysql_queries.DBTX, []string) ([]*GetCustomSequencesBySchemasRow, error)) *MockQuerier_GetCustomSequencesBySchemas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomTriggersBySchemasAndTables provides a mock function with given fields: ctx, db, tables
+func (_m *MockQuerier) GetCustomTriggersBySchemasAndTables(ctx context.Context, db mysql_queries.DBTX, tables []string) ([]*GetCustomTriggersBySchemasAndTablesRow, error) {
+	ret := _m.Called(ctx, db, tables)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomTriggersBySchemasAndTables")
+	}
+
+	var r0 []*GetCustomTriggersBySchemasAndTablesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mysql_queries.DBTX, []string) ([]*GetCustomTriggersBySchemasAndTablesRow, error)); ok {
+		return rf(ctx, db, tables)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mysql_queries.DBTX, []string) []*GetCustomTriggersBySchemasAndTablesRow); ok {
+		r0 = rf(ctx, db, tables)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*GetCustomTriggersBySchemasAndTablesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mysql_queries.DBTX, []string) error); ok {
+		r1 = rf(ctx, db, tables)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetCustomTriggersBySchemasAndTables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomTriggersBySchemasAndTables'
+type MockQuerier_GetCustomTriggersBySchemasAndTables_Call struct {
+	*mock.Call
+}
+
+// GetCustomTriggersBySchemasAndTables is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db mysql_queries.DBTX
+//   - tables []string
+func (_e *MockQuerier_Expecter) GetCustomTriggersBySchemasAndTables(ctx interface{}, db interface{}, tables interface{}) *MockQuerier_GetCustomTriggersBySchemasAndTables_Call {
+	return &MockQuerier_GetCustomTriggersBySchemasAndTables_Call{Call: _e.mock.On("GetCustomTriggersBySchemasAndTables", ctx, db, tables)}
+}
+
+func (_c *MockQuerier_GetCustomTriggersBySchemasAndTables_Call) Run(run func(ctx context.Context, db mysql_queries.DBTX, tables []string)) *MockQuerier_GetCustomTriggersBySchemasAndTables_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(mysql_queries.DBTX), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetCustomTriggersBySchemasAndTables_Call) Return(_a0 []*GetCustomTriggersBySchemasAndTablesRow, _a1 error) *MockQuerier_GetCustomTriggersBySchemasAndTables_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetCustomTriggersBySchemasAndTables_Call) RunAndReturn(run func(context.Context, mysql_queries.DBTX, []string) ([]*GetCustomTriggersBySchemasAndTablesRow, error)) *MockQuerier_GetCustomTriggersBySchemasAndTables_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDatabaseSchema provides a mock function with given fields: ctx, db
 func (_m *MockQuerier) GetDatabaseSchema(ctx context.Context, db mysql_queries.DBTX) ([]*GetDatabaseSchemaRow, error) {
 	ret := _m.Called(ctx, db)
@@ -81,6 +201,126 @@ func (_c *MockQuerier_GetDatabaseSchema_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetDatabaseTableSchemasBySchemasAndTables provides a mock function with given fields: ctx, db, tables
+func (_m *MockQuerier) GetDatabaseTableSchemasBySchemasAndTables(ctx context.Context, db mysql_queries.DBTX, tables []string) ([]*GetDatabaseTableSchemasBySchemasAndTablesRow, error) {
+	ret := _m.Called(ctx, db, tables)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabaseTableSchemasBySchemasAndTables")
+	}
+
+	var r0 []*GetDatabaseTableSchemasBySchemasAndTablesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mysql_queries.DBTX, []string) ([]*GetDatabaseTableSchemasBySchemasAndTablesRow, error)); ok {
+		return rf(ctx, db, tables)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mysql_queries.DBTX, []string) []*GetDatabaseTableSchemasBySchemasAndTablesRow); ok {
+		r0 = rf(ctx, db, tables)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*GetDatabaseTableSchemasBySchemasAndTablesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mysql_queries.DBTX, []string) error); ok {
+		r1 = rf(ctx, db, tables)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetDatabaseTableSchemasBySchemasAndTables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDatabaseTableSchemasBySchemasAndTables'
+type MockQuerier_GetDatabaseTableSchemasBySchemasAndTables_Call struct {
+	*mock.Call
+}
+
+// GetDatabaseTableSchemasBySchemasAndTables is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db mysql_queries.DBTX
+//   - tables []string
+func (_e *MockQuerier_Expecter) GetDatabaseTableSchemasBySchemasAndTables(ctx interface{}, db interface{}, tables interface{}) *MockQuerier_GetDatabaseTableSchemasBySchemasAndTables_Call {
+	return &MockQuerier_GetDatabaseTableSchemasBySchemasAndTables_Call{Call: _e.mock.On("GetDatabaseTableSchemasBySchemasAndTables", ctx, db, tables)}
+}
+
+func (_c *MockQuerier_GetDatabaseTableSchemasBySchemasAndTables_Call) Run(run func(ctx context.Context, db mysql_queries.DBTX, tables []string)) *MockQuerier_GetDatabaseTableSchemasBySchemasAndTables_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(mysql_queries.DBTX), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetDatabaseTableSchemasBySchemasAndTables_Call) Return(_a0 []*GetDatabaseTableSchemasBySchemasAndTablesRow, _a1 error) *MockQuerier_GetDatabaseTableSchemasBySchemasAndTables_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetDatabaseTableSchemasBySchemasAndTables_Call) RunAndReturn(run func(context.Context, mysql_queries.DBTX, []string) ([]*GetDatabaseTableSchemasBySchemasAndTablesRow, error)) *MockQuerier_GetDatabaseTableSchemasBySchemasAndTables_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndicesBySchemasAndTables provides a mock function with given fields: ctx, db, tables
+func (_m *MockQuerier) GetIndicesBySchemasAndTables(ctx context.Context, db mysql_queries.DBTX, tables []string) ([]*GetIndicesBySchemasAndTablesRow, error) {
+	ret := _m.Called(ctx, db, tables)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIndicesBySchemasAndTables")
+	}
+
+	var r0 []*GetIndicesBySchemasAndTablesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, mysql_queries.DBTX, []string) ([]*GetIndicesBySchemasAndTablesRow, error)); ok {
+		return rf(ctx, db, tables)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, mysql_queries.DBTX, []string) []*GetIndicesBySchemasAndTablesRow); ok {
+		r0 = rf(ctx, db, tables)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*GetIndicesBySchemasAndTablesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, mysql_queries.DBTX, []string) error); ok {
+		r1 = rf(ctx, db, tables)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_GetIndicesBySchemasAndTables_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndicesBySchemasAndTables'
+type MockQuerier_GetIndicesBySchemasAndTables_Call struct {
+	*mock.Call
+}
+
+// GetIndicesBySchemasAndTables is a helper method to define mock.On call
+//   - ctx context.Context
+//   - db mysql_queries.DBTX
+//   - tables []string
+func (_e *MockQuerier_Expecter) GetIndicesBySchemasAndTables(ctx interface{}, db interface{}, tables interface{}) *MockQuerier_GetIndicesBySchemasAndTables_Call {
+	return &MockQuerier_GetIndicesBySchemasAndTables_Call{Call: _e.mock.On("GetIndicesBySchemasAndTables", ctx, db, tables)}
+}
+
+func (_c *MockQuerier_GetIndicesBySchemasAndTables_Call) Run(run func(ctx context.Context, db mysql_queries.DBTX, tables []string)) *MockQuerier_GetIndicesBySchemasAndTables_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(mysql_queries.DBTX), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_GetIndicesBySchemasAndTables_Call) Return(_a0 []*GetIndicesBySchemasAndTablesRow, _a1 error) *MockQuerier_GetIndicesBySchemasAndTables_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_GetIndicesBySchemasAndTables_Call) RunAndReturn(run func(context.Context, mysql_queries.DBTX, []string) ([]*GetIndicesBySchemasAndTablesRow, error)) *MockQuerier_GetIndicesBySchemasAndTables_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRolePermissions provides a mock function with given fields: ctx, db
 func (_m *MockQuerier) GetRolePermissions(ctx context.Context, db mysql_queries.DBTX) ([]*GetRolePermissionsRow, error) {
 	ret := _m.Called(ctx, db)
@@ -7,7 +7,11 @@ import (
 )
 
 type Querier interface {
+	GetCustomSequencesBySchemas(ctx context.Context, db mysql_queries.DBTX, schemas []string) ([]*GetCustomSequencesBySchemasRow, error)
+	GetCustomTriggersBySchemasAndTables(ctx context.Context, db mysql_queries.DBTX, tables []string) ([]*GetCustomTriggersBySchemasAndTablesRow, error)
 	GetDatabaseSchema(ctx context.Context, db mysql_queries.DBTX) ([]*GetDatabaseSchemaRow, error)
+	GetDatabaseTableSchemasBySchemasAndTables(ctx context.Context, db mysql_queries.DBTX, tables []string) ([]*GetDatabaseTableSchemasBySchemasAndTablesRow, error)
+	GetIndicesBySchemasAndTables(ctx context.Context, db mysql_queries.DBTX, tables []string) ([]*GetIndicesBySchemasAndTablesRow, error)
 	GetRolePermissions(ctx context.Context, db mysql_queries.DBTX) ([]*GetRolePermissionsRow, error)
 	GetTableConstraintsBySchemas(ctx context.Context, db mysql_queries.DBTX, schemas []string) ([]*GetTableConstraintsBySchemasRow, error)
 }
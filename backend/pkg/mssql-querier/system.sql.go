@@ -334,3 +334,303 @@ func (q *Queries) GetTableConstraintsBySchemas(ctx context.Context, db mysql_que
 	}
 	return items, nil
 }
+
+const getDatabaseTableSchemasBySchemasAndTables = `
+SELECT
+    s.name AS schema_name,
+    t.name AS table_name,
+    c.name AS column_name,
+    c.column_id AS ordinal_position,
+    TYPE_NAME(c.user_type_id) AS data_type,
+    c.max_length,
+    c.precision AS numeric_precision,
+    c.scale AS numeric_scale,
+    c.is_nullable,
+    c.is_identity,
+    CAST(ic.seed_value AS NVARCHAR(MAX)) AS identity_seed,
+    CAST(ic.increment_value AS NVARCHAR(MAX)) AS identity_increment,
+    c.is_computed,
+    cc.definition AS generation_expression,
+    ISNULL(cc.is_persisted, 0) AS is_persisted,
+    dc.definition AS column_default
+FROM
+    sys.schemas s
+    INNER JOIN sys.tables t ON s.schema_id = t.schema_id
+    INNER JOIN sys.columns c ON t.object_id = c.object_id
+    LEFT JOIN sys.identity_columns ic ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+    LEFT JOIN sys.computed_columns cc ON c.object_id = cc.object_id AND c.column_id = cc.column_id
+    LEFT JOIN sys.default_constraints dc ON c.default_object_id = dc.object_id
+WHERE
+    CONCAT(s.name, '.', t.name) IN (SELECT value FROM STRING_SPLIT(@tables, ','))
+ORDER BY
+    s.name, t.name, c.column_id;
+`
+
+type GetDatabaseTableSchemasBySchemasAndTablesRow struct {
+	SchemaName           string
+	TableName            string
+	ColumnName           string
+	OrdinalPosition      int32
+	DataType             string
+	MaxLength            int16
+	NumericPrecision     int16
+	NumericScale         int16
+	IsNullable           bool
+	IsIdentity           bool
+	IdentitySeed         sql.NullString
+	IdentityIncrement    sql.NullString
+	IsComputed           bool
+	GenerationExpression sql.NullString
+	IsPersisted          bool
+	ColumnDefault        sql.NullString
+}
+
+// Tables are expected to be in the form of schema.table
+func (q *Queries) GetDatabaseTableSchemasBySchemasAndTables(ctx context.Context, db mysql_queries.DBTX, tables []string) ([]*GetDatabaseTableSchemasBySchemasAndTablesRow, error) {
+	rows, err := db.QueryContext(ctx, getDatabaseTableSchemasBySchemasAndTables, sql.Named("tables", strings.Join(tables, ",")))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetDatabaseTableSchemasBySchemasAndTablesRow
+	for rows.Next() {
+		var i GetDatabaseTableSchemasBySchemasAndTablesRow
+		if err := rows.Scan(
+			&i.SchemaName,
+			&i.TableName,
+			&i.ColumnName,
+			&i.OrdinalPosition,
+			&i.DataType,
+			&i.MaxLength,
+			&i.NumericPrecision,
+			&i.NumericScale,
+			&i.IsNullable,
+			&i.IsIdentity,
+			&i.IdentitySeed,
+			&i.IdentityIncrement,
+			&i.IsComputed,
+			&i.GenerationExpression,
+			&i.IsPersisted,
+			&i.ColumnDefault,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getIndicesBySchemasAndTables = `
+SELECT
+    s.name AS schema_name,
+    t.name AS table_name,
+    i.name AS index_name,
+    i.type_desc AS index_type,
+    i.is_unique,
+    (
+        SELECT STRING_AGG(QUOTENAME(c.name) + CASE WHEN ic.is_descending_key = 1 THEN ' DESC' ELSE ' ASC' END, ', ')
+            WITHIN GROUP (ORDER BY ic.key_ordinal)
+        FROM sys.index_columns ic
+        JOIN sys.columns c ON ic.object_id = c.object_id AND ic.column_id = c.column_id
+        WHERE ic.object_id = i.object_id AND ic.index_id = i.index_id AND ic.is_included_column = 0
+    ) AS key_columns,
+    (
+        SELECT STRING_AGG(QUOTENAME(c.name), ', ')
+            WITHIN GROUP (ORDER BY ic.index_column_id)
+        FROM sys.index_columns ic
+        JOIN sys.columns c ON ic.object_id = c.object_id AND ic.column_id = c.column_id
+        WHERE ic.object_id = i.object_id AND ic.index_id = i.index_id AND ic.is_included_column = 1
+    ) AS included_columns,
+    i.filter_definition
+FROM
+    sys.indexes i
+    INNER JOIN sys.tables t ON i.object_id = t.object_id
+    INNER JOIN sys.schemas s ON t.schema_id = s.schema_id
+WHERE
+    i.is_primary_key = 0
+    AND i.is_unique_constraint = 0
+    AND i.is_hypothetical = 0
+    AND i.type IN (1, 2) -- clustered and nonclustered rowstore indexes
+    AND CONCAT(s.name, '.', t.name) IN (SELECT value FROM STRING_SPLIT(@tables, ','))
+ORDER BY
+    s.name, t.name, i.name;
+`
+
+type GetIndicesBySchemasAndTablesRow struct {
+	SchemaName       string
+	TableName        string
+	IndexName        string
+	IndexType        string
+	IsUnique         bool
+	KeyColumns       string
+	IncludedColumns  sql.NullString
+	FilterDefinition sql.NullString
+}
+
+// Tables are expected to be in the form of schema.table
+func (q *Queries) GetIndicesBySchemasAndTables(ctx context.Context, db mysql_queries.DBTX, tables []string) ([]*GetIndicesBySchemasAndTablesRow, error) {
+	rows, err := db.QueryContext(ctx, getIndicesBySchemasAndTables, sql.Named("tables", strings.Join(tables, ",")))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetIndicesBySchemasAndTablesRow
+	for rows.Next() {
+		var i GetIndicesBySchemasAndTablesRow
+		if err := rows.Scan(
+			&i.SchemaName,
+			&i.TableName,
+			&i.IndexName,
+			&i.IndexType,
+			&i.IsUnique,
+			&i.KeyColumns,
+			&i.IncludedColumns,
+			&i.FilterDefinition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomTriggersBySchemasAndTables = `
+SELECT
+    s.name AS schema_name,
+    t.name AS table_name,
+    tr.name AS trigger_name,
+    m.definition
+FROM
+    sys.triggers tr
+    INNER JOIN sys.tables t ON tr.parent_id = t.object_id
+    INNER JOIN sys.schemas s ON t.schema_id = s.schema_id
+    INNER JOIN sys.sql_modules m ON tr.object_id = m.object_id
+WHERE
+    tr.is_ms_shipped = 0
+    AND tr.parent_class = 1
+    AND CONCAT(s.name, '.', t.name) IN (SELECT value FROM STRING_SPLIT(@tables, ','))
+ORDER BY
+    s.name, t.name, tr.name;
+`
+
+type GetCustomTriggersBySchemasAndTablesRow struct {
+	SchemaName  string
+	TableName   string
+	TriggerName string
+	Definition  sql.NullString
+}
+
+// Tables are expected to be in the form of schema.table
+func (q *Queries) GetCustomTriggersBySchemasAndTables(ctx context.Context, db mysql_queries.DBTX, tables []string) ([]*GetCustomTriggersBySchemasAndTablesRow, error) {
+	rows, err := db.QueryContext(ctx, getCustomTriggersBySchemasAndTables, sql.Named("tables", strings.Join(tables, ",")))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetCustomTriggersBySchemasAndTablesRow
+	for rows.Next() {
+		var i GetCustomTriggersBySchemasAndTablesRow
+		if err := rows.Scan(
+			&i.SchemaName,
+			&i.TableName,
+			&i.TriggerName,
+			&i.Definition,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCustomSequencesBySchemas = `
+SELECT
+    s.name AS schema_name,
+    seq.name AS sequence_name,
+    TYPE_NAME(seq.user_type_id) AS data_type,
+    seq.precision AS numeric_precision,
+    seq.scale AS numeric_scale,
+    CAST(seq.start_value AS NVARCHAR(MAX)) AS start_value,
+    CAST(seq.increment AS NVARCHAR(MAX)) AS increment_value,
+    CAST(seq.minimum_value AS NVARCHAR(MAX)) AS minimum_value,
+    CAST(seq.maximum_value AS NVARCHAR(MAX)) AS maximum_value,
+    seq.is_cycling,
+    seq.is_cached,
+    seq.cache_size
+FROM
+    sys.sequences seq
+    INNER JOIN sys.schemas s ON seq.schema_id = s.schema_id
+WHERE
+    s.name IN (SELECT value FROM STRING_SPLIT(@schemas, ','))
+ORDER BY
+    s.name, seq.name;
+`
+
+type GetCustomSequencesBySchemasRow struct {
+	SchemaName       string
+	SequenceName     string
+	DataType         string
+	NumericPrecision int16
+	NumericScale     int16
+	StartValue       string
+	IncrementValue   string
+	MinimumValue     string
+	MaximumValue     string
+	IsCycling        bool
+	IsCached         bool
+	CacheSize        sql.NullInt32
+}
+
+func (q *Queries) GetCustomSequencesBySchemas(ctx context.Context, db mysql_queries.DBTX, schemas []string) ([]*GetCustomSequencesBySchemasRow, error) {
+	rows, err := db.QueryContext(ctx, getCustomSequencesBySchemas, sql.Named("schemas", strings.Join(schemas, ",")))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetCustomSequencesBySchemasRow
+	for rows.Next() {
+		var i GetCustomSequencesBySchemasRow
+		if err := rows.Scan(
+			&i.SchemaName,
+			&i.SequenceName,
+			&i.DataType,
+			&i.NumericPrecision,
+			&i.NumericScale,
+			&i.StartValue,
+			&i.IncrementValue,
+			&i.MinimumValue,
+			&i.MaximumValue,
+			&i.IsCycling,
+			&i.IsCached,
+			&i.CacheSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
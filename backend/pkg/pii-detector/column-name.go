@@ -0,0 +1,120 @@
+package piidetector
+
+import (
+	"strings"
+	"unicode"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+// The score is lowered by this amount when a phrase only matches a part of the column name
+const partialNameMatchPenalty = 0.1
+
+type columnNameRule struct {
+	category mgmtv1alpha1.PiiCategory
+	// Phrases that are matched against the words of the column name
+	phrases []string
+	score   float64
+}
+
+var columnNameRules = []*columnNameRule{
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL, score: 0.8, phrases: []string{"email", "e_mail", "emailaddress", "email_address"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_PHONE_NUMBER, score: 0.8, phrases: []string{"phone", "phonenumber", "mobile", "cellphone", "cell_phone", "telephone", "tel", "fax", "msisdn"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_SSN, score: 0.9, phrases: []string{"ssn", "social_security", "socialsecuritynumber"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_CARD_NUMBER, score: 0.8, phrases: []string{"card_number", "cardnumber", "credit_card", "creditcard", "cc_number", "ccnumber"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_IP_ADDRESS, score: 0.8, phrases: []string{"ip", "ipaddress", "ip_address", "ip_addr", "ipv4", "ipv6", "remote_addr"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_FIRST_NAME, score: 0.8, phrases: []string{"first_name", "firstname", "fname", "given_name", "givenname", "forename"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_LAST_NAME, score: 0.8, phrases: []string{"last_name", "lastname", "lname", "surname", "family_name", "familyname"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_FULL_NAME, score: 0.7, phrases: []string{"full_name", "fullname", "display_name", "displayname", "contact_name", "customer_name", "person_name"}},
+	// a column that is only called name is just as likely to hold the name of a product as the name of a person
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_FULL_NAME, score: 0.35, phrases: []string{"name"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_USERNAME, score: 0.7, phrases: []string{"username", "user_name", "login", "screen_name", "handle"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_PASSWORD, score: 0.9, phrases: []string{"password", "passwd", "pwd", "passwordhash"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_STREET_ADDRESS, score: 0.7, phrases: []string{"address", "street", "streetaddress", "addr", "address_line"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_CITY, score: 0.8, phrases: []string{"city", "town"}},
+	// state is also commonly used for the status of a record
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_STATE, score: 0.5, phrases: []string{"state", "province"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_ZIPCODE, score: 0.8, phrases: []string{"zip", "zipcode", "postal_code", "postalcode", "postcode"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_COUNTRY, score: 0.6, phrases: []string{"country"}},
+	{category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_GENDER, score: 0.8, phrases: []string{"gender", "sex"}},
+}
+
+// Returns the category with the highest scoring rule that matches the column name.
+// Returns PII_CATEGORY_NONE if no rules match.
+func matchColumnName(column string) (mgmtv1alpha1.PiiCategory, float64) {
+	words := splitColumnName(column)
+	bestCategory := mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE
+	var bestScore float64
+	for _, rule := range columnNameRules {
+		for _, phrase := range rule.phrases {
+			phraseWords := splitColumnName(phrase)
+			if !containsWords(words, phraseWords) {
+				continue
+			}
+			score := rule.score
+			if len(phraseWords) != len(words) {
+				score -= partialNameMatchPenalty
+			}
+			if score > bestScore {
+				bestCategory = rule.category
+				bestScore = score
+			}
+		}
+	}
+	return bestCategory, bestScore
+}
+
+// Splits a column name into its lowercase words.
+// Handles snake_case, kebab-case, camelCase, dot separated paths and numbers.
+// Example: customerIPAddress_2 -> [customer ip address 2]
+func splitColumnName(column string) []string {
+	runes := []rune(column)
+	words := []string{}
+	current := []rune{}
+	flush := func() {
+		if len(current) > 0 {
+			words = append(words, strings.ToLower(string(current)))
+			current = []rune{}
+		}
+	}
+	for idx, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if idx > 0 && len(current) > 0 {
+			prev := runes[idx-1]
+			switch {
+			case unicode.IsUpper(r) && unicode.IsLower(prev):
+				flush()
+			case unicode.IsUpper(r) && unicode.IsUpper(prev) && idx+1 < len(runes) && unicode.IsLower(runes[idx+1]):
+				flush()
+			case unicode.IsDigit(r) != unicode.IsDigit(prev):
+				flush()
+			}
+		}
+		current = append(current, r)
+	}
+	flush()
+	return words
+}
+
+// Returns true if the phrase words appear next to each other in the words
+func containsWords(words, phrase []string) bool {
+	if len(phrase) == 0 || len(phrase) > len(words) {
+		return false
+	}
+	for start := 0; start+len(phrase) <= len(words); start++ {
+		matches := true
+		for idx, word := range phrase {
+			if words[start+idx] != word {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
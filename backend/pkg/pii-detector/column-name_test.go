@@ -0,0 +1,56 @@
+package piidetector
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_splitColumnName(t *testing.T) {
+	tests := map[string][]string{
+		"first_name":          {"first", "name"},
+		"firstName":           {"first", "name"},
+		"FirstName":           {"first", "name"},
+		"customerIPAddress_2": {"customer", "ip", "address", "2"},
+		"address1":            {"address", "1"},
+		"contact.email":       {"contact", "email"},
+		"e-mail":              {"e", "mail"},
+		"SSN":                 {"ssn"},
+		"":                    {},
+	}
+	for input, expected := range tests {
+		t.Run(input, func(t *testing.T) {
+			assert.Equal(t, expected, splitColumnName(input))
+		})
+	}
+}
+
+func Test_matchColumnName(t *testing.T) {
+	tests := []struct {
+		column   string
+		category mgmtv1alpha1.PiiCategory
+		score    float64
+	}{
+		{"email", mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL, 0.8},
+		{"emailAddress", mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL, 0.8},
+		{"billing_email", mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL, 0.7},
+		{"ip_address", mgmtv1alpha1.PiiCategory_PII_CATEGORY_IP_ADDRESS, 0.8},
+		{"billing_address", mgmtv1alpha1.PiiCategory_PII_CATEGORY_STREET_ADDRESS, 0.6},
+		{"address.city", mgmtv1alpha1.PiiCategory_PII_CATEGORY_CITY, 0.7},
+		{"user_name", mgmtv1alpha1.PiiCategory_PII_CATEGORY_USERNAME, 0.7},
+		{"name", mgmtv1alpha1.PiiCategory_PII_CATEGORY_FULL_NAME, 0.35},
+		{"product_name", mgmtv1alpha1.PiiCategory_PII_CATEGORY_FULL_NAME, 0.25},
+		{"SSN", mgmtv1alpha1.PiiCategory_PII_CATEGORY_SSN, 0.9},
+		{"zip_code", mgmtv1alpha1.PiiCategory_PII_CATEGORY_ZIPCODE, 0.7},
+		{"hotel", mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE, 0},
+		{"created_at", mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.column, func(t *testing.T) {
+			category, score := matchColumnName(tt.column)
+			assert.Equal(t, tt.category, category)
+			assert.InDelta(t, tt.score, score, 0.0001)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package piidetector
+
+import (
+	"context"
+	"slices"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	presidioapi "github.com/nucleuscloud/neosync/internal/ee/presidio"
+)
+
+const (
+	// The minimum ratio of sampled values that must match a value detector for it to count as evidence
+	minValueMatchRatio = 0.5
+	// Columns are only classified as PII when the confidence is at least this value
+	minConfidence = 0.3
+	// The number of sampled values needed before values that don't match the category of the column name count against it
+	minContradictingValues = 5
+	// The column name score is multiplied by this when the sampled values contradict it
+	contradictedNameFactor = 0.25
+)
+
+// The categories of PII that may be stored in numeric columns
+var numericCategories = []mgmtv1alpha1.PiiCategory{
+	mgmtv1alpha1.PiiCategory_PII_CATEGORY_PHONE_NUMBER,
+	mgmtv1alpha1.PiiCategory_PII_CATEGORY_SSN,
+	mgmtv1alpha1.PiiCategory_PII_CATEGORY_CARD_NUMBER,
+	mgmtv1alpha1.PiiCategory_PII_CATEGORY_ZIPCODE,
+}
+
+type Column struct {
+	Schema   string
+	Table    string
+	Name     string
+	DataType string
+	// The values that were sampled from the column. Nil values are ignored.
+	Values []any
+}
+
+// Classifies the PII that is stored in the column by combining the evidence of the column name, the sampled values
+// and optionally the Presidio analyzer. The analyzer is skipped when nil.
+func ClassifyColumn(
+	ctx context.Context,
+	column *Column,
+	analyzer presidioapi.AnalyzeInterface,
+) (*mgmtv1alpha1.ColumnPiiClassification, error) {
+	var sampled int64
+	for _, value := range column.Values {
+		if value != nil {
+			sampled++
+		}
+	}
+	values, isNumeric := getDetectableValues(column.Values)
+
+	evidence := newCategoryEvidence()
+
+	nameCategory, nameScore := matchColumnName(column.Name)
+	valueRatios := matchValues(values)
+	if nameCategory != mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE && isNameEvidenceValid(nameCategory, sampled, values, isNumeric) {
+		if _, ok := valueDetectors[nameCategory]; ok && len(values) >= minContradictingValues && valueRatios[nameCategory] < minValueMatchRatio {
+			nameScore *= contradictedNameFactor
+		}
+		evidence.add(nameCategory, mgmtv1alpha1.PiiDetector_PII_DETECTOR_COLUMN_NAME, nameScore)
+	}
+
+	for category, ratio := range valueRatios {
+		if ratio >= minValueMatchRatio {
+			evidence.add(category, mgmtv1alpha1.PiiDetector_PII_DETECTOR_VALUE_PATTERN, ratio)
+		}
+	}
+
+	if analyzer != nil && !isNumeric {
+		presidioScores, err := analyzeValues(ctx, analyzer, values, nameCategory)
+		if err != nil {
+			return nil, err
+		}
+		for category, score := range presidioScores {
+			evidence.add(category, mgmtv1alpha1.PiiDetector_PII_DETECTOR_PRESIDIO, score)
+		}
+	}
+
+	classification := &mgmtv1alpha1.ColumnPiiClassification{
+		Schema:        column.Schema,
+		Table:         column.Table,
+		Column:        column.Name,
+		DataType:      column.DataType,
+		Category:      mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE,
+		Detectors:     []mgmtv1alpha1.PiiDetector{},
+		SampledValues: sampled,
+	}
+	category, confidence, detectors := evidence.best()
+	if confidence < minConfidence {
+		return classification, nil
+	}
+	classification.Category = category
+	classification.Confidence = confidence
+	classification.Detectors = detectors
+	classification.SuggestedTransformer = getSuggestedTransformer(category, values, isNumeric)
+	return classification, nil
+}
+
+// The column name is not used as evidence when every sampled value is of a type that can't hold the category.
+// For example, email_verified booleans or city_id foreign keys.
+func isNameEvidenceValid(category mgmtv1alpha1.PiiCategory, sampled int64, values []string, isNumeric bool) bool {
+	if sampled > 0 && len(values) == 0 {
+		return false
+	}
+	if isNumeric && !slices.Contains(numericCategories, category) {
+		return false
+	}
+	return true
+}
+
+type detectorScore struct {
+	detector mgmtv1alpha1.PiiDetector
+	score    float64
+}
+
+// Collects the scores of every detector for each category
+type categoryEvidence struct {
+	scores map[mgmtv1alpha1.PiiCategory][]*detectorScore
+}
+
+func newCategoryEvidence() *categoryEvidence {
+	return &categoryEvidence{scores: map[mgmtv1alpha1.PiiCategory][]*detectorScore{}}
+}
+
+func (c *categoryEvidence) add(category mgmtv1alpha1.PiiCategory, detector mgmtv1alpha1.PiiDetector, score float64) {
+	if score <= 0 {
+		return
+	}
+	c.scores[category] = append(c.scores[category], &detectorScore{detector: detector, score: min(score, 1)})
+}
+
+// Returns the category with the highest confidence along with the detectors that found evidence of it.
+// The confidence of a category combines the scores of its detectors as independent evidence: 1 - (1-a)(1-b)...
+// Ties are broken by the order of the categories.
+func (c *categoryEvidence) best() (mgmtv1alpha1.PiiCategory, float64, []mgmtv1alpha1.PiiDetector) {
+	categories := make([]mgmtv1alpha1.PiiCategory, 0, len(c.scores))
+	for category := range c.scores {
+		categories = append(categories, category)
+	}
+	slices.Sort(categories)
+
+	bestCategory := mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE
+	var bestConfidence float64
+	var bestDetectors []mgmtv1alpha1.PiiDetector
+	for _, category := range categories {
+		missed := 1.0
+		detectors := []mgmtv1alpha1.PiiDetector{}
+		for _, ds := range c.scores[category] {
+			missed *= 1 - ds.score
+			detectors = append(detectors, ds.detector)
+		}
+		confidence := 1 - missed
+		if confidence > bestConfidence {
+			bestCategory = category
+			bestConfidence = confidence
+			bestDetectors = detectors
+		}
+	}
+	slices.Sort(bestDetectors)
+	return bestCategory, bestConfidence, bestDetectors
+}
@@ -0,0 +1,214 @@
+package piidetector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	presidioapi "github.com/nucleuscloud/neosync/internal/ee/presidio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func getRepeatedValues(value any, count int) []any {
+	values := make([]any, 0, count)
+	for i := 0; i < count; i++ {
+		values = append(values, value)
+	}
+	return values
+}
+
+func Test_ClassifyColumn(t *testing.T) {
+	tests := []struct {
+		name      string
+		column    *Column
+		category  mgmtv1alpha1.PiiCategory
+		detectors []mgmtv1alpha1.PiiDetector
+	}{
+		{
+			name:      "name and values",
+			column:    &Column{Name: "email", Values: []any{"jane@example.com", "john@example.com", nil}},
+			category:  mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL,
+			detectors: []mgmtv1alpha1.PiiDetector{mgmtv1alpha1.PiiDetector_PII_DETECTOR_COLUMN_NAME, mgmtv1alpha1.PiiDetector_PII_DETECTOR_VALUE_PATTERN},
+		},
+		{
+			name:      "values only",
+			column:    &Column{Name: "contact", Values: []any{"+1 (415) 555-2671", "+44 20 7946 0958"}},
+			category:  mgmtv1alpha1.PiiCategory_PII_CATEGORY_PHONE_NUMBER,
+			detectors: []mgmtv1alpha1.PiiDetector{mgmtv1alpha1.PiiDetector_PII_DETECTOR_VALUE_PATTERN},
+		},
+		{
+			name:      "name only",
+			column:    &Column{Name: "first_name", Values: []any{"Jane", "John"}},
+			category:  mgmtv1alpha1.PiiCategory_PII_CATEGORY_FIRST_NAME,
+			detectors: []mgmtv1alpha1.PiiDetector{mgmtv1alpha1.PiiDetector_PII_DETECTOR_COLUMN_NAME},
+		},
+		{
+			name:      "numeric card numbers",
+			column:    &Column{Name: "card_number", Values: []any{int64(4111111111111111), int64(5500000000000004)}},
+			category:  mgmtv1alpha1.PiiCategory_PII_CATEGORY_CARD_NUMBER,
+			detectors: []mgmtv1alpha1.PiiDetector{mgmtv1alpha1.PiiDetector_PII_DETECTOR_COLUMN_NAME, mgmtv1alpha1.PiiDetector_PII_DETECTOR_VALUE_PATTERN},
+		},
+		{
+			name:     "foreign key",
+			column:   &Column{Name: "city_id", Values: []any{int64(1), int64(2)}},
+			category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE,
+		},
+		{
+			name:     "boolean",
+			column:   &Column{Name: "email_verified", Values: []any{true, false}},
+			category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE,
+		},
+		{
+			name:     "contradicted by values",
+			column:   &Column{Name: "email_status", Values: getRepeatedValues("delivered", 10)},
+			category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE,
+		},
+		{
+			name:     "no pii",
+			column:   &Column{Name: "total", Values: []any{int64(10), int64(20)}},
+			category: mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ClassifyColumn(context.Background(), tt.column, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.category, actual.GetCategory())
+			if tt.category == mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE {
+				assert.Zero(t, actual.GetConfidence())
+				assert.Empty(t, actual.GetDetectors())
+				assert.Nil(t, actual.GetSuggestedTransformer())
+				return
+			}
+			assert.Equal(t, tt.detectors, actual.GetDetectors())
+			assert.GreaterOrEqual(t, actual.GetConfidence(), float64(minConfidence))
+			assert.LessOrEqual(t, actual.GetConfidence(), float64(1))
+			assert.NotNil(t, actual.GetSuggestedTransformer())
+		})
+	}
+}
+
+func Test_ClassifyColumn_Confidence(t *testing.T) {
+	nameOnly, err := ClassifyColumn(context.Background(), &Column{Name: "email", Values: []any{}}, nil)
+	require.NoError(t, err)
+	nameAndValues, err := ClassifyColumn(context.Background(), &Column{Name: "email", Values: []any{"jane@example.com", "jane"}}, nil)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 0.8, nameOnly.GetConfidence(), 0.0001)
+	assert.InDelta(t, 0.9, nameAndValues.GetConfidence(), 0.0001)
+	assert.Equal(t, int64(2), nameAndValues.GetSampledValues())
+}
+
+func Test_ClassifyColumn_SuggestedTransformer(t *testing.T) {
+	numeric, err := ClassifyColumn(context.Background(), &Column{Name: "phone", Values: []any{int64(4155552671)}}, nil)
+	require.NoError(t, err)
+	assert.NotNil(t, numeric.GetSuggestedTransformer().GetTransformInt64PhoneNumberConfig())
+
+	text, err := ClassifyColumn(context.Background(), &Column{Name: "phone", Values: []any{"415-555-2671"}}, nil)
+	require.NoError(t, err)
+	assert.NotNil(t, text.GetSuggestedTransformer().GetTransformPhoneNumberConfig())
+
+	state, err := ClassifyColumn(context.Background(), &Column{Name: "state", Values: []any{"CA", "NY"}}, nil)
+	require.NoError(t, err)
+	assert.False(t, state.GetSuggestedTransformer().GetGenerateStateConfig().GetGenerateFullName())
+}
+
+func mockAnalyzeResponse(results ...presidioapi.RecognizerResultWithAnaysisExplanation) *presidioapi.PostAnalyzeResponse {
+	return &presidioapi.PostAnalyzeResponse{JSON200: &results}
+}
+
+func Test_ClassifyColumn_Presidio(t *testing.T) {
+	t.Run("whole value", func(t *testing.T) {
+		analyzer := presidioapi.NewMockAnalyzeInterface(t)
+		analyzer.On("PostAnalyzeWithResponse", mock.Anything, mock.MatchedBy(func(req presidioapi.AnalyzeRequest) bool {
+			return req.Text == "Jane Doe\n\nJohn Doe"
+		})).
+			Return(mockAnalyzeResponse(
+				presidioapi.RecognizerResultWithAnaysisExplanation{EntityType: "PERSON", Start: 0, End: 8, Score: 0.85},
+				presidioapi.RecognizerResultWithAnaysisExplanation{EntityType: "PERSON", Start: 10, End: 18, Score: 0.85},
+			), nil).Once()
+
+		actual, err := ClassifyColumn(context.Background(), &Column{Name: "owner", Values: []any{"Jane Doe", "John Doe"}}, analyzer)
+		require.NoError(t, err)
+		assert.Equal(t, mgmtv1alpha1.PiiCategory_PII_CATEGORY_FULL_NAME, actual.GetCategory())
+		assert.InDelta(t, 0.85, actual.GetConfidence(), 0.0001)
+		assert.Equal(t, []mgmtv1alpha1.PiiDetector{mgmtv1alpha1.PiiDetector_PII_DETECTOR_PRESIDIO}, actual.GetDetectors())
+	})
+
+	t.Run("uses the category of the column name", func(t *testing.T) {
+		analyzer := presidioapi.NewMockAnalyzeInterface(t)
+		analyzer.On("PostAnalyzeWithResponse", mock.Anything, mock.Anything).
+			Return(mockAnalyzeResponse(
+				presidioapi.RecognizerResultWithAnaysisExplanation{EntityType: "PERSON", Start: 0, End: 4, Score: 0.85},
+				presidioapi.RecognizerResultWithAnaysisExplanation{EntityType: "PERSON", Start: 6, End: 10, Score: 0.85},
+			), nil)
+
+		actual, err := ClassifyColumn(context.Background(), &Column{Name: "surname", Values: []any{"Doe1", "Roe2"}}, analyzer)
+		require.NoError(t, err)
+		assert.Equal(t, mgmtv1alpha1.PiiCategory_PII_CATEGORY_LAST_NAME, actual.GetCategory())
+		assert.Equal(t, []mgmtv1alpha1.PiiDetector{mgmtv1alpha1.PiiDetector_PII_DETECTOR_COLUMN_NAME, mgmtv1alpha1.PiiDetector_PII_DETECTOR_PRESIDIO}, actual.GetDetectors())
+	})
+
+	t.Run("free text", func(t *testing.T) {
+		analyzer := presidioapi.NewMockAnalyzeInterface(t)
+		analyzer.On("PostAnalyzeWithResponse", mock.Anything, mock.Anything).
+			Return(mockAnalyzeResponse(presidioapi.RecognizerResultWithAnaysisExplanation{EntityType: "EMAIL_ADDRESS", Start: 11, End: 27, Score: 1}), nil)
+
+		actual, err := ClassifyColumn(context.Background(), &Column{Name: "notes", Values: []any{"Reach out jane@example.com"}}, analyzer)
+		require.NoError(t, err)
+		assert.Equal(t, mgmtv1alpha1.PiiCategory_PII_CATEGORY_FREE_TEXT, actual.GetCategory())
+		assert.NotNil(t, actual.GetSuggestedTransformer().GetTransformPiiTextConfig())
+	})
+
+	t.Run("limits the analyzed values", func(t *testing.T) {
+		analyzer := presidioapi.NewMockAnalyzeInterface(t)
+		analyzer.On("PostAnalyzeWithResponse", mock.Anything, mock.Anything).Return(mockAnalyzeResponse(), nil)
+
+		values := []any{}
+		for i := 0; i < 50; i++ {
+			values = append(values, fmt.Sprintf("value %d", i))
+		}
+		actual, err := ClassifyColumn(context.Background(), &Column{Name: "notes", Values: values}, analyzer)
+		require.NoError(t, err)
+		assert.Equal(t, mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE, actual.GetCategory())
+		analyzer.AssertNumberOfCalls(t, "PostAnalyzeWithResponse", 1)
+		req := analyzer.Calls[0].Arguments.Get(1).(presidioapi.AnalyzeRequest)
+		assert.Len(t, strings.Split(req.Text, presidioValueSeparator), maxPresidioValues)
+	})
+
+	t.Run("maps entities to their values", func(t *testing.T) {
+		analyzer := presidioapi.NewMockAnalyzeInterface(t)
+		analyzer.On("PostAnalyzeWithResponse", mock.Anything, mock.Anything).
+			Return(mockAnalyzeResponse(
+				// the whole second value
+				presidioapi.RecognizerResultWithAnaysisExplanation{EntityType: "EMAIL_ADDRESS", Start: 22, End: 38, Score: 1},
+				// only a part of the first value
+				presidioapi.RecognizerResultWithAnaysisExplanation{EntityType: "EMAIL_ADDRESS", Start: 5, End: 20, Score: 1},
+			), nil)
+
+		scores, err := analyzeValues(context.Background(), analyzer, []string{"mail bob@example.com", "", "jane@example.com"}, mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE)
+		require.NoError(t, err)
+		assert.InDelta(t, 1.0/3, scores[mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL], 0.0001)
+		assert.InDelta(t, 1.0/3, scores[mgmtv1alpha1.PiiCategory_PII_CATEGORY_FREE_TEXT], 0.0001)
+	})
+
+	t.Run("skips numeric columns", func(t *testing.T) {
+		analyzer := presidioapi.NewMockAnalyzeInterface(t)
+		_, err := ClassifyColumn(context.Background(), &Column{Name: "total", Values: []any{int64(1)}}, analyzer)
+		require.NoError(t, err)
+		analyzer.AssertNotCalled(t, "PostAnalyzeWithResponse", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		analyzer := presidioapi.NewMockAnalyzeInterface(t)
+		analyzer.On("PostAnalyzeWithResponse", mock.Anything, mock.Anything).Return(nil, errors.New("unavailable"))
+		_, err := ClassifyColumn(context.Background(), &Column{Name: "notes", Values: []any{"text"}}, analyzer)
+		require.Error(t, err)
+	})
+}
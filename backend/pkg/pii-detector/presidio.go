@@ -0,0 +1,143 @@
+package piidetector
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	presidioapi "github.com/nucleuscloud/neosync/internal/ee/presidio"
+)
+
+const (
+	// The maximum number of values of a column that are sent to the analyzer
+	maxPresidioValues = 20
+	// The minimal detection score of the entities that are returned by the analyzer
+	presidioScoreThreshold = 0.5
+
+	presidioLanguage = "en"
+	// Separates the values of a column in the text that is sent to the analyzer
+	presidioValueSeparator = "\n\n"
+)
+
+// The categories that each Presidio entity type may be classified as.
+// The first category is used unless the column name matched one of the others.
+var presidioEntityCategories = map[string][]mgmtv1alpha1.PiiCategory{
+	"EMAIL_ADDRESS": {mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL},
+	"PHONE_NUMBER":  {mgmtv1alpha1.PiiCategory_PII_CATEGORY_PHONE_NUMBER},
+	"US_SSN":        {mgmtv1alpha1.PiiCategory_PII_CATEGORY_SSN},
+	"CREDIT_CARD":   {mgmtv1alpha1.PiiCategory_PII_CATEGORY_CARD_NUMBER},
+	"IP_ADDRESS":    {mgmtv1alpha1.PiiCategory_PII_CATEGORY_IP_ADDRESS},
+	"PERSON": {
+		mgmtv1alpha1.PiiCategory_PII_CATEGORY_FULL_NAME,
+		mgmtv1alpha1.PiiCategory_PII_CATEGORY_FIRST_NAME,
+		mgmtv1alpha1.PiiCategory_PII_CATEGORY_LAST_NAME,
+		mgmtv1alpha1.PiiCategory_PII_CATEGORY_USERNAME,
+	},
+	"LOCATION": {
+		mgmtv1alpha1.PiiCategory_PII_CATEGORY_STREET_ADDRESS,
+		mgmtv1alpha1.PiiCategory_PII_CATEGORY_CITY,
+		mgmtv1alpha1.PiiCategory_PII_CATEGORY_STATE,
+		mgmtv1alpha1.PiiCategory_PII_CATEGORY_COUNTRY,
+	},
+}
+
+// Runs the values through the Presidio analyzer and returns a score for each category that was recognized.
+// The values of a column are joined into a single text so that the column is analyzed with one request.
+// An entity that spans the entire value classifies the value as the category of the entity,
+// while an entity that is only part of a value classifies it as free text.
+// The score of a category is the ratio of analyzed values it was recognized in multiplied by the average recognition score.
+func analyzeValues(
+	ctx context.Context,
+	analyzer presidioapi.AnalyzeInterface,
+	values []string,
+	nameCategory mgmtv1alpha1.PiiCategory,
+) (map[mgmtv1alpha1.PiiCategory]float64, error) {
+	scores := map[mgmtv1alpha1.PiiCategory]float64{}
+	analyzed := values[:min(len(values), maxPresidioValues)]
+	text, spans := joinPresidioValues(analyzed)
+	if len(spans) == 0 {
+		return scores, nil
+	}
+
+	threshold := float64(presidioScoreThreshold)
+	resp, err := analyzer.PostAnalyzeWithResponse(ctx, presidioapi.AnalyzeRequest{
+		Text:           text,
+		Language:       presidioLanguage,
+		ScoreThreshold: &threshold,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to analyze values: %w", err)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("received non-200 response from analyzer: %s %d %s", resp.Status(), resp.StatusCode(), string(resp.Body))
+	}
+
+	// the highest score of each category recognized in each value
+	valueScores := make([]map[mgmtv1alpha1.PiiCategory]float64, len(spans))
+	for _, result := range *resp.JSON200 {
+		categories, ok := presidioEntityCategories[result.EntityType]
+		if !ok {
+			continue
+		}
+		idx := sort.Search(len(spans), func(i int) bool { return spans[i].end > result.Start })
+		if idx == len(spans) || result.Start < spans[idx].start {
+			// the entity starts in the separator between two values
+			continue
+		}
+		span := spans[idx]
+		category := mgmtv1alpha1.PiiCategory_PII_CATEGORY_FREE_TEXT
+		if result.Start == span.start && result.End >= span.end {
+			category = categories[0]
+			if slices.Contains(categories, nameCategory) {
+				category = nameCategory
+			}
+		}
+		if valueScores[idx] == nil {
+			valueScores[idx] = map[mgmtv1alpha1.PiiCategory]float64{}
+		}
+		valueScores[idx][category] = max(valueScores[idx][category], result.Score)
+	}
+
+	totals := map[mgmtv1alpha1.PiiCategory]float64{}
+	for _, categoryScores := range valueScores {
+		for category, score := range categoryScores {
+			totals[category] += score
+		}
+	}
+	for category, total := range totals {
+		scores[category] = total / float64(len(analyzed))
+	}
+	return scores, nil
+}
+
+// The character offsets of a value in the text that is sent to the analyzer
+type presidioValueSpan struct {
+	start int
+	end   int
+}
+
+// Joins the non-empty values with a separator that the analyzer treats as a paragraph break, so that entities do not span several values.
+// Returns the text along with the offsets of each value, which are counted in characters as they are by the analyzer.
+func joinPresidioValues(values []string) (string, []presidioValueSpan) {
+	var sb strings.Builder
+	spans := make([]presidioValueSpan, 0, len(values))
+	var offset int
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		if len(spans) > 0 {
+			sb.WriteString(presidioValueSeparator)
+			offset += utf8.RuneCountInString(presidioValueSeparator)
+		}
+		length := utf8.RuneCountInString(value)
+		sb.WriteString(value)
+		spans = append(spans, presidioValueSpan{start: offset, end: offset + length})
+		offset += length
+	}
+	return sb.String(), spans
+}
@@ -0,0 +1,135 @@
+package piidetector
+
+import (
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+const (
+	generateIpAddressCode = `return [0, 0, 0, 0].map(() => Math.floor(Math.random() * 256)).join('.');`
+
+	// The minimum score of PII that is anonymized by the suggested transform_pii_text transformer
+	piiTextScoreThreshold = 0.5
+)
+
+// Returns the transformer that is suggested for a column that contains the category of PII.
+// The values that were sampled from the column are used to pick between the string and numeric variants of a transformer.
+// Returns nil if there is no suggestion for the category.
+func getSuggestedTransformer(category mgmtv1alpha1.PiiCategory, values []string, isNumeric bool) *mgmtv1alpha1.TransformerConfig {
+	switch category {
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL:
+		invalidEmailAction := mgmtv1alpha1.InvalidEmailAction_INVALID_EMAIL_ACTION_GENERATE
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformEmailConfig{
+				TransformEmailConfig: &mgmtv1alpha1.TransformEmail{InvalidEmailAction: &invalidEmailAction},
+			},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_PHONE_NUMBER:
+		if isNumeric {
+			return &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformInt64PhoneNumberConfig{
+					TransformInt64PhoneNumberConfig: &mgmtv1alpha1.TransformInt64PhoneNumber{PreserveLength: true},
+				},
+			}
+		}
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformPhoneNumberConfig{
+				TransformPhoneNumberConfig: &mgmtv1alpha1.TransformPhoneNumber{PreserveLength: true},
+			},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_SSN:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateSsnConfig{GenerateSsnConfig: &mgmtv1alpha1.GenerateSSN{}},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_CARD_NUMBER:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateCardNumberConfig{
+				GenerateCardNumberConfig: &mgmtv1alpha1.GenerateCardNumber{ValidLuhn: true},
+			},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_IP_ADDRESS:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateJavascriptConfig{
+				GenerateJavascriptConfig: &mgmtv1alpha1.GenerateJavascript{Code: generateIpAddressCode},
+			},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_FIRST_NAME:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig{
+				TransformFirstNameConfig: &mgmtv1alpha1.TransformFirstName{},
+			},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_LAST_NAME:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformLastNameConfig{
+				TransformLastNameConfig: &mgmtv1alpha1.TransformLastName{},
+			},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_FULL_NAME:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformFullNameConfig{
+				TransformFullNameConfig: &mgmtv1alpha1.TransformFullName{},
+			},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_USERNAME:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateUsernameConfig{GenerateUsernameConfig: &mgmtv1alpha1.GenerateUsername{}},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_PASSWORD:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateSha256HashConfig{GenerateSha256HashConfig: &mgmtv1alpha1.GenerateSha256Hash{}},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_STREET_ADDRESS:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateStreetAddressConfig{
+				GenerateStreetAddressConfig: &mgmtv1alpha1.GenerateStreetAddress{},
+			},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_CITY:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateCityConfig{GenerateCityConfig: &mgmtv1alpha1.GenerateCity{}},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_STATE:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateStateConfig{
+				GenerateStateConfig: &mgmtv1alpha1.GenerateState{GenerateFullName: getMaxLength(values) > 2},
+			},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_ZIPCODE:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateZipcodeConfig{GenerateZipcodeConfig: &mgmtv1alpha1.GenerateZipcode{}},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_COUNTRY:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateCountryConfig{
+				GenerateCountryConfig: &mgmtv1alpha1.GenerateCountry{GenerateFullName: getMaxLength(values) > 2},
+			},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_GENDER:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_GenerateGenderConfig{
+				GenerateGenderConfig: &mgmtv1alpha1.GenerateGender{Abbreviate: len(values) > 0 && getMaxLength(values) == 1},
+			},
+		}
+	case mgmtv1alpha1.PiiCategory_PII_CATEGORY_FREE_TEXT:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformPiiTextConfig{
+				TransformPiiTextConfig: &mgmtv1alpha1.TransformPiiText{
+					ScoreThreshold: piiTextScoreThreshold,
+					DefaultAnonymizer: &mgmtv1alpha1.PiiAnonymizer{
+						Config: &mgmtv1alpha1.PiiAnonymizer_Replace_{Replace: &mgmtv1alpha1.PiiAnonymizer_Replace{}},
+					},
+				},
+			},
+		}
+	default:
+		return nil
+	}
+}
+
+func getMaxLength(values []string) int {
+	var maxLength int
+	for _, value := range values {
+		maxLength = max(maxLength, len([]rune(value)))
+	}
+	return maxLength
+}
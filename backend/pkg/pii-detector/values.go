@@ -0,0 +1,138 @@
+package piidetector
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+var (
+	emailRegex = regexp.MustCompile(`^[a-zA-Z0-9.!#$%&'*+/=?^_{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)+$`)
+	ssnRegex   = regexp.MustCompile(`^(\d{3})-(\d{2})-(\d{4})$`)
+	phoneRegex = regexp.MustCompile(`^\+?[0-9][0-9 ().-]{5,22}[0-9]$`)
+)
+
+// The detectors that are run against every sampled value. A value may match more than one detector.
+var valueDetectors = map[mgmtv1alpha1.PiiCategory]func(value string) bool{
+	mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL:        isEmail,
+	mgmtv1alpha1.PiiCategory_PII_CATEGORY_SSN:          isSsn,
+	mgmtv1alpha1.PiiCategory_PII_CATEGORY_CARD_NUMBER:  isCardNumber,
+	mgmtv1alpha1.PiiCategory_PII_CATEGORY_PHONE_NUMBER: isPhoneNumber,
+	mgmtv1alpha1.PiiCategory_PII_CATEGORY_IP_ADDRESS:   isIpAddress,
+}
+
+// Returns the ratio of values that match each of the value detectors
+func matchValues(values []string) map[mgmtv1alpha1.PiiCategory]float64 {
+	ratios := map[mgmtv1alpha1.PiiCategory]float64{}
+	if len(values) == 0 {
+		return ratios
+	}
+	for category, detect := range valueDetectors {
+		var matches int
+		for _, value := range values {
+			if detect(value) {
+				matches++
+			}
+		}
+		if matches > 0 {
+			ratios[category] = float64(matches) / float64(len(values))
+		}
+	}
+	return ratios
+}
+
+func isEmail(value string) bool {
+	return len(value) <= 254 && emailRegex.MatchString(value)
+}
+
+// Matches social security numbers in the AAA-GG-SSSS format, excluding the numbers that are never assigned
+func isSsn(value string) bool {
+	parts := ssnRegex.FindStringSubmatch(value)
+	if parts == nil {
+		return false
+	}
+	area, group, serial := parts[1], parts[2], parts[3]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+// Matches card numbers of 13 to 19 digits that pass the Luhn checksum. Digits may be separated by spaces or dashes.
+func isCardNumber(value string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(value)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return isValidLuhn(digits)
+}
+
+func isValidLuhn(digits string) bool {
+	var sum int
+	double := false
+	for idx := len(digits) - 1; idx >= 0; idx-- {
+		digit := int(digits[idx] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// Matches phone numbers of 10 to 15 digits. Plain numbers are only matched when they start with a +
+// so that identifiers and amounts are not mistaken for phone numbers.
+func isPhoneNumber(value string) bool {
+	if !phoneRegex.MatchString(value) {
+		return false
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil && !strings.HasPrefix(value, "+") {
+		return false
+	}
+	var digits int
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+	return digits >= 10 && digits <= 15
+}
+
+func isIpAddress(value string) bool {
+	return strings.ContainsAny(value, ".:") && net.ParseIP(value) != nil
+}
+
+// Returns the values that can be run through the detectors as strings.
+// Integers are included as some PII, such as phone numbers, is often stored in numeric columns.
+// The second return value is true if every returned value is an integer.
+func getDetectableValues(values []any) (detectable []string, isNumeric bool) {
+	detectable = []string{}
+	isNumeric = true
+	for _, value := range values {
+		switch v := value.(type) {
+		case string:
+			detectable = append(detectable, v)
+			isNumeric = false
+		case []byte:
+			if !utf8.Valid(v) {
+				continue
+			}
+			detectable = append(detectable, string(v))
+			isNumeric = false
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			detectable = append(detectable, fmt.Sprintf("%d", v))
+		}
+	}
+	return detectable, isNumeric && len(detectable) > 0
+}
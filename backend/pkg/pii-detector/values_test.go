@@ -0,0 +1,74 @@
+package piidetector
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isEmail(t *testing.T) {
+	assert.True(t, isEmail("jane.doe+test@example.co.uk"))
+	assert.False(t, isEmail("jane.doe"))
+	assert.False(t, isEmail("jane@localhost"))
+	assert.False(t, isEmail("contact jane@example.com"))
+}
+
+func Test_isSsn(t *testing.T) {
+	assert.True(t, isSsn("123-45-6789"))
+	assert.False(t, isSsn("123456789"))
+	assert.False(t, isSsn("000-45-6789"))
+	assert.False(t, isSsn("666-45-6789"))
+	assert.False(t, isSsn("912-45-6789"))
+	assert.False(t, isSsn("123-00-6789"))
+	assert.False(t, isSsn("123-45-0000"))
+}
+
+func Test_isCardNumber(t *testing.T) {
+	assert.True(t, isCardNumber("4111111111111111"))
+	assert.True(t, isCardNumber("4111 1111 1111 1111"))
+	assert.True(t, isCardNumber("3782-822463-10005"))
+	assert.False(t, isCardNumber("4111111111111112"), "should fail the luhn checksum")
+	assert.False(t, isCardNumber("411111111111"), "should be too short")
+	assert.False(t, isCardNumber("4111-1111-1111-111a"))
+}
+
+func Test_isPhoneNumber(t *testing.T) {
+	assert.True(t, isPhoneNumber("+1 (415) 555-2671"))
+	assert.True(t, isPhoneNumber("415-555-2671"))
+	assert.True(t, isPhoneNumber("+14155552671"))
+	assert.False(t, isPhoneNumber("4155552671"), "plain numbers should not be matched")
+	assert.False(t, isPhoneNumber("1234567890.12"))
+	assert.False(t, isPhoneNumber("2024-01-01"))
+	assert.False(t, isPhoneNumber("123-45-6789"))
+}
+
+func Test_isIpAddress(t *testing.T) {
+	assert.True(t, isIpAddress("192.168.0.1"))
+	assert.True(t, isIpAddress("2001:db8::1"))
+	assert.False(t, isIpAddress("192.168.0"))
+	assert.False(t, isIpAddress("1234"))
+}
+
+func Test_matchValues(t *testing.T) {
+	ratios := matchValues([]string{"jane@example.com", "john@example.com", "not an email", "192.168.0.1"})
+	assert.Equal(t, map[mgmtv1alpha1.PiiCategory]float64{
+		mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL:      0.5,
+		mgmtv1alpha1.PiiCategory_PII_CATEGORY_IP_ADDRESS: 0.25,
+	}, ratios)
+	assert.Empty(t, matchValues(nil))
+}
+
+func Test_getDetectableValues(t *testing.T) {
+	values, isNumeric := getDetectableValues([]any{"a", []byte("b"), int64(1), nil, true, 1.5})
+	assert.Equal(t, []string{"a", "b", "1"}, values)
+	assert.False(t, isNumeric)
+
+	values, isNumeric = getDetectableValues([]any{int64(1), int32(2), nil})
+	assert.Equal(t, []string{"1", "2"}, values)
+	assert.True(t, isNumeric)
+
+	values, isNumeric = getDetectableValues([]any{true, nil})
+	assert.Empty(t, values)
+	assert.False(t, isNumeric)
+}
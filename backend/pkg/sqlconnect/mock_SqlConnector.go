@@ -22,9 +22,9 @@ func (_m *MockSqlConnector) EXPECT() *MockSqlConnector_Expecter {
 	return &MockSqlConnector_Expecter{mock: &_m.Mock}
 }
 
-// NewDbFromConnectionConfig provides a mock function with given fields: connectionConfig, connectionTimeout, logger
-func (_m *MockSqlConnector) NewDbFromConnectionConfig(connectionConfig *mgmtv1alpha1.ConnectionConfig, connectionTimeout *uint32, logger *slog.Logger) (SqlDbContainer, error) {
-	ret := _m.Called(connectionConfig, connectionTimeout, logger)
+// NewDbFromConnectionConfig provides a mock function with given fields: accountId, connectionConfig, connectionTimeout, logger
+func (_m *MockSqlConnector) NewDbFromConnectionConfig(accountId string, connectionConfig *mgmtv1alpha1.ConnectionConfig, connectionTimeout *uint32, logger *slog.Logger) (SqlDbContainer, error) {
+	ret := _m.Called(accountId, connectionConfig, connectionTimeout, logger)
 
 	if len(ret) == 0 {
 		panic("no return value specified for NewDbFromConnectionConfig")
@@ -32,19 +32,19 @@ func (_m *MockSqlConnector) NewDbFromConnectionConfig(connectionConfig *mgmtv1al
 
 	var r0 SqlDbContainer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*mgmtv1alpha1.ConnectionConfig, *uint32, *slog.Logger) (SqlDbContainer, error)); ok {
-		return rf(connectionConfig, connectionTimeout, logger)
+	if rf, ok := ret.Get(0).(func(string, *mgmtv1alpha1.ConnectionConfig, *uint32, *slog.Logger) (SqlDbContainer, error)); ok {
+		return rf(accountId, connectionConfig, connectionTimeout, logger)
 	}
-	if rf, ok := ret.Get(0).(func(*mgmtv1alpha1.ConnectionConfig, *uint32, *slog.Logger) SqlDbContainer); ok {
-		r0 = rf(connectionConfig, connectionTimeout, logger)
+	if rf, ok := ret.Get(0).(func(string, *mgmtv1alpha1.ConnectionConfig, *uint32, *slog.Logger) SqlDbContainer); ok {
+		r0 = rf(accountId, connectionConfig, connectionTimeout, logger)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(SqlDbContainer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*mgmtv1alpha1.ConnectionConfig, *uint32, *slog.Logger) error); ok {
-		r1 = rf(connectionConfig, connectionTimeout, logger)
+	if rf, ok := ret.Get(1).(func(string, *mgmtv1alpha1.ConnectionConfig, *uint32, *slog.Logger) error); ok {
+		r1 = rf(accountId, connectionConfig, connectionTimeout, logger)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -58,16 +58,17 @@ type MockSqlConnector_NewDbFromConnectionConfig_Call struct {
 }
 
 // NewDbFromConnectionConfig is a helper method to define mock.On call
+//   - accountId string
 //   - connectionConfig *mgmtv1alpha1.ConnectionConfig
 //   - connectionTimeout *uint32
 //   - logger *slog.Logger
-func (_e *MockSqlConnector_Expecter) NewDbFromConnectionConfig(connectionConfig interface{}, connectionTimeout interface{}, logger interface{}) *MockSqlConnector_NewDbFromConnectionConfig_Call {
-	return &MockSqlConnector_NewDbFromConnectionConfig_Call{Call: _e.mock.On("NewDbFromConnectionConfig", connectionConfig, connectionTimeout, logger)}
+func (_e *MockSqlConnector_Expecter) NewDbFromConnectionConfig(accountId interface{}, connectionConfig interface{}, connectionTimeout interface{}, logger interface{}) *MockSqlConnector_NewDbFromConnectionConfig_Call {
+	return &MockSqlConnector_NewDbFromConnectionConfig_Call{Call: _e.mock.On("NewDbFromConnectionConfig", accountId, connectionConfig, connectionTimeout, logger)}
 }
 
-func (_c *MockSqlConnector_NewDbFromConnectionConfig_Call) Run(run func(connectionConfig *mgmtv1alpha1.ConnectionConfig, connectionTimeout *uint32, logger *slog.Logger)) *MockSqlConnector_NewDbFromConnectionConfig_Call {
+func (_c *MockSqlConnector_NewDbFromConnectionConfig_Call) Run(run func(accountId string, connectionConfig *mgmtv1alpha1.ConnectionConfig, connectionTimeout *uint32, logger *slog.Logger)) *MockSqlConnector_NewDbFromConnectionConfig_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*mgmtv1alpha1.ConnectionConfig), args[1].(*uint32), args[2].(*slog.Logger))
+		run(args[0].(string), args[1].(*mgmtv1alpha1.ConnectionConfig), args[2].(*uint32), args[3].(*slog.Logger))
 	})
 	return _c
 }
@@ -77,7 +78,7 @@ func (_c *MockSqlConnector_NewDbFromConnectionConfig_Call) Return(_a0 SqlDbConta
 	return _c
 }
 
-func (_c *MockSqlConnector_NewDbFromConnectionConfig_Call) RunAndReturn(run func(*mgmtv1alpha1.ConnectionConfig, *uint32, *slog.Logger) (SqlDbContainer, error)) *MockSqlConnector_NewDbFromConnectionConfig_Call {
+func (_c *MockSqlConnector_NewDbFromConnectionConfig_Call) RunAndReturn(run func(string, *mgmtv1alpha1.ConnectionConfig, *uint32, *slog.Logger) (SqlDbContainer, error)) *MockSqlConnector_NewDbFromConnectionConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -14,11 +14,15 @@ import (
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/pkg/clienttls"
 	dbconnectconfig "github.com/nucleuscloud/neosync/backend/pkg/dbconnect-config"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 	tun "github.com/nucleuscloud/neosync/internal/sshtunnel"
 	"github.com/nucleuscloud/neosync/internal/sshtunnel/connectors/mssqltunconnector"
 	"github.com/nucleuscloud/neosync/internal/sshtunnel/connectors/mysqltunconnector"
 	"github.com/nucleuscloud/neosync/internal/sshtunnel/connectors/postgrestunconnector"
 	"golang.org/x/crypto/ssh"
+
+	// registers the "sqlite" database/sql driver
+	_ "modernc.org/sqlite"
 )
 
 // interface used by SqlConnector to abstract away the opening and closing of a sqldb that includes tunnelingff
@@ -35,15 +39,28 @@ type SqlDBTX interface {
 }
 
 type SqlConnector interface {
-	NewDbFromConnectionConfig(connectionConfig *mgmtv1alpha1.ConnectionConfig, connectionTimeout *uint32, logger *slog.Logger) (SqlDbContainer, error)
+	// The account id is the account the connection belongs to and is used to confine sqlite database files to the account's directory
+	NewDbFromConnectionConfig(accountId string, connectionConfig *mgmtv1alpha1.ConnectionConfig, connectionTimeout *uint32, logger *slog.Logger) (SqlDbContainer, error)
 }
 
-type SqlOpenConnector struct{}
+type SqlOpenConnector struct {
+	// Optional, used to resolve any secret references in the connection config at the time the connection is built
+	SecretResolver secrets.Resolver
+	// Sqlite database files of an account must be located within <SqliteAllowedDir>/<accountId>. Sqlite connections are disabled when empty.
+	SqliteAllowedDir string
+	// When true, the database files of every account are located directly within SqliteAllowedDir.
+	// Only intended for the cli, where the database files are read from the machine of the user running it.
+	SqliteSharedAcrossAccounts bool
+}
 
-func (rc *SqlOpenConnector) NewDbFromConnectionConfig(cc *mgmtv1alpha1.ConnectionConfig, connectionTimeout *uint32, logger *slog.Logger) (SqlDbContainer, error) {
+func (rc *SqlOpenConnector) NewDbFromConnectionConfig(accountId string, cc *mgmtv1alpha1.ConnectionConfig, connectionTimeout *uint32, logger *slog.Logger) (SqlDbContainer, error) {
 	if cc == nil {
 		return nil, errors.New("connectionConfig was nil, expected *mgmtv1alpha1.ConnectionConfig")
 	}
+	cc, err := secrets.ResolveConnectionConfig(context.Background(), rc.SecretResolver, cc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve connection config secrets: %w", err)
+	}
 
 	dbconnopts := getConnectionOptsFromConnectionConfig(cc)
 
@@ -115,6 +132,19 @@ func (rc *SqlOpenConnector) NewDbFromConnectionConfig(cc *mgmtv1alpha1.Connectio
 			), nil
 		}
 		return newStdlibContainer("sqlserver", dsn, dbconnopts), nil
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		allowedDir := rc.SqliteAllowedDir
+		if !rc.SqliteSharedAcrossAccounts {
+			allowedDir, err = dbconnectconfig.GetSqliteAccountDir(rc.SqliteAllowedDir, accountId)
+			if err != nil {
+				return nil, err
+			}
+		}
+		connDetails, err := dbconnectconfig.NewFromSqliteConnection(config, connectionTimeout, allowedDir)
+		if err != nil {
+			return nil, err
+		}
+		return newStdlibContainer("sqlite", connDetails.String(), dbconnopts), nil
 	default:
 		return nil, fmt.Errorf("unsupported connection: %T", config)
 	}
@@ -158,6 +188,8 @@ func getConnectionOptsFromConnectionConfig(cc *mgmtv1alpha1.ConnectionConfig) *D
 		return sqlConnOptsToDbConnOpts(config.PgConfig.GetConnectionOptions())
 	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
 		return sqlConnOptsToDbConnOpts(config.MssqlConfig.GetConnectionOptions())
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		return sqlConnOptsToDbConnOpts(config.SqliteConfig.GetConnectionOptions())
 	default:
 		return sqlConnOptsToDbConnOpts(&mgmtv1alpha1.SqlConnectionOptions{})
 	}
@@ -619,6 +619,68 @@ func (_c *MockSqlDatabase_GetSequencesByTables_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GetTableColumnMaxValue provides a mock function with given fields: ctx, schema, table, column, whereClause
+func (_m *MockSqlDatabase) GetTableColumnMaxValue(ctx context.Context, schema string, table string, column string, whereClause *string) (*string, error) {
+	ret := _m.Called(ctx, schema, table, column, whereClause)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTableColumnMaxValue")
+	}
+
+	var r0 *string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *string) (*string, error)); ok {
+		return rf(ctx, schema, table, column, whereClause)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *string) *string); ok {
+		r0 = rf(ctx, schema, table, column, whereClause)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, *string) error); ok {
+		r1 = rf(ctx, schema, table, column, whereClause)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetTableColumnMaxValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTableColumnMaxValue'
+type MockSqlDatabase_GetTableColumnMaxValue_Call struct {
+	*mock.Call
+}
+
+// GetTableColumnMaxValue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schema string
+//   - table string
+//   - column string
+//   - whereClause *string
+func (_e *MockSqlDatabase_Expecter) GetTableColumnMaxValue(ctx interface{}, schema interface{}, table interface{}, column interface{}, whereClause interface{}) *MockSqlDatabase_GetTableColumnMaxValue_Call {
+	return &MockSqlDatabase_GetTableColumnMaxValue_Call{Call: _e.mock.On("GetTableColumnMaxValue", ctx, schema, table, column, whereClause)}
+}
+
+func (_c *MockSqlDatabase_GetTableColumnMaxValue_Call) Run(run func(ctx context.Context, schema string, table string, column string, whereClause *string)) *MockSqlDatabase_GetTableColumnMaxValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(*string))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetTableColumnMaxValue_Call) Return(_a0 *string, _a1 error) *MockSqlDatabase_GetTableColumnMaxValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetTableColumnMaxValue_Call) RunAndReturn(run func(context.Context, string, string, string, *string) (*string, error)) *MockSqlDatabase_GetTableColumnMaxValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTableConstraintsBySchema provides a mock function with given fields: ctx, schemas
 func (_m *MockSqlDatabase) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*sqlmanager_shared.TableConstraints, error) {
 	ret := _m.Called(ctx, schemas)
@@ -796,6 +858,67 @@ func (_c *MockSqlDatabase_GetTableRowCount_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetTableRowSample provides a mock function with given fields: ctx, schema, table, limit
+func (_m *MockSqlDatabase) GetTableRowSample(ctx context.Context, schema string, table string, limit int64) ([]map[string]interface{}, error) {
+	ret := _m.Called(ctx, schema, table, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTableRowSample")
+	}
+
+	var r0 []map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) ([]map[string]interface{}, error)); ok {
+		return rf(ctx, schema, table, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) []map[string]interface{}); ok {
+		r0 = rf(ctx, schema, table, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, schema, table, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSqlDatabase_GetTableRowSample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTableRowSample'
+type MockSqlDatabase_GetTableRowSample_Call struct {
+	*mock.Call
+}
+
+// GetTableRowSample is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schema string
+//   - table string
+//   - limit int64
+func (_e *MockSqlDatabase_Expecter) GetTableRowSample(ctx interface{}, schema interface{}, table interface{}, limit interface{}) *MockSqlDatabase_GetTableRowSample_Call {
+	return &MockSqlDatabase_GetTableRowSample_Call{Call: _e.mock.On("GetTableRowSample", ctx, schema, table, limit)}
+}
+
+func (_c *MockSqlDatabase_GetTableRowSample_Call) Run(run func(ctx context.Context, schema string, table string, limit int64)) *MockSqlDatabase_GetTableRowSample_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetTableRowSample_Call) Return(_a0 []map[string]interface{}, _a1 error) *MockSqlDatabase_GetTableRowSample_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSqlDatabase_GetTableRowSample_Call) RunAndReturn(run func(context.Context, string, string, int64) ([]map[string]interface{}, error)) *MockSqlDatabase_GetTableRowSample_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockSqlDatabase creates a new instance of MockSqlDatabase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockSqlDatabase(t interface {
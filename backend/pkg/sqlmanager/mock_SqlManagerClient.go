@@ -145,9 +145,9 @@ func (_c *MockSqlManagerClient_NewSqlDb_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
-// NewSqlDbFromConnectionConfig provides a mock function with given fields: ctx, slogger, connectionConfig, connectionTimeout
-func (_m *MockSqlManagerClient) NewSqlDbFromConnectionConfig(ctx context.Context, slogger *slog.Logger, connectionConfig *mgmtv1alpha1.ConnectionConfig, connectionTimeout *int) (*SqlConnection, error) {
-	ret := _m.Called(ctx, slogger, connectionConfig, connectionTimeout)
+// NewSqlDbFromConnectionConfig provides a mock function with given fields: ctx, slogger, accountId, connectionConfig, connectionTimeout
+func (_m *MockSqlManagerClient) NewSqlDbFromConnectionConfig(ctx context.Context, slogger *slog.Logger, accountId string, connectionConfig *mgmtv1alpha1.ConnectionConfig, connectionTimeout *int) (*SqlConnection, error) {
+	ret := _m.Called(ctx, slogger, accountId, connectionConfig, connectionTimeout)
 
 	if len(ret) == 0 {
 		panic("no return value specified for NewSqlDbFromConnectionConfig")
@@ -155,19 +155,19 @@ func (_m *MockSqlManagerClient) NewSqlDbFromConnectionConfig(ctx context.Context
 
 	var r0 *SqlConnection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *slog.Logger, *mgmtv1alpha1.ConnectionConfig, *int) (*SqlConnection, error)); ok {
-		return rf(ctx, slogger, connectionConfig, connectionTimeout)
+	if rf, ok := ret.Get(0).(func(context.Context, *slog.Logger, string, *mgmtv1alpha1.ConnectionConfig, *int) (*SqlConnection, error)); ok {
+		return rf(ctx, slogger, accountId, connectionConfig, connectionTimeout)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *slog.Logger, *mgmtv1alpha1.ConnectionConfig, *int) *SqlConnection); ok {
-		r0 = rf(ctx, slogger, connectionConfig, connectionTimeout)
+	if rf, ok := ret.Get(0).(func(context.Context, *slog.Logger, string, *mgmtv1alpha1.ConnectionConfig, *int) *SqlConnection); ok {
+		r0 = rf(ctx, slogger, accountId, connectionConfig, connectionTimeout)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*SqlConnection)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *slog.Logger, *mgmtv1alpha1.ConnectionConfig, *int) error); ok {
-		r1 = rf(ctx, slogger, connectionConfig, connectionTimeout)
+	if rf, ok := ret.Get(1).(func(context.Context, *slog.Logger, string, *mgmtv1alpha1.ConnectionConfig, *int) error); ok {
+		r1 = rf(ctx, slogger, accountId, connectionConfig, connectionTimeout)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -183,15 +183,16 @@ type MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call struct {
 // NewSqlDbFromConnectionConfig is a helper method to define mock.On call
 //   - ctx context.Context
 //   - slogger *slog.Logger
+//   - accountId string
 //   - connectionConfig *mgmtv1alpha1.ConnectionConfig
 //   - connectionTimeout *int
-func (_e *MockSqlManagerClient_Expecter) NewSqlDbFromConnectionConfig(ctx interface{}, slogger interface{}, connectionConfig interface{}, connectionTimeout interface{}) *MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call {
-	return &MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call{Call: _e.mock.On("NewSqlDbFromConnectionConfig", ctx, slogger, connectionConfig, connectionTimeout)}
+func (_e *MockSqlManagerClient_Expecter) NewSqlDbFromConnectionConfig(ctx interface{}, slogger interface{}, accountId interface{}, connectionConfig interface{}, connectionTimeout interface{}) *MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call {
+	return &MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call{Call: _e.mock.On("NewSqlDbFromConnectionConfig", ctx, slogger, accountId, connectionConfig, connectionTimeout)}
 }
 
-func (_c *MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call) Run(run func(ctx context.Context, slogger *slog.Logger, connectionConfig *mgmtv1alpha1.ConnectionConfig, connectionTimeout *int)) *MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call {
+func (_c *MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call) Run(run func(ctx context.Context, slogger *slog.Logger, accountId string, connectionConfig *mgmtv1alpha1.ConnectionConfig, connectionTimeout *int)) *MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*slog.Logger), args[2].(*mgmtv1alpha1.ConnectionConfig), args[3].(*int))
+		run(args[0].(context.Context), args[1].(*slog.Logger), args[2].(string), args[3].(*mgmtv1alpha1.ConnectionConfig), args[4].(*int))
 	})
 	return _c
 }
@@ -201,7 +202,7 @@ func (_c *MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call) Return(_a0 *Sq
 	return _c
 }
 
-func (_c *MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call) RunAndReturn(run func(context.Context, *slog.Logger, *mgmtv1alpha1.ConnectionConfig, *int) (*SqlConnection, error)) *MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call {
+func (_c *MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call) RunAndReturn(run func(context.Context, *slog.Logger, string, *mgmtv1alpha1.ConnectionConfig, *int) (*SqlConnection, error)) *MockSqlManagerClient_NewSqlDbFromConnectionConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
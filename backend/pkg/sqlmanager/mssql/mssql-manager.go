@@ -2,6 +2,7 @@ package sqlmanager_mssql
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"regexp"
@@ -9,11 +10,14 @@ import (
 	"strings"
 
 	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlserver"
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	"github.com/nucleuscloud/neosync/internal/gotypeutil"
+	sqlserverutil "github.com/nucleuscloud/neosync/internal/sqlserver"
+	"golang.org/x/sync/errgroup"
 )
 
 type Manager struct {
@@ -182,29 +186,267 @@ func splitAndStrip(input, delim string) []string {
 }
 
 func (m *Manager) GetTableInitStatements(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) ([]*sqlmanager_shared.TableInitStatement, error) {
-	return []*sqlmanager_shared.TableInitStatement{}, nil
+	if len(tables) == 0 {
+		return []*sqlmanager_shared.TableInitStatement{}, nil
+	}
+
+	schemaset := map[string]struct{}{}
+	tableNames := make([]string, 0, len(tables))
+	for _, table := range tables {
+		schemaset[table.Schema] = struct{}{}
+		tableNames = append(tableNames, table.String())
+	}
+	schemas := make([]string, 0, len(schemaset))
+	for schema := range schemaset {
+		schemas = append(schemas, schema)
+	}
+
+	errgrp, errctx := errgroup.WithContext(ctx)
+
+	colDefMap := map[string][]*mssql_queries.GetDatabaseTableSchemasBySchemasAndTablesRow{}
+	errgrp.Go(func() error {
+		columnDefs, err := m.querier.GetDatabaseTableSchemasBySchemasAndTables(errctx, m.db, tableNames)
+		if err != nil && !neosyncdb.IsNoRows(err) {
+			return fmt.Errorf("unable to retrieve mssql table column definitions: %w", err)
+		}
+		for _, columnDefinition := range columnDefs {
+			key := sqlmanager_shared.BuildTable(columnDefinition.SchemaName, columnDefinition.TableName)
+			colDefMap[key] = append(colDefMap[key], columnDefinition)
+		}
+		return nil
+	})
+
+	constraintMap := map[string][]*mssql_queries.GetTableConstraintsBySchemasRow{}
+	errgrp.Go(func() error {
+		constraints, err := m.querier.GetTableConstraintsBySchemas(errctx, m.db, schemas)
+		if err != nil && !neosyncdb.IsNoRows(err) {
+			return fmt.Errorf("unable to retrieve mssql table constraints: %w", err)
+		}
+		for _, constraint := range constraints {
+			key := sqlmanager_shared.BuildTable(constraint.SchemaName, constraint.TableName)
+			constraintMap[key] = append(constraintMap[key], constraint)
+		}
+		return nil
+	})
+
+	indexMap := map[string][]string{}
+	clusteredIndexTables := map[string]struct{}{}
+	errgrp.Go(func() error {
+		idxrecords, err := m.querier.GetIndicesBySchemasAndTables(errctx, m.db, tableNames)
+		if err != nil && !neosyncdb.IsNoRows(err) {
+			return fmt.Errorf("unable to retrieve mssql table indices: %w", err)
+		}
+		for _, record := range idxrecords {
+			key := sqlmanager_shared.BuildTable(record.SchemaName, record.TableName)
+			if record.IndexType == clusteredIndexType {
+				clusteredIndexTables[key] = struct{}{}
+			}
+			indexMap[key] = append(indexMap[key], buildIndexStatement(record))
+		}
+		return nil
+	})
+
+	if err := errgrp.Wait(); err != nil {
+		return nil, err
+	}
+
+	output := []*sqlmanager_shared.TableInitStatement{}
+	for _, schematable := range tables {
+		key := schematable.String()
+		tableData, ok := colDefMap[key]
+		if !ok {
+			continue
+		}
+		_, hasClusteredIndex := clusteredIndexTables[key]
+
+		info := &sqlmanager_shared.TableInitStatement{
+			CreateTableStatement: buildCreateTableStatement(schematable.Schema, schematable.Table, tableData, nil),
+			AlterTableStatements: []*sqlmanager_shared.AlterTableStatement{},
+			IndexStatements:      indexMap[key],
+		}
+		for _, constraint := range constraintMap[key] {
+			stmt, err := buildAlterStatementByConstraint(constraint, hasClusteredIndex)
+			if err != nil {
+				return nil, err
+			}
+			info.AlterTableStatements = append(info.AlterTableStatements, stmt)
+		}
+		output = append(output, info)
+	}
+	return output, nil
 }
 
 func (m *Manager) GetSchemaTableDataTypes(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) (*sqlmanager_shared.SchemaTableDataTypeResponse, error) {
-	return &sqlmanager_shared.SchemaTableDataTypeResponse{
+	output := &sqlmanager_shared.SchemaTableDataTypeResponse{
 		Sequences:  []*sqlmanager_shared.DataType{},
 		Functions:  []*sqlmanager_shared.DataType{},
 		Composites: []*sqlmanager_shared.DataType{},
 		Enums:      []*sqlmanager_shared.DataType{},
 		Domains:    []*sqlmanager_shared.DataType{},
-	}, nil
+	}
+	if len(tables) == 0 {
+		return output, nil
+	}
+
+	schemasMap := map[string]struct{}{}
+	for _, t := range tables {
+		schemasMap[t.Schema] = struct{}{}
+	}
+	schemas := make([]string, 0, len(schemasMap))
+	for s := range schemasMap {
+		schemas = append(schemas, s)
+	}
+
+	rows, err := m.querier.GetCustomSequencesBySchemas(ctx, m.db, schemas)
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, fmt.Errorf("unable to get mssql custom sequences by schemas: %w", err)
+	}
+	for _, row := range rows {
+		output.Sequences = append(output.Sequences, &sqlmanager_shared.DataType{
+			Schema:     row.SchemaName,
+			Name:       row.SequenceName,
+			Definition: buildSequenceStatement(row),
+		})
+	}
+	return output, nil
 }
 
 func (m *Manager) GetSchemaTableTriggers(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) ([]*sqlmanager_shared.TableTrigger, error) {
-	return []*sqlmanager_shared.TableTrigger{}, nil
+	if len(tables) == 0 {
+		return []*sqlmanager_shared.TableTrigger{}, nil
+	}
+
+	tableNames := make([]string, 0, len(tables))
+	for _, t := range tables {
+		tableNames = append(tableNames, t.String())
+	}
+
+	rows, err := m.querier.GetCustomTriggersBySchemasAndTables(ctx, m.db, tableNames)
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && neosyncdb.IsNoRows(err) {
+		return []*sqlmanager_shared.TableTrigger{}, nil
+	}
+
+	output := []*sqlmanager_shared.TableTrigger{}
+	for _, row := range rows {
+		// encrypted triggers do not expose their definition
+		if !row.Definition.Valid {
+			continue
+		}
+		output = append(output, &sqlmanager_shared.TableTrigger{
+			Schema:      row.SchemaName,
+			Table:       row.TableName,
+			TriggerName: row.TriggerName,
+			Definition:  wrapIdempotentTrigger(row.SchemaName, row.TriggerName, row.Definition.String),
+		})
+	}
+	return output, nil
 }
 
 func (m *Manager) GetSchemaInitStatements(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) ([]*sqlmanager_shared.InitSchemaStatements, error) {
-	return []*sqlmanager_shared.InitSchemaStatements{}, nil
+	errgrp, errctx := errgroup.WithContext(ctx)
+	dataTypeStmts := []string{}
+	errgrp.Go(func() error {
+		datatypeCfg, err := m.GetSchemaTableDataTypes(errctx, tables)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve mssql schema table data types: %w", err)
+		}
+		dataTypeStmts = datatypeCfg.GetStatements()
+		return nil
+	})
+
+	tableTriggerStmts := []string{}
+	errgrp.Go(func() error {
+		tableTriggers, err := m.GetSchemaTableTriggers(errctx, tables)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve mssql schema table triggers: %w", err)
+		}
+		for _, ttrig := range tableTriggers {
+			tableTriggerStmts = append(tableTriggerStmts, ttrig.Definition)
+		}
+		return nil
+	})
+
+	createTables := []string{}
+	nonFkAlterStmts := []string{}
+	fkAlterStmts := []string{}
+	idxStmts := []string{}
+	errgrp.Go(func() error {
+		initStatementCfgs, err := m.GetTableInitStatements(errctx, tables)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve mssql schema table create statements: %w", err)
+		}
+		for _, stmtCfg := range initStatementCfgs {
+			createTables = append(createTables, stmtCfg.CreateTableStatement)
+			for _, alter := range stmtCfg.AlterTableStatements {
+				if alter.ConstraintType == sqlmanager_shared.ForeignConstraintType {
+					fkAlterStmts = append(fkAlterStmts, alter.Statement)
+				} else {
+					nonFkAlterStmts = append(nonFkAlterStmts, alter.Statement)
+				}
+			}
+			idxStmts = append(idxStmts, stmtCfg.IndexStatements...)
+		}
+		return nil
+	})
+	if err := errgrp.Wait(); err != nil {
+		return nil, err
+	}
+
+	return []*sqlmanager_shared.InitSchemaStatements{
+		{Label: "data types", Statements: dataTypeStmts},
+		{Label: "create table", Statements: createTables},
+		{Label: "non-fk alter table", Statements: nonFkAlterStmts},
+		{Label: "fk alter table", Statements: fkAlterStmts},
+		{Label: "table index", Statements: idxStmts},
+		{Label: "table triggers", Statements: tableTriggerStmts},
+	}, nil
 }
 
 func (m *Manager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
-	return "", errors.ErrUnsupported
+	errgrp, errctx := errgroup.WithContext(ctx)
+
+	schematable := sqlmanager_shared.SchemaTable{Schema: schema, Table: table}
+
+	var tableSchemas []*mssql_queries.GetDatabaseTableSchemasBySchemasAndTablesRow
+	errgrp.Go(func() error {
+		result, err := m.querier.GetDatabaseTableSchemasBySchemasAndTables(errctx, m.db, []string{schematable.String()})
+		if err != nil {
+			return fmt.Errorf("unable to generate database table schema: %w", err)
+		}
+		tableSchemas = result
+		return nil
+	})
+	tableConstraints := []*mssql_queries.GetTableConstraintsBySchemasRow{}
+	errgrp.Go(func() error {
+		result, err := m.querier.GetTableConstraintsBySchemas(errctx, m.db, []string{schema})
+		if err != nil {
+			return fmt.Errorf("unable to generate table constraints: %w", err)
+		}
+		for _, constraint := range result {
+			if constraint.TableName == table {
+				tableConstraints = append(tableConstraints, constraint)
+			}
+		}
+		return nil
+	})
+	if err := errgrp.Wait(); err != nil {
+		return "", err
+	}
+	if len(tableSchemas) == 0 {
+		return "", fmt.Errorf("unable to find columns for table %s", schematable.String())
+	}
+
+	constraints := make([]string, 0, len(tableConstraints))
+	for _, constraint := range tableConstraints {
+		definition, _, err := buildConstraintDefinition(constraint, false)
+		if err != nil {
+			return "", err
+		}
+		constraints = append(constraints, definition)
+	}
+	return buildCreateTableStatement(schema, table, tableSchemas, constraints), nil
 }
 
 func (m *Manager) BatchExec(ctx context.Context, batchSize int, statements []string, opts *sqlmanager_shared.BatchExecOpts) error {
@@ -243,6 +485,84 @@ func (m *Manager) GetTableRowCount(
 	return count, err
 }
 
+func (m *Manager) GetTableColumnMaxValue(
+	ctx context.Context,
+	schema, table, column string,
+	whereClause *string,
+) (*string, error) {
+	builder := goqu.Dialect(sqlmanager_shared.MssqlDriver)
+
+	query := builder.From(goqu.S(schema).Table(table)).Select(goqu.Cast(goqu.MAX(goqu.C(column)), "NVARCHAR(MAX)"))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	statement, _, err := query.ToSQL()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build table column max value statement for mssql: %w", err)
+	}
+	var maxValue sql.NullString
+	err = m.db.QueryRowContext(ctx, statement).Scan(&maxValue)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query table column max value for mssql: %w", err)
+	}
+	if !maxValue.Valid {
+		return nil, nil
+	}
+	return &maxValue.String, nil
+}
+
+func (m *Manager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	limit int64,
+) ([]map[string]any, error) {
+	// the row counts of the partitions are used instead of counting the rows so that large tables are never scanned twice
+	var estimatedRows int64
+	err := m.db.QueryRowContext(
+		ctx,
+		"SELECT COALESCE((SELECT SUM(rows) FROM sys.partitions WHERE object_id = OBJECT_ID(QUOTENAME(@p1) + '.' + QUOTENAME(@p2)) AND index_id IN (0, 1)), -1)",
+		schema, table,
+	).Scan(&estimatedRows)
+	if err != nil {
+		return nil, fmt.Errorf("unable to estimate table row count for mssql: %w", err)
+	}
+	statement, err := buildTableRowSampleStatement(schema, table, limit, sqlmanager_shared.GetTableSamplePercent(limit, estimatedRows))
+	if err != nil {
+		return nil, fmt.Errorf("unable to build table row sample statement for mssql: %w", err)
+	}
+	rows, err := m.db.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query table row sample for mssql: %w", err)
+	}
+	defer rows.Close()
+
+	output := []map[string]any{}
+	for rows.Next() {
+		row, err := sqlserverutil.SqlRowToSqlServerTypesMap(rows)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// Samples the pages of a table with TABLESAMPLE instead of sorting the table.
+// Tables that are not larger than the limit are not sampled.
+func buildTableRowSampleStatement(schema, table string, limit int64, percent float64) (string, error) {
+	builder := goqu.Dialect(sqlmanager_shared.MssqlDriver)
+	var from any = goqu.S(schema).Table(table)
+	if percent < 100 {
+		from = goqu.L("? TABLESAMPLE (? PERCENT)", from, percent)
+	}
+	query := builder.From(from).Limit(uint(limit))
+	statement, _, err := query.ToSQL()
+	return statement, err
+}
+
 func (m *Manager) GetSequencesByTables(ctx context.Context, schema string, tables []string) ([]*sqlmanager_shared.DataType, error) {
 	return nil, errors.ErrUnsupported
 }
@@ -328,3 +648,240 @@ func GetMssqlColumnOverrideAndResetProperties(columnInfo *sqlmanager_shared.Colu
 
 	return
 }
+
+const clusteredIndexType = "CLUSTERED"
+
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf("[%s]", strings.ReplaceAll(identifier, "]", "]]"))
+}
+
+func quoteSchemaTable(schema, table string) string {
+	return fmt.Sprintf("%s.%s", quoteIdentifier(schema), quoteIdentifier(table))
+}
+
+func escapeString(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
+
+func buildCreateTableStatement(
+	schema, table string,
+	columns []*mssql_queries.GetDatabaseTableSchemasBySchemasAndTablesRow,
+	constraints []string,
+) string {
+	tableDefs := make([]string, 0, len(columns)+len(constraints))
+	for _, column := range columns {
+		tableDefs = append(tableDefs, buildTableCol(column))
+	}
+	tableDefs = append(tableDefs, constraints...)
+	qualifiedTable := quoteSchemaTable(schema, table)
+	return fmt.Sprintf(
+		"IF OBJECT_ID(N'%s', N'U') IS NULL CREATE TABLE %s (%s);",
+		escapeString(qualifiedTable), qualifiedTable, strings.Join(tableDefs, ", "),
+	)
+}
+
+func buildTableCol(record *mssql_queries.GetDatabaseTableSchemasBySchemasAndTablesRow) string {
+	if record.IsComputed && record.GenerationExpression.Valid {
+		pieces := []string{quoteIdentifier(record.ColumnName), "AS", record.GenerationExpression.String}
+		if record.IsPersisted {
+			pieces = append(pieces, "PERSISTED")
+		}
+		return strings.Join(pieces, " ")
+	}
+
+	pieces := []string{quoteIdentifier(record.ColumnName), buildDataType(record.DataType, record.MaxLength, record.NumericPrecision, record.NumericScale)}
+	if record.IsIdentity {
+		seed := "1"
+		if record.IdentitySeed.Valid {
+			seed = record.IdentitySeed.String
+		}
+		increment := "1"
+		if record.IdentityIncrement.Valid {
+			increment = record.IdentityIncrement.String
+		}
+		pieces = append(pieces, fmt.Sprintf("IDENTITY(%s,%s)", seed, increment))
+	}
+	pieces = append(pieces, buildNullableText(record.IsNullable))
+	if record.ColumnDefault.Valid && record.ColumnDefault.String != "" {
+		pieces = append(pieces, fmt.Sprintf("DEFAULT %s", record.ColumnDefault.String))
+	}
+	return strings.Join(pieces, " ")
+}
+
+// Builds the full data type declaration as sys.columns stores length, precision and scale separately
+func buildDataType(dataType string, maxLength, precision, scale int16) string {
+	switch strings.ToLower(dataType) {
+	case "char", "varchar", "binary", "varbinary":
+		if maxLength == -1 {
+			return fmt.Sprintf("%s(MAX)", dataType)
+		}
+		return fmt.Sprintf("%s(%d)", dataType, maxLength)
+	case "nchar", "nvarchar":
+		if maxLength == -1 {
+			return fmt.Sprintf("%s(MAX)", dataType)
+		}
+		// max_length is reported in bytes and unicode characters are two bytes each
+		return fmt.Sprintf("%s(%d)", dataType, maxLength/2)
+	case "decimal", "numeric":
+		return fmt.Sprintf("%s(%d,%d)", dataType, precision, scale)
+	case "datetime2", "datetimeoffset", "time":
+		return fmt.Sprintf("%s(%d)", dataType, scale)
+	default:
+		return dataType
+	}
+}
+
+func buildNullableText(isNullable bool) string {
+	if isNullable {
+		return "NULL"
+	}
+	return "NOT NULL"
+}
+
+func buildAlterStatementByConstraint(
+	c *mssql_queries.GetTableConstraintsBySchemasRow,
+	hasClusteredIndex bool,
+) (*sqlmanager_shared.AlterTableStatement, error) {
+	definition, constraintType, err := buildConstraintDefinition(c, hasClusteredIndex)
+	if err != nil {
+		return nil, err
+	}
+	stmt := fmt.Sprintf("ALTER TABLE %s ADD %s;", quoteSchemaTable(c.SchemaName, c.TableName), definition)
+	return &sqlmanager_shared.AlterTableStatement{
+		Statement:      wrapIdempotentConstraint(c.SchemaName, c.TableName, c.ConstraintName, stmt),
+		ConstraintType: constraintType,
+	}, nil
+}
+
+// The primary key is made nonclustered when the table has a separate clustered index as a table may only have one
+func buildConstraintDefinition(
+	c *mssql_queries.GetTableConstraintsBySchemasRow,
+	hasClusteredIndex bool,
+) (string, sqlmanager_shared.ConstraintType, error) {
+	constraintCols := quoteIdentifiers(splitAndStrip(c.ConstraintColumns, ", "))
+	switch c.ConstraintType {
+	case "PRIMARY KEY":
+		clustered := ""
+		if hasClusteredIndex {
+			clustered = " NONCLUSTERED"
+		}
+		return fmt.Sprintf("CONSTRAINT %s PRIMARY KEY%s (%s)", quoteIdentifier(c.ConstraintName), clustered, strings.Join(constraintCols, ", ")), sqlmanager_shared.PrimaryConstraintType, nil
+	case "UNIQUE":
+		return fmt.Sprintf("CONSTRAINT %s UNIQUE (%s)", quoteIdentifier(c.ConstraintName), strings.Join(constraintCols, ", ")), sqlmanager_shared.UniqueConstraintType, nil
+	case "FOREIGN KEY":
+		if !c.ReferencedTable.Valid || !c.ReferencedColumns.Valid {
+			return "", 0, fmt.Errorf("foreign key constraint %s is missing its referenced table or columns", c.ConstraintName)
+		}
+		refSchema, refTable := sqlmanager_shared.SplitTableKey(c.ReferencedTable.String)
+		onUpdate, onDelete := parseForeignKeyActions(c.FKActions.String)
+		return fmt.Sprintf(
+			"CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s) ON DELETE %s ON UPDATE %s",
+			quoteIdentifier(c.ConstraintName),
+			strings.Join(constraintCols, ", "),
+			quoteSchemaTable(refSchema, refTable),
+			strings.Join(quoteIdentifiers(splitAndStrip(c.ReferencedColumns.String, ", ")), ", "),
+			onDelete,
+			onUpdate,
+		), sqlmanager_shared.ForeignConstraintType, nil
+	case "CHECK":
+		if !c.CheckClause.Valid {
+			return "", 0, fmt.Errorf("check constraint %s is missing its definition", c.ConstraintName)
+		}
+		// check definitions are stored wrapped in parentheses
+		return fmt.Sprintf("CONSTRAINT %s CHECK %s", quoteIdentifier(c.ConstraintName), c.CheckClause.String), sqlmanager_shared.CheckConstraintType, nil
+	}
+	return "", 0, errors.ErrUnsupported
+}
+
+var fkActionsRegex = regexp.MustCompile(`ON UPDATE (\w+), ON DELETE (\w+)`)
+
+// Parses the referential actions out of the "ON UPDATE NO_ACTION, ON DELETE CASCADE" form returned by the constraints query
+func parseForeignKeyActions(actions string) (onUpdate, onDelete string) {
+	onUpdate = "NO ACTION"
+	onDelete = "NO ACTION"
+	match := fkActionsRegex.FindStringSubmatch(actions)
+	if len(match) == 3 {
+		onUpdate = strings.ReplaceAll(match[1], "_", " ")
+		onDelete = strings.ReplaceAll(match[2], "_", " ")
+	}
+	return onUpdate, onDelete
+}
+
+func quoteIdentifiers(identifiers []string) []string {
+	output := make([]string, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		output = append(output, quoteIdentifier(identifier))
+	}
+	return output
+}
+
+func buildIndexStatement(record *mssql_queries.GetIndicesBySchemasAndTablesRow) string {
+	pieces := []string{"CREATE"}
+	if record.IsUnique {
+		pieces = append(pieces, "UNIQUE")
+	}
+	pieces = append(pieces,
+		record.IndexType,
+		"INDEX",
+		quoteIdentifier(record.IndexName),
+		"ON",
+		quoteSchemaTable(record.SchemaName, record.TableName),
+		fmt.Sprintf("(%s)", record.KeyColumns),
+	)
+	if record.IncludedColumns.Valid && record.IncludedColumns.String != "" {
+		pieces = append(pieces, fmt.Sprintf("INCLUDE (%s)", record.IncludedColumns.String))
+	}
+	if record.FilterDefinition.Valid && record.FilterDefinition.String != "" {
+		pieces = append(pieces, "WHERE", record.FilterDefinition.String)
+	}
+	return wrapIdempotentIndex(record.SchemaName, record.TableName, record.IndexName, fmt.Sprintf("%s;", strings.Join(pieces, " ")))
+}
+
+func buildSequenceStatement(record *mssql_queries.GetCustomSequencesBySchemasRow) string {
+	pieces := []string{
+		"CREATE SEQUENCE",
+		quoteSchemaTable(record.SchemaName, record.SequenceName),
+		"AS",
+		buildDataType(record.DataType, 0, record.NumericPrecision, record.NumericScale),
+		"START WITH", record.StartValue,
+		"INCREMENT BY", record.IncrementValue,
+		"MINVALUE", record.MinimumValue,
+		"MAXVALUE", record.MaximumValue,
+	}
+	if record.IsCycling {
+		pieces = append(pieces, "CYCLE")
+	} else {
+		pieces = append(pieces, "NO CYCLE")
+	}
+	if !record.IsCached {
+		pieces = append(pieces, "NO CACHE")
+	} else if record.CacheSize.Valid {
+		pieces = append(pieces, fmt.Sprintf("CACHE %d", record.CacheSize.Int32))
+	} else {
+		pieces = append(pieces, "CACHE")
+	}
+	qualifiedSequence := quoteSchemaTable(record.SchemaName, record.SequenceName)
+	return fmt.Sprintf("IF OBJECT_ID(N'%s', N'SO') IS NULL %s;", escapeString(qualifiedSequence), strings.Join(pieces, " "))
+}
+
+func wrapIdempotentConstraint(schema, table, constraintName, constraintStmt string) string {
+	return fmt.Sprintf(
+		"IF NOT EXISTS (SELECT 1 FROM sys.objects WHERE name = N'%s' AND parent_object_id = OBJECT_ID(N'%s')) %s",
+		escapeString(constraintName), escapeString(quoteSchemaTable(schema, table)), constraintStmt,
+	)
+}
+
+func wrapIdempotentIndex(schema, table, indexName, indexStmt string) string {
+	return fmt.Sprintf(
+		"IF NOT EXISTS (SELECT 1 FROM sys.indexes WHERE name = N'%s' AND object_id = OBJECT_ID(N'%s')) %s",
+		escapeString(indexName), escapeString(quoteSchemaTable(schema, table)), indexStmt,
+	)
+}
+
+// CREATE TRIGGER must be the only statement in its batch so it is executed as dynamic sql
+func wrapIdempotentTrigger(schema, triggerName, definition string) string {
+	return fmt.Sprintf(
+		"IF OBJECT_ID(N'%s', N'TR') IS NULL EXEC(N'%s');",
+		escapeString(quoteSchemaTable(schema, triggerName)), escapeString(strings.TrimSpace(definition)),
+	)
+}
@@ -1,8 +1,13 @@
 package sqlmanager_mssql
 
 import (
+	"context"
+	"database/sql"
 	"testing"
 
+	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,3 +20,117 @@ func Test_BuildMssqlDeleteStatement(t *testing.T) {
 		actual,
 	)
 }
+
+func Test_buildTableRowSampleStatement(t *testing.T) {
+	actual, err := buildTableRowSampleStatement("dbo", "users", 100, 0.5)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT  TOP (100) * FROM \"dbo\".\"users\" TABLESAMPLE (0.5 PERCENT)", actual)
+
+	actual, err = buildTableRowSampleStatement("dbo", "users", 100, 100)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT  TOP (100) * FROM \"dbo\".\"users\"", actual)
+}
+
+func Test_GetTableInitStatements(t *testing.T) {
+	querier := mssql_queries.NewMockQuerier(t)
+	manager := NewManager(querier, nil, nil)
+	tables := []*sqlmanager_shared.SchemaTable{{Schema: "sales", Table: "orders"}}
+
+	querier.On("GetDatabaseTableSchemasBySchemasAndTables", mock.Anything, mock.Anything, []string{"sales.orders"}).
+		Return([]*mssql_queries.GetDatabaseTableSchemasBySchemasAndTablesRow{
+			{SchemaName: "sales", TableName: "orders", ColumnName: "id", DataType: "int", IsIdentity: true, IdentitySeed: sql.NullString{String: "10", Valid: true}, IdentityIncrement: sql.NullString{String: "5", Valid: true}},
+			{SchemaName: "sales", TableName: "orders", ColumnName: "name", DataType: "nvarchar", MaxLength: 200, IsNullable: true},
+			{SchemaName: "sales", TableName: "orders", ColumnName: "total", DataType: "decimal", NumericPrecision: 10, NumericScale: 2, ColumnDefault: sql.NullString{String: "((0))", Valid: true}},
+			{SchemaName: "sales", TableName: "orders", ColumnName: "double_total", IsComputed: true, IsPersisted: true, GenerationExpression: sql.NullString{String: "([total]*(2))", Valid: true}},
+		}, nil)
+	querier.On("GetTableConstraintsBySchemas", mock.Anything, mock.Anything, []string{"sales"}).
+		Return([]*mssql_queries.GetTableConstraintsBySchemasRow{
+			{SchemaName: "sales", TableName: "orders", ConstraintName: "PK_orders", ConstraintType: "PRIMARY KEY", ConstraintColumns: "id"},
+			{
+				SchemaName: "sales", TableName: "orders", ConstraintName: "FK_orders_customers", ConstraintType: "FOREIGN KEY", ConstraintColumns: "customer_id",
+				ReferencedTable: sql.NullString{String: "sales.customers", Valid: true}, ReferencedColumns: sql.NullString{String: "id", Valid: true},
+				FKActions: sql.NullString{String: "ON UPDATE NO_ACTION, ON DELETE CASCADE", Valid: true},
+			},
+			{SchemaName: "sales", TableName: "orders", ConstraintName: "CK_total", ConstraintType: "CHECK", ConstraintColumns: "total", CheckClause: sql.NullString{String: "([total]>=(0))", Valid: true}},
+			{SchemaName: "sales", TableName: "customers", ConstraintName: "PK_customers", ConstraintType: "PRIMARY KEY", ConstraintColumns: "id"},
+		}, nil)
+	querier.On("GetIndicesBySchemasAndTables", mock.Anything, mock.Anything, []string{"sales.orders"}).
+		Return([]*mssql_queries.GetIndicesBySchemasAndTablesRow{
+			{SchemaName: "sales", TableName: "orders", IndexName: "IX_orders_name", IndexType: "CLUSTERED", KeyColumns: "[name] ASC", IncludedColumns: sql.NullString{}, FilterDefinition: sql.NullString{}},
+		}, nil)
+
+	actual, err := manager.GetTableInitStatements(context.Background(), tables)
+	require.NoError(t, err)
+	require.Len(t, actual, 1)
+	require.Equal(
+		t,
+		"IF OBJECT_ID(N'[sales].[orders]', N'U') IS NULL CREATE TABLE [sales].[orders] ([id] int IDENTITY(10,5) NOT NULL, [name] nvarchar(100) NULL, [total] decimal(10,2) NOT NULL DEFAULT ((0)), [double_total] AS ([total]*(2)) PERSISTED);",
+		actual[0].CreateTableStatement,
+	)
+	require.Equal(t, []*sqlmanager_shared.AlterTableStatement{
+		{
+			Statement:      "IF NOT EXISTS (SELECT 1 FROM sys.objects WHERE name = N'PK_orders' AND parent_object_id = OBJECT_ID(N'[sales].[orders]')) ALTER TABLE [sales].[orders] ADD CONSTRAINT [PK_orders] PRIMARY KEY NONCLUSTERED ([id]);",
+			ConstraintType: sqlmanager_shared.PrimaryConstraintType,
+		},
+		{
+			Statement:      "IF NOT EXISTS (SELECT 1 FROM sys.objects WHERE name = N'FK_orders_customers' AND parent_object_id = OBJECT_ID(N'[sales].[orders]')) ALTER TABLE [sales].[orders] ADD CONSTRAINT [FK_orders_customers] FOREIGN KEY ([customer_id]) REFERENCES [sales].[customers] ([id]) ON DELETE CASCADE ON UPDATE NO ACTION;",
+			ConstraintType: sqlmanager_shared.ForeignConstraintType,
+		},
+		{
+			Statement:      "IF NOT EXISTS (SELECT 1 FROM sys.objects WHERE name = N'CK_total' AND parent_object_id = OBJECT_ID(N'[sales].[orders]')) ALTER TABLE [sales].[orders] ADD CONSTRAINT [CK_total] CHECK ([total]>=(0));",
+			ConstraintType: sqlmanager_shared.CheckConstraintType,
+		},
+	}, actual[0].AlterTableStatements)
+	require.Equal(t, []string{
+		"IF NOT EXISTS (SELECT 1 FROM sys.indexes WHERE name = N'IX_orders_name' AND object_id = OBJECT_ID(N'[sales].[orders]')) CREATE CLUSTERED INDEX [IX_orders_name] ON [sales].[orders] ([name] ASC);",
+	}, actual[0].IndexStatements)
+}
+
+func Test_GetSchemaTableTriggers(t *testing.T) {
+	querier := mssql_queries.NewMockQuerier(t)
+	manager := NewManager(querier, nil, nil)
+
+	querier.On("GetCustomTriggersBySchemasAndTables", mock.Anything, mock.Anything, []string{"dbo.users"}).
+		Return([]*mssql_queries.GetCustomTriggersBySchemasAndTablesRow{
+			{SchemaName: "dbo", TableName: "users", TriggerName: "trg_users", Definition: sql.NullString{String: "CREATE TRIGGER dbo.trg_users ON dbo.users AFTER INSERT AS PRINT 'inserted';", Valid: true}},
+			{SchemaName: "dbo", TableName: "users", TriggerName: "trg_encrypted"},
+		}, nil)
+
+	actual, err := manager.GetSchemaTableTriggers(context.Background(), []*sqlmanager_shared.SchemaTable{{Schema: "dbo", Table: "users"}})
+	require.NoError(t, err)
+	require.Equal(t, []*sqlmanager_shared.TableTrigger{
+		{
+			Schema:      "dbo",
+			Table:       "users",
+			TriggerName: "trg_users",
+			Definition:  "IF OBJECT_ID(N'[dbo].[trg_users]', N'TR') IS NULL EXEC(N'CREATE TRIGGER dbo.trg_users ON dbo.users AFTER INSERT AS PRINT ''inserted'';');",
+		},
+	}, actual)
+}
+
+func Test_buildSequenceStatement(t *testing.T) {
+	actual := buildSequenceStatement(&mssql_queries.GetCustomSequencesBySchemasRow{
+		SchemaName:     "dbo",
+		SequenceName:   "order_seq",
+		DataType:       "bigint",
+		StartValue:     "1",
+		IncrementValue: "1",
+		MinimumValue:   "1",
+		MaximumValue:   "1000",
+		IsCached:       true,
+		CacheSize:      sql.NullInt32{Int32: 50, Valid: true},
+	})
+	require.Equal(
+		t,
+		"IF OBJECT_ID(N'[dbo].[order_seq]', N'SO') IS NULL CREATE SEQUENCE [dbo].[order_seq] AS bigint START WITH 1 INCREMENT BY 1 MINVALUE 1 MAXVALUE 1000 NO CYCLE CACHE 50;",
+		actual,
+	)
+}
+
+func Test_buildDataType(t *testing.T) {
+	require.Equal(t, "varchar(MAX)", buildDataType("varchar", -1, 0, 0))
+	require.Equal(t, "nchar(10)", buildDataType("nchar", 20, 0, 0))
+	require.Equal(t, "numeric(18,4)", buildDataType("numeric", 9, 18, 4))
+	require.Equal(t, "datetime2(7)", buildDataType("datetime2", 8, 27, 7))
+	require.Equal(t, "int", buildDataType("int", 4, 10, 0))
+}
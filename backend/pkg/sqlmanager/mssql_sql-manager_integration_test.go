@@ -131,7 +131,7 @@ func (s *MssqlIntegrationTestSuite) Test_NewSqlDbFromUrl() {
 func (s *MssqlIntegrationTestSuite) Test_NewSqlDbFromConnectionConfig() {
 	t := s.T()
 	connTimeout := 5
-	conn, err := s.sqlmanager.NewSqlDbFromConnectionConfig(s.ctx, slog.Default(), s.mgmtconn.GetConnectionConfig(), &connTimeout)
+	conn, err := s.sqlmanager.NewSqlDbFromConnectionConfig(s.ctx, slog.Default(), s.mgmtconn.GetAccountId(), s.mgmtconn.GetConnectionConfig(), &connTimeout)
 	requireNoConnErr(t, conn, err)
 
 	requireValidDatabase(t, s.ctx, conn, "sqlserver", "SELECT 1")
@@ -2,6 +2,7 @@ package sqlmanager_mysql
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	mysqlutil "github.com/nucleuscloud/neosync/internal/mysql"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -787,6 +789,84 @@ func (m *MysqlManager) GetTableRowCount(
 	return count, err
 }
 
+func (m *MysqlManager) GetTableColumnMaxValue(
+	ctx context.Context,
+	schema, table, column string,
+	whereClause *string,
+) (*string, error) {
+	builder := goqu.Dialect(sqlmanager_shared.MysqlDriver)
+	sqltable := goqu.S(schema).Table(table)
+
+	query := builder.From(sqltable).Select(goqu.Cast(goqu.MAX(goqu.C(column)), "CHAR"))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	statement, _, err := query.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var maxValue sql.NullString
+	err = m.pool.QueryRowContext(ctx, statement).Scan(&maxValue)
+	if err != nil {
+		return nil, err
+	}
+	if !maxValue.Valid {
+		return nil, nil
+	}
+	return &maxValue.String, nil
+}
+
+func (m *MysqlManager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	limit int64,
+) ([]map[string]any, error) {
+	// the estimate in information_schema is used instead of counting the rows so that large tables are never scanned twice
+	var estimatedRows int64
+	err := m.pool.QueryRowContext(
+		ctx,
+		"SELECT COALESCE((SELECT TABLE_ROWS FROM information_schema.TABLES WHERE TABLE_SCHEMA = ? AND TABLE_NAME = ?), -1)",
+		schema, table,
+	).Scan(&estimatedRows)
+	if err != nil {
+		return nil, fmt.Errorf("unable to estimate table row count for mysql: %w", err)
+	}
+	statement, err := buildTableRowSampleStatement(schema, table, limit, sqlmanager_shared.GetTableSamplePercent(limit, estimatedRows))
+	if err != nil {
+		return nil, fmt.Errorf("unable to build table row sample statement for mysql: %w", err)
+	}
+	rows, err := m.pool.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query table row sample for mysql: %w", err)
+	}
+	defer rows.Close()
+
+	output := []map[string]any{}
+	for rows.Next() {
+		row, err := mysqlutil.MysqlSqlRowToMap(rows)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// Mysql does not support TABLESAMPLE so each row is kept with the given probability instead of sorting the table.
+// Tables that are not larger than the limit are not sampled.
+func buildTableRowSampleStatement(schema, table string, limit int64, percent float64) (string, error) {
+	builder := goqu.Dialect(sqlmanager_shared.MysqlDriver)
+	query := builder.From(goqu.S(schema).Table(table)).Limit(uint(limit))
+	if percent < 100 {
+		query = query.Where(goqu.L("RAND() < ?", percent/100))
+	}
+	statement, _, err := query.ToSQL()
+	return statement, err
+}
+
 func (m *MysqlManager) Exec(ctx context.Context, statement string) error {
 	_, err := m.pool.ExecContext(ctx, statement)
 	if err != nil {
@@ -815,6 +895,19 @@ func BuildMysqlTruncateStatement(
 	return fmt.Sprintf("%s;", stmt), nil
 }
 
+func BuildMysqlDeleteStatement(
+	schema string,
+	table string,
+) (string, error) {
+	builder := goqu.Dialect("mysql")
+	sqltable := goqu.S(schema).Table(table)
+	stmt, _, err := builder.Delete(sqltable).ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s;", stmt), nil
+}
+
 func EscapeMysqlColumns(cols []string) []string {
 	outcols := make([]string, len(cols))
 	for idx := range cols {
@@ -15,6 +15,16 @@ func Test_EscapeMysqlColumns(t *testing.T) {
 	)
 }
 
+func Test_BuildMysqlDeleteStatement(t *testing.T) {
+	actual, err := BuildMysqlDeleteStatement("public", "users")
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		`DELETE FROM "public"."users";`,
+		actual,
+	)
+}
+
 func Test_BuildMysqlTruncateStatement(t *testing.T) {
 	actual, err := BuildMysqlTruncateStatement("public", "users")
 	require.NoError(t, err)
@@ -24,3 +34,13 @@ func Test_BuildMysqlTruncateStatement(t *testing.T) {
 		actual,
 	)
 }
+
+func Test_buildTableRowSampleStatement(t *testing.T) {
+	actual, err := buildTableRowSampleStatement("public", "users", 100, 0.5)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM \"public\".\"users\" WHERE RAND() < 0.005 LIMIT 100", actual)
+
+	actual, err = buildTableRowSampleStatement("public", "users", 100, 100)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM \"public\".\"users\" LIMIT 100", actual)
+}
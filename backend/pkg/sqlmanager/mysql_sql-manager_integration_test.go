@@ -155,7 +155,7 @@ func (s *MysqlIntegrationTestSuite) Test_NewSqlDbFromUrl() {
 func (s *MysqlIntegrationTestSuite) Test_NewSqlDbFromConnectionConfig() {
 	t := s.T()
 	connTimeout := 5
-	conn, err := s.sqlmanager.NewSqlDbFromConnectionConfig(s.ctx, slog.Default(), s.mgmtconn.GetConnectionConfig(), &connTimeout)
+	conn, err := s.sqlmanager.NewSqlDbFromConnectionConfig(s.ctx, slog.Default(), s.mgmtconn.GetAccountId(), s.mgmtconn.GetConnectionConfig(), &connTimeout)
 	requireNoConnErr(t, conn, err)
 
 	requireValidDatabase(t, s.ctx, conn, "mysql", "SELECT 1")
@@ -2,6 +2,7 @@ package sqlmanager_postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	"github.com/nucleuscloud/neosync/internal/gotypeutil"
+	pgutil "github.com/nucleuscloud/neosync/internal/postgres"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -872,6 +874,85 @@ func (p *PostgresManager) GetTableRowCount(
 	return count, err
 }
 
+func (p *PostgresManager) GetTableColumnMaxValue(
+	ctx context.Context,
+	schema, table, column string,
+	whereClause *string,
+) (*string, error) {
+	builder := goqu.Dialect(sqlmanager_shared.PostgresDriver)
+	sqltable := goqu.S(schema).Table(table)
+
+	query := builder.From(sqltable).Select(goqu.Cast(goqu.MAX(goqu.C(column)), "TEXT"))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	statement, _, err := query.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var maxValue sql.NullString
+	err = p.db.QueryRowContext(ctx, statement).Scan(&maxValue)
+	if err != nil {
+		return nil, err
+	}
+	if !maxValue.Valid {
+		return nil, nil
+	}
+	return &maxValue.String, nil
+}
+
+func (p *PostgresManager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	limit int64,
+) ([]map[string]any, error) {
+	// the planner's estimate is used instead of counting the rows so that large tables are never scanned twice
+	var estimatedRows int64
+	err := p.db.QueryRowContext(
+		ctx,
+		"SELECT COALESCE((SELECT reltuples::bigint FROM pg_catalog.pg_class WHERE oid = to_regclass(format('%I.%I', $1::text, $2::text))), -1)",
+		schema, table,
+	).Scan(&estimatedRows)
+	if err != nil {
+		return nil, fmt.Errorf("unable to estimate table row count for postgres: %w", err)
+	}
+	statement, err := buildTableRowSampleStatement(schema, table, limit, sqlmanager_shared.GetTableSamplePercent(limit, estimatedRows))
+	if err != nil {
+		return nil, fmt.Errorf("unable to build table row sample statement for postgres: %w", err)
+	}
+	rows, err := p.db.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query table row sample for postgres: %w", err)
+	}
+	defer rows.Close()
+
+	output := []map[string]any{}
+	for rows.Next() {
+		row, err := pgutil.SqlRowToPgTypesMap(rows)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// Samples the rows of a table with TABLESAMPLE BERNOULLI, which keeps each row with the given probability without sorting the table.
+// Tables that are not larger than the limit are not sampled.
+func buildTableRowSampleStatement(schema, table string, limit int64, percent float64) (string, error) {
+	builder := goqu.Dialect(sqlmanager_shared.PostgresDriver)
+	var from any = goqu.S(schema).Table(table)
+	if percent < 100 {
+		from = goqu.L("? TABLESAMPLE BERNOULLI (?)", from, percent)
+	}
+	query := builder.From(from).Limit(uint(limit))
+	statement, _, err := query.ToSQL()
+	return statement, err
+}
+
 func getGoquDialect() goqu.DialectWrapper {
 	return goqu.Dialect("postgres")
 }
@@ -904,6 +985,19 @@ func BuildPgTruncateCascadeStatement(
 	return fmt.Sprintf("%s;", stmt), nil
 }
 
+func BuildPgDeleteStatement(
+	schema string,
+	table string,
+) (string, error) {
+	builder := getGoquDialect()
+	sqltable := goqu.S(schema).Table(table)
+	stmt, _, err := builder.Delete(sqltable).ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s;", stmt), nil
+}
+
 func EscapePgColumns(cols []string) []string {
 	outcols := make([]string, len(cols))
 	for idx := range cols {
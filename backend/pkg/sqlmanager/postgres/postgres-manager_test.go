@@ -125,6 +125,16 @@ func Test_BuildPgTruncateStatement(t *testing.T) {
 	)
 }
 
+func Test_buildTableRowSampleStatement(t *testing.T) {
+	stmt, err := buildTableRowSampleStatement("public", "users", 100, 0.5)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM \"public\".\"users\" TABLESAMPLE BERNOULLI (0.5) LIMIT 100", stmt)
+
+	stmt, err = buildTableRowSampleStatement("public", "users", 100, 100)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM \"public\".\"users\" LIMIT 100", stmt)
+}
+
 func Test_BuildPgTruncateCascadeStatement(t *testing.T) {
 	actual, err := BuildPgTruncateCascadeStatement("public", "users")
 	require.NoError(t, err)
@@ -135,6 +145,16 @@ func Test_BuildPgTruncateCascadeStatement(t *testing.T) {
 	)
 }
 
+func Test_BuildPgDeleteStatement(t *testing.T) {
+	actual, err := BuildPgDeleteStatement("public", "users")
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"DELETE FROM \"public\".\"users\";",
+		actual,
+	)
+}
+
 func Test_SequenceConfiguration(t *testing.T) {
 	s := SequenceConfiguration{
 		IncrementBy: 1,
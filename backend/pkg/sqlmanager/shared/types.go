@@ -95,6 +95,7 @@ const (
 	PostgresDriver = "pgx"
 	MysqlDriver    = "mysql"
 	MssqlDriver    = "sqlserver"
+	SqliteDriver   = "sqlite"
 )
 
 type BatchExecOpts struct {
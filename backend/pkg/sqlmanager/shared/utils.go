@@ -72,3 +72,12 @@ func SplitTableKey(key string) (schema, table string) {
 	}
 	return pieces[0], pieces[1]
 }
+
+// Returns the percentage of rows that a table sample must keep to return roughly limit rows out of the estimated rows of a table.
+// Returns 100 if the estimate is unknown or the table is not larger than the limit, in which case the table is not sampled.
+func GetTableSamplePercent(limit, estimatedRows int64) float64 {
+	if limit <= 0 || estimatedRows <= limit {
+		return 100
+	}
+	return float64(limit) / float64(estimatedRows) * 100
+}
@@ -39,3 +39,11 @@ func Test_DedupeSlice(t *testing.T) {
 	actual := DedupeSlice(input)
 	require.Equal(t, []string{"foo", "bar", "baz"}, actual)
 }
+
+func Test_GetTableSamplePercent(t *testing.T) {
+	require.Equal(t, float64(100), GetTableSamplePercent(100, -1))
+	require.Equal(t, float64(100), GetTableSamplePercent(100, 0))
+	require.Equal(t, float64(100), GetTableSamplePercent(100, 100))
+	require.Equal(t, float64(10), GetTableSamplePercent(100, 1000))
+	require.Equal(t, 0.01, GetTableSamplePercent(100, 1_000_000))
+}
@@ -21,6 +21,7 @@ import (
 	sqlmanager_mysql "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/mysql"
 	sqlmanager_postgres "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/postgres"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	sqlmanager_sqlite "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/sqlite"
 )
 
 type SqlDatabase interface {
@@ -31,6 +32,8 @@ type SqlDatabase interface {
 	GetTableInitStatements(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) ([]*sqlmanager_shared.TableInitStatement, error)
 	GetRolePermissionsMap(ctx context.Context) (map[string][]string, error)
 	GetTableRowCount(ctx context.Context, schema, table string, whereClause *string) (int64, error)
+	GetTableColumnMaxValue(ctx context.Context, schema, table, column string, whereClause *string) (*string, error) // returns nil if the table has no rows
+	GetTableRowSample(ctx context.Context, schema, table string, limit int64) ([]map[string]any, error)             // returns a random sample of at most limit rows without sorting the table
 	GetSchemaTableDataTypes(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) (*sqlmanager_shared.SchemaTableDataTypeResponse, error)
 	GetSchemaTableTriggers(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) ([]*sqlmanager_shared.TableTrigger, error)
 	GetSchemaInitStatements(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) ([]*sqlmanager_shared.InitSchemaStatements, error)
@@ -92,6 +95,7 @@ type SqlManagerClient interface {
 	NewSqlDbFromConnectionConfig(
 		ctx context.Context,
 		slogger *slog.Logger,
+		accountId string,
 		connectionConfig *mgmtv1alpha1.ConnectionConfig,
 		connectionTimeout *int,
 	) (*SqlConnection, error)
@@ -115,7 +119,7 @@ func (s *SqlManager) NewPooledSqlDb(
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
 		var closer func()
 		if _, ok := s.pgpool.Load(connection.Id); !ok {
-			pgconn, err := s.sqlconnector.NewDbFromConnectionConfig(connection.GetConnectionConfig(), sqlmanager_shared.Ptr(uint32(5)), slogger)
+			pgconn, err := s.sqlconnector.NewDbFromConnectionConfig(connection.GetAccountId(), connection.GetConnectionConfig(), sqlmanager_shared.Ptr(uint32(5)), slogger)
 			if err != nil {
 				return nil, fmt.Errorf("unable to create new postgres pool from connection config: %w", err)
 			}
@@ -141,7 +145,7 @@ func (s *SqlManager) NewPooledSqlDb(
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
 		var closer func()
 		if _, ok := s.mysqlpool.Load(connection.Id); !ok {
-			conn, err := s.sqlconnector.NewDbFromConnectionConfig(connection.ConnectionConfig, sqlmanager_shared.Ptr(uint32(5)), slogger)
+			conn, err := s.sqlconnector.NewDbFromConnectionConfig(connection.GetAccountId(), connection.ConnectionConfig, sqlmanager_shared.Ptr(uint32(5)), slogger)
 			if err != nil {
 				return nil, fmt.Errorf("unable to create new mysql pool from connection config: %w", err)
 			}
@@ -171,7 +175,7 @@ func (s *SqlManager) NewPooledSqlDb(
 	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
 		var closer func()
 		if _, ok := s.mssqlpool.Load(connection.Id); !ok {
-			conn, err := s.sqlconnector.NewDbFromConnectionConfig(connection.ConnectionConfig, sqlmanager_shared.Ptr(uint32(5)), slogger)
+			conn, err := s.sqlconnector.NewDbFromConnectionConfig(connection.GetAccountId(), connection.ConnectionConfig, sqlmanager_shared.Ptr(uint32(5)), slogger)
 			if err != nil {
 				return nil, fmt.Errorf("unable to create new mssql pool from connection config: %w", err)
 			}
@@ -198,6 +202,25 @@ func (s *SqlManager) NewPooledSqlDb(
 
 		db = sqlmanager_mssql.NewManager(s.mssqlquerier, pool, closer)
 		driver = sqlmanager_shared.MssqlDriver
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		// sqlite databases are local files that are cheap to open, so they are not pooled
+		conn, err := s.sqlconnector.NewDbFromConnectionConfig(connection.GetAccountId(), connection.ConnectionConfig, sqlmanager_shared.Ptr(uint32(5)), slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create new sqlite client from connection config: %w", err)
+		}
+		pool, err := conn.Open()
+		if err != nil {
+			return nil, fmt.Errorf("unable to open sqlite connection: %w", err)
+		}
+		db = sqlmanager_sqlite.NewManager(pool, func() {
+			if conn != nil {
+				err := conn.Close()
+				if err != nil {
+					slogger.Error(fmt.Errorf("failed to close connection: %w", err).Error())
+				}
+			}
+		})
+		driver = sqlmanager_shared.SqliteDriver
 	default:
 		return nil, fmt.Errorf("unsupported sql database connection: %T", connection.ConnectionConfig.Config)
 	}
@@ -214,12 +237,13 @@ func (s *SqlManager) NewSqlDb(
 	connection *mgmtv1alpha1.Connection,
 	connectionTimeout *int,
 ) (*SqlConnection, error) {
-	return s.NewSqlDbFromConnectionConfig(ctx, slogger, connection.GetConnectionConfig(), connectionTimeout)
+	return s.NewSqlDbFromConnectionConfig(ctx, slogger, connection.GetAccountId(), connection.GetConnectionConfig(), connectionTimeout)
 }
 
 func (s *SqlManager) NewSqlDbFromConnectionConfig(
 	ctx context.Context,
 	slogger *slog.Logger,
+	accountId string,
 	connectionConfig *mgmtv1alpha1.ConnectionConfig,
 	connectionTimeout *int,
 ) (*SqlConnection, error) {
@@ -237,7 +261,7 @@ func (s *SqlManager) NewSqlDbFromConnectionConfig(
 		if pgconfig == nil {
 			return nil, fmt.Errorf("source connection is not a postgres config")
 		}
-		pgconn, err := s.sqlconnector.NewDbFromConnectionConfig(connectionConfig, connTimeout, slogger)
+		pgconn, err := s.sqlconnector.NewDbFromConnectionConfig(accountId, connectionConfig, connTimeout, slogger)
 		if err != nil {
 			return nil, fmt.Errorf("unable to create new postgres pool from connection config: %w", err)
 		}
@@ -252,7 +276,7 @@ func (s *SqlManager) NewSqlDbFromConnectionConfig(
 		})
 		driver = sqlmanager_shared.PostgresDriver
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
-		conn, err := s.sqlconnector.NewDbFromConnectionConfig(connectionConfig, connTimeout, slogger)
+		conn, err := s.sqlconnector.NewDbFromConnectionConfig(accountId, connectionConfig, connTimeout, slogger)
 		if err != nil {
 			return nil, fmt.Errorf("unable to create new mysql pool from connection config: %w", err)
 		}
@@ -270,7 +294,7 @@ func (s *SqlManager) NewSqlDbFromConnectionConfig(
 		})
 		driver = sqlmanager_shared.MysqlDriver
 	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
-		conn, err := s.sqlconnector.NewDbFromConnectionConfig(connectionConfig, connTimeout, slogger)
+		conn, err := s.sqlconnector.NewDbFromConnectionConfig(accountId, connectionConfig, connTimeout, slogger)
 		if err != nil {
 			return nil, fmt.Errorf("unable to create new mssql client from connection config: %w", err)
 		}
@@ -287,6 +311,24 @@ func (s *SqlManager) NewSqlDbFromConnectionConfig(
 			}
 		})
 		driver = sqlmanager_shared.MssqlDriver
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		conn, err := s.sqlconnector.NewDbFromConnectionConfig(accountId, connectionConfig, connTimeout, slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create new sqlite client from connection config: %w", err)
+		}
+		pool, err := conn.Open()
+		if err != nil {
+			return nil, fmt.Errorf("unable to open sqlite connection: %w", err)
+		}
+		db = sqlmanager_sqlite.NewManager(pool, func() {
+			if conn != nil {
+				err := conn.Close()
+				if err != nil {
+					slogger.Error(fmt.Errorf("failed to close connection: %w", err).Error())
+				}
+			}
+		})
+		driver = sqlmanager_shared.SqliteDriver
 	default:
 		return nil, fmt.Errorf("unsupported sql database connection: %T", connectionConfig.Config)
 	}
@@ -352,6 +394,17 @@ func (s *SqlManager) NewSqlDbFromUrl(
 			}
 		})
 		driver = sqlmanager_shared.MssqlDriver
+	case sqlmanager_shared.SqliteDriver:
+		conn, err := sql.Open(sqlmanager_shared.SqliteDriver, connectionUrl)
+		if err != nil {
+			return nil, err
+		}
+		db = sqlmanager_sqlite.NewManager(conn, func() {
+			if conn != nil {
+				conn.Close()
+			}
+		})
+		driver = sqlmanager_shared.SqliteDriver
 	default:
 		return nil, fmt.Errorf("unsupported sql driver: %s", driver)
 	}
@@ -373,6 +426,9 @@ func GetColumnOverrideAndResetProperties(driver string, cInfo *sqlmanager_shared
 	case sqlmanager_shared.MssqlDriver:
 		needsOverride, needsReset := sqlmanager_mssql.GetMssqlColumnOverrideAndResetProperties(cInfo)
 		return needsOverride, needsReset, nil
+	case sqlmanager_shared.SqliteDriver:
+		needsOverride, needsReset := sqlmanager_sqlite.GetSqliteColumnOverrideAndResetProperties(cInfo)
+		return needsOverride, needsReset, nil
 	default:
 		return false, false, fmt.Errorf("unsupported sql driver: %s", driver)
 	}
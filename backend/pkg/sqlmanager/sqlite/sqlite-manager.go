@@ -0,0 +1,696 @@
+package sqlmanager_sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3"
+	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	sqliteutil "github.com/nucleuscloud/neosync/internal/sqlite"
+)
+
+const (
+	// SQLite has no schemas. Every table of the database is surfaced under the main schema.
+	MainSchema = "main"
+
+	goquDialect = "sqlite3"
+)
+
+var (
+	typeArgsRegex    = regexp.MustCompile(`\((\d+)(?:\s*,\s*(\d+))?\)`)
+	createTableRegex = regexp.MustCompile(`(?i)^\s*CREATE\s+TABLE\s+(IF\s+NOT\s+EXISTS\s+)?`)
+	createIndexRegex = regexp.MustCompile(`(?i)^\s*CREATE\s+(UNIQUE\s+)?INDEX\s+(IF\s+NOT\s+EXISTS\s+)?`)
+	createTrigRegex  = regexp.MustCompile(`(?i)^\s*CREATE\s+(TEMP\s+|TEMPORARY\s+)?TRIGGER\s+(IF\s+NOT\s+EXISTS\s+)?`)
+)
+
+type SqliteManager struct {
+	pool  mysql_queries.DBTX
+	close func()
+}
+
+func NewManager(pool mysql_queries.DBTX, closer func()) *SqliteManager {
+	return &SqliteManager{pool: pool, close: closer}
+}
+
+type tableColumn struct {
+	TableName    string
+	Cid          int
+	ColumnName   string
+	DataType     string
+	NotNull      bool
+	DefaultValue sql.NullString
+	PkIndex      int
+	Hidden       int
+}
+
+func (m *SqliteManager) getTableColumns(ctx context.Context) ([]*tableColumn, error) {
+	rows, err := m.pool.QueryContext(ctx, `
+SELECT m.name, p.cid, p.name, p.type, p."notnull", p.dflt_value, p.pk, p.hidden
+FROM sqlite_master m
+JOIN pragma_table_xinfo(m.name) p
+WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%'
+ORDER BY m.name, p.cid;
+`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*tableColumn{}
+	for rows.Next() {
+		var col tableColumn
+		if err := rows.Scan(&col.TableName, &col.Cid, &col.ColumnName, &col.DataType, &col.NotNull, &col.DefaultValue, &col.PkIndex, &col.Hidden); err != nil {
+			return nil, err
+		}
+		output = append(output, &col)
+	}
+	return output, rows.Err()
+}
+
+func (m *SqliteManager) GetDatabaseSchema(ctx context.Context) ([]*sqlmanager_shared.DatabaseSchemaRow, error) {
+	columns, err := m.getTableColumns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*sqlmanager_shared.DatabaseSchemaRow{}
+	for _, col := range columns {
+		var generatedType *string
+		// hidden is 2 for virtual generated columns and 3 for stored generated columns
+		switch col.Hidden {
+		case 2:
+			generatedType = sqlmanager_shared.Ptr("VIRTUAL GENERATED")
+		case 3:
+			generatedType = sqlmanager_shared.Ptr("STORED GENERATED")
+		}
+
+		dataType := strings.ToLower(col.DataType)
+		charMaxLength, numericPrecision, numericScale := -1, -1, -1
+		if match := typeArgsRegex.FindStringSubmatch(dataType); len(match) > 0 {
+			first, _ := strconv.Atoi(match[1])
+			if match[2] != "" {
+				numericPrecision = first
+				numericScale, _ = strconv.Atoi(match[2])
+			} else if strings.Contains(dataType, "char") {
+				charMaxLength = first
+			} else {
+				numericPrecision = first
+			}
+		}
+
+		isNullable := "YES"
+		if col.NotNull {
+			isNullable = "NO"
+		}
+
+		result = append(result, &sqlmanager_shared.DatabaseSchemaRow{
+			TableSchema:            MainSchema,
+			TableName:              col.TableName,
+			ColumnName:             col.ColumnName,
+			DataType:               dataType,
+			ColumnDefault:          col.DefaultValue.String,
+			IsNullable:             isNullable,
+			GeneratedType:          generatedType,
+			CharacterMaximumLength: charMaxLength,
+			NumericPrecision:       numericPrecision,
+			NumericScale:           numericScale,
+			OrdinalPosition:        col.Cid + 1,
+		})
+	}
+	return result, nil
+}
+
+// returns: {main.users: { id: struct{}{}, created_at: struct{}{}}}
+func (m *SqliteManager) GetSchemaColumnMap(ctx context.Context) (map[string]map[string]*sqlmanager_shared.ColumnInfo, error) {
+	dbSchemas, err := m.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := sqlmanager_shared.GetUniqueSchemaColMappings(dbSchemas)
+	return result, nil
+}
+
+type foreignKeyRow struct {
+	TableName    string
+	Id           int
+	Seq          int
+	RefTableName string
+	FromColumn   string
+	ToColumn     sql.NullString
+}
+
+type indexColumnRow struct {
+	TableName  string
+	IndexName  string
+	Origin     string
+	ColumnName sql.NullString
+}
+
+func (m *SqliteManager) GetTableConstraintsBySchema(ctx context.Context, schemas []string) (*sqlmanager_shared.TableConstraints, error) {
+	if !slices.Contains(schemas, MainSchema) {
+		return &sqlmanager_shared.TableConstraints{}, nil
+	}
+
+	columns, err := m.getTableColumns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notNullMap := map[string]map[string]bool{}
+	pkColumns := map[string][]*tableColumn{}
+	for _, col := range columns {
+		if _, ok := notNullMap[col.TableName]; !ok {
+			notNullMap[col.TableName] = map[string]bool{}
+		}
+		notNullMap[col.TableName][col.ColumnName] = col.NotNull
+		if col.PkIndex > 0 {
+			pkColumns[col.TableName] = append(pkColumns[col.TableName], col)
+		}
+	}
+
+	primaryKeyMap := map[string][]string{}
+	for tableName, cols := range pkColumns {
+		sort.Slice(cols, func(i, j int) bool { return cols[i].PkIndex < cols[j].PkIndex })
+		pkCols := make([]string, 0, len(cols))
+		for _, col := range cols {
+			pkCols = append(pkCols, col.ColumnName)
+		}
+		primaryKeyMap[sqlmanager_shared.BuildTable(MainSchema, tableName)] = pkCols
+	}
+
+	fkRows, err := m.getForeignKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	foreignKeyMap := map[string][]*sqlmanager_shared.ForeignConstraint{}
+	var current *sqlmanager_shared.ForeignConstraint
+	var currentKey string
+	var currentId int
+	for _, row := range fkRows {
+		tableName := sqlmanager_shared.BuildTable(MainSchema, row.TableName)
+		if current == nil || currentKey != tableName || currentId != row.Id {
+			current = &sqlmanager_shared.ForeignConstraint{
+				Columns:     []string{},
+				NotNullable: []bool{},
+				ForeignKey: &sqlmanager_shared.ForeignKey{
+					Table:   sqlmanager_shared.BuildTable(MainSchema, row.RefTableName),
+					Columns: []string{},
+				},
+			}
+			currentKey = tableName
+			currentId = row.Id
+			foreignKeyMap[tableName] = append(foreignKeyMap[tableName], current)
+		}
+		current.Columns = append(current.Columns, row.FromColumn)
+		current.NotNullable = append(current.NotNullable, notNullMap[row.TableName][row.FromColumn])
+		if row.ToColumn.Valid {
+			current.ForeignKey.Columns = append(current.ForeignKey.Columns, row.ToColumn.String)
+		}
+	}
+	// foreign keys that omit the referenced columns point at the primary key of the parent table
+	for _, fks := range foreignKeyMap {
+		for _, fk := range fks {
+			if len(fk.ForeignKey.Columns) == 0 {
+				fk.ForeignKey.Columns = append(fk.ForeignKey.Columns, primaryKeyMap[fk.ForeignKey.Table]...)
+			}
+			if len(fk.Columns) != len(fk.ForeignKey.Columns) {
+				return nil, fmt.Errorf("length of columns was not equal to length of foreign key cols: %d %d", len(fk.Columns), len(fk.ForeignKey.Columns))
+			}
+		}
+	}
+
+	idxRows, err := m.getUniqueIndexColumns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	type tableIndex struct {
+		table string
+		index string
+	}
+	uniqueIdxCols := map[tableIndex][]string{}
+	uniqueIdxOrder := []tableIndex{}
+	for _, row := range idxRows {
+		// expression indexes can not be used as a unique constraint
+		if !row.ColumnName.Valid {
+			continue
+		}
+		key := tableIndex{table: row.TableName, index: row.IndexName}
+		if _, ok := uniqueIdxCols[key]; !ok {
+			uniqueIdxOrder = append(uniqueIdxOrder, key)
+		}
+		uniqueIdxCols[key] = append(uniqueIdxCols[key], row.ColumnName.String)
+	}
+	uniqueConstraintsMap := map[string][][]string{}
+	for _, key := range uniqueIdxOrder {
+		tableName := sqlmanager_shared.BuildTable(MainSchema, key.table)
+		uniqueConstraintsMap[tableName] = append(uniqueConstraintsMap[tableName], sqlmanager_shared.DedupeSlice(uniqueIdxCols[key]))
+	}
+
+	return &sqlmanager_shared.TableConstraints{
+		ForeignKeyConstraints: foreignKeyMap,
+		PrimaryKeyConstraints: primaryKeyMap,
+		UniqueConstraints:     uniqueConstraintsMap,
+	}, nil
+}
+
+func (m *SqliteManager) getForeignKeys(ctx context.Context) ([]*foreignKeyRow, error) {
+	rows, err := m.pool.QueryContext(ctx, `
+SELECT m.name, p.id, p.seq, p."table", p."from", p."to"
+FROM sqlite_master m
+JOIN pragma_foreign_key_list(m.name) p
+WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%'
+ORDER BY m.name, p.id, p.seq;
+`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*foreignKeyRow{}
+	for rows.Next() {
+		var row foreignKeyRow
+		if err := rows.Scan(&row.TableName, &row.Id, &row.Seq, &row.RefTableName, &row.FromColumn, &row.ToColumn); err != nil {
+			return nil, err
+		}
+		output = append(output, &row)
+	}
+	return output, rows.Err()
+}
+
+// Returns the columns of unique constraints and unique indexes. Primary keys and partial indexes are excluded.
+func (m *SqliteManager) getUniqueIndexColumns(ctx context.Context) ([]*indexColumnRow, error) {
+	rows, err := m.pool.QueryContext(ctx, `
+SELECT m.name, il.name, il.origin, ii.name
+FROM sqlite_master m
+JOIN pragma_index_list(m.name) il
+JOIN pragma_index_info(il.name) ii
+WHERE m.type = 'table' AND m.name NOT LIKE 'sqlite_%'
+AND il."unique" = 1 AND il.origin != 'pk' AND il.partial = 0
+ORDER BY m.name, il.name, ii.seqno;
+`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*indexColumnRow{}
+	for rows.Next() {
+		var row indexColumnRow
+		if err := rows.Scan(&row.TableName, &row.IndexName, &row.Origin, &row.ColumnName); err != nil {
+			return nil, err
+		}
+		output = append(output, &row)
+	}
+	return output, rows.Err()
+}
+
+// SQLite has no roles, any connection that can open the database file may read and write every table
+func (m *SqliteManager) GetRolePermissionsMap(ctx context.Context) (map[string][]string, error) {
+	tables, err := m.getTableNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schemaTablePrivsMap := map[string][]string{}
+	for _, table := range tables {
+		key := sqlmanager_shared.BuildTable(MainSchema, table)
+		schemaTablePrivsMap[key] = []string{"SELECT", "INSERT", "UPDATE", "DELETE"}
+	}
+	return schemaTablePrivsMap, nil
+}
+
+func (m *SqliteManager) getTableNames(ctx context.Context) ([]string, error) {
+	rows, err := m.pool.QueryContext(ctx, `SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name;`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		output = append(output, name)
+	}
+	return output, rows.Err()
+}
+
+type schemaObject struct {
+	Type      string
+	Name      string
+	TableName string
+	Sql       string
+}
+
+// Returns the stored definitions of the tables, indexes and triggers that belong to the given tables.
+// Indexes that are created automatically for constraints have no definition and are not returned.
+func (m *SqliteManager) getSchemaObjects(ctx context.Context, objectType string, tables []string) ([]*schemaObject, error) {
+	if len(tables) == 0 {
+		return []*schemaObject{}, nil
+	}
+	query, args, err := goqu.Dialect(goquDialect).
+		From("sqlite_master").
+		Select("type", "name", "tbl_name", "sql").
+		Where(
+			goqu.C("type").Eq(objectType),
+			goqu.C("tbl_name").In(tables),
+			goqu.C("sql").IsNotNull(),
+		).
+		Order(goqu.C("tbl_name").Asc(), goqu.C("name").Asc()).
+		Prepared(true).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	rows, err := m.pool.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	output := []*schemaObject{}
+	for rows.Next() {
+		var obj schemaObject
+		if err := rows.Scan(&obj.Type, &obj.Name, &obj.TableName, &obj.Sql); err != nil {
+			return nil, err
+		}
+		output = append(output, &obj)
+	}
+	return output, rows.Err()
+}
+
+func getMainTableNames(tables []*sqlmanager_shared.SchemaTable) []string {
+	output := []string{}
+	for _, table := range tables {
+		if table.Schema == MainSchema {
+			output = append(output, table.Table)
+		}
+	}
+	return output
+}
+
+// Foreign keys, unique and check constraints are part of the table definition in SQLite so no alter statements are returned
+func (m *SqliteManager) GetTableInitStatements(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) ([]*sqlmanager_shared.TableInitStatement, error) {
+	tableNames := getMainTableNames(tables)
+	if len(tableNames) == 0 {
+		return []*sqlmanager_shared.TableInitStatement{}, nil
+	}
+
+	createTables, err := m.getSchemaObjects(ctx, "table", tableNames)
+	if err != nil {
+		return nil, err
+	}
+	indices, err := m.getSchemaObjects(ctx, "index", tableNames)
+	if err != nil {
+		return nil, err
+	}
+	indexmap := map[string][]string{}
+	for _, idx := range indices {
+		indexmap[idx.TableName] = append(indexmap[idx.TableName], wrapIdempotentIndex(idx.Sql))
+	}
+	createMap := map[string]string{}
+	for _, table := range createTables {
+		createMap[table.Name] = wrapIdempotentTable(table.Sql)
+	}
+
+	output := []*sqlmanager_shared.TableInitStatement{}
+	for _, table := range tableNames {
+		createStmt, ok := createMap[table]
+		if !ok {
+			continue
+		}
+		output = append(output, &sqlmanager_shared.TableInitStatement{
+			CreateTableStatement: createStmt,
+			AlterTableStatements: []*sqlmanager_shared.AlterTableStatement{},
+			IndexStatements:      indexmap[table],
+		})
+	}
+	return output, nil
+}
+
+func (m *SqliteManager) GetSequencesByTables(ctx context.Context, schema string, tables []string) ([]*sqlmanager_shared.DataType, error) {
+	return nil, errors.ErrUnsupported
+}
+
+// SQLite does not support custom data types, functions or sequences
+func (m *SqliteManager) GetSchemaTableDataTypes(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) (*sqlmanager_shared.SchemaTableDataTypeResponse, error) {
+	return &sqlmanager_shared.SchemaTableDataTypeResponse{}, nil
+}
+
+func (m *SqliteManager) GetSchemaTableTriggers(ctx context.Context, tables []*sqlmanager_shared.SchemaTable) ([]*sqlmanager_shared.TableTrigger, error) {
+	triggers, err := m.getSchemaObjects(ctx, "trigger", getMainTableNames(tables))
+	if err != nil {
+		return nil, err
+	}
+	output := make([]*sqlmanager_shared.TableTrigger, 0, len(triggers))
+	for _, trigger := range triggers {
+		output = append(output, &sqlmanager_shared.TableTrigger{
+			Schema:      MainSchema,
+			Table:       trigger.TableName,
+			TriggerName: trigger.Name,
+			Definition:  wrapIdempotentTrigger(trigger.Sql),
+		})
+	}
+	return output, nil
+}
+
+func (m *SqliteManager) GetSchemaInitStatements(
+	ctx context.Context,
+	tables []*sqlmanager_shared.SchemaTable,
+) ([]*sqlmanager_shared.InitSchemaStatements, error) {
+	// sqlite only allows a single writer, so the lookups are run sequentially
+	tableTriggers, err := m.GetSchemaTableTriggers(ctx, tables)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve sqlite schema table triggers: %w", err)
+	}
+	tableTriggerStmts := []string{}
+	for _, ttrig := range tableTriggers {
+		tableTriggerStmts = append(tableTriggerStmts, ttrig.Definition)
+	}
+
+	initStatementCfgs, err := m.GetTableInitStatements(ctx, tables)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve sqlite schema table create statements: %w", err)
+	}
+	createTables := []string{}
+	idxStmts := []string{}
+	for _, stmtCfg := range initStatementCfgs {
+		createTables = append(createTables, stmtCfg.CreateTableStatement)
+		idxStmts = append(idxStmts, stmtCfg.IndexStatements...)
+	}
+
+	return []*sqlmanager_shared.InitSchemaStatements{
+		{Label: "data types"},
+		{Label: "create table", Statements: createTables},
+		{Label: "non-fk alter table"},
+		{Label: "fk alter table"},
+		{Label: "table index", Statements: idxStmts},
+		{Label: "table triggers", Statements: tableTriggerStmts},
+	}, nil
+}
+
+func (m *SqliteManager) GetCreateTableStatement(ctx context.Context, schema, table string) (string, error) {
+	if schema != MainSchema {
+		return "", fmt.Errorf("sqlite tables only exist in the %s schema, received: %s", MainSchema, schema)
+	}
+	objects, err := m.getSchemaObjects(ctx, "table", []string{table})
+	if err != nil {
+		return "", fmt.Errorf("unable to get table create statement: %w", err)
+	}
+	if len(objects) == 0 {
+		return "", fmt.Errorf("unable to get table create statement: table %s not found", table)
+	}
+	return wrapIdempotentTable(objects[0].Sql), nil
+}
+
+func (m *SqliteManager) BatchExec(ctx context.Context, batchSize int, statements []string, opts *sqlmanager_shared.BatchExecOpts) error {
+	for i := 0; i < len(statements); i += batchSize {
+		end := i + batchSize
+		if end > len(statements) {
+			end = len(statements)
+		}
+
+		batchCmd := strings.Join(statements[i:end], " ")
+		if opts != nil && opts.Prefix != nil && *opts.Prefix != "" {
+			batchCmd = fmt.Sprintf("%s %s", *opts.Prefix, batchCmd)
+		}
+		_, err := m.pool.ExecContext(ctx, batchCmd)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *SqliteManager) GetTableRowCount(
+	ctx context.Context,
+	schema, table string,
+	whereClause *string,
+) (int64, error) {
+	builder := goqu.Dialect(goquDialect)
+	sqltable := goqu.S(schema).Table(table)
+
+	query := builder.From(sqltable).Select(goqu.COUNT("*"))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	err = m.pool.QueryRowContext(ctx, sql).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+	return count, err
+}
+
+func (m *SqliteManager) GetTableColumnMaxValue(
+	ctx context.Context,
+	schema, table, column string,
+	whereClause *string,
+) (*string, error) {
+	builder := goqu.Dialect(goquDialect)
+	sqltable := goqu.S(schema).Table(table)
+
+	query := builder.From(sqltable).Select(goqu.Cast(goqu.MAX(goqu.C(column)), "TEXT"))
+	if whereClause != nil && *whereClause != "" {
+		query = query.Where(goqu.L(*whereClause))
+	}
+	statement, _, err := query.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+	var maxValue sql.NullString
+	err = m.pool.QueryRowContext(ctx, statement).Scan(&maxValue)
+	if err != nil {
+		return nil, err
+	}
+	if !maxValue.Valid {
+		return nil, nil
+	}
+	return &maxValue.String, nil
+}
+
+func (m *SqliteManager) GetTableRowSample(
+	ctx context.Context,
+	schema, table string,
+	limit int64,
+) ([]map[string]any, error) {
+	// sqlite does not keep row estimates so the rows are counted, which is cheap for local database files
+	count, err := m.GetTableRowCount(ctx, schema, table, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to count table rows for sqlite: %w", err)
+	}
+	statement, err := buildTableRowSampleStatement(schema, table, limit, sqlmanager_shared.GetTableSamplePercent(limit, count))
+	if err != nil {
+		return nil, fmt.Errorf("unable to build table row sample statement for sqlite: %w", err)
+	}
+	rows, err := m.pool.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query table row sample for sqlite: %w", err)
+	}
+	defer rows.Close()
+
+	output := []map[string]any{}
+	for rows.Next() {
+		row, err := sqliteutil.SqliteSqlRowToMap(rows)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// Sqlite does not support TABLESAMPLE so each row is kept with the given probability instead of sorting the table.
+// Tables that are not larger than the limit are not sampled.
+func buildTableRowSampleStatement(schema, table string, limit int64, percent float64) (string, error) {
+	builder := goqu.Dialect(goquDialect)
+	query := builder.From(goqu.S(schema).Table(table)).Limit(uint(limit))
+	if percent < 100 {
+		// random() returns a signed 64 bit integer, so the remainder keeps each row with the given probability
+		query = query.Where(goqu.L("abs(random() % 1000000) < ?", int64(percent*10000)))
+	}
+	statement, _, err := query.ToSQL()
+	return statement, err
+}
+
+func (m *SqliteManager) Exec(ctx context.Context, statement string) error {
+	_, err := m.pool.ExecContext(ctx, statement)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SqliteManager) Close() {
+	if m.pool != nil && m.close != nil {
+		m.close()
+	}
+}
+
+// SQLite has no TRUNCATE statement, an unqualified DELETE is optimized into a truncate
+func BuildSqliteTruncateStatement(
+	table string,
+) (string, error) {
+	builder := goqu.Dialect(goquDialect)
+	stmt, _, err := builder.Delete(goqu.T(table)).ToSQL()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s;", stmt), nil
+}
+
+func EscapeSqliteColumns(cols []string) []string {
+	outcols := make([]string, len(cols))
+	for idx := range cols {
+		outcols[idx] = EscapeSqliteColumn(cols[idx])
+	}
+	return outcols
+}
+
+func EscapeSqliteColumn(col string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(col, `"`, `""`))
+}
+
+func GetSqliteColumnOverrideAndResetProperties(columnInfo *sqlmanager_shared.ColumnInfo) (needsOverride, needsReset bool) {
+	needsOverride = false
+	needsReset = false
+	return
+}
+
+func wrapIdempotentTable(stmt string) string {
+	return fmt.Sprintf("%s;", createTableRegex.ReplaceAllString(strings.TrimSpace(stmt), "CREATE TABLE IF NOT EXISTS "))
+}
+
+func wrapIdempotentIndex(stmt string) string {
+	return fmt.Sprintf("%s;", createIndexRegex.ReplaceAllStringFunc(strings.TrimSpace(stmt), func(match string) string {
+		if createIndexRegex.FindStringSubmatch(match)[1] != "" {
+			return "CREATE UNIQUE INDEX IF NOT EXISTS "
+		}
+		return "CREATE INDEX IF NOT EXISTS "
+	}))
+}
+
+func wrapIdempotentTrigger(stmt string) string {
+	return fmt.Sprintf("%s;", createTrigRegex.ReplaceAllStringFunc(strings.TrimSpace(stmt), func(match string) string {
+		if createTrigRegex.FindStringSubmatch(match)[1] != "" {
+			return "CREATE TEMP TRIGGER IF NOT EXISTS "
+		}
+		return "CREATE TRIGGER IF NOT EXISTS "
+	}))
+}
@@ -0,0 +1,180 @@
+package sqlmanager_sqlite
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+const testSchema = `
+CREATE TABLE users (
+  id INTEGER PRIMARY KEY,
+  email VARCHAR(255) NOT NULL UNIQUE,
+  name TEXT DEFAULT 'anonymous',
+  created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE orders (
+  id INTEGER PRIMARY KEY,
+  user_id INTEGER NOT NULL REFERENCES users(id),
+  total NUMERIC(10,2) NOT NULL DEFAULT 0,
+  total_cents INTEGER GENERATED ALWAYS AS (total * 100) VIRTUAL
+);
+CREATE TABLE order_items (
+  order_id INTEGER NOT NULL,
+  line INTEGER NOT NULL,
+  sku TEXT,
+  PRIMARY KEY (order_id, line),
+  FOREIGN KEY (order_id) REFERENCES orders
+);
+CREATE INDEX idx_orders_user_id ON orders(user_id);
+CREATE TRIGGER trg_users_email AFTER UPDATE OF email ON users BEGIN SELECT 1; END;
+`
+
+func newTestManager(t *testing.T) (*SqliteManager, *sql.DB) {
+	t.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	_, err = db.Exec(testSchema)
+	require.NoError(t, err)
+	return NewManager(db, func() {}), db
+}
+
+func Test_SqliteManager_GetDatabaseSchema(t *testing.T) {
+	manager, _ := newTestManager(t)
+
+	actual, err := manager.GetDatabaseSchema(context.Background())
+	require.NoError(t, err)
+	require.Len(t, actual, 11)
+
+	rows := map[string]*sqlmanager_shared.DatabaseSchemaRow{}
+	for _, row := range actual {
+		require.Equal(t, MainSchema, row.TableSchema)
+		rows[row.TableName+"."+row.ColumnName] = row
+	}
+	require.Equal(t, "varchar(255)", rows["users.email"].DataType)
+	require.Equal(t, 255, rows["users.email"].CharacterMaximumLength)
+	require.Equal(t, "NO", rows["users.email"].IsNullable)
+	require.Equal(t, "'anonymous'", rows["users.name"].ColumnDefault)
+	require.Equal(t, "YES", rows["users.name"].IsNullable)
+	require.Equal(t, 10, rows["orders.total"].NumericPrecision)
+	require.Equal(t, 2, rows["orders.total"].NumericScale)
+	require.Equal(t, 4, rows["orders.total_cents"].OrdinalPosition)
+	require.NotNil(t, rows["orders.total_cents"].GeneratedType)
+	require.Equal(t, "VIRTUAL GENERATED", *rows["orders.total_cents"].GeneratedType)
+}
+
+func Test_SqliteManager_GetTableConstraintsBySchema(t *testing.T) {
+	manager, _ := newTestManager(t)
+
+	actual, err := manager.GetTableConstraintsBySchema(context.Background(), []string{"public"})
+	require.NoError(t, err)
+	require.Empty(t, actual.PrimaryKeyConstraints)
+
+	actual, err = manager.GetTableConstraintsBySchema(context.Background(), []string{MainSchema})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"main.users":       {"id"},
+		"main.orders":      {"id"},
+		"main.order_items": {"order_id", "line"},
+	}, actual.PrimaryKeyConstraints)
+	require.Equal(t, map[string][][]string{
+		"main.users": {{"email"}},
+	}, actual.UniqueConstraints)
+	require.Equal(t, map[string][]*sqlmanager_shared.ForeignConstraint{
+		"main.orders": {
+			{Columns: []string{"user_id"}, NotNullable: []bool{true}, ForeignKey: &sqlmanager_shared.ForeignKey{Table: "main.users", Columns: []string{"id"}}},
+		},
+		"main.order_items": {
+			{Columns: []string{"order_id"}, NotNullable: []bool{true}, ForeignKey: &sqlmanager_shared.ForeignKey{Table: "main.orders", Columns: []string{"id"}}},
+		},
+	}, actual.ForeignKeyConstraints)
+}
+
+func Test_SqliteManager_GetSchemaInitStatements(t *testing.T) {
+	manager, _ := newTestManager(t)
+	tables := []*sqlmanager_shared.SchemaTable{
+		{Schema: MainSchema, Table: "users"},
+		{Schema: MainSchema, Table: "orders"},
+		{Schema: MainSchema, Table: "order_items"},
+	}
+
+	actual, err := manager.GetSchemaInitStatements(context.Background(), tables)
+	require.NoError(t, err)
+	require.Len(t, actual, 6)
+	require.Equal(t, "create table", actual[1].Label)
+	require.Len(t, actual[1].Statements, 3)
+	for _, stmt := range actual[1].Statements {
+		require.Contains(t, stmt, "CREATE TABLE IF NOT EXISTS")
+	}
+	require.Equal(t, []string{"CREATE INDEX IF NOT EXISTS idx_orders_user_id ON orders(user_id);"}, actual[4].Statements)
+	require.Equal(t, []string{"CREATE TRIGGER IF NOT EXISTS trg_users_email AFTER UPDATE OF email ON users BEGIN SELECT 1; END;"}, actual[5].Statements)
+
+	// the statements must be idempotent so they can be replayed against a database that already has the schema
+	dest, _ := newTestManager(t)
+	for _, block := range actual {
+		require.NoError(t, dest.BatchExec(context.Background(), 10, block.Statements, nil))
+	}
+}
+
+func Test_SqliteManager_TableQueries(t *testing.T) {
+	manager, db := newTestManager(t)
+	_, err := db.Exec(`INSERT INTO users (id, email) VALUES (1, 'a@example.com'), (7, 'b@example.com');`)
+	require.NoError(t, err)
+
+	count, err := manager.GetTableRowCount(context.Background(), MainSchema, "users", nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	count, err = manager.GetTableRowCount(context.Background(), MainSchema, "users", sqlmanager_shared.Ptr("id > 1"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	maxValue, err := manager.GetTableColumnMaxValue(context.Background(), MainSchema, "users", "id", nil)
+	require.NoError(t, err)
+	require.Equal(t, "7", *maxValue)
+
+	maxValue, err = manager.GetTableColumnMaxValue(context.Background(), MainSchema, "orders", "id", nil)
+	require.NoError(t, err)
+	require.Nil(t, maxValue)
+
+	sample, err := manager.GetTableRowSample(context.Background(), MainSchema, "users", 1)
+	require.NoError(t, err)
+	require.Len(t, sample, 1)
+	require.Contains(t, []any{int64(1), int64(7)}, sample[0]["id"])
+	require.Equal(t, "anonymous", sample[0]["name"])
+
+	sample, err = manager.GetTableRowSample(context.Background(), MainSchema, "orders", 10)
+	require.NoError(t, err)
+	require.Empty(t, sample)
+
+	permissions, err := manager.GetRolePermissionsMap(context.Background())
+	require.NoError(t, err)
+	require.Len(t, permissions, 3)
+	require.Contains(t, permissions["main.users"], "INSERT")
+
+	createStmt, err := manager.GetCreateTableStatement(context.Background(), MainSchema, "users")
+	require.NoError(t, err)
+	require.Contains(t, createStmt, "CREATE TABLE IF NOT EXISTS users")
+}
+
+func Test_BuildSqliteTruncateStatement(t *testing.T) {
+	actual, err := BuildSqliteTruncateStatement("users")
+	require.NoError(t, err)
+	require.Equal(t, "DELETE FROM `users`;", actual)
+}
+
+func Test_buildTableRowSampleStatement(t *testing.T) {
+	actual, err := buildTableRowSampleStatement(MainSchema, "users", 100, 0.5)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM `main`.`users` WHERE abs(random() % 1000000) < 5000 LIMIT 100", actual)
+
+	actual, err = buildTableRowSampleStatement(MainSchema, "users", 100, 100)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM `main`.`users` LIMIT 100", actual)
+}
@@ -0,0 +1,564 @@
+package sqlmanager_translate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+)
+
+// Driver agnostic representation of a column type that each dialect is parsed into and rendered from
+type typeKind int
+
+const (
+	kindTinyInt typeKind = iota
+	kindSmallInt
+	kindInt
+	kindBigInt
+	kindBool
+	kindFloat
+	kindDouble
+	kindDecimal
+	kindChar
+	kindVarchar
+	kindText
+	kindBinary
+	kindDate
+	kindTime
+	kindTimestamp
+	kindTimestampTz
+	kindUuid
+	kindJson
+)
+
+type columnType struct {
+	kind      typeKind
+	length    int // -1 when unbounded
+	precision int // -1 when unset
+	scale     int // -1 when unset
+}
+
+var typeArgsRegex = regexp.MustCompile(`\((\d+)(?:\s*,\s*(\d+))?\)`)
+
+// Splits a data type such as "timestamp(3) without time zone" into its base name and numeric arguments
+func splitDataType(dataType string) (base string, args []int) {
+	normalized := strings.ToLower(strings.TrimSpace(dataType))
+	if match := typeArgsRegex.FindStringSubmatch(normalized); len(match) > 0 {
+		for _, arg := range match[1:] {
+			if arg == "" {
+				continue
+			}
+			val, err := strconv.Atoi(arg)
+			if err == nil {
+				args = append(args, val)
+			}
+		}
+		normalized = typeArgsRegex.ReplaceAllString(normalized, "")
+	}
+	normalized = strings.TrimSpace(strings.TrimSuffix(normalized, " unsigned"))
+	return strings.Join(strings.Fields(normalized), " "), args
+}
+
+func parseColumnType(driver string, info *sqlmanager_shared.ColumnInfo) (ct *columnType, lossReason string, err error) {
+	base, args := splitDataType(info.DataType)
+	length := -1
+	if len(args) > 0 {
+		length = args[0]
+	} else if info.CharacterMaximumLength != nil && *info.CharacterMaximumLength > 0 {
+		length = *info.CharacterMaximumLength
+	}
+	precision, scale := -1, -1
+	if len(args) > 0 {
+		precision = args[0]
+		if len(args) > 1 {
+			scale = args[1]
+		}
+	} else {
+		if info.NumericPrecision != nil && *info.NumericPrecision > 0 {
+			precision = *info.NumericPrecision
+		}
+		if info.NumericScale != nil && *info.NumericScale >= 0 {
+			scale = *info.NumericScale
+		}
+	}
+
+	switch driver {
+	case sqlmanager_shared.PostgresDriver:
+		return parsePostgresType(base, length, precision, scale)
+	case sqlmanager_shared.MysqlDriver:
+		return parseMysqlType(base, length, precision, scale)
+	case sqlmanager_shared.MssqlDriver:
+		return parseMssqlType(base, length, precision, scale)
+	case sqlmanager_shared.SqliteDriver:
+		return parseSqliteType(base, length, precision, scale)
+	default:
+		return nil, "", fmt.Errorf("unsupported source driver for schema translation: %s", driver)
+	}
+}
+
+func parsePostgresType(base string, length, precision, scale int) (*columnType, string, error) {
+	if strings.HasSuffix(base, "[]") {
+		return &columnType{kind: kindJson}, "arrays are stored as json", nil
+	}
+	switch base {
+	case "smallint", "int2":
+		return &columnType{kind: kindSmallInt}, "", nil
+	case "integer", "int", "int4":
+		return &columnType{kind: kindInt}, "", nil
+	case "bigint", "int8":
+		return &columnType{kind: kindBigInt}, "", nil
+	case "boolean", "bool":
+		return &columnType{kind: kindBool}, "", nil
+	case "real", "float4":
+		return &columnType{kind: kindFloat}, "", nil
+	case "double precision", "float8":
+		return &columnType{kind: kindDouble}, "", nil
+	case "numeric", "decimal":
+		return &columnType{kind: kindDecimal, precision: precision, scale: scale}, "", nil
+	case "money":
+		return &columnType{kind: kindDecimal, precision: 19, scale: 2}, "currency formatting is not preserved", nil
+	case "character varying", "varchar":
+		if length <= 0 {
+			return &columnType{kind: kindText, length: -1}, "", nil
+		}
+		return &columnType{kind: kindVarchar, length: length}, "", nil
+	case "character", "char", "bpchar":
+		if length <= 0 {
+			length = 1
+		}
+		return &columnType{kind: kindChar, length: length}, "", nil
+	case "text", "citext", "name":
+		return &columnType{kind: kindText, length: -1}, "", nil
+	case "bytea":
+		return &columnType{kind: kindBinary, length: -1}, "", nil
+	case "date":
+		return &columnType{kind: kindDate}, "", nil
+	case "time", "time without time zone":
+		return &columnType{kind: kindTime}, "", nil
+	case "time with time zone", "timetz":
+		return &columnType{kind: kindTime}, "time zone offsets are not preserved", nil
+	case "timestamp", "timestamp without time zone":
+		return &columnType{kind: kindTimestamp}, "", nil
+	case "timestamp with time zone", "timestamptz":
+		return &columnType{kind: kindTimestampTz}, "", nil
+	case "uuid":
+		return &columnType{kind: kindUuid}, "", nil
+	case "json", "jsonb":
+		return &columnType{kind: kindJson}, "", nil
+	default:
+		return &columnType{kind: kindText, length: -1}, fmt.Sprintf("%s has no equivalent type and is stored as text", base), nil
+	}
+}
+
+func parseMysqlType(base string, length, precision, scale int) (*columnType, string, error) {
+	switch base {
+	case "tinyint":
+		return &columnType{kind: kindTinyInt}, "", nil
+	case "smallint":
+		return &columnType{kind: kindSmallInt}, "", nil
+	case "mediumint", "int", "integer":
+		return &columnType{kind: kindInt}, "", nil
+	case "bigint":
+		return &columnType{kind: kindBigInt}, "", nil
+	case "bool", "boolean":
+		return &columnType{kind: kindBool}, "", nil
+	case "bit":
+		if precision <= 1 {
+			return &columnType{kind: kindBool}, "", nil
+		}
+		return &columnType{kind: kindBinary, length: -1}, "bit fields are stored as binary", nil
+	case "decimal", "numeric":
+		return &columnType{kind: kindDecimal, precision: precision, scale: scale}, "", nil
+	case "float":
+		return &columnType{kind: kindFloat}, "", nil
+	case "double", "real":
+		return &columnType{kind: kindDouble}, "", nil
+	case "char":
+		if length <= 0 {
+			length = 1
+		}
+		return &columnType{kind: kindChar, length: length}, "", nil
+	case "varchar":
+		return &columnType{kind: kindVarchar, length: length}, "", nil
+	case "tinytext", "text", "mediumtext", "longtext":
+		return &columnType{kind: kindText, length: -1}, "", nil
+	case "binary", "varbinary", "tinyblob", "blob", "mediumblob", "longblob":
+		return &columnType{kind: kindBinary, length: -1}, "", nil
+	case "date":
+		return &columnType{kind: kindDate}, "", nil
+	case "time":
+		return &columnType{kind: kindTime}, "", nil
+	case "datetime", "timestamp":
+		return &columnType{kind: kindTimestamp}, "", nil
+	case "year":
+		return &columnType{kind: kindSmallInt}, "years are stored as integers", nil
+	case "json":
+		return &columnType{kind: kindJson}, "", nil
+	case "enum", "set":
+		return &columnType{kind: kindVarchar, length: 255}, fmt.Sprintf("allowed %s values are not enforced", base), nil
+	default:
+		return &columnType{kind: kindText, length: -1}, fmt.Sprintf("%s has no equivalent type and is stored as text", base), nil
+	}
+}
+
+func parseMssqlType(base string, length, precision, scale int) (*columnType, string, error) {
+	switch base {
+	case "bit":
+		return &columnType{kind: kindBool}, "", nil
+	case "tinyint", "smallint":
+		// sql server tinyint is unsigned so it is widened to fit in the signed types of the other dialects
+		return &columnType{kind: kindSmallInt}, "", nil
+	case "int":
+		return &columnType{kind: kindInt}, "", nil
+	case "bigint":
+		return &columnType{kind: kindBigInt}, "", nil
+	case "decimal", "numeric":
+		return &columnType{kind: kindDecimal, precision: precision, scale: scale}, "", nil
+	case "money":
+		return &columnType{kind: kindDecimal, precision: 19, scale: 4}, "", nil
+	case "smallmoney":
+		return &columnType{kind: kindDecimal, precision: 10, scale: 4}, "", nil
+	case "real":
+		return &columnType{kind: kindFloat}, "", nil
+	case "float":
+		if precision > 0 && precision <= 24 {
+			return &columnType{kind: kindFloat}, "", nil
+		}
+		return &columnType{kind: kindDouble}, "", nil
+	case "char", "nchar":
+		if length <= 0 {
+			length = 1
+		}
+		return &columnType{kind: kindChar, length: length}, "", nil
+	case "varchar", "nvarchar":
+		if length <= 0 {
+			return &columnType{kind: kindText, length: -1}, "", nil
+		}
+		return &columnType{kind: kindVarchar, length: length}, "", nil
+	case "text", "ntext":
+		return &columnType{kind: kindText, length: -1}, "", nil
+	case "binary", "varbinary", "image":
+		return &columnType{kind: kindBinary, length: -1}, "", nil
+	case "date":
+		return &columnType{kind: kindDate}, "", nil
+	case "time":
+		return &columnType{kind: kindTime}, "", nil
+	case "datetime", "datetime2", "smalldatetime":
+		return &columnType{kind: kindTimestamp}, "", nil
+	case "datetimeoffset":
+		return &columnType{kind: kindTimestampTz}, "", nil
+	case "uniqueidentifier":
+		return &columnType{kind: kindUuid}, "", nil
+	case "timestamp", "rowversion":
+		return &columnType{kind: kindBinary, length: -1}, "row versions are no longer generated by the database", nil
+	default:
+		return &columnType{kind: kindText, length: -1}, fmt.Sprintf("%s has no equivalent type and is stored as text", base), nil
+	}
+}
+
+// SQLite accepts any declared type name, so well known names are matched first before falling back to the type affinity rules.
+// https://www.sqlite.org/datatype3.html#determination_of_column_affinity
+func parseSqliteType(base string, length, precision, scale int) (*columnType, string, error) {
+	switch base {
+	case "tinyint":
+		return &columnType{kind: kindTinyInt}, "", nil
+	case "smallint", "int2":
+		return &columnType{kind: kindSmallInt}, "", nil
+	case "mediumint":
+		return &columnType{kind: kindInt}, "", nil
+	case "int", "integer", "bigint", "int8", "unsigned big int":
+		// every sqlite integer may hold up to 8 bytes
+		return &columnType{kind: kindBigInt}, "", nil
+	case "bool", "boolean":
+		return &columnType{kind: kindBool}, "", nil
+	case "real", "float", "double", "double precision":
+		return &columnType{kind: kindDouble}, "", nil
+	case "numeric", "decimal":
+		return &columnType{kind: kindDecimal, precision: precision, scale: scale}, "", nil
+	case "char", "character", "nchar", "native character":
+		if length <= 0 {
+			length = 1
+		}
+		return &columnType{kind: kindChar, length: length}, "", nil
+	case "varchar", "varying character", "nvarchar":
+		if length <= 0 {
+			return &columnType{kind: kindText, length: -1}, "", nil
+		}
+		return &columnType{kind: kindVarchar, length: length}, "", nil
+	case "text", "clob":
+		return &columnType{kind: kindText, length: -1}, "", nil
+	case "", "blob":
+		return &columnType{kind: kindBinary, length: -1}, "", nil
+	case "date":
+		return &columnType{kind: kindDate}, "", nil
+	case "time":
+		return &columnType{kind: kindTime}, "", nil
+	case "datetime", "timestamp":
+		return &columnType{kind: kindTimestamp}, "", nil
+	case "uuid":
+		return &columnType{kind: kindUuid}, "", nil
+	case "json", "jsonb":
+		return &columnType{kind: kindJson}, "", nil
+	}
+
+	switch {
+	case strings.Contains(base, "int"):
+		return &columnType{kind: kindBigInt}, "", nil
+	case strings.Contains(base, "char"), strings.Contains(base, "clob"), strings.Contains(base, "text"):
+		return &columnType{kind: kindText, length: -1}, "", nil
+	case strings.Contains(base, "blob"):
+		return &columnType{kind: kindBinary, length: -1}, "", nil
+	case strings.Contains(base, "real"), strings.Contains(base, "floa"), strings.Contains(base, "doub"):
+		return &columnType{kind: kindDouble}, "", nil
+	default:
+		return &columnType{kind: kindDecimal, precision: -1, scale: -1}, fmt.Sprintf("%s has numeric affinity and is stored as numeric", base), nil
+	}
+}
+
+// Renders the column type for the destination driver.
+// Key columns are columns that are part of a primary key, unique or foreign key constraint which some dialects do not allow to be unbounded.
+func renderColumnType(driver string, ct *columnType, isKeyColumn bool) (dataType, lossReason string, err error) {
+	switch driver {
+	case sqlmanager_shared.PostgresDriver:
+		dataType, lossReason = renderPostgresType(ct)
+	case sqlmanager_shared.MysqlDriver:
+		dataType, lossReason = renderMysqlType(ct, isKeyColumn)
+	case sqlmanager_shared.MssqlDriver:
+		dataType, lossReason = renderMssqlType(ct, isKeyColumn)
+	case sqlmanager_shared.SqliteDriver:
+		dataType, lossReason = renderSqliteType(ct)
+	default:
+		return "", "", fmt.Errorf("unsupported destination driver for schema translation: %s", driver)
+	}
+	return dataType, lossReason, nil
+}
+
+func renderPostgresType(ct *columnType) (dataType, lossReason string) {
+	switch ct.kind {
+	case kindTinyInt, kindSmallInt:
+		return "smallint", ""
+	case kindInt:
+		return "integer", ""
+	case kindBigInt:
+		return "bigint", ""
+	case kindBool:
+		return "boolean", ""
+	case kindFloat:
+		return "real", ""
+	case kindDouble:
+		return "double precision", ""
+	case kindDecimal:
+		if ct.precision <= 0 {
+			return "numeric", ""
+		}
+		return fmt.Sprintf("numeric(%d,%d)", ct.precision, max(ct.scale, 0)), ""
+	case kindChar:
+		return fmt.Sprintf("char(%d)", ct.length), ""
+	case kindVarchar:
+		return fmt.Sprintf("varchar(%d)", ct.length), ""
+	case kindBinary:
+		return "bytea", ""
+	case kindDate:
+		return "date", ""
+	case kindTime:
+		return "time", ""
+	case kindTimestamp:
+		return "timestamp", ""
+	case kindTimestampTz:
+		return "timestamptz", ""
+	case kindUuid:
+		return "uuid", ""
+	case kindJson:
+		return "jsonb", ""
+	default:
+		return "text", ""
+	}
+}
+
+const (
+	mysqlMaxDecimalPrecision = 65
+	mysqlMaxDecimalScale     = 30
+	mysqlMaxVarcharLength    = 16383 // utf8mb4 limit
+	mysqlMaxKeyLength        = 255
+)
+
+func renderMysqlType(ct *columnType, isKeyColumn bool) (dataType, lossReason string) {
+	switch ct.kind {
+	case kindTinyInt:
+		return "tinyint", ""
+	case kindSmallInt:
+		return "smallint", ""
+	case kindInt:
+		return "int", ""
+	case kindBigInt:
+		return "bigint", ""
+	case kindBool:
+		return "tinyint(1)", ""
+	case kindFloat:
+		return "float", ""
+	case kindDouble:
+		return "double", ""
+	case kindDecimal:
+		if ct.precision <= 0 {
+			return fmt.Sprintf("decimal(%d,%d)", mysqlMaxDecimalPrecision, mysqlMaxDecimalScale), "unbounded numeric values are limited in precision and scale"
+		}
+		if ct.precision > mysqlMaxDecimalPrecision || ct.scale > mysqlMaxDecimalScale {
+			return fmt.Sprintf("decimal(%d,%d)", min(ct.precision, mysqlMaxDecimalPrecision), min(max(ct.scale, 0), mysqlMaxDecimalScale)), "numeric precision or scale exceeds the mysql maximum"
+		}
+		return fmt.Sprintf("decimal(%d,%d)", ct.precision, max(ct.scale, 0)), ""
+	case kindChar:
+		if ct.length > mysqlMaxKeyLength {
+			return fmt.Sprintf("varchar(%d)", ct.length), ""
+		}
+		return fmt.Sprintf("char(%d)", ct.length), ""
+	case kindVarchar:
+		if ct.length <= 0 || ct.length > mysqlMaxVarcharLength {
+			if isKeyColumn {
+				return fmt.Sprintf("varchar(%d)", mysqlMaxKeyLength), fmt.Sprintf("key columns are limited to %d characters", mysqlMaxKeyLength)
+			}
+			return "longtext", "length limit is not enforced"
+		}
+		return fmt.Sprintf("varchar(%d)", ct.length), ""
+	case kindText:
+		if isKeyColumn {
+			return fmt.Sprintf("varchar(%d)", mysqlMaxKeyLength), fmt.Sprintf("key columns are limited to %d characters", mysqlMaxKeyLength)
+		}
+		return "longtext", ""
+	case kindBinary:
+		if isKeyColumn {
+			return fmt.Sprintf("varbinary(%d)", mysqlMaxKeyLength), fmt.Sprintf("key columns are limited to %d bytes", mysqlMaxKeyLength)
+		}
+		return "longblob", ""
+	case kindDate:
+		return "date", ""
+	case kindTime:
+		return "time(6)", ""
+	case kindTimestamp:
+		return "datetime(6)", ""
+	case kindTimestampTz:
+		return "datetime(6)", "time zone offsets are not preserved"
+	case kindUuid:
+		return "char(36)", ""
+	case kindJson:
+		return "json", ""
+	default:
+		return "longtext", ""
+	}
+}
+
+const (
+	mssqlMaxDecimalPrecision = 38
+	mssqlMaxNvarcharLength   = 4000
+	mssqlMaxKeyLength        = 450 // 900 byte index key limit
+)
+
+func renderMssqlType(ct *columnType, isKeyColumn bool) (dataType, lossReason string) {
+	switch ct.kind {
+	case kindTinyInt, kindSmallInt:
+		return "smallint", ""
+	case kindInt:
+		return "int", ""
+	case kindBigInt:
+		return "bigint", ""
+	case kindBool:
+		return "bit", ""
+	case kindFloat:
+		return "real", ""
+	case kindDouble:
+		return "float", ""
+	case kindDecimal:
+		if ct.precision <= 0 {
+			return fmt.Sprintf("decimal(%d,%d)", mssqlMaxDecimalPrecision, 10), "unbounded numeric values are limited in precision and scale"
+		}
+		if ct.precision > mssqlMaxDecimalPrecision {
+			return fmt.Sprintf("decimal(%d,%d)", mssqlMaxDecimalPrecision, min(max(ct.scale, 0), mssqlMaxDecimalPrecision)), "numeric precision exceeds the sql server maximum"
+		}
+		return fmt.Sprintf("decimal(%d,%d)", ct.precision, max(ct.scale, 0)), ""
+	case kindChar:
+		if ct.length > mssqlMaxNvarcharLength {
+			return "nvarchar(max)", ""
+		}
+		return fmt.Sprintf("nchar(%d)", ct.length), ""
+	case kindVarchar:
+		if ct.length > mssqlMaxNvarcharLength {
+			if isKeyColumn {
+				return fmt.Sprintf("nvarchar(%d)", mssqlMaxKeyLength), fmt.Sprintf("key columns are limited to %d characters", mssqlMaxKeyLength)
+			}
+			return "nvarchar(max)", "length limit is not enforced"
+		}
+		return fmt.Sprintf("nvarchar(%d)", ct.length), ""
+	case kindText:
+		if isKeyColumn {
+			return fmt.Sprintf("nvarchar(%d)", mssqlMaxKeyLength), fmt.Sprintf("key columns are limited to %d characters", mssqlMaxKeyLength)
+		}
+		return "nvarchar(max)", ""
+	case kindBinary:
+		if isKeyColumn {
+			return "varbinary(900)", "key columns are limited to 900 bytes"
+		}
+		return "varbinary(max)", ""
+	case kindDate:
+		return "date", ""
+	case kindTime:
+		return "time(7)", ""
+	case kindTimestamp:
+		return "datetime2(7)", ""
+	case kindTimestampTz:
+		return "datetimeoffset(7)", ""
+	case kindUuid:
+		return "uniqueidentifier", ""
+	case kindJson:
+		return "nvarchar(max)", "json is stored as text"
+	default:
+		return "nvarchar(max)", ""
+	}
+}
+
+// Types are rendered so that sqlite picks the matching column affinity.
+// Integer types are all rendered as integer so that single column integer primary keys become aliases of the rowid.
+func renderSqliteType(ct *columnType) (dataType, lossReason string) {
+	switch ct.kind {
+	case kindTinyInt, kindSmallInt, kindInt, kindBigInt:
+		return "integer", ""
+	case kindBool:
+		return "boolean", ""
+	case kindFloat, kindDouble:
+		return "real", ""
+	case kindDecimal:
+		if ct.precision <= 0 {
+			return "numeric", "decimal values that do not fit in a double may lose precision"
+		}
+		return fmt.Sprintf("numeric(%d,%d)", ct.precision, max(ct.scale, 0)), "decimal values that do not fit in a double may lose precision"
+	case kindChar:
+		return fmt.Sprintf("char(%d)", ct.length), ""
+	case kindVarchar:
+		return fmt.Sprintf("varchar(%d)", ct.length), ""
+	case kindBinary:
+		return "blob", ""
+	case kindDate:
+		return "date", ""
+	case kindTime:
+		return "time", ""
+	case kindTimestamp:
+		return "datetime", ""
+	case kindTimestampTz:
+		return "datetime", ""
+	case kindUuid:
+		// a uuid declared type would have numeric affinity
+		return "text", ""
+	case kindJson:
+		return "text", "json is stored as text"
+	default:
+		return "text", ""
+	}
+}
+
+func isIntegerKind(kind typeKind) bool {
+	return kind == kindTinyInt || kind == kindSmallInt || kind == kindInt || kind == kindBigInt
+}
+
+func isStringKind(kind typeKind) bool {
+	return kind == kindChar || kind == kindVarchar || kind == kindText
+}
@@ -0,0 +1,117 @@
+package sqlmanager_translate
+
+import (
+	"regexp"
+	"strings"
+
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+)
+
+type defaultKind int
+
+const (
+	defaultNumber defaultKind = iota
+	defaultString
+	defaultBool
+	defaultCurrentTimestamp
+	defaultUuid
+)
+
+type columnDefault struct {
+	kind  defaultKind
+	value string
+}
+
+var (
+	numberRegex       = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+	quotedStringRegex = regexp.MustCompile(`^N?'((?:[^']|'')*)'$`)
+	// postgres casts literals to the column type, e.g. 'foo'::character varying
+	pgCastRegex             = regexp.MustCompile(`^('(?:[^']|'')*'|-?\d+(?:\.\d+)?|\(-?\d+(?:\.\d+)?\))::[a-z0-9_ "\[\]\(\),]+$`)
+	currentTimestampRegex   = regexp.MustCompile(`^(now\(\)|current_timestamp(\(\d*\))?|localtimestamp(\(\d*\))?|getdate\(\)|getutcdate\(\)|sysdatetime\(\)|sysutcdatetime\(\)|sysdatetimeoffset\(\)|statement_timestamp\(\)|transaction_timestamp\(\)|clock_timestamp\(\))$`)
+	uuidGenerationFunctions = []string{"gen_random_uuid()", "uuid_generate_v4()", "uuid()", "newid()", "newsequentialid()"}
+)
+
+// Parses a column default into a driver agnostic representation.
+// Returns false when the default is an expression that can not be translated.
+func parseColumnDefault(driver, rawDefault string, ct *columnType) (*columnDefault, bool) {
+	value := strings.TrimSpace(rawDefault)
+	switch driver {
+	case sqlmanager_shared.MssqlDriver, sqlmanager_shared.SqliteDriver:
+		value = trimWrappingParens(value)
+	case sqlmanager_shared.PostgresDriver:
+		if match := pgCastRegex.FindStringSubmatch(value); len(match) > 1 {
+			value = trimWrappingParens(match[1])
+		}
+	}
+	if value == "" {
+		return nil, true
+	}
+	lowered := strings.ToLower(value)
+
+	if currentTimestampRegex.MatchString(lowered) {
+		return &columnDefault{kind: defaultCurrentTimestamp}, true
+	}
+	for _, fn := range uuidGenerationFunctions {
+		if lowered == fn {
+			return &columnDefault{kind: defaultUuid}, true
+		}
+	}
+	if lowered == "true" || lowered == "false" {
+		return &columnDefault{kind: defaultBool, value: lowered}, true
+	}
+	if lowered == "null" {
+		return nil, true
+	}
+
+	if match := quotedStringRegex.FindStringSubmatch(value); len(match) > 1 {
+		return parseLiteralDefault(strings.ReplaceAll(match[1], "''", "'"), ct), true
+	}
+	if numberRegex.MatchString(value) {
+		return parseLiteralDefault(value, ct), true
+	}
+	// mysql returns literal defaults without quotes
+	if driver == sqlmanager_shared.MysqlDriver && !strings.Contains(value, "(") {
+		return parseLiteralDefault(value, ct), true
+	}
+	return nil, false
+}
+
+// Converts a literal into a default that matches the column type
+func parseLiteralDefault(value string, ct *columnType) *columnDefault {
+	if ct.kind == kindBool {
+		switch strings.ToLower(value) {
+		case "1", "t", "true", "y", "yes", "b'1'":
+			return &columnDefault{kind: defaultBool, value: "true"}
+		case "0", "f", "false", "n", "no", "b'0'":
+			return &columnDefault{kind: defaultBool, value: "false"}
+		}
+	}
+	if numberRegex.MatchString(value) && !isStringKind(ct.kind) {
+		return &columnDefault{kind: defaultNumber, value: value}
+	}
+	return &columnDefault{kind: defaultString, value: value}
+}
+
+// Removes parentheses that wrap the whole value such as ((0)) returned by sql server
+func trimWrappingParens(value string) string {
+	for strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") && isWrappedByParens(value) {
+		value = strings.TrimSpace(value[1 : len(value)-1])
+	}
+	return value
+}
+
+func isWrappedByParens(value string) bool {
+	depth := 0
+	for idx, char := range value {
+		switch char {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 && idx != len(value)-1 {
+				return false
+			}
+		}
+	}
+	return depth == 0
+}
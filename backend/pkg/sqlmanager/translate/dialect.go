@@ -0,0 +1,215 @@
+package sqlmanager_translate
+
+import (
+	"fmt"
+	"strings"
+
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+)
+
+// Destination specific rendering of identifiers, column definitions and statements
+type dialect struct {
+	driver string
+}
+
+func getDialect(driver string) (*dialect, error) {
+	switch driver {
+	case sqlmanager_shared.PostgresDriver, sqlmanager_shared.MysqlDriver, sqlmanager_shared.MssqlDriver, sqlmanager_shared.SqliteDriver:
+		return &dialect{driver: driver}, nil
+	default:
+		return nil, fmt.Errorf("unsupported destination driver for schema translation: %s", driver)
+	}
+}
+
+func (d *dialect) quoteIdentifier(name string) string {
+	switch d.driver {
+	case sqlmanager_shared.MysqlDriver:
+		return fmt.Sprintf("`%s`", strings.ReplaceAll(name, "`", "``"))
+	case sqlmanager_shared.MssqlDriver:
+		return fmt.Sprintf("[%s]", strings.ReplaceAll(name, "]", "]]"))
+	default:
+		return fmt.Sprintf("%q", name)
+	}
+}
+
+func (d *dialect) quoteIdentifiers(names []string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, d.quoteIdentifier(name))
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func (d *dialect) quoteTable(schema, table string) string {
+	// sqlite tables always live in the main schema of the destination database
+	if d.driver == sqlmanager_shared.SqliteDriver {
+		return d.quoteIdentifier(table)
+	}
+	return fmt.Sprintf("%s.%s", d.quoteIdentifier(schema), d.quoteIdentifier(table))
+}
+
+// SQLite can only declare foreign keys as part of the CREATE TABLE statement
+func (d *dialect) supportsAlterForeignKeys() bool {
+	return d.driver != sqlmanager_shared.SqliteDriver
+}
+
+// Returns an idempotent statement that creates the schema the destination tables are placed in.
+// MySQL schemas are databases and SQLite tables always live in the main schema, which needs no statement
+func (d *dialect) createSchema(schema string) string {
+	switch d.driver {
+	case sqlmanager_shared.PostgresDriver:
+		return fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s;", d.quoteIdentifier(schema))
+	case sqlmanager_shared.MysqlDriver:
+		return fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s;", d.quoteIdentifier(schema))
+	case sqlmanager_shared.MssqlDriver:
+		// CREATE SCHEMA must be the only statement in its batch, so it is run with EXEC
+		return fmt.Sprintf(
+			"IF SCHEMA_ID(N'%s') IS NULL EXEC(N'CREATE SCHEMA %s');",
+			escapeString(schema), escapeString(d.quoteIdentifier(schema)),
+		)
+	default:
+		return ""
+	}
+}
+
+func (d *dialect) createTable(schema, table string, colDefs []string) string {
+	if d.driver == sqlmanager_shared.MssqlDriver {
+		return fmt.Sprintf(
+			"IF OBJECT_ID(N'%s', N'U') IS NULL CREATE TABLE %s (%s);",
+			escapeString(d.quoteTable(schema, table)), d.quoteTable(schema, table), strings.Join(colDefs, ", "),
+		)
+	}
+	return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s);", d.quoteTable(schema, table), strings.Join(colDefs, ", "))
+}
+
+func (d *dialect) columnDefinition(name, dataType string, isNullable, isIdentity bool, defaultValue string) string {
+	pieces := []string{d.quoteIdentifier(name), dataType}
+	if isIdentity {
+		switch d.driver {
+		case sqlmanager_shared.PostgresDriver:
+			pieces = append(pieces, "GENERATED BY DEFAULT AS IDENTITY")
+		case sqlmanager_shared.MssqlDriver:
+			pieces = append(pieces, "IDENTITY(1,1)")
+		}
+	}
+	if isNullable {
+		pieces = append(pieces, "NULL")
+	} else {
+		pieces = append(pieces, "NOT NULL")
+	}
+	if defaultValue != "" {
+		pieces = append(pieces, "DEFAULT", defaultValue)
+	}
+	if isIdentity && d.driver == sqlmanager_shared.MysqlDriver {
+		pieces = append(pieces, "AUTO_INCREMENT")
+	}
+	return strings.Join(pieces, " ")
+}
+
+// Returns false if the default has no equivalent for the destination column type
+func (d *dialect) renderDefault(colDefault *columnDefault, ct *columnType) (string, bool) {
+	switch colDefault.kind {
+	case defaultNumber:
+		return colDefault.value, true
+	case defaultBool:
+		if d.driver == sqlmanager_shared.PostgresDriver {
+			return colDefault.value, true
+		}
+		if colDefault.value == "true" {
+			return "1", true
+		}
+		return "0", true
+	case defaultString:
+		escaped := escapeString(colDefault.value)
+		switch d.driver {
+		case sqlmanager_shared.MssqlDriver:
+			return fmt.Sprintf("N'%s'", escaped), true
+		case sqlmanager_shared.MysqlDriver:
+			// text, blob and json columns only accept expression defaults
+			if ct.kind == kindText || ct.kind == kindBinary || ct.kind == kindJson || (ct.kind == kindVarchar && (ct.length <= 0 || ct.length > mysqlMaxVarcharLength)) {
+				return fmt.Sprintf("('%s')", escaped), true
+			}
+			return fmt.Sprintf("'%s'", escaped), true
+		default:
+			return fmt.Sprintf("'%s'", escaped), true
+		}
+	case defaultCurrentTimestamp:
+		if ct.kind != kindTimestamp && ct.kind != kindTimestampTz {
+			return "", false
+		}
+		switch d.driver {
+		case sqlmanager_shared.MysqlDriver:
+			return "CURRENT_TIMESTAMP(6)", true
+		case sqlmanager_shared.MssqlDriver:
+			if ct.kind == kindTimestampTz {
+				return "SYSDATETIMEOFFSET()", true
+			}
+			return "SYSDATETIME()", true
+		default:
+			return "CURRENT_TIMESTAMP", true
+		}
+	case defaultUuid:
+		switch d.driver {
+		case sqlmanager_shared.MysqlDriver:
+			return "(uuid())", true
+		case sqlmanager_shared.MssqlDriver:
+			return "NEWID()", true
+		case sqlmanager_shared.SqliteDriver:
+			return "", false
+		default:
+			return "gen_random_uuid()", true
+		}
+	default:
+		return "", false
+	}
+}
+
+func (d *dialect) wrapIdempotentForeignKey(schema, table, constraintName, alterStatement string) string {
+	switch d.driver {
+	case sqlmanager_shared.MysqlDriver:
+		stmt := fmt.Sprintf(`
+CREATE PROCEDURE NeosyncAddConstraintIfNotExists()
+BEGIN
+    DECLARE constraint_exists INT DEFAULT 0;
+
+    SELECT COUNT(*) INTO constraint_exists
+    FROM information_schema.TABLE_CONSTRAINTS
+    WHERE CONSTRAINT_SCHEMA = '%s'
+    AND TABLE_NAME = '%s'
+    AND CONSTRAINT_NAME = '%s';
+
+    IF constraint_exists = 0 THEN
+        %s
+    END IF;
+END;
+
+CALL NeosyncAddConstraintIfNotExists();
+DROP PROCEDURE NeosyncAddConstraintIfNotExists;
+`, escapeString(schema), escapeString(table), escapeString(constraintName), alterStatement)
+		return strings.TrimSpace(stmt)
+	case sqlmanager_shared.MssqlDriver:
+		return fmt.Sprintf(
+			"IF NOT EXISTS (SELECT 1 FROM sys.foreign_keys WHERE name = N'%s' AND parent_object_id = OBJECT_ID(N'%s')) %s",
+			escapeString(constraintName), escapeString(d.quoteTable(schema, table)), alterStatement,
+		)
+	default:
+		stmt := fmt.Sprintf(`
+DO $$
+BEGIN
+	IF NOT EXISTS (
+		SELECT 1
+		FROM pg_constraint
+		WHERE conname = '%s'
+		AND conrelid = '%s'::regclass
+	) THEN
+		%s
+	END IF;
+END $$;
+	`, escapeString(constraintName), escapeString(d.quoteTable(schema, table)), alterStatement)
+		return strings.TrimSpace(stmt)
+	}
+}
+
+func escapeString(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
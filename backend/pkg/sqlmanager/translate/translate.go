@@ -0,0 +1,276 @@
+package sqlmanager_translate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+)
+
+// Describes a part of the source schema that could not be represented exactly by the destination driver
+type LossyConversion struct {
+	Table string
+	// Empty when the conversion applies to the table as a whole, such as a dropped constraint
+	Column          string
+	SourceType      string
+	DestinationType string
+	Reason          string
+}
+
+func (l *LossyConversion) String() string {
+	if l.Column == "" {
+		return fmt.Sprintf("%s: %s", l.Table, l.Reason)
+	}
+	return fmt.Sprintf("%s.%s (%s -> %s): %s", l.Table, l.Column, l.SourceType, l.DestinationType, l.Reason)
+}
+
+type SchemaTranslation struct {
+	InitStatements   []*sqlmanager_shared.InitSchemaStatements
+	LossyConversions []*LossyConversion
+}
+
+// Translates the column and constraint definitions of the source driver into idempotent CREATE TABLE and foreign key statements for the destination driver.
+// Columns and constraints are expected to be keyed by schema.table as returned by GetSchemaColumnMap and GetTableConstraintsBySchema.
+func TranslateSchema(
+	sourceDriver, destinationDriver string,
+	tables []*sqlmanager_shared.SchemaTable,
+	columns map[string]map[string]*sqlmanager_shared.ColumnInfo,
+	constraints *sqlmanager_shared.TableConstraints,
+) (*SchemaTranslation, error) {
+	if constraints == nil {
+		constraints = &sqlmanager_shared.TableConstraints{}
+	}
+	dialect, err := getDialect(destinationDriver)
+	if err != nil {
+		return nil, err
+	}
+
+	tableSet := make(map[string]struct{}, len(tables))
+	for _, table := range tables {
+		tableSet[table.String()] = struct{}{}
+	}
+
+	output := &SchemaTranslation{LossyConversions: []*LossyConversion{}}
+	createSchemas := []string{}
+	createTables := []string{}
+	fkAlterStmts := []string{}
+	for _, schemaTable := range tables {
+		key := schemaTable.String()
+		tableCols, ok := columns[key]
+		if !ok {
+			continue
+		}
+
+		primaryKeys := constraints.PrimaryKeyConstraints[key]
+		uniqueConstraints := constraints.UniqueConstraints[key]
+		foreignKeys := constraints.ForeignKeyConstraints[key]
+		keyColumns := getKeyColumns(primaryKeys, uniqueConstraints, foreignKeys)
+
+		colDefs := []string{}
+		for _, colName := range getOrderedColumns(tableCols) {
+			colDef, lossy, err := buildColumnDefinition(sourceDriver, dialect, colName, tableCols[colName], &columnKeyInfo{
+				isKey:              slices.Contains(keyColumns, colName),
+				isIdentityable:     isIdentityableColumn(colName, primaryKeys, uniqueConstraints),
+				isSinglePrimaryKey: len(primaryKeys) == 1 && primaryKeys[0] == colName,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("unable to translate column %s.%s: %w", key, colName, err)
+			}
+			for _, l := range lossy {
+				l.Table = key
+				output.LossyConversions = append(output.LossyConversions, l)
+			}
+			colDefs = append(colDefs, colDef)
+		}
+		if len(primaryKeys) > 0 {
+			colDefs = append(colDefs, fmt.Sprintf("PRIMARY KEY (%s)", dialect.quoteIdentifiers(primaryKeys)))
+		}
+		for _, uniqueCols := range uniqueConstraints {
+			colDefs = append(colDefs, fmt.Sprintf("UNIQUE (%s)", dialect.quoteIdentifiers(uniqueCols)))
+		}
+		for _, fk := range foreignKeys {
+			if fk.ForeignKey == nil {
+				continue
+			}
+			if _, ok := tableSet[fk.ForeignKey.Table]; !ok {
+				output.LossyConversions = append(output.LossyConversions, &LossyConversion{
+					Table:  key,
+					Reason: fmt.Sprintf("foreign key (%s) was dropped as the referenced table %s is not being initialized", strings.Join(fk.Columns, ", "), fk.ForeignKey.Table),
+				})
+				continue
+			}
+			refSchema, refTable := sqlmanager_shared.SplitTableKey(fk.ForeignKey.Table)
+			if !dialect.supportsAlterForeignKeys() {
+				colDefs = append(colDefs, fmt.Sprintf(
+					"FOREIGN KEY (%s) REFERENCES %s (%s)",
+					dialect.quoteIdentifiers(fk.Columns),
+					dialect.quoteTable(refSchema, refTable),
+					dialect.quoteIdentifiers(fk.ForeignKey.Columns),
+				))
+				continue
+			}
+			constraintName := buildConstraintName("fk", schemaTable.Table, fk.Columns)
+			stmt := fmt.Sprintf(
+				"ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s);",
+				dialect.quoteTable(schemaTable.Schema, schemaTable.Table),
+				dialect.quoteIdentifier(constraintName),
+				dialect.quoteIdentifiers(fk.Columns),
+				dialect.quoteTable(refSchema, refTable),
+				dialect.quoteIdentifiers(fk.ForeignKey.Columns),
+			)
+			fkAlterStmts = append(fkAlterStmts, dialect.wrapIdempotentForeignKey(schemaTable.Schema, schemaTable.Table, constraintName, stmt))
+		}
+		if stmt := dialect.createSchema(schemaTable.Schema); stmt != "" && !slices.Contains(createSchemas, stmt) {
+			createSchemas = append(createSchemas, stmt)
+		}
+		createTables = append(createTables, dialect.createTable(schemaTable.Schema, schemaTable.Table, colDefs))
+	}
+
+	output.InitStatements = []*sqlmanager_shared.InitSchemaStatements{
+		{Label: "create schema", Statements: createSchemas},
+		{Label: "create table", Statements: createTables},
+		{Label: "fk alter table", Statements: fkAlterStmts},
+	}
+	return output, nil
+}
+
+type columnKeyInfo struct {
+	// column is part of a primary key, unique or foreign key constraint
+	isKey bool
+	// column can be auto incremented in every dialect
+	isIdentityable bool
+	// column is the only column of the primary key
+	isSinglePrimaryKey bool
+}
+
+func buildColumnDefinition(
+	sourceDriver string,
+	dialect *dialect,
+	colName string,
+	info *sqlmanager_shared.ColumnInfo,
+	keyInfo *columnKeyInfo,
+) (string, []*LossyConversion, error) {
+	lossy := []*LossyConversion{}
+	addLoss := func(destType, reason string) {
+		lossy = append(lossy, &LossyConversion{
+			Column:          colName,
+			SourceType:      info.DataType,
+			DestinationType: destType,
+			Reason:          reason,
+		})
+	}
+
+	ct, parseLoss, err := parseColumnType(sourceDriver, info)
+	if err != nil {
+		return "", nil, err
+	}
+	dataType, renderLoss, err := renderColumnType(dialect.driver, ct, keyInfo.isKey)
+	if err != nil {
+		return "", nil, err
+	}
+	if parseLoss != "" {
+		addLoss(dataType, parseLoss)
+	}
+	if renderLoss != "" {
+		addLoss(dataType, renderLoss)
+	}
+
+	isIdentity := false
+	if isAutoIncrementColumn(info) {
+		switch {
+		case !isIntegerKind(ct.kind):
+			addLoss(dataType, "auto increment is only supported for integer columns and was dropped")
+		case !keyInfo.isIdentityable && dialect.driver == sqlmanager_shared.MysqlDriver:
+			addLoss(dataType, "auto increment requires the column to be a key and was dropped")
+		case !keyInfo.isSinglePrimaryKey && dialect.driver == sqlmanager_shared.SqliteDriver:
+			addLoss(dataType, "auto increment requires the column to be the only primary key column and was dropped")
+		default:
+			isIdentity = true
+		}
+	}
+
+	var defaultValue string
+	if !isIdentity && info.ColumnDefault != "" && !isSequenceDefault(info.ColumnDefault) {
+		colDefault, ok := parseColumnDefault(sourceDriver, info.ColumnDefault, ct)
+		if ok && colDefault != nil {
+			defaultValue, ok = dialect.renderDefault(colDefault, ct)
+		}
+		if !ok {
+			addLoss(dataType, fmt.Sprintf("default %s could not be translated and was dropped", info.ColumnDefault))
+		}
+	}
+
+	return dialect.columnDefinition(colName, dataType, info.IsNullable, isIdentity, defaultValue), lossy, nil
+}
+
+func getOrderedColumns(tableCols map[string]*sqlmanager_shared.ColumnInfo) []string {
+	colNames := make([]string, 0, len(tableCols))
+	for colName := range tableCols {
+		colNames = append(colNames, colName)
+	}
+	sort.SliceStable(colNames, func(i, j int) bool {
+		left, right := tableCols[colNames[i]].OrdinalPosition, tableCols[colNames[j]].OrdinalPosition
+		if left == right {
+			return colNames[i] < colNames[j]
+		}
+		return left < right
+	})
+	return colNames
+}
+
+func getKeyColumns(
+	primaryKeys []string,
+	uniqueConstraints [][]string,
+	foreignKeys []*sqlmanager_shared.ForeignConstraint,
+) []string {
+	keyColumns := []string{}
+	keyColumns = append(keyColumns, primaryKeys...)
+	for _, uniqueCols := range uniqueConstraints {
+		keyColumns = append(keyColumns, uniqueCols...)
+	}
+	for _, fk := range foreignKeys {
+		keyColumns = append(keyColumns, fk.Columns...)
+	}
+	return sqlmanager_shared.DedupeSlice(keyColumns)
+}
+
+// MySQL only allows auto increment columns that are the first column of a key
+func isIdentityableColumn(colName string, primaryKeys []string, uniqueConstraints [][]string) bool {
+	if len(primaryKeys) > 0 && primaryKeys[0] == colName {
+		return true
+	}
+	for _, uniqueCols := range uniqueConstraints {
+		if len(uniqueCols) > 0 && uniqueCols[0] == colName {
+			return true
+		}
+	}
+	return false
+}
+
+func isAutoIncrementColumn(info *sqlmanager_shared.ColumnInfo) bool {
+	if info.IdentityGeneration != nil && *info.IdentityGeneration != "" {
+		return true
+	}
+	return isSequenceDefault(info.ColumnDefault)
+}
+
+// postgres serial columns are backed by a sequence default
+func isSequenceDefault(columnDefault string) bool {
+	return strings.HasPrefix(strings.ToLower(columnDefault), "nextval(")
+}
+
+const maxConstraintNameLength = 63 // postgres has the smallest identifier limit
+
+func buildConstraintName(prefix, table string, columns []string) string {
+	name := fmt.Sprintf("%s_%s_%s", prefix, table, strings.Join(columns, "_"))
+	if len(name) <= maxConstraintNameLength {
+		return name
+	}
+	hash := sha256.Sum256([]byte(name))
+	suffix := hex.EncodeToString(hash[:])[:8]
+	return fmt.Sprintf("%s_%s", name[:maxConstraintNameLength-len(suffix)-1], suffix)
+}
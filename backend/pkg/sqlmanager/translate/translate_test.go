@@ -0,0 +1,232 @@
+package sqlmanager_translate
+
+import (
+	"testing"
+
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TranslateSchema_PostgresToMysql(t *testing.T) {
+	identity := "BY DEFAULT"
+	tables := []*sqlmanager_shared.SchemaTable{
+		{Schema: "public", Table: "users"},
+		{Schema: "public", Table: "orders"},
+	}
+	columns := map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users": {
+			"id":         {OrdinalPosition: 1, DataType: "integer", IdentityGeneration: &identity},
+			"email":      {OrdinalPosition: 2, DataType: "text"},
+			"name":       {OrdinalPosition: 3, DataType: "character varying(100)", IsNullable: true, ColumnDefault: "'anonymous'::character varying"},
+			"created_at": {OrdinalPosition: 4, DataType: "timestamp with time zone", ColumnDefault: "now()"},
+		},
+		"public.orders": {
+			"id":      {OrdinalPosition: 1, DataType: "bigint", ColumnDefault: "nextval('orders_id_seq'::regclass)"},
+			"user_id": {OrdinalPosition: 2, DataType: "integer"},
+			"total":   {OrdinalPosition: 3, DataType: "numeric(10,2)", ColumnDefault: "0"},
+			"tags":    {OrdinalPosition: 4, DataType: "text[]", IsNullable: true},
+			"paid":    {OrdinalPosition: 5, DataType: "boolean", ColumnDefault: "false"},
+		},
+	}
+	constraints := &sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{
+			"public.users":  {"id"},
+			"public.orders": {"id"},
+		},
+		UniqueConstraints: map[string][][]string{
+			"public.users": {{"email"}},
+		},
+		ForeignKeyConstraints: map[string][]*sqlmanager_shared.ForeignConstraint{
+			"public.orders": {
+				{Columns: []string{"user_id"}, ForeignKey: &sqlmanager_shared.ForeignKey{Table: "public.users", Columns: []string{"id"}}},
+			},
+		},
+	}
+
+	actual, err := TranslateSchema(sqlmanager_shared.PostgresDriver, sqlmanager_shared.MysqlDriver, tables, columns, constraints)
+	require.NoError(t, err)
+	require.Len(t, actual.InitStatements, 3)
+	require.Equal(t, []string{"CREATE DATABASE IF NOT EXISTS `public`;"}, actual.InitStatements[0].Statements)
+	require.Equal(t, []string{
+		"CREATE TABLE IF NOT EXISTS `public`.`users` (`id` int NOT NULL AUTO_INCREMENT, `email` varchar(255) NOT NULL, `name` varchar(100) NULL DEFAULT 'anonymous', `created_at` datetime(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6), PRIMARY KEY (`id`), UNIQUE (`email`));",
+		"CREATE TABLE IF NOT EXISTS `public`.`orders` (`id` bigint NOT NULL AUTO_INCREMENT, `user_id` int NOT NULL, `total` decimal(10,2) NOT NULL DEFAULT 0, `tags` json NULL, `paid` tinyint(1) NOT NULL DEFAULT 0, PRIMARY KEY (`id`));",
+	}, actual.InitStatements[1].Statements)
+	require.Len(t, actual.InitStatements[2].Statements, 1)
+	require.Contains(t, actual.InitStatements[2].Statements[0], "ALTER TABLE `public`.`orders` ADD CONSTRAINT `fk_orders_user_id` FOREIGN KEY (`user_id`) REFERENCES `public`.`users` (`id`);")
+
+	lossyColumns := []string{}
+	for _, l := range actual.LossyConversions {
+		lossyColumns = append(lossyColumns, l.Table+"."+l.Column)
+	}
+	require.ElementsMatch(t, []string{"public.users.email", "public.users.created_at", "public.orders.tags"}, lossyColumns)
+}
+
+func Test_TranslateSchema_MssqlToPostgres(t *testing.T) {
+	identity := "IDENTITY(1,1)"
+	maxLength := -1
+	tables := []*sqlmanager_shared.SchemaTable{{Schema: "dbo", Table: "accounts"}}
+	columns := map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"dbo.accounts": {
+			"id":        {OrdinalPosition: 1, DataType: "int", IdentityGeneration: &identity},
+			"public_id": {OrdinalPosition: 2, DataType: "uniqueidentifier", ColumnDefault: "(newid())"},
+			"bio":       {OrdinalPosition: 3, DataType: "nvarchar", CharacterMaximumLength: &maxLength, IsNullable: true},
+			"active":    {OrdinalPosition: 4, DataType: "bit", ColumnDefault: "((1))"},
+			"score":     {OrdinalPosition: 5, DataType: "int", ColumnDefault: "([dbo].[default_score]())"},
+		},
+	}
+	constraints := &sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{"dbo.accounts": {"id"}},
+		ForeignKeyConstraints: map[string][]*sqlmanager_shared.ForeignConstraint{
+			"dbo.accounts": {
+				{Columns: []string{"public_id"}, ForeignKey: &sqlmanager_shared.ForeignKey{Table: "dbo.users", Columns: []string{"id"}}},
+			},
+		},
+	}
+
+	actual, err := TranslateSchema(sqlmanager_shared.MssqlDriver, sqlmanager_shared.PostgresDriver, tables, columns, constraints)
+	require.NoError(t, err)
+	require.Equal(t, []string{`CREATE SCHEMA IF NOT EXISTS "dbo";`}, actual.InitStatements[0].Statements)
+	require.Equal(t, []string{
+		`CREATE TABLE IF NOT EXISTS "dbo"."accounts" ("id" integer GENERATED BY DEFAULT AS IDENTITY NOT NULL, "public_id" uuid NOT NULL DEFAULT gen_random_uuid(), "bio" text NULL, "active" boolean NOT NULL DEFAULT true, "score" integer NOT NULL, PRIMARY KEY ("id"));`,
+	}, actual.InitStatements[1].Statements)
+	require.Empty(t, actual.InitStatements[2].Statements, "foreign keys to tables that are not initialized are dropped")
+	require.Len(t, actual.LossyConversions, 2)
+	require.Equal(t, "score", actual.LossyConversions[0].Column)
+	require.Empty(t, actual.LossyConversions[1].Column)
+}
+
+func Test_TranslateSchema_MysqlToMssql(t *testing.T) {
+	identity := "auto_increment"
+	tables := []*sqlmanager_shared.SchemaTable{{Schema: "neosync", Table: "events"}}
+	columns := map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"neosync.events": {
+			"id":      {OrdinalPosition: 1, DataType: "bigint", IdentityGeneration: &identity},
+			"kind":    {OrdinalPosition: 2, DataType: "enum", ColumnDefault: "created"},
+			"payload": {OrdinalPosition: 3, DataType: "json", IsNullable: true},
+		},
+	}
+
+	actual, err := TranslateSchema(sqlmanager_shared.MysqlDriver, sqlmanager_shared.MssqlDriver, tables, columns, &sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{"neosync.events": {"id"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"IF SCHEMA_ID(N'neosync') IS NULL EXEC(N'CREATE SCHEMA [neosync]');"}, actual.InitStatements[0].Statements)
+	require.Equal(t, []string{
+		"IF OBJECT_ID(N'[neosync].[events]', N'U') IS NULL CREATE TABLE [neosync].[events] ([id] bigint IDENTITY(1,1) NOT NULL, [kind] nvarchar(255) NOT NULL DEFAULT N'created', [payload] nvarchar(max) NULL, PRIMARY KEY ([id]));",
+	}, actual.InitStatements[1].Statements)
+	require.Len(t, actual.LossyConversions, 2)
+}
+
+func Test_TranslateSchema_PostgresToSqlite(t *testing.T) {
+	identity := "BY DEFAULT"
+	tables := []*sqlmanager_shared.SchemaTable{
+		{Schema: "public", Table: "users"},
+		{Schema: "public", Table: "orders"},
+	}
+	columns := map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users": {
+			"id":         {OrdinalPosition: 1, DataType: "uuid", ColumnDefault: "gen_random_uuid()"},
+			"email":      {OrdinalPosition: 2, DataType: "text"},
+			"created_at": {OrdinalPosition: 3, DataType: "timestamp with time zone", ColumnDefault: "now()"},
+		},
+		"public.orders": {
+			"id":      {OrdinalPosition: 1, DataType: "bigint", IdentityGeneration: &identity},
+			"user_id": {OrdinalPosition: 2, DataType: "uuid"},
+			"paid":    {OrdinalPosition: 3, DataType: "boolean", ColumnDefault: "false"},
+		},
+	}
+	constraints := &sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{
+			"public.users":  {"id"},
+			"public.orders": {"id"},
+		},
+		ForeignKeyConstraints: map[string][]*sqlmanager_shared.ForeignConstraint{
+			"public.orders": {
+				{Columns: []string{"user_id"}, ForeignKey: &sqlmanager_shared.ForeignKey{Table: "public.users", Columns: []string{"id"}}},
+			},
+		},
+	}
+
+	actual, err := TranslateSchema(sqlmanager_shared.PostgresDriver, sqlmanager_shared.SqliteDriver, tables, columns, constraints)
+	require.NoError(t, err)
+	require.Len(t, actual.InitStatements, 3)
+	require.Empty(t, actual.InitStatements[0].Statements, "sqlite tables are created in the main schema")
+	require.Equal(t, []string{
+		`CREATE TABLE IF NOT EXISTS "users" ("id" text NOT NULL, "email" text NOT NULL, "created_at" datetime NOT NULL DEFAULT CURRENT_TIMESTAMP, PRIMARY KEY ("id"));`,
+		`CREATE TABLE IF NOT EXISTS "orders" ("id" integer NOT NULL, "user_id" text NOT NULL, "paid" boolean NOT NULL DEFAULT 0, PRIMARY KEY ("id"), FOREIGN KEY ("user_id") REFERENCES "users" ("id"));`,
+	}, actual.InitStatements[1].Statements)
+	require.Empty(t, actual.InitStatements[2].Statements)
+
+	lossyColumns := []string{}
+	for _, l := range actual.LossyConversions {
+		lossyColumns = append(lossyColumns, l.Table+"."+l.Column)
+	}
+	require.ElementsMatch(t, []string{"public.users.id"}, lossyColumns)
+}
+
+func Test_TranslateSchema_SqliteToPostgres(t *testing.T) {
+	tables := []*sqlmanager_shared.SchemaTable{{Schema: "main", Table: "notes"}}
+	columns := map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"main.notes": {
+			"id":       {OrdinalPosition: 1, DataType: "integer"},
+			"title":    {OrdinalPosition: 2, DataType: "varchar(80)", ColumnDefault: "'untitled'"},
+			"body":     {OrdinalPosition: 3, DataType: "", IsNullable: true},
+			"score":    {OrdinalPosition: 4, DataType: "float8", ColumnDefault: "(0)"},
+			"pinned":   {OrdinalPosition: 5, DataType: "boolean", ColumnDefault: "0"},
+			"archived": {OrdinalPosition: 6, DataType: "custom_flag", IsNullable: true},
+		},
+	}
+	constraints := &sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{"main.notes": {"id"}},
+	}
+
+	actual, err := TranslateSchema(sqlmanager_shared.SqliteDriver, sqlmanager_shared.PostgresDriver, tables, columns, constraints)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`CREATE TABLE IF NOT EXISTS "main"."notes" ("id" bigint NOT NULL, "title" varchar(80) NOT NULL DEFAULT 'untitled', "body" bytea NULL, "score" double precision NOT NULL DEFAULT 0, "pinned" boolean NOT NULL DEFAULT false, "archived" numeric NULL, PRIMARY KEY ("id"));`,
+	}, actual.InitStatements[1].Statements)
+	require.Len(t, actual.LossyConversions, 1)
+	require.Equal(t, "archived", actual.LossyConversions[0].Column)
+}
+
+func Test_TranslateSchema_UnsupportedDriver(t *testing.T) {
+	_, err := TranslateSchema(sqlmanager_shared.PostgresDriver, "oracle", nil, nil, nil)
+	require.Error(t, err)
+}
+
+func Test_parseColumnDefault(t *testing.T) {
+	tests := []struct {
+		driver   string
+		input    string
+		ct       *columnType
+		expected *columnDefault
+		ok       bool
+	}{
+		{sqlmanager_shared.PostgresDriver, "'it''s'::text", &columnType{kind: kindText}, &columnDefault{kind: defaultString, value: "it's"}, true},
+		{sqlmanager_shared.PostgresDriver, "'-1'::integer", &columnType{kind: kindInt}, &columnDefault{kind: defaultNumber, value: "-1"}, true},
+		{sqlmanager_shared.PostgresDriver, "CURRENT_TIMESTAMP", &columnType{kind: kindTimestamp}, &columnDefault{kind: defaultCurrentTimestamp}, true},
+		{sqlmanager_shared.PostgresDriver, "lower('A'::text)", &columnType{kind: kindText}, nil, false},
+		{sqlmanager_shared.MssqlDriver, "((0))", &columnType{kind: kindBool}, &columnDefault{kind: defaultBool, value: "false"}, true},
+		{sqlmanager_shared.MssqlDriver, "(N'foo')", &columnType{kind: kindVarchar}, &columnDefault{kind: defaultString, value: "foo"}, true},
+		{sqlmanager_shared.MssqlDriver, "(getdate())", &columnType{kind: kindTimestamp}, &columnDefault{kind: defaultCurrentTimestamp}, true},
+		{sqlmanager_shared.MysqlDriver, "123", &columnType{kind: kindVarchar}, &columnDefault{kind: defaultString, value: "123"}, true},
+		{sqlmanager_shared.MysqlDriver, "b'1'", &columnType{kind: kindBool}, &columnDefault{kind: defaultBool, value: "true"}, true},
+		{sqlmanager_shared.MysqlDriver, "uuid()", &columnType{kind: kindUuid}, &columnDefault{kind: defaultUuid}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.driver+"_"+tt.input, func(t *testing.T) {
+			actual, ok := parseColumnDefault(tt.driver, tt.input, tt.ct)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_buildConstraintName(t *testing.T) {
+	require.Equal(t, "fk_orders_user_id", buildConstraintName("fk", "orders", []string{"user_id"}))
+
+	long := buildConstraintName("fk", "a_very_long_table_name_that_goes_on", []string{"and_a_long_column_name", "another_column"})
+	require.Len(t, long, maxConstraintNameLength)
+	require.Equal(t, long, buildConstraintName("fk", "a_very_long_table_name_that_goes_on", []string{"and_a_long_column_name", "another_column"}))
+}
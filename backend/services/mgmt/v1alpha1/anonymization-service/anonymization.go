@@ -8,10 +8,13 @@ import (
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
 	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
 	jsonanonymizer "github.com/nucleuscloud/neosync/internal/json-anonymizer"
+	"github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/utils"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
@@ -50,6 +53,7 @@ func (s *Service) AnonymizeMany(
 		jsonanonymizer.WithDefaultTransformers(req.Msg.DefaultTransformers),
 		jsonanonymizer.WithHaltOnFailure(req.Msg.HaltOnFailure),
 		jsonanonymizer.WithConditionalAnonymizeConfig(s.cfg.IsPresidioEnabled, s.analyze, s.anonymize),
+		jsonanonymizer.WithDeterministicKey(s.getDeterministicKey(neosyncdb.UUIDString(*accountUuid))),
 	)
 	if err != nil {
 		return nil, err
@@ -130,6 +134,7 @@ func (s *Service) AnonymizeSingle(
 		jsonanonymizer.WithTransformerMappings(req.Msg.TransformerMappings),
 		jsonanonymizer.WithDefaultTransformers(req.Msg.DefaultTransformers),
 		jsonanonymizer.WithConditionalAnonymizeConfig(s.cfg.IsPresidioEnabled, s.analyze, s.anonymize),
+		jsonanonymizer.WithDeterministicKey(s.getDeterministicKey(neosyncdb.UUIDString(*accountUuid))),
 	)
 	if err != nil {
 		return nil, err
@@ -169,6 +174,64 @@ func (s *Service) AnonymizeSingle(
 	}), nil
 }
 
+func (s *Service) DecryptFpe(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.DecryptFpeRequest],
+) (*connect.Response[mgmtv1alpha1.DecryptFpeResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	if isWorkerApiKey(ctx) {
+		return nil, nucleuserrors.NewForbidden("worker api keys are not allowed to decrypt values")
+	}
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	accountId := neosyncdb.UUIDString(*accountUuid)
+
+	if s.cfg.TransformerSecret == "" {
+		return nil, nucleuserrors.NewBadRequest("format preserving encryption is not enabled, ensure the transformer secret has been configured")
+	}
+
+	userResp, err := s.useraccountService.GetUser(ctx, connect.NewRequest(&mgmtv1alpha1.GetUserRequest{}))
+	if err != nil {
+		return nil, err
+	}
+
+	transformer, err := transformers.NewFpeTransformer(s.getDeterministicKey(accountId), req.Msg.GetConfig())
+	if err != nil {
+		return nil, nucleuserrors.NewBadRequest(err.Error())
+	}
+
+	values := make([]string, 0, len(req.Msg.GetValues()))
+	for idx, value := range req.Msg.GetValues() {
+		decrypted, err := transformer.Decrypt(value)
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unable to decrypt value at index %d: %s", idx, err.Error()))
+		}
+		values = append(values, decrypted)
+	}
+
+	// decrypting reveals the original values so every request is recorded
+	logger.Info(
+		"decrypted format preserving encrypted values",
+		"accountId", accountId,
+		"userId", userResp.Msg.GetUserId(),
+		"valueCount", len(values),
+	)
+
+	return connect.NewResponse(&mgmtv1alpha1.DecryptFpeResponse{
+		Values: values,
+	}), nil
+}
+
+// Returns the account-scoped key for deterministic transformers, or an empty string if no transformer secret has been configured
+func (s *Service) getDeterministicKey(accountId string) string {
+	if s.cfg.TransformerSecret == "" {
+		return ""
+	}
+	return transformer_utils.DeriveAccountTransformerKey(s.cfg.TransformerSecret, accountId)
+}
+
 func getMetricLabels(ctx context.Context, requestName, accountId string) []attribute.KeyValue {
 	requestId := getTraceID(ctx)
 	if requestId == "" {
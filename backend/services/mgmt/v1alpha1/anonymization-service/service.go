@@ -17,6 +17,8 @@ type Service struct {
 type Config struct {
 	IsAuthEnabled     bool
 	IsPresidioEnabled bool
+	// Secret used to derive the account-scoped key for deterministic transformers
+	TransformerSecret string
 }
 
 func New(
@@ -2,11 +2,14 @@ package v1alpha1_apikeyservice
 
 import (
 	"context"
+	"fmt"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
 	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
@@ -82,6 +85,14 @@ func (s *Service) CreateAccountApiKey(
 		return nil, err
 	}
 
+	if err := auth_apikey.ValidateScopes(req.Msg.GetScopes()); err != nil {
+		return nil, err
+	}
+	jobIds, err := s.getAccountJobIds(ctx, *accountUuid, req.Msg.GetJobIds())
+	if err != nil {
+		return nil, err
+	}
+
 	clearKeyValue := apikey.NewV1AccountKey()
 	hashedKeyValue := pkg_utils.ToSha256(
 		clearKeyValue,
@@ -93,6 +104,8 @@ func (s *Service) CreateAccountApiKey(
 		AccountUuid:       *accountUuid,
 		CreatedByUserUuid: *userUuid,
 		ExpiresAt:         expiresAt,
+		Scopes:            req.Msg.GetScopes(),
+		JobIds:            jobIds,
 	})
 	if err != nil {
 		return nil, err
@@ -175,3 +188,22 @@ func (s *Service) DeleteAccountApiKey(
 
 	return connect.NewResponse(&mgmtv1alpha1.DeleteAccountApiKeyResponse{}), nil
 }
+
+// Returns the parsed job ids, verifying that each job belongs to the account
+func (s *Service) getAccountJobIds(ctx context.Context, accountId pgtype.UUID, jobIds []string) ([]pgtype.UUID, error) {
+	jobUuids := make([]pgtype.UUID, 0, len(jobIds))
+	for _, jobId := range jobIds {
+		jobUuid, err := neosyncdb.ToUuid(jobId)
+		if err != nil {
+			return nil, err
+		}
+		job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+		if err != nil && !neosyncdb.IsNoRows(err) {
+			return nil, err
+		} else if (err != nil && neosyncdb.IsNoRows(err)) || job.AccountID != accountId {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("job %s does not exist in the account", jobId))
+		}
+		jobUuids = append(jobUuids, jobUuid)
+	}
+	return jobUuids, nil
+}
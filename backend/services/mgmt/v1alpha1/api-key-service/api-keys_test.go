@@ -312,6 +312,51 @@ func Test_Service_CreateAccountApiKey_ForbiddenAccount(t *testing.T) {
 	assert.Nil(t, resp)
 }
 
+func Test_Service_CreateAccountApiKey_InvalidScope(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+
+	mockIsUserInAccount(mockUserAccountService, true)
+	mockUserAccountCalls(mockUserAccountService, true, uuid.NewString())
+
+	resp, err := svc.CreateAccountApiKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateAccountApiKeyRequest{
+		AccountId: uuid.NewString(),
+		Name:      "foo",
+		ExpiresAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Scopes:    []string{"jobs:trigger", "jobs:everything"},
+	}))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
+func Test_Service_CreateAccountApiKey_JobInOtherAccount(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+
+	mockIsUserInAccount(mockUserAccountService, true)
+	mockUserAccountCalls(mockUserAccountService, true, uuid.NewString())
+	mockQuerier.On("GetJobById", mock.Anything, mock.Anything, mock.Anything).
+		Return(db_queries.NeosyncApiJob{ID: newPgUuid(t), AccountID: newPgUuid(t)}, nil)
+
+	resp, err := svc.CreateAccountApiKey(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateAccountApiKeyRequest{
+		AccountId: uuid.NewString(),
+		Name:      "foo",
+		ExpiresAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+		Scopes:    []string{"jobs:trigger"},
+		JobIds:    []string{uuid.NewString()},
+	}))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
 func Test_Service_DeleteAccountApiKey_Existing(t *testing.T) {
 	mockDbtx := neosyncdb.NewMockDBTX(t)
 	mockQuerier := db_queries.NewMockQuerier(t)
@@ -0,0 +1,75 @@
+package v1alpha1_auditservice
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+)
+
+const (
+	defaultAuditEventLimit = 100
+)
+
+func (s *Service) ListAuditEvents(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ListAuditEventsRequest],
+) (*connect.Response[mgmtv1alpha1.ListAuditEventsResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	params := db_queries.GetAuditEventsParams{
+		AccountId:  *accountUuid,
+		MaxResults: defaultAuditEventLimit,
+	}
+	if req.Msg.Limit != nil && req.Msg.GetLimit() > 0 {
+		params.MaxResults = int32(req.Msg.GetLimit()) //nolint:gosec
+	}
+	if req.Msg.ActorId != nil {
+		actorUuid, err := neosyncdb.ToUuid(req.Msg.GetActorId())
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest("actor id must be a valid uuid")
+		}
+		params.ActorId = actorUuid
+	}
+	if req.Msg.Procedure != nil {
+		params.Procedure = pgtype.Text{String: req.Msg.GetProcedure(), Valid: true}
+	}
+	if req.Msg.ResourceId != nil {
+		params.ResourceId = pgtype.Text{String: req.Msg.GetResourceId(), Valid: true}
+	}
+	if req.Msg.StartTime != nil {
+		params.StartTime, err = neosyncdb.ToTimestamp(req.Msg.GetStartTime().AsTime())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.Msg.EndTime != nil {
+		params.EndTime, err = neosyncdb.ToTimestamp(req.Msg.GetEndTime().AsTime())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	events, err := s.db.Q.GetAuditEvents(ctx, s.db.Db, params)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.AuditEvent, len(events))
+	for idx := range events {
+		event := events[idx]
+		dtos[idx] = dtomaps.ToAuditEventDto(&event)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.ListAuditEventsResponse{
+		Events: dtos,
+	}), nil
+}
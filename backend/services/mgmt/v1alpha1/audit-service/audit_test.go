@@ -0,0 +1,99 @@
+package v1alpha1_auditservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_Service_ListAuditEvents(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+
+	accountId := uuid.NewString()
+	actorId := uuid.NewString()
+	startTime := time.Now().Add(-time.Hour).UTC()
+	rawData := []db_queries.NeosyncApiAuditEvent{
+		{
+			ID:          newPgUuid(t),
+			AccountID:   newPgUuid(t),
+			ActorType:   int16(neosyncdb.AuditActorType_User),
+			ActorUserID: newPgUuid(t),
+			Procedure:   mgmtv1alpha1connect.JobServiceCreateJobRunProcedure,
+			ResourceIds: []string{uuid.NewString()},
+			Request:     []byte(`{"jobId":"123"}`),
+			StatusCode:  "ok",
+			CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+		},
+	}
+	mockIsUserInAccount(mockUserAccountService, true)
+	mockQuerier.On("GetAuditEvents", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.GetAuditEventsParams) bool {
+		return neosyncdb.UUIDString(params.AccountId) == accountId &&
+			neosyncdb.UUIDString(params.ActorId) == actorId &&
+			params.Procedure.Valid && params.Procedure.String == mgmtv1alpha1connect.JobServiceCreateJobRunProcedure &&
+			!params.ResourceId.Valid &&
+			params.StartTime.Valid && params.StartTime.Time.Equal(startTime) &&
+			!params.EndTime.Valid &&
+			params.MaxResults == 100
+	})).Return(rawData, nil)
+
+	procedure := mgmtv1alpha1connect.JobServiceCreateJobRunProcedure
+	resp, err := svc.ListAuditEvents(context.Background(), connect.NewRequest(&mgmtv1alpha1.ListAuditEventsRequest{
+		AccountId: accountId,
+		ActorId:   &actorId,
+		Procedure: &procedure,
+		StartTime: timestamppb.New(startTime),
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.GetEvents(), 1)
+	event := resp.Msg.GetEvents()[0]
+	assert.Equal(t, neosyncdb.UUIDString(rawData[0].ID), event.GetId())
+	assert.Equal(t, mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_USER, event.GetActorType())
+	assert.Equal(t, neosyncdb.UUIDString(rawData[0].ActorUserID), event.GetActorUserId())
+	assert.Nil(t, event.ActorApiKeyId)
+	assert.Equal(t, `{"jobId":"123"}`, event.GetRequestJson())
+}
+
+func Test_Service_ListAuditEvents_ForbiddenAccount(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService)
+
+	mockIsUserInAccount(mockUserAccountService, false)
+
+	resp, err := svc.ListAuditEvents(context.Background(), connect.NewRequest(&mgmtv1alpha1.ListAuditEventsRequest{
+		AccountId: uuid.NewString(),
+	}))
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func newPgUuid(t *testing.T) pgtype.UUID {
+	t.Helper()
+	newuuid := uuid.NewString()
+	val, err := neosyncdb.ToUuid(newuuid)
+	assert.NoError(t, err)
+	return val
+}
+
+func mockIsUserInAccount(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, isInAccount bool) {
+	userAccountServiceMock.On("IsUserInAccount", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+		Ok: isInAccount,
+	}), nil)
+}
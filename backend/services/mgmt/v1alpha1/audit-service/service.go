@@ -0,0 +1,22 @@
+package v1alpha1_auditservice
+
+import (
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+)
+
+type Service struct {
+	cfg                *Config
+	db                 *neosyncdb.NeosyncDb
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient
+}
+
+type Config struct{}
+
+func New(
+	cfg *Config,
+	db *neosyncdb.NeosyncDb,
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient,
+) *Service {
+	return &Service{cfg: cfg, db: db, useraccountService: useraccountService}
+}
@@ -1,13 +1,13 @@
 package v1alpha1_connectiondataservice
 
 import (
-	"bufio"
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"os"
 	"sort"
 	"strings"
 	"time"
@@ -20,14 +20,19 @@ import (
 	dynamotypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	bucketrecords "github.com/nucleuscloud/neosync/backend/internal/bucket-records"
 	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	neosync_gcp "github.com/nucleuscloud/neosync/backend/internal/gcp"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	localdirectory "github.com/nucleuscloud/neosync/backend/pkg/local-directory"
+	sqlmanager_mssql "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/mssql"
 	sqlmanager_mysql "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/mysql"
 	sqlmanager_postgres "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/postgres"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	sqlmanager_sqlite "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/sqlite"
 	neosync_dynamodb "github.com/nucleuscloud/neosync/internal/dynamodb"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 	querybuilder "github.com/nucleuscloud/neosync/worker/pkg/query-builder"
 	"go.mongodb.org/mongo-driver/bson"
 	"golang.org/x/sync/errgroup"
@@ -81,13 +86,17 @@ func (s *Service) GetConnectionDataStream(
 	connectionTimeout := uint32(5)
 
 	switch config := connection.ConnectionConfig.Config.(type) {
-	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
 		err := s.areSchemaAndTableValid(ctx, connection, req.Msg.Schema, req.Msg.Table)
 		if err != nil {
 			return err
 		}
+		driver := sqlmanager_shared.MysqlDriver
+		if connection.GetConnectionConfig().GetSqliteConfig() != nil {
+			driver = sqlmanager_shared.SqliteDriver
+		}
 
-		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.ConnectionConfig, &connectionTimeout, logger)
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.GetAccountId(), connection.ConnectionConfig, &connectionTimeout, logger)
 		if err != nil {
 			return err
 		}
@@ -99,7 +108,7 @@ func (s *Service) GetConnectionDataStream(
 
 		table := sqlmanager_shared.BuildTable(req.Msg.Schema, req.Msg.Table)
 		// used to get column names
-		query, err := querybuilder.BuildSelectLimitQuery("mysql", table, 1)
+		query, err := querybuilder.BuildSelectLimitQuery(driver, table, 1)
 		if err != nil {
 			return err
 		}
@@ -107,13 +116,14 @@ func (s *Service) GetConnectionDataStream(
 		if err != nil && !neosyncdb.IsNoRows(err) {
 			return err
 		}
+		defer r.Close()
 
 		columnNames, err := r.Columns()
 		if err != nil {
 			return err
 		}
 
-		selectQuery, err := querybuilder.BuildSelectQuery("mysql", table, columnNames, nil)
+		selectQuery, err := querybuilder.BuildSelectQuery(driver, table, columnNames, nil)
 		if err != nil {
 			return err
 		}
@@ -148,7 +158,7 @@ func (s *Service) GetConnectionDataStream(
 			return err
 		}
 
-		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.GetConnectionConfig(), &connectionTimeout, logger)
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.GetAccountId(), connection.GetConnectionConfig(), &connectionTimeout, logger)
 		if err != nil {
 			return err
 		}
@@ -277,57 +287,17 @@ func (s *Service) GetConnectionDataStream(
 					return err
 				}
 
-				gzr, err := gzip.NewReader(result.Body)
-				if err != nil {
-					result.Body.Close()
-					return fmt.Errorf("error creating gzip reader: %w", err)
-				}
-
-				scanner := bufio.NewScanner(gzr)
-				for scanner.Scan() {
-					line := scanner.Bytes()
-					var data map[string]any
-					err = json.Unmarshal(line, &data)
+				err = bucketrecords.StreamRecords(*item.Key, result.Body, func(record map[string]any) error {
+					rowMap, err := bucketrecords.ToByteRecord(record)
 					if err != nil {
-						result.Body.Close()
-						gzr.Close()
-						return err
-					}
-
-					rowMap := make(map[string][]byte)
-					for key, value := range data {
-						var byteValue []byte
-						if str, ok := value.(string); ok {
-							// try converting string directly to []byte
-							// prevents quoted strings
-							byteValue = []byte(str)
-						} else {
-							// if not a string use JSON encoding
-							byteValue, err = json.Marshal(value)
-							if err != nil {
-								result.Body.Close()
-								gzr.Close()
-								return err
-							}
-							if string(byteValue) == "null" {
-								byteValue = nil
-							}
-						}
-						rowMap[key] = byteValue
-					}
-					if err := stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: rowMap}); err != nil {
-						result.Body.Close()
-						gzr.Close()
 						return err
 					}
-				}
-				if err := scanner.Err(); err != nil {
-					result.Body.Close()
-					gzr.Close()
+					return stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: rowMap})
+				})
+				result.Body.Close()
+				if err != nil {
 					return err
 				}
-				result.Body.Close()
-				gzr.Close()
 			}
 			if *output.IsTruncated {
 				pageToken = output.NextContinuationToken
@@ -341,7 +311,7 @@ func (s *Service) GetConnectionDataStream(
 		if gcpStreamCfg == nil {
 			return nucleuserrors.NewBadRequest("must provide non-nil gcp cloud storage config in request")
 		}
-		gcpclient, err := s.gcpmanager.GetClient(ctx, logger)
+		gcpclient, err := s.gcpmanager.GetClient(ctx, config.GcpCloudstorageConfig, logger)
 		if err != nil {
 			return fmt.Errorf("unable to init gcp storage client: %w", err)
 		}
@@ -369,6 +339,58 @@ func (s *Service) GetConnectionDataStream(
 		if err != nil {
 			return fmt.Errorf("unable to finish sending record stream: %w", err)
 		}
+	case *mgmtv1alpha1.ConnectionConfig_LocalDirConfig:
+		localDirStreamCfg := req.Msg.GetStreamConfig().GetLocalDirConfig()
+		if localDirStreamCfg == nil {
+			return nucleuserrors.NewBadRequest("jobId or jobRunId required for local directory connections")
+		}
+		rootPath, err := localdirectory.GetRootPath(config.LocalDirConfig.GetPath(), s.cfg.LocalDirectoryAllowedDir, connection.GetAccountId())
+		if err != nil {
+			return err
+		}
+		if err := localdirectory.ValidatePathSegment("schema", req.Msg.Schema); err != nil {
+			return err
+		}
+		if err := localdirectory.ValidatePathSegment("table", req.Msg.Table); err != nil {
+			return err
+		}
+
+		var jobRunId string
+		switch id := localDirStreamCfg.Id.(type) {
+		case *mgmtv1alpha1.LocalDirectoryStreamConfig_JobRunId:
+			jobRunId = id.JobRunId
+		case *mgmtv1alpha1.LocalDirectoryStreamConfig_JobId:
+			runId, err := localdirectory.GetLatestJobRunId(rootPath, id.JobId)
+			if err != nil {
+				return err
+			}
+			jobRunId = runId
+		default:
+			return nucleuserrors.NewNotImplemented(fmt.Sprintf("unsupported local directory config id: %T", id))
+		}
+		logger = logger.With("runId", jobRunId)
+
+		files, err := localdirectory.ListTableDataFiles(rootPath, jobRunId, sqlmanager_shared.BuildTable(req.Msg.Schema, req.Msg.Table))
+		if err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			logger.Info("0 files found for table in local directory")
+		}
+		for _, file := range files {
+			err := withLocalFile(file, func(reader io.Reader) error {
+				return bucketrecords.StreamRecords(file, reader, func(record map[string]any) error {
+					rowMap, err := bucketrecords.ToByteRecord(record)
+					if err != nil {
+						return err
+					}
+					return stream.Send(&mgmtv1alpha1.GetConnectionDataStreamResponse{Row: rowMap})
+				})
+			})
+			if err != nil {
+				return err
+			}
+		}
 	case *mgmtv1alpha1.ConnectionConfig_DynamodbConfig:
 		dynamoclient, err := s.awsManager.NewDynamoDbClient(ctx, config.DynamodbConfig)
 		if err != nil {
@@ -490,7 +512,7 @@ func (s *Service) GetConnectionSchema(
 	}
 
 	switch config := connection.ConnectionConfig.Config.(type) {
-	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
 		connectionTimeout := 5
 		db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection, &connectionTimeout)
 		if err != nil {
@@ -643,38 +665,18 @@ func (s *Service) GetConnectionSchema(
 					continue
 				}
 
-				gzr, err := gzip.NewReader(result.Body)
+				firstRecord, err := bucketrecords.GetFirstRecord(*item.Key, result.Body)
+				result.Body.Close()
 				if err != nil {
-					result.Body.Close()
-					return nil, fmt.Errorf("error creating gzip reader: %w", err)
-				}
-
-				scanner := bufio.NewScanner(gzr)
-				if scanner.Scan() {
-					line := scanner.Bytes()
-					var data map[string]any
-					err = json.Unmarshal(line, &data)
-					if err != nil {
-						result.Body.Close()
-						gzr.Close()
-						return nil, err
-					}
-
-					for key := range data {
-						schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
-							Schema: schemaTableList[0],
-							Table:  schemaTableList[1],
-							Column: key,
-						})
-					}
-				}
-				if err := scanner.Err(); err != nil {
-					result.Body.Close()
-					gzr.Close()
 					return nil, err
 				}
-				result.Body.Close()
-				gzr.Close()
+				for key := range firstRecord {
+					schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+						Schema: schemaTableList[0],
+						Table:  schemaTableList[1],
+						Column: key,
+					})
+				}
 			}
 			if *output.IsTruncated {
 				pageToken = output.NextContinuationToken
@@ -691,7 +693,7 @@ func (s *Service) GetConnectionSchema(
 			return nil, nucleuserrors.NewBadRequest("must provide gcp cloud storage config")
 		}
 
-		gcpclient, err := s.gcpmanager.GetClient(ctx, logger)
+		gcpclient, err := s.gcpmanager.GetClient(ctx, config.GcpCloudstorageConfig, logger)
 		if err != nil {
 			return nil, fmt.Errorf("unable to init gcp storage client: %w", err)
 		}
@@ -721,6 +723,68 @@ func (s *Service) GetConnectionSchema(
 		return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
 			Schemas: schemas,
 		}), nil
+	case *mgmtv1alpha1.ConnectionConfig_LocalDirConfig:
+		localDirCfg := req.Msg.GetSchemaConfig().GetLocalDirConfig()
+		if localDirCfg == nil {
+			return nil, nucleuserrors.NewBadRequest("jobId or jobRunId required for local directory connections")
+		}
+		rootPath, err := localdirectory.GetRootPath(config.LocalDirConfig.GetPath(), s.cfg.LocalDirectoryAllowedDir, connection.GetAccountId())
+		if err != nil {
+			return nil, err
+		}
+
+		var jobRunId string
+		switch id := localDirCfg.Id.(type) {
+		case *mgmtv1alpha1.LocalDirectorySchemaConfig_JobRunId:
+			jobRunId = id.JobRunId
+		case *mgmtv1alpha1.LocalDirectorySchemaConfig_JobId:
+			runId, err := localdirectory.GetLatestJobRunId(rootPath, id.JobId)
+			if err != nil {
+				return nil, err
+			}
+			jobRunId = runId
+		default:
+			return nil, nucleuserrors.NewNotImplemented(fmt.Sprintf("unsupported local directory config id: %T", id))
+		}
+
+		tables, err := localdirectory.ListTables(rootPath, jobRunId)
+		if err != nil {
+			return nil, err
+		}
+		schemas := []*mgmtv1alpha1.DatabaseColumn{}
+		for _, table := range tables {
+			schemaTableList := strings.SplitN(table, ".", 2)
+			files, err := localdirectory.ListTableDataFiles(rootPath, jobRunId, table)
+			if err != nil {
+				return nil, err
+			}
+			if len(files) == 0 {
+				logger.Info(fmt.Sprintf("empty local directory data folder for table: %s, continuing...", table))
+				continue
+			}
+			var firstRecord map[string]any
+			err = withLocalFile(files[0], func(reader io.Reader) error {
+				record, err := bucketrecords.GetFirstRecord(files[0], reader)
+				if err != nil {
+					return err
+				}
+				firstRecord = record
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			for key := range firstRecord {
+				schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+					Schema: schemaTableList[0],
+					Table:  schemaTableList[1],
+					Column: key,
+				})
+			}
+		}
+		return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+			Schemas: schemas,
+		}), nil
 	case *mgmtv1alpha1.ConnectionConfig_DynamodbConfig:
 		dynclient, err := s.awsManager.NewDynamoDbClient(ctx, config.DynamodbConfig)
 		if err != nil {
@@ -740,6 +804,26 @@ func (s *Service) GetConnectionSchema(
 		return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
 			Schemas: schemas,
 		}), nil
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		client, err := s.kafkaconnector.NewFromConnectionConfig(ctx, connection.GetConnectionConfig())
+		if err != nil {
+			return nil, fmt.Errorf("unable to create kafka client from connection: %w", err)
+		}
+		defer client.Close()
+		topics, err := client.ListTopics(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve kafka topics: %w", err)
+		}
+		schemas := []*mgmtv1alpha1.DatabaseColumn{}
+		for _, topic := range topics {
+			schemas = append(schemas, &mgmtv1alpha1.DatabaseColumn{
+				Schema: "kafka",
+				Table:  topic,
+			})
+		}
+		return connect.NewResponse(&mgmtv1alpha1.GetConnectionSchemaResponse{
+			Schemas: schemas,
+		}), nil
 	default:
 		return nil, nucleuserrors.NewNotImplemented(fmt.Sprintf("this connection config is not currently supported: %T", config))
 	}
@@ -948,6 +1032,28 @@ func (s *Service) GetConnectionInitStatements(
 			return nil, nucleuserrors.NewNotImplemented("postgres truncate unsupported. table foreig keys required to build truncate statement.")
 		}
 
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		if req.Msg.GetOptions().GetTruncateBeforeInsert() {
+			for k, v := range schemaTableMap {
+				stmt, err := sqlmanager_mssql.BuildMssqlDeleteStatement(v.Schema, v.Table)
+				if err != nil {
+					return nil, err
+				}
+				truncateStmtsMap[k] = stmt
+			}
+		}
+
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		if req.Msg.GetOptions().GetTruncateBeforeInsert() {
+			for k, v := range schemaTableMap {
+				stmt, err := sqlmanager_sqlite.BuildSqliteTruncateStatement(v.Table)
+				if err != nil {
+					return nil, err
+				}
+				truncateStmtsMap[k] = stmt
+			}
+		}
+
 	default:
 		return nil, errors.New("unsupported connection config")
 	}
@@ -1005,6 +1111,18 @@ func (s *Service) getConnectionSchema(ctx context.Context, connection *mgmtv1alp
 				GcpCloudstorageConfig: cfg,
 			},
 		}
+	case *mgmtv1alpha1.ConnectionConfig_LocalDirConfig:
+		var cfg *mgmtv1alpha1.LocalDirectorySchemaConfig
+		if opts.JobRunId != nil && *opts.JobRunId != "" {
+			cfg = &mgmtv1alpha1.LocalDirectorySchemaConfig{Id: &mgmtv1alpha1.LocalDirectorySchemaConfig_JobRunId{JobRunId: *opts.JobRunId}}
+		} else if opts.JobId != nil && *opts.JobId != "" {
+			cfg = &mgmtv1alpha1.LocalDirectorySchemaConfig{Id: &mgmtv1alpha1.LocalDirectorySchemaConfig_JobId{JobId: *opts.JobId}}
+		}
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_LocalDirConfig{
+				LocalDirConfig: cfg,
+			},
+		}
 	case *mgmtv1alpha1.ConnectionConfig_MongoConfig:
 		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
 			Config: &mgmtv1alpha1.ConnectionSchemaConfig_MongoConfig{
@@ -1015,12 +1133,19 @@ func (s *Service) getConnectionSchema(ctx context.Context, connection *mgmtv1alp
 		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
 			Config: &mgmtv1alpha1.ConnectionSchemaConfig_DynamodbConfig{},
 		}
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
 	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
 		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
 			Config: &mgmtv1alpha1.ConnectionSchemaConfig_MssqlConfig{
 				MssqlConfig: &mgmtv1alpha1.MssqlSchemaConfig{},
 			},
 		}
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		schemaReq.SchemaConfig = &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_SqliteConfig{
+				SqliteConfig: &mgmtv1alpha1.SqliteSchemaConfig{},
+			},
+		}
 	default:
 		return nil, nucleuserrors.NewNotImplemented("this connection config is not currently supported")
 	}
@@ -1035,7 +1160,7 @@ func (s *Service) getConnectionTableSchema(ctx context.Context, connection *mgmt
 	conntimeout := uint32(5)
 	switch connection.GetConnectionConfig().Config.(type) {
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
-		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.GetConnectionConfig(), &conntimeout, logger)
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.GetAccountId(), connection.GetConnectionConfig(), &conntimeout, logger)
 		if err != nil {
 			return nil, err
 		}
@@ -1061,7 +1186,7 @@ func (s *Service) getConnectionTableSchema(ctx context.Context, connection *mgmt
 		}
 		return schemas, nil
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
-		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.GetConnectionConfig(), &conntimeout, logger)
+		conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.GetAccountId(), connection.GetConnectionConfig(), &conntimeout, logger)
 		if err != nil {
 			return nil, err
 		}
@@ -1306,10 +1431,14 @@ func (s *Service) GetAiGeneratedData(
 		columns = append(columns, fmt.Sprintf("%s is %s", dbcol.Column, dbcol.DataType))
 	}
 
-	openaiconfig := aiconnection.GetConnectionConfig().GetOpenaiConfig()
-	if openaiconfig == nil {
+	if aiconnection.GetConnectionConfig().GetOpenaiConfig() == nil {
 		return nil, nucleuserrors.NewBadRequest("connection must be a valid openai connection")
 	}
+	aiconfig, err := secrets.ResolveConnectionConfig(ctx, s.secretResolver, aiconnection.GetConnectionConfig())
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve openai connection secrets: %w", err)
+	}
+	openaiconfig := aiconfig.GetOpenaiConfig()
 
 	client, err := azopenai.NewClientForOpenAI(openaiconfig.GetApiUrl(), azcore.NewKeyCredential(openaiconfig.GetApiKey()), &azopenai.ClientOptions{})
 	if err != nil {
@@ -1400,7 +1529,7 @@ func (s *Service) GetConnectionTableConstraints(
 	}
 
 	switch connection.Msg.GetConnection().GetConnectionConfig().GetConfig().(type) {
-	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
 		connectionTimeout := 5
 		db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection.Msg.GetConnection(), &connectionTimeout)
 		if err != nil {
@@ -1473,7 +1602,7 @@ func (s *Service) GetTableRowCount(
 	}
 
 	switch connection.Msg.GetConnection().GetConnectionConfig().Config.(type) {
-	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
 		connectionTimeout := 5
 		db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection.Msg.GetConnection(), &connectionTimeout)
 		if err != nil {
@@ -1493,3 +1622,13 @@ func (s *Service) GetTableRowCount(
 		return nil, fmt.Errorf("unsupported connection type when retrieving table row count %T", connection.Msg.GetConnection().GetConnectionConfig().Config)
 	}
 }
+
+// opens a file on the local file system and hands its contents to the provided callback
+func withLocalFile(path string, fn func(reader io.Reader) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open local file: %w", err)
+	}
+	defer file.Close()
+	return fn(file)
+}
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -25,6 +27,7 @@ import (
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	neosync_gcp "github.com/nucleuscloud/neosync/backend/internal/gcp"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	"github.com/nucleuscloud/neosync/backend/pkg/kafkaconnect"
 	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
@@ -49,6 +52,7 @@ const (
 	MysqlMock    ConnTypeMock = "mysql"
 	PostgresMock ConnTypeMock = "postgres"
 	AwsS3Mock    ConnTypeMock = "awsS3"
+	LocalDirMock ConnTypeMock = "localDir"
 )
 
 // GetConnectionSchema
@@ -115,6 +119,87 @@ func Test_GetConnectionSchema_AwsS3(t *testing.T) {
 	require.ElementsMatch(t, expected, resp.Msg.Schemas)
 }
 
+func Test_GetConnectionSchema_LocalDirectory(t *testing.T) {
+	m := createServiceMock(t)
+
+	allowedDir := t.TempDir()
+	root := filepath.Join(allowedDir, mockAccountId)
+	mockJobId := "7c54e1ce-3924-477c-bfa8-ab8bd36cfee2"
+	mockJobRunId := "7c54e1ce-3924-477c-bfa8-ab8bd36cfee2-2023-12-21T22:02:35Z"
+	dataPath := filepath.Join(root, "workflows", mockJobRunId, "activities", "public.regions", "data")
+	require.NoError(t, os.MkdirAll(dataPath, 0o755))
+	data, err := gzipData([]byte(`{"region_id":1,"region_name":"Europe"}`))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dataPath, "1.jsonl.gz"), data, 0o600))
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, LocalDirMock)
+	connection.ConnectionConfig.GetLocalDirConfig().Path = root
+	m.Service.cfg.LocalDirectoryAllowedDir = allowedDir
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+
+	resp, err := m.Service.GetConnectionSchema(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]{
+		Msg: &mgmtv1alpha1.GetConnectionSchemaRequest{
+			ConnectionId: mockConnectionId,
+			SchemaConfig: &mgmtv1alpha1.ConnectionSchemaConfig{
+				Config: &mgmtv1alpha1.ConnectionSchemaConfig_LocalDirConfig{
+					LocalDirConfig: &mgmtv1alpha1.LocalDirectorySchemaConfig{
+						Id: &mgmtv1alpha1.LocalDirectorySchemaConfig_JobId{JobId: mockJobId},
+					},
+				},
+			},
+		},
+	})
+
+	expected := []*mgmtv1alpha1.DatabaseColumn{
+		{Schema: "public", Table: "regions", Column: "region_id"},
+		{Schema: "public", Table: "regions", Column: "region_name"},
+	}
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	require.ElementsMatch(t, expected, resp.Msg.Schemas)
+}
+
+func Test_GetConnectionSchema_LocalDirectory_RejectsPathsOutsideAllowedDir(t *testing.T) {
+	allowedDir := t.TempDir()
+	root := filepath.Join(allowedDir, mockAccountId)
+	getSchema := func(t *testing.T, path, allowedDir, jobRunId string) error {
+		m := createServiceMock(t)
+		m.Service.cfg.LocalDirectoryAllowedDir = allowedDir
+		connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, LocalDirMock)
+		connection.ConnectionConfig.GetLocalDirConfig().Path = path
+		mockIsUserInAccount(m.UserAccountServiceMock, true)
+		m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: connection,
+		}), nil)
+
+		_, err := m.Service.GetConnectionSchema(context.Background(), &connect.Request[mgmtv1alpha1.GetConnectionSchemaRequest]{
+			Msg: &mgmtv1alpha1.GetConnectionSchemaRequest{
+				ConnectionId: mockConnectionId,
+				SchemaConfig: &mgmtv1alpha1.ConnectionSchemaConfig{
+					Config: &mgmtv1alpha1.ConnectionSchemaConfig_LocalDirConfig{
+						LocalDirConfig: &mgmtv1alpha1.LocalDirectorySchemaConfig{
+							Id: &mgmtv1alpha1.LocalDirectorySchemaConfig_JobRunId{JobRunId: jobRunId},
+						},
+					},
+				},
+			},
+		})
+		return err
+	}
+
+	require.Error(t, getSchema(t, root, "", "run-1"), "local directories are disabled without an allowed directory")
+	require.Error(t, getSchema(t, "/etc", allowedDir, "run-1"))
+	require.Error(t, getSchema(t, filepath.Join(root, ".."), allowedDir, "run-1"))
+	require.Error(t, getSchema(t, filepath.Join(allowedDir, "7d6a7f4e-1a6c-4d7e-9f0e-3c1b2a4d5e6f"), allowedDir, "run-1"), "directories of other accounts are rejected")
+	require.Error(t, getSchema(t, root, allowedDir, "../../.."))
+	require.Error(t, getSchema(t, root, allowedDir, "run-1/../.."))
+	require.NoError(t, getSchema(t, root, allowedDir, "run-1"))
+}
+
 func Test_GetConnectionSchema_Postgres(t *testing.T) {
 	m := createServiceMock(t)
 
@@ -633,6 +718,7 @@ type serviceMocks struct {
 	SqlConnectorMock       *sqlconnect.MockSqlConnector
 	AwsManagerMock         *awsmanager.MockNeosyncAwsManagerClient
 	MongoConnectorMock     *mongoconnect.MockInterface
+	KafkaConnectorMock     *kafkaconnect.MockInterface
 	SqlManagerMock         *sqlmanager.MockSqlManagerClient
 	DbMock                 *sqlmanager.MockSqlDatabase
 	GcpManagerMock         *neosync_gcp.MockManagerInterface
@@ -649,6 +735,7 @@ func createServiceMock(t *testing.T) *serviceMocks {
 	mockSqlConnector := sqlconnect.NewMockSqlConnector(t)
 	mockAwsManager := awsmanager.NewMockNeosyncAwsManagerClient(t)
 	mockMongoConnector := mongoconnect.NewMockInterface(t)
+	mockKafkaConnector := kafkaconnect.NewMockInterface(t)
 	mockSqlDb := sqlmanager.NewMockSqlDatabase(t)
 	mockSqlManager := sqlmanager.NewMockSqlManagerClient(t)
 	mockGcpManager := neosync_gcp.NewMockManagerInterface(t)
@@ -658,7 +745,7 @@ func createServiceMock(t *testing.T) *serviceMocks {
 		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 	}
 
-	service := New(&Config{}, mockUserAccountService, mockConnectionService, mockJobService, mockAwsManager, mockSqlConnector, mockPgquerier, mockMysqlquerier, mockMongoConnector, mockSqlManager, mockGcpManager)
+	service := New(&Config{}, mockUserAccountService, mockConnectionService, mockJobService, mockAwsManager, mockSqlConnector, mockPgquerier, mockMysqlquerier, mockMongoConnector, mockKafkaConnector, mockSqlManager, mockGcpManager, nil, nil)
 
 	return &serviceMocks{
 		Service:                service,
@@ -675,6 +762,7 @@ func createServiceMock(t *testing.T) *serviceMocks {
 		SqlConnectorMock:       mockSqlConnector,
 		AwsManagerMock:         mockAwsManager,
 		MongoConnectorMock:     mockMongoConnector,
+		KafkaConnectorMock:     mockKafkaConnector,
 		SqlManagerMock:         mockSqlManager,
 		DbMock:                 mockSqlDb,
 		GcpManagerMock:         mockGcpManager,
@@ -743,6 +831,14 @@ func getConnectionMock(accountId, name string, id string, connType ConnTypeMock)
 				},
 			},
 		}
+	} else if connType == LocalDirMock {
+		connection.ConnectionConfig = &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_LocalDirConfig{
+				LocalDirConfig: &mgmtv1alpha1.LocalDirectoryConnectionConfig{
+					Path: "/tmp/neosync",
+				},
+			},
+		}
 	}
 
 	return connection
@@ -0,0 +1,340 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongomanager"
+	piidetector "github.com/nucleuscloud/neosync/backend/pkg/pii-detector"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	presidioapi "github.com/nucleuscloud/neosync/internal/ee/presidio"
+	"go.mongodb.org/mongo-driver/bson"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultPiiScanSampleSize int64 = 100
+	// The number of tables that are sampled at the same time
+	piiScanConcurrency = 5
+	// The maximum number of tables that are scanned by a single request
+	maxPiiScanTables = 100
+	// The maximum number of rows that are sampled across every table of a single request.
+	// The sample size of each table is lowered when the scan covers enough tables to exceed it.
+	maxPiiScanRows int64 = 100000
+	// The maximum duration of a single scan
+	piiScanTimeout = 5 * time.Minute
+)
+
+// Mongo databases that are internal to the server and never scanned
+var mongoSystemDatabases = []string{"admin", "config", "local"}
+
+func (s *Service) ScanConnectionForPii(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.ScanConnectionForPiiRequest],
+) (*connect.Response[mgmtv1alpha1.ScanConnectionForPiiResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("connectionId", req.Msg.GetConnectionId())
+	connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+		Id: req.Msg.GetConnectionId(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	connection := connResp.Msg.GetConnection()
+	_, err = s.verifyUserInAccount(ctx, connection.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	var analyzer presidioapi.AnalyzeInterface
+	if req.Msg.GetUsePresidio() {
+		if !s.cfg.IsPresidioEnabled || s.analyze == nil {
+			return nil, nucleuserrors.NewNotImplemented("the presidio analyzer is not enabled on this server")
+		}
+		analyzer = s.analyze
+	}
+
+	sampleSize := defaultPiiScanSampleSize
+	if req.Msg.SampleSize != nil {
+		sampleSize = req.Msg.GetSampleSize()
+	}
+	filter := newPiiScanTableFilter(req.Msg.GetSchemas(), req.Msg.GetTables())
+
+	ctx, cancel := context.WithTimeout(ctx, piiScanTimeout)
+	defer cancel()
+
+	var columns []*piidetector.Column
+	switch connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		columns, err = s.sampleSqlColumns(ctx, logger, connection, filter, sampleSize)
+		if err != nil {
+			return nil, err
+		}
+	case *mgmtv1alpha1.ConnectionConfig_MongoConfig:
+		columns, err = s.sampleMongoColumns(ctx, logger, connection, filter, sampleSize)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unsupported connection type when scanning for pii %T", connection.GetConnectionConfig().GetConfig()))
+	}
+
+	classifications := make([]*mgmtv1alpha1.ColumnPiiClassification, 0, len(columns))
+	for _, column := range columns {
+		classification, err := piidetector.ClassifyColumn(ctx, column, analyzer)
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, newPiiScanTimeoutError()
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to classify column %s.%s.%s: %w", column.Schema, column.Table, column.Name, err)
+		}
+		classifications = append(classifications, classification)
+	}
+	logger.Debug(fmt.Sprintf("scanned %d columns for pii", len(classifications)))
+
+	return connect.NewResponse(&mgmtv1alpha1.ScanConnectionForPiiResponse{
+		Columns: classifications,
+	}), nil
+}
+
+// Samples the rows of every table that passes the filter and returns the columns ordered by table and ordinal position
+func (s *Service) sampleSqlColumns(
+	ctx context.Context,
+	logger *slog.Logger,
+	connection *mgmtv1alpha1.Connection,
+	filter *piiScanTableFilter,
+	sampleSize int64,
+) ([]*piidetector.Column, error) {
+	connectionTimeout := 5
+	db, err := s.sqlmanager.NewSqlDb(ctx, logger, connection, &connectionTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Db.Close()
+
+	dbschema, err := db.Db.GetDatabaseSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tableColumns := map[string][]*sqlmanager_shared.DatabaseSchemaRow{}
+	for _, row := range dbschema {
+		if !filter.isIncluded(row.TableSchema, row.TableName) {
+			continue
+		}
+		key := sqlmanager_shared.BuildTable(row.TableSchema, row.TableName)
+		tableColumns[key] = append(tableColumns[key], row)
+	}
+	tables := make([]string, 0, len(tableColumns))
+	for table := range tableColumns {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	sampleSize, err = getPiiScanTableSampleSize(sampleSize, len(tables))
+	if err != nil {
+		return nil, err
+	}
+
+	tableSamples := make([][]*piidetector.Column, len(tables))
+	errgrp, errctx := errgroup.WithContext(ctx)
+	errgrp.SetLimit(piiScanConcurrency)
+	for idx, table := range tables {
+		idx := idx
+		cols := tableColumns[table]
+		sort.Slice(cols, func(i, j int) bool {
+			return cols[i].OrdinalPosition < cols[j].OrdinalPosition
+		})
+		errgrp.Go(func() error {
+			schema, tableName := cols[0].TableSchema, cols[0].TableName
+			rows, err := db.Db.GetTableRowSample(errctx, schema, tableName, sampleSize)
+			if err != nil {
+				return fmt.Errorf("unable to sample rows of %s.%s: %w", schema, tableName, err)
+			}
+			columns := make([]*piidetector.Column, 0, len(cols))
+			for _, col := range cols {
+				values := make([]any, 0, len(rows))
+				for _, row := range rows {
+					values = append(values, row[col.ColumnName])
+				}
+				columns = append(columns, &piidetector.Column{
+					Schema:   schema,
+					Table:    tableName,
+					Name:     col.ColumnName,
+					DataType: col.DataType,
+					Values:   values,
+				})
+			}
+			tableSamples[idx] = columns
+			return nil
+		})
+	}
+	if err := errgrp.Wait(); err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, newPiiScanTimeoutError()
+	} else if err != nil {
+		return nil, err
+	}
+
+	columns := []*piidetector.Column{}
+	for _, tableColumns := range tableSamples {
+		columns = append(columns, tableColumns...)
+	}
+	return columns, nil
+}
+
+// Samples the documents of every collection that passes the filter.
+// Nested fields are returned as separate columns in dot notation, ordered by their path.
+func (s *Service) sampleMongoColumns(
+	ctx context.Context,
+	logger *slog.Logger,
+	connection *mgmtv1alpha1.Connection,
+	filter *piiScanTableFilter,
+	sampleSize int64,
+) ([]*piidetector.Column, error) {
+	db, err := s.mongoconnector.NewFromConnectionConfig(connection.GetConnectionConfig(), logger)
+	if err != nil {
+		return nil, err
+	}
+	mongoclient, err := db.Open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close(ctx)
+
+	dbnames, err := mongoclient.ListDatabaseNames(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(dbnames)
+
+	collections := []*mgmtv1alpha1.DatabaseTable{}
+	for _, dbname := range dbnames {
+		if slices.Contains(mongoSystemDatabases, dbname) {
+			continue
+		}
+		collectionNames, err := mongoclient.Database(dbname).ListCollectionNames(ctx, bson.D{})
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(collectionNames)
+		for _, collectionName := range collectionNames {
+			if filter.isIncluded(dbname, collectionName) {
+				collections = append(collections, &mgmtv1alpha1.DatabaseTable{Schema: dbname, Table: collectionName})
+			}
+		}
+	}
+	sampleSize, err = getPiiScanTableSampleSize(sampleSize, len(collections))
+	if err != nil {
+		return nil, err
+	}
+
+	columns := []*piidetector.Column{}
+	for _, collection := range collections {
+		docs, err := mongomanager.GetCollectionSample(ctx, mongoclient.Database(collection.GetSchema()), collection.GetTable(), sampleSize)
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, newPiiScanTimeoutError()
+		} else if err != nil {
+			return nil, err
+		}
+		columns = append(columns, getDocumentColumns(collection.GetSchema(), collection.GetTable(), docs)...)
+	}
+	return columns, nil
+}
+
+// Bounds the work of a scan by rejecting scans of too many tables and lowering the sample size of each table
+// so that no more than maxPiiScanRows are sampled in total
+func getPiiScanTableSampleSize(sampleSize int64, tables int) (int64, error) {
+	if tables > maxPiiScanTables {
+		return 0, nucleuserrors.NewBadRequest(fmt.Sprintf(
+			"the scan includes %d tables, which is more than the %d tables that can be scanned at once. Limit the scan to fewer schemas or tables",
+			tables, maxPiiScanTables,
+		))
+	}
+	if tables == 0 {
+		return sampleSize, nil
+	}
+	return max(1, min(sampleSize, maxPiiScanRows/int64(tables))), nil
+}
+
+func newPiiScanTimeoutError() error {
+	return nucleuserrors.NewBadRequest(fmt.Sprintf("the scan did not finish within %s. Limit the scan to fewer schemas or tables", piiScanTimeout))
+}
+
+func getDocumentColumns(database, collection string, docs []map[string]any) []*piidetector.Column {
+	fieldValues := map[string][]any{}
+	for _, doc := range docs {
+		flattened := map[string]any{}
+		flattenDocument("", doc, flattened)
+		for field, value := range flattened {
+			fieldValues[field] = append(fieldValues[field], value)
+		}
+	}
+	fields := make([]string, 0, len(fieldValues))
+	for field := range fieldValues {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	columns := make([]*piidetector.Column, 0, len(fields))
+	for _, field := range fields {
+		columns = append(columns, &piidetector.Column{
+			Schema: database,
+			Table:  collection,
+			Name:   field,
+			Values: fieldValues[field],
+		})
+	}
+	return columns
+}
+
+// Flattens the nested documents into the output map with their dot notation path as the key
+func flattenDocument(prefix string, doc map[string]any, output map[string]any) {
+	for key, value := range doc {
+		path := key
+		if prefix != "" {
+			path = fmt.Sprintf("%s.%s", prefix, key)
+		}
+		switch v := value.(type) {
+		case map[string]any:
+			flattenDocument(path, v, output)
+		case bson.M:
+			flattenDocument(path, v, output)
+		default:
+			output[path] = value
+		}
+	}
+}
+
+// Decides which tables are scanned. Every table is included when no schemas or tables are provided.
+type piiScanTableFilter struct {
+	schemas map[string]struct{}
+	tables  map[string]struct{}
+}
+
+func newPiiScanTableFilter(schemas []string, tables []*mgmtv1alpha1.DatabaseTable) *piiScanTableFilter {
+	filter := &piiScanTableFilter{schemas: map[string]struct{}{}, tables: map[string]struct{}{}}
+	for _, schema := range schemas {
+		filter.schemas[schema] = struct{}{}
+	}
+	for _, table := range tables {
+		filter.tables[sqlmanager_shared.BuildTable(table.GetSchema(), table.GetTable())] = struct{}{}
+	}
+	return filter
+}
+
+func (f *piiScanTableFilter) isIncluded(schema, table string) bool {
+	if len(f.schemas) == 0 && len(f.tables) == 0 {
+		return true
+	}
+	if _, ok := f.schemas[schema]; ok {
+		return true
+	}
+	_, ok := f.tables[sqlmanager_shared.BuildTable(schema, table)]
+	return ok
+}
@@ -0,0 +1,144 @@
+package v1alpha1_connectiondataservice
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ScanConnectionForPii_Postgres(t *testing.T) {
+	m := createServiceMock(t)
+
+	mockColumns := []*sqlmanager_shared.DatabaseSchemaRow{
+		{TableSchema: "public", TableName: "users", ColumnName: "email", DataType: "text", OrdinalPosition: 2},
+		{TableSchema: "public", TableName: "users", ColumnName: "id", DataType: "integer", OrdinalPosition: 1},
+		{TableSchema: "public", TableName: "orders", ColumnName: "total", DataType: "integer", OrdinalPosition: 1},
+		{TableSchema: "audit", TableName: "logins", ColumnName: "ip", DataType: "text", OrdinalPosition: 1},
+	}
+
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
+	m.DbMock.On("Close").Return(nil)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.DbMock.On("GetDatabaseSchema", mock.Anything).Return(mockColumns, nil)
+	m.DbMock.On("GetTableRowSample", mock.Anything, "public", "users", int64(50)).Return([]map[string]any{
+		{"id": int64(1), "email": "jane@example.com"},
+		{"id": int64(2), "email": "john@example.com"},
+		{"id": int64(3), "email": nil},
+	}, nil)
+
+	sampleSize := int64(50)
+	resp, err := m.Service.ScanConnectionForPii(context.Background(), connect.NewRequest(&mgmtv1alpha1.ScanConnectionForPiiRequest{
+		ConnectionId: mockConnectionId,
+		Tables:       []*mgmtv1alpha1.DatabaseTable{{Schema: "public", Table: "users"}},
+		SampleSize:   &sampleSize,
+	}))
+	require.NoError(t, err)
+
+	columns := resp.Msg.GetColumns()
+	require.Len(t, columns, 2)
+	require.Equal(t, "id", columns[0].GetColumn(), "columns should be ordered by their position")
+	require.Equal(t, mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE, columns[0].GetCategory())
+	require.Nil(t, columns[0].GetSuggestedTransformer())
+
+	email := columns[1]
+	require.Equal(t, "public", email.GetSchema())
+	require.Equal(t, "users", email.GetTable())
+	require.Equal(t, "text", email.GetDataType())
+	require.Equal(t, mgmtv1alpha1.PiiCategory_PII_CATEGORY_EMAIL, email.GetCategory())
+	require.Equal(t, int64(2), email.GetSampledValues())
+	require.Equal(t, []mgmtv1alpha1.PiiDetector{mgmtv1alpha1.PiiDetector_PII_DETECTOR_COLUMN_NAME, mgmtv1alpha1.PiiDetector_PII_DETECTOR_VALUE_PATTERN}, email.GetDetectors())
+	require.NotNil(t, email.GetSuggestedTransformer().GetTransformEmailConfig())
+}
+
+func Test_ScanConnectionForPii_PresidioNotEnabled(t *testing.T) {
+	m := createServiceMock(t)
+
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+
+	_, err := m.Service.ScanConnectionForPii(context.Background(), connect.NewRequest(&mgmtv1alpha1.ScanConnectionForPiiRequest{
+		ConnectionId: mockConnectionId,
+		UsePresidio:  true,
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
+
+func Test_ScanConnectionForPii_TooManyTables(t *testing.T) {
+	m := createServiceMock(t)
+
+	mockColumns := []*sqlmanager_shared.DatabaseSchemaRow{}
+	for i := 0; i <= maxPiiScanTables; i++ {
+		mockColumns = append(mockColumns, &sqlmanager_shared.DatabaseSchemaRow{TableSchema: "public", TableName: fmt.Sprintf("table_%d", i), ColumnName: "id", DataType: "integer", OrdinalPosition: 1})
+	}
+
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: m.DbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
+	m.DbMock.On("Close").Return(nil)
+	connection := getConnectionMock(mockAccountId, mockConnectionName, mockConnectionId, PostgresMock)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	m.ConnectionServiceMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: connection,
+	}), nil)
+	m.DbMock.On("GetDatabaseSchema", mock.Anything).Return(mockColumns, nil)
+
+	_, err := m.Service.ScanConnectionForPii(context.Background(), connect.NewRequest(&mgmtv1alpha1.ScanConnectionForPiiRequest{
+		ConnectionId: mockConnectionId,
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	m.DbMock.AssertNotCalled(t, "GetTableRowSample", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_getPiiScanTableSampleSize(t *testing.T) {
+	actual, err := getPiiScanTableSampleSize(100, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), actual)
+
+	actual, err = getPiiScanTableSampleSize(10000, 50)
+	require.NoError(t, err)
+	require.Equal(t, maxPiiScanRows/50, actual, "the sample of each table should be lowered to stay within the row budget")
+
+	actual, err = getPiiScanTableSampleSize(100, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), actual)
+
+	_, err = getPiiScanTableSampleSize(100, maxPiiScanTables+1)
+	require.Error(t, err)
+}
+
+func Test_getDocumentColumns(t *testing.T) {
+	columns := getDocumentColumns("app", "users", []map[string]any{
+		{"_id": "1", "contact": map[string]any{"email": "jane@example.com"}},
+		{"_id": "2", "contact": map[string]any{"email": "john@example.com", "phone": "+1 415 555 2671"}},
+	})
+	require.Len(t, columns, 3)
+	require.Equal(t, "_id", columns[0].Name)
+	require.Equal(t, "contact.email", columns[1].Name)
+	require.Equal(t, []any{"jane@example.com", "john@example.com"}, columns[1].Values)
+	require.Equal(t, "contact.phone", columns[2].Name)
+	require.Equal(t, "app", columns[2].Schema)
+	require.Equal(t, "users", columns[2].Table)
+}
+
+func Test_piiScanTableFilter(t *testing.T) {
+	require.True(t, newPiiScanTableFilter(nil, nil).isIncluded("public", "users"))
+
+	filter := newPiiScanTableFilter([]string{"audit"}, []*mgmtv1alpha1.DatabaseTable{{Schema: "public", Table: "users"}})
+	require.True(t, filter.isIncluded("audit", "logins"))
+	require.True(t, filter.isIncluded("public", "users"))
+	require.False(t, filter.isIncluded("public", "orders"))
+}
@@ -5,10 +5,13 @@ import (
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	neosync_gcp "github.com/nucleuscloud/neosync/backend/internal/gcp"
+	"github.com/nucleuscloud/neosync/backend/pkg/kafkaconnect"
 	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	awsmanager "github.com/nucleuscloud/neosync/internal/aws"
+	presidioapi "github.com/nucleuscloud/neosync/internal/ee/presidio"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 )
 
 type Service struct {
@@ -25,10 +28,18 @@ type Service struct {
 	sqlmanager   sql_manager.SqlManagerClient
 
 	mongoconnector mongoconnect.Interface
+	kafkaconnector kafkaconnect.Interface
 	gcpmanager     neosync_gcp.ManagerInterface
+
+	secretResolver secrets.Resolver
+
+	analyze presidioapi.AnalyzeInterface
 }
 
 type Config struct {
+	IsPresidioEnabled bool
+	// Local directory connections must be located within this directory. They are disabled when empty.
+	LocalDirectoryAllowedDir string
 }
 
 func New(
@@ -43,8 +54,11 @@ func New(
 	pgquerier pg_queries.Querier,
 	mysqlquerier mysql_queries.Querier,
 	mongoconnector mongoconnect.Interface,
+	kafkaconnector kafkaconnect.Interface,
 	sqlmanager sql_manager.SqlManagerClient,
 	gcpmanager neosync_gcp.ManagerInterface,
+	secretResolver secrets.Resolver,
+	analyzeclient presidioapi.AnalyzeInterface,
 ) *Service {
 	return &Service{
 		cfg:                cfg,
@@ -57,6 +71,9 @@ func New(
 		mysqlquerier:       mysqlquerier,
 		sqlmanager:         sqlmanager,
 		mongoconnector:     mongoconnector,
+		kafkaconnector:     kafkaconnector,
 		gcpmanager:         gcpmanager,
+		secretResolver:     secretResolver,
+		analyze:            analyzeclient,
 	}
 }
@@ -28,15 +28,22 @@ func (s *Service) CheckConnectionConfig(
 	req *connect.Request[mgmtv1alpha1.CheckConnectionConfigRequest],
 ) (*connect.Response[mgmtv1alpha1.CheckConnectionConfigResponse], error) {
 	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	_, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyAccountSecretReferences(req.Msg.GetConnectionConfig(), req.Msg.GetAccountId()); err != nil {
+		return nil, err
+	}
 
 	switch req.Msg.GetConnectionConfig().GetConfig().(type) {
-	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
 		role, err := getDbRoleFromConnectionConfig(req.Msg.GetConnectionConfig())
 		if err != nil {
 			return nil, err
 		}
 		connTimeout := 5
-		db, err := s.sqlmanager.NewSqlDbFromConnectionConfig(ctx, logger, req.Msg.GetConnectionConfig(), &connTimeout)
+		db, err := s.sqlmanager.NewSqlDbFromConnectionConfig(ctx, logger, req.Msg.GetAccountId(), req.Msg.GetConnectionConfig(), &connTimeout)
 		if err != nil {
 			return nil, err
 		}
@@ -153,6 +160,46 @@ func (s *Service) CheckConnectionConfig(
 			})
 		}
 
+		return connect.NewResponse(&mgmtv1alpha1.CheckConnectionConfigResponse{
+			IsConnected:     true,
+			ConnectionError: nil,
+			Privileges:      privs,
+		}), nil
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		client, err := s.kafkaconnector.NewFromConnectionConfig(ctx, req.Msg.GetConnectionConfig())
+		if err != nil {
+			return nil, err
+		}
+		defer client.Close()
+
+		if err := client.Ping(ctx); err != nil {
+			errmsg := err.Error()
+			return connect.NewResponse(&mgmtv1alpha1.CheckConnectionConfigResponse{
+				IsConnected:     false,
+				ConnectionError: &errmsg,
+				Privileges:      []*mgmtv1alpha1.ConnectionRolePrivilege{},
+			}), nil
+		}
+		topics, err := client.ListTopics(ctx)
+		if err != nil {
+			errmsg := err.Error()
+			return connect.NewResponse(&mgmtv1alpha1.CheckConnectionConfigResponse{
+				IsConnected:     false,
+				ConnectionError: &errmsg,
+				Privileges:      []*mgmtv1alpha1.ConnectionRolePrivilege{},
+			}), nil
+		}
+
+		privs := []*mgmtv1alpha1.ConnectionRolePrivilege{}
+		for _, topic := range topics {
+			privs = append(privs, &mgmtv1alpha1.ConnectionRolePrivilege{
+				Schema:        "kafka",
+				Table:         topic,
+				Grantee:       "",
+				PrivilegeType: []string{},
+			})
+		}
+
 		return connect.NewResponse(&mgmtv1alpha1.CheckConnectionConfigResponse{
 			IsConnected:     true,
 			ConnectionError: nil,
@@ -176,6 +223,7 @@ func (s *Service) CheckConnectionConfigById(
 
 	resp, err := s.CheckConnectionConfig(ctx, connect.NewRequest(&mgmtv1alpha1.CheckConnectionConfigRequest{
 		ConnectionConfig: connResp.Msg.GetConnection().ConnectionConfig,
+		AccountId:        connResp.Msg.GetConnection().GetAccountId(),
 	}))
 	if err != nil {
 		return nil, err
@@ -200,6 +248,9 @@ func getDbRoleFromConnectionConfig(cconfig *mgmtv1alpha1.ConnectionConfig) (stri
 		return getMysqlUserFromConnectionConfig(typedconfig.MysqlConfig)
 	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
 		return getMssqlUserFromConnectionConfig(typedconfig.MssqlConfig)
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		// sqlite has no users, access is governed by the permissions of the database file
+		return "", nil
 	default:
 		return "", fmt.Errorf("invalid database connection config (%T) for retrieving db role: %w", typedconfig, errors.ErrUnsupported)
 	}
@@ -299,6 +350,10 @@ func (s *Service) GetConnections(
 	dtoConns := []*mgmtv1alpha1.Connection{}
 	for idx := range connections {
 		connection := connections[idx]
+		connection.ConnectionConfig, err = neosyncdb.DecryptConnectionConfig(ctx, s.encryptor, connection.AccountID, connection.ConnectionConfig)
+		if err != nil {
+			return nil, err
+		}
 		dto, err := dtomaps.ToConnectionDto(&connection)
 		if err != nil {
 			return nil, err
@@ -331,6 +386,10 @@ func (s *Service) GetConnection(
 	if err != nil {
 		return nil, err
 	}
+	connection.ConnectionConfig, err = neosyncdb.DecryptConnectionConfig(ctx, s.encryptor, connection.AccountID, connection.ConnectionConfig)
+	if err != nil {
+		return nil, err
+	}
 	dto, err := dtomaps.ToConnectionDto(&connection)
 	if err != nil {
 		return nil, err
@@ -353,22 +412,31 @@ func (s *Service) CreateConnection(
 	if err != nil {
 		return nil, err
 	}
+	if err := s.verifyAccountSecretReferences(req.Msg.GetConnectionConfig(), req.Msg.GetAccountId()); err != nil {
+		return nil, err
+	}
 
 	userUuid, err := s.getUserUuid(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	storedConfig, err := neosyncdb.EncryptConnectionConfig(ctx, s.encryptor, *accountUuid, cc)
+	if err != nil {
+		return nil, err
+	}
+
 	connection, err := s.db.Q.CreateConnection(ctx, s.db.Db, db_queries.CreateConnectionParams{
 		AccountID:        *accountUuid,
 		Name:             req.Msg.Name,
-		ConnectionConfig: cc,
+		ConnectionConfig: storedConfig,
 		CreatedByID:      *userUuid,
 		UpdatedByID:      *userUuid,
 	})
 	if err != nil {
 		return nil, err
 	}
+	connection.ConnectionConfig = cc
 	dto, err := dtomaps.ToConnectionDto(&connection)
 	if err != nil {
 		return nil, err
@@ -397,6 +465,9 @@ func (s *Service) UpdateConnection(
 	if err != nil {
 		return nil, err
 	}
+	if err := s.verifyAccountSecretReferences(req.Msg.GetConnectionConfig(), neosyncdb.UUIDString(connection.AccountID)); err != nil {
+		return nil, err
+	}
 
 	userUuid, err := s.getUserUuid(ctx)
 	if err != nil {
@@ -408,15 +479,21 @@ func (s *Service) UpdateConnection(
 		return nil, err
 	}
 
+	storedConfig, err := neosyncdb.EncryptConnectionConfig(ctx, s.encryptor, connection.AccountID, cc)
+	if err != nil {
+		return nil, err
+	}
+
 	connection, err = s.db.Q.UpdateConnection(ctx, s.db.Db, db_queries.UpdateConnectionParams{
 		ID:               connection.ID,
-		ConnectionConfig: cc,
+		ConnectionConfig: storedConfig,
 		UpdatedByID:      *userUuid,
 		Name:             req.Msg.Name,
 	})
 	if err != nil {
 		return nil, err
 	}
+	connection.ConnectionConfig = cc
 	dto, err := dtomaps.ToConnectionDto(&connection)
 	if err != nil {
 		return nil, err
@@ -465,7 +542,7 @@ func (s *Service) CheckSqlQuery(
 		return nil, err
 	}
 
-	conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.Msg.Connection.ConnectionConfig, nil, logger)
+	conn, err := s.sqlConnector.NewDbFromConnectionConfig(connection.Msg.Connection.GetAccountId(), connection.Msg.Connection.ConnectionConfig, nil, logger)
 	if err != nil {
 		return nil, err
 	}
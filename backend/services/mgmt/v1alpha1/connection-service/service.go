@@ -6,7 +6,9 @@ import (
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/envelope"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	"github.com/nucleuscloud/neosync/backend/pkg/kafkaconnect"
 	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
@@ -22,14 +24,22 @@ type Service struct {
 	sqlmanager         sql_manager.SqlManagerClient
 
 	mongoconnector mongoconnect.Interface
+	kafkaconnector kafkaconnect.Interface
 
 	pgquerier    pg_queries.Querier
 	mysqlquerier mysql_queries.Querier
 
 	awsManager awsmanager.NeosyncAwsManagerClient
+
+	// Encrypts connection configs at rest. Nil if connection encryption has not been configured.
+	encryptor *envelope.Encryptor
 }
 
 type Config struct {
+	// The prefix that env references must begin with. Env references must also include the account id after the prefix
+	SecretsEnvPrefix string
+	// The vault path that each account's secrets are nested under. Vault references outside of the account's path are rejected
+	VaultAccountPathPrefix string
 }
 
 func New(
@@ -41,7 +51,9 @@ func New(
 	mysqlquerier mysql_queries.Querier,
 	mssqlquerier mssql_queries.Querier,
 	mongoconnector mongoconnect.Interface,
+	kafkaconnector kafkaconnect.Interface,
 	awsManager awsmanager.NeosyncAwsManagerClient,
+	encryptor *envelope.Encryptor,
 ) *Service {
 	pgpoolmap := &sync.Map{}
 	mysqlpoolmap := &sync.Map{}
@@ -56,6 +68,8 @@ func New(
 		mysqlquerier:       mysqlquerier,
 		sqlmanager:         sqlmanager,
 		mongoconnector:     mongoconnector,
+		kafkaconnector:     kafkaconnector,
 		awsManager:         awsManager,
+		encryptor:          encryptor,
 	}
 }
@@ -10,6 +10,7 @@ import (
 	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 )
 
 func (s *Service) verifyUserInAccount(
@@ -50,6 +51,17 @@ func (s *Service) getUserUuid(
 	return &userUuid, nil
 }
 
+// Ensures that the connection config does not reference secrets that belong to another account
+func (s *Service) verifyAccountSecretReferences(cc *mgmtv1alpha1.ConnectionConfig, accountId string) error {
+	if err := secrets.VerifyAccountReferences(cc, accountId, &secrets.AccountReferenceConfig{
+		EnvPrefix:              s.cfg.SecretsEnvPrefix,
+		VaultAccountPathPrefix: s.cfg.VaultAccountPathPrefix,
+	}); err != nil {
+		return nucleuserrors.NewBadRequest(err.Error())
+	}
+	return nil
+}
+
 func isWorkerApiKey(ctx context.Context) bool {
 	data, err := auth_apikey.GetTokenDataFromCtx(ctx)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"connectrpc.com/connect"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,6 +53,7 @@ func (s *IntegrationTestSuite) Test_ConnectionService_CheckConnectionConfig() {
 			s.ctx,
 			connect.NewRequest(&mgmtv1alpha1.CheckConnectionConfigRequest{
 				ConnectionConfig: conn.GetConnectionConfig(),
+				AccountId:        accountId,
 			}),
 		)
 		requireNoErrResp(t, resp, err)
@@ -111,6 +113,32 @@ func (s *IntegrationTestSuite) Test_ConnectionService_GetConnection() {
 	require.NotNil(t, resp.Msg.GetConnection())
 }
 
+func (s *IntegrationTestSuite) Test_ConnectionService_ConnectionConfigEncryptedAtRest() {
+	t := s.T()
+	accountId := s.createPersonalAccount(s.ctx, s.unauthdClients.users)
+	pgconnstr, err := s.pgcontainer.ConnectionString(s.ctx, "sslmode=disable")
+	require.NoError(t, err)
+
+	conn := s.createPostgresConnection(s.unauthdClients.connections, accountId, "foo", pgconnstr)
+
+	connUuid, err := neosyncdb.ToUuid(conn.GetId())
+	require.NoError(t, err)
+	stored, err := s.neosyncQuerier.GetConnectionById(s.ctx, s.pgpool, connUuid)
+	require.NoError(t, err)
+	require.NotNil(t, stored.ConnectionConfig.Encrypted)
+	require.NotNil(t, stored.ConnectionConfig.PgConfig)
+	require.Nil(t, stored.ConnectionConfig.PgConfig.Url)
+
+	resp, err := s.unauthdClients.connections.GetConnection(
+		s.ctx,
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: conn.GetId(),
+		}),
+	)
+	requireNoErrResp(t, resp, err)
+	require.Equal(t, pgconnstr, resp.Msg.GetConnection().GetConnectionConfig().GetPgConfig().GetUrl())
+}
+
 func (s *IntegrationTestSuite) Test_ConnectionService_GetConnections() {
 	t := s.T()
 	accountId := s.createPersonalAccount(s.ctx, s.unauthdClients.users)
@@ -27,6 +27,7 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
 	"github.com/nucleuscloud/neosync/backend/internal/utils"
+	"github.com/nucleuscloud/neosync/backend/pkg/kafkaconnect"
 	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
@@ -186,8 +187,10 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		pg_queries.New(),
 		mysql_queries.New(),
 		mssql_queries.New(),
-		mongoconnect.NewConnector(),
-		awsmanager.New(),
+		mongoconnect.NewConnector(nil),
+		kafkaconnect.NewConnector(nil),
+		awsmanager.New(nil),
+		newTestConnectionEncryptor(),
 	)
 	unauthdJobsService := v1alpha1_jobservice.New(
 		&v1alpha1_jobservice.Config{},
@@ -1,6 +1,7 @@
 package integrationtests_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/envelope"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
 	"github.com/stretchr/testify/require"
 )
@@ -67,3 +69,11 @@ func getAccountIds(t testing.TB, accounts []*mgmtv1alpha1.UserAccount) []string
 	}
 	return output
 }
+
+func newTestConnectionEncryptor() *envelope.Encryptor {
+	provider, err := envelope.NewLocalKeyProvider("test", map[string][]byte{"test": bytes.Repeat([]byte{1}, 32)})
+	if err != nil {
+		panic(err)
+	}
+	return envelope.New(provider)
+}
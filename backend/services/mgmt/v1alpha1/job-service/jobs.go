@@ -20,6 +20,7 @@ import (
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
 	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
 
 	workflowpb "go.temporal.io/api/workflow/v1"
@@ -329,6 +330,9 @@ func (s *Service) CreateJob(
 		if err != nil {
 			return nil, err
 		}
+		if err := validateJobDestinationOptions(dest.GetOptions()); err != nil {
+			return nil, err
+		}
 		options := &pg_models.JobDestinationOptions{}
 		err = options.FromDto(dest.GetOptions())
 		if err != nil {
@@ -338,6 +342,12 @@ func (s *Service) CreateJob(
 		connectionIds = append(connectionIds, dest.ConnectionId)
 		connectionUuids = append(connectionUuids, destUuid)
 	}
+	if err := validateIncrementalSyncDestinations(req.Msg.GetSource().GetOptions(), getJobDestinationOptions(req.Msg.GetDestinations())); err != nil {
+		return nil, err
+	}
+	if err := validateGeneratedSourceDestinations(req.Msg.GetSource().GetOptions(), getJobDestinationOptions(req.Msg.GetDestinations())); err != nil {
+		return nil, err
+	}
 
 	logger.Info("verifying connections")
 	count, err := s.db.Q.AreConnectionsInAccount(ctx, s.db.Db, db_queries.AreConnectionsInAccountParams{
@@ -366,6 +376,12 @@ func (s *Service) CreateJob(
 		connectionIds = append(connectionIds, config.Dynamodb.GetConnectionId())
 	case *mgmtv1alpha1.JobSourceOptions_Mssql:
 		connectionIds = append(connectionIds, config.Mssql.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_Sqlite:
+		connectionIds = append(connectionIds, config.Sqlite.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_Kafka:
+		connectionIds = append(connectionIds, config.Kafka.GetConnectionId())
+	case *mgmtv1alpha1.JobSourceOptions_LocalDirectory:
+		connectionIds = append(connectionIds, config.LocalDirectory.GetConnectionId())
 	default:
 	}
 
@@ -667,6 +683,9 @@ func (s *Service) CreateJobDestinationConnections(
 		if err != nil {
 			return nil, err
 		}
+		if err := validateJobDestinationOptions(dest.GetOptions()); err != nil {
+			return nil, err
+		}
 		options := &pg_models.JobDestinationOptions{}
 		err = options.FromDto(dest.Options)
 		if err != nil {
@@ -676,6 +695,12 @@ func (s *Service) CreateJobDestinationConnections(
 		connectionIds = append(connectionIds, dest.ConnectionId)
 		connectionUuids = append(connectionUuids, destUuid)
 	}
+	if err := validateIncrementalSyncDestinations(job.Msg.GetJob().GetSource().GetOptions(), getJobDestinationOptions(req.Msg.GetDestinations())); err != nil {
+		return nil, err
+	}
+	if err := validateGeneratedSourceDestinations(job.Msg.GetJob().GetSource().GetOptions(), getJobDestinationOptions(req.Msg.GetDestinations())); err != nil {
+		return nil, err
+	}
 
 	if !verifyConnectionIdsUnique(connectionIds) {
 		return nil, nucleuserrors.NewBadRequest("connections ids are not unique")
@@ -902,6 +927,12 @@ func (s *Service) UpdateJobSourceConnection(
 		connectionIdToVerify = config.Dynamodb.GetConnectionId()
 	case *mgmtv1alpha1.JobSourceOptions_Mssql:
 		connectionIdToVerify = config.Mssql.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Sqlite:
+		connectionIdToVerify = config.Sqlite.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Kafka:
+		connectionIdToVerify = config.Kafka.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_LocalDirectory:
+		connectionIdToVerify = config.LocalDirectory.GetConnectionId()
 	default:
 		return nil, fmt.Errorf("unable to find connection id to verify for config: %T", config)
 	}
@@ -961,10 +992,40 @@ func (s *Service) UpdateJobSourceConnection(
 		if dbConf == nil && generateConf == nil && aigenerateConf == nil {
 			return nil, nucleuserrors.NewBadRequest("job source option config type and connection type mismatch")
 		}
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		dbConf := req.Msg.GetSource().GetOptions().GetSqlite()
+		generateConf := req.Msg.GetSource().GetOptions().GetGenerate()
+		aigenerateConf := req.Msg.GetSource().GetOptions().GetAiGenerate()
+		if dbConf == nil && generateConf == nil && aigenerateConf == nil {
+			return nil, nucleuserrors.NewBadRequest("job source option config type and connection type mismatch")
+		}
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		if req.Msg.GetSource().GetOptions().GetKafka() == nil {
+			return nil, nucleuserrors.NewBadRequest("job source option config type and connection type mismatch")
+		}
+	case *mgmtv1alpha1.ConnectionConfig_LocalDirConfig:
+		if req.Msg.GetSource().GetOptions().GetLocalDirectory() == nil {
+			return nil, nucleuserrors.NewBadRequest("job source option config type and connection type mismatch")
+		}
 	default:
 		return nil, nucleuserrors.NewNotImplemented(fmt.Sprintf("connection config is not currently supported: %T", cconfig))
 	}
 
+	destinationConnections, err := s.db.Q.GetJobConnectionDestinations(ctx, s.db.Db, job.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve job destinations: %w", err)
+	}
+	destinationOptions := make([]*mgmtv1alpha1.JobDestinationOptions, 0, len(destinationConnections))
+	for _, destination := range destinationConnections {
+		destinationOptions = append(destinationOptions, destination.Options.ToDto())
+	}
+	if err := validateIncrementalSyncDestinations(req.Msg.GetSource().GetOptions(), destinationOptions); err != nil {
+		return nil, err
+	}
+	if err := validateGeneratedSourceDestinations(req.Msg.GetSource().GetOptions(), destinationOptions); err != nil {
+		return nil, err
+	}
+
 	connectionOptions := &pg_models.JobSourceOptions{}
 	err = connectionOptions.FromDto(req.Msg.GetSource().GetOptions())
 	if err != nil {
@@ -1082,6 +1143,10 @@ func (s *Service) SetJobSourceSqlConnectionSubsets(
 			connectionId = &job.ConnectionOptions.DynamoDBOptions.ConnectionId
 		} else if job.ConnectionOptions.MssqlOptions != nil {
 			connectionId = &job.ConnectionOptions.MssqlOptions.ConnectionId
+		} else if job.ConnectionOptions.SqliteOptions != nil {
+			connectionId = &job.ConnectionOptions.SqliteOptions.ConnectionId
+		} else if job.ConnectionOptions.MongoDbOptions != nil {
+			connectionId = &job.ConnectionOptions.MongoDbOptions.ConnectionId
 		} else {
 			return nil, nucleuserrors.NewBadRequest("only jobs with a valid source connection id may be subset")
 		}
@@ -1099,7 +1164,7 @@ func (s *Service) SetJobSourceSqlConnectionSubsets(
 	connection := connectionResp.Msg.Connection
 
 	if connection.ConnectionConfig == nil ||
-		(connection.ConnectionConfig.GetPgConfig() == nil && connection.ConnectionConfig.GetMysqlConfig() == nil && connection.ConnectionConfig.GetDynamodbConfig() == nil && connection.ConnectionConfig.GetMssqlConfig() == nil) {
+		(connection.ConnectionConfig.GetPgConfig() == nil && connection.ConnectionConfig.GetMysqlConfig() == nil && connection.ConnectionConfig.GetDynamodbConfig() == nil && connection.ConnectionConfig.GetMssqlConfig() == nil && connection.ConnectionConfig.GetSqliteConfig() == nil && connection.ConnectionConfig.GetMongoConfig() == nil) {
 		return nil, nucleuserrors.NewBadRequest("may only update subsets for select source connections")
 	}
 
@@ -1164,6 +1229,15 @@ func (s *Service) UpdateJobDestinationConnection(
 	if err := s.verifyConnectionInAccount(ctx, req.Msg.ConnectionId, job.Msg.Job.AccountId); err != nil {
 		return nil, err
 	}
+	if err := validateJobDestinationOptions(req.Msg.GetOptions()); err != nil {
+		return nil, err
+	}
+	if err := validateIncrementalSyncDestinations(job.Msg.GetJob().GetSource().GetOptions(), []*mgmtv1alpha1.JobDestinationOptions{req.Msg.GetOptions()}); err != nil {
+		return nil, err
+	}
+	if err := validateGeneratedSourceDestinations(job.Msg.GetJob().GetSource().GetOptions(), []*mgmtv1alpha1.JobDestinationOptions{req.Msg.GetOptions()}); err != nil {
+		return nil, err
+	}
 	options := &pg_models.JobDestinationOptions{}
 	err = options.FromDto(req.Msg.Options)
 	if err != nil {
@@ -1356,6 +1430,112 @@ func verifyConnectionsInAccount(ctx context.Context, db *neosyncdb.NeosyncDb, co
 	return true, nil
 }
 
+func validateJobDestinationOptions(options *mgmtv1alpha1.JobDestinationOptions) error {
+	var pathTemplate *string
+	switch config := options.GetConfig().(type) {
+	case *mgmtv1alpha1.JobDestinationOptions_AwsS3Options:
+		pathTemplate = config.AwsS3Options.PathTemplate
+	case *mgmtv1alpha1.JobDestinationOptions_GcpCloudstorageOptions:
+		pathTemplate = config.GcpCloudstorageOptions.PathTemplate
+	case *mgmtv1alpha1.JobDestinationOptions_LocalDirectoryOptions:
+		pathTemplate = config.LocalDirectoryOptions.PathTemplate
+	case *mgmtv1alpha1.JobDestinationOptions_KafkaOptions:
+		if config.KafkaOptions.GetFormat() == mgmtv1alpha1.KafkaMessageFormat_KAFKA_MESSAGE_FORMAT_AVRO && config.KafkaOptions.GetAvroSchema() == "" {
+			return nucleuserrors.NewBadRequest("must provide an avro schema when producing avro messages to kafka")
+		}
+	}
+	if pathTemplate != nil && *pathTemplate != "" {
+		if err := genbenthosconfigs_activity.ValidateBucketPathTemplate(*pathTemplate); err != nil {
+			return nucleuserrors.NewBadRequest(fmt.Sprintf("invalid path template: %s", err.Error()))
+		}
+	}
+	return nil
+}
+
+// Incremental tables only load the rows past the previous watermark,
+// so truncating the destination beforehand would drop every row that was synced by previous runs.
+func validateIncrementalSyncDestinations(source *mgmtv1alpha1.JobSourceOptions, destinations []*mgmtv1alpha1.JobDestinationOptions) error {
+	if !hasWatermarkColumns(source) {
+		return nil
+	}
+	for _, destination := range destinations {
+		if truncatesBeforeInsert(destination) {
+			return nucleuserrors.NewBadRequest("incremental sync can not be combined with truncate before insert on a destination, as only rows past the watermark would be loaded after truncating")
+		}
+	}
+	return nil
+}
+
+func hasWatermarkColumns(source *mgmtv1alpha1.JobSourceOptions) bool {
+	switch config := source.GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres:
+		for _, schema := range config.Postgres.GetSchemas() {
+			for _, table := range schema.GetTables() {
+				if table.GetWatermarkColumn() != "" {
+					return true
+				}
+			}
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Mysql:
+		for _, schema := range config.Mysql.GetSchemas() {
+			for _, table := range schema.GetTables() {
+				if table.GetWatermarkColumn() != "" {
+					return true
+				}
+			}
+		}
+	case *mgmtv1alpha1.JobSourceOptions_Mssql:
+		for _, schema := range config.Mssql.GetSchemas() {
+			for _, table := range schema.GetTables() {
+				if table.GetWatermarkColumn() != "" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func truncatesBeforeInsert(options *mgmtv1alpha1.JobDestinationOptions) bool {
+	switch config := options.GetConfig().(type) {
+	case *mgmtv1alpha1.JobDestinationOptions_PostgresOptions:
+		return config.PostgresOptions.GetTruncateTable().GetTruncateBeforeInsert()
+	case *mgmtv1alpha1.JobDestinationOptions_MysqlOptions:
+		return config.MysqlOptions.GetTruncateTable().GetTruncateBeforeInsert()
+	case *mgmtv1alpha1.JobDestinationOptions_MssqlOptions:
+		return config.MssqlOptions.GetTruncateTable().GetTruncateBeforeInsert()
+	case *mgmtv1alpha1.JobDestinationOptions_SqliteOptions:
+		return config.SqliteOptions.GetTruncateTable().GetTruncateBeforeInsert()
+	case *mgmtv1alpha1.JobDestinationOptions_MongodbOptions:
+		return config.MongodbOptions.GetTruncateCollection().GetTruncateBeforeInsert()
+	default:
+		return false
+	}
+}
+
+// Generated rows have no primary keys to merge on, which SQL Server requires to skip conflicting rows.
+func validateGeneratedSourceDestinations(source *mgmtv1alpha1.JobSourceOptions, destinations []*mgmtv1alpha1.JobDestinationOptions) error {
+	switch source.GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Generate, *mgmtv1alpha1.JobSourceOptions_AiGenerate:
+	default:
+		return nil
+	}
+	for _, destination := range destinations {
+		if destination.GetMssqlOptions().GetOnConflict().GetDoNothing() && destination.GetMssqlOptions().GetOnConflict().GetUpdate() == nil {
+			return nucleuserrors.NewBadRequest("on conflict do nothing is not supported for sql server destinations of generate jobs")
+		}
+	}
+	return nil
+}
+
+func getJobDestinationOptions(destinations []*mgmtv1alpha1.CreateJobDestination) []*mgmtv1alpha1.JobDestinationOptions {
+	options := make([]*mgmtv1alpha1.JobDestinationOptions, 0, len(destinations))
+	for _, destination := range destinations {
+		options = append(options, destination.GetOptions())
+	}
+	return options
+}
+
 func verifyConnectionIdsUnique(connectionIds []string) bool {
 	occurrenceMap := make(map[string]bool)
 
@@ -1400,8 +1580,20 @@ func verifyConnectionsAreCompatible(ctx context.Context, db *neosyncdb.NeosyncDb
 
 	for i := range dests {
 		d := dests[i]
-		// AWS S3 and GCP CloudStorage are always a valid destination regardless of source connection type
-		if d.ConnectionConfig.AwsS3Config != nil || d.ConnectionConfig.GcpCloudStorageConfig != nil {
+		// AWS S3, GCP CloudStorage, and local directories are always a valid destination regardless of source connection type
+		if d.ConnectionConfig.AwsS3Config != nil || d.ConnectionConfig.GcpCloudStorageConfig != nil || d.ConnectionConfig.LocalDirectoryConfig != nil {
+			continue
+		}
+		if isSqlConnectionConfig(sourceConnection.ConnectionConfig) && isSqlConnectionConfig(d.ConnectionConfig) &&
+			isInitTableSchemaEnabled(destinations[i].Options) {
+			// the source table schemas are translated into the destination's dialect when the tables are initialized
+			continue
+		}
+		if sourceConnection.ConnectionConfig.SqliteConfig != nil || d.ConnectionConfig.SqliteConfig != nil {
+			// sqlite databases hold local copies of other sql databases, so they may be paired with any sql connection
+			if !isSqlConnectionConfig(sourceConnection.ConnectionConfig) || !isSqlConnectionConfig(d.ConnectionConfig) {
+				return false, nil
+			}
 			continue
 		}
 		if sourceConnection.ConnectionConfig.PgConfig != nil && d.ConnectionConfig.PgConfig == nil {
@@ -1423,11 +1615,29 @@ func verifyConnectionsAreCompatible(ctx context.Context, db *neosyncdb.NeosyncDb
 		if sourceConnection.ConnectionConfig.MssqlConfig != nil && d.ConnectionConfig.MssqlConfig == nil {
 			return false, nil
 		}
+		if (sourceConnection.ConnectionConfig.KafkaConfig != nil) != (d.ConnectionConfig.KafkaConfig != nil) {
+			// kafka topics can only be synced to other kafka topics, and kafka can only receive messages from a kafka source
+			return false, nil
+		}
 	}
 
 	return true, nil
 }
 
+func isSqlConnectionConfig(cc *pg_models.ConnectionConfig) bool {
+	return cc.PgConfig != nil || cc.MysqlConfig != nil || cc.MssqlConfig != nil || cc.SqliteConfig != nil
+}
+
+func isInitTableSchemaEnabled(opts *pg_models.JobDestinationOptions) bool {
+	if opts == nil {
+		return false
+	}
+	return (opts.PostgresOptions != nil && opts.PostgresOptions.InitTableSchema) ||
+		(opts.MysqlOptions != nil && opts.MysqlOptions.InitTableSchema) ||
+		(opts.MssqlOptions != nil && opts.MssqlOptions.InitTableSchema) ||
+		(opts.SqliteOptions != nil && opts.SqliteOptions.InitTableSchema)
+}
+
 func (s *Service) SetJobWorkflowOptions(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.SetJobWorkflowOptionsRequest],
@@ -1592,7 +1802,7 @@ func (s *Service) ValidateJobMappings(
 		return nil, errors.New("connection config for connection was nil")
 	}
 
-	if connConfig.GetAwsS3Config() != nil || connConfig.GetMongoConfig() != nil || connConfig.GetDynamodbConfig() != nil {
+	if connConfig.GetAwsS3Config() != nil || connConfig.GetMongoConfig() != nil || connConfig.GetDynamodbConfig() != nil || connConfig.GetKafkaConfig() != nil || connConfig.GetLocalDirConfig() != nil {
 		return connect.NewResponse(&mgmtv1alpha1.ValidateJobMappingsResponse{}), nil
 	}
 
@@ -1988,6 +2198,15 @@ func getJobSourceConnectionId(jobSource *mgmtv1alpha1.JobSource) (*string, error
 	case *mgmtv1alpha1.JobSourceOptions_Mssql:
 		connId := config.Mssql.GetConnectionId()
 		connectionIdToVerify = &connId
+	case *mgmtv1alpha1.JobSourceOptions_Sqlite:
+		connId := config.Sqlite.GetConnectionId()
+		connectionIdToVerify = &connId
+	case *mgmtv1alpha1.JobSourceOptions_Kafka:
+		connId := config.Kafka.GetConnectionId()
+		connectionIdToVerify = &connId
+	case *mgmtv1alpha1.JobSourceOptions_LocalDirectory:
+		connId := config.LocalDirectory.GetConnectionId()
+		connectionIdToVerify = &connId
 	default:
 		return nil, fmt.Errorf("unsupported source option config type: %T", config)
 	}
@@ -778,6 +778,131 @@ func Test_CreateJob_Schedule_Creation_Error_JobCleanup_Error(t *testing.T) {
 	require.Nil(t, resp)
 }
 
+// CreateJob
+func Test_CreateJob_CrossDialect_InitTableSchema(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockTx := pgxmock.NewMockTx(t)
+	mockHandle := new(temporalmocks.ScheduleHandle)
+	mockScheduleClient := new(temporalmocks.ScheduleClient)
+
+	accountUuid, _ := neosyncdb.ToUuid(mockAccountId)
+
+	job1 := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	srcConn := getConnectionMock(mockAccountId, "pg-source")
+	destConn := getConnectionMock(mockAccountId, "mysql-dest")
+	destConn.ConnectionConfig = &pg_models.ConnectionConfig{MysqlConfig: &pg_models.MysqlConnectionConfig{}}
+
+	destOptions := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{
+			MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{
+				TruncateTable:   &mgmtv1alpha1.MysqlTruncateTableConfig{},
+				OnConflict:      &mgmtv1alpha1.MysqlOnConflictConfig{},
+				InitTableSchema: true,
+			},
+		},
+	}
+	destModelOptions := &pg_models.JobDestinationOptions{}
+	require.NoError(t, destModelOptions.FromDto(destOptions))
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockDbTransaction(m.DbtxMock, mockTx)
+	m.QuerierMock.On("AreConnectionsInAccount", mock.Anything, mock.Anything, db_queries.AreConnectionsInAccountParams{
+		AccountId:     accountUuid,
+		ConnectionIds: []pgtype.UUID{destConn.ID},
+	}).Return(int64(1), nil)
+	m.QuerierMock.On("IsConnectionInAccount", mock.Anything, mock.Anything, db_queries.IsConnectionInAccountParams{
+		AccountId:    accountUuid,
+		ConnectionId: srcConn.ID,
+	}).Return(int64(1), nil)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, srcConn.ID).Return(srcConn, nil)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, destConn.ID).Return(destConn, nil)
+	m.TemporalWfManagerMock.On("DoesAccountHaveTemporalWorkspace", mock.Anything, mockAccountId, mock.Anything).Return(true, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, mockAccountId).Return(&pg_models.TemporalConfig{
+		Namespace:        "default",
+		SyncJobQueueName: "sync-job",
+		Url:              "localhost:7233",
+	}, nil)
+	m.TemporalWfManagerMock.On("GetScheduleClientByAccount", mock.Anything, mockAccountId, mock.Anything).Return(mockScheduleClient, nil)
+	mockScheduleClient.On("Create", mock.Anything, mock.Anything).Return(mockHandle, nil)
+	mockHandle.On("GetID").Return(neosyncdb.UUIDString(job1.ID))
+
+	m.QuerierMock.On("CreateJob", mock.Anything, mockTx, mock.MatchedBy(func(params db_queries.CreateJobParams) bool {
+		return params.ConnectionOptions.PostgresOptions.ConnectionId == neosyncdb.UUIDString(srcConn.ID)
+	})).Return(job1, nil)
+	m.QuerierMock.On("CreateJobConnectionDestinations", mock.Anything, mockTx, []db_queries.CreateJobConnectionDestinationsParams{
+		{JobID: job1.ID, ConnectionID: destConn.ID, Options: destModelOptions},
+	}).Return(int64(1), nil)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job1.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{
+		mockJobDestConnAssociation(job1.ID, destConn.ID, destModelOptions),
+	}, nil)
+
+	resp, err := m.Service.CreateJob(context.Background(), connect.NewRequest(getCrossDialectCreateJobRequest(job1.Name, srcConn.ID, destConn.ID, destOptions)))
+
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+func Test_CreateJob_CrossDialect_NoInitTableSchema(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	accountUuid, _ := neosyncdb.ToUuid(mockAccountId)
+	srcConn := getConnectionMock(mockAccountId, "pg-source")
+	destConn := getConnectionMock(mockAccountId, "mysql-dest")
+	destConn.ConnectionConfig = &pg_models.ConnectionConfig{MysqlConfig: &pg_models.MysqlConnectionConfig{}}
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	m.QuerierMock.On("AreConnectionsInAccount", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+	m.QuerierMock.On("IsConnectionInAccount", mock.Anything, mock.Anything, db_queries.IsConnectionInAccountParams{
+		AccountId:    accountUuid,
+		ConnectionId: srcConn.ID,
+	}).Return(int64(1), nil)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, srcConn.ID).Return(srcConn, nil)
+	m.QuerierMock.On("GetConnectionById", mock.Anything, mock.Anything, destConn.ID).Return(destConn, nil)
+
+	_, err := m.Service.CreateJob(context.Background(), connect.NewRequest(getCrossDialectCreateJobRequest("some-name", srcConn.ID, destConn.ID, &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{
+			MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{
+				TruncateTable:   &mgmtv1alpha1.MysqlTruncateTableConfig{},
+				OnConflict:      &mgmtv1alpha1.MysqlOnConflictConfig{},
+				InitTableSchema: false,
+			},
+		},
+	})))
+
+	require.Error(t, err)
+	m.QuerierMock.AssertNotCalled(t, "CreateJob", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func getCrossDialectCreateJobRequest(name string, srcConnId, destConnId pgtype.UUID, destOptions *mgmtv1alpha1.JobDestinationOptions) *mgmtv1alpha1.CreateJobRequest {
+	return &mgmtv1alpha1.CreateJobRequest{
+		AccountId: mockAccountId,
+		JobName:   name,
+		Source: &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+					Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+						ConnectionId: neosyncdb.UUIDString(srcConnId),
+						Schemas: []*mgmtv1alpha1.PostgresSourceSchemaOption{
+							{Schema: "schema-1", Tables: []*mgmtv1alpha1.PostgresSourceTableOption{{Table: "table-1"}}},
+						},
+					},
+				},
+			},
+		},
+		Destinations: []*mgmtv1alpha1.CreateJobDestination{
+			{ConnectionId: neosyncdb.UUIDString(destConnId), Options: destOptions},
+		},
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "schema-1", Table: "table-1", Column: "col", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+				Config: &mgmtv1alpha1.TransformerConfig{},
+			}},
+		},
+		WorkflowOptions: &mgmtv1alpha1.WorkflowOptions{},
+		SyncOptions:     &mgmtv1alpha1.ActivityOptions{},
+	}
+}
+
 // CreateJobDestinationConnections
 func Test_CreateJobDestinationConnections(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
@@ -1786,6 +1911,63 @@ func Test_getDurationFromInt(t *testing.T) {
 	require.Equal(t, getDurationFromInt(ptr(int64(1))), time.Duration(1))
 }
 
+func Test_isSqlConnectionConfig(t *testing.T) {
+	require.True(t, isSqlConnectionConfig(&pg_models.ConnectionConfig{PgConfig: &pg_models.PostgresConnectionConfig{}}))
+	require.True(t, isSqlConnectionConfig(&pg_models.ConnectionConfig{SqliteConfig: &pg_models.SqliteConnectionConfig{Path: "/tmp/neosync.db"}}))
+	require.False(t, isSqlConnectionConfig(&pg_models.ConnectionConfig{MongoConfig: &pg_models.MongoConnectionConfig{}}))
+	require.False(t, isSqlConnectionConfig(&pg_models.ConnectionConfig{}))
+}
+
+func Test_isInitTableSchemaEnabled(t *testing.T) {
+	require.False(t, isInitTableSchemaEnabled(nil))
+	require.False(t, isInitTableSchemaEnabled(&pg_models.JobDestinationOptions{}))
+	require.False(t, isInitTableSchemaEnabled(&pg_models.JobDestinationOptions{MysqlOptions: &pg_models.MysqlDestinationOptions{}}))
+	require.True(t, isInitTableSchemaEnabled(&pg_models.JobDestinationOptions{MysqlOptions: &pg_models.MysqlDestinationOptions{InitTableSchema: true}}))
+	require.True(t, isInitTableSchemaEnabled(&pg_models.JobDestinationOptions{MssqlOptions: &pg_models.MssqlDestinationOptions{InitTableSchema: true}}))
+}
+
+func Test_validateJobDestinationOptions(t *testing.T) {
+	require.NoError(t, validateJobDestinationOptions(nil))
+	require.NoError(t, validateJobDestinationOptions(&mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_AwsS3Options{AwsS3Options: &mgmtv1alpha1.AwsS3DestinationConnectionOptions{}},
+	}))
+	require.NoError(t, validateJobDestinationOptions(&mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_GcpCloudstorageOptions{GcpCloudstorageOptions: &mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions{
+			PathTemplate: ptr("exports/{{table}}/{{date}}/{{file_index}}.{{extension}}"),
+		}},
+	}))
+
+	err := validateJobDestinationOptions(&mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_AwsS3Options{AwsS3Options: &mgmtv1alpha1.AwsS3DestinationConnectionOptions{
+			PathTemplate: ptr("exports/{{table}}.json"),
+		}},
+	})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	err = validateJobDestinationOptions(&mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_LocalDirectoryOptions{LocalDirectoryOptions: &mgmtv1alpha1.LocalDirectoryDestinationConnectionOptions{
+			PathTemplate: ptr("{{schema}}.json"),
+		}},
+	})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	require.NoError(t, validateJobDestinationOptions(&mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_KafkaOptions{KafkaOptions: &mgmtv1alpha1.KafkaDestinationConnectionOptions{
+			Topic: "users",
+		}},
+	}))
+	err = validateJobDestinationOptions(&mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_KafkaOptions{KafkaOptions: &mgmtv1alpha1.KafkaDestinationConnectionOptions{
+			Topic:  "users",
+			Format: mgmtv1alpha1.KafkaMessageFormat_KAFKA_MESSAGE_FORMAT_AVRO,
+		}},
+	})
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
 // ValidateJobMappings
 func Test_ValidateJobMappings_NoValidationErrors(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
@@ -1968,3 +2150,69 @@ func Test_ValidateJobMappings_ValidationErrors(t *testing.T) {
 func ptr[T any](val T) *T {
 	return &val
 }
+
+func Test_validateIncrementalSyncDestinations(t *testing.T) {
+	watermarkColumn := "updated_at"
+	incrementalSource := &mgmtv1alpha1.JobSourceOptions{
+		Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+			Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+				Schemas: []*mgmtv1alpha1.PostgresSourceSchemaOption{
+					{Schema: "public", Tables: []*mgmtv1alpha1.PostgresSourceTableOption{{Table: "users", WatermarkColumn: &watermarkColumn}}},
+				},
+			},
+		},
+	}
+	truncateDestination := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
+			PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{
+				TruncateTable: &mgmtv1alpha1.PostgresTruncateTableConfig{TruncateBeforeInsert: true},
+			},
+		},
+	}
+	appendDestination := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
+			PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{},
+		},
+	}
+
+	err := validateIncrementalSyncDestinations(incrementalSource, []*mgmtv1alpha1.JobDestinationOptions{appendDestination, truncateDestination})
+	require.Error(t, err)
+	require.NoError(t, validateIncrementalSyncDestinations(incrementalSource, []*mgmtv1alpha1.JobDestinationOptions{appendDestination}))
+
+	fullSource := &mgmtv1alpha1.JobSourceOptions{
+		Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+			Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+				Schemas: []*mgmtv1alpha1.PostgresSourceSchemaOption{
+					{Schema: "public", Tables: []*mgmtv1alpha1.PostgresSourceTableOption{{Table: "users"}}},
+				},
+			},
+		},
+	}
+	require.NoError(t, validateIncrementalSyncDestinations(fullSource, []*mgmtv1alpha1.JobDestinationOptions{truncateDestination}))
+}
+
+func Test_validateGeneratedSourceDestinations(t *testing.T) {
+	generateSource := &mgmtv1alpha1.JobSourceOptions{
+		Config: &mgmtv1alpha1.JobSourceOptions_Generate{Generate: &mgmtv1alpha1.GenerateSourceOptions{}},
+	}
+	syncSource := &mgmtv1alpha1.JobSourceOptions{
+		Config: &mgmtv1alpha1.JobSourceOptions_Mssql{Mssql: &mgmtv1alpha1.MssqlSourceConnectionOptions{}},
+	}
+	doNothingDestination := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_MssqlOptions{
+			MssqlOptions: &mgmtv1alpha1.MssqlDestinationConnectionOptions{
+				OnConflict: &mgmtv1alpha1.MssqlOnConflictConfig{DoNothing: true},
+			},
+		},
+	}
+	appendDestination := &mgmtv1alpha1.JobDestinationOptions{
+		Config: &mgmtv1alpha1.JobDestinationOptions_MssqlOptions{
+			MssqlOptions: &mgmtv1alpha1.MssqlDestinationConnectionOptions{},
+		},
+	}
+
+	err := validateGeneratedSourceDestinations(generateSource, []*mgmtv1alpha1.JobDestinationOptions{doNothingDestination})
+	require.Error(t, err)
+	require.NoError(t, validateGeneratedSourceDestinations(generateSource, []*mgmtv1alpha1.JobDestinationOptions{appendDestination}))
+	require.NoError(t, validateGeneratedSourceDestinations(syncSource, []*mgmtv1alpha1.JobDestinationOptions{doNothingDestination}))
+}
@@ -0,0 +1,247 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+func (s *Service) GetJobPlan(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetJobPlanRequest],
+) (*connect.Response[mgmtv1alpha1.GetJobPlanResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("jobId", req.Msg.GetJobId())
+
+	jobResp, err := s.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: req.Msg.GetJobId()}))
+	if err != nil {
+		return nil, err
+	}
+	job := jobResp.Msg.GetJob()
+	logger = logger.With("accountId", job.GetAccountId())
+
+	switch job.GetSource().GetOptions().GetConfig().(type) {
+	case *mgmtv1alpha1.JobSourceOptions_Postgres, *mgmtv1alpha1.JobSourceOptions_Mysql, *mgmtv1alpha1.JobSourceOptions_Mssql, *mgmtv1alpha1.JobSourceOptions_Sqlite:
+	default:
+		return nil, nucleuserrors.NewBadRequest("job plans are only supported for jobs that sync from a sql source")
+	}
+
+	accountUuid, err := neosyncdb.ToUuid(job.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	sourceConnection, err := shared.GetJobSourceConnection(ctx, job.GetSource(), s.connectionService)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionTimeout := 5
+	sourcedb, err := s.sqlmanager.NewSqlDb(ctx, logger, sourceConnection, &connectionTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer sourcedb.Db.Close()
+
+	syncPlan, err := genbenthosconfigs_activity.BuildSqlSyncPlan(ctx, sourcedb.Db, sourcedb.Driver, job, func(ctx context.Context, table string) (*shared.TableWatermark, error) {
+		return s.getPreviousTableWatermark(ctx, job.GetId(), accountUuid, table)
+	}, logger)
+	if err != nil {
+		return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("unable to plan job: %s", err.Error()))
+	}
+
+	stages := getRunConfigStages(syncPlan.RunConfigs)
+	tableRuns := make([]*mgmtv1alpha1.JobPlanTableRun, 0, len(syncPlan.RunConfigs))
+	for _, config := range syncPlan.RunConfigs {
+		schema, table := sqlmanager_shared.SplitTableKey(config.Table())
+		dependsOn := []string{}
+		for _, dep := range config.DependsOn() {
+			dependsOn = append(dependsOn, dep.Table)
+		}
+		tableRun := &mgmtv1alpha1.JobPlanTableRun{
+			Schema:      schema,
+			Table:       table,
+			RunType:     string(config.RunType()),
+			DependsOn:   dependsOn,
+			Columns:     config.InsertColumns(),
+			SelectQuery: syncPlan.SelectQueries[config.Table()][config.RunType()],
+			Stage:       int32(stages[config]), //nolint:gosec
+		}
+		if config.RunType() == tabledependency.RunTypeInsert {
+			var whereClause *string
+			if clause, ok := syncPlan.TableWhereClauses[config.Table()]; ok {
+				whereClause = &clause
+			}
+			count, err := sourcedb.Db.GetTableRowCount(ctx, schema, table, whereClause)
+			if err != nil {
+				logger.Warn(fmt.Sprintf("unable to estimate row count for table %s: %s", config.Table(), err.Error()))
+			} else {
+				tableRun.EstimatedRowCount = &count
+			}
+		}
+		tableRuns = append(tableRuns, tableRun)
+	}
+	slices.SortStableFunc(tableRuns, func(a, b *mgmtv1alpha1.JobPlanTableRun) int {
+		if a.Stage != b.Stage {
+			return int(a.Stage - b.Stage)
+		}
+		if c := strings.Compare(sqlmanager_shared.BuildTable(a.Schema, a.Table), sqlmanager_shared.BuildTable(b.Schema, b.Table)); c != 0 {
+			return c
+		}
+		// inserts run before updates
+		return strings.Compare(a.RunType, b.RunType)
+	})
+
+	destinations, err := s.getJobPlanDestinations(ctx, sourcedb, job, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetJobPlanResponse{
+		Plan: &mgmtv1alpha1.JobPlan{
+			TableRuns:    tableRuns,
+			Destinations: destinations,
+		},
+	}), nil
+}
+
+func (s *Service) getJobPlanDestinations(
+	ctx context.Context,
+	sourcedb *sql_manager.SqlConnection,
+	job *mgmtv1alpha1.Job,
+	logger *slog.Logger,
+) ([]*mgmtv1alpha1.JobPlanDestination, error) {
+	destinations := []*mgmtv1alpha1.JobPlanDestination{}
+	for _, destination := range job.GetDestinations() {
+		connResp, err := s.connectionService.GetConnection(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: destination.GetConnectionId(),
+		}))
+		if err != nil {
+			return nil, err
+		}
+		destinationConnection := connResp.Msg.GetConnection()
+		driver, err := shared.GetSqlDriverFromConnection(destinationConnection)
+		if err != nil {
+			// only sql destinations are initialized before the sync
+			continue
+		}
+
+		planDestination := &mgmtv1alpha1.JobPlanDestination{ConnectionId: destination.GetConnectionId()}
+		stmts, err := runsqlinittablestmts_activity.BuildDestinationInitStatements(ctx, sourcedb, driver, destinationConnection, job, destination, logger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build init statements for destination %s: %w", destination.GetConnectionId(), err)
+		}
+		if stmts != nil {
+			for _, block := range stmts.InitStatements {
+				planDestination.InitStatements = append(planDestination.InitStatements, &mgmtv1alpha1.JobPlanStatementBlock{
+					Label:      block.Label,
+					Statements: block.Statements,
+				})
+			}
+			planDestination.TruncateStatements = stmts.TruncateStatements
+			planDestination.ResetStatements = stmts.ResetStatements
+			for _, lossy := range stmts.LossyConversions {
+				planDestination.LossyConversions = append(planDestination.LossyConversions, &mgmtv1alpha1.JobPlanLossyConversion{
+					Table:           lossy.Table,
+					Column:          lossy.Column,
+					SourceType:      lossy.SourceType,
+					DestinationType: lossy.DestinationType,
+					Reason:          lossy.Reason,
+				})
+			}
+		}
+		destinations = append(destinations, planDestination)
+	}
+	return destinations, nil
+}
+
+// Returns the stage that each run config begins in, mirroring the order that the sync workflow runs them in
+func getRunConfigStages(configs []*tabledependency.RunConfig) map[*tabledependency.RunConfig]int {
+	stages := map[*tabledependency.RunConfig]int{}
+	completed := map[string][]string{}
+	pending := slices.Clone(configs)
+
+	for stage := 0; len(pending) > 0; stage++ {
+		ready := []*tabledependency.RunConfig{}
+		remaining := []*tabledependency.RunConfig{}
+		for _, config := range pending {
+			if isRunConfigReady(config, completed) {
+				ready = append(ready, config)
+			} else {
+				remaining = append(remaining, config)
+			}
+		}
+		if len(ready) == 0 {
+			// unsatisfiable dependencies, these will never run
+			for _, config := range remaining {
+				stages[config] = stage
+			}
+			break
+		}
+		for _, config := range ready {
+			stages[config] = stage
+			completed[config.Table()] = append(completed[config.Table()], config.InsertColumns()...)
+		}
+		pending = remaining
+	}
+	return stages
+}
+
+func isRunConfigReady(config *tabledependency.RunConfig, completed map[string][]string) bool {
+	for _, dep := range config.DependsOn() {
+		completedCols, ok := completed[dep.Table]
+		if !ok {
+			return false
+		}
+		for _, col := range dep.Columns {
+			if !slices.Contains(completedCols, col) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (s *Service) getPreviousTableWatermark(
+	ctx context.Context,
+	jobId string,
+	accountUuid pgtype.UUID,
+	table string,
+) (*shared.TableWatermark, error) {
+	runContext, err := s.db.Q.GetRunContextByKey(ctx, s.db.Db, db_queries.GetRunContextByKeyParams{
+		WorkflowId: jobId,
+		ExternalId: shared.GetWatermarkExternalId(table),
+		AccountId:  accountUuid,
+	})
+	if err != nil && neosyncdb.IsNoRows(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to retrieve watermark for %s: %w", table, err)
+	}
+	if len(runContext.Value) == 0 {
+		return nil, nil
+	}
+	var watermark *shared.TableWatermark
+	err = json.Unmarshal(runContext.Value, &watermark)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal watermark for %s: %w", table, err)
+	}
+	return watermark, nil
+}
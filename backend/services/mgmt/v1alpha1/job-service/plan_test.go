@@ -0,0 +1,237 @@
+package v1alpha1_jobservice
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetJobPlan(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	srcConnId := uuid.NewString()
+	job := mockJob(mockAccountId, mockUserId, srcConnId, pgtype.Text{})
+	job.ConnectionOptions = &pg_models.JobSourceOptions{
+		PostgresOptions: &pg_models.PostgresSourceOptions{ConnectionId: srcConnId},
+	}
+	passthrough := &pg_models.JobMappingTransformerModel{
+		Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+		Config: &pg_models.TransformerConfigs{},
+	}
+	job.Mappings = []*pg_models.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: passthrough},
+		{Schema: "public", Table: "orders", Column: "id", JobMappingTransformer: passthrough},
+		{Schema: "public", Table: "orders", Column: "buyer_id", JobMappingTransformer: passthrough},
+	}
+	destConn := getConnectionMock(mockAccountId, "dest")
+	destConnAssociation := mockJobDestConnAssociation(job.ID, destConn.ID, &pg_models.JobDestinationOptions{
+		PostgresOptions: &pg_models.PostgresDestinationOptions{
+			TruncateTableConfig: &pg_models.PostgresTruncateTableConfig{TruncateBeforeInsert: true, TruncateCascade: true},
+		},
+	})
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{destConnAssociation})
+
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id: srcConnId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{}},
+			},
+		},
+	}), nil)
+
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sql_manager.SqlConnection{Db: m.SqlDbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
+	m.SqlDbMock.On("Close").Return(nil)
+	m.SqlDbMock.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users":  {"id": &sqlmanager_shared.ColumnInfo{}},
+		"public.orders": {"id": &sqlmanager_shared.ColumnInfo{}, "buyer_id": &sqlmanager_shared.ColumnInfo{}},
+	}, nil)
+	m.SqlDbMock.On("GetTableConstraintsBySchema", mock.Anything, mock.Anything).Return(&sqlmanager_shared.TableConstraints{
+		ForeignKeyConstraints: map[string][]*sqlmanager_shared.ForeignConstraint{
+			"public.orders": {{Columns: []string{"buyer_id"}, NotNullable: []bool{true}, ForeignKey: &sqlmanager_shared.ForeignKey{Table: "public.users", Columns: []string{"id"}}}},
+		},
+		PrimaryKeyConstraints: map[string][]string{"public.users": {"id"}, "public.orders": {"id"}},
+	}, nil)
+	m.SqlDbMock.On("GetTableRowCount", mock.Anything, "public", "users", mock.Anything).Return(int64(10), nil)
+	m.SqlDbMock.On("GetTableRowCount", mock.Anything, "public", "orders", mock.Anything).Return(int64(25), nil)
+	m.SqlDbMock.On("GetSequencesByTables", mock.Anything, "public", mock.Anything).Return([]*sqlmanager_shared.DataType{}, nil)
+
+	resp, err := m.Service.GetJobPlan(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobPlanRequest{
+		JobId: neosyncdb.UUIDString(job.ID),
+	}))
+	require.NoError(t, err)
+
+	tableRuns := resp.Msg.GetPlan().GetTableRuns()
+	require.Len(t, tableRuns, 2)
+	require.Equal(t, "users", tableRuns[0].GetTable())
+	require.Equal(t, int32(0), tableRuns[0].GetStage())
+	require.Equal(t, int64(10), tableRuns[0].GetEstimatedRowCount())
+	require.NotEmpty(t, tableRuns[0].GetSelectQuery())
+	require.Equal(t, "orders", tableRuns[1].GetTable())
+	require.Equal(t, int32(1), tableRuns[1].GetStage())
+	require.Equal(t, []string{"public.users"}, tableRuns[1].GetDependsOn())
+	require.Equal(t, int64(25), tableRuns[1].GetEstimatedRowCount())
+
+	destinations := resp.Msg.GetPlan().GetDestinations()
+	require.Len(t, destinations, 1)
+	require.Equal(t, neosyncdb.UUIDString(destConn.ID), destinations[0].GetConnectionId())
+	require.Len(t, destinations[0].GetTruncateStatements(), 2)
+	require.Empty(t, destinations[0].GetInitStatements())
+}
+
+func Test_GetJobPlan_IncrementalRowCount(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	srcConnId := uuid.NewString()
+	job := mockJob(mockAccountId, mockUserId, srcConnId, pgtype.Text{})
+	job.ConnectionOptions = &pg_models.JobSourceOptions{
+		PostgresOptions: &pg_models.PostgresSourceOptions{
+			ConnectionId: srcConnId,
+			Schemas: []*pg_models.PostgresSourceSchemaOption{
+				{Schema: "public", Tables: []*pg_models.PostgresSourceTableOption{
+					{Table: "users", WhereClause: shared.Ptr("id > 1"), WatermarkColumn: shared.Ptr("id")},
+				}},
+			},
+		},
+	}
+	passthrough := &pg_models.JobMappingTransformerModel{
+		Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+		Config: &pg_models.TransformerConfigs{},
+	}
+	job.Mappings = []*pg_models.JobMapping{
+		{Schema: "public", Table: "users", Column: "id", JobMappingTransformer: passthrough},
+	}
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
+
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id: srcConnId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{}},
+			},
+		},
+	}), nil)
+	watermarkBits, err := json.Marshal(&shared.TableWatermark{Column: "id", Value: "5"})
+	require.NoError(t, err)
+	m.QuerierMock.On("GetRunContextByKey", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiRuncontext{Value: watermarkBits}, nil)
+
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sql_manager.SqlConnection{Db: m.SqlDbMock, Driver: sqlmanager_shared.PostgresDriver}, nil)
+	m.SqlDbMock.On("Close").Return(nil)
+	m.SqlDbMock.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users": {"id": &sqlmanager_shared.ColumnInfo{}},
+	}, nil)
+	m.SqlDbMock.On("GetTableConstraintsBySchema", mock.Anything, mock.Anything).Return(&sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{"public.users": {"id"}},
+	}, nil)
+	m.SqlDbMock.On("GetTableColumnMaxValue", mock.Anything, "public", "users", "id", mock.Anything).Return(shared.Ptr("10"), nil)
+	var countWhereClause *string
+	m.SqlDbMock.On("GetTableRowCount", mock.Anything, "public", "users", mock.Anything).
+		Run(func(args mock.Arguments) {
+			countWhereClause = args.Get(3).(*string)
+		}).
+		Return(int64(5), nil)
+
+	resp, err := m.Service.GetJobPlan(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobPlanRequest{
+		JobId: neosyncdb.UUIDString(job.ID),
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetPlan().GetTableRuns(), 1)
+	require.NotNil(t, countWhereClause)
+	require.Contains(t, *countWhereClause, "id > 1", "the row count must apply the configured where clause")
+	require.Contains(t, *countWhereClause, "'5'", "the row count must only count the rows past the previous watermark")
+	require.Contains(t, resp.Msg.GetPlan().GetTableRuns()[0].GetSelectQuery(), "'5'")
+}
+
+func Test_GetJobPlan_SqliteSource(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	srcConnId := uuid.NewString()
+	job := mockJob(mockAccountId, mockUserId, srcConnId, pgtype.Text{})
+	job.ConnectionOptions = &pg_models.JobSourceOptions{
+		SqliteOptions: &pg_models.SqliteSourceOptions{ConnectionId: srcConnId},
+	}
+	passthrough := &pg_models.JobMappingTransformerModel{
+		Source: int32(mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH),
+		Config: &pg_models.TransformerConfigs{},
+	}
+	job.Mappings = []*pg_models.JobMapping{
+		{Schema: "main", Table: "users", Column: "id", JobMappingTransformer: passthrough},
+	}
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
+
+	m.ConnectionServiceClientMock.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id: srcConnId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_SqliteConfig{SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{Path: "dev.db"}},
+			},
+		},
+	}), nil)
+
+	m.SqlManagerMock.On("NewSqlDb", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&sql_manager.SqlConnection{Db: m.SqlDbMock, Driver: sqlmanager_shared.SqliteDriver}, nil)
+	m.SqlDbMock.On("Close").Return(nil)
+	m.SqlDbMock.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"main.users": {"id": &sqlmanager_shared.ColumnInfo{}},
+	}, nil)
+	m.SqlDbMock.On("GetTableConstraintsBySchema", mock.Anything, mock.Anything).Return(&sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{"main.users": {"id"}},
+	}, nil)
+	m.SqlDbMock.On("GetTableRowCount", mock.Anything, "main", "users", mock.Anything).Return(int64(3), nil)
+
+	resp, err := m.Service.GetJobPlan(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobPlanRequest{
+		JobId: neosyncdb.UUIDString(job.ID),
+	}))
+	require.NoError(t, err)
+
+	tableRuns := resp.Msg.GetPlan().GetTableRuns()
+	require.Len(t, tableRuns, 1)
+	require.Equal(t, "main", tableRuns[0].GetSchema())
+	require.Equal(t, "users", tableRuns[0].GetTable())
+	require.Equal(t, int64(3), tableRuns[0].GetEstimatedRowCount())
+	require.NotEmpty(t, tableRuns[0].GetSelectQuery())
+}
+
+func Test_GetJobPlan_UnsupportedSource(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	job.ConnectionOptions = &pg_models.JobSourceOptions{
+		GenerateOptions: &pg_models.GenerateSourceOptions{},
+	}
+	mockGetJob(m.UserAccountServiceMock, m.QuerierMock, job, []db_queries.NeosyncApiJobDestinationConnectionAssociation{})
+
+	_, err := m.Service.GetJobPlan(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetJobPlanRequest{
+		JobId: neosyncdb.UUIDString(job.ID),
+	}))
+	require.Error(t, err)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func Test_getRunConfigStages(t *testing.T) {
+	users := tabledependency.NewRunConfig("public.users", tabledependency.RunTypeInsert, []string{"id"}, nil, []string{"id", "name"}, []string{"id", "name"}, nil, false)
+	orders := tabledependency.NewRunConfig("public.orders", tabledependency.RunTypeInsert, []string{"id"}, nil, []string{"id", "buyer_id"}, []string{"id", "buyer_id"}, []*tabledependency.DependsOn{{Table: "public.users", Columns: []string{"id"}}}, false)
+	items := tabledependency.NewRunConfig("public.items", tabledependency.RunTypeInsert, []string{"id"}, nil, []string{"id", "order_id"}, []string{"id", "order_id"}, []*tabledependency.DependsOn{{Table: "public.orders", Columns: []string{"id"}}}, false)
+	tags := tabledependency.NewRunConfig("public.tags", tabledependency.RunTypeInsert, []string{"id"}, nil, []string{"id"}, []string{"id"}, nil, false)
+
+	stages := getRunConfigStages([]*tabledependency.RunConfig{items, orders, tags, users})
+	require.Equal(t, map[*tabledependency.RunConfig]int{
+		users:  0,
+		tags:   0,
+		orders: 1,
+		items:  2,
+	}, stages)
+}
@@ -20,7 +20,9 @@ import (
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/loki"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/enums/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
@@ -273,6 +275,14 @@ func (s *Service) CreateJobRun(
 	if err != nil {
 		return nil, err
 	}
+
+	if req.Msg.ResumeFromRunId != nil {
+		logger = logger.With("resumeFromRunId", req.Msg.GetResumeFromRunId())
+		err = s.setResumeRun(ctx, logger, &job, scheduleHandle, req.Msg.GetResumeFromRunId())
+		if err != nil {
+			return nil, err
+		}
+	}
 	logger.Info("creating job run")
 	err = scheduleHandle.Trigger(ctx, temporalclient.ScheduleTriggerOptions{})
 	if err != nil {
@@ -283,6 +293,88 @@ func (s *Service) CreateJobRun(
 	return connect.NewResponse(&mgmtv1alpha1.CreateJobRunResponse{}), nil
 }
 
+// Records that the next run of the job should resume the provided job run.
+// The next run skips the tables that completed in the resumed run and only syncs the failed or unstarted tables.
+func (s *Service) setResumeRun(
+	ctx context.Context,
+	logger *slog.Logger,
+	job *db_queries.NeosyncApiJob,
+	scheduleHandle temporalclient.ScheduleHandle,
+	runId string,
+) error {
+	jobId := neosyncdb.UUIDString(job.ID)
+	verifResp, err := s.getVerifiedJobRun(ctx, logger, runId, neosyncdb.UUIDString(job.AccountID))
+	if err != nil {
+		return err
+	}
+	if dtomaps.GetJobIdFromWorkflow(logger, verifResp.WorkflowExecution.GetSearchAttributes()) != jobId {
+		return nucleuserrors.NewBadRequest("job run to resume does not belong to the provided job")
+	}
+	switch verifResp.WorkflowExecution.GetStatus() {
+	case enums.WORKFLOW_EXECUTION_STATUS_FAILED,
+		enums.WORKFLOW_EXECUTION_STATUS_CANCELED,
+		enums.WORKFLOW_EXECUTION_STATUS_TERMINATED,
+		enums.WORKFLOW_EXECUTION_STATUS_TIMED_OUT:
+	case enums.WORKFLOW_EXECUTION_STATUS_RUNNING:
+		return nucleuserrors.NewBadRequest("job run to resume is still running, it must be canceled before it can be resumed")
+	default:
+		return nucleuserrors.NewBadRequest(fmt.Sprintf("only failed or canceled job runs can be resumed, job run status is %s", verifResp.WorkflowExecution.GetStatus()))
+	}
+
+	_, err = s.db.Q.GetRunContextByKey(ctx, s.db.Db, db_queries.GetRunContextByKeyParams{
+		WorkflowId: runId,
+		ExternalId: shared.GetResumedByExternalId(),
+		AccountId:  job.AccountID,
+	})
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return fmt.Errorf("unable to retrieve resume claim of job run: %w", err)
+	} else if err == nil {
+		return nucleuserrors.NewBadRequest("job run has already been resumed")
+	}
+
+	destinations, err := s.db.Q.GetJobConnectionDestinations(ctx, s.db.Db, job.ID)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve job destinations: %w", err)
+	}
+	for _, destination := range destinations {
+		if !canRerunDestinationTables(destination.Options.ToDto()) {
+			return nucleuserrors.NewBadRequest("job run can only be resumed when every sql destination truncates its tables before insert or skips or updates conflicting rows, otherwise the rows written by the resumed run would be duplicated")
+		}
+	}
+
+	// the resume request is consumed by the next run of the job, runs of a job do not overlap so the triggered run is the one that consumes it
+	scheduleDesc, err := scheduleHandle.Describe(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to describe job schedule: %w", err)
+	}
+	if len(scheduleDesc.Info.RunningWorkflows) > 0 {
+		return nucleuserrors.NewBadRequest("job has a run in progress, it must complete or be canceled before a previous run can be resumed")
+	}
+
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return err
+	}
+	bits, err := json.Marshal(&shared.ResumeRun{RunId: runId})
+	if err != nil {
+		return fmt.Errorf("unable to marshal resume run: %w", err)
+	}
+	// the resume request is stored under the job as the run that resumes does not exist until the schedule is triggered
+	err = s.db.Q.SetRunContext(ctx, s.db.Db, db_queries.SetRunContextParams{
+		WorkflowID:  jobId,
+		ExternalID:  shared.GetResumeRunExternalId(),
+		AccountID:   job.AccountID,
+		Value:       bits,
+		CreatedByID: *userUuid,
+		UpdatedByID: *userUuid,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set resume run: %w", err)
+	}
+	logger.Info("resuming job run")
+	return nil
+}
+
 func (s *Service) CancelJobRun(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.CancelJobRunRequest],
@@ -432,6 +524,40 @@ func (s *Service) getVerifiedJobRun(
 	}, nil
 }
 
+// Looks up the job that a job run belongs to from the account's temporal namespace.
+// Used to check API keys that are restricted to specific jobs against the job of a run.
+type JobRunResolver struct {
+	temporalWfManager clientmanager.TemporalClientManagerClient
+}
+
+func NewJobRunResolver(temporalWfManager clientmanager.TemporalClientManagerClient) *JobRunResolver {
+	return &JobRunResolver{temporalWfManager: temporalWfManager}
+}
+
+func (r *JobRunResolver) GetJobIdByRunId(ctx context.Context, accountId, jobRunId string) (string, error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	hasNs, err := r.temporalWfManager.DoesAccountHaveTemporalWorkspace(ctx, accountId, logger)
+	if err != nil {
+		return "", err
+	}
+	if !hasNs {
+		return "", nucleuserrors.NewNotFound("unable to retrieve job run. temporal namespace not found")
+	}
+	tclient, err := r.temporalWfManager.GetWorkflowClientByAccount(ctx, accountId, logger)
+	if err != nil {
+		return "", err
+	}
+	tconfig, err := r.temporalWfManager.GetTemporalConfigByAccount(ctx, accountId)
+	if err != nil {
+		return "", err
+	}
+	run, err := getWorkflowExecutionsByRunId(ctx, tclient, tconfig.Namespace, jobRunId)
+	if err != nil {
+		return "", err
+	}
+	return dtomaps.GetJobIdFromWorkflow(logger, run.GetSearchAttributes()), nil
+}
+
 type LogLine struct {
 	WorkflowID string     `json:"WorkflowID"`
 	Time       *time.Time `json:"time,omitempty"`
@@ -698,14 +824,14 @@ func (s *Service) SetRunContext(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.SetRunContextRequest],
 ) (*connect.Response[mgmtv1alpha1.SetRunContextResponse], error) {
+	if err := s.verifyCanSetRunContext(ctx); err != nil {
+		return nil, err
+	}
 	id := req.Msg.GetId()
 	accountUuid, err := s.verifyUserInAccount(ctx, id.GetAccountId())
 	if err != nil {
 		return nil, err
 	}
-	if s.cfg.IsNeosyncCloud && !isWorkerApiKey(ctx) {
-		return nil, nucleuserrors.NewUnauthenticated("must provide valid authentication credentials for this endpoint")
-	}
 
 	var userId *pgtype.UUID
 	if isWorkerApiKey(ctx) {
@@ -737,10 +863,27 @@ func (s *Service) SetRunContext(
 	return connect.NewResponse(&mgmtv1alpha1.SetRunContextResponse{}), nil
 }
 
+// Run contexts hold the benthos configs and checkpoints that the worker runs, so only the worker may write them.
+// Neosync Cloud workers authenticate with a worker api key, self hosted workers authenticate with an account api key
+// that must have the jobs:write permission in the run's account, which is checked when the account is verified.
+// Without auth there is no way to tell the worker apart from other callers.
+func (s *Service) verifyCanSetRunContext(ctx context.Context) error {
+	if !s.cfg.IsAuthEnabled || isWorkerApiKey(ctx) {
+		return nil
+	}
+	if !s.cfg.IsNeosyncCloud && isAccountApiKey(ctx) {
+		return nil
+	}
+	return nucleuserrors.NewForbidden("run contexts may only be set by the worker")
+}
+
 func (s *Service) SetRunContexts(
 	ctx context.Context,
 	stream *connect.ClientStream[mgmtv1alpha1.SetRunContextsRequest],
 ) (*connect.Response[mgmtv1alpha1.SetRunContextsResponse], error) {
+	if err := s.verifyCanSetRunContext(ctx); err != nil {
+		return nil, err
+	}
 	for stream.Receive() {
 		req := stream.Msg()
 		id := req.GetId()
@@ -748,9 +891,6 @@ func (s *Service) SetRunContexts(
 		if err != nil {
 			return nil, err
 		}
-		if s.cfg.IsNeosyncCloud && !isWorkerApiKey(ctx) {
-			return nil, nucleuserrors.NewUnauthenticated("must provide valid authentication credentials for this endpoint")
-		}
 
 		var userId *pgtype.UUID
 		if isWorkerApiKey(ctx) {
@@ -784,3 +924,25 @@ func (s *Service) SetRunContexts(
 	}
 	return connect.NewResponse(&mgmtv1alpha1.SetRunContextsResponse{}), nil
 }
+
+// Returns true if the tables of the destination can be synced again without duplicating the rows written by a previous run.
+// The tables are cleared before they are synced again when the destination truncates them, otherwise the conflicting rows have to be skipped or updated.
+func canRerunDestinationTables(options *mgmtv1alpha1.JobDestinationOptions) bool {
+	switch config := options.GetConfig().(type) {
+	case *mgmtv1alpha1.JobDestinationOptions_PostgresOptions:
+		opts := config.PostgresOptions
+		return opts.GetTruncateTable().GetTruncateBeforeInsert() || opts.GetTruncateTable().GetCascade() ||
+			opts.GetOnConflict().GetDoNothing() || opts.GetOnConflict().GetUpdate() != nil
+	case *mgmtv1alpha1.JobDestinationOptions_MysqlOptions:
+		opts := config.MysqlOptions
+		return opts.GetTruncateTable().GetTruncateBeforeInsert() || opts.GetOnConflict().GetDoNothing() || opts.GetOnConflict().GetUpdate() != nil
+	case *mgmtv1alpha1.JobDestinationOptions_MssqlOptions:
+		opts := config.MssqlOptions
+		return opts.GetTruncateTable().GetTruncateBeforeInsert() || opts.GetOnConflict().GetUpdate() != nil
+	case *mgmtv1alpha1.JobDestinationOptions_SqliteOptions:
+		opts := config.SqliteOptions
+		return opts.GetTruncateTable().GetTruncateBeforeInsert() || opts.GetOnConflict().GetDoNothing()
+	default:
+		return true
+	}
+}
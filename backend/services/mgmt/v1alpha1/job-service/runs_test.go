@@ -2,17 +2,23 @@ package v1alpha1_jobservice
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
 	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -149,6 +155,196 @@ func Test_CreateJobRun(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func Test_CreateJobRun_Resume(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockHandle := new(temporalmocks.ScheduleHandle)
+	temporalClientMock := new(temporalmocks.Client)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobId := neosyncdb.UUIDString(job.ID)
+	runId := uuid.NewString()
+	run := getWorfklowExecutionInfoMock(jobId, runId)
+	run.Status = enums.WORKFLOW_EXECUTION_STATUS_FAILED
+
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, job.AccountID, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{run})
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetRunContextByKey", mock.Anything, mock.Anything, db_queries.GetRunContextByKeyParams{
+		WorkflowId: runId,
+		ExternalId: shared.GetResumedByExternalId(),
+		AccountId:  job.AccountID,
+	}).Return(db_queries.NeosyncApiRuncontext{}, pgx.ErrNoRows)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{
+		{Options: &pg_models.JobDestinationOptions{PostgresOptions: &pg_models.PostgresDestinationOptions{
+			TruncateTableConfig: &pg_models.PostgresTruncateTableConfig{TruncateBeforeInsert: true},
+		}}},
+	}, nil)
+	m.QuerierMock.On("SetRunContext", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.SetRunContextParams) bool {
+		return params.WorkflowID == jobId && params.ExternalID == shared.GetResumeRunExternalId() && string(params.Value) == fmt.Sprintf(`{"runId":%q}`, runId)
+	})).Return(nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, jobId, mock.Anything).Return(mockHandle, nil)
+	mockHandle.On("Describe", mock.Anything).Return(&temporalclient.ScheduleDescription{}, nil)
+	mockHandle.On("Trigger", mock.Anything, temporalclient.ScheduleTriggerOptions{}).Return(nil)
+
+	resp, err := m.Service.CreateJobRun(context.Background(), &connect.Request[mgmtv1alpha1.CreateJobRunRequest]{
+		Msg: &mgmtv1alpha1.CreateJobRunRequest{
+			JobId:           jobId,
+			ResumeFromRunId: &runId,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	m.QuerierMock.AssertExpectations(t)
+}
+
+func Test_CreateJobRun_Resume_Running(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockHandle := new(temporalmocks.ScheduleHandle)
+	temporalClientMock := new(temporalmocks.Client)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobId := neosyncdb.UUIDString(job.ID)
+	runId := uuid.NewString()
+	run := getWorfklowExecutionInfoMock(jobId, runId)
+	run.Status = enums.WORKFLOW_EXECUTION_STATUS_RUNNING
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, job.AccountID, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{run})
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, jobId, mock.Anything).Return(mockHandle, nil)
+
+	_, err := m.Service.CreateJobRun(context.Background(), &connect.Request[mgmtv1alpha1.CreateJobRunRequest]{
+		Msg: &mgmtv1alpha1.CreateJobRunRequest{
+			JobId:           jobId,
+			ResumeFromRunId: &runId,
+		},
+	})
+
+	assert.Error(t, err)
+	m.QuerierMock.AssertNotCalled(t, "SetRunContext", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_CreateJobRun_Resume_AlreadyResumed(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockHandle := new(temporalmocks.ScheduleHandle)
+	temporalClientMock := new(temporalmocks.Client)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobId := neosyncdb.UUIDString(job.ID)
+	runId := uuid.NewString()
+	run := getWorfklowExecutionInfoMock(jobId, runId)
+	run.Status = enums.WORKFLOW_EXECUTION_STATUS_FAILED
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, job.AccountID, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{run})
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetRunContextByKey", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiRuncontext{}, nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, jobId, mock.Anything).Return(mockHandle, nil)
+
+	_, err := m.Service.CreateJobRun(context.Background(), &connect.Request[mgmtv1alpha1.CreateJobRunRequest]{
+		Msg: &mgmtv1alpha1.CreateJobRunRequest{
+			JobId:           jobId,
+			ResumeFromRunId: &runId,
+		},
+	})
+
+	assert.Error(t, err)
+	m.QuerierMock.AssertNotCalled(t, "SetRunContext", mock.Anything, mock.Anything, mock.Anything)
+	mockHandle.AssertNotCalled(t, "Trigger", mock.Anything, mock.Anything)
+}
+
+func Test_CreateJobRun_Resume_DuplicatingDestination(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockHandle := new(temporalmocks.ScheduleHandle)
+	temporalClientMock := new(temporalmocks.Client)
+	job := mockJob(mockAccountId, mockUserId, uuid.NewString(), pgtype.Text{})
+	jobId := neosyncdb.UUIDString(job.ID)
+	runId := uuid.NewString()
+	run := getWorfklowExecutionInfoMock(jobId, runId)
+	run.Status = enums.WORKFLOW_EXECUTION_STATUS_FAILED
+
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetVerifiedJobRun(m.TemporalWfManagerMock, job.AccountID, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{run})
+	m.QuerierMock.On("GetJobById", mock.Anything, mock.Anything, job.ID).Return(job, nil)
+	m.QuerierMock.On("GetRunContextByKey", mock.Anything, mock.Anything, mock.Anything).Return(db_queries.NeosyncApiRuncontext{}, pgx.ErrNoRows)
+	m.QuerierMock.On("GetJobConnectionDestinations", mock.Anything, mock.Anything, job.ID).Return([]db_queries.NeosyncApiJobDestinationConnectionAssociation{
+		{Options: &pg_models.JobDestinationOptions{PostgresOptions: &pg_models.PostgresDestinationOptions{}}},
+	}, nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, mockAccountId, jobId, mock.Anything).Return(mockHandle, nil)
+
+	_, err := m.Service.CreateJobRun(context.Background(), &connect.Request[mgmtv1alpha1.CreateJobRunRequest]{
+		Msg: &mgmtv1alpha1.CreateJobRunRequest{
+			JobId:           jobId,
+			ResumeFromRunId: &runId,
+		},
+	})
+
+	assert.Error(t, err)
+	m.QuerierMock.AssertNotCalled(t, "SetRunContext", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_SetRunContext_RequiresWorkerApiKey(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+
+	_, err := m.Service.SetRunContext(context.Background(), connect.NewRequest(&mgmtv1alpha1.SetRunContextRequest{
+		Id:    &mgmtv1alpha1.RunContextKey{JobRunId: uuid.NewString(), ExternalId: "benthos-config", AccountId: mockAccountId},
+		Value: []byte("input: {}"),
+	}))
+
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	m.QuerierMock.AssertNotCalled(t, "SetRunContext", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_SetRunContext_WorkerApiKey(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	runId := uuid.NewString()
+	m.QuerierMock.On("SetRunContext", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.SetRunContextParams) bool {
+		return params.WorkflowID == runId && params.ExternalID == "benthos-config"
+	})).Return(nil)
+
+	ctx := context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &auth_apikey.TokenContextData{
+		ApiKeyType: apikey.WorkerApiKey,
+	})
+	_, err := m.Service.SetRunContext(ctx, connect.NewRequest(&mgmtv1alpha1.SetRunContextRequest{
+		Id:    &mgmtv1alpha1.RunContextKey{JobRunId: runId, ExternalId: "benthos-config", AccountId: mockAccountId},
+		Value: []byte("input: {}"),
+	}))
+	require.NoError(t, err)
+}
+
+func Test_SetRunContext_AccountApiKey_SelfHosted(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true})
+	mockUserAccountCalls(m.UserAccountServiceMock, true)
+	runId := uuid.NewString()
+	m.QuerierMock.On("SetRunContext", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.SetRunContextParams) bool {
+		return params.WorkflowID == runId && params.ExternalID == "benthos-config"
+	})).Return(nil)
+
+	ctx := context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &auth_apikey.TokenContextData{
+		ApiKeyType: apikey.AccountApiKey,
+	})
+	_, err := m.Service.SetRunContext(ctx, connect.NewRequest(&mgmtv1alpha1.SetRunContextRequest{
+		Id:    &mgmtv1alpha1.RunContextKey{JobRunId: runId, ExternalId: "benthos-config", AccountId: mockAccountId},
+		Value: []byte("input: {}"),
+	}))
+	require.NoError(t, err)
+}
+
+func Test_SetRunContext_AccountApiKey_NeosyncCloud(t *testing.T) {
+	m := createServiceMock(t, &Config{IsAuthEnabled: true, IsNeosyncCloud: true})
+
+	ctx := context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &auth_apikey.TokenContextData{
+		ApiKeyType: apikey.AccountApiKey,
+	})
+	_, err := m.Service.SetRunContext(ctx, connect.NewRequest(&mgmtv1alpha1.SetRunContextRequest{
+		Id:    &mgmtv1alpha1.RunContextKey{JobRunId: uuid.NewString(), ExternalId: "benthos-config", AccountId: mockAccountId},
+		Value: []byte("input: {}"),
+	}))
+
+	require.Error(t, err)
+	require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	m.QuerierMock.AssertNotCalled(t, "SetRunContext", mock.Anything, mock.Anything, mock.Anything)
+}
+
 // CancelJobRun
 func Test_CancelJobRun(t *testing.T) {
 	m := createServiceMock(t, &Config{IsAuthEnabled: true})
@@ -178,6 +374,31 @@ func Test_CancelJobRun(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func Test_JobRunResolver_GetJobIdByRunId(t *testing.T) {
+	temporalWfManagerMock := clientmanager.NewMockTemporalClientManagerClient(t)
+	temporalClientMock := new(temporalmocks.Client)
+	accountUuid, _ := neosyncdb.ToUuid(mockAccountId)
+	jobId := uuid.NewString()
+
+	mockGetVerifiedJobRun(temporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{getWorfklowExecutionInfoMock(jobId, "run-id")})
+
+	resolvedJobId, err := NewJobRunResolver(temporalWfManagerMock).GetJobIdByRunId(context.Background(), mockAccountId, "run-id")
+	require.NoError(t, err)
+	require.Equal(t, jobId, resolvedJobId)
+}
+
+func Test_JobRunResolver_GetJobIdByRunId_NotFound(t *testing.T) {
+	temporalWfManagerMock := clientmanager.NewMockTemporalClientManagerClient(t)
+	temporalClientMock := new(temporalmocks.Client)
+	accountUuid, _ := neosyncdb.ToUuid(mockAccountId)
+
+	mockGetVerifiedJobRun(temporalWfManagerMock, accountUuid, temporalClientMock, []*workflowpb.WorkflowExecutionInfo{})
+
+	_, err := NewJobRunResolver(temporalWfManagerMock).GetJobIdByRunId(context.Background(), mockAccountId, "run-id")
+	require.Error(t, err)
+	require.True(t, nucleuserrors.IsNotFound(err))
+}
+
 func mockGetVerifiedJobRun(
 	temporalWfManagerMock *clientmanager.MockTemporalClientManagerClient,
 	accountUuid pgtype.UUID,
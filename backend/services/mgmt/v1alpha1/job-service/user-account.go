@@ -44,6 +44,14 @@ func isWorkerApiKey(ctx context.Context) bool {
 	return data.ApiKeyType == apikey.WorkerApiKey
 }
 
+func isAccountApiKey(ctx context.Context) bool {
+	data, err := auth_apikey.GetTokenDataFromCtx(ctx)
+	if err != nil {
+		return false
+	}
+	return data.ApiKeyType == apikey.AccountApiKey
+}
+
 func (s *Service) getUserUuid(
 	ctx context.Context,
 ) (*pgtype.UUID, error) {
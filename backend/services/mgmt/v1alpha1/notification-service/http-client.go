@@ -0,0 +1,87 @@
+package v1alpha1_notificationservice
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// address ranges that are not covered by the net.IP helpers but must never be reached from a webhook
+var blockedWebhookPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // this network
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier grade nat
+	netip.MustParsePrefix("192.0.0.0/24"),  // ietf protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // benchmarking
+	netip.MustParsePrefix("64:ff9b::/96"),  // nat64, which can map to private ipv4 addresses
+}
+
+// Returns the client that webhooks are delivered with.
+// Webhook urls are user provided, so unless private addresses are allowed, the client refuses to connect to
+// private, loopback, and link-local addresses. The check happens after DNS resolution so that it also covers hostnames,
+// and redirects are not followed as they could point anywhere.
+func newWebhookHttpClient(allowPrivateAddresses bool) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   webhookRequestTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+	if !allowPrivateAddresses {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			return verifyWebhookAddress(address)
+		}
+	}
+	return &http.Client{
+		Timeout: webhookRequestTimeout,
+		Transport: &http.Transport{
+			// a proxy would be dialed instead of the webhook host, which would bypass the address check
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   webhookRequestTimeout,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// Called with the resolved ip address of every connection that is dialed
+func verifyWebhookAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("unable to parse webhook address: %w", err)
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("unable to parse webhook address: %w", err)
+	}
+	if !isPublicAddress(addr) {
+		return fmt.Errorf("webhook url resolved to %s, which is not a public address", addr.String())
+	}
+	return nil
+}
+
+func isPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		addr.IsUnspecified() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range blockedWebhookPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,298 @@
+package v1alpha1_notificationservice
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	defaultDeliveryLimit = 100
+	// the maximum number of webhooks that are delivered at once for a single event
+	maxConcurrentDeliveries = 5
+)
+
+func (s *Service) CreateNotificationRule(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CreateNotificationRuleRequest],
+) (*connect.Response[mgmtv1alpha1.CreateNotificationRuleResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	parsedUrl, err := url.Parse(req.Msg.GetUrl())
+	if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		return nil, nucleuserrors.NewBadRequest("url must be a valid http or https url")
+	}
+
+	var jobUuid pgtype.UUID
+	if req.Msg.JobId != nil {
+		jobUuid, err = neosyncdb.ToUuid(req.Msg.GetJobId())
+		if err != nil {
+			return nil, err
+		}
+		job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+		if err != nil && !neosyncdb.IsNoRows(err) {
+			return nil, err
+		} else if (err != nil && neosyncdb.IsNoRows(err)) || job.AccountID != *accountUuid {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("job %s does not exist in the account", req.Msg.GetJobId()))
+		}
+	}
+
+	eventTypes := make([]int16, 0, len(req.Msg.GetEventTypes()))
+	for _, eventType := range req.Msg.GetEventTypes() {
+		eventTypes = append(eventTypes, int16(eventType))
+	}
+
+	signingSecret, err := newSigningSecret()
+	if err != nil {
+		return nil, err
+	}
+	storedSigningSecret, err := neosyncdb.EncryptSigningSecret(ctx, s.encryptor, *accountUuid, signingSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.db.Q.CreateNotificationRule(ctx, s.db.Db, db_queries.CreateNotificationRuleParams{
+		AccountID:     *accountUuid,
+		JobID:         jobUuid,
+		Name:          req.Msg.GetName(),
+		Url:           req.Msg.GetUrl(),
+		SigningSecret: storedSigningSecret,
+		EventTypes:    eventTypes,
+		PayloadFormat: int16(req.Msg.GetPayloadFormat()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.CreateNotificationRuleResponse{
+		Rule:          dtomaps.ToNotificationRuleDto(&rule),
+		SigningSecret: signingSecret,
+	}), nil
+}
+
+func (s *Service) GetNotificationRules(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetNotificationRulesRequest],
+) (*connect.Response[mgmtv1alpha1.GetNotificationRulesResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	params := db_queries.GetNotificationRulesByAccountParams{AccountId: *accountUuid}
+	if req.Msg.JobId != nil {
+		params.JobId, err = neosyncdb.ToUuid(req.Msg.GetJobId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rules, err := s.db.Q.GetNotificationRulesByAccount(ctx, s.db.Db, params)
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.NotificationRule, len(rules))
+	for idx := range rules {
+		rule := rules[idx]
+		dtos[idx] = dtomaps.ToNotificationRuleDto(&rule)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetNotificationRulesResponse{
+		Rules: dtos,
+	}), nil
+}
+
+func (s *Service) DeleteNotificationRule(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.DeleteNotificationRuleRequest],
+) (*connect.Response[mgmtv1alpha1.DeleteNotificationRuleResponse], error) {
+	ruleUuid, err := neosyncdb.ToUuid(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	rule, err := s.db.Q.GetNotificationRuleById(ctx, s.db.Db, ruleUuid)
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && neosyncdb.IsNoRows(err) {
+		return connect.NewResponse(&mgmtv1alpha1.DeleteNotificationRuleResponse{}), nil
+	}
+
+	_, err = s.verifyUserInAccount(ctx, neosyncdb.UUIDString(rule.AccountID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Q.RemoveNotificationRuleById(ctx, s.db.Db, ruleUuid)
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.DeleteNotificationRuleResponse{}), nil
+}
+
+func (s *Service) GetNotificationDeliveries(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetNotificationDeliveriesRequest],
+) (*connect.Response[mgmtv1alpha1.GetNotificationDeliveriesResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	params := db_queries.GetNotificationDeliveriesParams{
+		AccountId:  *accountUuid,
+		MaxResults: defaultDeliveryLimit,
+	}
+	if req.Msg.Limit != nil && req.Msg.GetLimit() > 0 {
+		params.MaxResults = int32(req.Msg.GetLimit()) //nolint:gosec
+	}
+	if req.Msg.RuleId != nil {
+		params.RuleId, err = neosyncdb.ToUuid(req.Msg.GetRuleId())
+		if err != nil {
+			return nil, nucleuserrors.NewBadRequest("rule id must be a valid uuid")
+		}
+	}
+	if req.Msg.JobRunId != nil {
+		params.JobRunId = pgtype.Text{String: req.Msg.GetJobRunId(), Valid: true}
+	}
+
+	deliveries, err := s.db.Q.GetNotificationDeliveries(ctx, s.db.Db, params)
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.NotificationDelivery, len(deliveries))
+	for idx := range deliveries {
+		delivery := deliveries[idx]
+		dtos[idx] = dtomaps.ToNotificationDeliveryDto(&delivery)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetNotificationDeliveriesResponse{
+		Deliveries: dtos,
+	}), nil
+}
+
+func (s *Service) PublishJobRunEvent(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.PublishJobRunEventRequest],
+) (*connect.Response[mgmtv1alpha1.PublishJobRunEventResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx).With("jobId", req.Msg.GetJobId(), "jobRunId", req.Msg.GetJobRunId())
+
+	err := s.verifyCanPublishJobRunEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jobUuid, err := neosyncdb.ToUuid(req.Msg.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.db.Q.GetJobById(ctx, s.db.Db, jobUuid)
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && neosyncdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find job by id")
+	}
+
+	if !isWorkerApiKey(ctx) {
+		_, err = s.verifyUserInAccount(ctx, neosyncdb.UUIDString(job.AccountID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rules, err := s.db.Q.GetNotificationRulesForJobEvent(ctx, s.db.Db, db_queries.GetNotificationRulesForJobEventParams{
+		AccountId: job.AccountID,
+		JobId:     jobUuid,
+		EventType: int16(req.Msg.GetEventType()),
+	})
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return connect.NewResponse(&mgmtv1alpha1.PublishJobRunEventResponse{}), nil
+	}
+
+	event := &jobRunEvent{
+		Type:      toEventTypeName(req.Msg.GetEventType()),
+		AccountId: neosyncdb.UUIDString(job.AccountID),
+		JobId:     req.Msg.GetJobId(),
+		JobName:   job.Name,
+		JobRunId:  req.Msg.GetJobRunId(),
+		Error:     req.Msg.ErrorMessage,
+		Timestamp: time.Now().UTC(),
+	}
+
+	mu := sync.Mutex{}
+	deliveries := []*mgmtv1alpha1.NotificationDelivery{}
+	errgrp, errctx := errgroup.WithContext(ctx)
+	errgrp.SetLimit(maxConcurrentDeliveries)
+	for idx := range rules {
+		rule := rules[idx]
+		errgrp.Go(func() error {
+			body, err := buildWebhookPayload(mgmtv1alpha1.NotificationPayloadFormat(rule.PayloadFormat), event)
+			if err != nil {
+				return err
+			}
+			var result *deliveryResult
+			signingSecret, err := neosyncdb.DecryptSigningSecret(errctx, s.encryptor, rule.AccountID, rule.SigningSecret)
+			if err != nil {
+				// the delivery is recorded as failed without being attempted, as it can not be signed
+				errMsg := err.Error()
+				result = &deliveryResult{Error: &errMsg}
+			} else {
+				result = s.deliverWebhook(errctx, &rule, signingSecret, event.Type, body)
+			}
+			if !result.Succeeded {
+				logger.Warn("unable to deliver job run event", "ruleId", neosyncdb.UUIDString(rule.ID), "attempts", result.Attempts, "error", *result.Error)
+			}
+
+			params := db_queries.CreateNotificationDeliveryParams{
+				RuleID:    rule.ID,
+				AccountID: job.AccountID,
+				JobID:     jobUuid,
+				JobRunID:  req.Msg.GetJobRunId(),
+				EventType: int16(req.Msg.GetEventType()),
+				Succeeded: result.Succeeded,
+				Attempts:  result.Attempts,
+			}
+			if result.ResponseStatusCode != nil {
+				params.ResponseStatusCode = pgtype.Int4{Int32: *result.ResponseStatusCode, Valid: true}
+			}
+			if result.Error != nil {
+				params.Error = pgtype.Text{String: *result.Error, Valid: true}
+			}
+			delivery, err := s.db.Q.CreateNotificationDelivery(errctx, s.db.Db, params)
+			if err != nil {
+				return fmt.Errorf("unable to record notification delivery: %w", err)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			deliveries = append(deliveries, dtomaps.ToNotificationDeliveryDto(&delivery))
+			return nil
+		})
+	}
+	if err := errgrp.Wait(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.PublishJobRunEventResponse{
+		Deliveries: deliveries,
+	}), nil
+}
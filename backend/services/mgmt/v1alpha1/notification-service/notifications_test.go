@@ -0,0 +1,387 @@
+package v1alpha1_notificationservice
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	"github.com/nucleuscloud/neosync/backend/internal/envelope"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func Test_Service_CreateNotificationRule(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	encryptor := newTestEncryptor(t)
+	svc := New(&Config{}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService, encryptor)
+
+	accountUuid := newPgUuid(t)
+	jobUuid := newPgUuid(t)
+	mockIsUserInAccount(mockUserAccountService, true)
+	mockQuerier.On("GetJobById", mock.Anything, mock.Anything, jobUuid).
+		Return(db_queries.NeosyncApiJob{ID: jobUuid, AccountID: accountUuid}, nil)
+	var storedSecret *pg_models.NotificationSigningSecret
+	mockQuerier.On("CreateNotificationRule", mock.Anything, mock.Anything, mock.MatchedBy(func(params db_queries.CreateNotificationRuleParams) bool {
+		storedSecret = params.SigningSecret
+		return params.AccountID == accountUuid &&
+			params.JobID == jobUuid &&
+			params.SigningSecret != nil &&
+			assert.ObjectsAreEqual([]int16{3, 4}, params.EventTypes) &&
+			params.PayloadFormat == 2
+	})).Return(func(_ context.Context, _ db_queries.DBTX, params db_queries.CreateNotificationRuleParams) (db_queries.NeosyncApiNotificationRule, error) {
+		return db_queries.NeosyncApiNotificationRule{
+			ID:            newPgUuid(t),
+			AccountID:     params.AccountID,
+			JobID:         params.JobID,
+			Name:          params.Name,
+			Url:           params.Url,
+			SigningSecret: params.SigningSecret,
+			EventTypes:    params.EventTypes,
+			PayloadFormat: params.PayloadFormat,
+		}, nil
+	})
+
+	jobId := neosyncdb.UUIDString(jobUuid)
+	resp, err := svc.CreateNotificationRule(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateNotificationRuleRequest{
+		AccountId: neosyncdb.UUIDString(accountUuid),
+		JobId:     &jobId,
+		Name:      "failures",
+		Url:       "https://hooks.slack.com/services/abc",
+		EventTypes: []mgmtv1alpha1.JobRunEventType{
+			mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_FAILED,
+			mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_CANCELED,
+		},
+		PayloadFormat: mgmtv1alpha1.NotificationPayloadFormat_NOTIFICATION_PAYLOAD_FORMAT_SLACK,
+	}))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.Msg.GetSigningSecret())
+
+	// the secret must only be stored encrypted
+	assert.Nil(t, storedSecret.Value)
+	assert.NotNil(t, storedSecret.Encrypted)
+	decrypted, err := neosyncdb.DecryptSigningSecret(context.Background(), encryptor, accountUuid, storedSecret)
+	assert.NoError(t, err)
+	assert.Equal(t, resp.Msg.GetSigningSecret(), decrypted)
+	assert.Equal(t, jobId, resp.Msg.GetRule().GetJobId())
+	assert.Equal(t, mgmtv1alpha1.NotificationPayloadFormat_NOTIFICATION_PAYLOAD_FORMAT_SLACK, resp.Msg.GetRule().GetPayloadFormat())
+	assert.Len(t, resp.Msg.GetRule().GetEventTypes(), 2)
+}
+
+func Test_Service_CreateNotificationRule_InvalidUrl(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService, nil)
+
+	mockIsUserInAccount(mockUserAccountService, true)
+
+	resp, err := svc.CreateNotificationRule(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreateNotificationRuleRequest{
+		AccountId:  uuid.NewString(),
+		Name:       "failures",
+		Url:        "ftp://example.com/hook",
+		EventTypes: []mgmtv1alpha1.JobRunEventType{mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_FAILED},
+	}))
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func Test_Service_GetNotificationRules_OmitsSigningSecret(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService, nil)
+
+	accountUuid := newPgUuid(t)
+	mockIsUserInAccount(mockUserAccountService, true)
+	mockQuerier.On("GetNotificationRulesByAccount", mock.Anything, mock.Anything, db_queries.GetNotificationRulesByAccountParams{AccountId: accountUuid}).
+		Return([]db_queries.NeosyncApiNotificationRule{
+			{ID: newPgUuid(t), AccountID: accountUuid, Name: "failures", Url: "https://example.com/hook", SigningSecret: plaintextSecret("whsec_hunter2")},
+		}, nil)
+
+	resp, err := svc.GetNotificationRules(context.Background(), connect.NewRequest(&mgmtv1alpha1.GetNotificationRulesRequest{
+		AccountId: neosyncdb.UUIDString(accountUuid),
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.GetRules(), 1)
+
+	bits, err := protojson.Marshal(resp.Msg)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(bits), "whsec_hunter2")
+}
+
+func Test_Service_PublishJobRunEvent(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	encryptor := newTestEncryptor(t)
+	svc := New(&Config{IsAuthEnabled: true, MaxDeliveryAttempts: 2, RetryBackoff: time.Millisecond, AllowPrivateWebhookAddresses: true}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService, encryptor)
+
+	okSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		// the delivery must be signed with the decrypted secret
+		assert.Equal(t, signWebhookPayload("whsec_ok", mustParseInt(t, r.Header.Get(timestampHeader)), body), r.Header.Get(signatureHeader))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer okSrv.Close()
+	failSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failSrv.Close()
+
+	accountUuid := newPgUuid(t)
+	jobUuid := newPgUuid(t)
+	okRuleUuid := newPgUuid(t)
+	failRuleUuid := newPgUuid(t)
+	okSecret, err := neosyncdb.EncryptSigningSecret(context.Background(), encryptor, accountUuid, "whsec_ok")
+	assert.NoError(t, err)
+	mockQuerier.On("GetJobById", mock.Anything, mock.Anything, jobUuid).
+		Return(db_queries.NeosyncApiJob{ID: jobUuid, AccountID: accountUuid, Name: "prod-sync"}, nil)
+	mockQuerier.On("GetNotificationRulesForJobEvent", mock.Anything, mock.Anything, db_queries.GetNotificationRulesForJobEventParams{
+		AccountId: accountUuid,
+		JobId:     jobUuid,
+		EventType: int16(mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_FAILED),
+	}).Return([]db_queries.NeosyncApiNotificationRule{
+		{ID: okRuleUuid, AccountID: accountUuid, Url: okSrv.URL, SigningSecret: okSecret},
+		{ID: failRuleUuid, AccountID: accountUuid, Url: failSrv.URL, SigningSecret: plaintextSecret("whsec_fail")},
+	}, nil)
+	mockQuerier.On("CreateNotificationDelivery", mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ db_queries.DBTX, params db_queries.CreateNotificationDeliveryParams) (db_queries.NeosyncApiNotificationDelivery, error) {
+			return db_queries.NeosyncApiNotificationDelivery{
+				ID:                 newPgUuid(t),
+				RuleID:             params.RuleID,
+				AccountID:          params.AccountID,
+				JobID:              params.JobID,
+				JobRunID:           params.JobRunID,
+				EventType:          params.EventType,
+				Succeeded:          params.Succeeded,
+				Attempts:           params.Attempts,
+				ResponseStatusCode: params.ResponseStatusCode,
+				Error:              params.Error,
+			}, nil
+		})
+
+	errMsg := "sync failed"
+	resp, err := svc.PublishJobRunEvent(workerApiKeyCtx(), connect.NewRequest(&mgmtv1alpha1.PublishJobRunEventRequest{
+		JobId:        neosyncdb.UUIDString(jobUuid),
+		JobRunId:     "prod-sync-2024",
+		EventType:    mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_FAILED,
+		ErrorMessage: &errMsg,
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.GetDeliveries(), 2)
+
+	deliveriesByRule := map[string]*mgmtv1alpha1.NotificationDelivery{}
+	for _, delivery := range resp.Msg.GetDeliveries() {
+		deliveriesByRule[delivery.GetRuleId()] = delivery
+	}
+	okDelivery := deliveriesByRule[neosyncdb.UUIDString(okRuleUuid)]
+	assert.True(t, okDelivery.GetSucceeded())
+	assert.Equal(t, uint32(1), okDelivery.GetAttempts())
+	assert.Equal(t, "prod-sync-2024", okDelivery.GetJobRunId())
+
+	failDelivery := deliveriesByRule[neosyncdb.UUIDString(failRuleUuid)]
+	assert.False(t, failDelivery.GetSucceeded())
+	assert.Equal(t, uint32(2), failDelivery.GetAttempts())
+	assert.Equal(t, int32(http.StatusInternalServerError), failDelivery.GetResponseStatusCode())
+	assert.NotEmpty(t, failDelivery.GetError())
+}
+
+func Test_Service_PublishJobRunEvent_UndecryptableSecret(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	// encryption is not configured, so the stored secret can not be decrypted
+	svc := New(&Config{IsAuthEnabled: true, AllowPrivateWebhookAddresses: true}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService, nil)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("an unsigned webhook must not be delivered")
+	}))
+	defer srv.Close()
+
+	accountUuid := newPgUuid(t)
+	jobUuid := newPgUuid(t)
+	secret, err := neosyncdb.EncryptSigningSecret(context.Background(), newTestEncryptor(t), accountUuid, "whsec_test")
+	assert.NoError(t, err)
+	mockQuerier.On("GetJobById", mock.Anything, mock.Anything, jobUuid).
+		Return(db_queries.NeosyncApiJob{ID: jobUuid, AccountID: accountUuid}, nil)
+	mockQuerier.On("GetNotificationRulesForJobEvent", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiNotificationRule{
+			{ID: newPgUuid(t), AccountID: accountUuid, Url: srv.URL, SigningSecret: secret},
+		}, nil)
+	var recorded db_queries.CreateNotificationDeliveryParams
+	mockQuerier.On("CreateNotificationDelivery", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			recorded = args.Get(2).(db_queries.CreateNotificationDeliveryParams)
+		}).
+		Return(db_queries.NeosyncApiNotificationDelivery{}, nil)
+
+	_, err = svc.PublishJobRunEvent(workerApiKeyCtx(), connect.NewRequest(&mgmtv1alpha1.PublishJobRunEventRequest{
+		JobId:     neosyncdb.UUIDString(jobUuid),
+		JobRunId:  "prod-sync-2024",
+		EventType: mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_STARTED,
+	}))
+	assert.NoError(t, err)
+	assert.False(t, recorded.Succeeded)
+	assert.Equal(t, int32(0), recorded.Attempts)
+	assert.True(t, recorded.Error.Valid)
+}
+
+func Test_Service_PublishJobRunEvent_RequiresWorkerApiKey(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{IsAuthEnabled: true, IsNeosyncCloud: true}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService, nil)
+
+	resp, err := svc.PublishJobRunEvent(accountApiKeyCtx(), connect.NewRequest(&mgmtv1alpha1.PublishJobRunEventRequest{
+		JobId:     uuid.NewString(),
+		JobRunId:  "prod-sync-2024",
+		EventType: mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_STARTED,
+	}))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Nil(t, resp)
+}
+
+func Test_Service_PublishJobRunEvent_AccountApiKey_SelfHosted(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{IsAuthEnabled: true}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService, nil)
+
+	accountUuid := newPgUuid(t)
+	jobUuid := newPgUuid(t)
+	mockUserAccountService.On("IsUserInAccount", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.IsUserInAccountRequest]) bool {
+		return req.Msg.GetAccountId() == neosyncdb.UUIDString(accountUuid)
+	})).Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{Ok: true}), nil)
+	mockQuerier.On("GetJobById", mock.Anything, mock.Anything, jobUuid).
+		Return(db_queries.NeosyncApiJob{ID: jobUuid, AccountID: accountUuid}, nil)
+	mockQuerier.On("GetNotificationRulesForJobEvent", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiNotificationRule{}, nil)
+
+	resp, err := svc.PublishJobRunEvent(accountApiKeyCtx(), connect.NewRequest(&mgmtv1alpha1.PublishJobRunEventRequest{
+		JobId:     neosyncdb.UUIDString(jobUuid),
+		JobRunId:  "prod-sync-2024",
+		EventType: mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_STARTED,
+	}))
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Msg.GetDeliveries())
+}
+
+func Test_Service_PublishJobRunEvent_AccountApiKey_OtherAccount(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{IsAuthEnabled: true}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService, nil)
+
+	jobUuid := newPgUuid(t)
+	mockIsUserInAccount(mockUserAccountService, false)
+	mockQuerier.On("GetJobById", mock.Anything, mock.Anything, jobUuid).
+		Return(db_queries.NeosyncApiJob{ID: jobUuid, AccountID: newPgUuid(t)}, nil)
+
+	resp, err := svc.PublishJobRunEvent(accountApiKeyCtx(), connect.NewRequest(&mgmtv1alpha1.PublishJobRunEventRequest{
+		JobId:     neosyncdb.UUIDString(jobUuid),
+		JobRunId:  "prod-sync-2024",
+		EventType: mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_STARTED,
+	}))
+	assert.Error(t, err)
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+	assert.Nil(t, resp)
+	mockQuerier.AssertNotCalled(t, "GetNotificationRulesForJobEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_Service_PublishJobRunEvent_NoRules(t *testing.T) {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+
+	svc := New(&Config{}, neosyncdb.New(mockDbtx, mockQuerier), mockUserAccountService, nil)
+
+	accountUuid := newPgUuid(t)
+	jobUuid := newPgUuid(t)
+	mockIsUserInAccount(mockUserAccountService, true)
+	mockQuerier.On("GetJobById", mock.Anything, mock.Anything, jobUuid).
+		Return(db_queries.NeosyncApiJob{ID: jobUuid, AccountID: accountUuid}, nil)
+	mockQuerier.On("GetNotificationRulesForJobEvent", mock.Anything, mock.Anything, mock.Anything).
+		Return([]db_queries.NeosyncApiNotificationRule{}, nil)
+
+	resp, err := svc.PublishJobRunEvent(context.Background(), connect.NewRequest(&mgmtv1alpha1.PublishJobRunEventRequest{
+		JobId:     neosyncdb.UUIDString(jobUuid),
+		JobRunId:  "prod-sync-2024",
+		EventType: mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_STARTED,
+	}))
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Msg.GetDeliveries())
+}
+
+func workerApiKeyCtx() context.Context {
+	return context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &auth_apikey.TokenContextData{
+		ApiKeyType: apikey.WorkerApiKey,
+	})
+}
+
+func accountApiKeyCtx() context.Context {
+	return context.WithValue(context.Background(), auth_apikey.TokenContextKey{}, &auth_apikey.TokenContextData{
+		ApiKeyType: apikey.AccountApiKey,
+	})
+}
+
+func newPgUuid(t *testing.T) pgtype.UUID {
+	t.Helper()
+	newuuid := uuid.NewString()
+	val, err := neosyncdb.ToUuid(newuuid)
+	assert.NoError(t, err)
+	return val
+}
+
+func mockIsUserInAccount(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, isInAccount bool) {
+	userAccountServiceMock.On("IsUserInAccount", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+		Ok: isInAccount,
+	}), nil)
+}
+
+func newTestEncryptor(t *testing.T) *envelope.Encryptor {
+	t.Helper()
+	provider, err := envelope.NewLocalKeyProvider("key1", map[string][]byte{
+		"key1": bytes.Repeat([]byte{1}, 32),
+	})
+	assert.NoError(t, err)
+	return envelope.New(provider)
+}
+
+func plaintextSecret(secret string) *pg_models.NotificationSigningSecret {
+	return &pg_models.NotificationSigningSecret{Value: &secret}
+}
+
+func mustParseInt(t *testing.T, value string) int64 {
+	t.Helper()
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	assert.NoError(t, err)
+	return parsed
+}
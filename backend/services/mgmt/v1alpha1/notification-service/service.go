@@ -0,0 +1,58 @@
+package v1alpha1_notificationservice
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/envelope"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+)
+
+const (
+	defaultMaxDeliveryAttempts = 3
+	defaultRetryBackoff        = time.Second
+	webhookRequestTimeout      = 10 * time.Second
+)
+
+type Service struct {
+	cfg                *Config
+	db                 *neosyncdb.NeosyncDb
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient
+	httpclient         *http.Client
+	// encrypts the signing secrets of notification rules. Secrets are stored as plaintext if nil
+	encryptor *envelope.Encryptor
+}
+
+type Config struct {
+	IsAuthEnabled  bool
+	IsNeosyncCloud bool
+	// The number of times a webhook is attempted before the delivery is recorded as failed. Defaults to 3
+	MaxDeliveryAttempts int
+	// The delay before the first retry, doubled for each retry after. Defaults to 1s
+	RetryBackoff time.Duration
+	// Allows webhooks to be delivered to private, loopback, and link-local addresses.
+	// Only intended for self-hosted deployments that deliver to internal services
+	AllowPrivateWebhookAddresses bool
+}
+
+func New(
+	cfg *Config,
+	db *neosyncdb.NeosyncDb,
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient,
+	encryptor *envelope.Encryptor,
+) *Service {
+	if cfg.MaxDeliveryAttempts <= 0 {
+		cfg.MaxDeliveryAttempts = defaultMaxDeliveryAttempts
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = defaultRetryBackoff
+	}
+	return &Service{
+		cfg:                cfg,
+		db:                 db,
+		useraccountService: useraccountService,
+		httpclient:         newWebhookHttpClient(cfg.AllowPrivateWebhookAddresses),
+		encryptor:          encryptor,
+	}
+}
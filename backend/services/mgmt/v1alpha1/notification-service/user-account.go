@@ -0,0 +1,60 @@
+package v1alpha1_notificationservice
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/internal/apikey"
+	auth_apikey "github.com/nucleuscloud/neosync/backend/internal/auth/apikey"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+)
+
+func (s *Service) verifyUserInAccount(
+	ctx context.Context,
+	accountId string,
+) (*pgtype.UUID, error) {
+	resp, err := s.useraccountService.IsUserInAccount(ctx, connect.NewRequest(&mgmtv1alpha1.IsUserInAccountRequest{AccountId: accountId}))
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Msg.Ok {
+		return nil, nucleuserrors.NewForbidden("user in not in requested account")
+	}
+
+	accountUuid, err := neosyncdb.ToUuid(accountId)
+	if err != nil {
+		return nil, err
+	}
+	return &accountUuid, nil
+}
+
+// Job run events describe runs that only the worker executes, so users are not allowed to publish them when auth is enabled.
+// Self hosted workers authenticate with an account api key, which must be authorized in the job's account before an event is published
+func (s *Service) verifyCanPublishJobRunEvents(ctx context.Context) error {
+	if !s.cfg.IsAuthEnabled || isWorkerApiKey(ctx) {
+		return nil
+	}
+	if !s.cfg.IsNeosyncCloud && isAccountApiKey(ctx) {
+		return nil
+	}
+	return nucleuserrors.NewForbidden("job run events may only be published by the worker")
+}
+
+func isWorkerApiKey(ctx context.Context) bool {
+	data, err := auth_apikey.GetTokenDataFromCtx(ctx)
+	if err != nil {
+		return false
+	}
+	return data.ApiKeyType == apikey.WorkerApiKey
+}
+
+func isAccountApiKey(ctx context.Context) bool {
+	data, err := auth_apikey.GetTokenDataFromCtx(ctx)
+	if err != nil {
+		return false
+	}
+	return data.ApiKeyType == apikey.AccountApiKey
+}
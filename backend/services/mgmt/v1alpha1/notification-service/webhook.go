@@ -0,0 +1,199 @@
+package v1alpha1_notificationservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+)
+
+const (
+	// Hex encoded HMAC-SHA256 of "<timestamp>.<body>", keyed with the rule's signing secret
+	signatureHeader = "X-Neosync-Signature"
+	// Unix timestamp of when the delivery was signed
+	timestampHeader = "X-Neosync-Timestamp"
+	eventHeader     = "X-Neosync-Event"
+
+	signingSecretPrefix = "whsec_"
+	signatureVersion    = "v1"
+)
+
+// The JSON document that is delivered for a job run event
+type jobRunEvent struct {
+	Type      string    `json:"type"`
+	AccountId string    `json:"accountId"`
+	JobId     string    `json:"jobId"`
+	JobName   string    `json:"jobName"`
+	JobRunId  string    `json:"jobRunId"`
+	Error     *string   `json:"error,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+type deliveryResult struct {
+	Succeeded          bool
+	Attempts           int32
+	ResponseStatusCode *int32
+	Error              *string
+}
+
+func newSigningSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, secret); err != nil {
+		return "", fmt.Errorf("unable to generate signing secret: %w", err)
+	}
+	return signingSecretPrefix + hex.EncodeToString(secret), nil
+}
+
+func signWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return fmt.Sprintf("%s=%s", signatureVersion, hex.EncodeToString(mac.Sum(nil)))
+}
+
+func toEventTypeName(eventType mgmtv1alpha1.JobRunEventType) string {
+	switch eventType {
+	case mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_STARTED:
+		return "job_run.started"
+	case mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_SUCCEEDED:
+		return "job_run.succeeded"
+	case mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_FAILED:
+		return "job_run.failed"
+	case mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_CANCELED:
+		return "job_run.canceled"
+	default:
+		return "job_run.unknown"
+	}
+}
+
+func buildWebhookPayload(format mgmtv1alpha1.NotificationPayloadFormat, event *jobRunEvent) ([]byte, error) {
+	switch format {
+	case mgmtv1alpha1.NotificationPayloadFormat_NOTIFICATION_PAYLOAD_FORMAT_SLACK:
+		return json.Marshal(&slackMessage{Text: buildSlackText(event)})
+	default:
+		return json.Marshal(event)
+	}
+}
+
+func buildSlackText(event *jobRunEvent) string {
+	var outcome string
+	switch event.Type {
+	case "job_run.started":
+		outcome = ":arrow_forward: started"
+	case "job_run.succeeded":
+		outcome = ":white_check_mark: succeeded"
+	case "job_run.failed":
+		outcome = ":x: failed"
+	case "job_run.canceled":
+		outcome = ":no_entry_sign: was canceled"
+	default:
+		outcome = event.Type
+	}
+	text := fmt.Sprintf("Neosync job *%s* run `%s` %s", event.JobName, event.JobRunId, outcome)
+	if event.Error != nil && *event.Error != "" {
+		text = fmt.Sprintf("%s\n```%s```", text, *event.Error)
+	}
+	return text
+}
+
+// Delivers the payload to the rule's URL, retrying with exponential backoff on network errors, 429s and 5xx responses
+func (s *Service) deliverWebhook(
+	ctx context.Context,
+	rule *db_queries.NeosyncApiNotificationRule,
+	signingSecret string,
+	eventType string,
+	body []byte,
+) *deliveryResult {
+	result := &deliveryResult{}
+	backoff := s.cfg.RetryBackoff
+	for attempt := 1; attempt <= s.cfg.MaxDeliveryAttempts; attempt++ {
+		result.Attempts = int32(attempt) //nolint:gosec
+		statusCode, err := s.sendWebhook(ctx, rule, signingSecret, eventType, body)
+		if statusCode != nil {
+			code := int32(*statusCode) //nolint:gosec
+			result.ResponseStatusCode = &code
+		}
+		if err == nil {
+			result.Succeeded = true
+			result.Error = nil
+			return result
+		}
+		errMsg := err.Error()
+		result.Error = &errMsg
+		if statusCode != nil && !isRetryableStatusCode(*statusCode) {
+			return result
+		}
+		if attempt == s.cfg.MaxDeliveryAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			errMsg := ctx.Err().Error()
+			result.Error = &errMsg
+			return result
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	return result
+}
+
+// Sends the payload once, returning the response status code if a response was received
+func (s *Service) sendWebhook(
+	ctx context.Context,
+	rule *db_queries.NeosyncApiNotificationRule,
+	signingSecret string,
+	eventType string,
+	body []byte,
+) (*int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rule.Url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("unable to build webhook request: %w", err)
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Neosync-Webhooks")
+	req.Header.Set(eventHeader, eventType)
+	req.Header.Set(timestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(signatureHeader, signWebhookPayload(signingSecret, timestamp, body))
+
+	resp, err := s.httpclient.Do(req)
+	if err != nil {
+		// the url is left out of the recorded error, as it may contain a secret that the viewers of deliveries are not allowed to see
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return nil, fmt.Errorf("unable to send webhook: %w", urlErr.Err)
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+	// drains the body so that the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return &resp.StatusCode, nil
+}
+
+func isRetryableStatusCode(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
@@ -0,0 +1,180 @@
+package v1alpha1_notificationservice
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_signWebhookPayload(t *testing.T) {
+	body := []byte(`{"type":"job_run.failed"}`)
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write([]byte("1700000000." + string(body)))
+	expected := "v1=" + hex.EncodeToString(mac.Sum(nil))
+
+	require.Equal(t, expected, signWebhookPayload("whsec_test", 1700000000, body))
+	require.NotEqual(t, expected, signWebhookPayload("whsec_other", 1700000000, body))
+}
+
+func Test_newSigningSecret(t *testing.T) {
+	secret, err := newSigningSecret()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(secret, "whsec_"))
+	other, err := newSigningSecret()
+	require.NoError(t, err)
+	require.NotEqual(t, secret, other)
+}
+
+func Test_buildWebhookPayload(t *testing.T) {
+	errMsg := "connection refused"
+	event := &jobRunEvent{
+		Type:     "job_run.failed",
+		JobId:    "job-id",
+		JobName:  "prod-sync",
+		JobRunId: "prod-sync-2024",
+		Error:    &errMsg,
+	}
+
+	payload, err := buildWebhookPayload(mgmtv1alpha1.NotificationPayloadFormat_NOTIFICATION_PAYLOAD_FORMAT_UNSPECIFIED, event)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(payload, &decoded))
+	require.Equal(t, "job_run.failed", decoded["type"])
+	require.Equal(t, "connection refused", decoded["error"])
+
+	payload, err = buildWebhookPayload(mgmtv1alpha1.NotificationPayloadFormat_NOTIFICATION_PAYLOAD_FORMAT_SLACK, event)
+	require.NoError(t, err)
+	var slack slackMessage
+	require.NoError(t, json.Unmarshal(payload, &slack))
+	require.Equal(t, "Neosync job *prod-sync* run `prod-sync-2024` :x: failed\n```connection refused```", slack.Text)
+}
+
+func Test_deliverWebhook_RetriesServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "job_run.started", r.Header.Get(eventHeader))
+		timestamp := r.Header.Get(timestampHeader)
+		mac := hmac.New(sha256.New, []byte("whsec_test"))
+		mac.Write([]byte(timestamp + "." + string(body)))
+		require.Equal(t, "v1="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get(signatureHeader))
+
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	svc := New(&Config{RetryBackoff: time.Millisecond, AllowPrivateWebhookAddresses: true}, nil, nil, nil)
+	result := svc.deliverWebhook(
+		context.Background(),
+		&db_queries.NeosyncApiNotificationRule{Url: srv.URL},
+		"whsec_test",
+		"job_run.started",
+		[]byte(`{}`),
+	)
+	require.True(t, result.Succeeded)
+	require.Equal(t, int32(3), result.Attempts)
+	require.Equal(t, int32(http.StatusNoContent), *result.ResponseStatusCode)
+	require.Nil(t, result.Error)
+}
+
+func Test_deliverWebhook_DoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	svc := New(&Config{RetryBackoff: time.Millisecond, AllowPrivateWebhookAddresses: true}, nil, nil, nil)
+	result := svc.deliverWebhook(
+		context.Background(),
+		&db_queries.NeosyncApiNotificationRule{Url: srv.URL},
+		"whsec_test",
+		"job_run.started",
+		[]byte(`{}`),
+	)
+	require.False(t, result.Succeeded)
+	require.Equal(t, int32(1), calls.Load())
+	require.Equal(t, int32(1), result.Attempts)
+	require.Equal(t, int32(http.StatusNotFound), *result.ResponseStatusCode)
+	require.Equal(t, "webhook responded with status 404", *result.Error)
+}
+
+func Test_deliverWebhook_RejectsPrivateAddresses(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	svc := New(&Config{RetryBackoff: time.Millisecond}, nil, nil, nil)
+	result := svc.deliverWebhook(
+		context.Background(),
+		&db_queries.NeosyncApiNotificationRule{Url: srv.URL},
+		"whsec_test",
+		"job_run.started",
+		[]byte(`{}`),
+	)
+	require.False(t, result.Succeeded)
+	require.Equal(t, int32(0), calls.Load())
+	require.Nil(t, result.ResponseStatusCode)
+	require.Contains(t, *result.Error, "not a public address")
+	require.NotContains(t, *result.Error, srv.URL)
+}
+
+func Test_deliverWebhook_DoesNotFollowRedirects(t *testing.T) {
+	var redirected atomic.Int32
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer srv.Close()
+
+	svc := New(&Config{RetryBackoff: time.Millisecond, AllowPrivateWebhookAddresses: true}, nil, nil, nil)
+	result := svc.deliverWebhook(
+		context.Background(),
+		&db_queries.NeosyncApiNotificationRule{Url: srv.URL},
+		"whsec_test",
+		"job_run.started",
+		[]byte(`{}`),
+	)
+	require.False(t, result.Succeeded)
+	require.Equal(t, int32(0), redirected.Load())
+	require.Equal(t, int32(http.StatusTemporaryRedirect), *result.ResponseStatusCode)
+}
+
+func Test_isPublicAddress(t *testing.T) {
+	for _, addr := range []string{"93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946"} {
+		require.True(t, isPublicAddress(netip.MustParseAddr(addr)), addr)
+	}
+	for _, addr := range []string{
+		"127.0.0.1", "10.0.0.1", "172.16.0.1", "192.168.1.1", "169.254.169.254", "0.0.0.0", "100.64.0.1",
+		"::1", "fd00::1", "fe80::1", "::ffff:127.0.0.1", "::ffff:169.254.169.254", "64:ff9b::a00:1",
+	} {
+		require.False(t, isPublicAddress(netip.MustParseAddr(addr)), addr)
+	}
+}
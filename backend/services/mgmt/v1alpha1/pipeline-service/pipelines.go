@@ -0,0 +1,367 @@
+package v1alpha1_pipelineservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	pipeline_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/pipeline/workflow"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+func (s *Service) GetPipelines(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetPipelinesRequest],
+) (*connect.Response[mgmtv1alpha1.GetPipelinesResponse], error) {
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	pipelines, err := s.db.Q.GetPipelinesByAccount(ctx, s.db.Db, *accountUuid)
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, err
+	}
+
+	dtos := make([]*mgmtv1alpha1.Pipeline, len(pipelines))
+	for idx := range pipelines {
+		pipeline := pipelines[idx]
+		dtos[idx] = dtomaps.ToPipelineDto(&pipeline)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.GetPipelinesResponse{
+		Pipelines: dtos,
+	}), nil
+}
+
+func (s *Service) GetPipeline(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetPipelineRequest],
+) (*connect.Response[mgmtv1alpha1.GetPipelineResponse], error) {
+	pipeline, err := s.getVerifiedPipeline(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetPipelineResponse{
+		Pipeline: dtomaps.ToPipelineDto(pipeline),
+	}), nil
+}
+
+func (s *Service) CreatePipeline(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CreatePipelineRequest],
+) (*connect.Response[mgmtv1alpha1.CreatePipelineResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("accountId", req.Msg.GetAccountId())
+
+	accountUuid, err := s.verifyUserInAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.db.Q.IsPipelineNameAvailable(ctx, s.db.Db, db_queries.IsPipelineNameAvailableParams{
+		AccountId:    *accountUuid,
+		PipelineName: req.Msg.GetName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, nucleuserrors.NewAlreadyExists(fmt.Sprintf("pipeline with name %s already exists in the account", req.Msg.GetName()))
+	}
+
+	steps, err := s.toPipelineSteps(ctx, *accountUuid, req.Msg.GetSteps())
+	if err != nil {
+		return nil, err
+	}
+	cronText, err := toCronText(req.Msg.CronSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	hasNs, err := s.temporalWfManager.DoesAccountHaveTemporalWorkspace(ctx, req.Msg.GetAccountId(), logger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify account's temporal workspace. error: %w", err)
+	}
+	if !hasNs {
+		return nil, nucleuserrors.NewBadRequest("must first configure temporal namespace in account settings")
+	}
+	tScheduleClient, err := s.temporalWfManager.GetScheduleClientByAccount(ctx, req.Msg.GetAccountId(), logger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build temporal schedule client by account: %w", err)
+	}
+	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, req.Msg.GetAccountId())
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve temporal config by account: %w", err)
+	}
+
+	pipeline, err := s.db.Q.CreatePipeline(ctx, s.db.Db, db_queries.CreatePipelineParams{
+		AccountID:    *accountUuid,
+		Name:         req.Msg.GetName(),
+		Steps:        steps,
+		CronSchedule: cronText,
+		CreatedByID:  *userUuid,
+		UpdatedByID:  *userUuid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create pipeline: %w", err)
+	}
+	pipelineId := neosyncdb.UUIDString(pipeline.ID)
+	logger = logger.With("pipelineId", pipelineId)
+	logger.Info("created pipeline")
+
+	// the schedule is always created so that the pipeline can be triggered. It stays paused until a cron schedule is provided
+	spec, paused := toScheduleSpec(pipeline.CronSchedule)
+	_, err = tScheduleClient.Create(ctx, temporalclient.ScheduleOptions{
+		ID:     pipelineId,
+		Spec:   *spec,
+		Paused: paused,
+		Action: &temporalclient.ScheduleWorkflowAction{
+			Workflow:  pipeline_workflow.PipelineWorkflow,
+			TaskQueue: tconfig.SyncJobQueueName,
+			Args:      []any{toWorkflowRequest(&pipeline)},
+			ID:        pipelineId,
+		},
+	})
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to create pipeline schedule in temporal: %w", err).Error())
+		removeErr := s.db.Q.RemovePipelineById(ctx, s.db.Db, pipeline.ID)
+		if removeErr != nil {
+			return nil, fmt.Errorf("unable to create pipeline schedule and was unable to fully cleanup partially created resources: %w: %w", removeErr, err)
+		}
+		return nil, fmt.Errorf("unable to create pipeline schedule: %w", err)
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.CreatePipelineResponse{
+		Pipeline: dtomaps.ToPipelineDto(&pipeline),
+	}), nil
+}
+
+func (s *Service) UpdatePipeline(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.UpdatePipelineRequest],
+) (*connect.Response[mgmtv1alpha1.UpdatePipelineResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("pipelineId", req.Msg.GetId())
+
+	pipeline, err := s.getVerifiedPipeline(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	accountId := neosyncdb.UUIDString(pipeline.AccountID)
+	userUuid, err := s.getUserUuid(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.GetName() != pipeline.Name {
+		count, err := s.db.Q.IsPipelineNameAvailable(ctx, s.db.Db, db_queries.IsPipelineNameAvailableParams{
+			AccountId:    pipeline.AccountID,
+			PipelineName: req.Msg.GetName(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, nucleuserrors.NewAlreadyExists(fmt.Sprintf("pipeline with name %s already exists in the account", req.Msg.GetName()))
+		}
+	}
+
+	steps, err := s.toPipelineSteps(ctx, pipeline.AccountID, req.Msg.GetSteps())
+	if err != nil {
+		return nil, err
+	}
+	cronText, err := toCronText(req.Msg.CronSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated db_queries.NeosyncApiPipeline
+	if err := s.db.WithTx(ctx, nil, func(dbtx neosyncdb.BaseDBTX) error {
+		updated, err = s.db.Q.UpdatePipeline(ctx, dbtx, db_queries.UpdatePipelineParams{
+			ID:           pipeline.ID,
+			Name:         req.Msg.GetName(),
+			Steps:        steps,
+			CronSchedule: cronText,
+			UpdatedByID:  *userUuid,
+		})
+		if err != nil {
+			return err
+		}
+
+		scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, accountId, req.Msg.GetId(), logger)
+		if err != nil {
+			return err
+		}
+		spec, paused := toScheduleSpec(updated.CronSchedule)
+		err = scheduleHandle.Update(ctx, temporalclient.ScheduleUpdateOptions{
+			DoUpdate: func(schedule temporalclient.ScheduleUpdateInput) (*temporalclient.ScheduleUpdate, error) {
+				action, ok := schedule.Description.Schedule.Action.(*temporalclient.ScheduleWorkflowAction)
+				if !ok {
+					return nil, fmt.Errorf("unable to cast temporal action to *temporalclient.ScheduleWorkflowAction. Type was: %T", schedule.Description.Schedule.Action)
+				}
+				action.Args = []any{toWorkflowRequest(&updated)}
+				schedule.Description.Schedule.Action = action
+				schedule.Description.Schedule.Spec = spec
+				if schedule.Description.Schedule.State == nil {
+					schedule.Description.Schedule.State = &temporalclient.ScheduleState{}
+				}
+				schedule.Description.Schedule.State.Paused = paused
+				return &temporalclient.ScheduleUpdate{
+					Schedule: &schedule.Description.Schedule,
+				}, nil
+			},
+		})
+		if err != nil {
+			logger.Error(fmt.Errorf("unable to update pipeline schedule: %w", err).Error())
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.UpdatePipelineResponse{
+		Pipeline: dtomaps.ToPipelineDto(&updated),
+	}), nil
+}
+
+func (s *Service) DeletePipeline(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.DeletePipelineRequest],
+) (*connect.Response[mgmtv1alpha1.DeletePipelineResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("pipelineId", req.Msg.GetId())
+
+	pipelineUuid, err := neosyncdb.ToUuid(req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	pipeline, err := s.db.Q.GetPipelineById(ctx, s.db.Db, pipelineUuid)
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && neosyncdb.IsNoRows(err) {
+		return connect.NewResponse(&mgmtv1alpha1.DeletePipelineResponse{}), nil
+	}
+	accountId := neosyncdb.UUIDString(pipeline.AccountID)
+	_, err = s.verifyUserInAccount(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("deleting pipeline schedule")
+	scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, accountId, req.Msg.GetId(), logger)
+	if err != nil {
+		return nil, err
+	}
+	err = scheduleHandle.Delete(ctx)
+	if err != nil && !strings.Contains(err.Error(), "schedule not found") {
+		logger.Error(fmt.Errorf("unable to delete pipeline schedule: %w", err).Error())
+		return nil, err
+	}
+
+	logger.Info("deleting pipeline")
+	err = s.db.Q.RemovePipelineById(ctx, s.db.Db, pipeline.ID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.DeletePipelineResponse{}), nil
+}
+
+func (s *Service) getVerifiedPipeline(
+	ctx context.Context,
+	pipelineId string,
+) (*db_queries.NeosyncApiPipeline, error) {
+	pipelineUuid, err := neosyncdb.ToUuid(pipelineId)
+	if err != nil {
+		return nil, err
+	}
+	pipeline, err := s.db.Q.GetPipelineById(ctx, s.db.Db, pipelineUuid)
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && neosyncdb.IsNoRows(err) {
+		return nil, nucleuserrors.NewNotFound("unable to find pipeline by id")
+	}
+	_, err = s.verifyUserInAccount(ctx, neosyncdb.UUIDString(pipeline.AccountID))
+	if err != nil {
+		return nil, err
+	}
+	return &pipeline, nil
+}
+
+// Verifies that every step references a job in the account
+func (s *Service) toPipelineSteps(
+	ctx context.Context,
+	accountUuid pgtype.UUID,
+	dtos []*mgmtv1alpha1.PipelineStep,
+) ([]*pg_models.PipelineStep, error) {
+	jobs, err := s.db.Q.GetJobsByAccount(ctx, s.db.Db, accountUuid)
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, err
+	}
+	accountJobIds := map[string]struct{}{}
+	for idx := range jobs {
+		accountJobIds[neosyncdb.UUIDString(jobs[idx].ID)] = struct{}{}
+	}
+
+	steps := make([]*pg_models.PipelineStep, 0, len(dtos))
+	for idx, dto := range dtos {
+		if _, ok := accountJobIds[dto.GetJobId()]; !ok {
+			return nil, nucleuserrors.NewBadRequest(fmt.Sprintf("job %s of step %d does not exist in the account", dto.GetJobId(), idx+1))
+		}
+		step := &pg_models.PipelineStep{}
+		step.FromDto(dto)
+		steps = append(steps, step)
+	}
+	return steps, nil
+}
+
+func toCronText(cronSchedule *string) (pgtype.Text, error) {
+	cronText := pgtype.Text{}
+	if cronSchedule == nil || *cronSchedule == "" {
+		return cronText, nil
+	}
+	err := cronText.Scan(*cronSchedule)
+	if err != nil {
+		return cronText, err
+	}
+	return cronText, nil
+}
+
+// Returns the schedule spec of the pipeline and whether the schedule should be paused
+func toScheduleSpec(cronSchedule pgtype.Text) (spec *temporalclient.ScheduleSpec, paused bool) {
+	spec = &temporalclient.ScheduleSpec{}
+	schedule := neosyncdb.ToNullableString(cronSchedule)
+	if schedule == nil {
+		return spec, true
+	}
+	spec.CronExpressions = []string{*schedule}
+	return spec, false
+}
+
+func toWorkflowRequest(pipeline *db_queries.NeosyncApiPipeline) *pipeline_workflow.WorkflowRequest {
+	steps := make([]*pipeline_workflow.Step, 0, len(pipeline.Steps))
+	for _, step := range pipeline.Steps {
+		steps = append(steps, &pipeline_workflow.Step{
+			JobId:         step.JobId,
+			FailurePolicy: mgmtv1alpha1.PipelineStepFailurePolicy(step.FailurePolicy),
+		})
+	}
+	return &pipeline_workflow.WorkflowRequest{
+		PipelineId: neosyncdb.UUIDString(pipeline.ID),
+		Steps:      steps,
+	}
+}
@@ -0,0 +1,262 @@
+package v1alpha1_pipelineservice
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	db_queries "github.com/nucleuscloud/neosync/backend/gen/go/db"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
+	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	pipeline_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/pipeline/workflow"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	temporalclient "go.temporal.io/sdk/client"
+	temporalmocks "go.temporal.io/sdk/mocks"
+)
+
+func Test_Service_CreatePipeline(t *testing.T) {
+	m := createServiceMock(t)
+
+	accountUuid := newPgUuid(t)
+	accountId := neosyncdb.UUIDString(accountUuid)
+	userUuid := newPgUuid(t)
+	jobUuid := newPgUuid(t)
+	jobId := neosyncdb.UUIDString(jobUuid)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetUser(m.UserAccountServiceMock, userUuid)
+
+	mockScheduleClient := new(temporalmocks.ScheduleClient)
+	m.QuerierMock.On("IsPipelineNameAvailable", mock.Anything, mock.Anything, db_queries.IsPipelineNameAvailableParams{
+		AccountId:    accountUuid,
+		PipelineName: "nightly",
+	}).Return(int64(0), nil)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, accountUuid).
+		Return([]db_queries.NeosyncApiJob{{ID: jobUuid, AccountID: accountUuid}}, nil)
+	m.TemporalWfManagerMock.On("DoesAccountHaveTemporalWorkspace", mock.Anything, accountId, mock.Anything).Return(true, nil)
+	m.TemporalWfManagerMock.On("GetScheduleClientByAccount", mock.Anything, accountId, mock.Anything).Return(mockScheduleClient, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, accountId).Return(&pg_models.TemporalConfig{
+		Namespace:        "namespace",
+		SyncJobQueueName: "sync-job",
+		Url:              "url",
+	}, nil)
+	pipelineUuid := newPgUuid(t)
+	m.QuerierMock.On("CreatePipeline", mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ db_queries.DBTX, params db_queries.CreatePipelineParams) (db_queries.NeosyncApiPipeline, error) {
+			return db_queries.NeosyncApiPipeline{
+				ID:           pipelineUuid,
+				AccountID:    params.AccountID,
+				Name:         params.Name,
+				Steps:        params.Steps,
+				CronSchedule: params.CronSchedule,
+				CreatedByID:  params.CreatedByID,
+				UpdatedByID:  params.UpdatedByID,
+			}, nil
+		})
+	mockScheduleClient.On("Create", mock.Anything, mock.MatchedBy(func(opts temporalclient.ScheduleOptions) bool {
+		action, ok := opts.Action.(*temporalclient.ScheduleWorkflowAction)
+		if !ok || len(action.Args) != 1 {
+			return false
+		}
+		wfReq, ok := action.Args[0].(*pipeline_workflow.WorkflowRequest)
+		return ok &&
+			opts.ID == neosyncdb.UUIDString(pipelineUuid) &&
+			opts.Paused &&
+			action.TaskQueue == "sync-job" &&
+			len(wfReq.Steps) == 1 &&
+			wfReq.Steps[0].JobId == jobId &&
+			wfReq.Steps[0].FailurePolicy == mgmtv1alpha1.PipelineStepFailurePolicy_PIPELINE_STEP_FAILURE_POLICY_CONTINUE
+	})).Return(new(temporalmocks.ScheduleHandle), nil)
+
+	resp, err := m.Service.CreatePipeline(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreatePipelineRequest{
+		AccountId: accountId,
+		Name:      "nightly",
+		Steps: []*mgmtv1alpha1.PipelineStep{
+			{JobId: jobId, FailurePolicy: mgmtv1alpha1.PipelineStepFailurePolicy_PIPELINE_STEP_FAILURE_POLICY_CONTINUE},
+		},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, neosyncdb.UUIDString(pipelineUuid), resp.Msg.GetPipeline().GetId())
+	require.Len(t, resp.Msg.GetPipeline().GetSteps(), 1)
+	require.Nil(t, resp.Msg.GetPipeline().CronSchedule)
+}
+
+func Test_Service_CreatePipeline_JobNotInAccount(t *testing.T) {
+	m := createServiceMock(t)
+
+	accountUuid := newPgUuid(t)
+	userUuid := newPgUuid(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetUser(m.UserAccountServiceMock, userUuid)
+
+	m.QuerierMock.On("IsPipelineNameAvailable", mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, accountUuid).
+		Return([]db_queries.NeosyncApiJob{{ID: newPgUuid(t), AccountID: accountUuid}}, nil)
+
+	resp, err := m.Service.CreatePipeline(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreatePipelineRequest{
+		AccountId: neosyncdb.UUIDString(accountUuid),
+		Name:      "nightly",
+		Steps:     []*mgmtv1alpha1.PipelineStep{{JobId: uuid.NewString()}},
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_Service_CreatePipeline_NameTaken(t *testing.T) {
+	m := createServiceMock(t)
+
+	accountUuid := newPgUuid(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetUser(m.UserAccountServiceMock, newPgUuid(t))
+
+	m.QuerierMock.On("IsPipelineNameAvailable", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+
+	resp, err := m.Service.CreatePipeline(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreatePipelineRequest{
+		AccountId: neosyncdb.UUIDString(accountUuid),
+		Name:      "nightly",
+		Steps:     []*mgmtv1alpha1.PipelineStep{{JobId: uuid.NewString()}},
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+}
+
+func Test_Service_CreatePipeline_ScheduleFailureRemovesPipeline(t *testing.T) {
+	m := createServiceMock(t)
+
+	accountUuid := newPgUuid(t)
+	accountId := neosyncdb.UUIDString(accountUuid)
+	jobUuid := newPgUuid(t)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+	mockGetUser(m.UserAccountServiceMock, newPgUuid(t))
+
+	mockScheduleClient := new(temporalmocks.ScheduleClient)
+	m.QuerierMock.On("IsPipelineNameAvailable", mock.Anything, mock.Anything, mock.Anything).Return(int64(0), nil)
+	m.QuerierMock.On("GetJobsByAccount", mock.Anything, mock.Anything, accountUuid).
+		Return([]db_queries.NeosyncApiJob{{ID: jobUuid, AccountID: accountUuid}}, nil)
+	m.TemporalWfManagerMock.On("DoesAccountHaveTemporalWorkspace", mock.Anything, accountId, mock.Anything).Return(true, nil)
+	m.TemporalWfManagerMock.On("GetScheduleClientByAccount", mock.Anything, accountId, mock.Anything).Return(mockScheduleClient, nil)
+	m.TemporalWfManagerMock.On("GetTemporalConfigByAccount", mock.Anything, accountId).Return(&pg_models.TemporalConfig{
+		SyncJobQueueName: "sync-job",
+	}, nil)
+	pipelineUuid := newPgUuid(t)
+	m.QuerierMock.On("CreatePipeline", mock.Anything, mock.Anything, mock.Anything).
+		Return(db_queries.NeosyncApiPipeline{ID: pipelineUuid, AccountID: accountUuid}, nil)
+	mockScheduleClient.On("Create", mock.Anything, mock.Anything).Return(nil, errors.New("test: unable to create temporal schedule"))
+	m.QuerierMock.On("RemovePipelineById", mock.Anything, mock.Anything, pipelineUuid).Return(nil)
+
+	resp, err := m.Service.CreatePipeline(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreatePipelineRequest{
+		AccountId: accountId,
+		Name:      "nightly",
+		Steps:     []*mgmtv1alpha1.PipelineStep{{JobId: neosyncdb.UUIDString(jobUuid)}},
+	}))
+	require.Error(t, err)
+	require.Nil(t, resp)
+	m.QuerierMock.AssertCalled(t, "RemovePipelineById", mock.Anything, mock.Anything, pipelineUuid)
+}
+
+func Test_Service_DeletePipeline(t *testing.T) {
+	m := createServiceMock(t)
+
+	accountUuid := newPgUuid(t)
+	pipelineUuid := newPgUuid(t)
+	pipelineId := neosyncdb.UUIDString(pipelineUuid)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	mockHandle := new(temporalmocks.ScheduleHandle)
+	m.QuerierMock.On("GetPipelineById", mock.Anything, mock.Anything, pipelineUuid).
+		Return(db_queries.NeosyncApiPipeline{ID: pipelineUuid, AccountID: accountUuid}, nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, neosyncdb.UUIDString(accountUuid), pipelineId, mock.Anything).
+		Return(mockHandle, nil)
+	mockHandle.On("Delete", mock.Anything).Return(errors.New("schedule not found"))
+	m.QuerierMock.On("RemovePipelineById", mock.Anything, mock.Anything, pipelineUuid).Return(nil)
+
+	resp, err := m.Service.DeletePipeline(context.Background(), connect.NewRequest(&mgmtv1alpha1.DeletePipelineRequest{
+		Id: pipelineId,
+	}))
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+func Test_Service_CreatePipelineRun(t *testing.T) {
+	m := createServiceMock(t)
+
+	accountUuid := newPgUuid(t)
+	pipelineUuid := newPgUuid(t)
+	pipelineId := neosyncdb.UUIDString(pipelineUuid)
+	mockIsUserInAccount(m.UserAccountServiceMock, true)
+
+	mockHandle := new(temporalmocks.ScheduleHandle)
+	m.QuerierMock.On("GetPipelineById", mock.Anything, mock.Anything, pipelineUuid).
+		Return(db_queries.NeosyncApiPipeline{ID: pipelineUuid, AccountID: accountUuid}, nil)
+	m.TemporalWfManagerMock.On("GetScheduleHandleClientByAccount", mock.Anything, neosyncdb.UUIDString(accountUuid), pipelineId, mock.Anything).
+		Return(mockHandle, nil)
+	mockHandle.On("Trigger", mock.Anything, mock.Anything).Return(nil)
+
+	resp, err := m.Service.CreatePipelineRun(context.Background(), connect.NewRequest(&mgmtv1alpha1.CreatePipelineRunRequest{
+		PipelineId: pipelineId,
+	}))
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	mockHandle.AssertCalled(t, "Trigger", mock.Anything, mock.Anything)
+}
+
+func Test_toScheduleSpec(t *testing.T) {
+	spec, paused := toScheduleSpec(pgtype.Text{})
+	require.True(t, paused)
+	require.Empty(t, spec.CronExpressions)
+
+	spec, paused = toScheduleSpec(pgtype.Text{String: "0 2 * * *", Valid: true})
+	require.False(t, paused)
+	require.Equal(t, []string{"0 2 * * *"}, spec.CronExpressions)
+}
+
+type serviceMocks struct {
+	Service                *Service
+	DbtxMock               *neosyncdb.MockDBTX
+	QuerierMock            *db_queries.MockQuerier
+	UserAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient
+	TemporalWfManagerMock  *clientmanager.MockTemporalClientManagerClient
+}
+
+func createServiceMock(t *testing.T) *serviceMocks {
+	mockDbtx := neosyncdb.NewMockDBTX(t)
+	mockQuerier := db_queries.NewMockQuerier(t)
+	mockUserAccountService := mgmtv1alpha1connect.NewMockUserAccountServiceClient(t)
+	mockTemporalWfManager := clientmanager.NewMockTemporalClientManagerClient(t)
+
+	service := New(&Config{}, neosyncdb.New(mockDbtx, mockQuerier), mockTemporalWfManager, mockUserAccountService)
+
+	return &serviceMocks{
+		Service:                service,
+		DbtxMock:               mockDbtx,
+		QuerierMock:            mockQuerier,
+		UserAccountServiceMock: mockUserAccountService,
+		TemporalWfManagerMock:  mockTemporalWfManager,
+	}
+}
+
+func newPgUuid(t *testing.T) pgtype.UUID {
+	t.Helper()
+	newuuid := uuid.NewString()
+	val, err := neosyncdb.ToUuid(newuuid)
+	require.NoError(t, err)
+	return val
+}
+
+func mockIsUserInAccount(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, isInAccount bool) {
+	userAccountServiceMock.On("IsUserInAccount", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+		Ok: isInAccount,
+	}), nil)
+}
+
+func mockGetUser(userAccountServiceMock *mgmtv1alpha1connect.MockUserAccountServiceClient, userUuid pgtype.UUID) {
+	userAccountServiceMock.On("GetUser", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetUserResponse{
+		UserId: neosyncdb.UUIDString(userUuid),
+	}), nil)
+}
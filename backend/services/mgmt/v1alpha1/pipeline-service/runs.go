@@ -0,0 +1,122 @@
+package v1alpha1_pipelineservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	logger_interceptor "github.com/nucleuscloud/neosync/backend/internal/connect/interceptors/logger"
+	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
+	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalclient "go.temporal.io/sdk/client"
+)
+
+func (s *Service) CreatePipelineRun(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CreatePipelineRunRequest],
+) (*connect.Response[mgmtv1alpha1.CreatePipelineRunResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("pipelineId", req.Msg.GetPipelineId())
+
+	pipeline, err := s.getVerifiedPipeline(ctx, req.Msg.GetPipelineId())
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleHandle, err := s.temporalWfManager.GetScheduleHandleClientByAccount(ctx, neosyncdb.UUIDString(pipeline.AccountID), req.Msg.GetPipelineId(), logger)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("creating pipeline run")
+	err = scheduleHandle.Trigger(ctx, temporalclient.ScheduleTriggerOptions{})
+	if err != nil {
+		logger.Error(fmt.Errorf("unable to create pipeline run: %w", err).Error())
+		return nil, err
+	}
+	return connect.NewResponse(&mgmtv1alpha1.CreatePipelineRunResponse{}), nil
+}
+
+func (s *Service) GetPipelineRuns(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetPipelineRunsRequest],
+) (*connect.Response[mgmtv1alpha1.GetPipelineRunsResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("pipelineId", req.Msg.GetPipelineId())
+
+	pipeline, err := s.getVerifiedPipeline(ctx, req.Msg.GetPipelineId())
+	if err != nil {
+		return nil, err
+	}
+	accountId := neosyncdb.UUIDString(pipeline.AccountID)
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, accountId, logger)
+	if err != nil {
+		return nil, err
+	}
+	tconfig, err := s.temporalWfManager.GetTemporalConfigByAccount(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	// pipeline runs are started by the pipeline's schedule, which shares the id of the pipeline
+	query := fmt.Sprintf("TemporalScheduledById = %q", req.Msg.GetPipelineId())
+	executions := []*workflowpb.WorkflowExecutionInfo{}
+	var nextPageToken []byte
+	for hasMore := true; hasMore; hasMore = len(nextPageToken) > 0 {
+		resp, err := tclient.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     tconfig.Namespace,
+			PageSize:      20,
+			NextPageToken: nextPageToken,
+			Query:         query,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve pipeline runs: %w", err)
+		}
+		executions = append(executions, resp.Executions...)
+		nextPageToken = resp.NextPageToken
+	}
+
+	runs := make([]*mgmtv1alpha1.PipelineRun, len(executions))
+	for idx, execution := range executions {
+		runs[idx] = dtomaps.ToPipelineRunDto(execution, logger)
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetPipelineRunsResponse{
+		Runs: runs,
+	}), nil
+}
+
+func (s *Service) CancelPipelineRun(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.CancelPipelineRunRequest],
+) (*connect.Response[mgmtv1alpha1.CancelPipelineRunResponse], error) {
+	logger := logger_interceptor.GetLoggerFromContextOrDefault(ctx)
+	logger = logger.With("pipelineId", req.Msg.GetPipelineId(), "pipelineRunId", req.Msg.GetPipelineRunId())
+
+	pipeline, err := s.getVerifiedPipeline(ctx, req.Msg.GetPipelineId())
+	if err != nil {
+		return nil, err
+	}
+
+	tclient, err := s.temporalWfManager.GetWorkflowClientByAccount(ctx, neosyncdb.UUIDString(pipeline.AccountID), logger)
+	if err != nil {
+		return nil, err
+	}
+	execution, err := tclient.DescribeWorkflowExecution(ctx, req.Msg.GetPipelineRunId(), "")
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve pipeline run: %w", err)
+	}
+	if dtomaps.GetJobIdFromWorkflow(logger, execution.GetWorkflowExecutionInfo().GetSearchAttributes()) != req.Msg.GetPipelineId() {
+		return nil, nucleuserrors.NewNotFound("unable to find pipeline run for the provided pipeline")
+	}
+
+	logger.Info("canceling pipeline run")
+	err = tclient.CancelWorkflow(ctx, req.Msg.GetPipelineRunId(), execution.GetWorkflowExecutionInfo().GetExecution().GetRunId())
+	if err != nil {
+		return nil, fmt.Errorf("unable to cancel pipeline run: %w", err)
+	}
+	return connect.NewResponse(&mgmtv1alpha1.CancelPipelineRunResponse{}), nil
+}
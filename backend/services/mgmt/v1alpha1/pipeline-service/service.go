@@ -0,0 +1,31 @@
+package v1alpha1_pipelineservice
+
+import (
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	clientmanager "github.com/nucleuscloud/neosync/backend/internal/temporal/client-manager"
+)
+
+type Service struct {
+	cfg                *Config
+	db                 *neosyncdb.NeosyncDb
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient
+
+	temporalWfManager clientmanager.TemporalClientManagerClient
+}
+
+type Config struct{}
+
+func New(
+	cfg *Config,
+	db *neosyncdb.NeosyncDb,
+	temporalWfManager clientmanager.TemporalClientManagerClient,
+	useraccountService mgmtv1alpha1connect.UserAccountServiceClient,
+) *Service {
+	return &Service{
+		cfg:                cfg,
+		db:                 db,
+		temporalWfManager:  temporalWfManager,
+		useraccountService: useraccountService,
+	}
+}
@@ -83,7 +83,7 @@ var (
 		},
 		{
 			Name:              "Generate City",
-			Description:       "Randomly selects a city from a list of predfined US cities.",
+			Description:       "Randomly selects a city from the dataset of the locale, which defaults to en_US.",
 			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
 			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
 			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
@@ -156,7 +156,7 @@ var (
 		},
 		{
 			Name:              "Generate Full Address",
-			Description:       "Randomly generates a street address in the format: {street_num} {street_addresss} {street_descriptor} {city}, {state} {zipcode}. For example, 123 Main Street Boston, Massachusetts 02169.",
+			Description:       "Randomly generates a full address from the dataset of the locale, which defaults to en_US. For en_US, addresses are in the format: {street_num} {street_addresss} {street_descriptor} {city}, {state} {zipcode}. For example, 123 Main Street Boston, Massachusetts 02169.",
 			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
 			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
 			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
@@ -197,7 +197,7 @@ var (
 		},
 		{
 			Name:              "Generate Int64 Phone Number",
-			Description:       "Generates a new phone number with a default length of 10.",
+			Description:       "Generates a new phone number with a default length of 10. Uses the national numbering plan of the locale when one is provided.",
 			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_INT64,
 			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_INT64, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
 			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
@@ -266,7 +266,7 @@ var (
 		},
 		{
 			Name:              "Generate State",
-			Description:       "Randomly selects a US state.",
+			Description:       "Randomly selects a state from the dataset of the locale, which defaults to en_US.",
 			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
 			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
 			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
@@ -281,7 +281,7 @@ var (
 		},
 		{
 			Name:              "Generate Street Address",
-			Description:       "Randomly generates a street address in the format: {street_num} {street_addresss} {street_descriptor}. For example, 123 Main Street.",
+			Description:       "Randomly generates a street address from the dataset of the locale, which defaults to en_US. For en_US, street addresses are in the format: {street_num} {street_addresss} {street_descriptor}. For example, 123 Main Street.",
 			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
 			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
 			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
@@ -380,7 +380,7 @@ var (
 		},
 		{
 			Name:              "Generate Zipcode",
-			Description:       "Randomly selects a zip code from a list of predefined US zipcodes.",
+			Description:       "Randomly selects a zip code from the dataset of the locale, which defaults to en_US.",
 			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
 			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
 			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_GENERATE, mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
@@ -625,6 +625,21 @@ var (
 				},
 			},
 		},
+		{
+			Name:              "Transform Format Preserving Encryption",
+			Description:       "Reversibly encrypts a string value using FF3-1 format-preserving encryption with an account-specific key. The output has the same length and is made up of the same alphabet as the input, and can be decrypted by authorized users through the anonymization API.",
+			DataType:          mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING,
+			DataTypes:         []mgmtv1alpha1.TransformerDataType{mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_STRING, mgmtv1alpha1.TransformerDataType_TRANSFORMER_DATA_TYPE_NULL},
+			SupportedJobTypes: []mgmtv1alpha1.SupportedJobType{mgmtv1alpha1.SupportedJobType_SUPPORTED_JOB_TYPE_SYNC},
+			Source:            mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FPE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformFpeConfig{
+					TransformFpeConfig: &mgmtv1alpha1.TransformFpe{
+						Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_ALPHANUMERIC,
+					},
+				},
+			},
+		},
 	}
 
 	systemTransformerSourceMap = map[mgmtv1alpha1.TransformerSource]*mgmtv1alpha1.SystemTransformer{}
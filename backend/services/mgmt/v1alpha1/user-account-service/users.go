@@ -19,6 +19,7 @@ import (
 	"github.com/nucleuscloud/neosync/backend/internal/dtomaps"
 	nucleuserrors "github.com/nucleuscloud/neosync/backend/internal/errors"
 	"github.com/nucleuscloud/neosync/backend/internal/neosyncdb"
+	"github.com/nucleuscloud/neosync/backend/internal/rbac"
 	"github.com/nucleuscloud/neosync/backend/internal/version"
 	"github.com/nucleuscloud/neosync/internal/billing"
 	"github.com/stripe/stripe-go/v79"
@@ -196,13 +197,13 @@ func (s *Service) ConvertPersonalToTeamAccount(
 		}
 	}
 
-	personalAccountUuid, err := neosyncdb.ToUuid(personalAccountId)
+	personalAccountUuid, err := s.verifyUserInAccount(ctx, personalAccountId)
 	if err != nil {
 		return nil, err
 	}
 	resp, err := s.db.ConvertPersonalToTeamAccount(ctx, &neosyncdb.ConvertPersonalToTeamAccountRequest{
 		UserId:            userId,
-		PersonalAccountId: personalAccountUuid,
+		PersonalAccountId: *personalAccountUuid,
 		TeamName:          req.Msg.GetName(),
 	}, logger)
 	if err != nil {
@@ -285,22 +286,55 @@ func (s *Service) IsUserInAccount(
 		return nil, err
 	}
 	if apiKeyCount > 0 {
+		role := getApiKeyRole(ctx)
+		if err := authorizeRole(ctx, req.Msg.AccountId, role); err != nil {
+			return nil, err
+		}
 		return connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
-			Ok: apiKeyCount > 0,
+			Ok:   true,
+			Role: role,
 		}), nil
 	}
-	count, err := s.db.Q.IsUserInAccount(ctx, s.db.Db, db_queries.IsUserInAccountParams{
+	association, err := s.db.Q.GetAccountUserAssociation(ctx, s.db.Db, db_queries.GetAccountUserAssociationParams{
 		AccountId: accountId,
 		UserId:    userId,
 	})
-	if err != nil {
+	if err != nil && !neosyncdb.IsNoRows(err) {
+		return nil, err
+	} else if err != nil && neosyncdb.IsNoRows(err) {
+		return connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
+			Ok: false,
+		}), nil
+	}
+	role := mgmtv1alpha1.AccountRole(association.Role)
+	if err := authorizeRole(ctx, req.Msg.AccountId, role); err != nil {
 		return nil, err
 	}
 	return connect.NewResponse(&mgmtv1alpha1.IsUserInAccountResponse{
-		Ok: count > 0,
+		Ok:   true,
+		Role: role,
 	}), nil
 }
 
+// Account api keys without scopes have full access to their account.
+// Scoped api keys may only manage connections, and view their credentials, if they have been granted the connections:write scope
+func getApiKeyRole(ctx context.Context) mgmtv1alpha1.AccountRole {
+	data, err := auth_apikey.GetTokenDataFromCtx(ctx)
+	if err != nil || data.ApiKey == nil || auth_apikey.HasScope(data.ApiKey.Scopes, auth_apikey.ScopeConnectionsWrite) {
+		return mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_ADMIN
+	}
+	return mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_JOB_DEVELOPER
+}
+
+// Enforces the permission required by the current procedure, if one has been attached to the context
+func authorizeRole(ctx context.Context, accountId string, role mgmtv1alpha1.AccountRole) error {
+	authz, ok := rbac.GetAuthorization(ctx)
+	if !ok {
+		return nil
+	}
+	return authz.Authorize(accountId, role)
+}
+
 func (s *Service) CreateTeamAccount(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.CreateTeamAccountRequest],
@@ -414,6 +448,14 @@ func (s *Service) GetTeamAccountMembers(
 	if err != nil {
 		return nil, err
 	}
+	associations, err := s.db.Q.GetAccountUserAssociations(ctx, s.db.Db, *accountId)
+	if err != nil {
+		return nil, err
+	}
+	userRoles := make(map[string]mgmtv1alpha1.AccountRole, len(associations))
+	for _, association := range associations {
+		userRoles[neosyncdb.UUIDString(association.UserID)] = mgmtv1alpha1.AccountRole(association.Role)
+	}
 
 	dtoUsers := make([]*mgmtv1alpha1.AccountUser, len(userIdentities))
 	group := new(errgroup.Group)
@@ -422,7 +464,8 @@ func (s *Service) GetTeamAccountMembers(
 		user := userIdentities[i]
 		group.Go(func() error {
 			dtoUsers[i] = &mgmtv1alpha1.AccountUser{
-				Id: neosyncdb.UUIDString(user.UserID),
+				Id:   neosyncdb.UUIDString(user.UserID),
+				Role: userRoles[neosyncdb.UUIDString(user.UserID)],
 			}
 			if user.ProviderSub == "" {
 				logger.Warn(fmt.Sprintf("unable to find provider sub associated with user id: %q", neosyncdb.UUIDString(user.UserID)))
@@ -466,10 +509,7 @@ func (s *Service) RemoveTeamAccountMember(
 	if err != nil {
 		return nil, err
 	}
-	err = s.db.Q.RemoveAccountUser(ctx, s.db.Db, db_queries.RemoveAccountUserParams{
-		AccountId: *accountId,
-		UserId:    memberUserId,
-	})
+	err = s.db.RemoveAccountUser(ctx, *accountId, memberUserId)
 	if err != nil && !neosyncdb.IsNoRows(err) {
 		return nil, err
 	}
@@ -477,6 +517,54 @@ func (s *Service) RemoveTeamAccountMember(
 	return connect.NewResponse(&mgmtv1alpha1.RemoveTeamAccountMemberResponse{}), nil
 }
 
+func (s *Service) SetTeamAccountMemberRole(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.SetTeamAccountMemberRoleRequest],
+) (*connect.Response[mgmtv1alpha1.SetTeamAccountMemberRoleResponse], error) {
+	if req.Msg.GetRole() == mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED {
+		return nil, nucleuserrors.NewBadRequest("must provide a valid role")
+	}
+	accountId, err := s.verifyUserInAccount(ctx, req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifyTeamAccount(ctx, *accountId); err != nil {
+		return nil, err
+	}
+	memberUserId, err := neosyncdb.ToUuid(req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	association, err := s.db.SetAccountUserRole(ctx, *accountId, memberUserId, neosyncdb.AccountRole(req.Msg.GetRole()))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&mgmtv1alpha1.SetTeamAccountMemberRoleResponse{
+		User: &mgmtv1alpha1.AccountUser{
+			Id:   neosyncdb.UUIDString(association.UserID),
+			Role: mgmtv1alpha1.AccountRole(association.Role),
+		},
+	}), nil
+}
+
+func (s *Service) GetAccountRole(
+	ctx context.Context,
+	req *connect.Request[mgmtv1alpha1.GetAccountRoleRequest],
+) (*connect.Response[mgmtv1alpha1.GetAccountRoleResponse], error) {
+	resp, err := s.IsUserInAccount(ctx, connect.NewRequest(&mgmtv1alpha1.IsUserInAccountRequest{AccountId: req.Msg.AccountId}))
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Msg.Ok {
+		return nil, nucleuserrors.NewForbidden("user in not in requested account")
+	}
+	return connect.NewResponse(&mgmtv1alpha1.GetAccountRoleResponse{
+		Role: resp.Msg.Role,
+	}), nil
+}
+
 func (s *Service) InviteUserToTeamAccount(
 	ctx context.Context,
 	req *connect.Request[mgmtv1alpha1.InviteUserToTeamAccountRequest],
@@ -505,7 +593,12 @@ func (s *Service) InviteUserToTeamAccount(
 		return nil, err
 	}
 
-	invite, err := s.db.CreateTeamAccountInvite(ctx, *accountId, userId, req.Msg.Email, expiresAt)
+	role := mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_VIEWER
+	if req.Msg.Role != nil && req.Msg.GetRole() != mgmtv1alpha1.AccountRole_ACCOUNT_ROLE_UNSPECIFIED {
+		role = req.Msg.GetRole()
+	}
+
+	invite, err := s.db.CreateTeamAccountInvite(ctx, *accountId, userId, req.Msg.Email, expiresAt, neosyncdb.AccountRole(role))
 	if err != nil {
 		return nil, err
 	}
@@ -4,8 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ConnectionConfig struct {
@@ -18,9 +20,63 @@ type ConnectionConfig struct {
 	GcpCloudStorageConfig *GcpCloudStorageConfig          `json:"gcpCloudStorageConfig,omitempty"`
 	DynamoDBConfig        *DynamoDBConfig                 `json:"dynamoDBConfig,omitempty"`
 	MssqlConfig           *MssqlConfig                    `json:"mssqlConfig,omitempty"`
+	KafkaConfig           *KafkaConnectionConfig          `json:"kafkaConfig,omitempty"`
+	SqliteConfig          *SqliteConnectionConfig         `json:"sqliteConfig,omitempty"`
+
+	// When set, the full config has been encrypted and the fields above only indicate the connection type
+	Encrypted *EncryptedConnectionConfig `json:"encrypted,omitempty"`
+}
+
+type EncryptedConnectionConfig struct {
+	KeyId            string `json:"keyId"`
+	EncryptedDataKey []byte `json:"encryptedDataKey"`
+	Ciphertext       []byte `json:"ciphertext"`
+}
+
+// Returns a copy of the config that only retains which connection type is set.
+// Stored alongside encrypted configs so that the connection type can still be determined without decrypting.
+func (c *ConnectionConfig) TypeOnly() *ConnectionConfig {
+	typeOnly := &ConnectionConfig{}
+	if c.PgConfig != nil {
+		typeOnly.PgConfig = &PostgresConnectionConfig{}
+	}
+	if c.AwsS3Config != nil {
+		typeOnly.AwsS3Config = &AwsS3ConnectionConfig{}
+	}
+	if c.MysqlConfig != nil {
+		typeOnly.MysqlConfig = &MysqlConnectionConfig{}
+	}
+	if c.LocalDirectoryConfig != nil {
+		typeOnly.LocalDirectoryConfig = &LocalDirectoryConnectionConfig{}
+	}
+	if c.OpenAiConfig != nil {
+		typeOnly.OpenAiConfig = &OpenAiConnectionConfig{}
+	}
+	if c.MongoConfig != nil {
+		typeOnly.MongoConfig = &MongoConnectionConfig{}
+	}
+	if c.GcpCloudStorageConfig != nil {
+		typeOnly.GcpCloudStorageConfig = &GcpCloudStorageConfig{}
+	}
+	if c.DynamoDBConfig != nil {
+		typeOnly.DynamoDBConfig = &DynamoDBConfig{}
+	}
+	if c.MssqlConfig != nil {
+		typeOnly.MssqlConfig = &MssqlConfig{}
+	}
+	if c.KafkaConfig != nil {
+		typeOnly.KafkaConfig = &KafkaConnectionConfig{}
+	}
+	if c.SqliteConfig != nil {
+		typeOnly.SqliteConfig = &SqliteConnectionConfig{}
+	}
+	return typeOnly
 }
 
 func (c *ConnectionConfig) ToDto() (*mgmtv1alpha1.ConnectionConfig, error) {
+	if c.Encrypted != nil {
+		return nil, errors.New("connection config is encrypted and must be decrypted before converting to dto")
+	}
 	if c.PgConfig != nil {
 		var tunnel *mgmtv1alpha1.SSHTunnel
 		if c.PgConfig.SSHTunnel != nil {
@@ -167,6 +223,18 @@ func (c *ConnectionConfig) ToDto() (*mgmtv1alpha1.ConnectionConfig, error) {
 				MssqlConfig: mdto,
 			},
 		}, nil
+	} else if c.KafkaConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_KafkaConfig{
+				KafkaConfig: c.KafkaConfig.ToDto(),
+			},
+		}, nil
+	} else if c.SqliteConfig != nil {
+		return &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+				SqliteConfig: c.SqliteConfig.ToDto(),
+			},
+		}, nil
 	}
 	return nil, errors.ErrUnsupported
 }
@@ -266,6 +334,15 @@ func (c *ConnectionConfig) FromDto(dto *mgmtv1alpha1.ConnectionConfig) error {
 		if err != nil {
 			return err
 		}
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		c.KafkaConfig = &KafkaConnectionConfig{}
+		err := c.KafkaConfig.FromDto(config.KafkaConfig)
+		if err != nil {
+			return err
+		}
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		c.SqliteConfig = &SqliteConnectionConfig{}
+		c.SqliteConfig.FromDto(config.SqliteConfig)
 	default:
 		return fmt.Errorf("unable to convert to ConnectionConfig from DTO ConnectionConfig, type not supported: %T", config)
 	}
@@ -342,6 +419,33 @@ func (g *GcpCloudStorageConfig) FromDto(dto *mgmtv1alpha1.GcpCloudStorageConnect
 	return nil
 }
 
+type SqliteConnectionConfig struct {
+	Path              string             `json:"path"`
+	ConnectionOptions *ConnectionOptions `json:"connectionOptions,omitempty"`
+}
+
+func (s *SqliteConnectionConfig) ToDto() *mgmtv1alpha1.SqliteConnectionConfig {
+	var connectionOptions *mgmtv1alpha1.SqlConnectionOptions
+	if s.ConnectionOptions != nil {
+		connectionOptions = s.ConnectionOptions.ToDto()
+	}
+	return &mgmtv1alpha1.SqliteConnectionConfig{
+		Path:              s.Path,
+		ConnectionOptions: connectionOptions,
+	}
+}
+
+func (s *SqliteConnectionConfig) FromDto(dto *mgmtv1alpha1.SqliteConnectionConfig) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.SqliteConnectionConfig{}
+	}
+	s.Path = dto.GetPath()
+	if dto.GetConnectionOptions() != nil {
+		s.ConnectionOptions = &ConnectionOptions{}
+		s.ConnectionOptions.FromDto(dto.GetConnectionOptions())
+	}
+}
+
 type MssqlConfig struct {
 	Url               *string            `json:"url,omitempty"`
 	ConnectionOptions *ConnectionOptions `json:"connectionOptions,omitempty"`
@@ -422,6 +526,63 @@ func (d *DynamoDBConfig) FromDto(dto *mgmtv1alpha1.DynamoDBConnectionConfig) err
 	return nil
 }
 
+type KafkaConnectionConfig struct {
+	Brokers   []string         `json:"brokers"`
+	Sasl      *KafkaSaslConfig `json:"sasl,omitempty"`
+	EnableTls bool             `json:"enableTls,omitempty"`
+	ClientTls *ClientTls       `json:"clientTls,omitempty"`
+}
+
+type KafkaSaslConfig struct {
+	Mechanism int32  `json:"mechanism"`
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+}
+
+func (k *KafkaConnectionConfig) ToDto() *mgmtv1alpha1.KafkaConnectionConfig {
+	var sasl *mgmtv1alpha1.KafkaSaslConfig
+	if k.Sasl != nil {
+		sasl = &mgmtv1alpha1.KafkaSaslConfig{
+			Mechanism: mgmtv1alpha1.KafkaSaslMechanism(k.Sasl.Mechanism),
+			Username:  k.Sasl.Username,
+			Password:  k.Sasl.Password,
+		}
+	}
+	var clienttls *mgmtv1alpha1.ClientTlsConfig
+	if k.ClientTls != nil {
+		clienttls = k.ClientTls.ToDto()
+	}
+	return &mgmtv1alpha1.KafkaConnectionConfig{
+		Brokers:   k.Brokers,
+		Sasl:      sasl,
+		EnableTls: k.EnableTls,
+		ClientTls: clienttls,
+	}
+}
+
+func (k *KafkaConnectionConfig) FromDto(dto *mgmtv1alpha1.KafkaConnectionConfig) error {
+	if dto == nil {
+		return errors.New("kafka connection config dto was nil")
+	}
+	if len(dto.GetBrokers()) == 0 {
+		return errors.New("kafka connection config dto must contain at least one broker")
+	}
+	k.Brokers = dto.GetBrokers()
+	if dto.GetSasl() != nil {
+		k.Sasl = &KafkaSaslConfig{
+			Mechanism: int32(dto.GetSasl().GetMechanism()),
+			Username:  dto.GetSasl().GetUsername(),
+			Password:  dto.GetSasl().GetPassword(),
+		}
+	}
+	k.EnableTls = dto.GetEnableTls()
+	if dto.GetClientTls() != nil {
+		k.ClientTls = &ClientTls{}
+		k.ClientTls.FromDto(dto.GetClientTls())
+	}
+	return nil
+}
+
 type PostgresConnectionConfig struct {
 	Connection        *PostgresConnection `json:"connection,omitempty"`
 	Url               *string             `json:"url,omitempty"`
@@ -767,6 +928,10 @@ type JobSourceOptions struct {
 	MongoDbOptions    *MongoDbSourceOptions    `json:"mongoOptions,omitempty"`
 	DynamoDBOptions   *DynamoDBSourceOptions   `json:"dynamoDBOptions,omitempty"`
 	MssqlOptions      *MssqlSourceOptions      `json:"mssqlOptions,omitempty"`
+	KafkaOptions      *KafkaSourceOptions      `json:"kafkaOptions,omitempty"`
+	SqliteOptions     *SqliteSourceOptions     `json:"sqliteOptions,omitempty"`
+
+	LocalDirectoryOptions *LocalDirectorySourceOptions `json:"localDirectoryOptions,omitempty"`
 }
 
 type MssqlSourceOptions struct {
@@ -837,14 +1002,16 @@ func FromDtoMssqlSourceTableOption(dtos []*mgmtv1alpha1.MssqlSourceTableOption)
 }
 
 type MssqlSourceTableOption struct {
-	Table       string  `json:"table"`
-	WhereClause *string `json:"whereClause,omitempty"`
+	Table           string  `json:"table"`
+	WhereClause     *string `json:"whereClause,omitempty"`
+	WatermarkColumn *string `json:"watermarkColumn,omitempty"`
 }
 
 func (m *MssqlSourceTableOption) ToDto() *mgmtv1alpha1.MssqlSourceTableOption {
 	return &mgmtv1alpha1.MssqlSourceTableOption{
-		Table:       m.Table,
-		WhereClause: m.WhereClause,
+		Table:           m.Table,
+		WhereClause:     m.WhereClause,
+		WatermarkColumn: m.WatermarkColumn,
 	}
 }
 func (m *MssqlSourceTableOption) FromDto(dto *mgmtv1alpha1.MssqlSourceTableOption) {
@@ -853,6 +1020,93 @@ func (m *MssqlSourceTableOption) FromDto(dto *mgmtv1alpha1.MssqlSourceTableOptio
 	}
 	m.Table = dto.GetTable()
 	m.WhereClause = dto.WhereClause
+	m.WatermarkColumn = dto.WatermarkColumn
+}
+
+type SqliteSourceOptions struct {
+	HaltOnNewColumnAddition       bool                        `json:"haltOnNewColumnAddition"`
+	SubsetByForeignKeyConstraints bool                        `json:"subsetByForeignKeyConstraints"`
+	Schemas                       []*SqliteSourceSchemaOption `json:"schemas"`
+	ConnectionId                  string                      `json:"connectionId"`
+}
+
+func (s *SqliteSourceOptions) ToDto() *mgmtv1alpha1.SqliteSourceConnectionOptions {
+	dto := &mgmtv1alpha1.SqliteSourceConnectionOptions{
+		HaltOnNewColumnAddition:       s.HaltOnNewColumnAddition,
+		ConnectionId:                  s.ConnectionId,
+		Schemas:                       make([]*mgmtv1alpha1.SqliteSourceSchemaOption, len(s.Schemas)),
+		SubsetByForeignKeyConstraints: s.SubsetByForeignKeyConstraints,
+	}
+	for idx := range s.Schemas {
+		dto.Schemas[idx] = s.Schemas[idx].ToDto()
+	}
+	return dto
+}
+func (s *SqliteSourceOptions) FromDto(dto *mgmtv1alpha1.SqliteSourceConnectionOptions) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.SqliteSourceConnectionOptions{}
+	}
+	s.HaltOnNewColumnAddition = dto.GetHaltOnNewColumnAddition()
+	s.ConnectionId = dto.GetConnectionId()
+	s.SubsetByForeignKeyConstraints = dto.GetSubsetByForeignKeyConstraints()
+	s.Schemas = FromDtoSqliteSourceSchemaOptions(dto.GetSchemas())
+}
+
+type SqliteSourceSchemaOption struct {
+	Schema string                     `json:"schema"`
+	Tables []*SqliteSourceTableOption `json:"tables"`
+}
+
+func (s *SqliteSourceSchemaOption) ToDto() *mgmtv1alpha1.SqliteSourceSchemaOption {
+	dto := &mgmtv1alpha1.SqliteSourceSchemaOption{
+		Schema: s.Schema,
+		Tables: make([]*mgmtv1alpha1.SqliteSourceTableOption, 0, len(s.Tables)),
+	}
+	for _, table := range s.Tables {
+		dto.Tables = append(dto.Tables, table.ToDto())
+	}
+	return dto
+}
+func (s *SqliteSourceSchemaOption) FromDto(dto *mgmtv1alpha1.SqliteSourceSchemaOption) {
+	s.Schema = dto.GetSchema()
+	s.Tables = FromDtoSqliteSourceTableOption(dto.GetTables())
+}
+
+func FromDtoSqliteSourceSchemaOptions(dtos []*mgmtv1alpha1.SqliteSourceSchemaOption) []*SqliteSourceSchemaOption {
+	output := make([]*SqliteSourceSchemaOption, len(dtos))
+	for idx := range dtos {
+		output[idx] = &SqliteSourceSchemaOption{}
+		output[idx].FromDto(dtos[idx])
+	}
+	return output
+}
+
+func FromDtoSqliteSourceTableOption(dtos []*mgmtv1alpha1.SqliteSourceTableOption) []*SqliteSourceTableOption {
+	output := make([]*SqliteSourceTableOption, len(dtos))
+	for idx := range dtos {
+		output[idx] = &SqliteSourceTableOption{}
+		output[idx].FromDto(dtos[idx])
+	}
+	return output
+}
+
+type SqliteSourceTableOption struct {
+	Table       string  `json:"table"`
+	WhereClause *string `json:"whereClause,omitempty"`
+}
+
+func (s *SqliteSourceTableOption) ToDto() *mgmtv1alpha1.SqliteSourceTableOption {
+	return &mgmtv1alpha1.SqliteSourceTableOption{
+		Table:       s.Table,
+		WhereClause: s.WhereClause,
+	}
+}
+func (s *SqliteSourceTableOption) FromDto(dto *mgmtv1alpha1.SqliteSourceTableOption) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.SqliteSourceTableOption{}
+	}
+	s.Table = dto.GetTable()
+	s.WhereClause = dto.WhereClause
 }
 
 type DynamoDBSourceOptions struct {
@@ -982,12 +1236,18 @@ func (s *DynamoDBSourceOptions) FromDto(dto *mgmtv1alpha1.DynamoDBSourceConnecti
 }
 
 type MongoDbSourceOptions struct {
-	ConnectionId string `json:"connectionId"`
+	ConnectionId string                           `json:"connectionId"`
+	Collections  []*MongoDbSourceCollectionOption `json:"collections,omitempty"`
 }
 
 func (s *MongoDbSourceOptions) ToDto() *mgmtv1alpha1.MongoDBSourceConnectionOptions {
+	collections := make([]*mgmtv1alpha1.MongoDBSourceCollectionOption, len(s.Collections))
+	for i, c := range s.Collections {
+		collections[i] = c.ToDto()
+	}
 	return &mgmtv1alpha1.MongoDBSourceConnectionOptions{
 		ConnectionId: s.ConnectionId,
+		Collections:  collections,
 	}
 }
 
@@ -996,6 +1256,184 @@ func (s *MongoDbSourceOptions) FromDto(dto *mgmtv1alpha1.MongoDBSourceConnection
 		dto = &mgmtv1alpha1.MongoDBSourceConnectionOptions{}
 	}
 	s.ConnectionId = dto.GetConnectionId()
+	s.Collections = FromDtoMongoDbSourceCollectionOptions(dto.GetCollections())
+}
+
+type MongoDbSourceCollectionOption struct {
+	Database   string                        `json:"database"`
+	Collection string                        `json:"collection"`
+	Filter     *string                       `json:"filter,omitempty"`
+	Limit      *uint32                       `json:"limit,omitempty"`
+	References []*MongoDbCollectionReference `json:"references,omitempty"`
+}
+
+func (s *MongoDbSourceCollectionOption) ToDto() *mgmtv1alpha1.MongoDBSourceCollectionOption {
+	references := make([]*mgmtv1alpha1.MongoDBCollectionReference, len(s.References))
+	for i, r := range s.References {
+		references[i] = r.ToDto()
+	}
+	return &mgmtv1alpha1.MongoDBSourceCollectionOption{
+		Database:   s.Database,
+		Collection: s.Collection,
+		Filter:     s.Filter,
+		Limit:      s.Limit,
+		References: references,
+	}
+}
+
+func (s *MongoDbSourceCollectionOption) FromDto(dto *mgmtv1alpha1.MongoDBSourceCollectionOption) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.MongoDBSourceCollectionOption{}
+	}
+	s.Database = dto.GetDatabase()
+	s.Collection = dto.GetCollection()
+	s.Filter = dto.Filter
+	s.Limit = dto.Limit
+	s.References = make([]*MongoDbCollectionReference, len(dto.GetReferences()))
+	for i, r := range dto.GetReferences() {
+		ref := &MongoDbCollectionReference{}
+		ref.FromDto(r)
+		s.References[i] = ref
+	}
+}
+
+type MongoDbCollectionReference struct {
+	Field                string  `json:"field"`
+	ReferencedCollection string  `json:"referencedCollection"`
+	ReferencedField      *string `json:"referencedField,omitempty"`
+}
+
+func (s *MongoDbCollectionReference) ToDto() *mgmtv1alpha1.MongoDBCollectionReference {
+	return &mgmtv1alpha1.MongoDBCollectionReference{
+		Field:                s.Field,
+		ReferencedCollection: s.ReferencedCollection,
+		ReferencedField:      s.ReferencedField,
+	}
+}
+
+func (s *MongoDbCollectionReference) FromDto(dto *mgmtv1alpha1.MongoDBCollectionReference) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.MongoDBCollectionReference{}
+	}
+	s.Field = dto.GetField()
+	s.ReferencedCollection = dto.GetReferencedCollection()
+	s.ReferencedField = dto.ReferencedField
+}
+
+type LocalDirectorySourceOptions struct {
+	ConnectionId string  `json:"connectionId"`
+	JobId        *string `json:"jobId,omitempty"`
+	JobRunId     *string `json:"jobRunId,omitempty"`
+}
+
+func (s *LocalDirectorySourceOptions) ToDto() *mgmtv1alpha1.LocalDirectorySourceConnectionOptions {
+	dto := &mgmtv1alpha1.LocalDirectorySourceConnectionOptions{
+		ConnectionId: s.ConnectionId,
+	}
+	if s.JobRunId != nil {
+		dto.Id = &mgmtv1alpha1.LocalDirectorySourceConnectionOptions_JobRunId{JobRunId: *s.JobRunId}
+	} else if s.JobId != nil {
+		dto.Id = &mgmtv1alpha1.LocalDirectorySourceConnectionOptions_JobId{JobId: *s.JobId}
+	}
+	return dto
+}
+
+func (s *LocalDirectorySourceOptions) FromDto(dto *mgmtv1alpha1.LocalDirectorySourceConnectionOptions) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.LocalDirectorySourceConnectionOptions{}
+	}
+	s.ConnectionId = dto.GetConnectionId()
+	switch id := dto.GetId().(type) {
+	case *mgmtv1alpha1.LocalDirectorySourceConnectionOptions_JobId:
+		s.JobId = &id.JobId
+	case *mgmtv1alpha1.LocalDirectorySourceConnectionOptions_JobRunId:
+		s.JobRunId = &id.JobRunId
+	}
+}
+
+type KafkaSourceOptions struct {
+	ConnectionId   string                     `json:"connectionId"`
+	Topic          string                     `json:"topic"`
+	OffsetRange    *KafkaSourceOffsetRange    `json:"offsetRange,omitempty"`
+	TimestampRange *KafkaSourceTimestampRange `json:"timestampRange,omitempty"`
+	Format         *int32                     `json:"format,omitempty"`
+	AvroSchema     *string                    `json:"avroSchema,omitempty"`
+}
+
+type KafkaSourceOffsetRange struct {
+	Start *int64 `json:"start,omitempty"`
+	End   *int64 `json:"end,omitempty"`
+}
+
+type KafkaSourceTimestampRange struct {
+	Start *time.Time `json:"start,omitempty"`
+	End   *time.Time `json:"end,omitempty"`
+}
+
+func (s *KafkaSourceOptions) ToDto() *mgmtv1alpha1.KafkaSourceConnectionOptions {
+	dto := &mgmtv1alpha1.KafkaSourceConnectionOptions{
+		ConnectionId: s.ConnectionId,
+		Topic:        s.Topic,
+		Format:       toKafkaMessageFormatDto(s.Format),
+		AvroSchema:   s.AvroSchema,
+	}
+	if s.OffsetRange != nil {
+		dto.Range = &mgmtv1alpha1.KafkaSourceConnectionOptions_Offsets{
+			Offsets: &mgmtv1alpha1.KafkaOffsetRange{
+				Start: s.OffsetRange.Start,
+				End:   s.OffsetRange.End,
+			},
+		}
+	} else if s.TimestampRange != nil {
+		timestamps := &mgmtv1alpha1.KafkaTimestampRange{}
+		if s.TimestampRange.Start != nil {
+			timestamps.Start = timestamppb.New(*s.TimestampRange.Start)
+		}
+		if s.TimestampRange.End != nil {
+			timestamps.End = timestamppb.New(*s.TimestampRange.End)
+		}
+		dto.Range = &mgmtv1alpha1.KafkaSourceConnectionOptions_Timestamps{
+			Timestamps: timestamps,
+		}
+	}
+	return dto
+}
+
+func (s *KafkaSourceOptions) FromDto(dto *mgmtv1alpha1.KafkaSourceConnectionOptions) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.KafkaSourceConnectionOptions{}
+	}
+	s.ConnectionId = dto.GetConnectionId()
+	s.Topic = dto.GetTopic()
+	format := dto.GetFormat()
+	s.Format = (*int32)(&format)
+	s.AvroSchema = dto.AvroSchema
+	switch r := dto.GetRange().(type) {
+	case *mgmtv1alpha1.KafkaSourceConnectionOptions_Offsets:
+		s.OffsetRange = &KafkaSourceOffsetRange{
+			Start: r.Offsets.Start,
+			End:   r.Offsets.End,
+		}
+	case *mgmtv1alpha1.KafkaSourceConnectionOptions_Timestamps:
+		s.TimestampRange = &KafkaSourceTimestampRange{}
+		if r.Timestamps.GetStart() != nil {
+			start := r.Timestamps.GetStart().AsTime()
+			s.TimestampRange.Start = &start
+		}
+		if r.Timestamps.GetEnd() != nil {
+			end := r.Timestamps.GetEnd().AsTime()
+			s.TimestampRange.End = &end
+		}
+	}
+}
+
+func toKafkaMessageFormatDto(format *int32) mgmtv1alpha1.KafkaMessageFormat {
+	if format != nil {
+		if _, ok := mgmtv1alpha1.KafkaMessageFormat_name[*format]; ok {
+			return mgmtv1alpha1.KafkaMessageFormat(*format)
+		}
+	}
+	return mgmtv1alpha1.KafkaMessageFormat_KAFKA_MESSAGE_FORMAT_UNSPECIFIED
 }
 
 type MysqlSourceOptions struct {
@@ -1014,6 +1452,14 @@ type PostgresSourceOptions struct {
 type GenerateSourceOptions struct {
 	Schemas              []*GenerateSourceSchemaOption `json:"schemas"`
 	FkSourceConnectionId *string                       `json:"fkSourceConnectionId,omitempty"`
+	Profile              *GenerateSourceProfileOptions `json:"profile,omitempty"`
+}
+
+type GenerateSourceProfileOptions struct {
+	Refresh          bool    `json:"refresh,omitempty"`
+	SampleSize       *int64  `json:"sampleSize,omitempty"`
+	HistogramBuckets *uint32 `json:"histogramBuckets,omitempty"`
+	TopK             *uint32 `json:"topK,omitempty"`
 }
 
 type GenerateSourceSchemaOption struct {
@@ -1056,8 +1502,9 @@ func (s *PostgresSourceOptions) ToDto() *mgmtv1alpha1.PostgresSourceConnectionOp
 		for tidx := range schema.Tables {
 			table := schema.Tables[tidx]
 			tables[tidx] = &mgmtv1alpha1.PostgresSourceTableOption{
-				Table:       table.Table,
-				WhereClause: table.WhereClause,
+				Table:           table.Table,
+				WhereClause:     table.WhereClause,
+				WatermarkColumn: table.WatermarkColumn,
 			}
 		}
 		dto.Schemas[idx] = &mgmtv1alpha1.PostgresSourceSchemaOption{
@@ -1083,8 +1530,9 @@ func FromDtoPostgresSourceSchemaOptions(dtos []*mgmtv1alpha1.PostgresSourceSchem
 		for tidx := range schema.Tables {
 			table := schema.Tables[tidx]
 			tables[tidx] = &PostgresSourceTableOption{
-				Table:       table.Table,
-				WhereClause: table.WhereClause,
+				Table:           table.Table,
+				WhereClause:     table.WhereClause,
+				WatermarkColumn: table.WatermarkColumn,
 			}
 		}
 		output[idx] = &PostgresSourceSchemaOption{
@@ -1109,8 +1557,9 @@ func (s *MysqlSourceOptions) ToDto() *mgmtv1alpha1.MysqlSourceConnectionOptions
 		for tidx := range schema.Tables {
 			table := schema.Tables[tidx]
 			tables[tidx] = &mgmtv1alpha1.MysqlSourceTableOption{
-				Table:       table.Table,
-				WhereClause: table.WhereClause,
+				Table:           table.Table,
+				WhereClause:     table.WhereClause,
+				WatermarkColumn: table.WatermarkColumn,
 			}
 		}
 		dto.Schemas[idx] = &mgmtv1alpha1.MysqlSourceSchemaOption{
@@ -1136,8 +1585,9 @@ func FromDtoMysqlSourceSchemaOptions(dtos []*mgmtv1alpha1.MysqlSourceSchemaOptio
 		for tidx := range schema.Tables {
 			table := schema.Tables[tidx]
 			tables[tidx] = &MysqlSourceTableOption{
-				Table:       table.Table,
-				WhereClause: table.WhereClause,
+				Table:           table.Table,
+				WhereClause:     table.WhereClause,
+				WatermarkColumn: table.WatermarkColumn,
 			}
 		}
 		output[idx] = &MysqlSourceSchemaOption{
@@ -1149,6 +1599,16 @@ func FromDtoMysqlSourceSchemaOptions(dtos []*mgmtv1alpha1.MysqlSourceSchemaOptio
 	return output
 }
 
+func FromDtoMongoDbSourceCollectionOptions(dtos []*mgmtv1alpha1.MongoDBSourceCollectionOption) []*MongoDbSourceCollectionOption {
+	collections := make([]*MongoDbSourceCollectionOption, len(dtos))
+	for i, collection := range dtos {
+		c := &MongoDbSourceCollectionOption{}
+		c.FromDto(collection)
+		collections[i] = c
+	}
+	return collections
+}
+
 func FromDtoDynamoDBSourceTableOptions(dtos []*mgmtv1alpha1.DynamoDBSourceTableOption) []*DynamoDBSourceTableOption {
 	tables := make([]*DynamoDBSourceTableOption, len(dtos))
 	for i, table := range dtos {
@@ -1163,6 +1623,14 @@ func (s *GenerateSourceOptions) ToDto() *mgmtv1alpha1.GenerateSourceOptions {
 	dto := &mgmtv1alpha1.GenerateSourceOptions{
 		FkSourceConnectionId: s.FkSourceConnectionId,
 	}
+	if s.Profile != nil {
+		dto.Profile = &mgmtv1alpha1.GenerateSourceProfileOptions{
+			Refresh:          s.Profile.Refresh,
+			SampleSize:       s.Profile.SampleSize,
+			HistogramBuckets: s.Profile.HistogramBuckets,
+			TopK:             s.Profile.TopK,
+		}
+	}
 	dto.Schemas = make([]*mgmtv1alpha1.GenerateSourceSchemaOption, len(s.Schemas))
 	for idx := range s.Schemas {
 		schema := s.Schemas[idx]
@@ -1185,6 +1653,14 @@ func (s *GenerateSourceOptions) ToDto() *mgmtv1alpha1.GenerateSourceOptions {
 func (s *GenerateSourceOptions) FromDto(dto *mgmtv1alpha1.GenerateSourceOptions) {
 	s.FkSourceConnectionId = dto.FkSourceConnectionId
 	s.Schemas = FromDtoGenerateSourceSchemaOptions(dto.Schemas)
+	if dto.GetProfile() != nil {
+		s.Profile = &GenerateSourceProfileOptions{
+			Refresh:          dto.GetProfile().GetRefresh(),
+			SampleSize:       dto.GetProfile().SampleSize,
+			HistogramBuckets: dto.GetProfile().HistogramBuckets,
+			TopK:             dto.GetProfile().TopK,
+		}
+	}
 }
 
 func FromDtoGenerateSourceSchemaOptions(dtos []*mgmtv1alpha1.GenerateSourceSchemaOption) []*GenerateSourceSchemaOption {
@@ -1269,8 +1745,9 @@ type PostgresSourceSchemaOption struct {
 	Tables []*PostgresSourceTableOption `json:"tables"`
 }
 type PostgresSourceTableOption struct {
-	Table       string  `json:"table"`
-	WhereClause *string `json:"whereClause,omitempty"`
+	Table           string  `json:"table"`
+	WhereClause     *string `json:"whereClause,omitempty"`
+	WatermarkColumn *string `json:"watermarkColumn,omitempty"`
 }
 
 type MysqlSourceSchemaOption struct {
@@ -1278,8 +1755,9 @@ type MysqlSourceSchemaOption struct {
 	Tables []*MysqlSourceTableOption `json:"tables"`
 }
 type MysqlSourceTableOption struct {
-	Table       string  `json:"table"`
-	WhereClause *string `json:"whereClause,omitempty"`
+	Table           string  `json:"table"`
+	WhereClause     *string `json:"whereClause,omitempty"`
+	WatermarkColumn *string `json:"watermarkColumn,omitempty"`
 }
 
 func (j *JobSourceOptions) ToDto() *mgmtv1alpha1.JobSourceOptions {
@@ -1332,6 +1810,27 @@ func (j *JobSourceOptions) ToDto() *mgmtv1alpha1.JobSourceOptions {
 			},
 		}
 	}
+	if j.KafkaOptions != nil {
+		return &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_Kafka{
+				Kafka: j.KafkaOptions.ToDto(),
+			},
+		}
+	}
+	if j.SqliteOptions != nil {
+		return &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_Sqlite{
+				Sqlite: j.SqliteOptions.ToDto(),
+			},
+		}
+	}
+	if j.LocalDirectoryOptions != nil {
+		return &mgmtv1alpha1.JobSourceOptions{
+			Config: &mgmtv1alpha1.JobSourceOptions_LocalDirectory{
+				LocalDirectory: j.LocalDirectoryOptions.ToDto(),
+			},
+		}
+	}
 	return nil
 }
 
@@ -1368,6 +1867,18 @@ func (j *JobSourceOptions) FromDto(dto *mgmtv1alpha1.JobSourceOptions) error {
 		opts := &MssqlSourceOptions{}
 		opts.FromDto(dto.GetMssql())
 		j.MssqlOptions = opts
+	case *mgmtv1alpha1.JobSourceOptions_Kafka:
+		opts := &KafkaSourceOptions{}
+		opts.FromDto(dto.GetKafka())
+		j.KafkaOptions = opts
+	case *mgmtv1alpha1.JobSourceOptions_Sqlite:
+		opts := &SqliteSourceOptions{}
+		opts.FromDto(dto.GetSqlite())
+		j.SqliteOptions = opts
+	case *mgmtv1alpha1.JobSourceOptions_LocalDirectory:
+		opts := &LocalDirectorySourceOptions{}
+		opts.FromDto(dto.GetLocalDirectory())
+		j.LocalDirectoryOptions = opts
 	default:
 		return fmt.Errorf("invalid job source options config, received type: %T", config)
 	}
@@ -1382,6 +1893,67 @@ type JobDestinationOptions struct {
 	GcpCloudStorageOptions *GcpCloudStorageDestinationOptions `json:"gcpCloudStorageOptions,omitempty"`
 	DynamoDBOptions        *DynamoDBDestinationOptions        `json:"dynamoDBOptions,omitempty"`
 	MssqlOptions           *MssqlDestinationOptions           `json:"mssqlOptions,omitempty"`
+	KafkaOptions           *KafkaDestinationOptions           `json:"kafkaOptions,omitempty"`
+	SqliteOptions          *SqliteDestinationOptions          `json:"sqliteOptions,omitempty"`
+	LocalDirectoryOptions  *LocalDirectoryDestinationOptions  `json:"localDirectoryOptions,omitempty"`
+}
+
+type LocalDirectoryDestinationOptions struct {
+	Format       *int32       `json:"format,omitempty"`
+	Batch        *BatchConfig `json:"batch,omitempty"`
+	PathTemplate *string      `json:"pathTemplate,omitempty"`
+}
+
+func (l *LocalDirectoryDestinationOptions) ToDto() *mgmtv1alpha1.LocalDirectoryDestinationConnectionOptions {
+	var batch *mgmtv1alpha1.BatchConfig
+	if l.Batch != nil {
+		batch = l.Batch.ToDto()
+	}
+	return &mgmtv1alpha1.LocalDirectoryDestinationConnectionOptions{
+		Format:       toBucketFileFormatDto(l.Format),
+		Batch:        batch,
+		PathTemplate: l.PathTemplate,
+	}
+}
+
+func (l *LocalDirectoryDestinationOptions) FromDto(dto *mgmtv1alpha1.LocalDirectoryDestinationConnectionOptions) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.LocalDirectoryDestinationConnectionOptions{}
+	}
+	format := dto.GetFormat()
+	l.Format = (*int32)(&format)
+	if dto.Batch != nil {
+		l.Batch = &BatchConfig{}
+		l.Batch.FromDto(dto.GetBatch())
+	}
+	l.PathTemplate = dto.PathTemplate
+}
+
+type KafkaDestinationOptions struct {
+	Topic       string  `json:"topic"`
+	Format      *int32  `json:"format,omitempty"`
+	AvroSchema  *string `json:"avroSchema,omitempty"`
+	MaxInFlight *uint32 `json:"maxInFlight,omitempty"`
+}
+
+func (k *KafkaDestinationOptions) ToDto() *mgmtv1alpha1.KafkaDestinationConnectionOptions {
+	return &mgmtv1alpha1.KafkaDestinationConnectionOptions{
+		Topic:       k.Topic,
+		Format:      toKafkaMessageFormatDto(k.Format),
+		AvroSchema:  k.AvroSchema,
+		MaxInFlight: k.MaxInFlight,
+	}
+}
+
+func (k *KafkaDestinationOptions) FromDto(dto *mgmtv1alpha1.KafkaDestinationConnectionOptions) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.KafkaDestinationConnectionOptions{}
+	}
+	k.Topic = dto.GetTopic()
+	format := dto.GetFormat()
+	k.Format = (*int32)(&format)
+	k.AvroSchema = dto.AvroSchema
+	k.MaxInFlight = dto.MaxInFlight
 }
 
 type DynamoDBDestinationOptions struct {
@@ -1423,15 +1995,114 @@ func (d *DynamoDBDestinationTableMapping) FromDto(dto *mgmtv1alpha1.DynamoDBDest
 	d.DestinationTable = dto.GetDestinationTable()
 }
 
-type GcpCloudStorageDestinationOptions struct{}
+type GcpCloudStorageDestinationOptions struct {
+	Format       *int32       `json:"format,omitempty"`
+	MaxInFlight  *uint32      `json:"maxInFlight,omitempty"`
+	Timeout      *string      `json:"timeout,omitempty"`
+	Batch        *BatchConfig `json:"batch,omitempty"`
+	StorageClass *int32       `json:"storageClass,omitempty"`
+	PathTemplate *string      `json:"pathTemplate,omitempty"`
+}
+
+func (g *GcpCloudStorageDestinationOptions) ToDto() *mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions {
+	storageClass := mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions_STORAGE_CLASS_UNSPECIFIED
+	if g.StorageClass != nil {
+		if _, ok := mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions_StorageClass_name[*g.StorageClass]; ok {
+			storageClass = mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions_StorageClass(*g.StorageClass)
+		}
+	}
+	var batch *mgmtv1alpha1.BatchConfig
+	if g.Batch != nil {
+		batch = g.Batch.ToDto()
+	}
+	return &mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions{
+		Format:       toBucketFileFormatDto(g.Format),
+		MaxInFlight:  g.MaxInFlight,
+		Timeout:      g.Timeout,
+		Batch:        batch,
+		StorageClass: storageClass,
+		PathTemplate: g.PathTemplate,
+	}
+}
+
+func (g *GcpCloudStorageDestinationOptions) FromDto(dto *mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions{}
+	}
+	format := dto.GetFormat()
+	g.Format = (*int32)(&format)
+	g.MaxInFlight = dto.MaxInFlight
+	g.Timeout = dto.Timeout
+	if dto.Batch != nil {
+		g.Batch = &BatchConfig{}
+		g.Batch.FromDto(dto.GetBatch())
+	}
+	sc := dto.GetStorageClass()
+	g.StorageClass = (*int32)(&sc)
+	g.PathTemplate = dto.PathTemplate
+}
+
+func toBucketFileFormatDto(format *int32) mgmtv1alpha1.BucketFileFormat {
+	if format != nil {
+		if _, ok := mgmtv1alpha1.BucketFileFormat_name[*format]; ok {
+			return mgmtv1alpha1.BucketFileFormat(*format)
+		}
+	}
+	return mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_UNSPECIFIED
+}
+
+type MongoDestinationOptions struct {
+	TruncateCollectionConfig *MongoTruncateCollectionConfig `json:"truncateCollectionConfig,omitempty"`
+	InitCollectionSchema     bool                           `json:"initCollectionSchema"`
+	WriteMode                *int32                         `json:"writeMode,omitempty"`
+}
 
-type MongoDestinationOptions struct{}
+type MongoTruncateCollectionConfig struct {
+	TruncateBeforeInsert bool `json:"truncateBeforeInsert"`
+	Drop                 bool `json:"drop"`
+}
+
+func (m *MongoDestinationOptions) ToDto() *mgmtv1alpha1.MongoDBDestinationConnectionOptions {
+	dto := &mgmtv1alpha1.MongoDBDestinationConnectionOptions{
+		InitCollectionSchema: m.InitCollectionSchema,
+		WriteMode:            mgmtv1alpha1.MongoDBWriteMode_MONGO_DB_WRITE_MODE_UNSPECIFIED,
+	}
+	if m.TruncateCollectionConfig != nil {
+		dto.TruncateCollection = &mgmtv1alpha1.MongoDBTruncateCollectionConfig{
+			TruncateBeforeInsert: m.TruncateCollectionConfig.TruncateBeforeInsert,
+			Drop:                 m.TruncateCollectionConfig.Drop,
+		}
+	}
+	if m.WriteMode != nil {
+		if _, ok := mgmtv1alpha1.MongoDBWriteMode_name[*m.WriteMode]; ok {
+			dto.WriteMode = mgmtv1alpha1.MongoDBWriteMode(*m.WriteMode)
+		}
+	}
+	return dto
+}
+
+func (m *MongoDestinationOptions) FromDto(dto *mgmtv1alpha1.MongoDBDestinationConnectionOptions) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.MongoDBDestinationConnectionOptions{}
+	}
+	m.InitCollectionSchema = dto.GetInitCollectionSchema()
+	if dto.GetTruncateCollection() != nil {
+		m.TruncateCollectionConfig = &MongoTruncateCollectionConfig{
+			TruncateBeforeInsert: dto.GetTruncateCollection().GetTruncateBeforeInsert(),
+			Drop:                 dto.GetTruncateCollection().GetDrop(),
+		}
+	}
+	writeMode := int32(dto.GetWriteMode())
+	m.WriteMode = &writeMode
+}
 
 type AwsS3DestinationOptions struct {
 	StorageClass *int32       `json:"storageClass,omitempty"`
 	MaxInFlight  *uint32      `json:"maxInFlight,omitempty"`
 	Timeout      *string      `json:"timeout,omitempty"`
 	Batch        *BatchConfig `json:"batch,omitempty"`
+	Format       *int32       `json:"format,omitempty"`
+	PathTemplate *string      `json:"pathTemplate,omitempty"`
 }
 
 type BatchConfig struct {
@@ -1447,17 +2118,41 @@ type PostgresDestinationOptions struct {
 }
 
 type PostgresOnConflictConfig struct {
-	DoNothing bool `json:"doNothing"`
+	DoNothing bool                            `json:"doNothing"`
+	Update    *PostgresOnConflictUpdateConfig `json:"update,omitempty"`
+}
+
+type PostgresOnConflictUpdateConfig struct {
+	Columns []string `json:"columns,omitempty"`
+}
+
+func (t *PostgresOnConflictUpdateConfig) ToDto() *mgmtv1alpha1.PostgresOnConflictUpdateConfig {
+	return &mgmtv1alpha1.PostgresOnConflictUpdateConfig{
+		Columns: t.Columns,
+	}
+}
+
+func (t *PostgresOnConflictUpdateConfig) FromDto(dto *mgmtv1alpha1.PostgresOnConflictUpdateConfig) {
+	t.Columns = dto.GetColumns()
 }
 
 func (t *PostgresOnConflictConfig) ToDto() *mgmtv1alpha1.PostgresOnConflictConfig {
+	var update *mgmtv1alpha1.PostgresOnConflictUpdateConfig
+	if t.Update != nil {
+		update = t.Update.ToDto()
+	}
 	return &mgmtv1alpha1.PostgresOnConflictConfig{
 		DoNothing: t.DoNothing,
+		Update:    update,
 	}
 }
 
 func (t *PostgresOnConflictConfig) FromDto(dto *mgmtv1alpha1.PostgresOnConflictConfig) {
 	t.DoNothing = dto.DoNothing
+	if dto.GetUpdate() != nil {
+		t.Update = &PostgresOnConflictUpdateConfig{}
+		t.Update.FromDto(dto.GetUpdate())
+	}
 }
 
 type PostgresTruncateTableConfig struct {
@@ -1485,17 +2180,41 @@ type MysqlDestinationOptions struct {
 }
 
 type MysqlOnConflictConfig struct {
-	DoNothing bool `json:"doNothing"`
+	DoNothing bool                         `json:"doNothing"`
+	Update    *MysqlOnConflictUpdateConfig `json:"update,omitempty"`
+}
+
+type MysqlOnConflictUpdateConfig struct {
+	Columns []string `json:"columns,omitempty"`
+}
+
+func (t *MysqlOnConflictUpdateConfig) ToDto() *mgmtv1alpha1.MysqlOnConflictUpdateConfig {
+	return &mgmtv1alpha1.MysqlOnConflictUpdateConfig{
+		Columns: t.Columns,
+	}
+}
+
+func (t *MysqlOnConflictUpdateConfig) FromDto(dto *mgmtv1alpha1.MysqlOnConflictUpdateConfig) {
+	t.Columns = dto.GetColumns()
 }
 
 func (t *MysqlOnConflictConfig) ToDto() *mgmtv1alpha1.MysqlOnConflictConfig {
+	var update *mgmtv1alpha1.MysqlOnConflictUpdateConfig
+	if t.Update != nil {
+		update = t.Update.ToDto()
+	}
 	return &mgmtv1alpha1.MysqlOnConflictConfig{
 		DoNothing: t.DoNothing,
+		Update:    update,
 	}
 }
 
 func (t *MysqlOnConflictConfig) FromDto(dto *mgmtv1alpha1.MysqlOnConflictConfig) {
 	t.DoNothing = dto.DoNothing
+	if dto.GetUpdate() != nil {
+		t.Update = &MysqlOnConflictUpdateConfig{}
+		t.Update.FromDto(dto.GetUpdate())
+	}
 }
 
 type MysqlTruncateTableConfig struct {
@@ -1553,12 +2272,32 @@ func (m *MssqlDestinationOptions) FromDto(dto *mgmtv1alpha1.MssqlDestinationConn
 }
 
 type MssqlOnConflictConfig struct {
-	DoNothing bool `json:"doNothing"`
+	DoNothing bool                         `json:"doNothing"`
+	Update    *MssqlOnConflictUpdateConfig `json:"update,omitempty"`
+}
+
+type MssqlOnConflictUpdateConfig struct {
+	Columns []string `json:"columns,omitempty"`
+}
+
+func (t *MssqlOnConflictUpdateConfig) ToDto() *mgmtv1alpha1.MssqlOnConflictUpdateConfig {
+	return &mgmtv1alpha1.MssqlOnConflictUpdateConfig{
+		Columns: t.Columns,
+	}
+}
+
+func (t *MssqlOnConflictUpdateConfig) FromDto(dto *mgmtv1alpha1.MssqlOnConflictUpdateConfig) {
+	t.Columns = dto.GetColumns()
 }
 
 func (t *MssqlOnConflictConfig) ToDto() *mgmtv1alpha1.MssqlOnConflictConfig {
+	var update *mgmtv1alpha1.MssqlOnConflictUpdateConfig
+	if t.Update != nil {
+		update = t.Update.ToDto()
+	}
 	return &mgmtv1alpha1.MssqlOnConflictConfig{
 		DoNothing: t.DoNothing,
+		Update:    update,
 	}
 }
 
@@ -1567,6 +2306,10 @@ func (t *MssqlOnConflictConfig) FromDto(dto *mgmtv1alpha1.MssqlOnConflictConfig)
 		dto = &mgmtv1alpha1.MssqlOnConflictConfig{}
 	}
 	t.DoNothing = dto.DoNothing
+	if dto.GetUpdate() != nil {
+		t.Update = &MssqlOnConflictUpdateConfig{}
+		t.Update.FromDto(dto.GetUpdate())
+	}
 }
 
 type MssqlTruncateTableConfig struct {
@@ -1586,6 +2329,80 @@ func (t *MssqlTruncateTableConfig) FromDto(dto *mgmtv1alpha1.MssqlTruncateTableC
 	t.TruncateBeforeInsert = dto.TruncateBeforeInsert
 }
 
+type SqliteDestinationOptions struct {
+	TruncateTableConfig      *SqliteTruncateTableConfig `json:"truncateTableConfig,omitempty"`
+	InitTableSchema          bool                       `json:"initTableSchema"`
+	OnConflictConfig         *SqliteOnConflictConfig    `json:"onConflict,omitempty"`
+	SkipForeignKeyViolations bool                       `json:"skipForeignKeyViolations"`
+}
+
+func (s *SqliteDestinationOptions) ToDto() *mgmtv1alpha1.SqliteDestinationConnectionOptions {
+	var truncateTableConfig *mgmtv1alpha1.SqliteTruncateTableConfig
+	if s.TruncateTableConfig != nil {
+		truncateTableConfig = s.TruncateTableConfig.ToDto()
+	}
+	var onconflictConfig *mgmtv1alpha1.SqliteOnConflictConfig
+	if s.OnConflictConfig != nil {
+		onconflictConfig = s.OnConflictConfig.ToDto()
+	}
+
+	return &mgmtv1alpha1.SqliteDestinationConnectionOptions{
+		TruncateTable:            truncateTableConfig,
+		InitTableSchema:          s.InitTableSchema,
+		OnConflict:               onconflictConfig,
+		SkipForeignKeyViolations: s.SkipForeignKeyViolations,
+	}
+}
+func (s *SqliteDestinationOptions) FromDto(dto *mgmtv1alpha1.SqliteDestinationConnectionOptions) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.SqliteDestinationConnectionOptions{}
+	}
+	s.InitTableSchema = dto.GetInitTableSchema()
+	if dto.GetOnConflict() != nil {
+		s.OnConflictConfig = &SqliteOnConflictConfig{}
+		s.OnConflictConfig.FromDto(dto.GetOnConflict())
+	}
+	if dto.GetTruncateTable() != nil {
+		s.TruncateTableConfig = &SqliteTruncateTableConfig{}
+		s.TruncateTableConfig.FromDto(dto.GetTruncateTable())
+	}
+	s.SkipForeignKeyViolations = dto.GetSkipForeignKeyViolations()
+}
+
+type SqliteOnConflictConfig struct {
+	DoNothing bool `json:"doNothing"`
+}
+
+func (t *SqliteOnConflictConfig) ToDto() *mgmtv1alpha1.SqliteOnConflictConfig {
+	return &mgmtv1alpha1.SqliteOnConflictConfig{
+		DoNothing: t.DoNothing,
+	}
+}
+
+func (t *SqliteOnConflictConfig) FromDto(dto *mgmtv1alpha1.SqliteOnConflictConfig) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.SqliteOnConflictConfig{}
+	}
+	t.DoNothing = dto.DoNothing
+}
+
+type SqliteTruncateTableConfig struct {
+	TruncateBeforeInsert bool `json:"truncateBeforeInsert"`
+}
+
+func (t *SqliteTruncateTableConfig) ToDto() *mgmtv1alpha1.SqliteTruncateTableConfig {
+	return &mgmtv1alpha1.SqliteTruncateTableConfig{
+		TruncateBeforeInsert: t.TruncateBeforeInsert,
+	}
+}
+
+func (t *SqliteTruncateTableConfig) FromDto(dto *mgmtv1alpha1.SqliteTruncateTableConfig) {
+	if dto == nil {
+		dto = &mgmtv1alpha1.SqliteTruncateTableConfig{}
+	}
+	t.TruncateBeforeInsert = dto.TruncateBeforeInsert
+}
+
 func (j *JobDestinationOptions) ToDto() *mgmtv1alpha1.JobDestinationOptions {
 	if j.PostgresOptions != nil {
 		if j.PostgresOptions.TruncateTableConfig == nil {
@@ -1633,14 +2450,14 @@ func (j *JobDestinationOptions) ToDto() *mgmtv1alpha1.JobDestinationOptions {
 	if j.MongoOptions != nil {
 		return &mgmtv1alpha1.JobDestinationOptions{
 			Config: &mgmtv1alpha1.JobDestinationOptions_MongodbOptions{
-				MongodbOptions: &mgmtv1alpha1.MongoDBDestinationConnectionOptions{},
+				MongodbOptions: j.MongoOptions.ToDto(),
 			},
 		}
 	}
 	if j.GcpCloudStorageOptions != nil {
 		return &mgmtv1alpha1.JobDestinationOptions{
 			Config: &mgmtv1alpha1.JobDestinationOptions_GcpCloudstorageOptions{
-				GcpCloudstorageOptions: &mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions{},
+				GcpCloudstorageOptions: j.GcpCloudStorageOptions.ToDto(),
 			},
 		}
 	}
@@ -1658,6 +2475,27 @@ func (j *JobDestinationOptions) ToDto() *mgmtv1alpha1.JobDestinationOptions {
 			},
 		}
 	}
+	if j.KafkaOptions != nil {
+		return &mgmtv1alpha1.JobDestinationOptions{
+			Config: &mgmtv1alpha1.JobDestinationOptions_KafkaOptions{
+				KafkaOptions: j.KafkaOptions.ToDto(),
+			},
+		}
+	}
+	if j.SqliteOptions != nil {
+		return &mgmtv1alpha1.JobDestinationOptions{
+			Config: &mgmtv1alpha1.JobDestinationOptions_SqliteOptions{
+				SqliteOptions: j.SqliteOptions.ToDto(),
+			},
+		}
+	}
+	if j.LocalDirectoryOptions != nil {
+		return &mgmtv1alpha1.JobDestinationOptions{
+			Config: &mgmtv1alpha1.JobDestinationOptions_LocalDirectoryOptions{
+				LocalDirectoryOptions: j.LocalDirectoryOptions.ToDto(),
+			},
+		}
+	}
 
 	return nil
 }
@@ -1678,6 +2516,8 @@ func (a *AwsS3DestinationOptions) ToDto() *mgmtv1alpha1.AwsS3DestinationConnecti
 		MaxInFlight:  a.MaxInFlight,
 		Timeout:      a.Timeout,
 		Batch:        batch,
+		Format:       toBucketFileFormatDto(a.Format),
+		PathTemplate: a.PathTemplate,
 	}
 }
 
@@ -1693,6 +2533,9 @@ func (a *AwsS3DestinationOptions) FromDto(dto *mgmtv1alpha1.AwsS3DestinationConn
 		a.Batch = &BatchConfig{}
 		a.Batch.FromDto(dto.GetBatch())
 	}
+	format := dto.GetFormat()
+	a.Format = (*int32)(&format)
+	a.PathTemplate = dto.PathTemplate
 }
 
 func (b *BatchConfig) ToDto() *mgmtv1alpha1.BatchConfig {
@@ -1746,14 +2589,25 @@ func (j *JobDestinationOptions) FromDto(dto *mgmtv1alpha1.JobDestinationOptions)
 		j.AwsS3Options.FromDto(config.AwsS3Options)
 	case *mgmtv1alpha1.JobDestinationOptions_MongodbOptions:
 		j.MongoOptions = &MongoDestinationOptions{}
+		j.MongoOptions.FromDto(config.MongodbOptions)
 	case *mgmtv1alpha1.JobDestinationOptions_GcpCloudstorageOptions:
 		j.GcpCloudStorageOptions = &GcpCloudStorageDestinationOptions{}
+		j.GcpCloudStorageOptions.FromDto(config.GcpCloudstorageOptions)
 	case *mgmtv1alpha1.JobDestinationOptions_DynamodbOptions:
 		j.DynamoDBOptions = &DynamoDBDestinationOptions{}
 		j.DynamoDBOptions.FromDto(config.DynamodbOptions)
 	case *mgmtv1alpha1.JobDestinationOptions_MssqlOptions:
 		j.MssqlOptions = &MssqlDestinationOptions{}
 		j.MssqlOptions.FromDto(config.MssqlOptions)
+	case *mgmtv1alpha1.JobDestinationOptions_KafkaOptions:
+		j.KafkaOptions = &KafkaDestinationOptions{}
+		j.KafkaOptions.FromDto(config.KafkaOptions)
+	case *mgmtv1alpha1.JobDestinationOptions_SqliteOptions:
+		j.SqliteOptions = &SqliteDestinationOptions{}
+		j.SqliteOptions.FromDto(config.SqliteOptions)
+	case *mgmtv1alpha1.JobDestinationOptions_LocalDirectoryOptions:
+		j.LocalDirectoryOptions = &LocalDirectoryDestinationOptions{}
+		j.LocalDirectoryOptions.FromDto(config.LocalDirectoryOptions)
 	default:
 		return fmt.Errorf("invalid job destination options config: %T", config)
 	}
@@ -1794,6 +2648,23 @@ func (a *WorkflowOptions) FromDto(dto *mgmtv1alpha1.WorkflowOptions) {
 	a.RunTimeout = dto.RunTimeout
 }
 
+type PipelineStep struct {
+	JobId         string `json:"jobId"`
+	FailurePolicy int32  `json:"failurePolicy"`
+}
+
+func (p *PipelineStep) ToDto() *mgmtv1alpha1.PipelineStep {
+	return &mgmtv1alpha1.PipelineStep{
+		JobId:         p.JobId,
+		FailurePolicy: mgmtv1alpha1.PipelineStepFailurePolicy(p.FailurePolicy),
+	}
+}
+
+func (p *PipelineStep) FromDto(dto *mgmtv1alpha1.PipelineStep) {
+	p.JobId = dto.GetJobId()
+	p.FailurePolicy = int32(dto.GetFailurePolicy())
+}
+
 type ActivityOptions struct {
 	ScheduleToCloseTimeout *int64       `json:"scheduleToCloseTimeout,omitempty"`
 	StartToCloseTimeout    *int64       `json:"startToCloseTimeout,omitempty"`
@@ -1848,3 +2719,17 @@ func (t *AccountOnboardingConfig) ToDto() *mgmtv1alpha1.AccountOnboardingConfig
 func (t *AccountOnboardingConfig) FromDto(dto *mgmtv1alpha1.AccountOnboardingConfig) {
 	t.HasCompletedOnboarding = dto.GetHasCompletedOnboarding()
 }
+
+// The secret that webhook deliveries of a notification rule are signed with
+type NotificationSigningSecret struct {
+	// The plaintext secret. Only set for secrets that were stored while connection encryption was not enabled
+	Value *string `json:"value,omitempty"`
+	// When set, the secret has been encrypted with the connection encryption keys
+	Encrypted *EncryptedSecret `json:"encrypted,omitempty"`
+}
+
+type EncryptedSecret struct {
+	KeyId            string `json:"keyId"`
+	EncryptedDataKey []byte `json:"encryptedDataKey"`
+	Ciphertext       []byte `json:"ciphertext"`
+}
@@ -53,6 +53,9 @@ type TransformerConfigs struct {
 	TransformCharacterScramble *TransformCharacterScramble      `json:"transformCharacterScramble,omitempty"`
 	GenerateJavascript         *GenerateJavascript              `json:"generateJavascript,omitempty"`
 	GenerateCountry            *GenerateCountryConfig           `json:"generateCountryConfig,omitempty"`
+	TransformFpe               *TransformFpeConfig              `json:"transformFpe,omitempty"`
+
+	Deterministic bool `json:"deterministic,omitempty"`
 }
 
 type GenerateEmailConfig struct {
@@ -73,15 +76,20 @@ type GenerateCardNumberConfig struct {
 	ValidLuhn bool `json:"validLuhn"`
 }
 
-type GenerateCityConfig struct{}
+type GenerateCityConfig struct {
+	Locale *int32 `json:"locale,omitempty"`
+}
 
 type GenerateDefaultConfig struct{}
 
 type GenerateE164PhoneNumberConfig struct {
-	Min int64 `json:"min"`
-	Max int64 `json:"max"`
+	Min    int64  `json:"min"`
+	Max    int64  `json:"max"`
+	Locale *int32 `json:"locale,omitempty"`
+}
+type GenerateFirstNameConfig struct {
+	Locale *int32 `json:"locale,omitempty"`
 }
-type GenerateFirstNameConfig struct{}
 
 type GenerateFloat64Config struct {
 	RandomizeSign bool    `json:"randomizeSign"`
@@ -90,9 +98,13 @@ type GenerateFloat64Config struct {
 	Precision     int64   `json:"precision"`
 }
 
-type GenerateFullAddressConfig struct{}
+type GenerateFullAddressConfig struct {
+	Locale *int32 `json:"locale,omitempty"`
+}
 
-type GenerateFullNameConfig struct{}
+type GenerateFullNameConfig struct {
+	Locale *int32 `json:"locale,omitempty"`
+}
 
 type GenerateGenderConfig struct {
 	Abbreviate bool `json:"abbreviate"`
@@ -106,21 +118,27 @@ type GenerateInt64Config struct {
 	Max           int64 `json:"max"`
 }
 
-type GenerateLastNameConfig struct{}
+type GenerateLastNameConfig struct {
+	Locale *int32 `json:"locale,omitempty"`
+}
 
 type GenerateSha256HashConfig struct{}
 
 type GenerateSsnConfig struct{}
 
 type GenerateStateConfig struct {
-	GenerateFullName bool `json:"generateFullName"`
+	GenerateFullName bool   `json:"generateFullName"`
+	Locale           *int32 `json:"locale,omitempty"`
 }
 
-type GenerateStreetAddressConfig struct{}
+type GenerateStreetAddressConfig struct {
+	Locale *int32 `json:"locale,omitempty"`
+}
 
 type GenerateStringPhoneNumberConfig struct {
-	Min int64 `json:"min"`
-	Max int64 `json:"max"`
+	Min    int64  `json:"min"`
+	Max    int64  `json:"max"`
+	Locale *int32 `json:"locale,omitempty"`
 }
 
 type GenerateStringConfig struct {
@@ -137,14 +155,17 @@ type GenerateUuidConfig struct {
 	IncludeHyphens bool `json:"includeHyphens"`
 }
 
-type GenerateZipcodeConfig struct{}
+type GenerateZipcodeConfig struct {
+	Locale *int32 `json:"locale,omitempty"`
+}
 
 type TransformE164PhoneNumberConfig struct {
 	PreserveLength bool `json:"preserveLength"`
 }
 
 type TransformFirstNameConfig struct {
-	PreserveLength bool `json:"preserveLength"`
+	PreserveLength bool   `json:"preserveLength"`
+	Locale         *int32 `json:"locale,omitempty"`
 }
 
 type TransformFloat64Config struct {
@@ -153,7 +174,8 @@ type TransformFloat64Config struct {
 }
 
 type TransformFullNameConfig struct {
-	PreserveLength bool `json:"preserveLength"`
+	PreserveLength bool   `json:"preserveLength"`
+	Locale         *int32 `json:"locale,omitempty"`
 }
 
 type TransformInt64PhoneNumberConfig struct {
@@ -166,7 +188,8 @@ type TransformInt64Config struct {
 }
 
 type TransformLastNameConfig struct {
-	PreserveLength bool `json:"preserveLength"`
+	PreserveLength bool   `json:"preserveLength"`
+	Locale         *int32 `json:"locale,omitempty"`
 }
 
 type TransformPhoneNumberConfig struct {
@@ -205,6 +228,13 @@ type GenerateCountryConfig struct {
 	GenerateFullName bool `json:"generateFullName"`
 }
 
+type TransformFpeConfig struct {
+	Alphabet               int32   `json:"alphabet"`
+	CustomAlphabet         *string `json:"customAlphabet,omitempty"`
+	Tweak                  *string `json:"tweak,omitempty"`
+	PassThroughShortValues bool    `json:"passThroughShortValues,omitempty"`
+}
+
 // from API -> DB
 func (t *JobMappingTransformerModel) FromTransformerDto(tr *mgmtv1alpha1.JobMappingTransformer) error {
 	t.Source = int32(tr.Source)
@@ -224,6 +254,7 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 	if tr == nil {
 		tr = &mgmtv1alpha1.TransformerConfig{}
 	}
+	t.Deterministic = tr.GetDeterministic()
 	switch tr.Config.(type) {
 	case *mgmtv1alpha1.TransformerConfig_GenerateEmailConfig:
 		t.GenerateEmail = &GenerateEmailConfig{
@@ -244,16 +275,21 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 			ValidLuhn: tr.GetGenerateCardNumberConfig().ValidLuhn,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateCityConfig:
-		t.GenerateCity = &GenerateCityConfig{}
+		t.GenerateCity = &GenerateCityConfig{
+			Locale: (*int32)(tr.GetGenerateCityConfig().Locale),
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateDefaultConfig:
 		t.GenerateDefault = &GenerateDefaultConfig{}
 	case *mgmtv1alpha1.TransformerConfig_GenerateE164PhoneNumberConfig:
 		t.GenerateE164PhoneNumber = &GenerateE164PhoneNumberConfig{
-			Min: tr.GetGenerateE164PhoneNumberConfig().Min,
-			Max: tr.GetGenerateE164PhoneNumberConfig().Max,
+			Min:    tr.GetGenerateE164PhoneNumberConfig().Min,
+			Max:    tr.GetGenerateE164PhoneNumberConfig().Max,
+			Locale: (*int32)(tr.GetGenerateE164PhoneNumberConfig().Locale),
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateFirstNameConfig:
-		t.GenerateFirstName = &GenerateFirstNameConfig{}
+		t.GenerateFirstName = &GenerateFirstNameConfig{
+			Locale: (*int32)(tr.GetGenerateFirstNameConfig().Locale),
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateFloat64Config:
 		t.GenerateFloat64 = &GenerateFloat64Config{
 			RandomizeSign: tr.GetGenerateFloat64Config().RandomizeSign,
@@ -262,9 +298,13 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 			Precision:     tr.GetGenerateFloat64Config().Precision,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateFullAddressConfig:
-		t.GenerateFullAddress = &GenerateFullAddressConfig{}
+		t.GenerateFullAddress = &GenerateFullAddressConfig{
+			Locale: (*int32)(tr.GetGenerateFullAddressConfig().Locale),
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateFullNameConfig:
-		t.GenerateFullName = &GenerateFullNameConfig{}
+		t.GenerateFullName = &GenerateFullNameConfig{
+			Locale: (*int32)(tr.GetGenerateFullNameConfig().Locale),
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateGenderConfig:
 		t.GenerateGender = &GenerateGenderConfig{
 			Abbreviate: tr.GetGenerateGenderConfig().Abbreviate,
@@ -278,7 +318,9 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 			Max:           tr.GetGenerateInt64Config().Max,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateLastNameConfig:
-		t.GenerateLastName = &GenerateLastNameConfig{}
+		t.GenerateLastName = &GenerateLastNameConfig{
+			Locale: (*int32)(tr.GetGenerateLastNameConfig().Locale),
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateSha256HashConfig:
 		t.GenerateSha256Hash = &GenerateSha256HashConfig{}
 	case *mgmtv1alpha1.TransformerConfig_GenerateSsnConfig:
@@ -286,13 +328,17 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 	case *mgmtv1alpha1.TransformerConfig_GenerateStateConfig:
 		t.GenerateState = &GenerateStateConfig{
 			GenerateFullName: tr.GetGenerateStateConfig().GenerateFullName,
+			Locale:           (*int32)(tr.GetGenerateStateConfig().Locale),
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateStreetAddressConfig:
-		t.GenerateStreetAddress = &GenerateStreetAddressConfig{}
+		t.GenerateStreetAddress = &GenerateStreetAddressConfig{
+			Locale: (*int32)(tr.GetGenerateStreetAddressConfig().Locale),
+		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateStringPhoneNumberConfig:
 		t.GenerateStringPhoneNumber = &GenerateStringPhoneNumberConfig{
-			Min: tr.GetGenerateStringPhoneNumberConfig().Min,
-			Max: tr.GetGenerateStringPhoneNumberConfig().Max,
+			Min:    tr.GetGenerateStringPhoneNumberConfig().Min,
+			Max:    tr.GetGenerateStringPhoneNumberConfig().Max,
+			Locale: (*int32)(tr.GetGenerateStringPhoneNumberConfig().Locale),
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateStringConfig:
 		t.GenerateString = &GenerateStringConfig{
@@ -310,7 +356,9 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 			IncludeHyphens: tr.GetGenerateUuidConfig().IncludeHyphens,
 		}
 	case *mgmtv1alpha1.TransformerConfig_GenerateZipcodeConfig:
-		t.GenerateZipcode = &GenerateZipcodeConfig{}
+		t.GenerateZipcode = &GenerateZipcodeConfig{
+			Locale: (*int32)(tr.GetGenerateZipcodeConfig().Locale),
+		}
 	case *mgmtv1alpha1.TransformerConfig_TransformE164PhoneNumberConfig:
 		t.TransformE164PhoneNumber = &TransformE164PhoneNumberConfig{
 			PreserveLength: tr.GetTransformE164PhoneNumberConfig().PreserveLength,
@@ -318,6 +366,7 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 	case *mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig:
 		t.TransformFirstname = &TransformFirstNameConfig{
 			PreserveLength: tr.GetTransformFirstNameConfig().PreserveLength,
+			Locale:         (*int32)(tr.GetTransformFirstNameConfig().Locale),
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformFloat64Config:
 		t.TransformFloat64 = &TransformFloat64Config{
@@ -327,6 +376,7 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 	case *mgmtv1alpha1.TransformerConfig_TransformFullNameConfig:
 		t.TransformFullName = &TransformFullNameConfig{
 			PreserveLength: tr.GetTransformFullNameConfig().PreserveLength,
+			Locale:         (*int32)(tr.GetTransformFullNameConfig().Locale),
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformInt64PhoneNumberConfig:
 		t.TransformInt64PhoneNumber = &TransformInt64PhoneNumberConfig{
@@ -340,6 +390,7 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 	case *mgmtv1alpha1.TransformerConfig_TransformLastNameConfig:
 		t.TransformLastName = &TransformLastNameConfig{
 			PreserveLength: tr.GetTransformLastNameConfig().PreserveLength,
+			Locale:         (*int32)(tr.GetTransformLastNameConfig().Locale),
 		}
 	case *mgmtv1alpha1.TransformerConfig_TransformPhoneNumberConfig:
 		t.TransformPhoneNumber = &TransformPhoneNumberConfig{
@@ -377,6 +428,13 @@ func (t *TransformerConfigs) FromTransformerConfigDto(tr *mgmtv1alpha1.Transform
 		t.GenerateCountry = &GenerateCountryConfig{
 			GenerateFullName: tr.GetGenerateCountryConfig().GenerateFullName,
 		}
+	case *mgmtv1alpha1.TransformerConfig_TransformFpeConfig:
+		t.TransformFpe = &TransformFpeConfig{
+			Alphabet:               int32(tr.GetTransformFpeConfig().GetAlphabet()),
+			CustomAlphabet:         tr.GetTransformFpeConfig().CustomAlphabet,
+			Tweak:                  tr.GetTransformFpeConfig().Tweak,
+			PassThroughShortValues: tr.GetTransformFpeConfig().GetPassThroughShortValues(),
+		}
 	default:
 		t = &TransformerConfigs{}
 	}
@@ -403,6 +461,12 @@ func (t *JobMappingTransformerModel) ToTransformerDto() *mgmtv1alpha1.JobMapping
 }
 
 func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerConfig {
+	dto := t.toTransformerConfigDto()
+	dto.Deterministic = t.Deterministic
+	return dto
+}
+
+func (t *TransformerConfigs) toTransformerConfigDto() *mgmtv1alpha1.TransformerConfig {
 	switch {
 	case t.GenerateEmail != nil:
 		return &mgmtv1alpha1.TransformerConfig{
@@ -441,7 +505,9 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 	case t.GenerateCity != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateCityConfig{
-				GenerateCityConfig: &mgmtv1alpha1.GenerateCity{},
+				GenerateCityConfig: &mgmtv1alpha1.GenerateCity{
+					Locale: (*mgmtv1alpha1.TransformerLocale)(t.GenerateCity.Locale),
+				},
 			},
 		}
 	case t.GenerateDefault != nil:
@@ -452,15 +518,18 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateE164PhoneNumberConfig{
 				GenerateE164PhoneNumberConfig: &mgmtv1alpha1.GenerateE164PhoneNumber{
-					Min: t.GenerateE164PhoneNumber.Min,
-					Max: t.GenerateE164PhoneNumber.Max,
+					Min:    t.GenerateE164PhoneNumber.Min,
+					Max:    t.GenerateE164PhoneNumber.Max,
+					Locale: (*mgmtv1alpha1.TransformerLocale)(t.GenerateE164PhoneNumber.Locale),
 				},
 			},
 		}
 	case t.GenerateFirstName != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateFirstNameConfig{
-				GenerateFirstNameConfig: &mgmtv1alpha1.GenerateFirstName{},
+				GenerateFirstNameConfig: &mgmtv1alpha1.GenerateFirstName{
+					Locale: (*mgmtv1alpha1.TransformerLocale)(t.GenerateFirstName.Locale),
+				},
 			},
 		}
 	case t.GenerateFloat64 != nil:
@@ -477,13 +546,17 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 	case t.GenerateFullAddress != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateFullAddressConfig{
-				GenerateFullAddressConfig: &mgmtv1alpha1.GenerateFullAddress{},
+				GenerateFullAddressConfig: &mgmtv1alpha1.GenerateFullAddress{
+					Locale: (*mgmtv1alpha1.TransformerLocale)(t.GenerateFullAddress.Locale),
+				},
 			},
 		}
 	case t.GenerateFullName != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateFullNameConfig{
-				GenerateFullNameConfig: &mgmtv1alpha1.GenerateFullName{},
+				GenerateFullNameConfig: &mgmtv1alpha1.GenerateFullName{
+					Locale: (*mgmtv1alpha1.TransformerLocale)(t.GenerateFullName.Locale),
+				},
 			},
 		}
 	case t.GenerateGender != nil:
@@ -513,7 +586,9 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 	case t.GenerateLastName != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateLastNameConfig{
-				GenerateLastNameConfig: &mgmtv1alpha1.GenerateLastName{},
+				GenerateLastNameConfig: &mgmtv1alpha1.GenerateLastName{
+					Locale: (*mgmtv1alpha1.TransformerLocale)(t.GenerateLastName.Locale),
+				},
 			},
 		}
 	case t.GenerateSha256Hash != nil:
@@ -533,21 +608,25 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateStateConfig{
 				GenerateStateConfig: &mgmtv1alpha1.GenerateState{
 					GenerateFullName: t.GenerateState.GenerateFullName,
+					Locale:           (*mgmtv1alpha1.TransformerLocale)(t.GenerateState.Locale),
 				},
 			},
 		}
 	case t.GenerateStreetAddress != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateStreetAddressConfig{
-				GenerateStreetAddressConfig: &mgmtv1alpha1.GenerateStreetAddress{},
+				GenerateStreetAddressConfig: &mgmtv1alpha1.GenerateStreetAddress{
+					Locale: (*mgmtv1alpha1.TransformerLocale)(t.GenerateStreetAddress.Locale),
+				},
 			},
 		}
 	case t.GenerateStringPhoneNumber != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateStringPhoneNumberConfig{
 				GenerateStringPhoneNumberConfig: &mgmtv1alpha1.GenerateStringPhoneNumber{
-					Min: t.GenerateStringPhoneNumber.Min,
-					Max: t.GenerateStringPhoneNumber.Max,
+					Min:    t.GenerateStringPhoneNumber.Min,
+					Max:    t.GenerateStringPhoneNumber.Max,
+					Locale: (*mgmtv1alpha1.TransformerLocale)(t.GenerateStringPhoneNumber.Locale),
 				},
 			},
 		}
@@ -589,7 +668,9 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 	case t.GenerateZipcode != nil:
 		return &mgmtv1alpha1.TransformerConfig{
 			Config: &mgmtv1alpha1.TransformerConfig_GenerateZipcodeConfig{
-				GenerateZipcodeConfig: &mgmtv1alpha1.GenerateZipcode{},
+				GenerateZipcodeConfig: &mgmtv1alpha1.GenerateZipcode{
+					Locale: (*mgmtv1alpha1.TransformerLocale)(t.GenerateZipcode.Locale),
+				},
 			},
 		}
 	case t.TransformE164PhoneNumber != nil:
@@ -605,6 +686,7 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig{
 				TransformFirstNameConfig: &mgmtv1alpha1.TransformFirstName{
 					PreserveLength: t.TransformFirstname.PreserveLength,
+					Locale:         (*mgmtv1alpha1.TransformerLocale)(t.TransformFirstname.Locale),
 				},
 			},
 		}
@@ -622,6 +704,7 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_TransformFullNameConfig{
 				TransformFullNameConfig: &mgmtv1alpha1.TransformFullName{
 					PreserveLength: t.TransformFullName.PreserveLength,
+					Locale:         (*mgmtv1alpha1.TransformerLocale)(t.TransformFullName.Locale),
 				},
 			},
 		}
@@ -647,6 +730,7 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 			Config: &mgmtv1alpha1.TransformerConfig_TransformLastNameConfig{
 				TransformLastNameConfig: &mgmtv1alpha1.TransformLastName{
 					PreserveLength: t.TransformLastName.PreserveLength,
+					Locale:         (*mgmtv1alpha1.TransformerLocale)(t.TransformLastName.Locale),
 				},
 			},
 		}
@@ -726,6 +810,17 @@ func (t *TransformerConfigs) ToTransformerConfigDto() *mgmtv1alpha1.TransformerC
 				},
 			},
 		}
+	case t.TransformFpe != nil:
+		return &mgmtv1alpha1.TransformerConfig{
+			Config: &mgmtv1alpha1.TransformerConfig_TransformFpeConfig{
+				TransformFpeConfig: &mgmtv1alpha1.TransformFpe{
+					Alphabet:               mgmtv1alpha1.FpeAlphabet(t.TransformFpe.Alphabet),
+					CustomAlphabet:         t.TransformFpe.CustomAlphabet,
+					Tweak:                  t.TransformFpe.Tweak,
+					PassThroughShortValues: t.TransformFpe.PassThroughShortValues,
+				},
+			},
+		}
 	default:
 		return &mgmtv1alpha1.TransformerConfig{}
 	}
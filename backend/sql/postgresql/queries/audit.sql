@@ -0,0 +1,17 @@
+-- name: CreateAuditEvent :exec
+INSERT INTO neosync_api.audit_events (
+  account_id, actor_type, actor_user_id, actor_api_key_id, procedure, resource_ids, request, status_code, changes
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+);
+
+-- name: GetAuditEvents :many
+SELECT * FROM neosync_api.audit_events
+WHERE account_id = sqlc.arg('accountId')
+  AND (sqlc.narg('actorId')::uuid IS NULL OR actor_user_id = sqlc.narg('actorId') OR actor_api_key_id = sqlc.narg('actorId'))
+  AND (sqlc.narg('procedure')::text IS NULL OR procedure = sqlc.narg('procedure'))
+  AND (sqlc.narg('resourceId')::text IS NULL OR sqlc.narg('resourceId') = ANY(resource_ids))
+  AND (sqlc.narg('startTime')::timestamp IS NULL OR created_at >= sqlc.narg('startTime'))
+  AND (sqlc.narg('endTime')::timestamp IS NULL OR created_at < sqlc.narg('endTime'))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('maxResults');
@@ -36,6 +36,15 @@ updated_by_id = $3
 WHERE id = $4
 RETURNING *;
 
+-- name: GetAllConnections :many
+SELECT * from neosync_api.connections
+ORDER BY created_at ASC;
+
+-- name: UpdateConnectionConfig :execrows
+UPDATE neosync_api.connections
+SET connection_config = sqlc.arg('connectionConfig')
+WHERE id = sqlc.arg('id') AND connection_config = sqlc.arg('previousConnectionConfig');
+
 -- name: IsConnectionNameAvailable :one
 SELECT count(c.id) from neosync_api.connections c
 INNER JOIN neosync_api.accounts a ON a.id = c.account_id
@@ -0,0 +1,51 @@
+-- name: CreateNotificationRule :one
+INSERT INTO neosync_api.notification_rules (
+  account_id, job_id, name, url, signing_secret, event_types, payload_format
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: GetNotificationRuleById :one
+SELECT * FROM neosync_api.notification_rules
+WHERE id = $1;
+
+-- name: GetNotificationRulesByAccount :many
+SELECT * FROM neosync_api.notification_rules
+WHERE account_id = sqlc.arg('accountId')
+  AND (sqlc.narg('jobId')::uuid IS NULL OR job_id IS NULL OR job_id = sqlc.narg('jobId'))
+ORDER BY created_at ASC;
+
+-- name: GetNotificationRulesForJobEvent :many
+SELECT * FROM neosync_api.notification_rules
+WHERE account_id = sqlc.arg('accountId')
+  AND (job_id IS NULL OR job_id = sqlc.arg('jobId'))
+  AND sqlc.arg('eventType')::smallint = ANY(event_types);
+
+-- name: GetAllNotificationRules :many
+SELECT * FROM neosync_api.notification_rules
+ORDER BY created_at ASC;
+
+-- name: UpdateNotificationRuleSigningSecret :execrows
+UPDATE neosync_api.notification_rules
+SET signing_secret = sqlc.arg('signingSecret')
+WHERE id = sqlc.arg('id') AND signing_secret = sqlc.arg('previousSigningSecret');
+
+-- name: RemoveNotificationRuleById :exec
+DELETE FROM neosync_api.notification_rules WHERE id = $1;
+
+-- name: CreateNotificationDelivery :one
+INSERT INTO neosync_api.notification_deliveries (
+  rule_id, account_id, job_id, job_run_id, event_type, succeeded, attempts, response_status_code, error
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+)
+RETURNING *;
+
+-- name: GetNotificationDeliveries :many
+SELECT * FROM neosync_api.notification_deliveries
+WHERE account_id = sqlc.arg('accountId')
+  AND (sqlc.narg('ruleId')::uuid IS NULL OR rule_id = sqlc.narg('ruleId'))
+  AND (sqlc.narg('jobRunId')::text IS NULL OR job_run_id = sqlc.narg('jobRunId'))
+ORDER BY created_at DESC
+LIMIT sqlc.arg('maxResults');
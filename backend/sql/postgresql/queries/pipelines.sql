@@ -0,0 +1,32 @@
+-- name: CreatePipeline :one
+INSERT INTO neosync_api.pipelines (
+  account_id, name, steps, cron_schedule, created_by_id, updated_by_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: GetPipelineById :one
+SELECT * FROM neosync_api.pipelines
+WHERE id = $1;
+
+-- name: GetPipelinesByAccount :many
+SELECT * FROM neosync_api.pipelines
+WHERE account_id = $1
+ORDER BY created_at ASC;
+
+-- name: IsPipelineNameAvailable :one
+SELECT count(*) FROM neosync_api.pipelines
+WHERE account_id = sqlc.arg('accountId') and name = sqlc.arg('pipelineName');
+
+-- name: UpdatePipeline :one
+UPDATE neosync_api.pipelines
+SET name = $1,
+steps = $2,
+cron_schedule = $3,
+updated_by_id = $4
+WHERE id = $5
+RETURNING *;
+
+-- name: RemovePipelineById :exec
+DELETE FROM neosync_api.pipelines WHERE id = $1;
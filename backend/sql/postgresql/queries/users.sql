@@ -99,9 +99,9 @@ WHERE u.id = $1;
 
 -- name: CreateAccountUserAssociation :exec
 INSERT INTO neosync_api.account_user_associations (
-  account_id, user_id
+  account_id, user_id, role
 ) VALUES (
-  $1, $2
+  $1, $2, $3
 )
 ON CONFLICT (account_id, user_id) DO NOTHING;
 
@@ -111,6 +111,20 @@ INNER JOIN neosync_api.accounts a ON a.id = aua.account_id
 INNER JOIN neosync_api.users u ON u.id = aua.user_id
 WHERE a.id = sqlc.arg('accountId') AND u.id = sqlc.arg('userId');
 
+-- name: GetAccountUserAssociations :many
+SELECT aua.* from neosync_api.account_user_associations aua
+WHERE aua.account_id = sqlc.arg('accountId');
+
+-- name: UpdateAccountUserRole :one
+UPDATE neosync_api.account_user_associations
+SET role = sqlc.arg('role')
+WHERE account_id = sqlc.arg('accountId') AND user_id = sqlc.arg('userId')
+RETURNING *;
+
+-- name: GetAccountUserCountByRole :one
+SELECT count(aua.id) from neosync_api.account_user_associations aua
+WHERE aua.account_id = sqlc.arg('accountId') AND aua.role = sqlc.arg('role');
+
 -- name: IsUserInAccount :one
 SELECT count(aua.id) from neosync_api.account_user_associations aua
 INNER JOIN neosync_api.accounts a ON a.id = aua.account_id
@@ -156,9 +170,9 @@ WHERE account_id = sqlc.arg('accountId') AND user_id = sqlc.arg('userId');
 
 -- name: CreateAccountInvite :one
 INSERT INTO neosync_api.account_invites (
-  account_id, sender_user_id, email, expires_at
+  account_id, sender_user_id, email, expires_at, role
 ) VALUES (
-  $1, $2, $3, $4
+  $1, $2, $3, $4, $5
 )
 RETURNING *;
 
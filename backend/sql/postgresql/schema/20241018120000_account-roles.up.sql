@@ -0,0 +1,7 @@
+-- existing members keep full access to their accounts
+ALTER TABLE neosync_api.account_user_associations
+ADD COLUMN role smallint NOT NULL DEFAULT 1;
+
+-- pending invites were sent without a role being chosen, so they are given the least privileged viewer role
+ALTER TABLE neosync_api.account_invites
+ADD COLUMN role smallint NOT NULL DEFAULT 4;
@@ -0,0 +1,3 @@
+ALTER TABLE neosync_api.account_api_keys
+DROP COLUMN IF EXISTS scopes,
+DROP COLUMN IF EXISTS job_ids;
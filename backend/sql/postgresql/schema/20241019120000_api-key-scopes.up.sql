@@ -0,0 +1,4 @@
+-- an empty set of scopes grants full access to the account
+ALTER TABLE neosync_api.account_api_keys
+ADD COLUMN scopes text[] NOT NULL DEFAULT '{}',
+ADD COLUMN job_ids uuid[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS prevent_neosync_api_audit_events_modification ON neosync_api.audit_events;
+
+DROP FUNCTION IF EXISTS prevent_audit_event_modification();
+
+DROP INDEX IF EXISTS neosync_api.audit_events_account_id_created_at_idx;
+
+DROP TABLE IF EXISTS neosync_api.audit_events;
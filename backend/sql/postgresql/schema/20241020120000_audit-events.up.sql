@@ -0,0 +1,29 @@
+-- account_id is intentionally not a foreign key so that audit history survives the deletion of the account
+CREATE TABLE IF NOT EXISTS neosync_api.audit_events (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  account_id uuid NULL,
+  actor_type smallint NOT NULL,
+  actor_user_id uuid NULL,
+  actor_api_key_id uuid NULL,
+  procedure text NOT NULL,
+  resource_ids text[] NOT NULL DEFAULT '{}',
+  request jsonb NOT NULL DEFAULT '{}',
+  status_code text NOT NULL,
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT audit_events_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_account_id_created_at_idx
+ON neosync_api.audit_events (account_id, created_at DESC);
+
+CREATE OR REPLACE FUNCTION prevent_audit_event_modification()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit events are append-only';
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER prevent_neosync_api_audit_events_modification
+BEFORE UPDATE OR DELETE ON neosync_api.audit_events
+FOR EACH ROW
+EXECUTE FUNCTION prevent_audit_event_modification();
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS neosync_api.notification_deliveries_account_id_created_at_idx;
+
+DROP TABLE IF EXISTS neosync_api.notification_deliveries;
+
+DROP TRIGGER IF EXISTS update_neosync_api_notification_rules_updated_at ON neosync_api.notification_rules;
+
+DROP INDEX IF EXISTS neosync_api.notification_rules_account_id_idx;
+
+DROP TABLE IF EXISTS neosync_api.notification_rules;
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS neosync_api.notification_rules (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  account_id uuid NOT NULL,
+  job_id uuid NULL,
+  name text NOT NULL,
+  url text NOT NULL,
+  signing_secret text NOT NULL,
+  event_types smallint[] NOT NULL,
+  payload_format smallint NOT NULL DEFAULT 0,
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT notification_rules_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_notification_rules_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE,
+  CONSTRAINT fk_notification_rules_jobs_id FOREIGN KEY (job_id) REFERENCES neosync_api.jobs(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS notification_rules_account_id_idx
+ON neosync_api.notification_rules (account_id);
+
+CREATE TRIGGER update_neosync_api_notification_rules_updated_at
+BEFORE UPDATE ON neosync_api.notification_rules
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS neosync_api.notification_deliveries (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  rule_id uuid NOT NULL,
+  account_id uuid NOT NULL,
+  job_id uuid NOT NULL,
+  job_run_id text NOT NULL,
+  event_type smallint NOT NULL,
+  succeeded boolean NOT NULL,
+  attempts integer NOT NULL,
+  response_status_code integer NULL,
+  error text NULL,
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT notification_deliveries_pkey PRIMARY KEY (id),
+  CONSTRAINT fk_notification_deliveries_rules_id FOREIGN KEY (rule_id) REFERENCES neosync_api.notification_rules(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS notification_deliveries_account_id_created_at_idx
+ON neosync_api.notification_deliveries (account_id, created_at DESC);
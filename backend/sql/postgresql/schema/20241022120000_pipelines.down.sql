@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_neosync_api_pipelines_updated_at ON neosync_api.pipelines;
+
+DROP TABLE IF EXISTS neosync_api.pipelines;
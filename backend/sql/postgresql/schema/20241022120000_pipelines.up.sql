@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS neosync_api.pipelines (
+  id uuid NOT NULL DEFAULT gen_random_uuid(),
+  account_id uuid NOT NULL,
+  name text NOT NULL,
+  steps jsonb NOT NULL DEFAULT '[]'::jsonb,
+  cron_schedule text NULL,
+  created_by_id uuid NOT NULL,
+  updated_by_id uuid NOT NULL,
+  created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT pipelines_pkey PRIMARY KEY (id),
+  CONSTRAINT pipelines_name_account_id UNIQUE (name, account_id),
+  CONSTRAINT fk_pipelines_accounts_id FOREIGN KEY (account_id) REFERENCES neosync_api.accounts(id) ON DELETE CASCADE,
+  CONSTRAINT fk_pipelines_created_by_users_id FOREIGN KEY (created_by_id) REFERENCES neosync_api.users(id),
+  CONSTRAINT fk_pipelines_updated_by_users_id FOREIGN KEY (updated_by_id) REFERENCES neosync_api.users(id)
+);
+
+CREATE TRIGGER update_neosync_api_pipelines_updated_at
+BEFORE UPDATE ON neosync_api.pipelines
+FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,2 @@
+-- the fields that were changed by an update procedure, along with their redacted values before and after the update
+ALTER TABLE neosync_api.audit_events ADD COLUMN IF NOT EXISTS changes jsonb NULL;
@@ -0,0 +1,3 @@
+-- encrypted secrets can not be restored, so rules with an encrypted secret must be recreated to receive a new secret
+ALTER TABLE neosync_api.notification_rules
+ALTER COLUMN signing_secret TYPE text USING COALESCE(signing_secret->>'value', '');
@@ -0,0 +1,4 @@
+-- signing secrets are stored as a json document so that they can be encrypted with the connection encryption keys.
+-- existing secrets are kept in plaintext until they are encrypted by the rotate-connection-keys command
+ALTER TABLE neosync_api.notification_rules
+ALTER COLUMN signing_secret TYPE jsonb USING jsonb_build_object('value', signing_secret);
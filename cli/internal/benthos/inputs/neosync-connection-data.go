@@ -167,6 +167,20 @@ func (g *neosyncInput) Connect(ctx context.Context) error {
 				},
 			}
 		}
+	} else if g.connectionType == "localDirectory" {
+		localDirCfg := &mgmtv1alpha1.LocalDirectoryStreamConfig{}
+		if g.connectionOpts != nil {
+			if g.connectionOpts.jobRunId != nil && *g.connectionOpts.jobRunId != "" {
+				localDirCfg.Id = &mgmtv1alpha1.LocalDirectoryStreamConfig_JobRunId{JobRunId: *g.connectionOpts.jobRunId}
+			} else if g.connectionOpts.jobId != nil && *g.connectionOpts.jobId != "" {
+				localDirCfg.Id = &mgmtv1alpha1.LocalDirectoryStreamConfig_JobId{JobId: *g.connectionOpts.jobId}
+			}
+		}
+		streamCfg = &mgmtv1alpha1.ConnectionStreamConfig{
+			Config: &mgmtv1alpha1.ConnectionStreamConfig_LocalDirConfig{
+				LocalDirConfig: localDirCfg,
+			},
+		}
 	}
 
 	resp, err := g.neosyncConnectApi.GetConnectionDataStream(ctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionDataStreamRequest{
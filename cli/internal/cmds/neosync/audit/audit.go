@@ -0,0 +1,213 @@
+package audit_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/version"
+	http_client "github.com/nucleuscloud/neosync/worker/pkg/http/client"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	procedurePrefix = "/mgmt.v1alpha1."
+)
+
+type listAuditEventsOpts struct {
+	accountId  string
+	actorId    string
+	procedure  string
+	resourceId string
+	since      time.Duration
+	limit      uint32
+	output     string
+}
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List the audit log of changes made to an account",
+		Long: `Lists the most recent changes made to the account through the Neosync API, such as creating or deleting connections, jobs and transformers or triggering job runs.
+The procedure may be provided in full (/mgmt.v1alpha1.JobService/CreateJobRun) or without the package prefix (JobService/CreateJobRun).`,
+		Example: `neosync audit --since 24h
+neosync audit --procedure JobService/CreateJobRun --resource-id <job-id>`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			opts := &listAuditEventsOpts{}
+			opts.accountId, err = cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			opts.actorId, err = cmd.Flags().GetString("actor-id")
+			if err != nil {
+				return err
+			}
+			opts.procedure, err = cmd.Flags().GetString("procedure")
+			if err != nil {
+				return err
+			}
+			opts.resourceId, err = cmd.Flags().GetString("resource-id")
+			if err != nil {
+				return err
+			}
+			opts.since, err = cmd.Flags().GetDuration("since")
+			if err != nil {
+				return err
+			}
+			opts.limit, err = cmd.Flags().GetUint32("limit")
+			if err != nil {
+				return err
+			}
+			opts.output, err = cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			if opts.output != "" && opts.output != "json" {
+				return fmt.Errorf("must provide valid output")
+			}
+			cmd.SilenceUsage = true
+			return listAuditEvents(cmd.Context(), &apiKey, opts)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account to list audit events for. Defaults to account id in cli context")
+	cmd.Flags().String("actor-id", "", "Only show changes made by this user or API key id")
+	cmd.Flags().String("procedure", "", "Only show changes made by this procedure. Ex: JobService/CreateJobRun")
+	cmd.Flags().String("resource-id", "", "Only show changes that reference this resource id")
+	cmd.Flags().Duration("since", 0, "Only show changes made within this duration. Ex: 24h")
+	cmd.Flags().Uint32("limit", 100, "The maximum number of audit events to return (max 1000)")
+	cmd.Flags().StringP("output", "o", "", "json")
+	return cmd
+}
+
+func listAuditEvents(
+	ctx context.Context,
+	apiKey *string,
+	opts *listAuditEventsOpts,
+) error {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	accountId := opts.accountId
+	if accountId == "" {
+		aId, err := userconfig.GetAccountId()
+		if err != nil {
+			fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+			return err
+		}
+		accountId = aId
+	}
+	if accountId == "" {
+		return errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+
+	req := &mgmtv1alpha1.ListAuditEventsRequest{
+		AccountId: accountId,
+		Limit:     &opts.limit,
+	}
+	if opts.actorId != "" {
+		req.ActorId = &opts.actorId
+	}
+	if opts.procedure != "" {
+		procedure := toFullProcedure(opts.procedure)
+		req.Procedure = &procedure
+	}
+	if opts.resourceId != "" {
+		req.ResourceId = &opts.resourceId
+	}
+	if opts.since > 0 {
+		req.StartTime = timestamppb.New(time.Now().Add(-opts.since))
+	}
+
+	auditclient := mgmtv1alpha1connect.NewAuditServiceClient(
+		http_client.NewWithHeaders(version.Get().Headers()),
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(
+			auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey)),
+		),
+	)
+	res, err := auditclient.ListAuditEvents(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	if opts.output == "json" {
+		marshaled, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(res.Msg)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(marshaled)) //nolint:forbidigo
+		return nil
+	}
+
+	fmt.Println() //nolint:forbidigo
+	printAuditEventTable(res.Msg.GetEvents())
+	fmt.Println() //nolint:forbidigo
+	return nil
+}
+
+// Allows the procedure to be provided without the package prefix
+func toFullProcedure(procedure string) string {
+	if strings.HasPrefix(procedure, "/") {
+		return procedure
+	}
+	return procedurePrefix + procedure
+}
+
+func printAuditEventTable(
+	events []*mgmtv1alpha1.AuditEvent,
+) {
+	tbl := table.
+		New("Time", "Actor", "Procedure", "Resources", "Status").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for _, event := range events {
+		tbl.AddRow(
+			event.GetCreatedAt().AsTime().Local().Format(time.RFC3339),
+			getActorDisplay(event),
+			strings.TrimPrefix(event.GetProcedure(), procedurePrefix),
+			strings.Join(event.GetResourceIds(), ", "),
+			event.GetStatusCode(),
+		)
+	}
+	tbl.Print()
+}
+
+func getActorDisplay(event *mgmtv1alpha1.AuditEvent) string {
+	switch event.GetActorType() {
+	case mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_USER:
+		return fmt.Sprintf("user:%s", event.GetActorUserId())
+	case mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_API_KEY:
+		if event.GetActorApiKeyId() == "" {
+			return "api-key:worker"
+		}
+		return fmt.Sprintf("api-key:%s", event.GetActorApiKeyId())
+	case mgmtv1alpha1.AuditActorType_AUDIT_ACTOR_TYPE_ANONYMOUS:
+		return "anonymous"
+	default:
+		return "unknown"
+	}
+}
@@ -130,6 +130,12 @@ func getCategory(cc *mgmtv1alpha1.ConnectionConfig) string {
 		return "OpenAI"
 	case *mgmtv1alpha1.ConnectionConfig_DynamodbConfig:
 		return "DynamoDB"
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		return "Kafka"
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		return "SQLite"
+	case *mgmtv1alpha1.ConnectionConfig_LocalDirConfig:
+		return "Local Directory"
 	default:
 		return "Unknown"
 	}
@@ -0,0 +1,236 @@
+package connections_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/version"
+	http_client "github.com/nucleuscloud/neosync/worker/pkg/http/client"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type scanOptions struct {
+	schemas     []string
+	tables      []*mgmtv1alpha1.DatabaseTable
+	sampleSize  *int64
+	usePresidio bool
+	showAll     bool
+	output      string
+}
+
+func newScanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scan [id]",
+		Short: "scans the tables of a connection for columns that contain PII",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("must provide connection uuid as argument")
+			}
+			connectionUuid, err := uuid.Parse(args[0])
+			if err != nil {
+				return err
+			}
+
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+
+			opts := &scanOptions{}
+			opts.schemas, err = cmd.Flags().GetStringSlice("schema")
+			if err != nil {
+				return err
+			}
+			tables, err := cmd.Flags().GetStringSlice("table")
+			if err != nil {
+				return err
+			}
+			opts.tables, err = parseTables(tables)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("sample-size") {
+				sampleSize, err := cmd.Flags().GetInt64("sample-size")
+				if err != nil {
+					return err
+				}
+				opts.sampleSize = &sampleSize
+			}
+			opts.usePresidio, err = cmd.Flags().GetBool("presidio")
+			if err != nil {
+				return err
+			}
+			opts.showAll, err = cmd.Flags().GetBool("all")
+			if err != nil {
+				return err
+			}
+			opts.output, err = cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			if opts.output != "" && opts.output != "json" {
+				return fmt.Errorf("must provide valid output")
+			}
+
+			cmd.SilenceUsage = true
+			return scanConnection(cmd.Context(), connectionUuid.String(), &apiKey, &accountId, opts)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that connection is in. Defaults to account id in cli context")
+	cmd.Flags().StringSlice("schema", []string{}, "Only scans the tables of this schema. Can be provided multiple times")
+	cmd.Flags().StringSlice("table", []string{}, "Only scans this table in the format <schema>.<table>. Can be provided multiple times")
+	cmd.Flags().Int64("sample-size", 100, "The maximum number of rows that are sampled from each table")
+	cmd.Flags().Bool("presidio", false, "Also runs the sampled values through the Presidio analyzer. Neosync Cloud/Enterprise only")
+	cmd.Flags().Bool("all", false, "Prints every scanned column instead of only the columns where PII was detected")
+	cmd.Flags().StringP("output", "o", "", "json")
+	return cmd
+}
+
+func parseTables(tables []string) ([]*mgmtv1alpha1.DatabaseTable, error) {
+	output := make([]*mgmtv1alpha1.DatabaseTable, 0, len(tables))
+	for _, table := range tables {
+		schema, name, ok := strings.Cut(table, ".")
+		if !ok || schema == "" || name == "" {
+			return nil, fmt.Errorf("table must be in the format <schema>.<table>: %s", table)
+		}
+		output = append(output, &mgmtv1alpha1.DatabaseTable{Schema: schema, Table: name})
+	}
+	return output, nil
+}
+
+func scanConnection(
+	ctx context.Context,
+	connectionId string,
+	apiKey, accountIdFlag *string,
+	opts *scanOptions,
+) error {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	var accountId = accountIdFlag
+	if accountId == nil || *accountId == "" {
+		aId, err := userconfig.GetAccountId()
+		if err != nil {
+			fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+			return err
+		}
+		accountId = &aId
+	}
+
+	if accountId == nil || *accountId == "" {
+		return errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+
+	httpclient := http_client.NewWithHeaders(version.Get().Headers())
+	interceptors := connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey)))
+	connectionclient := mgmtv1alpha1connect.NewConnectionServiceClient(httpclient, serverconfig.GetApiBaseUrl(), interceptors)
+	connectiondataclient := mgmtv1alpha1connect.NewConnectionDataServiceClient(httpclient, serverconfig.GetApiBaseUrl(), interceptors)
+
+	connection, err := connectionclient.GetConnection(ctx, connect.NewRequest[mgmtv1alpha1.GetConnectionRequest](&mgmtv1alpha1.GetConnectionRequest{
+		Id: connectionId,
+	}))
+	if err != nil {
+		return err
+	}
+	if connection.Msg.GetConnection().GetAccountId() != *accountId {
+		return fmt.Errorf("Unable to scan connection. Connection not found. AccountId: %s", *accountId)
+	}
+
+	res, err := connectiondataclient.ScanConnectionForPii(ctx, connect.NewRequest[mgmtv1alpha1.ScanConnectionForPiiRequest](&mgmtv1alpha1.ScanConnectionForPiiRequest{
+		ConnectionId: connectionId,
+		Schemas:      opts.schemas,
+		Tables:       opts.tables,
+		SampleSize:   opts.sampleSize,
+		UsePresidio:  opts.usePresidio,
+	}))
+	if err != nil {
+		return err
+	}
+
+	if opts.output == "json" {
+		marshaled, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(res.Msg)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(marshaled)) //nolint:forbidigo
+		return nil
+	}
+
+	columns := []*mgmtv1alpha1.ColumnPiiClassification{}
+	for _, column := range res.Msg.GetColumns() {
+		if opts.showAll || column.GetCategory() != mgmtv1alpha1.PiiCategory_PII_CATEGORY_NONE {
+			columns = append(columns, column)
+		}
+	}
+
+	fmt.Println() //nolint:forbidigo
+	if len(columns) == 0 {
+		fmt.Printf("No PII was detected in the %d scanned columns\n", len(res.Msg.GetColumns())) //nolint:forbidigo
+		fmt.Println()                                                                            //nolint:forbidigo
+		return nil
+	}
+	printScanTable(columns)
+	fmt.Println() //nolint:forbidigo
+	return nil
+}
+
+func printScanTable(
+	columns []*mgmtv1alpha1.ColumnPiiClassification,
+) {
+	tbl := table.
+		New("Column", "Category", "Confidence", "Detectors", "Suggested Transformer").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for _, column := range columns {
+		detectors := make([]string, 0, len(column.GetDetectors()))
+		for _, detector := range column.GetDetectors() {
+			detectors = append(detectors, strings.ToLower(strings.TrimPrefix(detector.String(), "PII_DETECTOR_")))
+		}
+		tbl.AddRow(
+			fmt.Sprintf("%s.%s.%s", column.GetSchema(), column.GetTable(), column.GetColumn()),
+			strings.ToLower(strings.TrimPrefix(column.GetCategory().String(), "PII_CATEGORY_")),
+			fmt.Sprintf("%.2f", column.GetConfidence()),
+			strings.Join(detectors, ", "),
+			getTransformerConfigName(column.GetSuggestedTransformer()),
+		)
+	}
+	tbl.Print()
+}
+
+// Returns the name of the transformer that is configured. Example: transform_email
+func getTransformerConfigName(config *mgmtv1alpha1.TransformerConfig) string {
+	if config == nil {
+		return ""
+	}
+	msg := config.ProtoReflect()
+	field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("config"))
+	if field == nil {
+		return ""
+	}
+	return strings.TrimSuffix(string(field.Name()), "_config")
+}
@@ -15,5 +15,6 @@ func NewCmd() *cobra.Command {
 
 	cmd.AddCommand(newListCmd())
 	cmd.AddCommand(newTriggerCmd())
+	cmd.AddCommand(newPlanCmd())
 	return cmd
 }
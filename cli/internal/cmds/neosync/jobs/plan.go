@@ -0,0 +1,213 @@
+package jobs_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/version"
+	http_client "github.com/nucleuscloud/neosync/worker/pkg/http/client"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newPlanCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan [id]",
+		Short: "show what a run of a job would do without executing anything",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("must provide job uuid as argument")
+			}
+
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			if output != "" && output != "json" {
+				return fmt.Errorf("must provide valid output")
+			}
+
+			showQueries, err := cmd.Flags().GetBool("show-queries")
+			if err != nil {
+				return err
+			}
+
+			jobUuid, err := uuid.Parse(args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			return planJob(cmd.Context(), jobUuid.String(), &apiKey, &accountId, output, showQueries)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account that job is in. Defaults to account id in cli context")
+	cmd.Flags().StringP("output", "o", "", "json")
+	cmd.Flags().Bool("show-queries", false, "Prints the select query of each table run")
+	return cmd
+}
+
+func planJob(
+	ctx context.Context,
+	jobId string,
+	apiKey, accountIdFlag *string,
+	output string,
+	showQueries bool,
+) error {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return err
+	}
+	var accountId = accountIdFlag
+	if accountId == nil || *accountId == "" {
+		aId, err := userconfig.GetAccountId()
+		if err != nil {
+			fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+			return err
+		}
+		accountId = &aId
+	}
+
+	if accountId == nil || *accountId == "" {
+		return errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(
+		http_client.NewWithHeaders(version.Get().Headers()),
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey))),
+	)
+	job, err := jobclient.GetJob(ctx, connect.NewRequest[mgmtv1alpha1.GetJobRequest](&mgmtv1alpha1.GetJobRequest{
+		Id: jobId,
+	}))
+	if err != nil {
+		return err
+	}
+	if job.Msg.Job.AccountId != *accountId {
+		return fmt.Errorf("Unable to plan job. Job not found. AccountId: %s", *accountId)
+	}
+
+	res, err := jobclient.GetJobPlan(ctx, connect.NewRequest[mgmtv1alpha1.GetJobPlanRequest](&mgmtv1alpha1.GetJobPlanRequest{
+		JobId: jobId,
+	}))
+	if err != nil {
+		return err
+	}
+
+	if output == "json" {
+		marshaled, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(res.Msg.GetPlan())
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(marshaled)) //nolint:forbidigo
+		return nil
+	}
+
+	fmt.Println() //nolint:forbidigo
+	printTableRunTable(res.Msg.GetPlan().GetTableRuns())
+	if showQueries {
+		for _, run := range res.Msg.GetPlan().GetTableRuns() {
+			fmt.Println()                                                                                                  //nolint:forbidigo
+			fmt.Println(color.New(color.FgGreen).Sprintf("%s.%s (%s)", run.GetSchema(), run.GetTable(), run.GetRunType())) //nolint:forbidigo
+			fmt.Println(run.GetSelectQuery())                                                                              //nolint:forbidigo
+		}
+	}
+	for _, destination := range res.Msg.GetPlan().GetDestinations() {
+		printDestinationStatements(destination)
+	}
+	fmt.Println() //nolint:forbidigo
+	return nil
+}
+
+func printTableRunTable(
+	runs []*mgmtv1alpha1.JobPlanTableRun,
+) {
+	tbl := table.
+		New("Stage", "Table", "Run Type", "Depends On", "Estimated Rows").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for _, run := range runs {
+		estimatedRows := "unknown"
+		if run.EstimatedRowCount != nil {
+			estimatedRows = fmt.Sprintf("%d", run.GetEstimatedRowCount())
+		}
+		tbl.AddRow(
+			run.GetStage(),
+			fmt.Sprintf("%s.%s", run.GetSchema(), run.GetTable()),
+			run.GetRunType(),
+			strings.Join(run.GetDependsOn(), ", "),
+			estimatedRows,
+		)
+	}
+	tbl.Print()
+}
+
+func printDestinationStatements(
+	destination *mgmtv1alpha1.JobPlanDestination,
+) {
+	if len(destination.GetInitStatements()) == 0 && len(destination.GetTruncateStatements()) == 0 && len(destination.GetResetStatements()) == 0 && len(destination.GetLossyConversions()) == 0 {
+		return
+	}
+	fmt.Println()                                                                                   //nolint:forbidigo
+	fmt.Println(color.New(color.FgYellow).Sprintf("Destination %s", destination.GetConnectionId())) //nolint:forbidigo
+	for _, block := range destination.GetInitStatements() {
+		printStatements(block.GetLabel(), block.GetStatements())
+	}
+	printStatements("truncate", destination.GetTruncateStatements())
+	printStatements("reset", destination.GetResetStatements())
+	printLossyConversions(destination.GetLossyConversions())
+}
+
+func printLossyConversions(conversions []*mgmtv1alpha1.JobPlanLossyConversion) {
+	if len(conversions) == 0 {
+		return
+	}
+	fmt.Println(color.New(color.FgRed).Sprintf("-- lossy conversions")) //nolint:forbidigo
+	for _, conversion := range conversions {
+		if conversion.GetColumn() == "" {
+			fmt.Printf("%s: %s\n", conversion.GetTable(), conversion.GetReason()) //nolint:forbidigo
+			continue
+		}
+		fmt.Printf( //nolint:forbidigo
+			"%s.%s (%s -> %s): %s\n",
+			conversion.GetTable(), conversion.GetColumn(), conversion.GetSourceType(), conversion.GetDestinationType(), conversion.GetReason(),
+		)
+	}
+}
+
+func printStatements(label string, statements []string) {
+	if len(statements) == 0 {
+		return
+	}
+	fmt.Println(color.New(color.FgGreen).Sprintf("-- %s", label)) //nolint:forbidigo
+	for _, stmt := range statements {
+		fmt.Println(stmt) //nolint:forbidigo
+	}
+}
@@ -37,6 +37,11 @@ func newTriggerCmd() *cobra.Command {
 				return err
 			}
 
+			resumeFromRunId, err := cmd.Flags().GetString("resume-from-run-id")
+			if err != nil {
+				return err
+			}
+
 			jobId := args[0]
 
 			jobUuid, err := uuid.Parse(jobId)
@@ -45,10 +50,11 @@ func newTriggerCmd() *cobra.Command {
 			}
 
 			cmd.SilenceUsage = true
-			return triggerJob(cmd.Context(), jobUuid.String(), &apiKey, &accountId)
+			return triggerJob(cmd.Context(), jobUuid.String(), &apiKey, &accountId, resumeFromRunId)
 		},
 	}
 	cmd.Flags().String("account-id", "", "Account that job is in. Defaults to account id in cli context")
+	cmd.Flags().String("resume-from-run-id", "", "Resumes a failed or canceled job run by only syncing the tables that did not complete in it")
 	return cmd
 }
 
@@ -56,6 +62,7 @@ func triggerJob(
 	ctx context.Context,
 	jobId string,
 	apiKey, accountIdFlag *string,
+	resumeFromRunId string,
 ) error {
 	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
 	if err != nil {
@@ -89,8 +96,13 @@ func triggerJob(
 	if job.Msg.Job.AccountId != *accountId {
 		return fmt.Errorf("Unable to trigger job run. Job not found. AccountId: %s", *accountId)
 	}
+	var resumeFromRunIdPtr *string
+	if resumeFromRunId != "" {
+		resumeFromRunIdPtr = &resumeFromRunId
+	}
 	_, err = jobclient.CreateJobRun(ctx, connect.NewRequest[mgmtv1alpha1.CreateJobRunRequest](&mgmtv1alpha1.CreateJobRunRequest{
-		JobId: jobId,
+		JobId:           jobId,
+		ResumeFromRunId: resumeFromRunIdPtr,
 	}))
 	if err != nil {
 		return err
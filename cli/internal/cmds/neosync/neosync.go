@@ -8,9 +8,11 @@ import (
 	"path/filepath"
 
 	accounts_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/accounts"
+	audit_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/audit"
 	connections_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/connections"
 	jobs_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/jobs"
 	login_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/login"
+	notifications_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/notifications"
 	sync_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/sync"
 	version_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/version"
 	whoami_cmd "github.com/nucleuscloud/neosync/cli/internal/cmds/neosync/whoami"
@@ -73,6 +75,8 @@ func Execute() {
 	rootCmd.AddCommand(sync_cmd.NewCmd())
 	rootCmd.AddCommand(accounts_cmd.NewCmd())
 	rootCmd.AddCommand(connections_cmd.NewCmd())
+	rootCmd.AddCommand(audit_cmd.NewCmd())
+	rootCmd.AddCommand(notifications_cmd.NewCmd())
 
 	cobra.CheckErr(rootCmd.Execute())
 }
@@ -0,0 +1,115 @@
+package notifications_cmd
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+type createRuleOpts struct {
+	accountId string
+	jobId     string
+	name      string
+	url       string
+	events    []string
+	slack     bool
+}
+
+func newCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Creates a rule that delivers job run events to a webhook",
+		Long: `Creates a rule that sends an HTTP POST to the url when a job run starts, succeeds, fails or is canceled.
+Each delivery is signed with the signing secret that is printed when the rule is created. The secret is not shown again.`,
+		Example: `neosync notifications create --name prod-failures --url https://example.com/hooks/neosync --events failed,canceled
+neosync notifications create --name slack --job-id <job-id> --url https://hooks.slack.com/services/... --slack`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			opts := &createRuleOpts{}
+			opts.accountId, err = cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			opts.jobId, err = cmd.Flags().GetString("job-id")
+			if err != nil {
+				return err
+			}
+			opts.name, err = cmd.Flags().GetString("name")
+			if err != nil {
+				return err
+			}
+			opts.url, err = cmd.Flags().GetString("url")
+			if err != nil {
+				return err
+			}
+			opts.events, err = cmd.Flags().GetStringSlice("events")
+			if err != nil {
+				return err
+			}
+			opts.slack, err = cmd.Flags().GetBool("slack")
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return createRule(cmd.Context(), &apiKey, opts)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account to create the rule in. Defaults to account id in cli context")
+	cmd.Flags().String("job-id", "", "Only notify for runs of this job. Defaults to every job in the account")
+	cmd.Flags().String("name", "", "The name of the rule")
+	cmd.Flags().String("url", "", "The http or https url the webhook is delivered to")
+	cmd.Flags().StringSlice("events", []string{"failed"}, "The job run events to notify on. One or more of: started, succeeded, failed, canceled")
+	cmd.Flags().Bool("slack", false, "Formats the payload as a Slack incoming webhook message")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("url")
+	return cmd
+}
+
+func createRule(
+	ctx context.Context,
+	apiKey *string,
+	opts *createRuleOpts,
+) error {
+	eventTypes, err := parseEventTypes(opts.events)
+	if err != nil {
+		return err
+	}
+	accountId, err := resolveAccountId(opts.accountId)
+	if err != nil {
+		return err
+	}
+
+	req := &mgmtv1alpha1.CreateNotificationRuleRequest{
+		AccountId:     accountId,
+		Name:          opts.name,
+		Url:           opts.url,
+		EventTypes:    eventTypes,
+		PayloadFormat: mgmtv1alpha1.NotificationPayloadFormat_NOTIFICATION_PAYLOAD_FORMAT_JSON,
+	}
+	if opts.jobId != "" {
+		req.JobId = &opts.jobId
+	}
+	if opts.slack {
+		req.PayloadFormat = mgmtv1alpha1.NotificationPayloadFormat_NOTIFICATION_PAYLOAD_FORMAT_SLACK
+	}
+
+	notificationclient, err := newNotificationClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	res, err := notificationclient.CreateNotificationRule(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created notification rule %s\n", res.Msg.GetRule().GetId())  //nolint:forbidigo
+	fmt.Printf("Signing secret: %s\n", res.Msg.GetSigningSecret())           //nolint:forbidigo
+	fmt.Println("Store the signing secret now, it will not be shown again.") //nolint:forbidigo
+	return nil
+}
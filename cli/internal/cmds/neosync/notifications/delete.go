@@ -0,0 +1,52 @@
+package notifications_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/spf13/cobra"
+)
+
+func newDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [id]",
+		Short: "Deletes a notification rule",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return errors.New("must provide rule uuid as argument")
+			}
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			ruleUuid, err := uuid.Parse(args[0])
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+			return deleteRule(cmd.Context(), &apiKey, ruleUuid.String())
+		},
+	}
+	return cmd
+}
+
+func deleteRule(
+	ctx context.Context,
+	apiKey *string,
+	ruleId string,
+) error {
+	notificationclient, err := newNotificationClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	_, err = notificationclient.DeleteNotificationRule(ctx, connect.NewRequest(&mgmtv1alpha1.DeleteNotificationRuleRequest{Id: ruleId}))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Deleted notification rule %s\n", ruleId) //nolint:forbidigo
+	return nil
+}
@@ -0,0 +1,147 @@
+package notifications_cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type listDeliveriesOpts struct {
+	accountId string
+	ruleId    string
+	jobRunId  string
+	limit     uint32
+	output    string
+}
+
+func newDeliveriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deliveries",
+		Short: "Lists the delivery history of an account's notification rules",
+		Example: `neosync notifications deliveries --rule-id <rule-id>
+neosync notifications deliveries --job-run-id <job-run-id>`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			opts := &listDeliveriesOpts{}
+			opts.accountId, err = cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			opts.ruleId, err = cmd.Flags().GetString("rule-id")
+			if err != nil {
+				return err
+			}
+			opts.jobRunId, err = cmd.Flags().GetString("job-run-id")
+			if err != nil {
+				return err
+			}
+			opts.limit, err = cmd.Flags().GetUint32("limit")
+			if err != nil {
+				return err
+			}
+			opts.output, err = cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			if opts.output != "" && opts.output != "json" {
+				return fmt.Errorf("must provide valid output")
+			}
+			cmd.SilenceUsage = true
+			return listDeliveries(cmd.Context(), &apiKey, opts)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account to list deliveries for. Defaults to account id in cli context")
+	cmd.Flags().String("rule-id", "", "Only show deliveries for this rule")
+	cmd.Flags().String("job-run-id", "", "Only show deliveries for this job run")
+	cmd.Flags().Uint32("limit", 100, "The maximum number of deliveries to return (max 1000)")
+	cmd.Flags().StringP("output", "o", "", "json")
+	return cmd
+}
+
+func listDeliveries(
+	ctx context.Context,
+	apiKey *string,
+	opts *listDeliveriesOpts,
+) error {
+	accountId, err := resolveAccountId(opts.accountId)
+	if err != nil {
+		return err
+	}
+	req := &mgmtv1alpha1.GetNotificationDeliveriesRequest{
+		AccountId: accountId,
+		Limit:     &opts.limit,
+	}
+	if opts.ruleId != "" {
+		req.RuleId = &opts.ruleId
+	}
+	if opts.jobRunId != "" {
+		req.JobRunId = &opts.jobRunId
+	}
+
+	notificationclient, err := newNotificationClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	res, err := notificationclient.GetNotificationDeliveries(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	if opts.output == "json" {
+		marshaled, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(res.Msg)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(marshaled)) //nolint:forbidigo
+		return nil
+	}
+
+	fmt.Println() //nolint:forbidigo
+	printDeliveryTable(res.Msg.GetDeliveries())
+	fmt.Println() //nolint:forbidigo
+	return nil
+}
+
+func printDeliveryTable(
+	deliveries []*mgmtv1alpha1.NotificationDelivery,
+) {
+	tbl := table.
+		New("Time", "Rule", "Job Run", "Event", "Status", "Attempts", "Error").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for _, delivery := range deliveries {
+		status := "failed"
+		if delivery.GetSucceeded() {
+			status = "delivered"
+		}
+		if delivery.ResponseStatusCode != nil {
+			status = fmt.Sprintf("%s (%s)", status, strconv.Itoa(int(delivery.GetResponseStatusCode())))
+		}
+		tbl.AddRow(
+			delivery.GetCreatedAt().AsTime().Local().Format(time.RFC3339),
+			delivery.GetRuleId(),
+			delivery.GetJobRunId(),
+			toEventTypeName(delivery.GetEventType()),
+			status,
+			delivery.GetAttempts(),
+			delivery.GetError(),
+		)
+	}
+	tbl.Print()
+}
@@ -0,0 +1,124 @@
+package notifications_cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/fatih/color"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/rodaine/table"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "Lists the notification rules of an account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiKey, err := cmd.Flags().GetString("api-key")
+			if err != nil {
+				return err
+			}
+			accountId, err := cmd.Flags().GetString("account-id")
+			if err != nil {
+				return err
+			}
+			jobId, err := cmd.Flags().GetString("job-id")
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			if output != "" && output != "json" {
+				return fmt.Errorf("must provide valid output")
+			}
+			cmd.SilenceUsage = true
+			return listRules(cmd.Context(), &apiKey, accountId, jobId, output)
+		},
+	}
+	cmd.Flags().String("account-id", "", "Account to list rules for. Defaults to account id in cli context")
+	cmd.Flags().String("job-id", "", "Only list the rules that apply to this job")
+	cmd.Flags().StringP("output", "o", "", "json")
+	return cmd
+}
+
+func listRules(
+	ctx context.Context,
+	apiKey *string,
+	accountIdFlag, jobId, output string,
+) error {
+	accountId, err := resolveAccountId(accountIdFlag)
+	if err != nil {
+		return err
+	}
+	req := &mgmtv1alpha1.GetNotificationRulesRequest{AccountId: accountId}
+	if jobId != "" {
+		req.JobId = &jobId
+	}
+
+	notificationclient, err := newNotificationClient(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+	res, err := notificationclient.GetNotificationRules(ctx, connect.NewRequest(req))
+	if err != nil {
+		return err
+	}
+
+	if output == "json" {
+		marshaled, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(res.Msg)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(marshaled)) //nolint:forbidigo
+		return nil
+	}
+
+	fmt.Println() //nolint:forbidigo
+	printRuleTable(res.Msg.GetRules())
+	fmt.Println() //nolint:forbidigo
+	return nil
+}
+
+func printRuleTable(
+	rules []*mgmtv1alpha1.NotificationRule,
+) {
+	tbl := table.
+		New("Id", "Name", "Job", "Events", "Format", "Url").
+		WithHeaderFormatter(
+			color.New(color.FgGreen, color.Underline).SprintfFunc(),
+		).
+		WithFirstColumnFormatter(
+			color.New(color.FgYellow).SprintfFunc(),
+		)
+
+	for _, rule := range rules {
+		job := rule.GetJobId()
+		if job == "" {
+			job = "all"
+		}
+		events := make([]string, 0, len(rule.GetEventTypes()))
+		for _, eventType := range rule.GetEventTypes() {
+			events = append(events, toEventTypeName(eventType))
+		}
+		format := "json"
+		if rule.GetPayloadFormat() == mgmtv1alpha1.NotificationPayloadFormat_NOTIFICATION_PAYLOAD_FORMAT_SLACK {
+			format = "slack"
+		}
+		tbl.AddRow(
+			rule.GetId(),
+			rule.GetName(),
+			job,
+			strings.Join(events, ", "),
+			format,
+			rule.GetUrl(),
+		)
+	}
+	tbl.Print()
+}
@@ -0,0 +1,92 @@
+package notifications_cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/cli/internal/auth"
+	auth_interceptor "github.com/nucleuscloud/neosync/cli/internal/connect/interceptors/auth"
+	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
+	"github.com/nucleuscloud/neosync/cli/internal/version"
+	http_client "github.com/nucleuscloud/neosync/worker/pkg/http/client"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "notifications",
+		Short: "Parent command for job run notification rules",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newCreateCmd())
+	cmd.AddCommand(newListCmd())
+	cmd.AddCommand(newDeleteCmd())
+	cmd.AddCommand(newDeliveriesCmd())
+	return cmd
+}
+
+func newNotificationClient(ctx context.Context, apiKey *string) (mgmtv1alpha1connect.NotificationServiceClient, error) {
+	isAuthEnabled, err := auth.IsAuthEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mgmtv1alpha1connect.NewNotificationServiceClient(
+		http_client.NewWithHeaders(version.Get().Headers()),
+		serverconfig.GetApiBaseUrl(),
+		connect.WithInterceptors(
+			auth_interceptor.NewInterceptor(isAuthEnabled, auth.AuthHeader, auth.GetAuthHeaderTokenFn(apiKey)),
+		),
+	), nil
+}
+
+func resolveAccountId(accountId string) (string, error) {
+	if accountId != "" {
+		return accountId, nil
+	}
+	aId, err := userconfig.GetAccountId()
+	if err != nil {
+		fmt.Println("Unable to retrieve account id. Please use account switch command to set account.") //nolint:forbidigo
+		return "", err
+	}
+	if aId == "" {
+		return "", errors.New("Account Id not found. Please use account switch command to set account.")
+	}
+	return aId, nil
+}
+
+var eventTypeNames = map[string]mgmtv1alpha1.JobRunEventType{
+	"started":   mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_STARTED,
+	"succeeded": mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_SUCCEEDED,
+	"failed":    mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_FAILED,
+	"canceled":  mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_CANCELED,
+}
+
+func parseEventTypes(names []string) ([]mgmtv1alpha1.JobRunEventType, error) {
+	eventTypes := make([]mgmtv1alpha1.JobRunEventType, 0, len(names))
+	for _, name := range names {
+		eventType, ok := eventTypeNames[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unknown event %q. valid events are: started, succeeded, failed, canceled", name)
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+	return eventTypes, nil
+}
+
+func toEventTypeName(eventType mgmtv1alpha1.JobRunEventType) string {
+	for name, value := range eventTypeNames {
+		if value == eventType {
+			return name
+		}
+	}
+	return "unknown"
+}
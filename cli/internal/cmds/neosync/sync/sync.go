@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	syncmap "sync"
@@ -16,6 +17,7 @@ import (
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	dbconnectconfig "github.com/nucleuscloud/neosync/backend/pkg/dbconnect-config"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
@@ -23,6 +25,8 @@ import (
 	sqlmanager_postgres "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/postgres"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	sqlmanager_sqlite "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/sqlite"
+	sqlmanager_translate "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/translate"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	"github.com/nucleuscloud/neosync/cli/internal/auth"
 	cli_neosync_benthos "github.com/nucleuscloud/neosync/cli/internal/benthos"
@@ -31,6 +35,7 @@ import (
 	"github.com/nucleuscloud/neosync/cli/internal/serverconfig"
 	"github.com/nucleuscloud/neosync/cli/internal/userconfig"
 	"github.com/nucleuscloud/neosync/cli/internal/version"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 	neosyncbenthos_dynamodb "github.com/nucleuscloud/neosync/worker/pkg/benthos/dynamodb"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
@@ -54,12 +59,15 @@ type DriverType string
 const (
 	postgresDriver DriverType = "postgres"
 	mysqlDriver    DriverType = "mysql"
+	sqliteDriver   DriverType = "sqlite"
 
 	awsS3Connection           ConnectionType = "awsS3"
 	gcpCloudStorageConnection ConnectionType = "gcpCloudStorage"
+	localDirectoryConnection  ConnectionType = "localDirectory"
 	postgresConnection        ConnectionType = "postgres"
 	mysqlConnection           ConnectionType = "mysql"
 	awsDynamoDBConnection     ConnectionType = "awsDynamoDB"
+	sqliteConnection          ConnectionType = "sqlite"
 
 	batchSize = 20
 )
@@ -68,6 +76,7 @@ var (
 	driverMap = map[string]DriverType{
 		string(postgresDriver): postgresDriver,
 		string(mysqlDriver):    mysqlDriver,
+		string(sqliteDriver):   sqliteDriver,
 	}
 )
 
@@ -259,10 +268,10 @@ func NewCmd() *cobra.Command {
 	cmd.Flags().Bool("debug", false, "Run in debug mode")
 
 	cmd.Flags().String("connection-id", "", "Connection id for sync source")
-	cmd.Flags().String("job-id", "", "Id of Job to sync data from. Only used with [AWS S3, GCP Cloud Storage] connections. Can use job-run-id instead.")
-	cmd.Flags().String("job-run-id", "", "Id of Job run to sync data from. Only used with [AWS S3, GCP Cloud Storage] connections. Can use job-id instead.")
-	cmd.Flags().String("destination-connection-url", "", "Connection url for sync output")
-	cmd.Flags().String("destination-driver", "", "Connection driver for sync output")
+	cmd.Flags().String("job-id", "", "Id of Job to sync data from. Only used with [AWS S3, GCP Cloud Storage, Local Directory] connections. Can use job-run-id instead.")
+	cmd.Flags().String("job-run-id", "", "Id of Job run to sync data from. Only used with [AWS S3, GCP Cloud Storage, Local Directory] connections. Can use job-id instead.")
+	cmd.Flags().String("destination-connection-url", "", "Connection url for sync output. For sqlite this is the path to the database file")
+	cmd.Flags().String("destination-driver", "", "Connection driver for sync output [postgres, mysql, sqlite]")
 	cmd.Flags().String("account-id", "", "Account source connection is in. Defaults to account id in cli context")
 	cmd.Flags().String("config", "", "Location of config file")
 	cmd.Flags().Bool("init-schema", false, "Create table schema and its constraints")
@@ -327,7 +336,12 @@ func sync(
 	pgquerier := pg_queries.New()
 	mysqlquerier := mysql_queries.New()
 	mssqlquerier := mssql_queries.New()
-	sqlConnector := &sqlconnect.SqlOpenConnector{}
+	// sqlite source connections are read from the local machine and must be located within the directory the cli was run from
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("unable to determine working directory: %w", err)
+	}
+	sqlConnector := &sqlconnect.SqlOpenConnector{SecretResolver: secrets.NewFromEnvironment(), SqliteAllowedDir: workingDir, SqliteSharedAcrossAccounts: true}
 	sqlmanagerclient := sqlmanager.NewSqlManager(pgpoolmap, pgquerier, mysqlpoolmap, mysqlquerier, mssqlpoolmap, mssqlquerier, sqlConnector)
 
 	logger.Debug("Retrieving neosync connection")
@@ -350,12 +364,15 @@ func sync(
 	if connectionType == gcpCloudStorageConnection && (cmd.Source.ConnectionOpts.JobId == nil || *cmd.Source.ConnectionOpts.JobId == "") && (cmd.Source.ConnectionOpts.JobRunId == nil || *cmd.Source.ConnectionOpts.JobRunId == "") {
 		return errors.New("GCP Cloud Storage source connection type requires job-id or job-run-id")
 	}
+	if connectionType == localDirectoryConnection && (cmd.Source.ConnectionOpts.JobId == nil || *cmd.Source.ConnectionOpts.JobId == "") && (cmd.Source.ConnectionOpts.JobRunId == nil || *cmd.Source.ConnectionOpts.JobRunId == "") {
+		return errors.New("local directory source connection type requires job-id or job-run-id")
+	}
 
-	if cmd.Destination.TruncateCascade && cmd.Destination.Driver == mysqlDriver {
+	if cmd.Destination.TruncateCascade && (cmd.Destination.Driver == mysqlDriver || cmd.Destination.Driver == sqliteDriver) {
 		return fmt.Errorf("truncate cascade is only supported in postgres")
 	}
 
-	if connectionType == mysqlConnection || connectionType == postgresConnection {
+	if connectionType == mysqlConnection || connectionType == postgresConnection || connectionType == sqliteConnection {
 		if cmd.Destination.Driver == "" {
 			return fmt.Errorf("must provide destination-driver")
 		}
@@ -363,9 +380,17 @@ func sync(
 			return fmt.Errorf("must provide destination-connection-url")
 		}
 
-		if cmd.Destination.Driver != mysqlDriver && cmd.Destination.Driver != postgresDriver {
-			return errors.New("unsupported destination driver. only postgres and mysql are currently supported")
+		if cmd.Destination.Driver != mysqlDriver && cmd.Destination.Driver != postgresDriver && cmd.Destination.Driver != sqliteDriver {
+			return errors.New("unsupported destination driver. only postgres, mysql, and sqlite are currently supported")
+		}
+	}
+
+	if cmd.Destination.Driver == sqliteDriver {
+		dsn, err := getSqliteDsn(cmd.Destination.ConnectionUrl)
+		if err != nil {
+			return err
 		}
+		cmd.Destination.ConnectionUrl = dsn
 	}
 
 	if connectionType == awsDynamoDBConnection {
@@ -468,6 +493,29 @@ func sync(
 			return nil
 		}
 		schemaConfig = schemaCfg
+	case localDirectoryConnection:
+		var cfg *mgmtv1alpha1.LocalDirectorySchemaConfig
+		if cmd.Source.ConnectionOpts.JobRunId != nil && *cmd.Source.ConnectionOpts.JobRunId != "" {
+			cfg = &mgmtv1alpha1.LocalDirectorySchemaConfig{Id: &mgmtv1alpha1.LocalDirectorySchemaConfig_JobRunId{JobRunId: *cmd.Source.ConnectionOpts.JobRunId}}
+		} else if cmd.Source.ConnectionOpts.JobId != nil && *cmd.Source.ConnectionOpts.JobId != "" {
+			cfg = &mgmtv1alpha1.LocalDirectorySchemaConfig{Id: &mgmtv1alpha1.LocalDirectorySchemaConfig_JobId{JobId: *cmd.Source.ConnectionOpts.JobId}}
+		}
+
+		localDirConfig := &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_LocalDirConfig{
+				LocalDirConfig: cfg,
+			},
+		}
+
+		schemaCfg, err := getDestinationSchemaConfig(ctx, connectiondataclient, sqlmanagerclient, connection, cmd, localDirConfig, logger)
+		if err != nil {
+			return err
+		}
+		if len(schemaCfg.Schemas) == 0 {
+			logger.Warn("No tables found.")
+			return nil
+		}
+		schemaConfig = schemaCfg
 	case mysqlConnection:
 		logger.Info("Building schema and table constraints...")
 		mysqlCfg := &mgmtv1alpha1.ConnectionSchemaConfig{
@@ -500,6 +548,22 @@ func sync(
 			return nil
 		}
 		schemaConfig = schemaCfg
+	case sqliteConnection:
+		logger.Info("Building schema and table constraints...")
+		sqliteConfig := &mgmtv1alpha1.ConnectionSchemaConfig{
+			Config: &mgmtv1alpha1.ConnectionSchemaConfig_SqliteConfig{
+				SqliteConfig: &mgmtv1alpha1.SqliteSchemaConfig{},
+			},
+		}
+		schemaCfg, err := getConnectionSchemaConfig(ctx, logger, connectiondataclient, connection, cmd, sqliteConfig)
+		if err != nil {
+			return err
+		}
+		if len(schemaCfg.Schemas) == 0 {
+			logger.Warn("No tables found.")
+			return nil
+		}
+		schemaConfig = schemaCfg
 	case awsDynamoDBConnection:
 		dynamoConfig := &mgmtv1alpha1.ConnectionSchemaConfig{
 			Config: &mgmtv1alpha1.ConnectionSchemaConfig_DynamodbConfig{
@@ -560,20 +624,42 @@ func sync(
 func areSourceAndDestCompatible(connection *mgmtv1alpha1.Connection, destinationDriver DriverType) error {
 	switch connection.ConnectionConfig.Config.(type) {
 	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
-		if destinationDriver != postgresDriver {
+		if destinationDriver != postgresDriver && destinationDriver != sqliteDriver {
 			return fmt.Errorf("Connection and destination types are incompatible [postgres, %s]", destinationDriver)
 		}
 	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
-		if destinationDriver != mysqlDriver {
+		if destinationDriver != mysqlDriver && destinationDriver != sqliteDriver {
 			return fmt.Errorf("Connection and destination types are incompatible [mysql, %s]", destinationDriver)
 		}
-	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config, *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig, *mgmtv1alpha1.ConnectionConfig_DynamodbConfig:
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		// sqlite may be synced into any of the supported sql destinations
+	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config, *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig, *mgmtv1alpha1.ConnectionConfig_DynamodbConfig, *mgmtv1alpha1.ConnectionConfig_LocalDirConfig:
 	default:
-		return errors.New("unsupported destination driver. only postgres and mysql are currently supported")
+		return errors.New("unsupported destination driver. only postgres, mysql, and sqlite are currently supported")
 	}
 	return nil
 }
 
+// Adds the default sqlite connection pragmas when the url is a plain file path.
+// The busy timeout lets tables that are synced in parallel wait on each other's write locks instead of failing.
+func getSqliteDsn(connectionUrl string) (string, error) {
+	if strings.Contains(connectionUrl, "?") {
+		return connectionUrl, nil
+	}
+	path, err := filepath.Abs(connectionUrl)
+	if err != nil {
+		return "", err
+	}
+	// the destination file is explicitly provided on the command line, so its own directory is allowed
+	connConfig, err := dbconnectconfig.NewFromSqliteConnection(&mgmtv1alpha1.ConnectionConfig_SqliteConfig{
+		SqliteConfig: &mgmtv1alpha1.SqliteConnectionConfig{Path: path},
+	}, nil, filepath.Dir(path))
+	if err != nil {
+		return "", err
+	}
+	return connConfig.String(), nil
+}
+
 func syncData(ctx context.Context, cfg *benthosConfigResponse, logger *charmlog.Logger) error {
 	configbits, err := yaml.Marshal(cfg.Config)
 	if err != nil {
@@ -718,6 +804,24 @@ func runDestinationInitStatements(
 			logger.Error("Error truncating tables:", err)
 			return err
 		}
+	} else if cmd.Destination.Driver == sqliteDriver && cmd.Destination.TruncateBeforeInsert {
+		orderedTablesResp, err := tabledependency.GetTablesOrderedByDependency(dependencyMap)
+		if err != nil {
+			return err
+		}
+		// sqlite has no truncate so rows are deleted from child tables before their parents to satisfy foreign keys
+		orderedTableTruncateStatements := []string{}
+		for i := len(orderedTablesResp.OrderedTables) - 1; i >= 0; i-- {
+			stmt, ok := schemaConfig.TruncateTableStatementsMap[orderedTablesResp.OrderedTables[i].String()]
+			if ok {
+				orderedTableTruncateStatements = append(orderedTableTruncateStatements, stmt)
+			}
+		}
+		err = db.Db.BatchExec(ctx, batchSize, orderedTableTruncateStatements, &sql_manager.BatchExecOpts{})
+		if err != nil {
+			logger.Error("Error truncating tables:", err)
+			return err
+		}
 	}
 	return nil
 }
@@ -997,6 +1101,10 @@ func getConnectionSchemaConfig(
 	var initTableStatementsMap map[string]string
 	var truncateTableStatementsMap map[string]string
 	var initSchemaStatements []*mgmtv1alpha1.SchemaInitStatements
+	var uniqueConstraints map[string]*mgmtv1alpha1.UniqueConstraints
+	// the api builds init statements in the dialect of the source so they are translated here when syncing across drivers
+	sourceDriver, isSqlSource := getSqlManagerDriverFromConnection(connection)
+	isCrossDriverSync := isSqlSource && sourceDriver != getSqlManagerDriver(cmd.Destination.Driver)
 	errgrp, errctx := errgroup.WithContext(ctx)
 	errgrp.Go(func() error {
 		schemaResp, err := connectiondataclient.GetConnectionSchema(errctx, connect.NewRequest(&mgmtv1alpha1.GetConnectionSchemaRequest{
@@ -1017,10 +1125,14 @@ func getConnectionSchemaConfig(
 		}
 		tableConstraints = constraintConnectionResp.Msg.GetForeignKeyConstraints()
 		tablePrimaryKeys = constraintConnectionResp.Msg.GetPrimaryKeyConstraints()
+		uniqueConstraints = constraintConnectionResp.Msg.GetUniqueConstraints()
 		return nil
 	})
 
 	errgrp.Go(func() error {
+		if isCrossDriverSync {
+			return nil
+		}
 		initStatementsResp, err := getTableInitStatementMap(errctx, logger, connectiondataclient, cmd.Source.ConnectionId, cmd.Destination)
 		if err != nil {
 			return err
@@ -1053,6 +1165,25 @@ func getConnectionSchemaConfig(
 		tc[table] = fkConstraints
 	}
 
+	if isCrossDriverSync {
+		if cmd.Destination.InitSchema {
+			logger.Infof("Translating %s schema to %s...", sourceDriver, cmd.Destination.Driver)
+			stmts, err := translateInitSchemaStatements(sourceDriver, cmd.Destination.Driver, schemas, tc, tablePrimaryKeys, uniqueConstraints)
+			if err != nil {
+				return nil, err
+			}
+			for _, lossy := range stmts.LossyConversions {
+				logger.Warnf("lossy conversion: %s", lossy.String())
+			}
+			initSchemaStatements = stmts.InitStatements
+		}
+		truncateStmts, err := buildTruncateStatementsMap(cmd.Destination, getTableColMap(schemas))
+		if err != nil {
+			return nil, err
+		}
+		truncateTableStatementsMap = truncateStmts
+	}
+
 	return &schemaConfig{
 		Schemas:                    schemas,
 		TableConstraints:           tc,
@@ -1108,9 +1239,24 @@ func getDestinationSchemaConfig(
 		}
 	}
 
+	truncateTableStatementsMap, err := buildTruncateStatementsMap(cmd.Destination, tableColMap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemaConfig{
+		Schemas:                    schemaResp.Msg.GetSchemas(),
+		TableConstraints:           tableConstraints.ForeignKeyConstraints,
+		TablePrimaryKeys:           primaryKeys,
+		TruncateTableStatementsMap: truncateTableStatementsMap,
+	}, nil
+}
+
+func buildTruncateStatementsMap(destination *sqlDestinationConfig, tableColMap map[string][]string) (map[string]string, error) {
 	truncateTableStatementsMap := map[string]string{}
-	if cmd.Destination.Driver == postgresDriver {
-		if cmd.Destination.TruncateCascade {
+	switch destination.Driver {
+	case postgresDriver:
+		if destination.TruncateCascade {
 			for t := range tableColMap {
 				schema, table := sqlmanager_shared.SplitTableKey(t)
 				stmt, err := sqlmanager_postgres.BuildPgTruncateCascadeStatement(schema, table)
@@ -1121,8 +1267,19 @@ func getDestinationSchemaConfig(
 			}
 		}
 		// truncate before insert handled in runDestinationInitStatements
-	} else {
-		if cmd.Destination.TruncateBeforeInsert {
+	case sqliteDriver:
+		if destination.TruncateBeforeInsert {
+			for t := range tableColMap {
+				_, table := sqlmanager_shared.SplitTableKey(t)
+				stmt, err := sqlmanager_sqlite.BuildSqliteTruncateStatement(table)
+				if err != nil {
+					return nil, err
+				}
+				truncateTableStatementsMap[t] = stmt
+			}
+		}
+	default:
+		if destination.TruncateBeforeInsert {
 			for t := range tableColMap {
 				schema, table := sqlmanager_shared.SplitTableKey(t)
 				stmt, err := sqlmanager_mysql.BuildMysqlTruncateStatement(schema, table)
@@ -1133,13 +1290,81 @@ func getDestinationSchemaConfig(
 			}
 		}
 	}
+	return truncateTableStatementsMap, nil
+}
 
-	return &schemaConfig{
-		Schemas:                    schemaResp.Msg.GetSchemas(),
-		TableConstraints:           tableConstraints.ForeignKeyConstraints,
-		TablePrimaryKeys:           primaryKeys,
-		TruncateTableStatementsMap: truncateTableStatementsMap,
-	}, nil
+type translatedSchema struct {
+	InitStatements   []*mgmtv1alpha1.SchemaInitStatements
+	LossyConversions []*sqlmanager_translate.LossyConversion
+}
+
+func translateInitSchemaStatements(
+	sourceDriver string,
+	destinationDriver DriverType,
+	schemas []*mgmtv1alpha1.DatabaseColumn,
+	foreignKeys map[string][]*sql_manager.ForeignConstraint,
+	primaryKeys map[string]*mgmtv1alpha1.PrimaryConstraint,
+	uniqueConstraints map[string]*mgmtv1alpha1.UniqueConstraints,
+) (*translatedSchema, error) {
+	tables := []*sql_manager.SchemaTable{}
+	schemaSet := map[string]struct{}{}
+	columns := map[string]map[string]*sql_manager.ColumnInfo{}
+	for _, col := range schemas {
+		key := sql_manager.BuildTable(col.GetSchema(), col.GetTable())
+		tableCols, ok := columns[key]
+		if !ok {
+			tableCols = map[string]*sql_manager.ColumnInfo{}
+			columns[key] = tableCols
+			tables = append(tables, &sql_manager.SchemaTable{Schema: col.GetSchema(), Table: col.GetTable()})
+			schemaSet[col.GetSchema()] = struct{}{}
+		}
+		tableCols[col.GetColumn()] = &sql_manager.ColumnInfo{
+			OrdinalPosition:    len(tableCols) + 1,
+			ColumnDefault:      col.GetColumnDefault(),
+			IsNullable:         sql_manager.ConvertNullableTextToBool(col.GetIsNullable()),
+			DataType:           col.GetDataType(),
+			IdentityGeneration: col.IdentityGeneration,
+		}
+	}
+
+	constraints := &sql_manager.TableConstraints{
+		ForeignKeyConstraints: foreignKeys,
+		PrimaryKeyConstraints: map[string][]string{},
+		UniqueConstraints:     map[string][][]string{},
+	}
+	for table, pk := range primaryKeys {
+		constraints.PrimaryKeyConstraints[table] = pk.GetColumns()
+	}
+	for table, uc := range uniqueConstraints {
+		for _, c := range uc.GetConstraints() {
+			constraints.UniqueConstraints[table] = append(constraints.UniqueConstraints[table], c.GetColumns())
+		}
+	}
+
+	translation, err := sqlmanager_translate.TranslateSchema(sourceDriver, getSqlManagerDriver(destinationDriver), tables, columns, constraints)
+	if err != nil {
+		return nil, err
+	}
+
+	// tables are created in the same schemas as the source when the destination supports them
+	createSchemaStmts := []string{}
+	for schema := range schemaSet {
+		switch destinationDriver {
+		case postgresDriver:
+			createSchemaStmts = append(createSchemaStmts, fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %q;", schema))
+		case mysqlDriver:
+			createSchemaStmts = append(createSchemaStmts, fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS `%s`;", schema))
+		}
+	}
+	slices.Sort(createSchemaStmts)
+
+	initStatements := []*mgmtv1alpha1.SchemaInitStatements{
+		{Label: "create schema", Statements: createSchemaStmts},
+	}
+	for _, block := range translation.InitStatements {
+		initStatements = append(initStatements, &mgmtv1alpha1.SchemaInitStatements{Label: block.Label, Statements: block.Statements})
+	}
+	return &translatedSchema{InitStatements: initStatements, LossyConversions: translation.LossyConversions}, nil
 }
 
 func getDestinationTableConstraints(ctx context.Context, sqlmanagerclient sqlmanager.SqlManagerClient, connectionDriver DriverType, connectionUrl string, schemas []string) (*sql_manager.TableConstraints, error) {
@@ -1182,6 +1407,26 @@ func parseDriverString(str string) (DriverType, bool) {
 	return p, ok
 }
 
+func getSqlManagerDriver(driver DriverType) string {
+	if driver == postgresDriver {
+		return sql_manager.PostgresDriver
+	}
+	return string(driver)
+}
+
+func getSqlManagerDriverFromConnection(connection *mgmtv1alpha1.Connection) (string, bool) {
+	switch connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		return sql_manager.PostgresDriver, true
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		return sql_manager.MysqlDriver, true
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		return sql_manager.SqliteDriver, true
+	default:
+		return "", false
+	}
+}
+
 func getConnectionType(connection *mgmtv1alpha1.Connection) (ConnectionType, error) {
 	if connection.ConnectionConfig.GetAwsS3Config() != nil {
 		return awsS3Connection, nil
@@ -1189,6 +1434,9 @@ func getConnectionType(connection *mgmtv1alpha1.Connection) (ConnectionType, err
 	if connection.GetConnectionConfig().GetGcpCloudstorageConfig() != nil {
 		return gcpCloudStorageConnection, nil
 	}
+	if connection.GetConnectionConfig().GetLocalDirConfig() != nil {
+		return localDirectoryConnection, nil
+	}
 	if connection.ConnectionConfig.GetMysqlConfig() != nil {
 		return mysqlConnection, nil
 	}
@@ -1198,5 +1446,8 @@ func getConnectionType(connection *mgmtv1alpha1.Connection) (ConnectionType, err
 	if connection.ConnectionConfig.GetDynamodbConfig() != nil {
 		return awsDynamoDBConnection, nil
 	}
+	if connection.GetConnectionConfig().GetSqliteConfig() != nil {
+		return sqliteConnection, nil
+	}
 	return "", errors.New("unsupported connection type")
 }
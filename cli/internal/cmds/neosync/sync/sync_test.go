@@ -2,9 +2,11 @@ package sync_cmd
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	charmlog "github.com/charmbracelet/log"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	"github.com/stretchr/testify/require"
 )
@@ -145,3 +147,30 @@ func Test_buildPlainInsertArgs(t *testing.T) {
 	require.Empty(t, buildPlainInsertArgs([]string{}))
 	require.Equal(t, buildPlainInsertArgs([]string{"foo", "bar", "baz"}), `root = [this."foo", this."bar", this."baz"]`)
 }
+
+func Test_areSourceAndDestCompatible(t *testing.T) {
+	pg := &mgmtv1alpha1.Connection{ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{}}}
+	mysql := &mgmtv1alpha1.Connection{ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{Config: &mgmtv1alpha1.ConnectionConfig_MysqlConfig{}}}
+	sqlite := &mgmtv1alpha1.Connection{ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{Config: &mgmtv1alpha1.ConnectionConfig_SqliteConfig{}}}
+
+	require.NoError(t, areSourceAndDestCompatible(pg, postgresDriver))
+	require.NoError(t, areSourceAndDestCompatible(pg, sqliteDriver))
+	require.Error(t, areSourceAndDestCompatible(pg, mysqlDriver))
+	require.NoError(t, areSourceAndDestCompatible(mysql, sqliteDriver))
+	require.Error(t, areSourceAndDestCompatible(mysql, postgresDriver))
+	require.NoError(t, areSourceAndDestCompatible(sqlite, postgresDriver))
+	require.NoError(t, areSourceAndDestCompatible(sqlite, mysqlDriver))
+	require.NoError(t, areSourceAndDestCompatible(sqlite, sqliteDriver))
+}
+
+func Test_getSqliteDsn(t *testing.T) {
+	abspath, err := filepath.Abs("./local.db")
+	require.NoError(t, err)
+	dsn, err := getSqliteDsn("./local.db")
+	require.NoError(t, err)
+	require.Equal(t, abspath+"?_pragma=busy_timeout%285000%29&_pragma=foreign_keys%281%29", dsn)
+
+	dsn, err = getSqliteDsn("./local.db?_pragma=foreign_keys(0)")
+	require.NoError(t, err)
+	require.Equal(t, "./local.db?_pragma=foreign_keys(0)", dsn)
+}
@@ -93,6 +93,19 @@ const sidebars: SidebarsConfig = {
             },
           ],
         },
+        {
+          type: 'category',
+          label: 'connections',
+          collapsible: true,
+          collapsed: false,
+          items: [
+            {
+              type: 'doc',
+              id: 'cli/connections/scan',
+              label: 'scan',
+            },
+          ],
+        },
         {
           type: 'category',
           label: 'jobs',
@@ -104,6 +117,11 @@ const sidebars: SidebarsConfig = {
               id: 'cli/jobs/list',
               label: 'list',
             },
+            {
+              type: 'doc',
+              id: 'cli/jobs/plan',
+              label: 'plan',
+            },
             {
               type: 'doc',
               id: 'cli/jobs/trigger',
@@ -121,6 +139,16 @@ const sidebars: SidebarsConfig = {
           id: 'cli/sync',
           label: 'sync',
         },
+        {
+          type: 'doc',
+          id: 'cli/audit',
+          label: 'audit',
+        },
+        {
+          type: 'doc',
+          id: 'cli/notifications',
+          label: 'notifications',
+        },
       ],
     },
     {
@@ -254,6 +282,26 @@ const sidebars: SidebarsConfig = {
       id: 'guides/incremental-data-sync',
       label: 'Incremental Data Syncs',
     },
+    {
+      type: 'doc',
+      id: 'guides/team-roles',
+      label: 'Team Roles',
+    },
+    {
+      type: 'doc',
+      id: 'guides/secret-references',
+      label: 'Secret References',
+    },
+    {
+      type: 'doc',
+      id: 'guides/connection-encryption',
+      label: 'Connection Encryption',
+    },
+    {
+      type: 'doc',
+      id: 'guides/job-pipelines',
+      label: 'Job Pipelines',
+    },
     {
       type: 'html',
       value: '<div>Connections</div>',
@@ -274,6 +322,11 @@ const sidebars: SidebarsConfig = {
       id: 'connections/s3',
       label: 'S3',
     },
+    {
+      type: 'doc',
+      id: 'connections/local-directory',
+      label: 'Local Directory',
+    },
     {
       type: 'doc',
       id: 'connections/mongodb',
@@ -284,6 +337,16 @@ const sidebars: SidebarsConfig = {
       id: 'connections/dynamodb',
       label: 'DynamoDB',
     },
+    {
+      type: 'doc',
+      id: 'connections/kafka',
+      label: 'Kafka',
+    },
+    {
+      type: 'doc',
+      id: 'connections/sqlite',
+      label: 'SQLite',
+    },
     {
       type: 'doc',
       id: 'connections/sqlserver',
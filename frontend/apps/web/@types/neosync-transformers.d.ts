@@ -70,6 +70,8 @@ declare namespace neosync {
 		preserveLength?: boolean;
 		/** An optional seed value used for generating deterministic transformations. */
 		seed?: number;
+		/** An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US. */
+		locale?: string;
 	}
  
   /**
@@ -104,6 +106,8 @@ declare namespace neosync {
 		preserveLength?: boolean;
 		/** An optional seed value used for generating deterministic transformations. */
 		seed?: number;
+		/** An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US. */
+		locale?: string;
 	}
  
   /**
@@ -147,6 +151,8 @@ declare namespace neosync {
 		preserveLength?: boolean;
 		/** An optional seed value used for generating deterministic transformations. */
 		seed?: number;
+		/** An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US. */
+		locale?: string;
 	}
  
   /**
@@ -235,10 +241,12 @@ declare namespace neosync {
 		maxLength: number;
 		/** An optional seed value used to generate deterministic outputs. */
 		seed?: number;
+		/** An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US. */
+		locale?: string;
 	}
 
   /**
-   * Randomly selects a city from a list of predefined US cities.
+   * Randomly selects a city from the dataset of the locale, which defaults to en_US.
    */
 	declare function generateCity(options: GenerateCityOptions): any;
 	
@@ -276,6 +284,8 @@ declare namespace neosync {
 		maxLength?: number;
 		/** An optional seed value used to generate deterministic outputs. */
 		seed?: number;
+		/** An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US. */
+		locale?: string;
 	}
 
   /**
@@ -310,10 +320,12 @@ declare namespace neosync {
 		maxLength: number;
 		/** An optional seed value used to generate deterministic outputs. */
 		seed?: number;
+		/** An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US. */
+		locale?: string;
 	}
 
   /**
-   * Generates a randomly selected real full address that exists in the United States.
+   * Generates a randomly selected full address from the dataset of the locale, which defaults to en_US.
    */
 	declare function generateFullAddress(options: GenerateFullAddressOptions): any;
 	
@@ -323,6 +335,8 @@ declare namespace neosync {
 		maxLength?: number;
 		/** An optional seed value used to generate deterministic outputs. */
 		seed?: number;
+		/** An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US. */
+		locale?: string;
 	}
 
   /**
@@ -381,6 +395,8 @@ declare namespace neosync {
 		max: number;
 		/** An optional seed value used to generate deterministic outputs. */
 		seed?: number;
+		/** An optional locale, such as de_DE, to generate phone numbers in the national numbering plan of. Random digits are generated if no locale is provided. */
+		locale?: string;
 	}
 
   /**
@@ -394,6 +410,8 @@ declare namespace neosync {
 		maxLength?: number;
 		/** An optional seed value used to generate deterministic outputs. */
 		seed?: number;
+		/** An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US. */
+		locale?: string;
 	}
 
   /**
@@ -442,10 +460,12 @@ declare namespace neosync {
 		generateFullName?: boolean;
 		/** An optional seed value used to generate deterministic outputs. */
 		seed?: number;
+		/** An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US. */
+		locale?: string;
 	}
 
   /**
-   * Randomly selects a US state and by default, returns it as a 2-letter state code.
+   * Randomly selects a state from the dataset of the locale, which defaults to en_US, and by default, returns it as a 2-letter state code.
    */
 	declare function generateState(options: GenerateStateOptions): any;
 	
@@ -455,10 +475,12 @@ declare namespace neosync {
 		maxLength: number;
 		/** An optional seed value used to generate deterministic outputs. */
 		seed?: number;
+		/** An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US. */
+		locale?: string;
 	}
 
   /**
-   * Randomly generates a street address.
+   * Randomly generates a street address from the dataset of the locale, which defaults to en_US.
    */
 	declare function generateStreetAddress(options: GenerateStreetAddressOptions): any;
 	
@@ -470,10 +492,12 @@ declare namespace neosync {
 		max: number;
 		/** An optional seed value used to generate deterministic outputs. */
 		seed?: number;
+		/** An optional locale, such as de_DE, to generate phone numbers in the national numbering plan of. Random digits are generated if no locale is provided. */
+		locale?: string;
 	}
 
   /**
-   * Generates a random 10 digit phone number and returns it as a string with no hyphens.
+   * Generates a random phone number and returns it as a string with no hyphens. Uses the national numbering plan of the locale when one is provided.
    */
 	declare function generateStringPhoneNumber(options: GenerateStringPhoneNumberOptions): any;
 	
@@ -527,10 +551,12 @@ declare namespace neosync {
 	export interface GenerateZipcodeOptions {
 		/** An optional seed value used to generate deterministic outputs. */
 		seed?: number;
+		/** An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US. */
+		locale?: string;
 	}
 
   /**
-   * Generates a randomly selected US zipcode.
+   * Generates a randomly selected zipcode from the dataset of the locale, which defaults to en_US.
    */
 	declare function generateZipcode(options: GenerateZipcodeOptions): any;
 	
@@ -86,6 +86,7 @@ export default function DynamoDBForm(props: Props) {
     setIsValidating(true);
     try {
       const res = await checkDbConnection({
+        accountId: account?.id ?? '',
         connectionConfig: buildConnectionConfigDynamoDB(form.getValues()),
       });
       setValidationResponse(res);
@@ -316,6 +316,7 @@ export default function MssqlForm(props: Props): ReactElement {
               try {
                 const values = form.getValues();
                 const res = await checkConnection({
+                  accountId: account?.id ?? '',
                   connectionConfig: buildConnectionConfigMssql(values),
                 });
 
@@ -485,6 +485,7 @@ export default function MysqlForm(props: Props) {
               try {
                 const values = form.getValues();
                 const res = await checkMysqlConnection({
+                  accountId: account?.id ?? '',
                   connectionConfig: buildConnectionConfigMysql({
                     ...values,
                     url: activeTab === 'url' ? values.url : undefined,
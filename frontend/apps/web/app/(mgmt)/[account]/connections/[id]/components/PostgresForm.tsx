@@ -558,6 +558,7 @@ export default function PostgresForm(props: Props): ReactElement {
               try {
                 const values = form.getValues();
                 const res = await checkPostgresConnection({
+                  accountId: account?.id ?? '',
                   connectionConfig: buildConnectionConfigPostgres({
                     ...values,
                     url: activeTab === 'url' ? values.url : undefined,
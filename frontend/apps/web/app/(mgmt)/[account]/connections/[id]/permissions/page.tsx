@@ -24,7 +24,7 @@ import {
   GetConnectionResponse,
 } from '@neosync/sdk';
 import {
-  checkConnectionConfig,
+  checkConnectionConfigById,
   getConnection,
 } from '@neosync/sdk/connectquery';
 import { UpdateIcon } from '@radix-ui/react-icons';
@@ -67,9 +67,7 @@ export default function PermissionsPage({ params }: PageProps) {
     isLoading: isCheckConnLoading,
     isFetching,
     refetch: refetchCheckConnectionConfig,
-  } = useQuery(checkConnectionConfig, {
-    connectionConfig: data?.connection?.connectionConfig,
-  });
+  } = useQuery(checkConnectionConfigById, { id }, { enabled: !!id });
 
   const columns = useMemo(
     () =>
@@ -49,6 +49,7 @@ import {
   MongoDBSourceConnectionOptions,
   MssqlDestinationConnectionOptions,
   MssqlOnConflictConfig,
+  MssqlOnConflictUpdateConfig,
   MssqlSourceConnectionOptions,
   MssqlSourceSchemaOption,
   MssqlSourceSchemaSubset,
@@ -56,6 +57,7 @@ import {
   MssqlTruncateTableConfig,
   MysqlDestinationConnectionOptions,
   MysqlOnConflictConfig,
+  MysqlOnConflictUpdateConfig,
   MysqlSourceConnectionOptions,
   MysqlSourceSchemaOption,
   MysqlSourceSchemaSubset,
@@ -64,6 +66,7 @@ import {
   Passthrough,
   PostgresDestinationConnectionOptions,
   PostgresOnConflictConfig,
+  PostgresOnConflictUpdateConfig,
   PostgresSourceConnectionOptions,
   PostgresSourceSchemaOption,
   PostgresSourceSchemaSubset,
@@ -444,6 +447,9 @@ export function toJobDestinationOptions(
               doNothing:
                 values.destinationOptions.postgres?.onConflictDoNothing ??
                 false,
+              update: values.destinationOptions.postgres?.onConflictUpdate
+                ? new PostgresOnConflictUpdateConfig({})
+                : undefined,
             }),
             initTableSchema:
               values.destinationOptions.postgres?.initTableSchema,
@@ -465,6 +471,9 @@ export function toJobDestinationOptions(
             onConflict: new MysqlOnConflictConfig({
               doNothing:
                 values.destinationOptions.mysql?.onConflictDoNothing ?? false,
+              update: values.destinationOptions.mysql?.onConflictUpdate
+                ? new MysqlOnConflictUpdateConfig({})
+                : undefined,
             }),
             initTableSchema: values.destinationOptions.mysql?.initTableSchema,
             skipForeignKeyViolations:
@@ -479,11 +488,15 @@ export function toJobDestinationOptions(
           case: 'awsS3Options',
           value: new AwsS3DestinationConnectionOptions({
             storageClass: values.destinationOptions.awss3?.storageClass,
+            format: values.destinationOptions.awss3?.format,
             timeout: values.destinationOptions.awss3?.timeout,
             maxInFlight: values.destinationOptions.awss3?.maxInFlight,
             batch: new BatchConfig({
               ...values.destinationOptions.awss3?.batch,
             }),
+            pathTemplate: values.destinationOptions.awss3?.pathTemplate
+              ? values.destinationOptions.awss3.pathTemplate
+              : undefined,
           }),
         },
       });
@@ -497,10 +510,22 @@ export function toJobDestinationOptions(
       });
     }
     case 'gcpCloudstorageConfig': {
+      const gcsOptions = values.destinationOptions.gcpcloudstorage;
       return new JobDestinationOptions({
         config: {
           case: 'gcpCloudstorageOptions',
-          value: new GcpCloudStorageDestinationConnectionOptions({}),
+          value: new GcpCloudStorageDestinationConnectionOptions({
+            storageClass: gcsOptions?.storageClass,
+            format: gcsOptions?.format,
+            timeout: gcsOptions?.timeout ? gcsOptions.timeout : undefined,
+            maxInFlight: gcsOptions?.maxInFlight,
+            batch: new BatchConfig({
+              ...gcsOptions?.batch,
+            }),
+            pathTemplate: gcsOptions?.pathTemplate
+              ? gcsOptions.pathTemplate
+              : undefined,
+          }),
         },
       });
     }
@@ -533,6 +558,9 @@ export function toJobDestinationOptions(
             onConflict: new MssqlOnConflictConfig({
               doNothing:
                 values.destinationOptions.mssql?.onConflictDoNothing ?? false,
+              update: values.destinationOptions.mssql?.onConflictUpdate
+                ? new MssqlOnConflictUpdateConfig({})
+                : undefined,
             }),
             initTableSchema: values.destinationOptions.mssql?.initTableSchema,
             skipForeignKeyViolations:
@@ -1388,6 +1416,7 @@ export function getDefaultDestinationFormValues(
             initTableSchema: d.options.config.value.initTableSchema ?? false,
             onConflictDoNothing:
               d.options.config.value.onConflict?.doNothing ?? false,
+            onConflictUpdate: !!d.options.config.value.onConflict?.update,
             skipForeignKeyViolations:
               d.options.config.value.skipForeignKeyViolations ?? false,
           },
@@ -1404,6 +1433,7 @@ export function getDefaultDestinationFormValues(
             initTableSchema: d.options.config.value.initTableSchema ?? false,
             onConflictDoNothing:
               d.options.config.value.onConflict?.doNothing ?? false,
+            onConflictUpdate: !!d.options.config.value.onConflict?.update,
             skipForeignKeyViolations:
               d.options.config.value.skipForeignKeyViolations ?? false,
           },
@@ -1433,6 +1463,7 @@ export function getDefaultDestinationFormValues(
             initTableSchema: d.options.config.value.initTableSchema ?? false,
             onConflictDoNothing:
               d.options.config.value.onConflict?.doNothing ?? false,
+            onConflictUpdate: !!d.options.config.value.onConflict?.update,
             skipForeignKeyViolations:
               d.options.config.value.skipForeignKeyViolations ?? false,
           },
@@ -1444,12 +1475,32 @@ export function getDefaultDestinationFormValues(
         destinationOptions: {
           awss3: {
             storageClass: d.options.config.value.storageClass,
+            format: d.options.config.value.format,
+            maxInFlight: d.options.config.value.maxInFlight,
+            timeout: d.options.config.value.timeout,
+            batch: {
+              count: d.options.config.value.batch?.count,
+              period: d.options.config.value.batch?.period,
+            },
+            pathTemplate: d.options.config.value.pathTemplate,
+          },
+        },
+      };
+    }
+    case 'gcpCloudstorageOptions': {
+      return {
+        connectionId: d.connectionId,
+        destinationOptions: {
+          gcpcloudstorage: {
+            storageClass: d.options.config.value.storageClass,
+            format: d.options.config.value.format,
             maxInFlight: d.options.config.value.maxInFlight,
             timeout: d.options.config.value.timeout,
             batch: {
               count: d.options.config.value.batch?.count,
               period: d.options.config.value.batch?.period,
             },
+            pathTemplate: d.options.config.value.pathTemplate,
           },
         },
       };
@@ -16,6 +16,7 @@ import {
 } from '@/components/ui/form';
 import { Input } from '@/components/ui/input';
 import { Popover, PopoverContent } from '@/components/ui/popover';
+import { ToggleGroup, ToggleGroupItem } from '@/components/ui/toggle-group';
 import {
   Select,
   SelectContent,
@@ -27,9 +28,9 @@ import { cn } from '@/libs/utils';
 import { getErrorMessage } from '@/util/util';
 import { ApiKeyFormValues } from '@/yup-validations/apikey';
 import { Timestamp } from '@bufbuild/protobuf';
-import { useMutation } from '@connectrpc/connect-query';
+import { useMutation, useQuery } from '@connectrpc/connect-query';
 import { yupResolver } from '@hookform/resolvers/yup';
-import { createAccountApiKey } from '@neosync/sdk/connectquery';
+import { createAccountApiKey, getJobs } from '@neosync/sdk/connectquery';
 import { CalendarIcon } from '@radix-ui/react-icons';
 import { PopoverTrigger } from '@radix-ui/react-popover';
 import { addDays } from 'date-fns';
@@ -43,8 +44,27 @@ export interface ApiKeyValueSessionStore {
   keyValue: string;
 }
 
+// Mirrors the scopes supported by the backend
+const API_KEY_SCOPES = [
+  { value: 'jobs:read', label: 'Read jobs' },
+  { value: 'jobs:write', label: 'Write jobs' },
+  { value: 'jobs:trigger', label: 'Trigger jobs' },
+  { value: 'connections:read', label: 'Read connections' },
+  { value: 'connections:write', label: 'Write connections' },
+  { value: 'connections:data', label: 'Stream connection data' },
+  { value: 'transformers:read', label: 'Read transformers' },
+  { value: 'transformers:write', label: 'Write transformers' },
+  { value: 'anonymize', label: 'Anonymize' },
+  { value: 'metrics:read', label: 'Read metrics' },
+];
+
 export default function NewApiKeyForm(): ReactElement {
   const { account } = useAccount();
+  const { data: jobsData } = useQuery(
+    getJobs,
+    { accountId: account?.id },
+    { enabled: !!account?.id }
+  );
   const router = useRouter();
   const form = useForm<ApiKeyFormValues>({
     mode: 'onChange',
@@ -53,6 +73,8 @@ export default function NewApiKeyForm(): ReactElement {
       name: '',
       expiresAtSelect: '7',
       expiresAt: startOfDay(addDays(new Date(), 7)),
+      scopes: [],
+      jobIds: [],
     },
   });
   const posthog = usePostHog();
@@ -69,6 +91,8 @@ export default function NewApiKeyForm(): ReactElement {
           seconds: BigInt(values.expiresAt.getTime() / 1000),
         }),
         name: values.name,
+        scopes: values.scopes,
+        jobIds: values.jobIds,
       });
       if (apiKey.apiKey?.id) {
         if (apiKey.apiKey.keyValue && !!window?.sessionStorage) {
@@ -219,6 +243,72 @@ export default function NewApiKeyForm(): ReactElement {
             )}
           />
         )}
+        <FormField
+          control={form.control}
+          name="scopes"
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>Scopes</FormLabel>
+              <FormDescription>
+                Limit what the API key is allowed to do. If no scopes are
+                selected, the API key has full access to the account.
+              </FormDescription>
+              <FormControl>
+                <ToggleGroup
+                  type="multiple"
+                  className="flex justify-start items-start flex-wrap"
+                  onValueChange={field.onChange}
+                  value={field.value}
+                >
+                  {API_KEY_SCOPES.map((scope) => (
+                    <ToggleGroupItem
+                      key={scope.value}
+                      className="border"
+                      value={scope.value}
+                    >
+                      {scope.label}
+                    </ToggleGroupItem>
+                  ))}
+                </ToggleGroup>
+              </FormControl>
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+
+        <FormField
+          control={form.control}
+          name="jobIds"
+          render={({ field }) => (
+            <FormItem>
+              <FormLabel>Jobs</FormLabel>
+              <FormDescription>
+                Restrict the API key to specific jobs. If no jobs are selected,
+                the API key can be used with any job in the account.
+              </FormDescription>
+              <FormControl>
+                <ToggleGroup
+                  type="multiple"
+                  className="flex justify-start items-start flex-wrap"
+                  onValueChange={field.onChange}
+                  value={field.value}
+                >
+                  {(jobsData?.jobs ?? []).map((job) => (
+                    <ToggleGroupItem
+                      key={job.id}
+                      className="border"
+                      value={job.id}
+                    >
+                      {job.name}
+                    </ToggleGroupItem>
+                  ))}
+                </ToggleGroup>
+              </FormControl>
+              <FormMessage />
+            </FormItem>
+          )}
+        />
+
         <div className="flex flex-row justify-end">
           <Button type="submit">Submit</Button>
         </div>
@@ -196,6 +196,7 @@ export default function NewDynamoDBForm(): ReactElement {
     setIsValidating(true);
     try {
       const res = await checkDbConnection({
+        accountId: account?.id ?? '',
         connectionConfig: buildConnectionConfigDynamoDB(form.getValues()),
       });
       setValidationResponse(res);
@@ -176,6 +176,7 @@ export default function MongoDBForm(): ReactElement {
     setIsValidating(true);
     try {
       const res = await checkMongoDbConnection({
+        accountId: account?.id ?? '',
         connectionConfig: buildConnectionConfigMongo(form.getValues()),
       });
       setValidationResponse(res);
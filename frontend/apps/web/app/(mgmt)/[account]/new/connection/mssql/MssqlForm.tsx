@@ -437,6 +437,7 @@ the hook in the useEffect conditionally. This is used to retrieve the values for
               const values = form.getValues();
               try {
                 const res = await checkMssqlConnection({
+                  accountId: account?.id ?? '',
                   connectionConfig: buildConnectionConfigMssql(values),
                 });
                 setValidationResponse(res);
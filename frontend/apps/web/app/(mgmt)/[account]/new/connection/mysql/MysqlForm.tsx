@@ -634,6 +634,7 @@ the hook in the useEffect conditionally. This is used to retrieve the values for
               const values = form.getValues();
               try {
                 const res = await checkMysqlConnection({
+                  accountId: account?.id ?? '',
                   connectionConfig: buildConnectionConfigMysql({
                     ...values,
                     url: activeTab === 'url' ? values.url : undefined,
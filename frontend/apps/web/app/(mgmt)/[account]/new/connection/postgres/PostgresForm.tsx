@@ -696,6 +696,7 @@ the hook in the useEffect conditionally. This is used to retrieve the values for
               try {
                 const values = form.getValues();
                 const res = await checkPostgresConnection({
+                  accountId: account?.id ?? '',
                   connectionConfig: buildConnectionConfigPostgres({
                     ...values,
                     url: activeTab === 'url' ? values.url : undefined,
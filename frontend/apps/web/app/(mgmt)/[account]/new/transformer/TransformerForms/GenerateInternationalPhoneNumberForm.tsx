@@ -5,6 +5,7 @@ import { Input } from '@/components/ui/input';
 import { PlainMessage } from '@bufbuild/protobuf';
 import { GenerateE164PhoneNumber } from '@neosync/sdk';
 import { ReactElement } from 'react';
+import LocaleForm from './LocaleForm';
 import { TransformerConfigProps } from './util';
 
 interface Props
@@ -82,6 +83,15 @@ export default function GenerateInternationalPhoneNumberForm(
           </div>
         </div>
       </div>
+      <LocaleForm
+        value={value.locale}
+        onChange={(locale) =>
+          setValue(new GenerateE164PhoneNumber({ ...value, locale }))
+        }
+        isDisabled={isDisabled}
+        description="Select a locale to generate phone numbers in its national numbering plan. Random digits are generated by default."
+        error={errors?.locale?.message}
+      />
     </div>
   );
 }
@@ -0,0 +1,25 @@
+'use client';
+import { TransformerLocale } from '@neosync/sdk';
+import { ReactElement } from 'react';
+import LocaleForm from './LocaleForm';
+import { TransformerConfigProps } from './util';
+
+interface LocaleConfig {
+  locale?: TransformerLocale;
+}
+
+interface Props extends TransformerConfigProps<LocaleConfig> {}
+
+// Used by the name and address generators whose only configuration is the locale
+export default function LocaleConfigForm(props: Props): ReactElement {
+  const { value, setValue, isDisabled, errors } = props;
+
+  return (
+    <LocaleForm
+      value={value.locale}
+      onChange={(locale) => setValue({ ...value, locale })}
+      isDisabled={isDisabled}
+      error={errors?.locale?.message}
+    />
+  );
+}
@@ -0,0 +1,78 @@
+'use client';
+import FormErrorMessage from '@/components/FormErrorMessage';
+import { FormDescription, FormLabel } from '@/components/ui/form';
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from '@/components/ui/select';
+import { getTransformerLocaleString } from '@/util/util';
+import { TransformerLocale } from '@neosync/sdk';
+import { ReactElement } from 'react';
+
+interface Props {
+  value?: TransformerLocale;
+  onChange(newValue: TransformerLocale): void;
+  isDisabled?: boolean;
+  description?: string;
+  error?: string;
+}
+
+const LOCALES = [
+  TransformerLocale.EN_US,
+  TransformerLocale.DE_DE,
+  TransformerLocale.FR_FR,
+  TransformerLocale.ES_ES,
+  TransformerLocale.JA_JP,
+];
+
+export default function LocaleForm(props: Props): ReactElement {
+  const { value, onChange, isDisabled, description, error } = props;
+
+  return (
+    <div className="flex flex-row items-center justify-between rounded-lg border dark:border-gray-700 p-3 shadow-sm">
+      <div className="space-y-0.5 w-[80%]">
+        <FormLabel>Locale</FormLabel>
+        <FormDescription>
+          {description ??
+            'Select the locale of the dataset that values are generated from. Defaults to en_US.'}
+        </FormDescription>
+      </div>
+      <div className="flex flex-col">
+        <div className="justify-end flex">
+          <Select
+            disabled={isDisabled}
+            onValueChange={(newValue) => {
+              onChange(parseInt(newValue, 10));
+            }}
+            value={(value ?? TransformerLocale.UNSPECIFIED).toString()}
+          >
+            <SelectTrigger className="w-[300px]">
+              <SelectValue />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectItem
+                className="cursor-pointer"
+                value={TransformerLocale.UNSPECIFIED.toString()}
+              >
+                default
+              </SelectItem>
+              {LOCALES.map((locale) => (
+                <SelectItem
+                  key={locale}
+                  className="cursor-pointer"
+                  value={locale.toString()}
+                >
+                  {getTransformerLocaleString(locale)}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+        </div>
+        <FormErrorMessage message={error} />
+      </div>
+    </div>
+  );
+}
@@ -5,6 +5,7 @@ import { Switch } from '@/components/ui/switch';
 import { PlainMessage } from '@bufbuild/protobuf';
 import { TransformFirstName } from '@neosync/sdk';
 import { ReactElement } from 'react';
+import LocaleForm from './LocaleForm';
 import { TransformerConfigProps } from './util';
 
 interface Props
@@ -35,6 +36,14 @@ export default function TransformFirstNameForm(props: Props): ReactElement {
         />
       </div>
       <FormErrorMessage message={errors?.preserveLength?.message} />
+      <LocaleForm
+        value={value.locale}
+        onChange={(locale) =>
+          setValue(new TransformFirstName({ ...value, locale }))
+        }
+        isDisabled={isDisabled}
+        error={errors?.locale?.message}
+      />
     </div>
   );
 }
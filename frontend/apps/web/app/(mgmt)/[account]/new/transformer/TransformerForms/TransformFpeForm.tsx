@@ -0,0 +1,165 @@
+'use client';
+import FormErrorMessage from '@/components/FormErrorMessage';
+import { FormDescription, FormLabel } from '@/components/ui/form';
+import { Input } from '@/components/ui/input';
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from '@/components/ui/select';
+import { Switch } from '@/components/ui/switch';
+import { PlainMessage } from '@bufbuild/protobuf';
+import { FpeAlphabet, TransformFpe } from '@neosync/sdk';
+import { ReactElement } from 'react';
+import { TransformerConfigProps } from './util';
+
+interface Props
+  extends TransformerConfigProps<TransformFpe, PlainMessage<TransformFpe>> {}
+
+export default function TransformFpeForm(props: Props): ReactElement {
+  const { value, setValue, isDisabled, errors } = props;
+
+  return (
+    <div className="flex flex-col w-full space-y-4">
+      <div className="flex flex-row items-center justify-between rounded-lg border dark:border-gray-700 p-3 shadow-sm">
+        <div className="space-y-0.5 w-[70%]">
+          <FormLabel>Alphabet</FormLabel>
+          <FormDescription>
+            The characters that values are made up of. Encrypted values are
+            made up of the same characters and characters outside of the
+            alphabet are left in place.
+          </FormDescription>
+        </div>
+        <div className="flex flex-col">
+          <div className="justify-end flex">
+            <Select
+              disabled={isDisabled}
+              onValueChange={(newValue) => {
+                setValue(
+                  new TransformFpe({
+                    ...value,
+                    alphabet: parseInt(newValue, 10),
+                  })
+                );
+              }}
+              value={(value.alphabet ?? FpeAlphabet.ALPHANUMERIC).toString()}
+            >
+              <SelectTrigger className="w-[300px]">
+                <SelectValue />
+              </SelectTrigger>
+              <SelectContent>
+                {[
+                  FpeAlphabet.NUMERIC,
+                  FpeAlphabet.ALPHANUMERIC,
+                  FpeAlphabet.CUSTOM,
+                ].map((alphabet) => (
+                  <SelectItem
+                    key={alphabet}
+                    className="cursor-pointer"
+                    value={alphabet.toString()}
+                  >
+                    {getFpeAlphabetString(alphabet)}
+                  </SelectItem>
+                ))}
+              </SelectContent>
+            </Select>
+          </div>
+          <FormErrorMessage message={errors?.alphabet?.message} />
+        </div>
+      </div>
+      {value.alphabet === FpeAlphabet.CUSTOM && (
+        <div className="flex flex-row items-center justify-between rounded-lg border dark:border-gray-700 p-3 shadow-sm">
+          <div className="space-y-0.5 w-[70%]">
+            <FormLabel>Custom Alphabet</FormLabel>
+            <FormDescription>
+              The unique characters that make up the alphabet, for example
+              ABCDEF0123456789. Must contain at least 2 characters.
+            </FormDescription>
+          </div>
+          <div className="flex flex-col">
+            <div className="justify-end flex min-w-[300px]">
+              <Input
+                type="string"
+                value={value.customAlphabet ?? ''}
+                onChange={(e) => {
+                  setValue(
+                    new TransformFpe({
+                      ...value,
+                      customAlphabet: e.target.value,
+                    })
+                  );
+                }}
+                disabled={isDisabled}
+              />
+            </div>
+            <FormErrorMessage message={errors?.customAlphabet?.message} />
+          </div>
+        </div>
+      )}
+      <div className="flex flex-row items-center justify-between rounded-lg border dark:border-gray-700 p-3 shadow-sm">
+        <div className="space-y-0.5 w-[70%]">
+          <FormLabel>Tweak</FormLabel>
+          <FormDescription>
+            An optional value that changes the encrypted output, for example to
+            tokenize the same value differently per column. The same tweak is
+            required to decrypt the value.
+          </FormDescription>
+        </div>
+        <div className="flex flex-col">
+          <div className="justify-end flex min-w-[300px]">
+            <Input
+              type="string"
+              value={value.tweak ?? ''}
+              onChange={(e) => {
+                setValue(
+                  new TransformFpe({
+                    ...value,
+                    tweak: e.target.value,
+                  })
+                );
+              }}
+              disabled={isDisabled}
+            />
+          </div>
+          <FormErrorMessage message={errors?.tweak?.message} />
+        </div>
+      </div>
+      <div className="flex flex-row items-center justify-between rounded-lg border dark:border-gray-700 p-3 shadow-sm">
+        <div className="space-y-0.5 w-[80%]">
+          <FormLabel>Pass Through Short Values</FormLabel>
+          <FormDescription>
+            Values with too few characters from the alphabet can not be
+            encrypted, for example numbers with fewer than 6 digits. By default
+            these values fail the sync. When enabled, they are left unencrypted
+            instead.
+          </FormDescription>
+        </div>
+        <Switch
+          checked={value.passThroughShortValues}
+          onCheckedChange={(checked) =>
+            setValue(
+              new TransformFpe({ ...value, passThroughShortValues: checked })
+            )
+          }
+          disabled={isDisabled}
+        />
+        <FormErrorMessage message={errors?.passThroughShortValues?.message} />
+      </div>
+    </div>
+  );
+}
+
+function getFpeAlphabetString(alphabet: FpeAlphabet): string {
+  switch (alphabet) {
+    case FpeAlphabet.NUMERIC:
+      return 'Numeric (0-9)';
+    case FpeAlphabet.ALPHANUMERIC:
+      return 'Alphanumeric (0-9, a-z, A-Z)';
+    case FpeAlphabet.CUSTOM:
+      return 'Custom';
+    default:
+      return 'Alphanumeric (0-9, a-z, A-Z)';
+  }
+}
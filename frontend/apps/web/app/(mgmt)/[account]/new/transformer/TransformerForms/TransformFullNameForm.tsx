@@ -5,6 +5,7 @@ import { Switch } from '@/components/ui/switch';
 import { PlainMessage } from '@bufbuild/protobuf';
 import { TransformFullName } from '@neosync/sdk';
 import { ReactElement } from 'react';
+import LocaleForm from './LocaleForm';
 import { TransformerConfigProps } from './util';
 
 interface Props
@@ -36,6 +37,14 @@ export default function TransformFullNameForm(props: Props): ReactElement {
         />
       </div>
       <FormErrorMessage message={errors?.preserveLength?.message} />
+      <LocaleForm
+        value={value.locale}
+        onChange={(locale) =>
+          setValue(new TransformFullName({ ...value, locale }))
+        }
+        isDisabled={isDisabled}
+        error={errors?.locale?.message}
+      />
     </div>
   );
 }
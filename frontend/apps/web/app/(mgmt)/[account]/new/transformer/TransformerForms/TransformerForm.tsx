@@ -1,5 +1,14 @@
 import { PlainMessage } from '@bufbuild/protobuf';
-import { TransformerConfig } from '@neosync/sdk';
+import {
+  GenerateCity,
+  GenerateFirstName,
+  GenerateFullAddress,
+  GenerateFullName,
+  GenerateLastName,
+  GenerateStreetAddress,
+  GenerateZipcode,
+  TransformerConfig,
+} from '@neosync/sdk';
 import { ReactElement } from 'react';
 import { FieldErrors } from 'react-hook-form';
 import GenerateCardNumberForm from './GenerateCardNumber';
@@ -15,11 +24,13 @@ import GenerateStateForm from './GenerateStateForm';
 import GenerateStringForm from './GenerateStringForm';
 import GenerateStringPhoneNumberNumberForm from './GenerateStringPhoneNumberForm';
 import GenerateUuidForm from './GenerateUuidForm';
+import LocaleConfigForm from './LocaleConfigForm';
 import TransformCharacterScrambleForm from './TransformCharacterScrambleForm';
 import TransformE164NumberForm from './TransformE164PhoneNumberForm';
 import TransformEmailForm from './TransformEmailForm';
 import TransformFirstNameForm from './TransformFirstNameForm';
 import TransformFloat64Form from './TransformFloat64Form';
+import TransformFpeForm from './TransformFpeForm';
 import TransformFullNameForm from './TransformFullNameForm';
 import TransformInt64Form from './TransformInt64Form';
 import TransformIntPhoneNumberForm from './TransformInt64PhoneForm';
@@ -418,6 +429,147 @@ export default function TransformerForm(props: Props): ReactElement {
           errors={errors?.config?.value}
         />
       );
+    case 'transformFpeConfig':
+      return (
+        <TransformFpeForm
+          value={valConfig.value}
+          setValue={(newVal) =>
+            setValue(
+              new TransformerConfig({
+                config: { case: valConfig.case, value: newVal },
+              })
+            )
+          }
+          isDisabled={disabled}
+          errors={errors?.config?.value}
+        />
+      );
+    case 'generateCityConfig':
+      return (
+        <LocaleConfigForm
+          value={valConfig.value}
+          setValue={(newVal) =>
+            setValue(
+              new TransformerConfig({
+                config: {
+                  case: valConfig.case,
+                  value: new GenerateCity(newVal),
+                },
+              })
+            )
+          }
+          isDisabled={disabled}
+          errors={errors?.config?.value}
+        />
+      );
+    case 'generateFirstNameConfig':
+      return (
+        <LocaleConfigForm
+          value={valConfig.value}
+          setValue={(newVal) =>
+            setValue(
+              new TransformerConfig({
+                config: {
+                  case: valConfig.case,
+                  value: new GenerateFirstName(newVal),
+                },
+              })
+            )
+          }
+          isDisabled={disabled}
+          errors={errors?.config?.value}
+        />
+      );
+    case 'generateFullAddressConfig':
+      return (
+        <LocaleConfigForm
+          value={valConfig.value}
+          setValue={(newVal) =>
+            setValue(
+              new TransformerConfig({
+                config: {
+                  case: valConfig.case,
+                  value: new GenerateFullAddress(newVal),
+                },
+              })
+            )
+          }
+          isDisabled={disabled}
+          errors={errors?.config?.value}
+        />
+      );
+    case 'generateFullNameConfig':
+      return (
+        <LocaleConfigForm
+          value={valConfig.value}
+          setValue={(newVal) =>
+            setValue(
+              new TransformerConfig({
+                config: {
+                  case: valConfig.case,
+                  value: new GenerateFullName(newVal),
+                },
+              })
+            )
+          }
+          isDisabled={disabled}
+          errors={errors?.config?.value}
+        />
+      );
+    case 'generateLastNameConfig':
+      return (
+        <LocaleConfigForm
+          value={valConfig.value}
+          setValue={(newVal) =>
+            setValue(
+              new TransformerConfig({
+                config: {
+                  case: valConfig.case,
+                  value: new GenerateLastName(newVal),
+                },
+              })
+            )
+          }
+          isDisabled={disabled}
+          errors={errors?.config?.value}
+        />
+      );
+    case 'generateStreetAddressConfig':
+      return (
+        <LocaleConfigForm
+          value={valConfig.value}
+          setValue={(newVal) =>
+            setValue(
+              new TransformerConfig({
+                config: {
+                  case: valConfig.case,
+                  value: new GenerateStreetAddress(newVal),
+                },
+              })
+            )
+          }
+          isDisabled={disabled}
+          errors={errors?.config?.value}
+        />
+      );
+    case 'generateZipcodeConfig':
+      return (
+        <LocaleConfigForm
+          value={valConfig.value}
+          setValue={(newVal) =>
+            setValue(
+              new TransformerConfig({
+                config: {
+                  case: valConfig.case,
+                  value: new GenerateZipcode(newVal),
+                },
+              })
+            )
+          }
+          isDisabled={disabled}
+          errors={errors?.config?.value}
+        />
+      );
     default:
       return NoConfigComponent ?? <div />;
   }
@@ -36,13 +36,19 @@ import { useMutation, useQuery } from '@connectrpc/connect-query';
 import { Editor } from '@monaco-editor/react';
 import {
   cancelJobRun,
+  createJobRun,
   deleteJobRun,
   getJobRun,
   getJobRunEvents,
   getRunContext,
   terminateJobRun,
 } from '@neosync/sdk/connectquery';
-import { ArrowRightIcon, Cross2Icon, TrashIcon } from '@radix-ui/react-icons';
+import {
+  ArrowRightIcon,
+  Cross2Icon,
+  ReloadIcon,
+  TrashIcon,
+} from '@radix-ui/react-icons';
 import { formatDuration, intervalToDuration } from 'date-fns';
 import { useTheme } from 'next-themes';
 import { useRouter } from 'next/navigation';
@@ -100,6 +106,7 @@ export default function Page({ params }: PageProps): ReactElement {
   const { mutateAsync: removeJobRunAsync } = useMutation(deleteJobRun);
   const { mutateAsync: cancelJobRunAsync } = useMutation(cancelJobRun);
   const { mutateAsync: terminateJobRunAsync } = useMutation(terminateJobRun);
+  const { mutateAsync: createJobRunAsync } = useMutation(createJobRun);
   const { mutateAsync: getRunContextAsync } = useMutation(getRunContext);
 
   const [isViewSelectDialogOpen, setIsSelectDialogOpen] =
@@ -178,6 +185,22 @@ export default function Page({ params }: PageProps): ReactElement {
     }
   }
 
+  async function onResume(): Promise<void> {
+    if (!jobRun?.jobId) {
+      return;
+    }
+    try {
+      await createJobRunAsync({ jobId: jobRun.jobId, resumeFromRunId: id });
+      toast.success('Job run resumed successfully!');
+      router.push(`/${account?.name}/jobs/${jobRun.jobId}`);
+    } catch (err) {
+      console.error(err);
+      toast.error('Unable to resume job run', {
+        description: getErrorMessage(err),
+      });
+    }
+  }
+
   async function onViewSelectClicked(
     schema: string,
     table: string
@@ -265,6 +288,21 @@ export default function Page({ params }: PageProps): ReactElement {
                   />
                 </div>
               )}
+              {isResumableJobRunStatus(jobRun?.status) && (
+                <ConfirmationDialog
+                  trigger={
+                    <Button variant="default">
+                      <ButtonText leftIcon={<ReloadIcon />} text="Resume" />
+                    </Button>
+                  }
+                  headerText="Resume Job Run?"
+                  description="A new job run will be started that skips the tables that completed in this job run and only syncs the tables that failed or did not start."
+                  onConfirm={async () => onResume()}
+                  buttonText="Resume"
+                  buttonVariant="default"
+                  buttonIcon={<ReloadIcon />}
+                />
+              )}
               <ButtonLink jobId={jobRun?.jobId} />
             </div>
           }
@@ -500,6 +538,15 @@ function AlertDestructive(props: AlertProps): ReactElement {
   );
 }
 
+function isResumableJobRunStatus(status?: JobRunStatusEnum): boolean {
+  return (
+    status === JobRunStatusEnum.FAILED ||
+    status === JobRunStatusEnum.CANCELED ||
+    status === JobRunStatusEnum.TERMINATED ||
+    status === JobRunStatusEnum.TIMED_OUT
+  );
+}
+
 interface ButtonProps {
   jobId?: string;
 }
@@ -155,6 +155,32 @@ function ApiKeyDetails(props: ApiKeyDetailsProps): ReactElement {
             <p className="text-sm tracking-tight w-[100px]">User ID:</p>
             <Badge variant="outline">{apiKey.userId}</Badge>
           </div>
+          <div className="flex flex-row gap-2">
+            <p className="text-sm tracking-tight w-[100px]">Scopes:</p>
+            <div className="flex flex-row flex-wrap gap-2">
+              {apiKey.scopes.length === 0 ? (
+                <Badge variant="outline">full access</Badge>
+              ) : (
+                apiKey.scopes.map((scope) => (
+                  <Badge key={scope} variant="outline">
+                    {scope}
+                  </Badge>
+                ))
+              )}
+            </div>
+          </div>
+          {apiKey.jobIds.length > 0 && (
+            <div className="flex flex-row gap-2">
+              <p className="text-sm tracking-tight w-[100px]">Jobs:</p>
+              <div className="flex flex-row flex-wrap gap-2">
+                {apiKey.jobIds.map((jobId) => (
+                  <Badge key={jobId} variant="outline">
+                    {jobId}
+                  </Badge>
+                ))}
+              </div>
+            </div>
+          )}
         </div>
       </div>
     </div>
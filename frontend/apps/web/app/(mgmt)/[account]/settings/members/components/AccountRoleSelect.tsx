@@ -0,0 +1,49 @@
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from '@/components/ui/select';
+import { getAccountRoleString } from '@/util/util';
+import { AccountRole } from '@neosync/sdk';
+import { ReactElement } from 'react';
+
+export const SUPPORTED_ACCOUNT_ROLES = [
+  AccountRole.ADMIN,
+  AccountRole.JOB_DEVELOPER,
+  AccountRole.JOB_EXECUTOR,
+  AccountRole.VIEWER,
+];
+
+interface Props {
+  value: AccountRole;
+  onChange(value: AccountRole): void;
+  disabled?: boolean;
+}
+
+export default function AccountRoleSelect(props: Props): ReactElement {
+  const { value, onChange, disabled } = props;
+  return (
+    <Select
+      onValueChange={(newVal) => onChange(parseInt(newVal, 10))}
+      value={value ? value.toString() : ''}
+      disabled={disabled}
+    >
+      <SelectTrigger>
+        <SelectValue placeholder="Select a role" />
+      </SelectTrigger>
+      <SelectContent>
+        {SUPPORTED_ACCOUNT_ROLES.map((role) => (
+          <SelectItem
+            key={role}
+            className="cursor-pointer"
+            value={role.toString()}
+          >
+            {getAccountRoleString(role)}
+          </SelectItem>
+        ))}
+      </SelectContent>
+    </Select>
+  );
+}
@@ -15,8 +15,10 @@ import {
 import {
   Form,
   FormControl,
+  FormDescription,
   FormField,
   FormItem,
+  FormLabel,
   FormMessage,
 } from '@/components/ui/form';
 import { Input } from '@/components/ui/input';
@@ -25,12 +27,14 @@ import { getErrorMessage } from '@/util/util';
 import { InviteMembersForm } from '@/yup-validations/invite-members';
 import { useMutation } from '@connectrpc/connect-query';
 import { yupResolver } from '@hookform/resolvers/yup';
+import { AccountRole } from '@neosync/sdk';
 import { inviteUserToTeamAccount } from '@neosync/sdk/connectquery';
 import { DialogClose } from '@radix-ui/react-dialog';
 import { PlusIcon } from '@radix-ui/react-icons';
 import { ReactElement, useState } from 'react';
 import { useForm } from 'react-hook-form';
 import { toast } from 'sonner';
+import AccountRoleSelect from './AccountRoleSelect';
 
 interface Props {
   accountId: string;
@@ -46,6 +50,7 @@ export default function InviteUserForm(props: Props): ReactElement {
     resolver: yupResolver(InviteMembersForm),
     defaultValues: {
       email: '',
+      role: AccountRole.VIEWER,
     },
   });
   const { mutateAsync } = useMutation(inviteUserToTeamAccount);
@@ -55,6 +60,7 @@ export default function InviteUserForm(props: Props): ReactElement {
       const invite = await mutateAsync({
         accountId: accountId,
         email: values.email,
+        role: values.role,
       });
       setShowNewinviteDialog(false);
       if (invite?.invite?.token) {
@@ -113,6 +119,26 @@ export default function InviteUserForm(props: Props): ReactElement {
                   </FormItem>
                 )}
               />
+              <FormField
+                control={form.control}
+                name="role"
+                render={({ field }) => (
+                  <FormItem>
+                    <FormLabel>Role</FormLabel>
+                    <FormDescription>
+                      The role the member will be given once they accept the
+                      invite.
+                    </FormDescription>
+                    <FormControl>
+                      <AccountRoleSelect
+                        value={field.value}
+                        onChange={field.onChange}
+                      />
+                    </FormControl>
+                    <FormMessage />
+                  </FormItem>
+                )}
+              />
 
               <DialogFooter>
                 <Button
@@ -37,23 +37,26 @@ import {
 import { getErrorMessage } from '@/util/util';
 import { PlainMessage } from '@bufbuild/protobuf';
 import { useMutation, useQuery } from '@connectrpc/connect-query';
-import { AccountUser } from '@neosync/sdk';
+import { AccountRole, AccountUser } from '@neosync/sdk';
 import {
   getTeamAccountMembers,
   removeTeamAccountMember,
+  setTeamAccountMemberRole,
 } from '@neosync/sdk/connectquery';
 import { DotsHorizontalIcon } from '@radix-ui/react-icons';
 import { toast } from 'sonner';
+import AccountRoleSelect from './AccountRoleSelect';
 
 interface ColumnProps {
   onDeleted(id: string): void;
+  onUpdated(): void;
   accountId: string;
 }
 
 function getColumns(
   props: ColumnProps
 ): ColumnDef<PlainMessage<AccountUser>>[] {
-  const { onDeleted, accountId } = props;
+  const { onDeleted, onUpdated, accountId } = props;
   return [
     {
       accessorKey: 'name',
@@ -80,6 +83,18 @@ function getColumns(
         <span className=" truncate font-medium">{row.getValue('email')}</span>
       ),
     },
+    {
+      accessorKey: 'role',
+      header: 'Role',
+      cell: ({ row }) => (
+        <MemberRoleSelect
+          accountId={accountId}
+          userId={row.original.id}
+          role={row.original.role}
+          onUpdated={onUpdated}
+        />
+      ),
+    },
     {
       id: 'actions',
       cell: ({ row }) => (
@@ -112,6 +127,7 @@ export default function MembersTable(props: Props) {
       data={data?.users || []}
       accountId={accountId}
       onDeleted={() => refetch()}
+      onUpdated={() => refetch()}
     />
   );
 }
@@ -120,10 +136,11 @@ interface DataTableProps {
   data: AccountUser[];
   accountId: string;
   onDeleted(id: string): void;
+  onUpdated(): void;
 }
 
 function DataTable(props: DataTableProps): React.ReactElement {
-  const { data, accountId, onDeleted } = props;
+  const { data, accountId, onDeleted, onUpdated } = props;
   const [sorting, setSorting] = React.useState<SortingState>([]);
   const [columnFilters, setColumnFilters] = React.useState<ColumnFiltersState>(
     []
@@ -133,7 +150,7 @@ function DataTable(props: DataTableProps): React.ReactElement {
   const [rowSelection, setRowSelection] = React.useState({});
 
   const columns = React.useMemo(
-    () => getColumns({ accountId, onDeleted }),
+    () => getColumns({ accountId, onDeleted, onUpdated }),
     [accountId]
   );
 
@@ -298,3 +315,41 @@ function DataTableRowActions<TData>({
     </DropdownMenu>
   );
 }
+
+interface MemberRoleSelectProps {
+  accountId: string;
+  userId: string;
+  role: AccountRole;
+  onUpdated(): void;
+}
+
+function MemberRoleSelect(props: MemberRoleSelectProps): React.ReactElement {
+  const { accountId, userId, role, onUpdated } = props;
+  const { mutateAsync, isPending } = useMutation(setTeamAccountMemberRole);
+
+  async function onChange(newRole: AccountRole): Promise<void> {
+    if (newRole === role) {
+      return;
+    }
+    try {
+      await mutateAsync({ accountId, userId, role: newRole });
+      toast.success('Member role updated!');
+      onUpdated();
+    } catch (err) {
+      console.error(err);
+      toast.error('Unable to update member role!', {
+        description: getErrorMessage(err),
+      });
+    }
+  }
+
+  return (
+    <div className="w-[180px]">
+      <AccountRoleSelect
+        value={role}
+        onChange={onChange}
+        disabled={isPending}
+      />
+    </div>
+  );
+}
@@ -14,6 +14,7 @@ import { AwsS3DestinationOptionsFormValues } from '@/yup-validations/jobs';
 import { AwsS3DestinationConnectionOptions_StorageClass } from '@neosync/sdk';
 import { ReactElement, ReactNode } from 'react';
 import { FieldErrors } from 'react-hook-form';
+import BucketFileFormatSelect from './BucketFileFormatSelect';
 
 interface Props {
   value: AwsS3DestinationOptionsFormValues;
@@ -77,6 +78,37 @@ export default function AwsS3DestinationOptionsForm(
             <FormErrorMessage message={errors?.storageClass?.message} />
           </FormInputContainer>
         </FormItemContainer>
+        <FormItemContainer>
+          <FormHeader
+            title="File Format"
+            description="The format that records will be written to S3 in. Parquet files use a schema derived from the source table's columns."
+            isErrored={!!errors?.format}
+          />
+          <FormInputContainer>
+            <BucketFileFormatSelect
+              value={value.format}
+              onChange={(newVal) => setValue({ ...value, format: newVal })}
+            />
+            <FormErrorMessage message={errors?.format?.message} />
+          </FormInputContainer>
+        </FormItemContainer>
+        <FormItemContainer>
+          <FormHeader
+            title="Path Template"
+            description="Optional template for the path of each object, relative to the connection's path prefix. Ex: exports/{{table}}/{{date}}/{{file_index}}.{{extension}}. Data written with a custom template can not be used as a job source."
+            isErrored={!!errors?.pathTemplate}
+          />
+          <FormInputContainer>
+            <Input
+              value={value.pathTemplate ?? ''}
+              placeholder="workflows/{{job_run_id}}/activities/{{schema}}.{{table}}/data/{{file_index}}.{{extension}}"
+              onChange={(e) =>
+                setValue({ ...value, pathTemplate: e.target.value })
+              }
+            />
+            <FormErrorMessage message={errors?.pathTemplate?.message} />
+          </FormInputContainer>
+        </FormItemContainer>
         <FormItemContainer>
           <FormHeader
             title="Max in Flight"
@@ -0,0 +1,51 @@
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from '@/components/ui/select';
+import { getBucketFileFormatString } from '@/util/util';
+import { BucketFileFormat } from '@neosync/sdk';
+import { ReactElement } from 'react';
+
+interface Props {
+  value?: BucketFileFormat;
+  onChange(newVal: BucketFileFormat): void;
+}
+
+const SUPPORTED_FORMATS = [
+  BucketFileFormat.JSON_LINES,
+  BucketFileFormat.PARQUET,
+  BucketFileFormat.CSV,
+];
+
+export default function BucketFileFormatSelect(props: Props): ReactElement {
+  const { value, onChange } = props;
+
+  return (
+    <Select
+      onValueChange={(newVal) => onChange(parseInt(newVal, 10))}
+      value={
+        !value || value === BucketFileFormat.UNSPECIFIED
+          ? BucketFileFormat.JSON_LINES.toString()
+          : value.toString()
+      }
+    >
+      <SelectTrigger>
+        <SelectValue />
+      </SelectTrigger>
+      <SelectContent>
+        {SUPPORTED_FORMATS.map((format) => (
+          <SelectItem
+            key={format}
+            className="cursor-pointer"
+            value={format.toString()}
+          >
+            {getBucketFileFormatString(format)}
+          </SelectItem>
+        ))}
+      </SelectContent>
+    </Select>
+  );
+}
@@ -10,6 +10,7 @@ import TableMappingsCard, {
   Props as TableMappingsCardProps,
 } from '../NosqlTable/TableMappings/TableMappingsCard';
 import AwsS3DestinationOptionsForm from './AwsS3DestinationOptionsForm';
+import GcpCloudStorageDestinationOptionsForm from './GcpCloudStorageDestinationOptionsForm';
 
 interface DestinationOptionsProps {
   connection?: Connection;
@@ -146,6 +147,32 @@ export default function DestinationOptionsForm(
               description="If there is a conflict when inserting data do not insert"
             />
           </div>
+          <div>
+            <SwitchCard
+              isChecked={value.postgres?.onConflictUpdate ?? false}
+              onCheckedChange={(newVal) => {
+                setValue({
+                  ...value,
+                  postgres: {
+                    ...(value.postgres ?? {
+                      initTableSchema: false,
+                      onConflictDoNothing: false,
+                      truncateBeforeInsert: false,
+                      truncateCascade: false,
+                      skipForeignKeyViolations: false,
+                    }),
+
+                    onConflictDoNothing: newVal
+                      ? false
+                      : (value.postgres?.onConflictDoNothing ?? false),
+                    onConflictUpdate: newVal,
+                  },
+                });
+              }}
+              title="On Conflict Update"
+              description="If there is a conflict when inserting data update the existing row's non-key columns"
+            />
+          </div>
           <div>
             <SwitchCard
               isChecked={value.postgres?.skipForeignKeyViolations ?? false}
@@ -238,6 +265,31 @@ export default function DestinationOptionsForm(
               description="If there is a conflict when inserting data do not insert"
             />
           </div>
+          <div>
+            <SwitchCard
+              isChecked={value.mysql?.onConflictUpdate ?? false}
+              onCheckedChange={(newVal) => {
+                setValue({
+                  ...value,
+                  mysql: {
+                    ...(value.mysql ?? {
+                      initTableSchema: false,
+                      onConflictDoNothing: false,
+                      truncateBeforeInsert: false,
+                      skipForeignKeyViolations: false,
+                    }),
+
+                    onConflictDoNothing: newVal
+                      ? false
+                      : (value.mysql?.onConflictDoNothing ?? false),
+                    onConflictUpdate: newVal,
+                  },
+                });
+              }}
+              title="On Conflict Update"
+              description="If there is a conflict when inserting data update the existing row's non-key columns"
+            />
+          </div>
           <div>
             <SwitchCard
               isChecked={value.mysql?.skipForeignKeyViolations ?? false}
@@ -275,7 +327,15 @@ export default function DestinationOptionsForm(
     case 'mongoConfig':
       return <></>;
     case 'gcpCloudstorageConfig':
-      return <></>;
+      return (
+        <GcpCloudStorageDestinationOptionsForm
+          value={value.gcpcloudstorage ?? {}}
+          setValue={(val) =>
+            setValue({ ...value, gcpcloudstorage: { ...val } })
+          }
+          errors={errors?.gcpcloudstorage}
+        />
+      );
     case 'dynamodbConfig':
       return (
         <DynamoDbOptions
@@ -340,9 +400,34 @@ export default function DestinationOptionsForm(
               description="Truncates table before inserting data"
             />
           </div>
+          {!hideInitTableSchema && (
+            <div>
+              <SwitchCard
+                isChecked={value.mssql?.initTableSchema ?? false}
+                onCheckedChange={(newVal) => {
+                  setValue({
+                    ...value,
+                    mssql: {
+                      ...(value.mssql ?? {
+                        initTableSchema: false,
+                        onConflictDoNothing: false,
+                        truncateBeforeInsert: false,
+                        skipForeignKeyViolations: false,
+                      }),
+
+                      initTableSchema: newVal ?? false,
+                    },
+                  });
+                }}
+                title="Init Table Schema"
+                postTitle={<Badge>Experimental</Badge>}
+                description="Creates table(s) and their constraints. The database schema must already exist. "
+              />
+            </div>
+          )}
           <div>
             {/* <SwitchCard
-              isChecked={value.mssql?.initTableSchema ?? false}
+              isChecked={value.mssql?.onConflictDoNothing ?? false}
               onCheckedChange={(newVal) => {
                 setValue({
                   ...value,
@@ -352,17 +437,17 @@ export default function DestinationOptionsForm(
                       onConflictDoNothing: false,
                       truncateBeforeInsert: false,
                     }),
-                    initTableSchema: newVal,
+                    onConflictDoNothing: newVal,
                   },
                 });
               }}
-              title="Init Table Schema"
-              description="Creates table(s) and their constraints. The database schema must already exist. "
+              title="On Conflict Do Nothing"
+              description="If there is a conflict when inserting data do not insert"
             /> */}
           </div>
           <div>
-            {/* <SwitchCard
-              isChecked={value.mssql?.onConflictDoNothing ?? false}
+            <SwitchCard
+              isChecked={value.mssql?.onConflictUpdate ?? false}
               onCheckedChange={(newVal) => {
                 setValue({
                   ...value,
@@ -371,14 +456,19 @@ export default function DestinationOptionsForm(
                       initTableSchema: false,
                       onConflictDoNothing: false,
                       truncateBeforeInsert: false,
+                      skipForeignKeyViolations: false,
                     }),
-                    onConflictDoNothing: newVal,
+
+                    onConflictDoNothing: newVal
+                      ? false
+                      : (value.mssql?.onConflictDoNothing ?? false),
+                    onConflictUpdate: newVal,
                   },
                 });
               }}
-              title="On Conflict Do Nothing"
-              description="If there is a conflict when inserting data do not insert"
-            /> */}
+              title="On Conflict Update"
+              description="If there is a conflict when inserting data update the existing row's non-key columns"
+            />
           </div>
           <div>
             <SwitchCard
@@ -0,0 +1,237 @@
+import FormErrorMessage from '@/components/FormErrorMessage';
+import { FormDescription } from '@/components/ui/form';
+import { Input, InputProps } from '@/components/ui/input';
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from '@/components/ui/select';
+import { cn } from '@/libs/utils';
+import { getGcpStorageClassString } from '@/util/util';
+import { GcpCloudStorageDestinationOptionsFormValues } from '@/yup-validations/jobs';
+import { GcpCloudStorageDestinationConnectionOptions_StorageClass } from '@neosync/sdk';
+import { ReactElement, ReactNode } from 'react';
+import { FieldErrors } from 'react-hook-form';
+import BucketFileFormatSelect from './BucketFileFormatSelect';
+
+interface Props {
+  value: GcpCloudStorageDestinationOptionsFormValues;
+  setValue(newVal: GcpCloudStorageDestinationOptionsFormValues): void;
+  errors?: FieldErrors<GcpCloudStorageDestinationOptionsFormValues>;
+}
+
+const SUPPORTED_STORAGECLASSES = [
+  GcpCloudStorageDestinationConnectionOptions_StorageClass.STANDARD,
+  GcpCloudStorageDestinationConnectionOptions_StorageClass.NEARLINE,
+  GcpCloudStorageDestinationConnectionOptions_StorageClass.COLDLINE,
+  GcpCloudStorageDestinationConnectionOptions_StorageClass.ARCHIVE,
+];
+
+export default function GcpCloudStorageDestinationOptionsForm(
+  props: Props
+): ReactElement {
+  const { value, setValue, errors } = props;
+
+  return (
+    <div className="flex flex-col gap-6 rounded-lg border p-4">
+      <div className="flex flex-col gap-2">
+        <Header />
+      </div>
+      <div className="flex flex-col gap-2">
+        <FormItemContainer>
+          <FormHeader
+            title="Storage Class"
+            description="The storage class that objects will be written with. Defaults to the bucket's default storage class."
+            isErrored={!!errors?.storageClass}
+          />
+          <FormInputContainer>
+            <Select
+              onValueChange={(newVal) => {
+                setValue({ ...value, storageClass: parseInt(newVal, 10) });
+              }}
+              value={value.storageClass ? value.storageClass.toString() : ''}
+            >
+              <SelectTrigger>
+                <SelectValue placeholder="bucket default" />
+              </SelectTrigger>
+              <SelectContent>
+                {SUPPORTED_STORAGECLASSES.map((sc) => (
+                  <SelectItem
+                    key={sc}
+                    className="cursor-pointer"
+                    value={sc.toString()}
+                  >
+                    {getGcpStorageClassString(sc)}
+                  </SelectItem>
+                ))}
+              </SelectContent>
+            </Select>
+            <FormErrorMessage message={errors?.storageClass?.message} />
+          </FormInputContainer>
+        </FormItemContainer>
+        <FormItemContainer>
+          <FormHeader
+            title="File Format"
+            description="The format that records will be written to GCS in. Parquet files use a schema derived from the source table's columns."
+            isErrored={!!errors?.format}
+          />
+          <FormInputContainer>
+            <BucketFileFormatSelect
+              value={value.format}
+              onChange={(newVal) => setValue({ ...value, format: newVal })}
+            />
+            <FormErrorMessage message={errors?.format?.message} />
+          </FormInputContainer>
+        </FormItemContainer>
+        <FormItemContainer>
+          <FormHeader
+            title="Path Template"
+            description="Optional template for the path of each object, relative to the connection's path prefix. Ex: exports/{{table}}/{{date}}/{{file_index}}.{{extension}}. Data written with a custom template can not be used as a job source."
+            isErrored={!!errors?.pathTemplate}
+          />
+          <FormInputContainer>
+            <Input
+              value={value.pathTemplate ?? ''}
+              placeholder="workflows/{{job_run_id}}/activities/{{schema}}.{{table}}/data/{{file_index}}.{{extension}}"
+              onChange={(e) =>
+                setValue({ ...value, pathTemplate: e.target.value })
+              }
+            />
+            <FormErrorMessage message={errors?.pathTemplate?.message} />
+          </FormInputContainer>
+        </FormItemContainer>
+        <FormItemContainer>
+          <FormHeader
+            title="Max in Flight"
+            description="The max number of batched records to have in flight at a given time. Increase to improve throughput."
+            isErrored={!!errors?.maxInFlight}
+          />
+          <div>
+            <NumberedInput
+              value={value.maxInFlight ?? 64}
+              onChange={(val) => setValue({ ...value, maxInFlight: val })}
+            />
+            <FormErrorMessage message={errors?.maxInFlight?.message} />
+          </div>
+        </FormItemContainer>
+        <FormItemContainer>
+          <FormHeader
+            title="Upload Timeout"
+            description="The maximum period to wait on an upload before abandoning and re-attempting. Ex: 5s, 1m"
+            isErrored={!!errors?.timeout}
+          />
+          <FormInputContainer>
+            <Input
+              value={value.timeout ?? ''}
+              onChange={(e) => setValue({ ...value, timeout: e.target.value })}
+            />
+            <FormErrorMessage message={errors?.timeout?.message} />
+          </FormInputContainer>
+        </FormItemContainer>
+        <FormItemContainer>
+          <FormHeader
+            title="Batch Count"
+            description="The max allowed per batch before flushing to GCS. 0 to disable count-based batching."
+            isErrored={!!errors?.batch?.count}
+          />
+          <FormInputContainer>
+            <NumberedInput
+              value={value.batch?.count ?? 100}
+              onChange={(val) =>
+                setValue({ ...value, batch: { ...value.batch, count: val } })
+              }
+            />
+            <FormErrorMessage message={errors?.batch?.count?.message} />
+          </FormInputContainer>
+        </FormItemContainer>
+        <FormItemContainer>
+          <FormHeader
+            title="Batch Period"
+            description="Time in which an incomplete batch should be flushed regardless of the count. Ex: 1s, 1m, 500ms. Empty to disable time-based batching (not recommended)."
+            isErrored={!!errors?.batch?.period}
+          />
+          <FormInputContainer>
+            <Input
+              value={value.batch?.period ?? '5s'}
+              onChange={(e) =>
+                setValue({
+                  ...value,
+                  batch: { ...value.batch, period: e.target.value },
+                })
+              }
+            />
+            <FormErrorMessage message={errors?.batch?.period?.message} />
+          </FormInputContainer>
+        </FormItemContainer>
+      </div>
+    </div>
+  );
+}
+
+function Header(): ReactElement {
+  return (
+    <div>
+      <h2 className="text-md font-semibold tracking-tight">
+        GCS Configuration
+      </h2>
+      <p className="text-sm tracking-tight">
+        Change how Neosync handles sending records to the bucket.
+      </p>
+    </div>
+  );
+}
+
+interface FormItemContainerProps {
+  children: ReactNode;
+}
+function FormItemContainer(props: FormItemContainerProps): ReactElement {
+  const { children } = props;
+  return <div className="flex flex-col gap-2">{children}</div>;
+}
+
+interface FormInputContainerProps {
+  children: ReactNode;
+}
+function FormInputContainer(props: FormInputContainerProps): ReactElement {
+  const { children } = props;
+  return <div className="flex flex-col gap-1">{children}</div>;
+}
+
+interface FormHeaderProps {
+  title: string;
+  description: string;
+  containerClassName?: string;
+  isErrored?: boolean;
+}
+function FormHeader(props: FormHeaderProps): ReactElement {
+  const { title, description, containerClassName, isErrored } = props;
+  return (
+    <div className={containerClassName}>
+      <label className={cn(isErrored ? 'text-red-500' : undefined)}>
+        {title}
+      </label>
+      <FormDescription>{description}</FormDescription>
+    </div>
+  );
+}
+
+interface NumberedInputProps extends Omit<InputProps, 'onChange'> {
+  onChange(value: number): void;
+}
+
+function NumberedInput(props: NumberedInputProps): ReactElement {
+  const { onChange, ...rest } = props;
+
+  return (
+    <Input
+      {...rest}
+      type="number"
+      onChange={(event) => {
+        const numVal = event.target.valueAsNumber;
+        if (!isNaN(numVal)) onChange(numVal);
+      }}
+    />
+  );
+}
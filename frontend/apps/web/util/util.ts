@@ -2,12 +2,16 @@ import { TransformerHandler } from '@/components/jobs/SchemaTable/transformer-ha
 import { Transformer } from '@/shared/transformers';
 import { JobMappingTransformerForm } from '@/yup-validations/jobs';
 import {
+  AccountRole,
   AwsS3DestinationConnectionOptions_StorageClass,
+  BucketFileFormat,
+  GcpCloudStorageDestinationConnectionOptions_StorageClass,
   GenerateEmailType,
   InvalidEmailAction,
   SupportedJobType,
   SystemTransformer,
   TransformerDataType,
+  TransformerLocale,
   TransformerSource,
   UserDefinedTransformer,
 } from '@neosync/sdk';
@@ -131,6 +135,18 @@ export function getGenerateEmailTypeString(
   return value ? value.toLowerCase() : 'unknown';
 }
 
+export function getTransformerLocaleString(locale: TransformerLocale): string {
+  const value = TransformerLocale[locale];
+  if (!value) {
+    return 'unknown';
+  }
+  // EN_US => en_US
+  const [language, region] = value.split('_');
+  return region
+    ? `${language.toLowerCase()}_${region}`
+    : language.toLowerCase();
+}
+
 export function getInvalidEmailActionString(
   invalidEmailAction: InvalidEmailAction
 ): string {
@@ -145,6 +161,43 @@ export function getStorageClassString(
   return value ? value.toLowerCase() : 'unknown';
 }
 
+export function getGcpStorageClassString(
+  storageclass: GcpCloudStorageDestinationConnectionOptions_StorageClass
+): string {
+  const value =
+    GcpCloudStorageDestinationConnectionOptions_StorageClass[storageclass];
+  return value ? value.toLowerCase() : 'unknown';
+}
+
+export function getAccountRoleString(role: AccountRole): string {
+  switch (role) {
+    case AccountRole.ADMIN:
+      return 'Admin';
+    case AccountRole.JOB_DEVELOPER:
+      return 'Job Developer';
+    case AccountRole.JOB_EXECUTOR:
+      return 'Job Executor';
+    case AccountRole.VIEWER:
+      return 'Viewer';
+    default:
+      return 'unknown';
+  }
+}
+
+export function getBucketFileFormatString(format: BucketFileFormat): string {
+  switch (format) {
+    case BucketFileFormat.UNSPECIFIED:
+    case BucketFileFormat.JSON_LINES:
+      return 'JSON Lines (gzip)';
+    case BucketFileFormat.PARQUET:
+      return 'Parquet';
+    case BucketFileFormat.CSV:
+      return 'CSV (gzip)';
+    default:
+      return 'unknown';
+  }
+}
+
 // Given the currently selected transformer mapping config, return the relevant Transformer
 export function getTransformerFromField(
   handler: TransformerHandler,
@@ -22,6 +22,8 @@ export const ApiKeyFormValues = Yup.object({
     ),
   expiresAtSelect: Yup.string().oneOf(['7', '30', '60', '90', 'custom']),
   expiresAt: Yup.date().required('The Expiration is a required field.'),
+  scopes: Yup.array().of(Yup.string().required()).required().default([]),
+  jobIds: Yup.array().of(Yup.string().required()).required().default([]),
 });
 
 export type ApiKeyFormValues = Yup.InferType<typeof ApiKeyFormValues>;
@@ -1,7 +1,16 @@
+import { AccountRole } from '@neosync/sdk';
 import * as Yup from 'yup';
 
 export const InviteMembersForm = Yup.object({
   email: Yup.string().email().required('The Email is required'),
+  role: Yup.number()
+    .oneOf([
+      AccountRole.ADMIN,
+      AccountRole.JOB_DEVELOPER,
+      AccountRole.JOB_EXECUTOR,
+      AccountRole.VIEWER,
+    ])
+    .required('The Role is required'),
 });
 
 export type InviteMembersForm = Yup.InferType<typeof InviteMembersForm>;
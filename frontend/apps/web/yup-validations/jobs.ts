@@ -169,6 +169,7 @@ const PostgresDbDestinationOptionsFormValues = Yup.object({
   truncateCascade: Yup.boolean().optional().default(false),
   initTableSchema: Yup.boolean().optional().default(false),
   onConflictDoNothing: Yup.boolean().optional().default(false),
+  onConflictUpdate: Yup.boolean().optional(),
   skipForeignKeyViolations: Yup.boolean().optional().default(false),
 });
 
@@ -176,6 +177,7 @@ const MysqlDbDestinationOptionsFormValues = Yup.object({
   truncateBeforeInsert: Yup.boolean().optional().default(false),
   initTableSchema: Yup.boolean().optional().default(false),
   onConflictDoNothing: Yup.boolean().optional().default(false),
+  onConflictUpdate: Yup.boolean().optional(),
   skipForeignKeyViolations: Yup.boolean().optional().default(false),
 });
 
@@ -183,6 +185,7 @@ const MssqlDbDestinationOptionsFormValues = Yup.object({
   truncateBeforeInsert: Yup.boolean().optional().default(false),
   initTableSchema: Yup.boolean().optional().default(false),
   onConflictDoNothing: Yup.boolean().optional().default(false),
+  onConflictUpdate: Yup.boolean().optional(),
   skipForeignKeyViolations: Yup.boolean().optional().default(false),
 });
 
@@ -193,8 +196,28 @@ const BatchFormValues = Yup.object({
     .test('duration', 'Must be a valid duration', getDurationValidateFn()),
 });
 
+// Mirrors the validation done by the backend, which is the source of truth
+function isValidBucketPathTemplate(value?: string): boolean {
+  if (!value) {
+    return true;
+  }
+  return (
+    /\{\{\s*table\s*\}\}/.test(value) &&
+    /\{\{\s*(file_index|timestamp)\s*\}\}/.test(value)
+  );
+}
+
+const BucketPathTemplateFormValue = Yup.string()
+  .optional()
+  .test(
+    'pathTemplate',
+    'Must include {{table}} and either {{file_index}} or {{timestamp}}',
+    isValidBucketPathTemplate
+  );
+
 export const AwsS3DestinationOptionsFormValues = Yup.object({
   storageClass: Yup.number().optional(),
+  format: Yup.number().optional(),
   maxInFlight: Yup.number()
     .min(1, 'Must be greater than or equal to 1')
     .max(200, 'Must be less than or equal to 200') // arbitrarily setting this value here.
@@ -203,17 +226,36 @@ export const AwsS3DestinationOptionsFormValues = Yup.object({
     .optional()
     .test('duration', 'Must be a valid duration', getDurationValidateFn()),
   batch: BatchFormValues.optional(),
+  pathTemplate: BucketPathTemplateFormValue,
 });
 export type AwsS3DestinationOptionsFormValues = Yup.InferType<
   typeof AwsS3DestinationOptionsFormValues
 >;
 
+export const GcpCloudStorageDestinationOptionsFormValues = Yup.object({
+  storageClass: Yup.number().optional(),
+  format: Yup.number().optional(),
+  maxInFlight: Yup.number()
+    .min(1, 'Must be greater than or equal to 1')
+    .max(200, 'Must be less than or equal to 200')
+    .optional(),
+  timeout: Yup.string()
+    .optional()
+    .test('duration', 'Must be a valid duration', getDurationValidateFn()),
+  batch: BatchFormValues.optional(),
+  pathTemplate: BucketPathTemplateFormValue,
+});
+export type GcpCloudStorageDestinationOptionsFormValues = Yup.InferType<
+  typeof GcpCloudStorageDestinationOptionsFormValues
+>;
+
 export const DestinationOptionsFormValues = Yup.object({
   postgres: PostgresDbDestinationOptionsFormValues.optional(),
   mysql: MysqlDbDestinationOptionsFormValues.optional(),
   dynamodb: DynamoDbDestinationOptionsFormValues.optional(),
   mssql: MssqlDbDestinationOptionsFormValues.optional(),
   awss3: AwsS3DestinationOptionsFormValues.optional(),
+  gcpcloudstorage: GcpCloudStorageDestinationOptionsFormValues.optional(),
 }).required('Destination Options are required.');
 // Object that holds connection specific destination options for a job
 export type DestinationOptionsFormValues = Yup.InferType<
@@ -11,6 +11,7 @@ import {
 import { PartialMessage } from '@bufbuild/protobuf';
 import {
   ConnectError,
+  FpeAlphabet,
   IsTransformerNameAvailableRequest,
   IsTransformerNameAvailableResponse,
   TransformerConfig,
@@ -70,6 +71,7 @@ const generateInternationalPhoneNumberConfig = Yup.object({
       return minValidator(value);
     }
   ),
+  locale: Yup.number().optional(),
 });
 
 const generateFloat64Config = Yup.object().shape({
@@ -168,6 +170,7 @@ const generateStringPhoneNumberConfig = Yup.object().shape({
       return minValidator(value);
     }
   ),
+  locale: Yup.number().optional(),
 });
 
 const generateStringConfig = Yup.object().shape({
@@ -217,6 +220,7 @@ const transformFirstNameConfig = Yup.object().shape({
   preserveLength: Yup.boolean()
     .default(false)
     .required('This field is required.'),
+  locale: Yup.number().optional(),
 });
 
 const transformFloat64Config = Yup.object().shape({
@@ -244,6 +248,7 @@ const transformFullNameConfig = Yup.object().shape({
   preserveLength: Yup.boolean()
     .default(false)
     .required('This field is required.'),
+  locale: Yup.number().optional(),
 });
 
 const transformInt64PhoneNumberConfig = Yup.object().shape({
@@ -281,6 +286,7 @@ const transformLastNameConfig = Yup.object().shape({
   preserveLength: Yup.boolean()
     .default(false)
     .required('This field is required.'),
+  locale: Yup.number().optional(),
 });
 
 const transformStringPhoneNumberConfig = Yup.object().shape({
@@ -303,6 +309,7 @@ const generateStateConfig = Yup.object().shape({
   generateFullName: Yup.boolean()
     .default(false)
     .required('This field is required'),
+  locale: Yup.number().optional(),
 });
 
 const JavascriptConfig = Yup.object().shape({
@@ -382,6 +389,37 @@ const transformCharacterScrambleConfig = Yup.object().shape({
   userProvidedRegex: Yup.string().optional(),
 });
 
+const transformFpeConfig = Yup.object().shape({
+  alphabet: Yup.number().optional(),
+  customAlphabet: Yup.string()
+    .optional()
+    .test(
+      'custom-alphabet',
+      'The custom alphabet must contain at least 2 unique characters.',
+      (value, context) => {
+        if (context.parent.alphabet !== FpeAlphabet.CUSTOM) {
+          return true;
+        }
+        return new Set(Array.from(value ?? '')).size >= 2;
+      }
+    )
+    .test(
+      'unique-characters',
+      'The custom alphabet must not contain duplicate characters.',
+      (value) => {
+        const chars = Array.from(value ?? '');
+        return new Set(chars).size === chars.length;
+      }
+    ),
+  tweak: Yup.string().optional(),
+  passThroughShortValues: Yup.boolean().default(false),
+});
+
+// Used by the name and address generators whose only configuration is the locale
+const LOCALE_TRANSFORMER_CONFIG = Yup.object({
+  locale: Yup.number().optional(),
+});
+
 // This is intended to be empty and is used for any transformer config that has no configuration options
 const EMPTY_TRANSFORMER_VALUE_CONFIG = Yup.object({});
 
@@ -389,23 +427,24 @@ const EMPTY_TRANSFORMER_VALUE_CONFIG = Yup.object({});
 // Ideally we can more explicitly type this in the future based on the Transformer types we get from @neosync/sdk
 const TRANSFORMER_SCHEMA_CONFIGS = {
   generateBoolConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
-  generateCityConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
   generateDefaultConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
-  generateFirstNameConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
-  generateFullAddressConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
-  generateFullNameConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
   generateInt64PhoneNumberConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
-  generateLastNameConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
   generateSha256hashConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
   generateSsnConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
-  generateStreetAddressConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
   generateUnixtimestampConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
   generateUsernameConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
   generateUtctimestampConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
-  generateZipcodeConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
   nullconfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
   passthroughConfig: EMPTY_TRANSFORMER_VALUE_CONFIG,
 
+  generateCityConfig: LOCALE_TRANSFORMER_CONFIG,
+  generateFirstNameConfig: LOCALE_TRANSFORMER_CONFIG,
+  generateFullAddressConfig: LOCALE_TRANSFORMER_CONFIG,
+  generateFullNameConfig: LOCALE_TRANSFORMER_CONFIG,
+  generateLastNameConfig: LOCALE_TRANSFORMER_CONFIG,
+  generateStreetAddressConfig: LOCALE_TRANSFORMER_CONFIG,
+  generateZipcodeConfig: LOCALE_TRANSFORMER_CONFIG,
+
   generateEmailConfig: generateEmailConfig,
   transformEmailConfig: transformEmailConfig,
   generateCardNumberConfig: generateCardNumberConfig,
@@ -432,6 +471,7 @@ const TRANSFORMER_SCHEMA_CONFIGS = {
   generateJavascriptConfig: JavascriptConfig,
   generateStateConfig: generateStateConfig,
   generateCountryConfig: generateCountryConfig,
+  transformFpeConfig: transformFpeConfig,
 
   transformPiiTextConfig: transformPiiTextConfig,
 } as const;
@@ -7,10 +7,13 @@ import {
 
 import { AnonymizationService } from './mgmt/v1alpha1/anonymization_connect.js';
 import { ApiKeyService } from './mgmt/v1alpha1/api_key_connect.js';
+import { AuditService } from './mgmt/v1alpha1/audit_connect.js';
 import { ConnectionService } from './mgmt/v1alpha1/connection_connect.js';
 import { ConnectionDataService } from './mgmt/v1alpha1/connection_data_connect.js';
 import { JobService } from './mgmt/v1alpha1/job_connect.js';
 import { MetricsService } from './mgmt/v1alpha1/metrics_connect.js';
+import { NotificationService } from './mgmt/v1alpha1/notification_connect.js';
+import { PipelineService } from './mgmt/v1alpha1/pipeline_connect.js';
 import { TransformersService } from './mgmt/v1alpha1/transformer_connect.js';
 import { UserAccountService } from './mgmt/v1alpha1/user_account_connect.js';
 
@@ -26,6 +29,9 @@ export interface NeosyncV1alpha1Client {
   connectiondata: PromiseClient<typeof ConnectionDataService>;
   metrics: PromiseClient<typeof MetricsService>;
   anonymization: PromiseClient<typeof AnonymizationService>;
+  audit: PromiseClient<typeof AuditService>;
+  notifications: PromiseClient<typeof NotificationService>;
+  pipelines: PromiseClient<typeof PipelineService>;
 }
 
 /**
@@ -92,6 +98,9 @@ export function getNeosyncV1alpha1Client(
     connectiondata: createPromiseClient(ConnectionDataService, transport),
     metrics: createPromiseClient(MetricsService, transport),
     anonymization: createPromiseClient(AnonymizationService, transport),
+    audit: createPromiseClient(AuditService, transport),
+    notifications: createPromiseClient(NotificationService, transport),
+    pipelines: createPromiseClient(PipelineService, transport),
   };
 }
 
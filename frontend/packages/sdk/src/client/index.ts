@@ -1,17 +1,23 @@
 export { ApiKeyService } from './mgmt/v1alpha1/api_key_connect.js';
+export { AuditService } from './mgmt/v1alpha1/audit_connect.js';
 export { ConnectionService } from './mgmt/v1alpha1/connection_connect.js';
 export { ConnectionDataService } from './mgmt/v1alpha1/connection_data_connect.js';
 export { JobService } from './mgmt/v1alpha1/job_connect.js';
 export { MetricsService } from './mgmt/v1alpha1/metrics_connect.js';
+export { NotificationService } from './mgmt/v1alpha1/notification_connect.js';
+export { PipelineService } from './mgmt/v1alpha1/pipeline_connect.js';
 export { TransformersService } from './mgmt/v1alpha1/transformer_connect.js';
 export { UserAccountService } from './mgmt/v1alpha1/user_account_connect.js';
 
 export * from './mgmt/v1alpha1/anonymization_pb.js';
 export * from './mgmt/v1alpha1/api_key_pb.js';
+export * from './mgmt/v1alpha1/audit_pb.js';
 export * from './mgmt/v1alpha1/connection_data_pb.js';
 export * from './mgmt/v1alpha1/connection_pb.js';
 export * from './mgmt/v1alpha1/job_pb.js';
 export * from './mgmt/v1alpha1/metrics_pb.js';
+export * from './mgmt/v1alpha1/notification_pb.js';
+export * from './mgmt/v1alpha1/pipeline_pb.js';
 export * from './mgmt/v1alpha1/transformer_pb.js';
 export * from './mgmt/v1alpha1/user_account_pb.js';
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { MethodKind } from "@bufbuild/protobuf";
-import { AnonymizeManyRequest, AnonymizeManyResponse, AnonymizeSingleRequest, AnonymizeSingleResponse } from "./anonymization_pb.js";
+import { AnonymizeManyRequest, AnonymizeManyResponse, AnonymizeSingleRequest, AnonymizeSingleResponse, DecryptFpeRequest, DecryptFpeResponse } from "./anonymization_pb.js";
 
 /**
  * Anonymizes many JSON strings by applying specified transformation mappings.
@@ -37,3 +37,19 @@ export const anonymizeSingle = {
     typeName: "mgmt.v1alpha1.AnonymizationService"
   }
 } as const;
+
+/**
+ * Decrypts values that were encrypted by the format-preserving encryption transformer.
+ *
+ * @generated from rpc mgmt.v1alpha1.AnonymizationService.DecryptFpe
+ */
+export const decryptFpe = {
+  localName: "decryptFpe",
+  name: "DecryptFpe",
+  kind: MethodKind.Unary,
+  I: DecryptFpeRequest,
+  O: DecryptFpeResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.AnonymizationService"
+  }
+} as const;
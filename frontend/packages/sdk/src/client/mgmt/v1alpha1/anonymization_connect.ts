@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AnonymizeManyRequest, AnonymizeManyResponse, AnonymizeSingleRequest, AnonymizeSingleResponse } from "./anonymization_pb.js";
+import { AnonymizeManyRequest, AnonymizeManyResponse, AnonymizeSingleRequest, AnonymizeSingleResponse, DecryptFpeRequest, DecryptFpeResponse } from "./anonymization_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -34,6 +34,17 @@ export const AnonymizationService = {
       O: AnonymizeSingleResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Decrypts values that were encrypted by the format-preserving encryption transformer.
+     *
+     * @generated from rpc mgmt.v1alpha1.AnonymizationService.DecryptFpe
+     */
+    decryptFpe: {
+      name: "DecryptFpe",
+      I: DecryptFpeRequest,
+      O: DecryptFpeResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
-import { TransformerConfig } from "./transformer_pb.js";
+import { TransformerConfig, TransformFpe } from "./transformer_pb.js";
 
 /**
  * @generated from message mgmt.v1alpha1.AnonymizeManyRequest
@@ -377,3 +377,97 @@ export class AnonymizeSingleResponse extends Message<AnonymizeSingleResponse> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.DecryptFpeRequest
+ */
+export class DecryptFpeRequest extends Message<DecryptFpeRequest> {
+  /**
+   * The unique account identifier whose key was used to encrypt the values
+   *
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * The format-preserving encryption config that the values were encrypted with
+   *
+   * @generated from field: mgmt.v1alpha1.TransformFpe config = 2;
+   */
+  config?: TransformFpe;
+
+  /**
+   * The encrypted values (up to 100 items)
+   *
+   * @generated from field: repeated string values = 3;
+   */
+  values: string[] = [];
+
+  constructor(data?: PartialMessage<DecryptFpeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DecryptFpeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "config", kind: "message", T: TransformFpe },
+    { no: 3, name: "values", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DecryptFpeRequest {
+    return new DecryptFpeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DecryptFpeRequest {
+    return new DecryptFpeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DecryptFpeRequest {
+    return new DecryptFpeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DecryptFpeRequest | PlainMessage<DecryptFpeRequest> | undefined, b: DecryptFpeRequest | PlainMessage<DecryptFpeRequest> | undefined): boolean {
+    return proto3.util.equals(DecryptFpeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DecryptFpeResponse
+ */
+export class DecryptFpeResponse extends Message<DecryptFpeResponse> {
+  /**
+   * The decrypted values, in the same order as the request
+   *
+   * @generated from field: repeated string values = 1;
+   */
+  values: string[] = [];
+
+  constructor(data?: PartialMessage<DecryptFpeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DecryptFpeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "values", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DecryptFpeResponse {
+    return new DecryptFpeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DecryptFpeResponse {
+    return new DecryptFpeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DecryptFpeResponse {
+    return new DecryptFpeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DecryptFpeResponse | PlainMessage<DecryptFpeResponse> | undefined, b: DecryptFpeResponse | PlainMessage<DecryptFpeResponse> | undefined): boolean {
+    return proto3.util.equals(DecryptFpeResponse, a, b);
+  }
+}
+
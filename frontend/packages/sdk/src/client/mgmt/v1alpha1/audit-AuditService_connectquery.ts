@@ -0,0 +1,24 @@
+// @generated by protoc-gen-connect-query v1.4.2 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/audit.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { ListAuditEventsRequest, ListAuditEventsResponse } from "./audit_pb.js";
+
+/**
+ * Returns the audit events for an account
+ *
+ * @generated from rpc mgmt.v1alpha1.AuditService.ListAuditEvents
+ */
+export const listAuditEvents = {
+  localName: "listAuditEvents",
+  name: "ListAuditEvents",
+  kind: MethodKind.Unary,
+  I: ListAuditEventsRequest,
+  O: ListAuditEventsResponse,
+      idempotency: MethodIdempotency.NoSideEffects,
+  service: {
+    typeName: "mgmt.v1alpha1.AuditService"
+  }
+} as const;
@@ -0,0 +1,31 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/audit.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ListAuditEventsRequest, ListAuditEventsResponse } from "./audit_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * Service that exposes the audit log of mutations made through the management API
+ *
+ * @generated from service mgmt.v1alpha1.AuditService
+ */
+export const AuditService = {
+  typeName: "mgmt.v1alpha1.AuditService",
+  methods: {
+    /**
+     * Returns the audit events for an account
+     *
+     * @generated from rpc mgmt.v1alpha1.AuditService.ListAuditEvents
+     */
+    listAuditEvents: {
+      name: "ListAuditEvents",
+      I: ListAuditEventsRequest,
+      O: ListAuditEventsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+  }
+} as const;
+
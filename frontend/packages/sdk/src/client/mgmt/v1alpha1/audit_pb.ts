@@ -0,0 +1,290 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/audit.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum mgmt.v1alpha1.AuditActorType
+ */
+export enum AuditActorType {
+  /**
+   * The actor could not be determined
+   *
+   * @generated from enum value: AUDIT_ACTOR_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The request was made by a user with a session token
+   *
+   * @generated from enum value: AUDIT_ACTOR_TYPE_USER = 1;
+   */
+  USER = 1,
+
+  /**
+   * The request was made with an API Key
+   *
+   * @generated from enum value: AUDIT_ACTOR_TYPE_API_KEY = 2;
+   */
+  API_KEY = 2,
+
+  /**
+   * The request was made with authentication disabled
+   *
+   * @generated from enum value: AUDIT_ACTOR_TYPE_ANONYMOUS = 3;
+   */
+  ANONYMOUS = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AuditActorType)
+proto3.util.setEnumType(AuditActorType, "mgmt.v1alpha1.AuditActorType", [
+  { no: 0, name: "AUDIT_ACTOR_TYPE_UNSPECIFIED" },
+  { no: 1, name: "AUDIT_ACTOR_TYPE_USER" },
+  { no: 2, name: "AUDIT_ACTOR_TYPE_API_KEY" },
+  { no: 3, name: "AUDIT_ACTOR_TYPE_ANONYMOUS" },
+]);
+
+/**
+ * @generated from message mgmt.v1alpha1.AuditEvent
+ */
+export class AuditEvent extends Message<AuditEvent> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * The account the mutation was made against, if known
+   *
+   * @generated from field: optional string account_id = 2;
+   */
+  accountId?: string;
+
+  /**
+   * What type of actor made the request
+   *
+   * @generated from field: mgmt.v1alpha1.AuditActorType actor_type = 3;
+   */
+  actorType = AuditActorType.UNSPECIFIED;
+
+  /**
+   * The user that made the request. For account API Keys, this is the user that the key belongs to
+   *
+   * @generated from field: optional string actor_user_id = 4;
+   */
+  actorUserId?: string;
+
+  /**
+   * The API Key that was used to make the request
+   *
+   * @generated from field: optional string actor_api_key_id = 5;
+   */
+  actorApiKeyId?: string;
+
+  /**
+   * The fully qualified RPC that was called. Ex: /mgmt.v1alpha1.JobService/CreateJob
+   *
+   * @generated from field: string procedure = 6;
+   */
+  procedure = "";
+
+  /**
+   * The identifiers of the resources that were referenced or created by the request
+   *
+   * @generated from field: repeated string resource_ids = 7;
+   */
+  resourceIds: string[] = [];
+
+  /**
+   * The JSON encoded request with any sensitive fields redacted
+   *
+   * @generated from field: string request_json = 8;
+   */
+  requestJson = "";
+
+  /**
+   * The result of the request. "ok" if it succeeded, otherwise the connect error code
+   *
+   * @generated from field: string status_code = 9;
+   */
+  statusCode = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * For update procedures that succeeded, the JSON encoded fields of the resource that were changed, keyed by field path.
+   * Each entry holds the value of the field before and after the update, with any sensitive values redacted.
+   *
+   * @generated from field: optional string changes_json = 11;
+   */
+  changesJson?: string;
+
+  constructor(data?: PartialMessage<AuditEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.AuditEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "actor_type", kind: "enum", T: proto3.getEnumType(AuditActorType) },
+    { no: 4, name: "actor_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "actor_api_key_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "procedure", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "resource_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "request_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "status_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "created_at", kind: "message", T: Timestamp },
+    { no: 11, name: "changes_json", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent {
+    return new AuditEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean {
+    return proto3.util.equals(AuditEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ListAuditEventsRequest
+ */
+export class ListAuditEventsRequest extends Message<ListAuditEventsRequest> {
+  /**
+   * The account to list audit events for
+   *
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Only return events made by this user or API Key
+   *
+   * @generated from field: optional string actor_id = 2;
+   */
+  actorId?: string;
+
+  /**
+   * Only return events for this procedure. Ex: /mgmt.v1alpha1.JobService/CreateJob
+   *
+   * @generated from field: optional string procedure = 3;
+   */
+  procedure?: string;
+
+  /**
+   * Only return events that reference this resource
+   *
+   * @generated from field: optional string resource_id = 4;
+   */
+  resourceId?: string;
+
+  /**
+   * Only return events that occurred at or after this time
+   *
+   * @generated from field: optional google.protobuf.Timestamp start_time = 5;
+   */
+  startTime?: Timestamp;
+
+  /**
+   * Only return events that occurred before this time
+   *
+   * @generated from field: optional google.protobuf.Timestamp end_time = 6;
+   */
+  endTime?: Timestamp;
+
+  /**
+   * The maximum number of events to return. Defaults to 100
+   *
+   * @generated from field: optional uint32 limit = 7;
+   */
+  limit?: number;
+
+  constructor(data?: PartialMessage<ListAuditEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ListAuditEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "actor_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "procedure", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "resource_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 5, name: "start_time", kind: "message", T: Timestamp, opt: true },
+    { no: 6, name: "end_time", kind: "message", T: Timestamp, opt: true },
+    { no: 7, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined, b: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ListAuditEventsResponse
+ */
+export class ListAuditEventsResponse extends Message<ListAuditEventsResponse> {
+  /**
+   * The events, ordered by most recent first
+   *
+   * @generated from field: repeated mgmt.v1alpha1.AuditEvent events = 1;
+   */
+  events: AuditEvent[] = [];
+
+  constructor(data?: PartialMessage<ListAuditEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ListAuditEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "events", kind: "message", T: AuditEvent, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined, b: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsResponse, a, b);
+  }
+}
+
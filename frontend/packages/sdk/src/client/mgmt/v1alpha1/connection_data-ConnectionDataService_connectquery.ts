@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { MethodKind } from "@bufbuild/protobuf";
-import { GetAiGeneratedDataRequest, GetAiGeneratedDataResponse, GetConnectionForeignConstraintsRequest, GetConnectionForeignConstraintsResponse, GetConnectionInitStatementsRequest, GetConnectionInitStatementsResponse, GetConnectionPrimaryConstraintsRequest, GetConnectionPrimaryConstraintsResponse, GetConnectionSchemaMapRequest, GetConnectionSchemaMapResponse, GetConnectionSchemaMapsRequest, GetConnectionSchemaMapsResponse, GetConnectionSchemaRequest, GetConnectionSchemaResponse, GetConnectionTableConstraintsRequest, GetConnectionTableConstraintsResponse, GetConnectionUniqueConstraintsRequest, GetConnectionUniqueConstraintsResponse, GetTableRowCountRequest, GetTableRowCountResponse } from "./connection_data_pb.js";
+import { GetAiGeneratedDataRequest, GetAiGeneratedDataResponse, GetConnectionForeignConstraintsRequest, GetConnectionForeignConstraintsResponse, GetConnectionInitStatementsRequest, GetConnectionInitStatementsResponse, GetConnectionPrimaryConstraintsRequest, GetConnectionPrimaryConstraintsResponse, GetConnectionSchemaMapRequest, GetConnectionSchemaMapResponse, GetConnectionSchemaMapsRequest, GetConnectionSchemaMapsResponse, GetConnectionSchemaRequest, GetConnectionSchemaResponse, GetConnectionTableConstraintsRequest, GetConnectionTableConstraintsResponse, GetConnectionUniqueConstraintsRequest, GetConnectionUniqueConstraintsResponse, GetTableRowCountRequest, GetTableRowCountResponse, ScanConnectionForPiiRequest, ScanConnectionForPiiResponse } from "./connection_data_pb.js";
 
 /**
  * Returns the schema for a specific connection. Used mostly for SQL-based connections
@@ -168,3 +168,20 @@ export const getTableRowCount = {
     typeName: "mgmt.v1alpha1.ConnectionDataService"
   }
 } as const;
+
+/**
+ * Samples the rows of each table in the connection and classifies which columns contain PII.
+ * Returns a suggested transformer for every column where PII was detected.
+ *
+ * @generated from rpc mgmt.v1alpha1.ConnectionDataService.ScanConnectionForPii
+ */
+export const scanConnectionForPii = {
+  localName: "scanConnectionForPii",
+  name: "ScanConnectionForPii",
+  kind: MethodKind.Unary,
+  I: ScanConnectionForPiiRequest,
+  O: ScanConnectionForPiiResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.ConnectionDataService"
+  }
+} as const;
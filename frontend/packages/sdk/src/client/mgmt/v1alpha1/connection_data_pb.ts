@@ -5,6 +5,171 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64, Struct } from "@bufbuild/protobuf";
+import { TransformerConfig } from "./transformer_pb.js";
+
+/**
+ * @generated from enum mgmt.v1alpha1.PiiCategory
+ */
+export enum PiiCategory {
+  /**
+   * @generated from enum value: PII_CATEGORY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * No PII was detected in the column
+   *
+   * @generated from enum value: PII_CATEGORY_NONE = 1;
+   */
+  NONE = 1,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_EMAIL = 2;
+   */
+  EMAIL = 2,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_PHONE_NUMBER = 3;
+   */
+  PHONE_NUMBER = 3,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_SSN = 4;
+   */
+  SSN = 4,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_CARD_NUMBER = 5;
+   */
+  CARD_NUMBER = 5,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_IP_ADDRESS = 6;
+   */
+  IP_ADDRESS = 6,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_FIRST_NAME = 7;
+   */
+  FIRST_NAME = 7,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_LAST_NAME = 8;
+   */
+  LAST_NAME = 8,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_FULL_NAME = 9;
+   */
+  FULL_NAME = 9,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_USERNAME = 10;
+   */
+  USERNAME = 10,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_PASSWORD = 11;
+   */
+  PASSWORD = 11,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_STREET_ADDRESS = 12;
+   */
+  STREET_ADDRESS = 12,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_CITY = 13;
+   */
+  CITY = 13,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_STATE = 14;
+   */
+  STATE = 14,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_ZIPCODE = 15;
+   */
+  ZIPCODE = 15,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_COUNTRY = 16;
+   */
+  COUNTRY = 16,
+
+  /**
+   * @generated from enum value: PII_CATEGORY_GENDER = 17;
+   */
+  GENDER = 17,
+
+  /**
+   * Free text that contains PII, as detected by the Presidio analyzer
+   *
+   * @generated from enum value: PII_CATEGORY_FREE_TEXT = 18;
+   */
+  FREE_TEXT = 18,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PiiCategory)
+proto3.util.setEnumType(PiiCategory, "mgmt.v1alpha1.PiiCategory", [
+  { no: 0, name: "PII_CATEGORY_UNSPECIFIED" },
+  { no: 1, name: "PII_CATEGORY_NONE" },
+  { no: 2, name: "PII_CATEGORY_EMAIL" },
+  { no: 3, name: "PII_CATEGORY_PHONE_NUMBER" },
+  { no: 4, name: "PII_CATEGORY_SSN" },
+  { no: 5, name: "PII_CATEGORY_CARD_NUMBER" },
+  { no: 6, name: "PII_CATEGORY_IP_ADDRESS" },
+  { no: 7, name: "PII_CATEGORY_FIRST_NAME" },
+  { no: 8, name: "PII_CATEGORY_LAST_NAME" },
+  { no: 9, name: "PII_CATEGORY_FULL_NAME" },
+  { no: 10, name: "PII_CATEGORY_USERNAME" },
+  { no: 11, name: "PII_CATEGORY_PASSWORD" },
+  { no: 12, name: "PII_CATEGORY_STREET_ADDRESS" },
+  { no: 13, name: "PII_CATEGORY_CITY" },
+  { no: 14, name: "PII_CATEGORY_STATE" },
+  { no: 15, name: "PII_CATEGORY_ZIPCODE" },
+  { no: 16, name: "PII_CATEGORY_COUNTRY" },
+  { no: 17, name: "PII_CATEGORY_GENDER" },
+  { no: 18, name: "PII_CATEGORY_FREE_TEXT" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.PiiDetector
+ */
+export enum PiiDetector {
+  /**
+   * @generated from enum value: PII_DETECTOR_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The name of the column matched a known PII column name
+   *
+   * @generated from enum value: PII_DETECTOR_COLUMN_NAME = 1;
+   */
+  COLUMN_NAME = 1,
+
+  /**
+   * The sampled values matched the pattern of a PII value
+   *
+   * @generated from enum value: PII_DETECTOR_VALUE_PATTERN = 2;
+   */
+  VALUE_PATTERN = 2,
+
+  /**
+   * The Presidio analyzer recognized PII in the sampled values
+   *
+   * @generated from enum value: PII_DETECTOR_PRESIDIO = 3;
+   */
+  PRESIDIO = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PiiDetector)
+proto3.util.setEnumType(PiiDetector, "mgmt.v1alpha1.PiiDetector", [
+  { no: 0, name: "PII_DETECTOR_UNSPECIFIED" },
+  { no: 1, name: "PII_DETECTOR_COLUMN_NAME" },
+  { no: 2, name: "PII_DETECTOR_VALUE_PATTERN" },
+  { no: 3, name: "PII_DETECTOR_PRESIDIO" },
+]);
 
 /**
  * @generated from message mgmt.v1alpha1.PostgresStreamConfig
@@ -199,6 +364,60 @@ export class GcpCloudStorageStreamConfig extends Message<GcpCloudStorageStreamCo
   }
 }
 
+/**
+ * Reads the files that a job run exported to a local directory
+ *
+ * @generated from message mgmt.v1alpha1.LocalDirectoryStreamConfig
+ */
+export class LocalDirectoryStreamConfig extends Message<LocalDirectoryStreamConfig> {
+  /**
+   * @generated from oneof mgmt.v1alpha1.LocalDirectoryStreamConfig.id
+   */
+  id: {
+    /**
+     * Reads the most recent run of the job that is found in the directory
+     *
+     * @generated from field: string job_id = 1;
+     */
+    value: string;
+    case: "jobId";
+  } | {
+    /**
+     * @generated from field: string job_run_id = 2;
+     */
+    value: string;
+    case: "jobRunId";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<LocalDirectoryStreamConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.LocalDirectoryStreamConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LocalDirectoryStreamConfig {
+    return new LocalDirectoryStreamConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LocalDirectoryStreamConfig {
+    return new LocalDirectoryStreamConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LocalDirectoryStreamConfig {
+    return new LocalDirectoryStreamConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LocalDirectoryStreamConfig | PlainMessage<LocalDirectoryStreamConfig> | undefined, b: LocalDirectoryStreamConfig | PlainMessage<LocalDirectoryStreamConfig> | undefined): boolean {
+    return proto3.util.equals(LocalDirectoryStreamConfig, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.ConnectionStreamConfig
  */
@@ -236,6 +455,12 @@ export class ConnectionStreamConfig extends Message<ConnectionStreamConfig> {
      */
     value: AwsDynamoDBStreamConfig;
     case: "dynamodbConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.LocalDirectoryStreamConfig local_dir_config = 6;
+     */
+    value: LocalDirectoryStreamConfig;
+    case: "localDirConfig";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<ConnectionStreamConfig>) {
@@ -251,6 +476,7 @@ export class ConnectionStreamConfig extends Message<ConnectionStreamConfig> {
     { no: 3, name: "mysql_config", kind: "message", T: MysqlStreamConfig, oneof: "config" },
     { no: 4, name: "gcp_cloudstorage_config", kind: "message", T: GcpCloudStorageStreamConfig, oneof: "config" },
     { no: 5, name: "dynamodb_config", kind: "message", T: AwsDynamoDBStreamConfig, oneof: "config" },
+    { no: 6, name: "local_dir_config", kind: "message", T: LocalDirectoryStreamConfig, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectionStreamConfig {
@@ -459,6 +685,37 @@ export class MssqlSchemaConfig extends Message<MssqlSchemaConfig> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.SqliteSchemaConfig
+ */
+export class SqliteSchemaConfig extends Message<SqliteSchemaConfig> {
+  constructor(data?: PartialMessage<SqliteSchemaConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteSchemaConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteSchemaConfig {
+    return new SqliteSchemaConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteSchemaConfig {
+    return new SqliteSchemaConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteSchemaConfig {
+    return new SqliteSchemaConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteSchemaConfig | PlainMessage<SqliteSchemaConfig> | undefined, b: SqliteSchemaConfig | PlainMessage<SqliteSchemaConfig> | undefined): boolean {
+    return proto3.util.equals(SqliteSchemaConfig, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.AwsS3SchemaConfig
  */
@@ -621,6 +878,60 @@ export class GcpCloudStorageSchemaConfig extends Message<GcpCloudStorageSchemaCo
   }
 }
 
+/**
+ * Reads the schema of the files that a job run exported to a local directory
+ *
+ * @generated from message mgmt.v1alpha1.LocalDirectorySchemaConfig
+ */
+export class LocalDirectorySchemaConfig extends Message<LocalDirectorySchemaConfig> {
+  /**
+   * @generated from oneof mgmt.v1alpha1.LocalDirectorySchemaConfig.id
+   */
+  id: {
+    /**
+     * Reads the most recent run of the job that is found in the directory
+     *
+     * @generated from field: string job_id = 1;
+     */
+    value: string;
+    case: "jobId";
+  } | {
+    /**
+     * @generated from field: string job_run_id = 2;
+     */
+    value: string;
+    case: "jobRunId";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<LocalDirectorySchemaConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.LocalDirectorySchemaConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LocalDirectorySchemaConfig {
+    return new LocalDirectorySchemaConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LocalDirectorySchemaConfig {
+    return new LocalDirectorySchemaConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LocalDirectorySchemaConfig {
+    return new LocalDirectorySchemaConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LocalDirectorySchemaConfig | PlainMessage<LocalDirectorySchemaConfig> | undefined, b: LocalDirectorySchemaConfig | PlainMessage<LocalDirectorySchemaConfig> | undefined): boolean {
+    return proto3.util.equals(LocalDirectorySchemaConfig, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.ConnectionSchemaConfig
  */
@@ -670,6 +981,18 @@ export class ConnectionSchemaConfig extends Message<ConnectionSchemaConfig> {
      */
     value: MssqlSchemaConfig;
     case: "mssqlConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.SqliteSchemaConfig sqlite_config = 8;
+     */
+    value: SqliteSchemaConfig;
+    case: "sqliteConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.LocalDirectorySchemaConfig local_dir_config = 9;
+     */
+    value: LocalDirectorySchemaConfig;
+    case: "localDirConfig";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<ConnectionSchemaConfig>) {
@@ -687,6 +1010,8 @@ export class ConnectionSchemaConfig extends Message<ConnectionSchemaConfig> {
     { no: 5, name: "gcp_cloudstorage_config", kind: "message", T: GcpCloudStorageSchemaConfig, oneof: "config" },
     { no: 6, name: "dynamodb_config", kind: "message", T: DynamoDBSchemaConfig, oneof: "config" },
     { no: 7, name: "mssql_config", kind: "message", T: MssqlSchemaConfig, oneof: "config" },
+    { no: 8, name: "sqlite_config", kind: "message", T: SqliteSchemaConfig, oneof: "config" },
+    { no: 9, name: "local_dir_config", kind: "message", T: LocalDirectorySchemaConfig, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectionSchemaConfig {
@@ -2071,3 +2396,212 @@ export class GetTableRowCountResponse extends Message<GetTableRowCountResponse>
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.ScanConnectionForPiiRequest
+ */
+export class ScanConnectionForPiiRequest extends Message<ScanConnectionForPiiRequest> {
+  /**
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  /**
+   * Optionally limits the scan to the tables of these schemas. Mongo databases are treated as schemas.
+   * Every table is scanned when no schemas or tables are provided.
+   *
+   * @generated from field: repeated string schemas = 2;
+   */
+  schemas: string[] = [];
+
+  /**
+   * Optionally limits the scan to these tables. Mongo collections are treated as tables.
+   *
+   * @generated from field: repeated mgmt.v1alpha1.DatabaseTable tables = 3;
+   */
+  tables: DatabaseTable[] = [];
+
+  /**
+   * The maximum number of rows that are sampled from each table. Defaults to 100
+   *
+   * @generated from field: optional int64 sample_size = 4;
+   */
+  sampleSize?: bigint;
+
+  /**
+   * Also runs the sampled values through the Presidio analyzer.
+   * NeosyncCloud/Enterprise only
+   *
+   * @generated from field: bool use_presidio = 5;
+   */
+  usePresidio = false;
+
+  constructor(data?: PartialMessage<ScanConnectionForPiiRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ScanConnectionForPiiRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "schemas", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "tables", kind: "message", T: DatabaseTable, repeated: true },
+    { no: 4, name: "sample_size", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 5, name: "use_presidio", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScanConnectionForPiiRequest {
+    return new ScanConnectionForPiiRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScanConnectionForPiiRequest {
+    return new ScanConnectionForPiiRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScanConnectionForPiiRequest {
+    return new ScanConnectionForPiiRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScanConnectionForPiiRequest | PlainMessage<ScanConnectionForPiiRequest> | undefined, b: ScanConnectionForPiiRequest | PlainMessage<ScanConnectionForPiiRequest> | undefined): boolean {
+    return proto3.util.equals(ScanConnectionForPiiRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ScanConnectionForPiiResponse
+ */
+export class ScanConnectionForPiiResponse extends Message<ScanConnectionForPiiResponse> {
+  /**
+   * The classification of every scanned column, ordered by schema, table and column
+   *
+   * @generated from field: repeated mgmt.v1alpha1.ColumnPiiClassification columns = 1;
+   */
+  columns: ColumnPiiClassification[] = [];
+
+  constructor(data?: PartialMessage<ScanConnectionForPiiResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ScanConnectionForPiiResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "columns", kind: "message", T: ColumnPiiClassification, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScanConnectionForPiiResponse {
+    return new ScanConnectionForPiiResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ScanConnectionForPiiResponse {
+    return new ScanConnectionForPiiResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ScanConnectionForPiiResponse {
+    return new ScanConnectionForPiiResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ScanConnectionForPiiResponse | PlainMessage<ScanConnectionForPiiResponse> | undefined, b: ScanConnectionForPiiResponse | PlainMessage<ScanConnectionForPiiResponse> | undefined): boolean {
+    return proto3.util.equals(ScanConnectionForPiiResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.ColumnPiiClassification
+ */
+export class ColumnPiiClassification extends Message<ColumnPiiClassification> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * The column name. Nested fields of Mongo documents are returned in dot notation
+   *
+   * @generated from field: string column = 3;
+   */
+  column = "";
+
+  /**
+   * The data type of the column. Empty for Mongo collections
+   *
+   * @generated from field: string data_type = 4;
+   */
+  dataType = "";
+
+  /**
+   * The kind of PII that was detected in the column
+   *
+   * @generated from field: mgmt.v1alpha1.PiiCategory category = 5;
+   */
+  category = PiiCategory.UNSPECIFIED;
+
+  /**
+   * The confidence that the column contains the category of PII, between 0 and 1. This is 0 when no PII was detected
+   *
+   * @generated from field: double confidence = 6;
+   */
+  confidence = 0;
+
+  /**
+   * The detectors that found evidence of the category
+   *
+   * @generated from field: repeated mgmt.v1alpha1.PiiDetector detectors = 7;
+   */
+  detectors: PiiDetector[] = [];
+
+  /**
+   * The number of non-null values that were sampled from the column
+   *
+   * @generated from field: int64 sampled_values = 8;
+   */
+  sampledValues = protoInt64.zero;
+
+  /**
+   * A transformer that is suggested for the column. Unset when no PII was detected
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerConfig suggested_transformer = 9;
+   */
+  suggestedTransformer?: TransformerConfig;
+
+  constructor(data?: PartialMessage<ColumnPiiClassification>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.ColumnPiiClassification";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "data_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "category", kind: "enum", T: proto3.getEnumType(PiiCategory) },
+    { no: 6, name: "confidence", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "detectors", kind: "enum", T: proto3.getEnumType(PiiDetector), repeated: true },
+    { no: 8, name: "sampled_values", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "suggested_transformer", kind: "message", T: TransformerConfig, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ColumnPiiClassification {
+    return new ColumnPiiClassification().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ColumnPiiClassification {
+    return new ColumnPiiClassification().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ColumnPiiClassification {
+    return new ColumnPiiClassification().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ColumnPiiClassification | PlainMessage<ColumnPiiClassification> | undefined, b: ColumnPiiClassification | PlainMessage<ColumnPiiClassification> | undefined): boolean {
+    return proto3.util.equals(ColumnPiiClassification, a, b);
+  }
+}
+
@@ -6,6 +6,38 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
 
+/**
+ * @generated from enum mgmt.v1alpha1.KafkaSaslMechanism
+ */
+export enum KafkaSaslMechanism {
+  /**
+   * @generated from enum value: KAFKA_SASL_MECHANISM_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: KAFKA_SASL_MECHANISM_PLAIN = 1;
+   */
+  PLAIN = 1,
+
+  /**
+   * @generated from enum value: KAFKA_SASL_MECHANISM_SCRAM_SHA_256 = 2;
+   */
+  SCRAM_SHA_256 = 2,
+
+  /**
+   * @generated from enum value: KAFKA_SASL_MECHANISM_SCRAM_SHA_512 = 3;
+   */
+  SCRAM_SHA_512 = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(KafkaSaslMechanism)
+proto3.util.setEnumType(KafkaSaslMechanism, "mgmt.v1alpha1.KafkaSaslMechanism", [
+  { no: 0, name: "KAFKA_SASL_MECHANISM_UNSPECIFIED" },
+  { no: 1, name: "KAFKA_SASL_MECHANISM_PLAIN" },
+  { no: 2, name: "KAFKA_SASL_MECHANISM_SCRAM_SHA_256" },
+  { no: 3, name: "KAFKA_SASL_MECHANISM_SCRAM_SHA_512" },
+]);
+
 /**
  * @generated from message mgmt.v1alpha1.GetConnectionsRequest
  */
@@ -405,6 +437,13 @@ export class CheckConnectionConfigRequest extends Message<CheckConnectionConfigR
    */
   connectionConfig?: ConnectionConfig;
 
+  /**
+   * The account the connection is being checked for
+   *
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
   constructor(data?: PartialMessage<CheckConnectionConfigRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -414,6 +453,7 @@ export class CheckConnectionConfigRequest extends Message<CheckConnectionConfigR
   static readonly typeName = "mgmt.v1alpha1.CheckConnectionConfigRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "connection_config", kind: "message", T: ConnectionConfig },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckConnectionConfigRequest {
@@ -819,6 +859,22 @@ export class ConnectionConfig extends Message<ConnectionConfig> {
      */
     value: MssqlConnectionConfig;
     case: "mssqlConfig";
+  } | {
+    /**
+     * Configures a Kafka Connection
+     *
+     * @generated from field: mgmt.v1alpha1.KafkaConnectionConfig kafka_config = 10;
+     */
+    value: KafkaConnectionConfig;
+    case: "kafkaConfig";
+  } | {
+    /**
+     * Configures a SQLite Connection
+     *
+     * @generated from field: mgmt.v1alpha1.SqliteConnectionConfig sqlite_config = 11;
+     */
+    value: SqliteConnectionConfig;
+    case: "sqliteConfig";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<ConnectionConfig>) {
@@ -838,6 +894,8 @@ export class ConnectionConfig extends Message<ConnectionConfig> {
     { no: 7, name: "gcp_cloudstorage_config", kind: "message", T: GcpCloudStorageConnectionConfig, oneof: "config" },
     { no: 8, name: "dynamodb_config", kind: "message", T: DynamoDBConnectionConfig, oneof: "config" },
     { no: 9, name: "mssql_config", kind: "message", T: MssqlConnectionConfig, oneof: "config" },
+    { no: 10, name: "kafka_config", kind: "message", T: KafkaConnectionConfig, oneof: "config" },
+    { no: 11, name: "sqlite_config", kind: "message", T: SqliteConnectionConfig, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectionConfig {
@@ -857,6 +915,124 @@ export class ConnectionConfig extends Message<ConnectionConfig> {
   }
 }
 
+/**
+ * Configuration for a Kafka Connection
+ *
+ * @generated from message mgmt.v1alpha1.KafkaConnectionConfig
+ */
+export class KafkaConnectionConfig extends Message<KafkaConnectionConfig> {
+  /**
+   * The list of seed brokers in host:port format
+   *
+   * @generated from field: repeated string brokers = 1;
+   */
+  brokers: string[] = [];
+
+  /**
+   * Optionally authenticate with the brokers using SASL
+   *
+   * @generated from field: mgmt.v1alpha1.KafkaSaslConfig sasl = 2;
+   */
+  sasl?: KafkaSaslConfig;
+
+  /**
+   * Connect to the brokers over TLS
+   *
+   * @generated from field: bool enable_tls = 3;
+   */
+  enableTls = false;
+
+  /**
+   * Provide client-side TLS Certificates. Only used if TLS is enabled.
+   *
+   * @generated from field: mgmt.v1alpha1.ClientTlsConfig client_tls = 4;
+   */
+  clientTls?: ClientTlsConfig;
+
+  constructor(data?: PartialMessage<KafkaConnectionConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.KafkaConnectionConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "brokers", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "sasl", kind: "message", T: KafkaSaslConfig },
+    { no: 3, name: "enable_tls", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "client_tls", kind: "message", T: ClientTlsConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaConnectionConfig {
+    return new KafkaConnectionConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KafkaConnectionConfig {
+    return new KafkaConnectionConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KafkaConnectionConfig {
+    return new KafkaConnectionConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KafkaConnectionConfig | PlainMessage<KafkaConnectionConfig> | undefined, b: KafkaConnectionConfig | PlainMessage<KafkaConnectionConfig> | undefined): boolean {
+    return proto3.util.equals(KafkaConnectionConfig, a, b);
+  }
+}
+
+/**
+ * SASL authentication for a Kafka Connection
+ *
+ * @generated from message mgmt.v1alpha1.KafkaSaslConfig
+ */
+export class KafkaSaslConfig extends Message<KafkaSaslConfig> {
+  /**
+   * Defaults to PLAIN
+   *
+   * @generated from field: mgmt.v1alpha1.KafkaSaslMechanism mechanism = 1;
+   */
+  mechanism = KafkaSaslMechanism.UNSPECIFIED;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string password = 3;
+   */
+  password = "";
+
+  constructor(data?: PartialMessage<KafkaSaslConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.KafkaSaslConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "mechanism", kind: "enum", T: proto3.getEnumType(KafkaSaslMechanism) },
+    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaSaslConfig {
+    return new KafkaSaslConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KafkaSaslConfig {
+    return new KafkaSaslConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KafkaSaslConfig {
+    return new KafkaSaslConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KafkaSaslConfig | PlainMessage<KafkaSaslConfig> | undefined, b: KafkaSaslConfig | PlainMessage<KafkaSaslConfig> | undefined): boolean {
+    return proto3.util.equals(KafkaSaslConfig, a, b);
+  }
+}
+
 /**
  * Configuration for a Mssql Connection
  *
@@ -920,6 +1096,54 @@ export class MssqlConnectionConfig extends Message<MssqlConnectionConfig> {
   }
 }
 
+/**
+ * Configuration for a SQLite Connection
+ *
+ * @generated from message mgmt.v1alpha1.SqliteConnectionConfig
+ */
+export class SqliteConnectionConfig extends Message<SqliteConnectionConfig> {
+  /**
+   * The path to the SQLite database file. The file must be accessible to both the API and the worker.
+   * The database is created if it does not exist.
+   *
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.SqlConnectionOptions connection_options = 2;
+   */
+  connectionOptions?: SqlConnectionOptions;
+
+  constructor(data?: PartialMessage<SqliteConnectionConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteConnectionConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "connection_options", kind: "message", T: SqlConnectionOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteConnectionConfig {
+    return new SqliteConnectionConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteConnectionConfig {
+    return new SqliteConnectionConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteConnectionConfig {
+    return new SqliteConnectionConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteConnectionConfig | PlainMessage<SqliteConnectionConfig> | undefined, b: SqliteConnectionConfig | PlainMessage<SqliteConnectionConfig> | undefined): boolean {
+    return proto3.util.equals(SqliteConnectionConfig, a, b);
+  }
+}
+
 /**
  * Configuration for a DynaomDB Connection
  *
@@ -7,6 +7,120 @@ import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialM
 import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 import { TransformerConfig, TransformerSource } from "./transformer_pb.js";
 
+/**
+ * @generated from enum mgmt.v1alpha1.KafkaMessageFormat
+ */
+export enum KafkaMessageFormat {
+  /**
+   * Defaults to JSON
+   *
+   * @generated from enum value: KAFKA_MESSAGE_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Message values are JSON objects
+   *
+   * @generated from enum value: KAFKA_MESSAGE_FORMAT_JSON = 1;
+   */
+  JSON = 1,
+
+  /**
+   * Message values are Avro binary encoded records
+   *
+   * @generated from enum value: KAFKA_MESSAGE_FORMAT_AVRO = 2;
+   */
+  AVRO = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(KafkaMessageFormat)
+proto3.util.setEnumType(KafkaMessageFormat, "mgmt.v1alpha1.KafkaMessageFormat", [
+  { no: 0, name: "KAFKA_MESSAGE_FORMAT_UNSPECIFIED" },
+  { no: 1, name: "KAFKA_MESSAGE_FORMAT_JSON" },
+  { no: 2, name: "KAFKA_MESSAGE_FORMAT_AVRO" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.MongoDBWriteMode
+ */
+export enum MongoDBWriteMode {
+  /**
+   * Defaults to upsert
+   *
+   * @generated from enum value: MONGO_DB_WRITE_MODE_UNSPECIFIED = 0;
+   */
+  MONGO_DB_WRITE_MODE_UNSPECIFIED = 0,
+
+  /**
+   * Sets the fields of the document with a matching _id, inserting the document if it does not exist
+   *
+   * @generated from enum value: MONGO_DB_WRITE_MODE_UPSERT = 1;
+   */
+  MONGO_DB_WRITE_MODE_UPSERT = 1,
+
+  /**
+   * Replaces the document with a matching _id, inserting the document if it does not exist
+   *
+   * @generated from enum value: MONGO_DB_WRITE_MODE_REPLACE = 2;
+   */
+  MONGO_DB_WRITE_MODE_REPLACE = 2,
+
+  /**
+   * Inserts every document, failing on documents whose _id already exists
+   *
+   * @generated from enum value: MONGO_DB_WRITE_MODE_INSERT = 3;
+   */
+  MONGO_DB_WRITE_MODE_INSERT = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MongoDBWriteMode)
+proto3.util.setEnumType(MongoDBWriteMode, "mgmt.v1alpha1.MongoDBWriteMode", [
+  { no: 0, name: "MONGO_DB_WRITE_MODE_UNSPECIFIED" },
+  { no: 1, name: "MONGO_DB_WRITE_MODE_UPSERT" },
+  { no: 2, name: "MONGO_DB_WRITE_MODE_REPLACE" },
+  { no: 3, name: "MONGO_DB_WRITE_MODE_INSERT" },
+]);
+
+/**
+ * The file format that records are written to in a bucket destination
+ *
+ * @generated from enum mgmt.v1alpha1.BucketFileFormat
+ */
+export enum BucketFileFormat {
+  /**
+   * Defaults to gzipped JSON lines
+   *
+   * @generated from enum value: BUCKET_FILE_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Gzipped JSON lines, one record per line
+   *
+   * @generated from enum value: BUCKET_FILE_FORMAT_JSON_LINES = 1;
+   */
+  JSON_LINES = 1,
+
+  /**
+   * Parquet with a schema derived from the source table's columns
+   *
+   * @generated from enum value: BUCKET_FILE_FORMAT_PARQUET = 2;
+   */
+  PARQUET = 2,
+
+  /**
+   * Gzipped CSV with a header row
+   *
+   * @generated from enum value: BUCKET_FILE_FORMAT_CSV = 3;
+   */
+  CSV = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(BucketFileFormat)
+proto3.util.setEnumType(BucketFileFormat, "mgmt.v1alpha1.BucketFileFormat", [
+  { no: 0, name: "BUCKET_FILE_FORMAT_UNSPECIFIED" },
+  { no: 1, name: "BUCKET_FILE_FORMAT_JSON_LINES" },
+  { no: 2, name: "BUCKET_FILE_FORMAT_PARQUET" },
+  { no: 3, name: "BUCKET_FILE_FORMAT_CSV" },
+]);
+
 /**
  * @generated from enum mgmt.v1alpha1.JobStatus
  */
@@ -395,6 +509,24 @@ export class JobSourceOptions extends Message<JobSourceOptions> {
      */
     value: MssqlSourceConnectionOptions;
     case: "mssql";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.KafkaSourceConnectionOptions kafka = 9;
+     */
+    value: KafkaSourceConnectionOptions;
+    case: "kafka";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.SqliteSourceConnectionOptions sqlite = 10;
+     */
+    value: SqliteSourceConnectionOptions;
+    case: "sqlite";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.LocalDirectorySourceConnectionOptions local_directory = 11;
+     */
+    value: LocalDirectorySourceConnectionOptions;
+    case: "localDirectory";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<JobSourceOptions>) {
@@ -413,6 +545,9 @@ export class JobSourceOptions extends Message<JobSourceOptions> {
     { no: 6, name: "mongodb", kind: "message", T: MongoDBSourceConnectionOptions, oneof: "config" },
     { no: 7, name: "dynamodb", kind: "message", T: DynamoDBSourceConnectionOptions, oneof: "config" },
     { no: 8, name: "mssql", kind: "message", T: MssqlSourceConnectionOptions, oneof: "config" },
+    { no: 9, name: "kafka", kind: "message", T: KafkaSourceConnectionOptions, oneof: "config" },
+    { no: 10, name: "sqlite", kind: "message", T: SqliteSourceConnectionOptions, oneof: "config" },
+    { no: 11, name: "local_directory", kind: "message", T: LocalDirectorySourceConnectionOptions, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobSourceOptions {
@@ -711,6 +846,14 @@ export class GenerateSourceOptions extends Message<GenerateSourceOptions> {
    */
   fkSourceConnectionId?: string;
 
+  /**
+   * When set, the tables are profiled from the fk source connection and generated rows are sampled
+   * from the stored profile so that they follow the distributions of the source data.
+   *
+   * @generated from field: optional mgmt.v1alpha1.GenerateSourceProfileOptions profile = 4;
+   */
+  profile?: GenerateSourceProfileOptions;
+
   constructor(data?: PartialMessage<GenerateSourceOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -721,6 +864,7 @@ export class GenerateSourceOptions extends Message<GenerateSourceOptions> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "schemas", kind: "message", T: GenerateSourceSchemaOption, repeated: true },
     { no: 3, name: "fk_source_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "profile", kind: "message", T: GenerateSourceProfileOptions, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateSourceOptions {
@@ -740,6 +884,69 @@ export class GenerateSourceOptions extends Message<GenerateSourceOptions> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.GenerateSourceProfileOptions
+ */
+export class GenerateSourceProfileOptions extends Message<GenerateSourceProfileOptions> {
+  /**
+   * Profiles the source on every run instead of reusing the profile stored by a previous run
+   *
+   * @generated from field: bool refresh = 1;
+   */
+  refresh = false;
+
+  /**
+   * The maximum number of rows that are sampled from each table when profiling. Defaults to 10000
+   *
+   * @generated from field: optional int64 sample_size = 2;
+   */
+  sampleSize?: bigint;
+
+  /**
+   * The number of buckets used for the histograms of numeric and time columns. Defaults to 10
+   *
+   * @generated from field: optional uint32 histogram_buckets = 3;
+   */
+  histogramBuckets?: number;
+
+  /**
+   * The number of most frequent values that are kept for categorical columns. Defaults to 20
+   *
+   * @generated from field: optional uint32 top_k = 4;
+   */
+  topK?: number;
+
+  constructor(data?: PartialMessage<GenerateSourceProfileOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GenerateSourceProfileOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "refresh", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "sample_size", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 3, name: "histogram_buckets", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 4, name: "top_k", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateSourceProfileOptions {
+    return new GenerateSourceProfileOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateSourceProfileOptions {
+    return new GenerateSourceProfileOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateSourceProfileOptions {
+    return new GenerateSourceProfileOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateSourceProfileOptions | PlainMessage<GenerateSourceProfileOptions> | undefined, b: GenerateSourceProfileOptions | PlainMessage<GenerateSourceProfileOptions> | undefined): boolean {
+    return proto3.util.equals(GenerateSourceProfileOptions, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.GenerateSourceSchemaOption
  */
@@ -839,6 +1046,14 @@ export class MongoDBSourceConnectionOptions extends Message<MongoDBSourceConnect
    */
   connectionId = "";
 
+  /**
+   * List of collection option configurations for any mapped source collection.
+   * Collections that are not listed here are read in full.
+   *
+   * @generated from field: repeated mgmt.v1alpha1.MongoDBSourceCollectionOption collections = 2;
+   */
+  collections: MongoDBSourceCollectionOption[] = [];
+
   constructor(data?: PartialMessage<MongoDBSourceConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -848,6 +1063,7 @@ export class MongoDBSourceConnectionOptions extends Message<MongoDBSourceConnect
   static readonly typeName = "mgmt.v1alpha1.MongoDBSourceConnectionOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "collections", kind: "message", T: MongoDBSourceCollectionOption, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBSourceConnectionOptions {
@@ -867,6 +1083,136 @@ export class MongoDBSourceConnectionOptions extends Message<MongoDBSourceConnect
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.MongoDBSourceCollectionOption
+ */
+export class MongoDBSourceCollectionOption extends Message<MongoDBSourceCollectionOption> {
+  /**
+   * The database that the collection lives in
+   *
+   * @generated from field: string database = 1;
+   */
+  database = "";
+
+  /**
+   * The collection that this configuration will be applied to
+   *
+   * @generated from field: string collection = 2;
+   */
+  collection = "";
+
+  /**
+   * An optional filter document, in MongoDB Extended JSON, that is used for subsetting the collection.
+   * Example: {"region": "us-east-1", "createdAt": {"$gte": {"$date": "2024-01-01T00:00:00Z"}}}
+   *
+   * @generated from field: optional string filter = 3;
+   */
+  filter?: string;
+
+  /**
+   * An optional maximum number of documents to read from the collection
+   *
+   * @generated from field: optional uint32 limit = 4;
+   */
+  limit?: number;
+
+  /**
+   * Fields in this collection that reference documents in other collections of the same database.
+   * If this collection is subset, the referenced collections are subset to the documents that are referenced by it.
+   *
+   * @generated from field: repeated mgmt.v1alpha1.MongoDBCollectionReference references = 5;
+   */
+  references: MongoDBCollectionReference[] = [];
+
+  constructor(data?: PartialMessage<MongoDBSourceCollectionOption>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MongoDBSourceCollectionOption";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "database", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "collection", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "filter", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 5, name: "references", kind: "message", T: MongoDBCollectionReference, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBSourceCollectionOption {
+    return new MongoDBSourceCollectionOption().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MongoDBSourceCollectionOption {
+    return new MongoDBSourceCollectionOption().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MongoDBSourceCollectionOption {
+    return new MongoDBSourceCollectionOption().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MongoDBSourceCollectionOption | PlainMessage<MongoDBSourceCollectionOption> | undefined, b: MongoDBSourceCollectionOption | PlainMessage<MongoDBSourceCollectionOption> | undefined): boolean {
+    return proto3.util.equals(MongoDBSourceCollectionOption, a, b);
+  }
+}
+
+/**
+ * A virtual reference from a field of one collection to a field of another collection
+ *
+ * @generated from message mgmt.v1alpha1.MongoDBCollectionReference
+ */
+export class MongoDBCollectionReference extends Message<MongoDBCollectionReference> {
+  /**
+   * The field in the referencing collection that holds the referenced value. Dot notation may be used for nested fields.
+   *
+   * @generated from field: string field = 1;
+   */
+  field = "";
+
+  /**
+   * The collection, in the same database, that is referenced
+   *
+   * @generated from field: string referenced_collection = 2;
+   */
+  referencedCollection = "";
+
+  /**
+   * The field in the referenced collection that is matched. Defaults to _id
+   *
+   * @generated from field: optional string referenced_field = 3;
+   */
+  referencedField?: string;
+
+  constructor(data?: PartialMessage<MongoDBCollectionReference>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MongoDBCollectionReference";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "referenced_collection", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "referenced_field", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBCollectionReference {
+    return new MongoDBCollectionReference().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MongoDBCollectionReference {
+    return new MongoDBCollectionReference().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MongoDBCollectionReference {
+    return new MongoDBCollectionReference().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MongoDBCollectionReference | PlainMessage<MongoDBCollectionReference> | undefined, b: MongoDBCollectionReference | PlainMessage<MongoDBCollectionReference> | undefined): boolean {
+    return proto3.util.equals(MongoDBCollectionReference, a, b);
+  }
+}
+
 /**
  * DynamoDB connection options for a job source
  *
@@ -936,131 +1282,316 @@ export class DynamoDBSourceConnectionOptions extends Message<DynamoDBSourceConne
 }
 
 /**
- * @generated from message mgmt.v1alpha1.DynamoDBSourceUnmappedTransformConfig
+ * Kafka connection options for a job source
+ *
+ * @generated from message mgmt.v1alpha1.KafkaSourceConnectionOptions
  */
-export class DynamoDBSourceUnmappedTransformConfig extends Message<DynamoDBSourceUnmappedTransformConfig> {
+export class KafkaSourceConnectionOptions extends Message<KafkaSourceConnectionOptions> {
   /**
-   * Byte
+   * The unique connection id to a kafka connection configuration
    *
-   * @generated from field: mgmt.v1alpha1.JobMappingTransformer b = 1;
+   * @generated from field: string connection_id = 1;
    */
-  b?: JobMappingTransformer;
+  connectionId = "";
 
   /**
-   * Boolean
+   * The topic that messages are consumed from. Job mappings must use this topic as their table.
    *
-   * @generated from field: mgmt.v1alpha1.JobMappingTransformer boolean = 2;
+   * @generated from field: string topic = 2;
    */
-  boolean?: JobMappingTransformer;
+  topic = "";
 
   /**
-   * Number
+   * The range of messages that will be consumed from each partition of the topic.
+   * Defaults to every message that is available when the run begins.
    *
-   * @generated from field: mgmt.v1alpha1.JobMappingTransformer n = 4;
+   * @generated from oneof mgmt.v1alpha1.KafkaSourceConnectionOptions.range
    */
-  n?: JobMappingTransformer;
+  range: {
+    /**
+     * @generated from field: mgmt.v1alpha1.KafkaOffsetRange offsets = 3;
+     */
+    value: KafkaOffsetRange;
+    case: "offsets";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.KafkaTimestampRange timestamps = 4;
+     */
+    value: KafkaTimestampRange;
+    case: "timestamps";
+  } | { case: undefined; value?: undefined } = { case: undefined };
 
   /**
-   * String
+   * The format of the message values. Defaults to JSON
    *
-   * @generated from field: mgmt.v1alpha1.JobMappingTransformer s = 6;
+   * @generated from field: mgmt.v1alpha1.KafkaMessageFormat format = 5;
    */
-  s?: JobMappingTransformer;
+  format = KafkaMessageFormat.UNSPECIFIED;
 
-  constructor(data?: PartialMessage<DynamoDBSourceUnmappedTransformConfig>) {
+  /**
+   * The Avro schema (in JSON) of the message values. Required when the format is Avro.
+   *
+   * @generated from field: optional string avro_schema = 6;
+   */
+  avroSchema?: string;
+
+  constructor(data?: PartialMessage<KafkaSourceConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.DynamoDBSourceUnmappedTransformConfig";
+  static readonly typeName = "mgmt.v1alpha1.KafkaSourceConnectionOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "b", kind: "message", T: JobMappingTransformer },
-    { no: 2, name: "boolean", kind: "message", T: JobMappingTransformer },
-    { no: 4, name: "n", kind: "message", T: JobMappingTransformer },
-    { no: 6, name: "s", kind: "message", T: JobMappingTransformer },
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "topic", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "offsets", kind: "message", T: KafkaOffsetRange, oneof: "range" },
+    { no: 4, name: "timestamps", kind: "message", T: KafkaTimestampRange, oneof: "range" },
+    { no: 5, name: "format", kind: "enum", T: proto3.getEnumType(KafkaMessageFormat) },
+    { no: 6, name: "avro_schema", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DynamoDBSourceUnmappedTransformConfig {
-    return new DynamoDBSourceUnmappedTransformConfig().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaSourceConnectionOptions {
+    return new KafkaSourceConnectionOptions().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DynamoDBSourceUnmappedTransformConfig {
-    return new DynamoDBSourceUnmappedTransformConfig().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KafkaSourceConnectionOptions {
+    return new KafkaSourceConnectionOptions().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DynamoDBSourceUnmappedTransformConfig {
-    return new DynamoDBSourceUnmappedTransformConfig().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KafkaSourceConnectionOptions {
+    return new KafkaSourceConnectionOptions().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DynamoDBSourceUnmappedTransformConfig | PlainMessage<DynamoDBSourceUnmappedTransformConfig> | undefined, b: DynamoDBSourceUnmappedTransformConfig | PlainMessage<DynamoDBSourceUnmappedTransformConfig> | undefined): boolean {
-    return proto3.util.equals(DynamoDBSourceUnmappedTransformConfig, a, b);
+  static equals(a: KafkaSourceConnectionOptions | PlainMessage<KafkaSourceConnectionOptions> | undefined, b: KafkaSourceConnectionOptions | PlainMessage<KafkaSourceConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(KafkaSourceConnectionOptions, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.DynamoDBSourceTableOption
+ * A range of offsets that is applied to each partition of a topic
+ *
+ * @generated from message mgmt.v1alpha1.KafkaOffsetRange
  */
-export class DynamoDBSourceTableOption extends Message<DynamoDBSourceTableOption> {
+export class KafkaOffsetRange extends Message<KafkaOffsetRange> {
   /**
-   * The table that this configuration will be applied to
+   * The first offset to consume (inclusive). Defaults to the earliest available offset.
    *
-   * @generated from field: string table = 1;
+   * @generated from field: optional int64 start = 1;
    */
-  table = "";
+  start?: bigint;
 
   /**
-   * An optional PartiQL query that may be used for subsetting the DynamoDB table.
-   * This is not a parameterized query and must be valid. Intended to be everything after the WHERE keyword.
+   * The offset to stop consuming at (exclusive). Defaults to the latest offset when the run begins.
    *
-   * @generated from field: optional string where_clause = 2;
+   * @generated from field: optional int64 end = 2;
    */
-  whereClause?: string;
+  end?: bigint;
 
-  constructor(data?: PartialMessage<DynamoDBSourceTableOption>) {
+  constructor(data?: PartialMessage<KafkaOffsetRange>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.DynamoDBSourceTableOption";
+  static readonly typeName = "mgmt.v1alpha1.KafkaOffsetRange";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "where_clause", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 1, name: "start", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 2, name: "end", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DynamoDBSourceTableOption {
-    return new DynamoDBSourceTableOption().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaOffsetRange {
+    return new KafkaOffsetRange().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DynamoDBSourceTableOption {
-    return new DynamoDBSourceTableOption().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KafkaOffsetRange {
+    return new KafkaOffsetRange().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DynamoDBSourceTableOption {
-    return new DynamoDBSourceTableOption().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KafkaOffsetRange {
+    return new KafkaOffsetRange().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DynamoDBSourceTableOption | PlainMessage<DynamoDBSourceTableOption> | undefined, b: DynamoDBSourceTableOption | PlainMessage<DynamoDBSourceTableOption> | undefined): boolean {
-    return proto3.util.equals(DynamoDBSourceTableOption, a, b);
+  static equals(a: KafkaOffsetRange | PlainMessage<KafkaOffsetRange> | undefined, b: KafkaOffsetRange | PlainMessage<KafkaOffsetRange> | undefined): boolean {
+    return proto3.util.equals(KafkaOffsetRange, a, b);
   }
 }
 
 /**
- * @generated from message mgmt.v1alpha1.PostgresSourceConnectionOptions
+ * A range of message timestamps that is applied to each partition of a topic
+ *
+ * @generated from message mgmt.v1alpha1.KafkaTimestampRange
  */
-export class PostgresSourceConnectionOptions extends Message<PostgresSourceConnectionOptions> {
+export class KafkaTimestampRange extends Message<KafkaTimestampRange> {
   /**
-   * @generated from field: bool halt_on_new_column_addition = 1;
+   * Consumes messages with a timestamp at or after this time. Defaults to the earliest available message.
+   *
+   * @generated from field: google.protobuf.Timestamp start = 1;
    */
-  haltOnNewColumnAddition = false;
+  start?: Timestamp;
 
   /**
-   * @generated from field: repeated mgmt.v1alpha1.PostgresSourceSchemaOption schemas = 2;
+   * Stops consuming at the first message with a timestamp at or after this time. Defaults to the latest message when the run begins.
+   *
+   * @generated from field: google.protobuf.Timestamp end = 2;
    */
-  schemas: PostgresSourceSchemaOption[] = [];
+  end?: Timestamp;
 
-  /**
+  constructor(data?: PartialMessage<KafkaTimestampRange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.KafkaTimestampRange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start", kind: "message", T: Timestamp },
+    { no: 2, name: "end", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaTimestampRange {
+    return new KafkaTimestampRange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KafkaTimestampRange {
+    return new KafkaTimestampRange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KafkaTimestampRange {
+    return new KafkaTimestampRange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KafkaTimestampRange | PlainMessage<KafkaTimestampRange> | undefined, b: KafkaTimestampRange | PlainMessage<KafkaTimestampRange> | undefined): boolean {
+    return proto3.util.equals(KafkaTimestampRange, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DynamoDBSourceUnmappedTransformConfig
+ */
+export class DynamoDBSourceUnmappedTransformConfig extends Message<DynamoDBSourceUnmappedTransformConfig> {
+  /**
+   * Byte
+   *
+   * @generated from field: mgmt.v1alpha1.JobMappingTransformer b = 1;
+   */
+  b?: JobMappingTransformer;
+
+  /**
+   * Boolean
+   *
+   * @generated from field: mgmt.v1alpha1.JobMappingTransformer boolean = 2;
+   */
+  boolean?: JobMappingTransformer;
+
+  /**
+   * Number
+   *
+   * @generated from field: mgmt.v1alpha1.JobMappingTransformer n = 4;
+   */
+  n?: JobMappingTransformer;
+
+  /**
+   * String
+   *
+   * @generated from field: mgmt.v1alpha1.JobMappingTransformer s = 6;
+   */
+  s?: JobMappingTransformer;
+
+  constructor(data?: PartialMessage<DynamoDBSourceUnmappedTransformConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DynamoDBSourceUnmappedTransformConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "b", kind: "message", T: JobMappingTransformer },
+    { no: 2, name: "boolean", kind: "message", T: JobMappingTransformer },
+    { no: 4, name: "n", kind: "message", T: JobMappingTransformer },
+    { no: 6, name: "s", kind: "message", T: JobMappingTransformer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DynamoDBSourceUnmappedTransformConfig {
+    return new DynamoDBSourceUnmappedTransformConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DynamoDBSourceUnmappedTransformConfig {
+    return new DynamoDBSourceUnmappedTransformConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DynamoDBSourceUnmappedTransformConfig {
+    return new DynamoDBSourceUnmappedTransformConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DynamoDBSourceUnmappedTransformConfig | PlainMessage<DynamoDBSourceUnmappedTransformConfig> | undefined, b: DynamoDBSourceUnmappedTransformConfig | PlainMessage<DynamoDBSourceUnmappedTransformConfig> | undefined): boolean {
+    return proto3.util.equals(DynamoDBSourceUnmappedTransformConfig, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DynamoDBSourceTableOption
+ */
+export class DynamoDBSourceTableOption extends Message<DynamoDBSourceTableOption> {
+  /**
+   * The table that this configuration will be applied to
+   *
+   * @generated from field: string table = 1;
+   */
+  table = "";
+
+  /**
+   * An optional PartiQL query that may be used for subsetting the DynamoDB table.
+   * This is not a parameterized query and must be valid. Intended to be everything after the WHERE keyword.
+   *
+   * @generated from field: optional string where_clause = 2;
+   */
+  whereClause?: string;
+
+  constructor(data?: PartialMessage<DynamoDBSourceTableOption>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DynamoDBSourceTableOption";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "where_clause", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DynamoDBSourceTableOption {
+    return new DynamoDBSourceTableOption().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DynamoDBSourceTableOption {
+    return new DynamoDBSourceTableOption().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DynamoDBSourceTableOption {
+    return new DynamoDBSourceTableOption().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DynamoDBSourceTableOption | PlainMessage<DynamoDBSourceTableOption> | undefined, b: DynamoDBSourceTableOption | PlainMessage<DynamoDBSourceTableOption> | undefined): boolean {
+    return proto3.util.equals(DynamoDBSourceTableOption, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.PostgresSourceConnectionOptions
+ */
+export class PostgresSourceConnectionOptions extends Message<PostgresSourceConnectionOptions> {
+  /**
+   * @generated from field: bool halt_on_new_column_addition = 1;
+   */
+  haltOnNewColumnAddition = false;
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.PostgresSourceSchemaOption schemas = 2;
+   */
+  schemas: PostgresSourceSchemaOption[] = [];
+
+  /**
    * @generated from field: string connection_id = 3;
    */
   connectionId = "";
@@ -1158,6 +1689,15 @@ export class PostgresSourceTableOption extends Message<PostgresSourceTableOption
    */
   whereClause?: string;
 
+  /**
+   * When set, the table is synced incrementally using this column as the watermark.
+   * Only rows with a value greater than the watermark recorded by the previous run are selected, and they are upserted into the destination.
+   * This should be a monotonically increasing column such as an updated_at timestamp or an auto incrementing primary key.
+   *
+   * @generated from field: optional string watermark_column = 3;
+   */
+  watermarkColumn?: string;
+
   constructor(data?: PartialMessage<PostgresSourceTableOption>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1168,6 +1708,7 @@ export class PostgresSourceTableOption extends Message<PostgresSourceTableOption
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "where_clause", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "watermark_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PostgresSourceTableOption {
@@ -1299,6 +1840,15 @@ export class MysqlSourceTableOption extends Message<MysqlSourceTableOption> {
    */
   whereClause?: string;
 
+  /**
+   * When set, the table is synced incrementally using this column as the watermark.
+   * Only rows with a value greater than the watermark recorded by the previous run are selected, and they are upserted into the destination.
+   * This should be a monotonically increasing column such as an updated_at timestamp or an auto incrementing primary key.
+   *
+   * @generated from field: optional string watermark_column = 3;
+   */
+  watermarkColumn?: string;
+
   constructor(data?: PartialMessage<MysqlSourceTableOption>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1309,6 +1859,7 @@ export class MysqlSourceTableOption extends Message<MysqlSourceTableOption> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "where_clause", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "watermark_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MysqlSourceTableOption {
@@ -1440,6 +1991,15 @@ export class MssqlSourceTableOption extends Message<MssqlSourceTableOption> {
    */
   whereClause?: string;
 
+  /**
+   * When set, the table is synced incrementally using this column as the watermark.
+   * Only rows with a value greater than the watermark recorded by the previous run are selected, and they are upserted into the destination.
+   * This should be a monotonically increasing column such as an updated_at timestamp or an auto incrementing primary key.
+   *
+   * @generated from field: optional string watermark_column = 3;
+   */
+  watermarkColumn?: string;
+
   constructor(data?: PartialMessage<MssqlSourceTableOption>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1450,6 +2010,7 @@ export class MssqlSourceTableOption extends Message<MssqlSourceTableOption> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "where_clause", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "watermark_column", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MssqlSourceTableOption {
@@ -1469,6 +2030,149 @@ export class MssqlSourceTableOption extends Message<MssqlSourceTableOption> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.SqliteSourceConnectionOptions
+ */
+export class SqliteSourceConnectionOptions extends Message<SqliteSourceConnectionOptions> {
+  /**
+   * @generated from field: bool halt_on_new_column_addition = 1;
+   */
+  haltOnNewColumnAddition = false;
+
+  /**
+   * SQLite does not have schemas, all tables are found in the main schema
+   *
+   * @generated from field: repeated mgmt.v1alpha1.SqliteSourceSchemaOption schemas = 2;
+   */
+  schemas: SqliteSourceSchemaOption[] = [];
+
+  /**
+   * @generated from field: string connection_id = 3;
+   */
+  connectionId = "";
+
+  /**
+   * @generated from field: bool subset_by_foreign_key_constraints = 4;
+   */
+  subsetByForeignKeyConstraints = false;
+
+  constructor(data?: PartialMessage<SqliteSourceConnectionOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteSourceConnectionOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "halt_on_new_column_addition", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "schemas", kind: "message", T: SqliteSourceSchemaOption, repeated: true },
+    { no: 3, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "subset_by_foreign_key_constraints", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteSourceConnectionOptions {
+    return new SqliteSourceConnectionOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteSourceConnectionOptions {
+    return new SqliteSourceConnectionOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteSourceConnectionOptions {
+    return new SqliteSourceConnectionOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteSourceConnectionOptions | PlainMessage<SqliteSourceConnectionOptions> | undefined, b: SqliteSourceConnectionOptions | PlainMessage<SqliteSourceConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(SqliteSourceConnectionOptions, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SqliteSourceSchemaOption
+ */
+export class SqliteSourceSchemaOption extends Message<SqliteSourceSchemaOption> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.SqliteSourceTableOption tables = 2;
+   */
+  tables: SqliteSourceTableOption[] = [];
+
+  constructor(data?: PartialMessage<SqliteSourceSchemaOption>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteSourceSchemaOption";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tables", kind: "message", T: SqliteSourceTableOption, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteSourceSchemaOption {
+    return new SqliteSourceSchemaOption().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteSourceSchemaOption {
+    return new SqliteSourceSchemaOption().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteSourceSchemaOption {
+    return new SqliteSourceSchemaOption().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteSourceSchemaOption | PlainMessage<SqliteSourceSchemaOption> | undefined, b: SqliteSourceSchemaOption | PlainMessage<SqliteSourceSchemaOption> | undefined): boolean {
+    return proto3.util.equals(SqliteSourceSchemaOption, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SqliteSourceTableOption
+ */
+export class SqliteSourceTableOption extends Message<SqliteSourceTableOption> {
+  /**
+   * @generated from field: string table = 1;
+   */
+  table = "";
+
+  /**
+   * @generated from field: optional string where_clause = 2;
+   */
+  whereClause?: string;
+
+  constructor(data?: PartialMessage<SqliteSourceTableOption>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteSourceTableOption";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "where_clause", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteSourceTableOption {
+    return new SqliteSourceTableOption().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteSourceTableOption {
+    return new SqliteSourceTableOption().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteSourceTableOption {
+    return new SqliteSourceTableOption().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteSourceTableOption | PlainMessage<SqliteSourceTableOption> | undefined, b: SqliteSourceTableOption | PlainMessage<SqliteSourceTableOption> | undefined): boolean {
+    return proto3.util.equals(SqliteSourceTableOption, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.AwsS3SourceConnectionOptions
  */
@@ -1506,6 +2210,71 @@ export class AwsS3SourceConnectionOptions extends Message<AwsS3SourceConnectionO
   }
 }
 
+/**
+ * Local directory connection options for a job source.
+ * Reads the files that a previous job run exported in the default layout of workflows/<job_run_id>/activities/<schema.table>/data/
+ *
+ * @generated from message mgmt.v1alpha1.LocalDirectorySourceConnectionOptions
+ */
+export class LocalDirectorySourceConnectionOptions extends Message<LocalDirectorySourceConnectionOptions> {
+  /**
+   * The unique connection id to a local directory connection configuration
+   *
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  /**
+   * The export that is read. Job mappings must use the schemas and tables of the exported files.
+   *
+   * @generated from oneof mgmt.v1alpha1.LocalDirectorySourceConnectionOptions.id
+   */
+  id: {
+    /**
+     * Reads the most recent run of the job that is found in the directory
+     *
+     * @generated from field: string job_id = 2;
+     */
+    value: string;
+    case: "jobId";
+  } | {
+    /**
+     * @generated from field: string job_run_id = 3;
+     */
+    value: string;
+    case: "jobRunId";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<LocalDirectorySourceConnectionOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.LocalDirectorySourceConnectionOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+    { no: 3, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "id" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LocalDirectorySourceConnectionOptions {
+    return new LocalDirectorySourceConnectionOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LocalDirectorySourceConnectionOptions {
+    return new LocalDirectorySourceConnectionOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LocalDirectorySourceConnectionOptions {
+    return new LocalDirectorySourceConnectionOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LocalDirectorySourceConnectionOptions | PlainMessage<LocalDirectorySourceConnectionOptions> | undefined, b: LocalDirectorySourceConnectionOptions | PlainMessage<LocalDirectorySourceConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(LocalDirectorySourceConnectionOptions, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.JobDestinationOptions
  */
@@ -1561,6 +2330,30 @@ export class JobDestinationOptions extends Message<JobDestinationOptions> {
      */
     value: MssqlDestinationConnectionOptions;
     case: "mssqlOptions";
+  } | {
+    /**
+     * Destination Connection options for Kafka
+     *
+     * @generated from field: mgmt.v1alpha1.KafkaDestinationConnectionOptions kafka_options = 8;
+     */
+    value: KafkaDestinationConnectionOptions;
+    case: "kafkaOptions";
+  } | {
+    /**
+     * Destination Connection options for SQLite
+     *
+     * @generated from field: mgmt.v1alpha1.SqliteDestinationConnectionOptions sqlite_options = 9;
+     */
+    value: SqliteDestinationConnectionOptions;
+    case: "sqliteOptions";
+  } | {
+    /**
+     * Destination Connection options for a local directory
+     *
+     * @generated from field: mgmt.v1alpha1.LocalDirectoryDestinationConnectionOptions local_directory_options = 10;
+     */
+    value: LocalDirectoryDestinationConnectionOptions;
+    case: "localDirectoryOptions";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<JobDestinationOptions>) {
@@ -1578,6 +2371,9 @@ export class JobDestinationOptions extends Message<JobDestinationOptions> {
     { no: 5, name: "gcp_cloudstorage_options", kind: "message", T: GcpCloudStorageDestinationConnectionOptions, oneof: "config" },
     { no: 6, name: "dynamodb_options", kind: "message", T: DynamoDBDestinationConnectionOptions, oneof: "config" },
     { no: 7, name: "mssql_options", kind: "message", T: MssqlDestinationConnectionOptions, oneof: "config" },
+    { no: 8, name: "kafka_options", kind: "message", T: KafkaDestinationConnectionOptions, oneof: "config" },
+    { no: 9, name: "sqlite_options", kind: "message", T: SqliteDestinationConnectionOptions, oneof: "config" },
+    { no: 10, name: "local_directory_options", kind: "message", T: LocalDirectoryDestinationConnectionOptions, oneof: "config" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobDestinationOptions {
@@ -1601,6 +2397,28 @@ export class JobDestinationOptions extends Message<JobDestinationOptions> {
  * @generated from message mgmt.v1alpha1.MongoDBDestinationConnectionOptions
  */
 export class MongoDBDestinationConnectionOptions extends Message<MongoDBDestinationConnectionOptions> {
+  /**
+   * Removes the existing documents of the destination collections before the sync runs
+   *
+   * @generated from field: mgmt.v1alpha1.MongoDBTruncateCollectionConfig truncate_collection = 1;
+   */
+  truncateCollection?: MongoDBTruncateCollectionConfig;
+
+  /**
+   * Creates the destination collections with the indexes, JSON schema validators, collation and
+   * capped or time-series options of the source collections before the sync runs
+   *
+   * @generated from field: bool init_collection_schema = 2;
+   */
+  initCollectionSchema = false;
+
+  /**
+   * How documents are written to the destination collections. Defaults to upserting by _id
+   *
+   * @generated from field: mgmt.v1alpha1.MongoDBWriteMode write_mode = 3;
+   */
+  writeMode = MongoDBWriteMode.MONGO_DB_WRITE_MODE_UNSPECIFIED;
+
   constructor(data?: PartialMessage<MongoDBDestinationConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1609,6 +2427,9 @@ export class MongoDBDestinationConnectionOptions extends Message<MongoDBDestinat
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.MongoDBDestinationConnectionOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "truncate_collection", kind: "message", T: MongoDBTruncateCollectionConfig },
+    { no: 2, name: "init_collection_schema", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "write_mode", kind: "enum", T: proto3.getEnumType(MongoDBWriteMode) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBDestinationConnectionOptions {
@@ -1628,12 +2449,102 @@ export class MongoDBDestinationConnectionOptions extends Message<MongoDBDestinat
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.MongoDBTruncateCollectionConfig
+ */
+export class MongoDBTruncateCollectionConfig extends Message<MongoDBTruncateCollectionConfig> {
+  /**
+   * @generated from field: bool truncate_before_insert = 1;
+   */
+  truncateBeforeInsert = false;
+
+  /**
+   * Drops the collection instead of deleting its documents, which also removes its indexes and options
+   *
+   * @generated from field: bool drop = 2;
+   */
+  drop = false;
+
+  constructor(data?: PartialMessage<MongoDBTruncateCollectionConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MongoDBTruncateCollectionConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "truncate_before_insert", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "drop", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBTruncateCollectionConfig {
+    return new MongoDBTruncateCollectionConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MongoDBTruncateCollectionConfig {
+    return new MongoDBTruncateCollectionConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MongoDBTruncateCollectionConfig {
+    return new MongoDBTruncateCollectionConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MongoDBTruncateCollectionConfig | PlainMessage<MongoDBTruncateCollectionConfig> | undefined, b: MongoDBTruncateCollectionConfig | PlainMessage<MongoDBTruncateCollectionConfig> | undefined): boolean {
+    return proto3.util.equals(MongoDBTruncateCollectionConfig, a, b);
+  }
+}
+
 /**
  * Configuration for Google Cloud Storage Destination Connection Job Options
  *
  * @generated from message mgmt.v1alpha1.GcpCloudStorageDestinationConnectionOptions
  */
 export class GcpCloudStorageDestinationConnectionOptions extends Message<GcpCloudStorageDestinationConnectionOptions> {
+  /**
+   * The format that records will be written to GCS in. Defaults to gzipped JSON lines
+   *
+   * @generated from field: mgmt.v1alpha1.BucketFileFormat format = 1;
+   */
+  format = BucketFileFormat.UNSPECIFIED;
+
+  /**
+   * The maximum number of batched messages to have in flight at a given time. Increase this to improve throughput.
+   *
+   * @generated from field: optional uint32 max_in_flight = 2;
+   */
+  maxInFlight?: number;
+
+  /**
+   * The maximum period (duration string) to wait on an upload before abandoning it and reattempting.
+   *
+   * @generated from field: optional string timeout = 3;
+   */
+  timeout?: string;
+
+  /**
+   * Configure batching options to more efficiently store records in GCS
+   *
+   * @generated from field: mgmt.v1alpha1.BatchConfig batch = 4;
+   */
+  batch?: BatchConfig;
+
+  /**
+   * The storage class that will be used when objects are written to GCS. Defaults to the bucket's default storage class
+   *
+   * @generated from field: mgmt.v1alpha1.GcpCloudStorageDestinationConnectionOptions.StorageClass storage_class = 5;
+   */
+  storageClass = GcpCloudStorageDestinationConnectionOptions_StorageClass.UNSPECIFIED;
+
+  /**
+   * A template for the path of each object, relative to the connection's path prefix.
+   * Supports the {{job_id}}, {{job_name}}, {{job_run_id}}, {{schema}}, {{table}}, {{date}}, {{year}}, {{month}}, {{day}}, {{hour}},
+   * {{timestamp}}, {{file_index}}, and {{extension}} placeholders and must include {{table}} and one of {{file_index}} or {{timestamp}}.
+   * Defaults to workflows/<job_run_id>/activities/<schema.table>/data/, which is the layout that Neosync reads bucket sources from.
+   *
+   * @generated from field: optional string path_template = 6;
+   */
+  pathTemplate?: string;
+
   constructor(data?: PartialMessage<GcpCloudStorageDestinationConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1642,6 +2553,12 @@ export class GcpCloudStorageDestinationConnectionOptions extends Message<GcpClou
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GcpCloudStorageDestinationConnectionOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "format", kind: "enum", T: proto3.getEnumType(BucketFileFormat) },
+    { no: 2, name: "max_in_flight", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+    { no: 3, name: "timeout", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "batch", kind: "message", T: BatchConfig },
+    { no: 5, name: "storage_class", kind: "enum", T: proto3.getEnumType(GcpCloudStorageDestinationConnectionOptions_StorageClass) },
+    { no: 6, name: "path_template", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GcpCloudStorageDestinationConnectionOptions {
@@ -1661,6 +2578,44 @@ export class GcpCloudStorageDestinationConnectionOptions extends Message<GcpClou
   }
 }
 
+/**
+ * @generated from enum mgmt.v1alpha1.GcpCloudStorageDestinationConnectionOptions.StorageClass
+ */
+export enum GcpCloudStorageDestinationConnectionOptions_StorageClass {
+  /**
+   * @generated from enum value: STORAGE_CLASS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: STORAGE_CLASS_STANDARD = 1;
+   */
+  STANDARD = 1,
+
+  /**
+   * @generated from enum value: STORAGE_CLASS_NEARLINE = 2;
+   */
+  NEARLINE = 2,
+
+  /**
+   * @generated from enum value: STORAGE_CLASS_COLDLINE = 3;
+   */
+  COLDLINE = 3,
+
+  /**
+   * @generated from enum value: STORAGE_CLASS_ARCHIVE = 4;
+   */
+  ARCHIVE = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(GcpCloudStorageDestinationConnectionOptions_StorageClass)
+proto3.util.setEnumType(GcpCloudStorageDestinationConnectionOptions_StorageClass, "mgmt.v1alpha1.GcpCloudStorageDestinationConnectionOptions.StorageClass", [
+  { no: 0, name: "STORAGE_CLASS_UNSPECIFIED" },
+  { no: 1, name: "STORAGE_CLASS_STANDARD" },
+  { no: 2, name: "STORAGE_CLASS_NEARLINE" },
+  { no: 3, name: "STORAGE_CLASS_COLDLINE" },
+  { no: 4, name: "STORAGE_CLASS_ARCHIVE" },
+]);
+
 /**
  * Configuration for DynamoDB Destination Connection Job Options
  *
@@ -1751,6 +2706,71 @@ export class DynamoDBDestinationTableMapping extends Message<DynamoDBDestination
   }
 }
 
+/**
+ * Configuration for Kafka Destination Connection Job Options
+ *
+ * @generated from message mgmt.v1alpha1.KafkaDestinationConnectionOptions
+ */
+export class KafkaDestinationConnectionOptions extends Message<KafkaDestinationConnectionOptions> {
+  /**
+   * The topic that messages will be produced to. The key and headers of each source message are preserved.
+   *
+   * @generated from field: string topic = 1;
+   */
+  topic = "";
+
+  /**
+   * The format that message values will be written in. Defaults to JSON
+   *
+   * @generated from field: mgmt.v1alpha1.KafkaMessageFormat format = 2;
+   */
+  format = KafkaMessageFormat.UNSPECIFIED;
+
+  /**
+   * The Avro schema (in JSON) of the message values. Required when the format is Avro.
+   *
+   * @generated from field: optional string avro_schema = 3;
+   */
+  avroSchema?: string;
+
+  /**
+   * The maximum number of batched messages to have in flight at a given time. Increase this to improve throughput.
+   *
+   * @generated from field: optional uint32 max_in_flight = 4;
+   */
+  maxInFlight?: number;
+
+  constructor(data?: PartialMessage<KafkaDestinationConnectionOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.KafkaDestinationConnectionOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "topic", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "format", kind: "enum", T: proto3.getEnumType(KafkaMessageFormat) },
+    { no: 3, name: "avro_schema", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "max_in_flight", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KafkaDestinationConnectionOptions {
+    return new KafkaDestinationConnectionOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KafkaDestinationConnectionOptions {
+    return new KafkaDestinationConnectionOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KafkaDestinationConnectionOptions {
+    return new KafkaDestinationConnectionOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KafkaDestinationConnectionOptions | PlainMessage<KafkaDestinationConnectionOptions> | undefined, b: KafkaDestinationConnectionOptions | PlainMessage<KafkaDestinationConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(KafkaDestinationConnectionOptions, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.PostgresDestinationConnectionOptions
  */
@@ -1809,39 +2829,86 @@ export class PostgresDestinationConnectionOptions extends Message<PostgresDestin
 }
 
 /**
- * @generated from message mgmt.v1alpha1.PostgresOnConflictConfig
+ * @generated from message mgmt.v1alpha1.PostgresOnConflictConfig
+ */
+export class PostgresOnConflictConfig extends Message<PostgresOnConflictConfig> {
+  /**
+   * @generated from field: bool do_nothing = 1;
+   */
+  doNothing = false;
+
+  /**
+   * When set, rows that conflict with an existing row update the existing row instead. Takes precedence over do_nothing.
+   *
+   * @generated from field: mgmt.v1alpha1.PostgresOnConflictUpdateConfig update = 2;
+   */
+  update?: PostgresOnConflictUpdateConfig;
+
+  constructor(data?: PartialMessage<PostgresOnConflictConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.PostgresOnConflictConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "do_nothing", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "update", kind: "message", T: PostgresOnConflictUpdateConfig },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PostgresOnConflictConfig {
+    return new PostgresOnConflictConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PostgresOnConflictConfig {
+    return new PostgresOnConflictConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PostgresOnConflictConfig {
+    return new PostgresOnConflictConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PostgresOnConflictConfig | PlainMessage<PostgresOnConflictConfig> | undefined, b: PostgresOnConflictConfig | PlainMessage<PostgresOnConflictConfig> | undefined): boolean {
+    return proto3.util.equals(PostgresOnConflictConfig, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.PostgresOnConflictUpdateConfig
  */
-export class PostgresOnConflictConfig extends Message<PostgresOnConflictConfig> {
+export class PostgresOnConflictUpdateConfig extends Message<PostgresOnConflictUpdateConfig> {
   /**
-   * @generated from field: bool do_nothing = 1;
+   * The columns to update on conflict. When empty, all non-key columns are updated.
+   *
+   * @generated from field: repeated string columns = 1;
    */
-  doNothing = false;
+  columns: string[] = [];
 
-  constructor(data?: PartialMessage<PostgresOnConflictConfig>) {
+  constructor(data?: PartialMessage<PostgresOnConflictUpdateConfig>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mgmt.v1alpha1.PostgresOnConflictConfig";
+  static readonly typeName = "mgmt.v1alpha1.PostgresOnConflictUpdateConfig";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "do_nothing", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "columns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PostgresOnConflictConfig {
-    return new PostgresOnConflictConfig().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PostgresOnConflictUpdateConfig {
+    return new PostgresOnConflictUpdateConfig().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PostgresOnConflictConfig {
-    return new PostgresOnConflictConfig().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PostgresOnConflictUpdateConfig {
+    return new PostgresOnConflictUpdateConfig().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PostgresOnConflictConfig {
-    return new PostgresOnConflictConfig().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PostgresOnConflictUpdateConfig {
+    return new PostgresOnConflictUpdateConfig().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PostgresOnConflictConfig | PlainMessage<PostgresOnConflictConfig> | undefined, b: PostgresOnConflictConfig | PlainMessage<PostgresOnConflictConfig> | undefined): boolean {
-    return proto3.util.equals(PostgresOnConflictConfig, a, b);
+  static equals(a: PostgresOnConflictUpdateConfig | PlainMessage<PostgresOnConflictUpdateConfig> | undefined, b: PostgresOnConflictUpdateConfig | PlainMessage<PostgresOnConflictUpdateConfig> | undefined): boolean {
+    return proto3.util.equals(PostgresOnConflictUpdateConfig, a, b);
   }
 }
 
@@ -1991,6 +3058,13 @@ export class MysqlOnConflictConfig extends Message<MysqlOnConflictConfig> {
    */
   doNothing = false;
 
+  /**
+   * When set, rows that conflict with an existing row update the existing row instead. Takes precedence over do_nothing.
+   *
+   * @generated from field: mgmt.v1alpha1.MysqlOnConflictUpdateConfig update = 2;
+   */
+  update?: MysqlOnConflictUpdateConfig;
+
   constructor(data?: PartialMessage<MysqlOnConflictConfig>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2000,6 +3074,7 @@ export class MysqlOnConflictConfig extends Message<MysqlOnConflictConfig> {
   static readonly typeName = "mgmt.v1alpha1.MysqlOnConflictConfig";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "do_nothing", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "update", kind: "message", T: MysqlOnConflictUpdateConfig },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MysqlOnConflictConfig {
@@ -2019,6 +3094,45 @@ export class MysqlOnConflictConfig extends Message<MysqlOnConflictConfig> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.MysqlOnConflictUpdateConfig
+ */
+export class MysqlOnConflictUpdateConfig extends Message<MysqlOnConflictUpdateConfig> {
+  /**
+   * The columns to update on conflict. When empty, all non-key columns are updated.
+   *
+   * @generated from field: repeated string columns = 1;
+   */
+  columns: string[] = [];
+
+  constructor(data?: PartialMessage<MysqlOnConflictUpdateConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MysqlOnConflictUpdateConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "columns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MysqlOnConflictUpdateConfig {
+    return new MysqlOnConflictUpdateConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MysqlOnConflictUpdateConfig {
+    return new MysqlOnConflictUpdateConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MysqlOnConflictUpdateConfig {
+    return new MysqlOnConflictUpdateConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MysqlOnConflictUpdateConfig | PlainMessage<MysqlOnConflictUpdateConfig> | undefined, b: MysqlOnConflictUpdateConfig | PlainMessage<MysqlOnConflictUpdateConfig> | undefined): boolean {
+    return proto3.util.equals(MysqlOnConflictUpdateConfig, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.MssqlDestinationConnectionOptions
  */
@@ -2029,15 +3143,11 @@ export class MssqlDestinationConnectionOptions extends Message<MssqlDestinationC
   truncateTable?: MssqlTruncateTableConfig;
 
   /**
-   * Currently not supported and a placeholder for future implementation
-   *
    * @generated from field: bool init_table_schema = 2;
    */
   initTableSchema = false;
 
   /**
-   * Currently not supported and a placeholder for future implementation
-   *
    * @generated from field: mgmt.v1alpha1.MssqlOnConflictConfig on_conflict = 3;
    */
   onConflict?: MssqlOnConflictConfig;
@@ -2126,6 +3236,13 @@ export class MssqlOnConflictConfig extends Message<MssqlOnConflictConfig> {
    */
   doNothing = false;
 
+  /**
+   * When set, rows are merged into the table by primary key and existing rows are updated. Takes precedence over do_nothing.
+   *
+   * @generated from field: mgmt.v1alpha1.MssqlOnConflictUpdateConfig update = 2;
+   */
+  update?: MssqlOnConflictUpdateConfig;
+
   constructor(data?: PartialMessage<MssqlOnConflictConfig>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2135,6 +3252,7 @@ export class MssqlOnConflictConfig extends Message<MssqlOnConflictConfig> {
   static readonly typeName = "mgmt.v1alpha1.MssqlOnConflictConfig";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "do_nothing", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "update", kind: "message", T: MssqlOnConflictUpdateConfig },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MssqlOnConflictConfig {
@@ -2154,6 +3272,178 @@ export class MssqlOnConflictConfig extends Message<MssqlOnConflictConfig> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.MssqlOnConflictUpdateConfig
+ */
+export class MssqlOnConflictUpdateConfig extends Message<MssqlOnConflictUpdateConfig> {
+  /**
+   * The columns to update on conflict. When empty, all non-key columns are updated.
+   *
+   * @generated from field: repeated string columns = 1;
+   */
+  columns: string[] = [];
+
+  constructor(data?: PartialMessage<MssqlOnConflictUpdateConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MssqlOnConflictUpdateConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "columns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MssqlOnConflictUpdateConfig {
+    return new MssqlOnConflictUpdateConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MssqlOnConflictUpdateConfig {
+    return new MssqlOnConflictUpdateConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MssqlOnConflictUpdateConfig {
+    return new MssqlOnConflictUpdateConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MssqlOnConflictUpdateConfig | PlainMessage<MssqlOnConflictUpdateConfig> | undefined, b: MssqlOnConflictUpdateConfig | PlainMessage<MssqlOnConflictUpdateConfig> | undefined): boolean {
+    return proto3.util.equals(MssqlOnConflictUpdateConfig, a, b);
+  }
+}
+
+/**
+ * SQLite does not have schemas, so all tables are written to the main schema of the destination database regardless of the source schema
+ *
+ * @generated from message mgmt.v1alpha1.SqliteDestinationConnectionOptions
+ */
+export class SqliteDestinationConnectionOptions extends Message<SqliteDestinationConnectionOptions> {
+  /**
+   * @generated from field: mgmt.v1alpha1.SqliteTruncateTableConfig truncate_table = 1;
+   */
+  truncateTable?: SqliteTruncateTableConfig;
+
+  /**
+   * @generated from field: bool init_table_schema = 2;
+   */
+  initTableSchema = false;
+
+  /**
+   * @generated from field: mgmt.v1alpha1.SqliteOnConflictConfig on_conflict = 3;
+   */
+  onConflict?: SqliteOnConflictConfig;
+
+  /**
+   * Insert all valid records, skipping any that violate foreign key constraints.
+   *
+   * @generated from field: bool skip_foreign_key_violations = 4;
+   */
+  skipForeignKeyViolations = false;
+
+  constructor(data?: PartialMessage<SqliteDestinationConnectionOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteDestinationConnectionOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "truncate_table", kind: "message", T: SqliteTruncateTableConfig },
+    { no: 2, name: "init_table_schema", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "on_conflict", kind: "message", T: SqliteOnConflictConfig },
+    { no: 4, name: "skip_foreign_key_violations", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteDestinationConnectionOptions {
+    return new SqliteDestinationConnectionOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteDestinationConnectionOptions {
+    return new SqliteDestinationConnectionOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteDestinationConnectionOptions {
+    return new SqliteDestinationConnectionOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteDestinationConnectionOptions | PlainMessage<SqliteDestinationConnectionOptions> | undefined, b: SqliteDestinationConnectionOptions | PlainMessage<SqliteDestinationConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(SqliteDestinationConnectionOptions, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SqliteTruncateTableConfig
+ */
+export class SqliteTruncateTableConfig extends Message<SqliteTruncateTableConfig> {
+  /**
+   * @generated from field: bool truncate_before_insert = 1;
+   */
+  truncateBeforeInsert = false;
+
+  constructor(data?: PartialMessage<SqliteTruncateTableConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteTruncateTableConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "truncate_before_insert", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteTruncateTableConfig {
+    return new SqliteTruncateTableConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteTruncateTableConfig {
+    return new SqliteTruncateTableConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteTruncateTableConfig {
+    return new SqliteTruncateTableConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteTruncateTableConfig | PlainMessage<SqliteTruncateTableConfig> | undefined, b: SqliteTruncateTableConfig | PlainMessage<SqliteTruncateTableConfig> | undefined): boolean {
+    return proto3.util.equals(SqliteTruncateTableConfig, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SqliteOnConflictConfig
+ */
+export class SqliteOnConflictConfig extends Message<SqliteOnConflictConfig> {
+  /**
+   * @generated from field: bool do_nothing = 1;
+   */
+  doNothing = false;
+
+  constructor(data?: PartialMessage<SqliteOnConflictConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteOnConflictConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "do_nothing", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteOnConflictConfig {
+    return new SqliteOnConflictConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteOnConflictConfig {
+    return new SqliteOnConflictConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteOnConflictConfig {
+    return new SqliteOnConflictConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteOnConflictConfig | PlainMessage<SqliteOnConflictConfig> | undefined, b: SqliteOnConflictConfig | PlainMessage<SqliteOnConflictConfig> | undefined): boolean {
+    return proto3.util.equals(SqliteOnConflictConfig, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.AwsS3DestinationConnectionOptions
  */
@@ -2186,6 +3476,23 @@ export class AwsS3DestinationConnectionOptions extends Message<AwsS3DestinationC
    */
   batch?: BatchConfig;
 
+  /**
+   * The format that records will be written to S3 in. Defaults to gzipped JSON lines
+   *
+   * @generated from field: mgmt.v1alpha1.BucketFileFormat format = 5;
+   */
+  format = BucketFileFormat.UNSPECIFIED;
+
+  /**
+   * A template for the path of each object, relative to the connection's path prefix.
+   * Supports the {{job_id}}, {{job_name}}, {{job_run_id}}, {{schema}}, {{table}}, {{date}}, {{year}}, {{month}}, {{day}}, {{hour}},
+   * {{timestamp}}, {{file_index}}, and {{extension}} placeholders and must include {{table}} and one of {{file_index}} or {{timestamp}}.
+   * Defaults to workflows/<job_run_id>/activities/<schema.table>/data/, which is the layout that Neosync reads bucket sources from.
+   *
+   * @generated from field: optional string path_template = 6;
+   */
+  pathTemplate?: string;
+
   constructor(data?: PartialMessage<AwsS3DestinationConnectionOptions>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2198,6 +3505,8 @@ export class AwsS3DestinationConnectionOptions extends Message<AwsS3DestinationC
     { no: 2, name: "max_in_flight", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
     { no: 3, name: "timeout", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "batch", kind: "message", T: BatchConfig },
+    { no: 5, name: "format", kind: "enum", T: proto3.getEnumType(BucketFileFormat) },
+    { no: 6, name: "path_template", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AwsS3DestinationConnectionOptions {
@@ -2273,6 +3582,65 @@ proto3.util.setEnumType(AwsS3DestinationConnectionOptions_StorageClass, "mgmt.v1
   { no: 7, name: "STORAGE_CLASS_DEEP_ARCHIVE" },
 ]);
 
+/**
+ * Configuration for Local Directory Destination Connection Job Options
+ *
+ * @generated from message mgmt.v1alpha1.LocalDirectoryDestinationConnectionOptions
+ */
+export class LocalDirectoryDestinationConnectionOptions extends Message<LocalDirectoryDestinationConnectionOptions> {
+  /**
+   * The format that records will be written to the directory in. Defaults to gzipped JSON lines
+   *
+   * @generated from field: mgmt.v1alpha1.BucketFileFormat format = 1;
+   */
+  format = BucketFileFormat.UNSPECIFIED;
+
+  /**
+   * Configure batching options to control how many records are written to each file
+   *
+   * @generated from field: mgmt.v1alpha1.BatchConfig batch = 2;
+   */
+  batch?: BatchConfig;
+
+  /**
+   * A template for the path of each file, relative to the connection's directory.
+   * Supports the same placeholders as the bucket destinations.
+   * Defaults to workflows/<job_run_id>/activities/<schema.table>/data/, which is the layout that Neosync reads local directory sources from.
+   *
+   * @generated from field: optional string path_template = 3;
+   */
+  pathTemplate?: string;
+
+  constructor(data?: PartialMessage<LocalDirectoryDestinationConnectionOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.LocalDirectoryDestinationConnectionOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "format", kind: "enum", T: proto3.getEnumType(BucketFileFormat) },
+    { no: 2, name: "batch", kind: "message", T: BatchConfig },
+    { no: 3, name: "path_template", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LocalDirectoryDestinationConnectionOptions {
+    return new LocalDirectoryDestinationConnectionOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LocalDirectoryDestinationConnectionOptions {
+    return new LocalDirectoryDestinationConnectionOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LocalDirectoryDestinationConnectionOptions {
+    return new LocalDirectoryDestinationConnectionOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LocalDirectoryDestinationConnectionOptions | PlainMessage<LocalDirectoryDestinationConnectionOptions> | undefined, b: LocalDirectoryDestinationConnectionOptions | PlainMessage<LocalDirectoryDestinationConnectionOptions> | undefined): boolean {
+    return proto3.util.equals(LocalDirectoryDestinationConnectionOptions, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.BatchConfig
  */
@@ -3175,16 +4543,90 @@ export class MssqlSourceSchemaSubset extends Message<MssqlSourceSchemaSubset> {
     return new MssqlSourceSchemaSubset().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MssqlSourceSchemaSubset {
-    return new MssqlSourceSchemaSubset().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MssqlSourceSchemaSubset {
+    return new MssqlSourceSchemaSubset().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MssqlSourceSchemaSubset {
+    return new MssqlSourceSchemaSubset().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MssqlSourceSchemaSubset | PlainMessage<MssqlSourceSchemaSubset> | undefined, b: MssqlSourceSchemaSubset | PlainMessage<MssqlSourceSchemaSubset> | undefined): boolean {
+    return proto3.util.equals(MssqlSourceSchemaSubset, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SqliteSourceSchemaSubset
+ */
+export class SqliteSourceSchemaSubset extends Message<SqliteSourceSchemaSubset> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.SqliteSourceSchemaOption sqlite_schemas = 1;
+   */
+  sqliteSchemas: SqliteSourceSchemaOption[] = [];
+
+  constructor(data?: PartialMessage<SqliteSourceSchemaSubset>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SqliteSourceSchemaSubset";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sqlite_schemas", kind: "message", T: SqliteSourceSchemaOption, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SqliteSourceSchemaSubset {
+    return new SqliteSourceSchemaSubset().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SqliteSourceSchemaSubset {
+    return new SqliteSourceSchemaSubset().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SqliteSourceSchemaSubset {
+    return new SqliteSourceSchemaSubset().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SqliteSourceSchemaSubset | PlainMessage<SqliteSourceSchemaSubset> | undefined, b: SqliteSourceSchemaSubset | PlainMessage<SqliteSourceSchemaSubset> | undefined): boolean {
+    return proto3.util.equals(SqliteSourceSchemaSubset, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.MongoDBSourceSchemaSubset
+ */
+export class MongoDBSourceSchemaSubset extends Message<MongoDBSourceSchemaSubset> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.MongoDBSourceCollectionOption collections = 1;
+   */
+  collections: MongoDBSourceCollectionOption[] = [];
+
+  constructor(data?: PartialMessage<MongoDBSourceSchemaSubset>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.MongoDBSourceSchemaSubset";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "collections", kind: "message", T: MongoDBSourceCollectionOption, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MongoDBSourceSchemaSubset {
+    return new MongoDBSourceSchemaSubset().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MongoDBSourceSchemaSubset {
+    return new MongoDBSourceSchemaSubset().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MssqlSourceSchemaSubset {
-    return new MssqlSourceSchemaSubset().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MongoDBSourceSchemaSubset {
+    return new MongoDBSourceSchemaSubset().fromJsonString(jsonString, options);
   }
 
-  static equals(a: MssqlSourceSchemaSubset | PlainMessage<MssqlSourceSchemaSubset> | undefined, b: MssqlSourceSchemaSubset | PlainMessage<MssqlSourceSchemaSubset> | undefined): boolean {
-    return proto3.util.equals(MssqlSourceSchemaSubset, a, b);
+  static equals(a: MongoDBSourceSchemaSubset | PlainMessage<MongoDBSourceSchemaSubset> | undefined, b: MongoDBSourceSchemaSubset | PlainMessage<MongoDBSourceSchemaSubset> | undefined): boolean {
+    return proto3.util.equals(MongoDBSourceSchemaSubset, a, b);
   }
 }
 
@@ -3219,6 +4661,18 @@ export class JobSourceSqlSubetSchemas extends Message<JobSourceSqlSubetSchemas>
      */
     value: MssqlSourceSchemaSubset;
     case: "mssqlSubset";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.SqliteSourceSchemaSubset sqlite_subset = 6;
+     */
+    value: SqliteSourceSchemaSubset;
+    case: "sqliteSubset";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.MongoDBSourceSchemaSubset mongodb_subset = 7;
+     */
+    value: MongoDBSourceSchemaSubset;
+    case: "mongodbSubset";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<JobSourceSqlSubetSchemas>) {
@@ -3233,6 +4687,8 @@ export class JobSourceSqlSubetSchemas extends Message<JobSourceSqlSubetSchemas>
     { no: 3, name: "mysql_subset", kind: "message", T: MysqlSourceSchemaSubset, oneof: "schemas" },
     { no: 4, name: "dynamodb_subset", kind: "message", T: DynamoDBSourceSchemaSubset, oneof: "schemas" },
     { no: 5, name: "mssql_subset", kind: "message", T: MssqlSourceSchemaSubset, oneof: "schemas" },
+    { no: 6, name: "sqlite_subset", kind: "message", T: SqliteSourceSchemaSubset, oneof: "schemas" },
+    { no: 7, name: "mongodb_subset", kind: "message", T: MongoDBSourceSchemaSubset, oneof: "schemas" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobSourceSqlSubetSchemas {
@@ -3908,6 +5364,14 @@ export class CreateJobRunRequest extends Message<CreateJobRunRequest> {
    */
   jobId = "";
 
+  /**
+   * Optionally resume a previous run of this job that failed or was canceled.
+   * Tables that completed in the previous run are skipped and only the failed or unstarted tables are synced.
+   *
+   * @generated from field: optional string resume_from_run_id = 2;
+   */
+  resumeFromRunId?: string;
+
   constructor(data?: PartialMessage<CreateJobRunRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3917,6 +5381,7 @@ export class CreateJobRunRequest extends Message<CreateJobRunRequest> {
   static readonly typeName = "mgmt.v1alpha1.CreateJobRunRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "resume_from_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateJobRunRequest {
@@ -6032,6 +7497,401 @@ export class SetRunContextResponse extends Message<SetRunContextResponse> {
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.GetJobPlanRequest
+ */
+export class GetJobPlanRequest extends Message<GetJobPlanRequest> {
+  /**
+   * The unique identifier of the job to plan
+   *
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  constructor(data?: PartialMessage<GetJobPlanRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobPlanRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobPlanRequest {
+    return new GetJobPlanRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobPlanRequest {
+    return new GetJobPlanRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobPlanRequest {
+    return new GetJobPlanRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobPlanRequest | PlainMessage<GetJobPlanRequest> | undefined, b: GetJobPlanRequest | PlainMessage<GetJobPlanRequest> | undefined): boolean {
+    return proto3.util.equals(GetJobPlanRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetJobPlanResponse
+ */
+export class GetJobPlanResponse extends Message<GetJobPlanResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.JobPlan plan = 1;
+   */
+  plan?: JobPlan;
+
+  constructor(data?: PartialMessage<GetJobPlanResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetJobPlanResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "plan", kind: "message", T: JobPlan },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJobPlanResponse {
+    return new GetJobPlanResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJobPlanResponse {
+    return new GetJobPlanResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJobPlanResponse {
+    return new GetJobPlanResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJobPlanResponse | PlainMessage<GetJobPlanResponse> | undefined, b: GetJobPlanResponse | PlainMessage<GetJobPlanResponse> | undefined): boolean {
+    return proto3.util.equals(GetJobPlanResponse, a, b);
+  }
+}
+
+/**
+ * Describes what a run of a job would do, without executing anything
+ *
+ * @generated from message mgmt.v1alpha1.JobPlan
+ */
+export class JobPlan extends Message<JobPlan> {
+  /**
+   * The table runs in the order that they will be executed
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobPlanTableRun table_runs = 1;
+   */
+  tableRuns: JobPlanTableRun[] = [];
+
+  /**
+   * The statements that will be run against each sql destination before the sync begins
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobPlanDestination destinations = 2;
+   */
+  destinations: JobPlanDestination[] = [];
+
+  constructor(data?: PartialMessage<JobPlan>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobPlan";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "table_runs", kind: "message", T: JobPlanTableRun, repeated: true },
+    { no: 2, name: "destinations", kind: "message", T: JobPlanDestination, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobPlan {
+    return new JobPlan().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobPlan {
+    return new JobPlan().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobPlan {
+    return new JobPlan().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobPlan | PlainMessage<JobPlan> | undefined, b: JobPlan | PlainMessage<JobPlan> | undefined): boolean {
+    return proto3.util.equals(JobPlan, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobPlanTableRun
+ */
+export class JobPlanTableRun extends Message<JobPlanTableRun> {
+  /**
+   * @generated from field: string schema = 1;
+   */
+  schema = "";
+
+  /**
+   * @generated from field: string table = 2;
+   */
+  table = "";
+
+  /**
+   * insert or update. Tables with circular dependencies are inserted first and then updated
+   *
+   * @generated from field: string run_type = 3;
+   */
+  runType = "";
+
+  /**
+   * The tables (schema.table) that must complete before this table run begins
+   *
+   * @generated from field: repeated string depends_on = 4;
+   */
+  dependsOn: string[] = [];
+
+  /**
+   * The columns that will be written to the destination
+   *
+   * @generated from field: repeated string columns = 5;
+   */
+  columns: string[] = [];
+
+  /**
+   * The query that will be used to select the rows from the source
+   *
+   * @generated from field: string select_query = 6;
+   */
+  selectQuery = "";
+
+  /**
+   * The number of rows in the source table that match the configured subset. Not set if it could not be estimated
+   *
+   * @generated from field: optional int64 estimated_row_count = 7;
+   */
+  estimatedRowCount?: bigint;
+
+  /**
+   * The order in which this table run will begin. Table runs with the same stage may run in parallel
+   *
+   * @generated from field: int32 stage = 8;
+   */
+  stage = 0;
+
+  constructor(data?: PartialMessage<JobPlanTableRun>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobPlanTableRun";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "run_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "depends_on", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "columns", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "select_query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "estimated_row_count", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 8, name: "stage", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobPlanTableRun {
+    return new JobPlanTableRun().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobPlanTableRun {
+    return new JobPlanTableRun().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobPlanTableRun {
+    return new JobPlanTableRun().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobPlanTableRun | PlainMessage<JobPlanTableRun> | undefined, b: JobPlanTableRun | PlainMessage<JobPlanTableRun> | undefined): boolean {
+    return proto3.util.equals(JobPlanTableRun, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobPlanDestination
+ */
+export class JobPlanDestination extends Message<JobPlanDestination> {
+  /**
+   * @generated from field: string connection_id = 1;
+   */
+  connectionId = "";
+
+  /**
+   * Schema initialization statements, grouped into blocks that are executed in order
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobPlanStatementBlock init_statements = 2;
+   */
+  initStatements: JobPlanStatementBlock[] = [];
+
+  /**
+   * Statements that clear the destination tables, in the order they are executed
+   *
+   * @generated from field: repeated string truncate_statements = 3;
+   */
+  truncateStatements: string[] = [];
+
+  /**
+   * Statements that reset sequences and identity columns once the tables have been cleared
+   *
+   * @generated from field: repeated string reset_statements = 4;
+   */
+  resetStatements: string[] = [];
+
+  /**
+   * Parts of the source schema that could not be represented exactly when translating it into the destination's sql dialect
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobPlanLossyConversion lossy_conversions = 5;
+   */
+  lossyConversions: JobPlanLossyConversion[] = [];
+
+  constructor(data?: PartialMessage<JobPlanDestination>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobPlanDestination";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "init_statements", kind: "message", T: JobPlanStatementBlock, repeated: true },
+    { no: 3, name: "truncate_statements", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "reset_statements", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "lossy_conversions", kind: "message", T: JobPlanLossyConversion, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobPlanDestination {
+    return new JobPlanDestination().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobPlanDestination {
+    return new JobPlanDestination().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobPlanDestination {
+    return new JobPlanDestination().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobPlanDestination | PlainMessage<JobPlanDestination> | undefined, b: JobPlanDestination | PlainMessage<JobPlanDestination> | undefined): boolean {
+    return proto3.util.equals(JobPlanDestination, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobPlanLossyConversion
+ */
+export class JobPlanLossyConversion extends Message<JobPlanLossyConversion> {
+  /**
+   * The table (schema.table) that the conversion applies to
+   *
+   * @generated from field: string table = 1;
+   */
+  table = "";
+
+  /**
+   * Empty when the conversion applies to the table as a whole, such as a dropped constraint
+   *
+   * @generated from field: string column = 2;
+   */
+  column = "";
+
+  /**
+   * @generated from field: string source_type = 3;
+   */
+  sourceType = "";
+
+  /**
+   * @generated from field: string destination_type = 4;
+   */
+  destinationType = "";
+
+  /**
+   * Why the source definition could not be represented exactly by the destination
+   *
+   * @generated from field: string reason = 5;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<JobPlanLossyConversion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobPlanLossyConversion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "table", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "column", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "source_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "destination_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobPlanLossyConversion {
+    return new JobPlanLossyConversion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobPlanLossyConversion {
+    return new JobPlanLossyConversion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobPlanLossyConversion {
+    return new JobPlanLossyConversion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobPlanLossyConversion | PlainMessage<JobPlanLossyConversion> | undefined, b: JobPlanLossyConversion | PlainMessage<JobPlanLossyConversion> | undefined): boolean {
+    return proto3.util.equals(JobPlanLossyConversion, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.JobPlanStatementBlock
+ */
+export class JobPlanStatementBlock extends Message<JobPlanStatementBlock> {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label = "";
+
+  /**
+   * @generated from field: repeated string statements = 2;
+   */
+  statements: string[] = [];
+
+  constructor(data?: PartialMessage<JobPlanStatementBlock>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.JobPlanStatementBlock";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "statements", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JobPlanStatementBlock {
+    return new JobPlanStatementBlock().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JobPlanStatementBlock {
+    return new JobPlanStatementBlock().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JobPlanStatementBlock {
+    return new JobPlanStatementBlock().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JobPlanStatementBlock | PlainMessage<JobPlanStatementBlock> | undefined, b: JobPlanStatementBlock | PlainMessage<JobPlanStatementBlock> | undefined): boolean {
+    return proto3.util.equals(JobPlanStatementBlock, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.SetRunContextsRequest
  */
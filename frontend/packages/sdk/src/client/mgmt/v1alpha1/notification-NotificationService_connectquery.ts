@@ -0,0 +1,89 @@
+// @generated by protoc-gen-connect-query v1.4.2 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/notification.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { CreateNotificationRuleRequest, CreateNotificationRuleResponse, DeleteNotificationRuleRequest, DeleteNotificationRuleResponse, GetNotificationDeliveriesRequest, GetNotificationDeliveriesResponse, GetNotificationRulesRequest, GetNotificationRulesResponse, PublishJobRunEventRequest, PublishJobRunEventResponse } from "./notification_pb.js";
+
+/**
+ * Creates a rule that delivers job run events to a webhook
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.CreateNotificationRule
+ */
+export const createNotificationRule = {
+  localName: "createNotificationRule",
+  name: "CreateNotificationRule",
+  kind: MethodKind.Unary,
+  I: CreateNotificationRuleRequest,
+  O: CreateNotificationRuleResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Returns the notification rules of an account
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.GetNotificationRules
+ */
+export const getNotificationRules = {
+  localName: "getNotificationRules",
+  name: "GetNotificationRules",
+  kind: MethodKind.Unary,
+  I: GetNotificationRulesRequest,
+  O: GetNotificationRulesResponse,
+      idempotency: MethodIdempotency.NoSideEffects,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Deletes a notification rule
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.DeleteNotificationRule
+ */
+export const deleteNotificationRule = {
+  localName: "deleteNotificationRule",
+  name: "DeleteNotificationRule",
+  kind: MethodKind.Unary,
+  I: DeleteNotificationRuleRequest,
+  O: DeleteNotificationRuleResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Returns the delivery history of an account's notification rules
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.GetNotificationDeliveries
+ */
+export const getNotificationDeliveries = {
+  localName: "getNotificationDeliveries",
+  name: "GetNotificationDeliveries",
+  kind: MethodKind.Unary,
+  I: GetNotificationDeliveriesRequest,
+  O: GetNotificationDeliveriesResponse,
+      idempotency: MethodIdempotency.NoSideEffects,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
+
+/**
+ * Delivers a job run event to every rule that matches it. Called by the worker as a job run progresses
+ *
+ * @generated from rpc mgmt.v1alpha1.NotificationService.PublishJobRunEvent
+ */
+export const publishJobRunEvent = {
+  localName: "publishJobRunEvent",
+  name: "PublishJobRunEvent",
+  kind: MethodKind.Unary,
+  I: PublishJobRunEventRequest,
+  O: PublishJobRunEventResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.NotificationService"
+  }
+} as const;
@@ -0,0 +1,76 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/notification.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CreateNotificationRuleRequest, CreateNotificationRuleResponse, DeleteNotificationRuleRequest, DeleteNotificationRuleResponse, GetNotificationDeliveriesRequest, GetNotificationDeliveriesResponse, GetNotificationRulesRequest, GetNotificationRulesResponse, PublishJobRunEventRequest, PublishJobRunEventResponse } from "./notification_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * Service that manages notification rules and delivers job run lifecycle events as webhooks
+ *
+ * @generated from service mgmt.v1alpha1.NotificationService
+ */
+export const NotificationService = {
+  typeName: "mgmt.v1alpha1.NotificationService",
+  methods: {
+    /**
+     * Creates a rule that delivers job run events to a webhook
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.CreateNotificationRule
+     */
+    createNotificationRule: {
+      name: "CreateNotificationRule",
+      I: CreateNotificationRuleRequest,
+      O: CreateNotificationRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the notification rules of an account
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.GetNotificationRules
+     */
+    getNotificationRules: {
+      name: "GetNotificationRules",
+      I: GetNotificationRulesRequest,
+      O: GetNotificationRulesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Deletes a notification rule
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.DeleteNotificationRule
+     */
+    deleteNotificationRule: {
+      name: "DeleteNotificationRule",
+      I: DeleteNotificationRuleRequest,
+      O: DeleteNotificationRuleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the delivery history of an account's notification rules
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.GetNotificationDeliveries
+     */
+    getNotificationDeliveries: {
+      name: "GetNotificationDeliveries",
+      I: GetNotificationDeliveriesRequest,
+      O: GetNotificationDeliveriesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Delivers a job run event to every rule that matches it. Called by the worker as a job run progresses
+     *
+     * @generated from rpc mgmt.v1alpha1.NotificationService.PublishJobRunEvent
+     */
+    publishJobRunEvent: {
+      name: "PublishJobRunEvent",
+      I: PublishJobRunEventRequest,
+      O: PublishJobRunEventResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
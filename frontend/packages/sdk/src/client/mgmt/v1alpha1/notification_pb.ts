@@ -0,0 +1,742 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/notification.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum mgmt.v1alpha1.JobRunEventType
+ */
+export enum JobRunEventType {
+  /**
+   * The event type is unknown
+   *
+   * @generated from enum value: JOB_RUN_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The job run has started
+   *
+   * @generated from enum value: JOB_RUN_EVENT_TYPE_STARTED = 1;
+   */
+  STARTED = 1,
+
+  /**
+   * The job run completed successfully
+   *
+   * @generated from enum value: JOB_RUN_EVENT_TYPE_SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * The job run ended with an error
+   *
+   * @generated from enum value: JOB_RUN_EVENT_TYPE_FAILED = 3;
+   */
+  FAILED = 3,
+
+  /**
+   * The job run was canceled
+   *
+   * @generated from enum value: JOB_RUN_EVENT_TYPE_CANCELED = 4;
+   */
+  CANCELED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(JobRunEventType)
+proto3.util.setEnumType(JobRunEventType, "mgmt.v1alpha1.JobRunEventType", [
+  { no: 0, name: "JOB_RUN_EVENT_TYPE_UNSPECIFIED" },
+  { no: 1, name: "JOB_RUN_EVENT_TYPE_STARTED" },
+  { no: 2, name: "JOB_RUN_EVENT_TYPE_SUCCEEDED" },
+  { no: 3, name: "JOB_RUN_EVENT_TYPE_FAILED" },
+  { no: 4, name: "JOB_RUN_EVENT_TYPE_CANCELED" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.NotificationPayloadFormat
+ */
+export enum NotificationPayloadFormat {
+  /**
+   * Defaults to JSON
+   *
+   * @generated from enum value: NOTIFICATION_PAYLOAD_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The event is delivered as a JSON document
+   *
+   * @generated from enum value: NOTIFICATION_PAYLOAD_FORMAT_JSON = 1;
+   */
+  JSON = 1,
+
+  /**
+   * The event is delivered as a Slack incoming webhook message
+   *
+   * @generated from enum value: NOTIFICATION_PAYLOAD_FORMAT_SLACK = 2;
+   */
+  SLACK = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(NotificationPayloadFormat)
+proto3.util.setEnumType(NotificationPayloadFormat, "mgmt.v1alpha1.NotificationPayloadFormat", [
+  { no: 0, name: "NOTIFICATION_PAYLOAD_FORMAT_UNSPECIFIED" },
+  { no: 1, name: "NOTIFICATION_PAYLOAD_FORMAT_JSON" },
+  { no: 2, name: "NOTIFICATION_PAYLOAD_FORMAT_SLACK" },
+]);
+
+/**
+ * @generated from message mgmt.v1alpha1.NotificationRule
+ */
+export class NotificationRule extends Message<NotificationRule> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * The job the rule is scoped to. If not provided, the rule applies to every job in the account
+   *
+   * @generated from field: optional string job_id = 3;
+   */
+  jobId?: string;
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name = "";
+
+  /**
+   * The URL that the webhook is delivered to
+   *
+   * @generated from field: string url = 5;
+   */
+  url = "";
+
+  /**
+   * The job run events that trigger a delivery
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobRunEventType event_types = 6;
+   */
+  eventTypes: JobRunEventType[] = [];
+
+  /**
+   * @generated from field: mgmt.v1alpha1.NotificationPayloadFormat payload_format = 7;
+   */
+  payloadFormat = NotificationPayloadFormat.UNSPECIFIED;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 9;
+   */
+  updatedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<NotificationRule>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.NotificationRule";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "event_types", kind: "enum", T: proto3.getEnumType(JobRunEventType), repeated: true },
+    { no: 7, name: "payload_format", kind: "enum", T: proto3.getEnumType(NotificationPayloadFormat) },
+    { no: 8, name: "created_at", kind: "message", T: Timestamp },
+    { no: 9, name: "updated_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NotificationRule {
+    return new NotificationRule().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NotificationRule {
+    return new NotificationRule().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NotificationRule {
+    return new NotificationRule().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NotificationRule | PlainMessage<NotificationRule> | undefined, b: NotificationRule | PlainMessage<NotificationRule> | undefined): boolean {
+    return proto3.util.equals(NotificationRule, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CreateNotificationRuleRequest
+ */
+export class CreateNotificationRuleRequest extends Message<CreateNotificationRuleRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Scopes the rule to a single job. If not provided, the rule applies to every job in the account
+   *
+   * @generated from field: optional string job_id = 2;
+   */
+  jobId?: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * The URL that the webhook is delivered to. Must be http or https
+   *
+   * @generated from field: string url = 4;
+   */
+  url = "";
+
+  /**
+   * The job run events that trigger a delivery
+   *
+   * @generated from field: repeated mgmt.v1alpha1.JobRunEventType event_types = 5;
+   */
+  eventTypes: JobRunEventType[] = [];
+
+  /**
+   * @generated from field: mgmt.v1alpha1.NotificationPayloadFormat payload_format = 6;
+   */
+  payloadFormat = NotificationPayloadFormat.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<CreateNotificationRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CreateNotificationRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "event_types", kind: "enum", T: proto3.getEnumType(JobRunEventType), repeated: true },
+    { no: 6, name: "payload_format", kind: "enum", T: proto3.getEnumType(NotificationPayloadFormat) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateNotificationRuleRequest {
+    return new CreateNotificationRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateNotificationRuleRequest {
+    return new CreateNotificationRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateNotificationRuleRequest {
+    return new CreateNotificationRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateNotificationRuleRequest | PlainMessage<CreateNotificationRuleRequest> | undefined, b: CreateNotificationRuleRequest | PlainMessage<CreateNotificationRuleRequest> | undefined): boolean {
+    return proto3.util.equals(CreateNotificationRuleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CreateNotificationRuleResponse
+ */
+export class CreateNotificationRuleResponse extends Message<CreateNotificationRuleResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.NotificationRule rule = 1;
+   */
+  rule?: NotificationRule;
+
+  /**
+   * The secret that each delivery is signed with. This is only returned when the rule is created
+   *
+   * @generated from field: string signing_secret = 2;
+   */
+  signingSecret = "";
+
+  constructor(data?: PartialMessage<CreateNotificationRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CreateNotificationRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rule", kind: "message", T: NotificationRule },
+    { no: 2, name: "signing_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateNotificationRuleResponse {
+    return new CreateNotificationRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateNotificationRuleResponse {
+    return new CreateNotificationRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateNotificationRuleResponse {
+    return new CreateNotificationRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateNotificationRuleResponse | PlainMessage<CreateNotificationRuleResponse> | undefined, b: CreateNotificationRuleResponse | PlainMessage<CreateNotificationRuleResponse> | undefined): boolean {
+    return proto3.util.equals(CreateNotificationRuleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetNotificationRulesRequest
+ */
+export class GetNotificationRulesRequest extends Message<GetNotificationRulesRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Only return the rules that apply to this job, including the account wide rules
+   *
+   * @generated from field: optional string job_id = 2;
+   */
+  jobId?: string;
+
+  constructor(data?: PartialMessage<GetNotificationRulesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetNotificationRulesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNotificationRulesRequest {
+    return new GetNotificationRulesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetNotificationRulesRequest {
+    return new GetNotificationRulesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetNotificationRulesRequest {
+    return new GetNotificationRulesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetNotificationRulesRequest | PlainMessage<GetNotificationRulesRequest> | undefined, b: GetNotificationRulesRequest | PlainMessage<GetNotificationRulesRequest> | undefined): boolean {
+    return proto3.util.equals(GetNotificationRulesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetNotificationRulesResponse
+ */
+export class GetNotificationRulesResponse extends Message<GetNotificationRulesResponse> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.NotificationRule rules = 1;
+   */
+  rules: NotificationRule[] = [];
+
+  constructor(data?: PartialMessage<GetNotificationRulesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetNotificationRulesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rules", kind: "message", T: NotificationRule, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNotificationRulesResponse {
+    return new GetNotificationRulesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetNotificationRulesResponse {
+    return new GetNotificationRulesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetNotificationRulesResponse {
+    return new GetNotificationRulesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetNotificationRulesResponse | PlainMessage<GetNotificationRulesResponse> | undefined, b: GetNotificationRulesResponse | PlainMessage<GetNotificationRulesResponse> | undefined): boolean {
+    return proto3.util.equals(GetNotificationRulesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeleteNotificationRuleRequest
+ */
+export class DeleteNotificationRuleRequest extends Message<DeleteNotificationRuleRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteNotificationRuleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeleteNotificationRuleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteNotificationRuleRequest {
+    return new DeleteNotificationRuleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteNotificationRuleRequest {
+    return new DeleteNotificationRuleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteNotificationRuleRequest {
+    return new DeleteNotificationRuleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteNotificationRuleRequest | PlainMessage<DeleteNotificationRuleRequest> | undefined, b: DeleteNotificationRuleRequest | PlainMessage<DeleteNotificationRuleRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteNotificationRuleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeleteNotificationRuleResponse
+ */
+export class DeleteNotificationRuleResponse extends Message<DeleteNotificationRuleResponse> {
+  constructor(data?: PartialMessage<DeleteNotificationRuleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeleteNotificationRuleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteNotificationRuleResponse {
+    return new DeleteNotificationRuleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteNotificationRuleResponse {
+    return new DeleteNotificationRuleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteNotificationRuleResponse {
+    return new DeleteNotificationRuleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteNotificationRuleResponse | PlainMessage<DeleteNotificationRuleResponse> | undefined, b: DeleteNotificationRuleResponse | PlainMessage<DeleteNotificationRuleResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteNotificationRuleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.NotificationDelivery
+ */
+export class NotificationDelivery extends Message<NotificationDelivery> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string rule_id = 2;
+   */
+  ruleId = "";
+
+  /**
+   * @generated from field: string job_id = 3;
+   */
+  jobId = "";
+
+  /**
+   * @generated from field: string job_run_id = 4;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobRunEventType event_type = 5;
+   */
+  eventType = JobRunEventType.UNSPECIFIED;
+
+  /**
+   * True if the receiver responded with a 2xx status code
+   *
+   * @generated from field: bool succeeded = 6;
+   */
+  succeeded = false;
+
+  /**
+   * The number of times delivery was attempted
+   *
+   * @generated from field: uint32 attempts = 7;
+   */
+  attempts = 0;
+
+  /**
+   * The status code of the last response, if a response was received
+   *
+   * @generated from field: optional int32 response_status_code = 8;
+   */
+  responseStatusCode?: number;
+
+  /**
+   * The error of the last attempt if the delivery did not succeed
+   *
+   * @generated from field: optional string error = 9;
+   */
+  error?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  constructor(data?: PartialMessage<NotificationDelivery>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.NotificationDelivery";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "event_type", kind: "enum", T: proto3.getEnumType(JobRunEventType) },
+    { no: 6, name: "succeeded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "attempts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "response_status_code", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
+    { no: 9, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 10, name: "created_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NotificationDelivery {
+    return new NotificationDelivery().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NotificationDelivery {
+    return new NotificationDelivery().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NotificationDelivery {
+    return new NotificationDelivery().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NotificationDelivery | PlainMessage<NotificationDelivery> | undefined, b: NotificationDelivery | PlainMessage<NotificationDelivery> | undefined): boolean {
+    return proto3.util.equals(NotificationDelivery, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetNotificationDeliveriesRequest
+ */
+export class GetNotificationDeliveriesRequest extends Message<GetNotificationDeliveriesRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Only return deliveries for this rule
+   *
+   * @generated from field: optional string rule_id = 2;
+   */
+  ruleId?: string;
+
+  /**
+   * Only return deliveries for this job run
+   *
+   * @generated from field: optional string job_run_id = 3;
+   */
+  jobRunId?: string;
+
+  /**
+   * The maximum number of deliveries to return. Defaults to 100
+   *
+   * @generated from field: optional uint32 limit = 4;
+   */
+  limit?: number;
+
+  constructor(data?: PartialMessage<GetNotificationDeliveriesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetNotificationDeliveriesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "rule_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "limit", kind: "scalar", T: 13 /* ScalarType.UINT32 */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNotificationDeliveriesRequest {
+    return new GetNotificationDeliveriesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetNotificationDeliveriesRequest {
+    return new GetNotificationDeliveriesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetNotificationDeliveriesRequest {
+    return new GetNotificationDeliveriesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetNotificationDeliveriesRequest | PlainMessage<GetNotificationDeliveriesRequest> | undefined, b: GetNotificationDeliveriesRequest | PlainMessage<GetNotificationDeliveriesRequest> | undefined): boolean {
+    return proto3.util.equals(GetNotificationDeliveriesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetNotificationDeliveriesResponse
+ */
+export class GetNotificationDeliveriesResponse extends Message<GetNotificationDeliveriesResponse> {
+  /**
+   * The deliveries, ordered by most recent first
+   *
+   * @generated from field: repeated mgmt.v1alpha1.NotificationDelivery deliveries = 1;
+   */
+  deliveries: NotificationDelivery[] = [];
+
+  constructor(data?: PartialMessage<GetNotificationDeliveriesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetNotificationDeliveriesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deliveries", kind: "message", T: NotificationDelivery, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetNotificationDeliveriesResponse {
+    return new GetNotificationDeliveriesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetNotificationDeliveriesResponse {
+    return new GetNotificationDeliveriesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetNotificationDeliveriesResponse {
+    return new GetNotificationDeliveriesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetNotificationDeliveriesResponse | PlainMessage<GetNotificationDeliveriesResponse> | undefined, b: GetNotificationDeliveriesResponse | PlainMessage<GetNotificationDeliveriesResponse> | undefined): boolean {
+    return proto3.util.equals(GetNotificationDeliveriesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.PublishJobRunEventRequest
+ */
+export class PublishJobRunEventRequest extends Message<PublishJobRunEventRequest> {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * @generated from field: string job_run_id = 2;
+   */
+  jobRunId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobRunEventType event_type = 3;
+   */
+  eventType = JobRunEventType.UNSPECIFIED;
+
+  /**
+   * The error that caused the run to fail
+   *
+   * @generated from field: optional string error_message = 4;
+   */
+  errorMessage?: string;
+
+  constructor(data?: PartialMessage<PublishJobRunEventRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.PublishJobRunEventRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "job_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "event_type", kind: "enum", T: proto3.getEnumType(JobRunEventType) },
+    { no: 4, name: "error_message", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishJobRunEventRequest {
+    return new PublishJobRunEventRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PublishJobRunEventRequest {
+    return new PublishJobRunEventRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PublishJobRunEventRequest {
+    return new PublishJobRunEventRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PublishJobRunEventRequest | PlainMessage<PublishJobRunEventRequest> | undefined, b: PublishJobRunEventRequest | PlainMessage<PublishJobRunEventRequest> | undefined): boolean {
+    return proto3.util.equals(PublishJobRunEventRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.PublishJobRunEventResponse
+ */
+export class PublishJobRunEventResponse extends Message<PublishJobRunEventResponse> {
+  /**
+   * The deliveries that were made for the event
+   *
+   * @generated from field: repeated mgmt.v1alpha1.NotificationDelivery deliveries = 1;
+   */
+  deliveries: NotificationDelivery[] = [];
+
+  constructor(data?: PartialMessage<PublishJobRunEventResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.PublishJobRunEventResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deliveries", kind: "message", T: NotificationDelivery, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PublishJobRunEventResponse {
+    return new PublishJobRunEventResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PublishJobRunEventResponse {
+    return new PublishJobRunEventResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PublishJobRunEventResponse {
+    return new PublishJobRunEventResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PublishJobRunEventResponse | PlainMessage<PublishJobRunEventResponse> | undefined, b: PublishJobRunEventResponse | PlainMessage<PublishJobRunEventResponse> | undefined): boolean {
+    return proto3.util.equals(PublishJobRunEventResponse, a, b);
+  }
+}
+
@@ -0,0 +1,138 @@
+// @generated by protoc-gen-connect-query v1.4.2 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/pipeline.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+import { CancelPipelineRunRequest, CancelPipelineRunResponse, CreatePipelineRequest, CreatePipelineResponse, CreatePipelineRunRequest, CreatePipelineRunResponse, DeletePipelineRequest, DeletePipelineResponse, GetPipelineRequest, GetPipelineResponse, GetPipelineRunsRequest, GetPipelineRunsResponse, GetPipelinesRequest, GetPipelinesResponse, UpdatePipelineRequest, UpdatePipelineResponse } from "./pipeline_pb.js";
+
+/**
+ * Creates a pipeline
+ *
+ * @generated from rpc mgmt.v1alpha1.PipelineService.CreatePipeline
+ */
+export const createPipeline = {
+  localName: "createPipeline",
+  name: "CreatePipeline",
+  kind: MethodKind.Unary,
+  I: CreatePipelineRequest,
+  O: CreatePipelineResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.PipelineService"
+  }
+} as const;
+
+/**
+ * Returns the pipelines of an account
+ *
+ * @generated from rpc mgmt.v1alpha1.PipelineService.GetPipelines
+ */
+export const getPipelines = {
+  localName: "getPipelines",
+  name: "GetPipelines",
+  kind: MethodKind.Unary,
+  I: GetPipelinesRequest,
+  O: GetPipelinesResponse,
+      idempotency: MethodIdempotency.NoSideEffects,
+  service: {
+    typeName: "mgmt.v1alpha1.PipelineService"
+  }
+} as const;
+
+/**
+ * Returns a single pipeline
+ *
+ * @generated from rpc mgmt.v1alpha1.PipelineService.GetPipeline
+ */
+export const getPipeline = {
+  localName: "getPipeline",
+  name: "GetPipeline",
+  kind: MethodKind.Unary,
+  I: GetPipelineRequest,
+  O: GetPipelineResponse,
+      idempotency: MethodIdempotency.NoSideEffects,
+  service: {
+    typeName: "mgmt.v1alpha1.PipelineService"
+  }
+} as const;
+
+/**
+ * Replaces the name, steps, and schedule of a pipeline
+ *
+ * @generated from rpc mgmt.v1alpha1.PipelineService.UpdatePipeline
+ */
+export const updatePipeline = {
+  localName: "updatePipeline",
+  name: "UpdatePipeline",
+  kind: MethodKind.Unary,
+  I: UpdatePipelineRequest,
+  O: UpdatePipelineResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.PipelineService"
+  }
+} as const;
+
+/**
+ * Deletes a pipeline and its schedule. The jobs of the pipeline are not deleted
+ *
+ * @generated from rpc mgmt.v1alpha1.PipelineService.DeletePipeline
+ */
+export const deletePipeline = {
+  localName: "deletePipeline",
+  name: "DeletePipeline",
+  kind: MethodKind.Unary,
+  I: DeletePipelineRequest,
+  O: DeletePipelineResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.PipelineService"
+  }
+} as const;
+
+/**
+ * Starts a run of the pipeline
+ *
+ * @generated from rpc mgmt.v1alpha1.PipelineService.CreatePipelineRun
+ */
+export const createPipelineRun = {
+  localName: "createPipelineRun",
+  name: "CreatePipelineRun",
+  kind: MethodKind.Unary,
+  I: CreatePipelineRunRequest,
+  O: CreatePipelineRunResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.PipelineService"
+  }
+} as const;
+
+/**
+ * Returns the runs of a pipeline
+ *
+ * @generated from rpc mgmt.v1alpha1.PipelineService.GetPipelineRuns
+ */
+export const getPipelineRuns = {
+  localName: "getPipelineRuns",
+  name: "GetPipelineRuns",
+  kind: MethodKind.Unary,
+  I: GetPipelineRunsRequest,
+  O: GetPipelineRunsResponse,
+      idempotency: MethodIdempotency.NoSideEffects,
+  service: {
+    typeName: "mgmt.v1alpha1.PipelineService"
+  }
+} as const;
+
+/**
+ * Cancels a pipeline run. The job run of the current step is canceled as well
+ *
+ * @generated from rpc mgmt.v1alpha1.PipelineService.CancelPipelineRun
+ */
+export const cancelPipelineRun = {
+  localName: "cancelPipelineRun",
+  name: "CancelPipelineRun",
+  kind: MethodKind.Unary,
+  I: CancelPipelineRunRequest,
+  O: CancelPipelineRunResponse,
+  service: {
+    typeName: "mgmt.v1alpha1.PipelineService"
+  }
+} as const;
@@ -0,0 +1,111 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/pipeline.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { CancelPipelineRunRequest, CancelPipelineRunResponse, CreatePipelineRequest, CreatePipelineResponse, CreatePipelineRunRequest, CreatePipelineRunResponse, DeletePipelineRequest, DeletePipelineResponse, GetPipelineRequest, GetPipelineResponse, GetPipelineRunsRequest, GetPipelineRunsResponse, GetPipelinesRequest, GetPipelinesResponse, UpdatePipelineRequest, UpdatePipelineResponse } from "./pipeline_pb.js";
+import { MethodIdempotency, MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * Service that manages pipelines, which run a sequence of jobs one after another.
+ * The job runs that a pipeline starts are regular job runs and are returned by JobService.GetJobRuns
+ *
+ * @generated from service mgmt.v1alpha1.PipelineService
+ */
+export const PipelineService = {
+  typeName: "mgmt.v1alpha1.PipelineService",
+  methods: {
+    /**
+     * Creates a pipeline
+     *
+     * @generated from rpc mgmt.v1alpha1.PipelineService.CreatePipeline
+     */
+    createPipeline: {
+      name: "CreatePipeline",
+      I: CreatePipelineRequest,
+      O: CreatePipelineResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the pipelines of an account
+     *
+     * @generated from rpc mgmt.v1alpha1.PipelineService.GetPipelines
+     */
+    getPipelines: {
+      name: "GetPipelines",
+      I: GetPipelinesRequest,
+      O: GetPipelinesResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Returns a single pipeline
+     *
+     * @generated from rpc mgmt.v1alpha1.PipelineService.GetPipeline
+     */
+    getPipeline: {
+      name: "GetPipeline",
+      I: GetPipelineRequest,
+      O: GetPipelineResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Replaces the name, steps, and schedule of a pipeline
+     *
+     * @generated from rpc mgmt.v1alpha1.PipelineService.UpdatePipeline
+     */
+    updatePipeline: {
+      name: "UpdatePipeline",
+      I: UpdatePipelineRequest,
+      O: UpdatePipelineResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Deletes a pipeline and its schedule. The jobs of the pipeline are not deleted
+     *
+     * @generated from rpc mgmt.v1alpha1.PipelineService.DeletePipeline
+     */
+    deletePipeline: {
+      name: "DeletePipeline",
+      I: DeletePipelineRequest,
+      O: DeletePipelineResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Starts a run of the pipeline
+     *
+     * @generated from rpc mgmt.v1alpha1.PipelineService.CreatePipelineRun
+     */
+    createPipelineRun: {
+      name: "CreatePipelineRun",
+      I: CreatePipelineRunRequest,
+      O: CreatePipelineRunResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the runs of a pipeline
+     *
+     * @generated from rpc mgmt.v1alpha1.PipelineService.GetPipelineRuns
+     */
+    getPipelineRuns: {
+      name: "GetPipelineRuns",
+      I: GetPipelineRunsRequest,
+      O: GetPipelineRunsResponse,
+      kind: MethodKind.Unary,
+      idempotency: MethodIdempotency.NoSideEffects,
+    },
+    /**
+     * Cancels a pipeline run. The job run of the current step is canceled as well
+     *
+     * @generated from rpc mgmt.v1alpha1.PipelineService.CancelPipelineRun
+     */
+    cancelPipelineRun: {
+      name: "CancelPipelineRun",
+      I: CancelPipelineRunRequest,
+      O: CancelPipelineRunResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,860 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.js"
+// @generated from file mgmt/v1alpha1/pipeline.proto (package mgmt.v1alpha1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+import { JobRunStatus } from "./job_pb.js";
+
+/**
+ * @generated from enum mgmt.v1alpha1.PipelineStepFailurePolicy
+ */
+export enum PipelineStepFailurePolicy {
+  /**
+   * Defaults to halt
+   *
+   * @generated from enum value: PIPELINE_STEP_FAILURE_POLICY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The pipeline stops and the remaining steps are not run
+   *
+   * @generated from enum value: PIPELINE_STEP_FAILURE_POLICY_HALT = 1;
+   */
+  HALT = 1,
+
+  /**
+   * The pipeline continues with the next step. The pipeline run still ends in failure once every step has run
+   *
+   * @generated from enum value: PIPELINE_STEP_FAILURE_POLICY_CONTINUE = 2;
+   */
+  CONTINUE = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PipelineStepFailurePolicy)
+proto3.util.setEnumType(PipelineStepFailurePolicy, "mgmt.v1alpha1.PipelineStepFailurePolicy", [
+  { no: 0, name: "PIPELINE_STEP_FAILURE_POLICY_UNSPECIFIED" },
+  { no: 1, name: "PIPELINE_STEP_FAILURE_POLICY_HALT" },
+  { no: 2, name: "PIPELINE_STEP_FAILURE_POLICY_CONTINUE" },
+]);
+
+/**
+ * @generated from message mgmt.v1alpha1.PipelineStep
+ */
+export class PipelineStep extends Message<PipelineStep> {
+  /**
+   * The job that is run by this step
+   *
+   * @generated from field: string job_id = 1;
+   */
+  jobId = "";
+
+  /**
+   * What happens to the rest of the pipeline when this step's job run does not succeed
+   *
+   * @generated from field: mgmt.v1alpha1.PipelineStepFailurePolicy failure_policy = 2;
+   */
+  failurePolicy = PipelineStepFailurePolicy.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<PipelineStep>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.PipelineStep";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "job_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "failure_policy", kind: "enum", T: proto3.getEnumType(PipelineStepFailurePolicy) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PipelineStep {
+    return new PipelineStep().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PipelineStep {
+    return new PipelineStep().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PipelineStep {
+    return new PipelineStep().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PipelineStep | PlainMessage<PipelineStep> | undefined, b: PipelineStep | PlainMessage<PipelineStep> | undefined): boolean {
+    return proto3.util.equals(PipelineStep, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.Pipeline
+ */
+export class Pipeline extends Message<Pipeline> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * The steps of the pipeline, run one at a time in order. Each step starts once the previous step's job run has ended
+   *
+   * @generated from field: repeated mgmt.v1alpha1.PipelineStep steps = 4;
+   */
+  steps: PipelineStep[] = [];
+
+  /**
+   * The schedule the pipeline runs on. If not provided, the pipeline only runs when triggered
+   *
+   * @generated from field: optional string cron_schedule = 5;
+   */
+  cronSchedule?: string;
+
+  /**
+   * @generated from field: string created_by_user_id = 6;
+   */
+  createdByUserId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: string updated_by_user_id = 8;
+   */
+  updatedByUserId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 9;
+   */
+  updatedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Pipeline>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.Pipeline";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "steps", kind: "message", T: PipelineStep, repeated: true },
+    { no: 5, name: "cron_schedule", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 6, name: "created_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "created_at", kind: "message", T: Timestamp },
+    { no: 8, name: "updated_by_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "updated_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Pipeline {
+    return new Pipeline().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Pipeline {
+    return new Pipeline().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Pipeline {
+    return new Pipeline().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Pipeline | PlainMessage<Pipeline> | undefined, b: Pipeline | PlainMessage<Pipeline> | undefined): boolean {
+    return proto3.util.equals(Pipeline, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CreatePipelineRequest
+ */
+export class CreatePipelineRequest extends Message<CreatePipelineRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.PipelineStep steps = 3;
+   */
+  steps: PipelineStep[] = [];
+
+  /**
+   * The schedule the pipeline runs on. If not provided, the pipeline only runs when triggered
+   *
+   * @generated from field: optional string cron_schedule = 4;
+   */
+  cronSchedule?: string;
+
+  constructor(data?: PartialMessage<CreatePipelineRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CreatePipelineRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "steps", kind: "message", T: PipelineStep, repeated: true },
+    { no: 4, name: "cron_schedule", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePipelineRequest {
+    return new CreatePipelineRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePipelineRequest {
+    return new CreatePipelineRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePipelineRequest {
+    return new CreatePipelineRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreatePipelineRequest | PlainMessage<CreatePipelineRequest> | undefined, b: CreatePipelineRequest | PlainMessage<CreatePipelineRequest> | undefined): boolean {
+    return proto3.util.equals(CreatePipelineRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CreatePipelineResponse
+ */
+export class CreatePipelineResponse extends Message<CreatePipelineResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.Pipeline pipeline = 1;
+   */
+  pipeline?: Pipeline;
+
+  constructor(data?: PartialMessage<CreatePipelineResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CreatePipelineResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pipeline", kind: "message", T: Pipeline },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePipelineResponse {
+    return new CreatePipelineResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePipelineResponse {
+    return new CreatePipelineResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePipelineResponse {
+    return new CreatePipelineResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreatePipelineResponse | PlainMessage<CreatePipelineResponse> | undefined, b: CreatePipelineResponse | PlainMessage<CreatePipelineResponse> | undefined): boolean {
+    return proto3.util.equals(CreatePipelineResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetPipelinesRequest
+ */
+export class GetPipelinesRequest extends Message<GetPipelinesRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<GetPipelinesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetPipelinesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPipelinesRequest {
+    return new GetPipelinesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPipelinesRequest {
+    return new GetPipelinesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPipelinesRequest {
+    return new GetPipelinesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPipelinesRequest | PlainMessage<GetPipelinesRequest> | undefined, b: GetPipelinesRequest | PlainMessage<GetPipelinesRequest> | undefined): boolean {
+    return proto3.util.equals(GetPipelinesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetPipelinesResponse
+ */
+export class GetPipelinesResponse extends Message<GetPipelinesResponse> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.Pipeline pipelines = 1;
+   */
+  pipelines: Pipeline[] = [];
+
+  constructor(data?: PartialMessage<GetPipelinesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetPipelinesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pipelines", kind: "message", T: Pipeline, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPipelinesResponse {
+    return new GetPipelinesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPipelinesResponse {
+    return new GetPipelinesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPipelinesResponse {
+    return new GetPipelinesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPipelinesResponse | PlainMessage<GetPipelinesResponse> | undefined, b: GetPipelinesResponse | PlainMessage<GetPipelinesResponse> | undefined): boolean {
+    return proto3.util.equals(GetPipelinesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetPipelineRequest
+ */
+export class GetPipelineRequest extends Message<GetPipelineRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetPipelineRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetPipelineRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPipelineRequest {
+    return new GetPipelineRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPipelineRequest {
+    return new GetPipelineRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPipelineRequest {
+    return new GetPipelineRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPipelineRequest | PlainMessage<GetPipelineRequest> | undefined, b: GetPipelineRequest | PlainMessage<GetPipelineRequest> | undefined): boolean {
+    return proto3.util.equals(GetPipelineRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetPipelineResponse
+ */
+export class GetPipelineResponse extends Message<GetPipelineResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.Pipeline pipeline = 1;
+   */
+  pipeline?: Pipeline;
+
+  constructor(data?: PartialMessage<GetPipelineResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetPipelineResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pipeline", kind: "message", T: Pipeline },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPipelineResponse {
+    return new GetPipelineResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPipelineResponse {
+    return new GetPipelineResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPipelineResponse {
+    return new GetPipelineResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPipelineResponse | PlainMessage<GetPipelineResponse> | undefined, b: GetPipelineResponse | PlainMessage<GetPipelineResponse> | undefined): boolean {
+    return proto3.util.equals(GetPipelineResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.UpdatePipelineRequest
+ */
+export class UpdatePipelineRequest extends Message<UpdatePipelineRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.PipelineStep steps = 3;
+   */
+  steps: PipelineStep[] = [];
+
+  /**
+   * The schedule the pipeline runs on. If not provided, the schedule is removed and the pipeline only runs when triggered
+   *
+   * @generated from field: optional string cron_schedule = 4;
+   */
+  cronSchedule?: string;
+
+  constructor(data?: PartialMessage<UpdatePipelineRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.UpdatePipelineRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "steps", kind: "message", T: PipelineStep, repeated: true },
+    { no: 4, name: "cron_schedule", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePipelineRequest {
+    return new UpdatePipelineRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdatePipelineRequest {
+    return new UpdatePipelineRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdatePipelineRequest {
+    return new UpdatePipelineRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdatePipelineRequest | PlainMessage<UpdatePipelineRequest> | undefined, b: UpdatePipelineRequest | PlainMessage<UpdatePipelineRequest> | undefined): boolean {
+    return proto3.util.equals(UpdatePipelineRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.UpdatePipelineResponse
+ */
+export class UpdatePipelineResponse extends Message<UpdatePipelineResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.Pipeline pipeline = 1;
+   */
+  pipeline?: Pipeline;
+
+  constructor(data?: PartialMessage<UpdatePipelineResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.UpdatePipelineResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pipeline", kind: "message", T: Pipeline },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdatePipelineResponse {
+    return new UpdatePipelineResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdatePipelineResponse {
+    return new UpdatePipelineResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdatePipelineResponse {
+    return new UpdatePipelineResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdatePipelineResponse | PlainMessage<UpdatePipelineResponse> | undefined, b: UpdatePipelineResponse | PlainMessage<UpdatePipelineResponse> | undefined): boolean {
+    return proto3.util.equals(UpdatePipelineResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeletePipelineRequest
+ */
+export class DeletePipelineRequest extends Message<DeletePipelineRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeletePipelineRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeletePipelineRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeletePipelineRequest {
+    return new DeletePipelineRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeletePipelineRequest {
+    return new DeletePipelineRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeletePipelineRequest {
+    return new DeletePipelineRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeletePipelineRequest | PlainMessage<DeletePipelineRequest> | undefined, b: DeletePipelineRequest | PlainMessage<DeletePipelineRequest> | undefined): boolean {
+    return proto3.util.equals(DeletePipelineRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.DeletePipelineResponse
+ */
+export class DeletePipelineResponse extends Message<DeletePipelineResponse> {
+  constructor(data?: PartialMessage<DeletePipelineResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.DeletePipelineResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeletePipelineResponse {
+    return new DeletePipelineResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeletePipelineResponse {
+    return new DeletePipelineResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeletePipelineResponse {
+    return new DeletePipelineResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeletePipelineResponse | PlainMessage<DeletePipelineResponse> | undefined, b: DeletePipelineResponse | PlainMessage<DeletePipelineResponse> | undefined): boolean {
+    return proto3.util.equals(DeletePipelineResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CreatePipelineRunRequest
+ */
+export class CreatePipelineRunRequest extends Message<CreatePipelineRunRequest> {
+  /**
+   * @generated from field: string pipeline_id = 1;
+   */
+  pipelineId = "";
+
+  constructor(data?: PartialMessage<CreatePipelineRunRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CreatePipelineRunRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pipeline_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePipelineRunRequest {
+    return new CreatePipelineRunRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePipelineRunRequest {
+    return new CreatePipelineRunRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePipelineRunRequest {
+    return new CreatePipelineRunRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreatePipelineRunRequest | PlainMessage<CreatePipelineRunRequest> | undefined, b: CreatePipelineRunRequest | PlainMessage<CreatePipelineRunRequest> | undefined): boolean {
+    return proto3.util.equals(CreatePipelineRunRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CreatePipelineRunResponse
+ */
+export class CreatePipelineRunResponse extends Message<CreatePipelineRunResponse> {
+  constructor(data?: PartialMessage<CreatePipelineRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CreatePipelineRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreatePipelineRunResponse {
+    return new CreatePipelineRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreatePipelineRunResponse {
+    return new CreatePipelineRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreatePipelineRunResponse {
+    return new CreatePipelineRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreatePipelineRunResponse | PlainMessage<CreatePipelineRunResponse> | undefined, b: CreatePipelineRunResponse | PlainMessage<CreatePipelineRunResponse> | undefined): boolean {
+    return proto3.util.equals(CreatePipelineRunResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.PipelineRun
+ */
+export class PipelineRun extends Message<PipelineRun> {
+  /**
+   * The id of the pipeline run. This is the temporal workflow id of the parent workflow
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string pipeline_id = 2;
+   */
+  pipelineId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.JobRunStatus status = 3;
+   */
+  status = JobRunStatus.UNSPECIFIED;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 4;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp completed_at = 5;
+   */
+  completedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<PipelineRun>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.PipelineRun";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "pipeline_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status", kind: "enum", T: proto3.getEnumType(JobRunStatus) },
+    { no: 4, name: "started_at", kind: "message", T: Timestamp },
+    { no: 5, name: "completed_at", kind: "message", T: Timestamp, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PipelineRun {
+    return new PipelineRun().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PipelineRun {
+    return new PipelineRun().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PipelineRun {
+    return new PipelineRun().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PipelineRun | PlainMessage<PipelineRun> | undefined, b: PipelineRun | PlainMessage<PipelineRun> | undefined): boolean {
+    return proto3.util.equals(PipelineRun, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetPipelineRunsRequest
+ */
+export class GetPipelineRunsRequest extends Message<GetPipelineRunsRequest> {
+  /**
+   * @generated from field: string pipeline_id = 1;
+   */
+  pipelineId = "";
+
+  constructor(data?: PartialMessage<GetPipelineRunsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetPipelineRunsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pipeline_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPipelineRunsRequest {
+    return new GetPipelineRunsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPipelineRunsRequest {
+    return new GetPipelineRunsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPipelineRunsRequest {
+    return new GetPipelineRunsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPipelineRunsRequest | PlainMessage<GetPipelineRunsRequest> | undefined, b: GetPipelineRunsRequest | PlainMessage<GetPipelineRunsRequest> | undefined): boolean {
+    return proto3.util.equals(GetPipelineRunsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetPipelineRunsResponse
+ */
+export class GetPipelineRunsResponse extends Message<GetPipelineRunsResponse> {
+  /**
+   * @generated from field: repeated mgmt.v1alpha1.PipelineRun runs = 1;
+   */
+  runs: PipelineRun[] = [];
+
+  constructor(data?: PartialMessage<GetPipelineRunsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetPipelineRunsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "runs", kind: "message", T: PipelineRun, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPipelineRunsResponse {
+    return new GetPipelineRunsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPipelineRunsResponse {
+    return new GetPipelineRunsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPipelineRunsResponse {
+    return new GetPipelineRunsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPipelineRunsResponse | PlainMessage<GetPipelineRunsResponse> | undefined, b: GetPipelineRunsResponse | PlainMessage<GetPipelineRunsResponse> | undefined): boolean {
+    return proto3.util.equals(GetPipelineRunsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CancelPipelineRunRequest
+ */
+export class CancelPipelineRunRequest extends Message<CancelPipelineRunRequest> {
+  /**
+   * @generated from field: string pipeline_id = 1;
+   */
+  pipelineId = "";
+
+  /**
+   * @generated from field: string pipeline_run_id = 2;
+   */
+  pipelineRunId = "";
+
+  constructor(data?: PartialMessage<CancelPipelineRunRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CancelPipelineRunRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "pipeline_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "pipeline_run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelPipelineRunRequest {
+    return new CancelPipelineRunRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelPipelineRunRequest {
+    return new CancelPipelineRunRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelPipelineRunRequest {
+    return new CancelPipelineRunRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelPipelineRunRequest | PlainMessage<CancelPipelineRunRequest> | undefined, b: CancelPipelineRunRequest | PlainMessage<CancelPipelineRunRequest> | undefined): boolean {
+    return proto3.util.equals(CancelPipelineRunRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.CancelPipelineRunResponse
+ */
+export class CancelPipelineRunResponse extends Message<CancelPipelineRunResponse> {
+  constructor(data?: PartialMessage<CancelPipelineRunResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.CancelPipelineRunResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelPipelineRunResponse {
+    return new CancelPipelineRunResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelPipelineRunResponse {
+    return new CancelPipelineRunResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelPipelineRunResponse {
+    return new CancelPipelineRunResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelPipelineRunResponse | PlainMessage<CancelPipelineRunResponse> | undefined, b: CancelPipelineRunResponse | PlainMessage<CancelPipelineRunResponse> | undefined): boolean {
+    return proto3.util.equals(CancelPipelineRunResponse, a, b);
+  }
+}
+
@@ -246,6 +246,11 @@ export enum TransformerSource {
    * @generated from enum value: TRANSFORMER_SOURCE_TRANSFORM_PII_TEXT = 47;
    */
   TRANSFORM_PII_TEXT = 47,
+
+  /**
+   * @generated from enum value: TRANSFORMER_SOURCE_TRANSFORM_FPE = 48;
+   */
+  TRANSFORM_FPE = 48,
 }
 // Retrieve enum metadata with: proto3.getEnumType(TransformerSource)
 proto3.util.setEnumType(TransformerSource, "mgmt.v1alpha1.TransformerSource", [
@@ -296,6 +301,7 @@ proto3.util.setEnumType(TransformerSource, "mgmt.v1alpha1.TransformerSource", [
   { no: 45, name: "TRANSFORMER_SOURCE_GENERATE_JAVASCRIPT" },
   { no: 46, name: "TRANSFORMER_SOURCE_GENERATE_COUNTRY" },
   { no: 47, name: "TRANSFORMER_SOURCE_TRANSFORM_PII_TEXT" },
+  { no: 48, name: "TRANSFORMER_SOURCE_TRANSFORM_FPE" },
 ]);
 
 /**
@@ -468,6 +474,104 @@ proto3.util.setEnumType(InvalidEmailAction, "mgmt.v1alpha1.InvalidEmailAction",
   { no: 4, name: "INVALID_EMAIL_ACTION_GENERATE" },
 ]);
 
+/**
+ * The locale of the dataset that names, addresses and phone numbers are generated from
+ *
+ * @generated from enum mgmt.v1alpha1.TransformerLocale
+ */
+export enum TransformerLocale {
+  /**
+   * Unspecified defaults to en_US
+   *
+   * @generated from enum value: TRANSFORMER_LOCALE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * English (United States)
+   *
+   * @generated from enum value: TRANSFORMER_LOCALE_EN_US = 1;
+   */
+  EN_US = 1,
+
+  /**
+   * German (Germany)
+   *
+   * @generated from enum value: TRANSFORMER_LOCALE_DE_DE = 2;
+   */
+  DE_DE = 2,
+
+  /**
+   * French (France)
+   *
+   * @generated from enum value: TRANSFORMER_LOCALE_FR_FR = 3;
+   */
+  FR_FR = 3,
+
+  /**
+   * Spanish (Spain)
+   *
+   * @generated from enum value: TRANSFORMER_LOCALE_ES_ES = 4;
+   */
+  ES_ES = 4,
+
+  /**
+   * Japanese (Japan)
+   *
+   * @generated from enum value: TRANSFORMER_LOCALE_JA_JP = 5;
+   */
+  JA_JP = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(TransformerLocale)
+proto3.util.setEnumType(TransformerLocale, "mgmt.v1alpha1.TransformerLocale", [
+  { no: 0, name: "TRANSFORMER_LOCALE_UNSPECIFIED" },
+  { no: 1, name: "TRANSFORMER_LOCALE_EN_US" },
+  { no: 2, name: "TRANSFORMER_LOCALE_DE_DE" },
+  { no: 3, name: "TRANSFORMER_LOCALE_FR_FR" },
+  { no: 4, name: "TRANSFORMER_LOCALE_ES_ES" },
+  { no: 5, name: "TRANSFORMER_LOCALE_JA_JP" },
+]);
+
+/**
+ * @generated from enum mgmt.v1alpha1.FpeAlphabet
+ */
+export enum FpeAlphabet {
+  /**
+   * Defaults to alphanumeric
+   *
+   * @generated from enum value: FPE_ALPHABET_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 0-9
+   *
+   * @generated from enum value: FPE_ALPHABET_NUMERIC = 1;
+   */
+  NUMERIC = 1,
+
+  /**
+   * 0-9, a-z and A-Z
+   *
+   * @generated from enum value: FPE_ALPHABET_ALPHANUMERIC = 2;
+   */
+  ALPHANUMERIC = 2,
+
+  /**
+   * The characters provided in the custom_alphabet field
+   *
+   * @generated from enum value: FPE_ALPHABET_CUSTOM = 3;
+   */
+  CUSTOM = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(FpeAlphabet)
+proto3.util.setEnumType(FpeAlphabet, "mgmt.v1alpha1.FpeAlphabet", [
+  { no: 0, name: "FPE_ALPHABET_UNSPECIFIED" },
+  { no: 1, name: "FPE_ALPHABET_NUMERIC" },
+  { no: 2, name: "FPE_ALPHABET_ALPHANUMERIC" },
+  { no: 3, name: "FPE_ALPHABET_CUSTOM" },
+]);
+
 /**
  * @generated from message mgmt.v1alpha1.GetSystemTransformersRequest
  */
@@ -1543,8 +1647,23 @@ export class TransformerConfig extends Message<TransformerConfig> {
      */
     value: TransformPiiText;
     case: "transformPiiTextConfig";
+  } | {
+    /**
+     * @generated from field: mgmt.v1alpha1.TransformFpe transform_fpe_config = 45;
+     */
+    value: TransformFpe;
+    case: "transformFpeConfig";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
+  /**
+   * When enabled, the randomizer for the transformer is derived from an HMAC of the input value keyed by an account-level secret.
+   * This causes the same input value to always produce the same output across rows, tables, and job runs.
+   * Only applies to transformers that operate on an existing input value.
+   *
+   * @generated from field: bool deterministic = 100;
+   */
+  deterministic = false;
+
   constructor(data?: PartialMessage<TransformerConfig>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1597,6 +1716,8 @@ export class TransformerConfig extends Message<TransformerConfig> {
     { no: 42, name: "generate_javascript_config", kind: "message", T: GenerateJavascript, oneof: "config" },
     { no: 43, name: "generate_country_config", kind: "message", T: GenerateCountry, oneof: "config" },
     { no: 44, name: "transform_pii_text_config", kind: "message", T: TransformPiiText, oneof: "config" },
+    { no: 45, name: "transform_fpe_config", kind: "message", T: TransformFpe, oneof: "config" },
+    { no: 100, name: "deterministic", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformerConfig {
@@ -2178,6 +2299,13 @@ export class GenerateCardNumber extends Message<GenerateCardNumber> {
  * @generated from message mgmt.v1alpha1.GenerateCity
  */
 export class GenerateCity extends Message<GenerateCity> {
+  /**
+   * Optionally specify the locale of the dataset that values are generated from. Defaults to en_US.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 1;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<GenerateCity>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2186,6 +2314,7 @@ export class GenerateCity extends Message<GenerateCity> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateCity";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateCity {
@@ -2250,6 +2379,13 @@ export class GenerateE164PhoneNumber extends Message<GenerateE164PhoneNumber> {
    */
   max = protoInt64.zero;
 
+  /**
+   * Optionally specify a locale to generate numbers in the numbering plan of its country.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 3;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<GenerateE164PhoneNumber>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2260,6 +2396,7 @@ export class GenerateE164PhoneNumber extends Message<GenerateE164PhoneNumber> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "min", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 2, name: "max", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateE164PhoneNumber {
@@ -2283,6 +2420,13 @@ export class GenerateE164PhoneNumber extends Message<GenerateE164PhoneNumber> {
  * @generated from message mgmt.v1alpha1.GenerateFirstName
  */
 export class GenerateFirstName extends Message<GenerateFirstName> {
+  /**
+   * Optionally specify the locale of the dataset that values are generated from. Defaults to en_US.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 1;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<GenerateFirstName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2291,6 +2435,7 @@ export class GenerateFirstName extends Message<GenerateFirstName> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateFirstName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateFirstName {
@@ -2369,6 +2514,13 @@ export class GenerateFloat64 extends Message<GenerateFloat64> {
  * @generated from message mgmt.v1alpha1.GenerateFullAddress
  */
 export class GenerateFullAddress extends Message<GenerateFullAddress> {
+  /**
+   * Optionally specify the locale of the dataset that values are generated from. Defaults to en_US.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 1;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<GenerateFullAddress>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2377,6 +2529,7 @@ export class GenerateFullAddress extends Message<GenerateFullAddress> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateFullAddress";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateFullAddress {
@@ -2400,6 +2553,13 @@ export class GenerateFullAddress extends Message<GenerateFullAddress> {
  * @generated from message mgmt.v1alpha1.GenerateFullName
  */
 export class GenerateFullName extends Message<GenerateFullName> {
+  /**
+   * Optionally specify the locale of the dataset that values are generated from. Defaults to en_US.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 1;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<GenerateFullName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2408,6 +2568,7 @@ export class GenerateFullName extends Message<GenerateFullName> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateFullName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateFullName {
@@ -2548,6 +2709,13 @@ export class GenerateInt64 extends Message<GenerateInt64> {
  * @generated from message mgmt.v1alpha1.GenerateLastName
  */
 export class GenerateLastName extends Message<GenerateLastName> {
+  /**
+   * Optionally specify the locale of the dataset that values are generated from. Defaults to en_US.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 1;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<GenerateLastName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2556,6 +2724,7 @@ export class GenerateLastName extends Message<GenerateLastName> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateLastName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateLastName {
@@ -2648,6 +2817,13 @@ export class GenerateState extends Message<GenerateState> {
    */
   generateFullName = false;
 
+  /**
+   * Optionally specify the locale of the dataset that values are generated from. Defaults to en_US.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 2;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<GenerateState>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2657,6 +2833,7 @@ export class GenerateState extends Message<GenerateState> {
   static readonly typeName = "mgmt.v1alpha1.GenerateState";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "generate_full_name", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateState {
@@ -2680,6 +2857,13 @@ export class GenerateState extends Message<GenerateState> {
  * @generated from message mgmt.v1alpha1.GenerateStreetAddress
  */
 export class GenerateStreetAddress extends Message<GenerateStreetAddress> {
+  /**
+   * Optionally specify the locale of the dataset that values are generated from. Defaults to en_US.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 1;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<GenerateStreetAddress>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2688,6 +2872,7 @@ export class GenerateStreetAddress extends Message<GenerateStreetAddress> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateStreetAddress";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateStreetAddress {
@@ -2721,6 +2906,13 @@ export class GenerateStringPhoneNumber extends Message<GenerateStringPhoneNumber
    */
   max = protoInt64.zero;
 
+  /**
+   * Optionally specify a locale to generate numbers in the national format of its country.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 4;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<GenerateStringPhoneNumber>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2731,6 +2923,7 @@ export class GenerateStringPhoneNumber extends Message<GenerateStringPhoneNumber
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 2, name: "min", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 3, name: "max", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateStringPhoneNumber {
@@ -2927,6 +3120,13 @@ export class GenerateUuid extends Message<GenerateUuid> {
  * @generated from message mgmt.v1alpha1.GenerateZipcode
  */
 export class GenerateZipcode extends Message<GenerateZipcode> {
+  /**
+   * Optionally specify the locale of the dataset that values are generated from. Defaults to en_US.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 1;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<GenerateZipcode>) {
     super();
     proto3.util.initPartial(data, this);
@@ -2935,6 +3135,7 @@ export class GenerateZipcode extends Message<GenerateZipcode> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "mgmt.v1alpha1.GenerateZipcode";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateZipcode {
@@ -3000,6 +3201,13 @@ export class TransformFirstName extends Message<TransformFirstName> {
    */
   preserveLength = false;
 
+  /**
+   * Optionally specify the locale of the dataset that values are generated from. Defaults to en_US.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 2;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<TransformFirstName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3009,6 +3217,7 @@ export class TransformFirstName extends Message<TransformFirstName> {
   static readonly typeName = "mgmt.v1alpha1.TransformFirstName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "preserve_length", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformFirstName {
@@ -3080,6 +3289,13 @@ export class TransformFullName extends Message<TransformFullName> {
    */
   preserveLength = false;
 
+  /**
+   * Optionally specify the locale of the dataset that values are generated from. Defaults to en_US.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 2;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<TransformFullName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3089,6 +3305,7 @@ export class TransformFullName extends Message<TransformFullName> {
   static readonly typeName = "mgmt.v1alpha1.TransformFullName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "preserve_length", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformFullName {
@@ -3197,6 +3414,13 @@ export class TransformLastName extends Message<TransformLastName> {
    */
   preserveLength = false;
 
+  /**
+   * Optionally specify the locale of the dataset that values are generated from. Defaults to en_US.
+   *
+   * @generated from field: optional mgmt.v1alpha1.TransformerLocale locale = 2;
+   */
+  locale?: TransformerLocale;
+
   constructor(data?: PartialMessage<TransformLastName>) {
     super();
     proto3.util.initPartial(data, this);
@@ -3206,6 +3430,7 @@ export class TransformLastName extends Message<TransformLastName> {
   static readonly typeName = "mgmt.v1alpha1.TransformLastName";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "preserve_length", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "locale", kind: "enum", T: proto3.getEnumType(TransformerLocale), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformLastName {
@@ -3751,3 +3976,71 @@ export class GenerateCountry extends Message<GenerateCountry> {
   }
 }
 
+/**
+ * Reversibly encrypts a string value with FF3-1 format-preserving encryption using an account-scoped key.
+ * The output has the same length as the input and is made up of the same alphabet.
+ *
+ * @generated from message mgmt.v1alpha1.TransformFpe
+ */
+export class TransformFpe extends Message<TransformFpe> {
+  /**
+   * The alphabet that values are made up of. Characters that are not part of the alphabet are left in place.
+   *
+   * @generated from field: mgmt.v1alpha1.FpeAlphabet alphabet = 1;
+   */
+  alphabet = FpeAlphabet.UNSPECIFIED;
+
+  /**
+   * The characters that make up the alphabet when the alphabet is FPE_ALPHABET_CUSTOM. Must contain at least 2 unique characters.
+   *
+   * @generated from field: optional string custom_alphabet = 2;
+   */
+  customAlphabet?: string;
+
+  /**
+   * An optional tweak that changes the output for the same input, for example to tokenize the same value differently per column.
+   * The same tweak must be used to decrypt the value.
+   *
+   * @generated from field: optional string tweak = 3;
+   */
+  tweak?: string;
+
+  /**
+   * FF3-1 can not encrypt values with fewer characters from the alphabet than its minimum length, for example values shorter than 6 digits.
+   * By default these values fail the sync. When enabled, they are passed through unencrypted instead.
+   *
+   * @generated from field: bool pass_through_short_values = 4;
+   */
+  passThroughShortValues = false;
+
+  constructor(data?: PartialMessage<TransformFpe>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.TransformFpe";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "alphabet", kind: "enum", T: proto3.getEnumType(FpeAlphabet) },
+    { no: 2, name: "custom_alphabet", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 3, name: "tweak", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 4, name: "pass_through_short_values", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransformFpe {
+    return new TransformFpe().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransformFpe {
+    return new TransformFpe().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransformFpe {
+    return new TransformFpe().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransformFpe | PlainMessage<TransformFpe> | undefined, b: TransformFpe | PlainMessage<TransformFpe> | undefined): boolean {
+    return proto3.util.equals(TransformFpe, a, b);
+  }
+}
+
@@ -38,6 +38,54 @@ proto3.util.setEnumType(UserAccountType, "mgmt.v1alpha1.UserAccountType", [
   { no: 3, name: "USER_ACCOUNT_TYPE_ENTERPRISE" },
 ]);
 
+/**
+ * The role of a member within an account. Determines which actions the member is allowed to perform.
+ *
+ * @generated from enum mgmt.v1alpha1.AccountRole
+ */
+export enum AccountRole {
+  /**
+   * @generated from enum value: ACCOUNT_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Full access to the account, including connection credentials, members, and api keys
+   *
+   * @generated from enum value: ACCOUNT_ROLE_ADMIN = 1;
+   */
+  ADMIN = 1,
+
+  /**
+   * May create and edit jobs and transformers and trigger runs. Can not view connection credentials.
+   *
+   * @generated from enum value: ACCOUNT_ROLE_JOB_DEVELOPER = 2;
+   */
+  JOB_DEVELOPER = 2,
+
+  /**
+   * May view jobs and trigger, cancel, and terminate runs. Can not view connection credentials.
+   *
+   * @generated from enum value: ACCOUNT_ROLE_JOB_EXECUTOR = 3;
+   */
+  JOB_EXECUTOR = 3,
+
+  /**
+   * Read only access to the account. Can not view connection credentials.
+   *
+   * @generated from enum value: ACCOUNT_ROLE_VIEWER = 4;
+   */
+  VIEWER = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(AccountRole)
+proto3.util.setEnumType(AccountRole, "mgmt.v1alpha1.AccountRole", [
+  { no: 0, name: "ACCOUNT_ROLE_UNSPECIFIED" },
+  { no: 1, name: "ACCOUNT_ROLE_ADMIN" },
+  { no: 2, name: "ACCOUNT_ROLE_JOB_DEVELOPER" },
+  { no: 3, name: "ACCOUNT_ROLE_JOB_EXECUTOR" },
+  { no: 4, name: "ACCOUNT_ROLE_VIEWER" },
+]);
+
 /**
  * @generated from enum mgmt.v1alpha1.BillingStatus
  */
@@ -593,6 +641,13 @@ export class IsUserInAccountResponse extends Message<IsUserInAccountResponse> {
    */
   ok = false;
 
+  /**
+   * The role that the user has in the account
+   *
+   * @generated from field: mgmt.v1alpha1.AccountRole role = 2;
+   */
+  role = AccountRole.UNSPECIFIED;
+
   constructor(data?: PartialMessage<IsUserInAccountResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -602,6 +657,7 @@ export class IsUserInAccountResponse extends Message<IsUserInAccountResponse> {
   static readonly typeName = "mgmt.v1alpha1.IsUserInAccountResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "ok", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IsUserInAccountResponse {
@@ -932,6 +988,13 @@ export class AccountUser extends Message<AccountUser> {
    */
   email = "";
 
+  /**
+   * The role of the user in the account
+   *
+   * @generated from field: mgmt.v1alpha1.AccountRole role = 5;
+   */
+  role = AccountRole.UNSPECIFIED;
+
   constructor(data?: PartialMessage<AccountUser>) {
     super();
     proto3.util.initPartial(data, this);
@@ -944,6 +1007,7 @@ export class AccountUser extends Message<AccountUser> {
     { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountUser {
@@ -1111,6 +1175,168 @@ export class RemoveTeamAccountMemberResponse extends Message<RemoveTeamAccountMe
   }
 }
 
+/**
+ * @generated from message mgmt.v1alpha1.SetTeamAccountMemberRoleRequest
+ */
+export class SetTeamAccountMemberRoleRequest extends Message<SetTeamAccountMemberRoleRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId = "";
+
+  /**
+   * @generated from field: mgmt.v1alpha1.AccountRole role = 3;
+   */
+  role = AccountRole.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<SetTeamAccountMemberRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetTeamAccountMemberRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetTeamAccountMemberRoleRequest {
+    return new SetTeamAccountMemberRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetTeamAccountMemberRoleRequest {
+    return new SetTeamAccountMemberRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetTeamAccountMemberRoleRequest {
+    return new SetTeamAccountMemberRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetTeamAccountMemberRoleRequest | PlainMessage<SetTeamAccountMemberRoleRequest> | undefined, b: SetTeamAccountMemberRoleRequest | PlainMessage<SetTeamAccountMemberRoleRequest> | undefined): boolean {
+    return proto3.util.equals(SetTeamAccountMemberRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.SetTeamAccountMemberRoleResponse
+ */
+export class SetTeamAccountMemberRoleResponse extends Message<SetTeamAccountMemberRoleResponse> {
+  /**
+   * @generated from field: mgmt.v1alpha1.AccountUser user = 1;
+   */
+  user?: AccountUser;
+
+  constructor(data?: PartialMessage<SetTeamAccountMemberRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.SetTeamAccountMemberRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user", kind: "message", T: AccountUser },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetTeamAccountMemberRoleResponse {
+    return new SetTeamAccountMemberRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetTeamAccountMemberRoleResponse {
+    return new SetTeamAccountMemberRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetTeamAccountMemberRoleResponse {
+    return new SetTeamAccountMemberRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetTeamAccountMemberRoleResponse | PlainMessage<SetTeamAccountMemberRoleResponse> | undefined, b: SetTeamAccountMemberRoleResponse | PlainMessage<SetTeamAccountMemberRoleResponse> | undefined): boolean {
+    return proto3.util.equals(SetTeamAccountMemberRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetAccountRoleRequest
+ */
+export class GetAccountRoleRequest extends Message<GetAccountRoleRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<GetAccountRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetAccountRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountRoleRequest {
+    return new GetAccountRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountRoleRequest {
+    return new GetAccountRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountRoleRequest {
+    return new GetAccountRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountRoleRequest | PlainMessage<GetAccountRoleRequest> | undefined, b: GetAccountRoleRequest | PlainMessage<GetAccountRoleRequest> | undefined): boolean {
+    return proto3.util.equals(GetAccountRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message mgmt.v1alpha1.GetAccountRoleResponse
+ */
+export class GetAccountRoleResponse extends Message<GetAccountRoleResponse> {
+  /**
+   * The role of the requesting user in the account
+   *
+   * @generated from field: mgmt.v1alpha1.AccountRole role = 1;
+   */
+  role = AccountRole.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<GetAccountRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mgmt.v1alpha1.GetAccountRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountRoleResponse {
+    return new GetAccountRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountRoleResponse {
+    return new GetAccountRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountRoleResponse {
+    return new GetAccountRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountRoleResponse | PlainMessage<GetAccountRoleResponse> | undefined, b: GetAccountRoleResponse | PlainMessage<GetAccountRoleResponse> | undefined): boolean {
+    return proto3.util.equals(GetAccountRoleResponse, a, b);
+  }
+}
+
 /**
  * @generated from message mgmt.v1alpha1.InviteUserToTeamAccountRequest
  */
@@ -1125,6 +1351,13 @@ export class InviteUserToTeamAccountRequest extends Message<InviteUserToTeamAcco
    */
   email = "";
 
+  /**
+   * The role the user will be given once they accept the invite. Defaults to viewer.
+   *
+   * @generated from field: optional mgmt.v1alpha1.AccountRole role = 3;
+   */
+  role?: AccountRole;
+
   constructor(data?: PartialMessage<InviteUserToTeamAccountRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1135,6 +1368,7 @@ export class InviteUserToTeamAccountRequest extends Message<InviteUserToTeamAcco
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole), opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InviteUserToTeamAccountRequest {
@@ -1203,6 +1437,13 @@ export class AccountInvite extends Message<AccountInvite> {
    */
   expiresAt?: Timestamp;
 
+  /**
+   * The role the user will be given once they accept the invite
+   *
+   * @generated from field: mgmt.v1alpha1.AccountRole role = 10;
+   */
+  role = AccountRole.UNSPECIFIED;
+
   constructor(data?: PartialMessage<AccountInvite>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1220,6 +1461,7 @@ export class AccountInvite extends Message<AccountInvite> {
     { no: 7, name: "created_at", kind: "message", T: Timestamp },
     { no: 8, name: "updated_at", kind: "message", T: Timestamp },
     { no: 9, name: "expires_at", kind: "message", T: Timestamp },
+    { no: 10, name: "role", kind: "enum", T: proto3.getEnumType(AccountRole) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountInvite {
@@ -18,9 +18,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 )
 
 type NeosyncAwsManager struct {
+	secretResolver secrets.Resolver
 }
 
 type NeosyncAwsManagerClient interface {
@@ -41,8 +43,9 @@ type NeosyncAwsManagerClient interface {
 	NewDynamoDbClient(ctx context.Context, connCfg *mgmtv1alpha1.DynamoDBConnectionConfig) (*DynamoDbClient, error)
 }
 
-func New() *NeosyncAwsManager {
-	return &NeosyncAwsManager{}
+// The secret resolver is optional and is used to resolve any secret references in the connection credentials
+func New(secretResolver secrets.Resolver) *NeosyncAwsManager {
+	return &NeosyncAwsManager{secretResolver: secretResolver}
 }
 
 // Returns a wrapper dynamodb client
@@ -56,7 +59,7 @@ func (n *NeosyncAwsManager) NewDynamoDbClient(ctx context.Context, connCfg *mgmt
 
 // returns the raw, underlying aws client
 func (n *NeosyncAwsManager) newDynamoDbClient(ctx context.Context, connCfg *mgmtv1alpha1.DynamoDBConnectionConfig) (*dynamodb.Client, error) {
-	cfg, err := getDynamoAwsConfig(ctx, connCfg)
+	cfg, err := getDynamoAwsConfig(ctx, connCfg, n.secretResolver)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +71,7 @@ func (n *NeosyncAwsManager) newDynamoDbClient(ctx context.Context, connCfg *mgmt
 }
 
 func (n *NeosyncAwsManager) NewS3Client(ctx context.Context, connCfg *mgmtv1alpha1.AwsS3ConnectionConfig) (*s3.Client, error) {
-	cfg, err := getS3AwsConfig(ctx, connCfg)
+	cfg, err := getS3AwsConfig(ctx, connCfg, n.secretResolver)
 	if err != nil {
 		return nil, err
 	}
@@ -116,36 +119,57 @@ func withS3Region(region *string) func(o *s3.Options) {
 	}
 }
 
-func getS3AwsConfig(ctx context.Context, s3ConnConfig *mgmtv1alpha1.AwsS3ConnectionConfig) (*aws.Config, error) {
+func getS3AwsConfig(ctx context.Context, s3ConnConfig *mgmtv1alpha1.AwsS3ConnectionConfig, secretResolver secrets.Resolver) (*aws.Config, error) {
+	creds, err := resolveCredentials(ctx, s3ConnConfig.GetCredentials(), secretResolver)
+	if err != nil {
+		return nil, err
+	}
 	return GetAwsConfig(ctx, &AwsCredentialsConfig{
 		Region:          s3ConnConfig.GetRegion(),
 		Endpoint:        s3ConnConfig.GetEndpoint(),
-		Profile:         s3ConnConfig.GetCredentials().GetProfile(),
-		Id:              s3ConnConfig.GetCredentials().GetAccessKeyId(),
-		Secret:          s3ConnConfig.GetCredentials().GetSecretAccessKey(),
-		Token:           s3ConnConfig.GetCredentials().GetSessionToken(),
-		Role:            s3ConnConfig.GetCredentials().GetRoleArn(),
-		RoleExternalId:  s3ConnConfig.GetCredentials().GetRoleExternalId(),
+		Profile:         creds.GetProfile(),
+		Id:              creds.GetAccessKeyId(),
+		Secret:          creds.GetSecretAccessKey(),
+		Token:           creds.GetSessionToken(),
+		Role:            creds.GetRoleArn(),
+		RoleExternalId:  creds.GetRoleExternalId(),
 		RoleSessionName: "neosync",
-		UseEc2:          s3ConnConfig.GetCredentials().GetFromEc2Role(),
+		UseEc2:          creds.GetFromEc2Role(),
 	})
 }
 
-func getDynamoAwsConfig(ctx context.Context, dynConnConfig *mgmtv1alpha1.DynamoDBConnectionConfig) (*aws.Config, error) {
+func getDynamoAwsConfig(ctx context.Context, dynConnConfig *mgmtv1alpha1.DynamoDBConnectionConfig, secretResolver secrets.Resolver) (*aws.Config, error) {
+	creds, err := resolveCredentials(ctx, dynConnConfig.GetCredentials(), secretResolver)
+	if err != nil {
+		return nil, err
+	}
 	return GetAwsConfig(ctx, &AwsCredentialsConfig{
 		Region:          dynConnConfig.GetRegion(),
 		Endpoint:        dynConnConfig.GetEndpoint(),
-		Profile:         dynConnConfig.GetCredentials().GetProfile(),
-		Id:              dynConnConfig.GetCredentials().GetAccessKeyId(),
-		Secret:          dynConnConfig.GetCredentials().GetSecretAccessKey(),
-		Token:           dynConnConfig.GetCredentials().GetSessionToken(),
-		Role:            dynConnConfig.GetCredentials().GetRoleArn(),
-		RoleExternalId:  dynConnConfig.GetCredentials().GetRoleExternalId(),
+		Profile:         creds.GetProfile(),
+		Id:              creds.GetAccessKeyId(),
+		Secret:          creds.GetSecretAccessKey(),
+		Token:           creds.GetSessionToken(),
+		Role:            creds.GetRoleArn(),
+		RoleExternalId:  creds.GetRoleExternalId(),
 		RoleSessionName: "neosync",
-		UseEc2:          dynConnConfig.GetCredentials().GetFromEc2Role(),
+		UseEc2:          creds.GetFromEc2Role(),
 	})
 }
 
+// Returns the credentials with any secret references replaced by their values
+func resolveCredentials(ctx context.Context, creds *mgmtv1alpha1.AwsS3Credentials, secretResolver secrets.Resolver) (*mgmtv1alpha1.AwsS3Credentials, error) {
+	resolved, err := secrets.ResolveConnectionConfig(ctx, secretResolver, &mgmtv1alpha1.ConnectionConfig{
+		Config: &mgmtv1alpha1.ConnectionConfig_AwsS3Config{
+			AwsS3Config: &mgmtv1alpha1.AwsS3ConnectionConfig{Credentials: creds},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve aws credential secrets: %w", err)
+	}
+	return resolved.GetAwsS3Config().GetCredentials(), nil
+}
+
 func IsNotFound(err error) bool {
 	if err != nil {
 		var apiErr smithy.APIError
@@ -0,0 +1,238 @@
+package fpe
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+const (
+	numRounds = 8
+	// FF3-1 uses a 56-bit tweak
+	TweakLength = 7
+	// NIST SP 800-38G Rev 1 requires radix^minlen >= 1,000,000
+	minDomainSize = 1_000_000
+	maxRadix      = 1 << 16
+)
+
+// FF3-1 format-preserving cipher as defined by NIST SP 800-38G Rev 1.
+// Values are encrypted into values of the same length made up of the same alphabet.
+type Cipher struct {
+	block    cipher.Block
+	tweak    []byte
+	alphabet []rune
+	indices  map[rune]int
+	radix    int
+	minLen   int
+	maxLen   int
+}
+
+// Creates a new FF3-1 cipher.
+// The key must be 16, 24 or 32 bytes and the tweak must be exactly 7 bytes.
+// The alphabet must contain between 2 and 65536 unique characters.
+func NewCipher(key, tweak []byte, alphabet string) (*Cipher, error) {
+	switch len(key) {
+	case 16, 24, 32:
+	default:
+		return nil, fmt.Errorf("key must be 16, 24 or 32 bytes, got %d", len(key))
+	}
+	if len(tweak) != TweakLength {
+		return nil, fmt.Errorf("tweak must be %d bytes, got %d", TweakLength, len(tweak))
+	}
+
+	chars := []rune(alphabet)
+	indices := make(map[rune]int, len(chars))
+	for idx, char := range chars {
+		if _, ok := indices[char]; ok {
+			return nil, fmt.Errorf("alphabet contains duplicate character %q", char)
+		}
+		indices[char] = idx
+	}
+	radix := len(chars)
+	if radix < 2 || radix > maxRadix {
+		return nil, fmt.Errorf("alphabet must contain between 2 and %d characters, got %d", maxRadix, radix)
+	}
+
+	// the spec operates on the key in reversed byte order
+	block, err := aes.NewCipher(reverseBytes(key))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cipher{
+		block:    block,
+		tweak:    append([]byte{}, tweak...),
+		alphabet: chars,
+		indices:  indices,
+		radix:    radix,
+		minLen:   getMinLength(radix),
+		maxLen:   getMaxLength(radix),
+	}, nil
+}
+
+// Smallest length where radix^minlen >= 1,000,000
+func getMinLength(radix int) int {
+	minLen := 1
+	for size := radix; size < minDomainSize; size *= radix {
+		minLen++
+	}
+	return minLen
+}
+
+// 2 * floor(log_radix(2^96))
+func getMaxLength(radix int) int {
+	limit := new(big.Int).Lsh(big.NewInt(1), 96)
+	size := big.NewInt(int64(radix))
+	halfLen := 0
+	for size.Cmp(limit) <= 0 {
+		halfLen++
+		size.Mul(size, big.NewInt(int64(radix)))
+	}
+	return 2 * halfLen
+}
+
+// Returns the inclusive bounds on the number of characters that can be encrypted with this alphabet
+func (c *Cipher) LengthBounds() (minLen, maxLen int) {
+	return c.minLen, c.maxLen
+}
+
+// Returns true if the character is part of the alphabet
+func (c *Cipher) IsInAlphabet(char rune) bool {
+	_, ok := c.indices[char]
+	return ok
+}
+
+func (c *Cipher) Encrypt(value string) (string, error) {
+	return c.run(value, true)
+}
+
+func (c *Cipher) Decrypt(value string) (string, error) {
+	return c.run(value, false)
+}
+
+func (c *Cipher) run(value string, encrypt bool) (string, error) {
+	numerals, err := c.toNumerals(value)
+	if err != nil {
+		return "", err
+	}
+	n := len(numerals)
+	if n < c.minLen || n > c.maxLen {
+		return "", fmt.Errorf("value must be between %d and %d characters for an alphabet of %d characters, got %d", c.minLen, c.maxLen, c.radix, n)
+	}
+
+	u := (n + 1) / 2
+	v := n - u
+	a := numerals[:u]
+	b := numerals[u:]
+
+	tweakLeft := []byte{c.tweak[0], c.tweak[1], c.tweak[2], c.tweak[3] & 0xF0}
+	tweakRight := []byte{c.tweak[4], c.tweak[5], c.tweak[6], (c.tweak[3] & 0x0F) << 4}
+
+	radix := big.NewInt(int64(c.radix))
+	modU := new(big.Int).Exp(radix, big.NewInt(int64(u)), nil)
+	modV := new(big.Int).Exp(radix, big.NewInt(int64(v)), nil)
+
+	for round := 0; round < numRounds; round++ {
+		i := round
+		if !encrypt {
+			i = numRounds - 1 - round
+		}
+		m, mod, w := u, modU, tweakRight
+		if i%2 == 1 {
+			m, mod, w = v, modV, tweakLeft
+		}
+
+		// the round function input is derived from B when encrypting and from A when decrypting
+		roundInput := b
+		if !encrypt {
+			roundInput = a
+		}
+		y, err := c.roundFunction(w, i, roundInput)
+		if err != nil {
+			return "", err
+		}
+
+		var num *big.Int
+		if encrypt {
+			num = new(big.Int).Add(c.numRev(a), y)
+		} else {
+			num = new(big.Int).Sub(c.numRev(b), y)
+		}
+		num.Mod(num, mod)
+		result := c.strRev(num, m)
+
+		if encrypt {
+			a, b = b, result
+		} else {
+			b, a = a, result
+		}
+	}
+
+	output := make([]rune, 0, n)
+	for _, numeral := range append(append([]int{}, a...), b...) {
+		output = append(output, c.alphabet[numeral])
+	}
+	return string(output), nil
+}
+
+func (c *Cipher) roundFunction(w []byte, round int, numerals []int) (*big.Int, error) {
+	numBytes := c.numRev(numerals).Bytes()
+	if len(numBytes) > 12 {
+		return nil, errors.New("numeral string exceeds the maximum block size")
+	}
+
+	p := make([]byte, aes.BlockSize)
+	copy(p, w)
+	p[3] ^= byte(round)
+	copy(p[aes.BlockSize-len(numBytes):], numBytes)
+
+	s := make([]byte, aes.BlockSize)
+	c.block.Encrypt(s, reverseBytes(p))
+	return new(big.Int).SetBytes(reverseBytes(s)), nil
+}
+
+// Interprets the numerals in reversed order, meaning the first numeral is the least significant
+func (c *Cipher) numRev(numerals []int) *big.Int {
+	radix := big.NewInt(int64(c.radix))
+	num := big.NewInt(0)
+	for idx := len(numerals) - 1; idx >= 0; idx-- {
+		num.Mul(num, radix)
+		num.Add(num, big.NewInt(int64(numerals[idx])))
+	}
+	return num
+}
+
+// Converts the number into m numerals in reversed order
+func (c *Cipher) strRev(num *big.Int, m int) []int {
+	radix := big.NewInt(int64(c.radix))
+	remaining := new(big.Int).Set(num)
+	digit := new(big.Int)
+	numerals := make([]int, m)
+	for idx := 0; idx < m; idx++ {
+		remaining.DivMod(remaining, radix, digit)
+		numerals[idx] = int(digit.Int64())
+	}
+	return numerals
+}
+
+func (c *Cipher) toNumerals(value string) ([]int, error) {
+	numerals := make([]int, 0, len(value))
+	for _, char := range value {
+		idx, ok := c.indices[char]
+		if !ok {
+			return nil, fmt.Errorf("character %q is not part of the alphabet", char)
+		}
+		numerals = append(numerals, idx)
+	}
+	return numerals, nil
+}
+
+func reverseBytes(input []byte) []byte {
+	output := make([]byte, len(input))
+	for idx := range input {
+		output[len(input)-1-idx] = input[idx]
+	}
+	return output
+}
@@ -0,0 +1,88 @@
+package fpe
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	digits       = "0123456789"
+	alphanumeric = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+func Test_Cipher_KnownVector(t *testing.T) {
+	key, err := hex.DecodeString("2DE79D232DF5585D68CE47882AE256D6")
+	require.NoError(t, err)
+	tweak, err := hex.DecodeString("CBD09280979564")
+	require.NoError(t, err)
+
+	c, err := NewCipher(key, tweak, digits)
+	require.NoError(t, err)
+
+	ciphertext, err := c.Encrypt("3992520240")
+	require.NoError(t, err)
+	require.Equal(t, "8901801106", ciphertext)
+
+	plaintext, err := c.Decrypt(ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, "3992520240", plaintext)
+}
+
+func Test_Cipher_RoundTrip(t *testing.T) {
+	key := make([]byte, 32)
+	tweak := []byte{1, 2, 3, 4, 5, 6, 7}
+
+	tests := []struct {
+		alphabet string
+		value    string
+	}{
+		{digits, "123456"},
+		{digits, "4111111111111111"},
+		{alphanumeric, "Acct9"},
+		{alphanumeric, "abcdefghijABCDEFGHIJ0123456789ab"},
+		{"αβγδεζηθικ", "αβγδεζηθ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			c, err := NewCipher(key, tweak, tt.alphabet)
+			require.NoError(t, err)
+
+			ciphertext, err := c.Encrypt(tt.value)
+			require.NoError(t, err)
+			require.Equal(t, len([]rune(tt.value)), len([]rune(ciphertext)))
+			require.NotEqual(t, tt.value, ciphertext)
+			for _, char := range ciphertext {
+				require.True(t, c.IsInAlphabet(char))
+			}
+
+			plaintext, err := c.Decrypt(ciphertext)
+			require.NoError(t, err)
+			require.Equal(t, tt.value, plaintext)
+		})
+	}
+}
+
+func Test_Cipher_Errors(t *testing.T) {
+	_, err := NewCipher(make([]byte, 10), make([]byte, TweakLength), digits)
+	require.Error(t, err, "invalid key length")
+	_, err = NewCipher(make([]byte, 16), make([]byte, 8), digits)
+	require.Error(t, err, "invalid tweak length")
+	_, err = NewCipher(make([]byte, 16), make([]byte, TweakLength), "aab")
+	require.Error(t, err, "duplicate alphabet characters")
+	_, err = NewCipher(make([]byte, 16), make([]byte, TweakLength), "a")
+	require.Error(t, err, "alphabet too small")
+
+	c, err := NewCipher(make([]byte, 16), make([]byte, TweakLength), digits)
+	require.NoError(t, err)
+	minLen, maxLen := c.LengthBounds()
+	require.Equal(t, 6, minLen)
+	require.Equal(t, 56, maxLen)
+
+	_, err = c.Encrypt("12345")
+	require.Error(t, err, "below the minimum domain size")
+	_, err = c.Encrypt("12345a")
+	require.Error(t, err, "character outside of the alphabet")
+}
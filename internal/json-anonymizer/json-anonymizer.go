@@ -27,6 +27,7 @@ type JsonAnonymizer struct {
 	haltOnFailure              bool
 	skipPaths                  map[string]struct{}
 	anonymizeConfig            *anonymizeConfig
+	deterministicKey           string
 }
 
 type anonymizeConfig struct {
@@ -51,15 +52,16 @@ func NewAnonymizer(opts ...Option) (*JsonAnonymizer, error) {
 	}
 
 	// Initialize transformerExecutors
+	execOpts := a.getTransformerExecutorOptions()
 	var err error
-	a.transformerExecutors, err = initTransformerExecutors(a.transformerMappings, a.anonymizeConfig)
+	a.transformerExecutors, err = initTransformerExecutors(a.transformerMappings, execOpts)
 	if err != nil {
 		return nil, err
 	}
 
 	// Initialize defaultTransformerExecutor if needed
 	if a.defaultTransformers != nil {
-		a.defaultTransformerExecutor, err = initDefaultTransformerExecutors(a.defaultTransformers, a.anonymizeConfig)
+		a.defaultTransformerExecutor, err = initDefaultTransformerExecutors(a.defaultTransformers, execOpts)
 		if err != nil {
 			return nil, err
 		}
@@ -84,6 +86,13 @@ func WithConditionalAnonymizeConfig(isEnabled bool, analyze presidioapi.AnalyzeI
 	}
 }
 
+// WithDeterministicKey sets the key used by transformers that are configured as deterministic
+func WithDeterministicKey(key string) Option {
+	return func(a *JsonAnonymizer) {
+		a.deterministicKey = key
+	}
+}
+
 // WithTransformerMappings sets the transformer mappings
 func WithTransformerMappings(mappings []*mgmtv1alpha1.TransformerMapping) Option {
 	return func(a *JsonAnonymizer) {
@@ -323,15 +332,22 @@ func (a *JsonAnonymizer) AnonymizeJSONObject(jsonStr string) (string, error) {
 	return string(processedJSON), nil
 }
 
+func (a *JsonAnonymizer) getTransformerExecutorOptions() []transformer.TransformerExecutorOption {
+	execOpts := []transformer.TransformerExecutorOption{}
+	if a.anonymizeConfig != nil && a.anonymizeConfig.analyze != nil && a.anonymizeConfig.anonymize != nil {
+		execOpts = append(execOpts, transformer.WithTransformPiiTextConfig(a.anonymizeConfig.analyze, a.anonymizeConfig.anonymize))
+	}
+	if a.deterministicKey != "" {
+		execOpts = append(execOpts, transformer.WithDeterministicKey(a.deterministicKey))
+	}
+	return execOpts
+}
+
 func initTransformerExecutors(
 	transformerMappings []*mgmtv1alpha1.TransformerMapping,
-	anonymizeConfig *anonymizeConfig,
+	execOpts []transformer.TransformerExecutorOption,
 ) ([]*transformer.TransformerExecutor, error) {
 	executors := []*transformer.TransformerExecutor{}
-	execOpts := []transformer.TransformerExecutorOption{}
-	if anonymizeConfig != nil && anonymizeConfig.analyze != nil && anonymizeConfig.anonymize != nil {
-		execOpts = append(execOpts, transformer.WithTransformPiiTextConfig(anonymizeConfig.analyze, anonymizeConfig.anonymize))
-	}
 
 	for _, mapping := range transformerMappings {
 		executor, err := transformer.InitializeTransformerByConfigType(mapping.GetTransformer(), execOpts...)
@@ -352,12 +368,8 @@ type DefaultExecutors struct {
 
 func initDefaultTransformerExecutors(
 	defaultTransformer *mgmtv1alpha1.DefaultTransformersConfig,
-	anonymizeConfig *anonymizeConfig,
+	execOpts []transformer.TransformerExecutorOption,
 ) (*DefaultExecutors, error) {
-	execOpts := []transformer.TransformerExecutorOption{}
-	if anonymizeConfig != nil && anonymizeConfig.analyze != nil && anonymizeConfig.anonymize != nil {
-		execOpts = append(execOpts, transformer.WithTransformPiiTextConfig(anonymizeConfig.analyze, anonymizeConfig.anonymize))
-	}
 
 	var stringExecutor, numberExecutor, booleanExecutor *transformer.TransformerExecutor
 	var err error
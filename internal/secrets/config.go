@@ -0,0 +1,27 @@
+package secrets
+
+import (
+	"github.com/spf13/viper"
+)
+
+// Builds a resolver with the providers that have been enabled through the environment.
+// A provider that has not been configured will return an error if one of its references is resolved.
+func NewFromEnvironment() Resolver {
+	providers := []Provider{}
+
+	if prefix := viper.GetString("SECRETS_ENV_PREFIX"); prefix != "" {
+		providers = append(providers, NewEnvProvider(prefix))
+	}
+	if dir := viper.GetString("SECRETS_FILE_DIR"); dir != "" {
+		providers = append(providers, NewFileProvider(dir))
+	}
+	if address := viper.GetString("SECRETS_VAULT_ADDRESS"); address != "" {
+		providers = append(providers, NewVaultProvider(&VaultConfig{
+			Address:           address,
+			Token:             viper.GetString("SECRETS_VAULT_TOKEN"),
+			Namespace:         viper.GetString("SECRETS_VAULT_NAMESPACE"),
+			AccountPathPrefix: viper.GetString("SECRETS_VAULT_ACCOUNT_PATH_PREFIX"),
+		}))
+	}
+	return New(providers...)
+}
@@ -0,0 +1,182 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	// Fields of a connection config that are allowed to contain a secret reference.
+	// Other fields, like hosts and bucket names, are always used as-is.
+	credentialFieldNames = map[protoreflect.Name]struct{}{
+		"url":                         {},
+		"pass":                        {},
+		"access_key_id":               {},
+		"secret_access_key":           {},
+		"session_token":               {},
+		"api_key":                     {},
+		"service_account_credentials": {},
+		"client_key":                  {},
+		"passphrase":                  {},
+		"password":                    {},
+	}
+	// Messages whose string fields are all credentials
+	credentialMessages = map[protoreflect.FullName]struct{}{
+		(&mgmtv1alpha1.SSHPassphrase{}).ProtoReflect().Descriptor().FullName(): {},
+		(&mgmtv1alpha1.SSHPrivateKey{}).ProtoReflect().Descriptor().FullName(): {},
+	}
+)
+
+// Returns true if any of the credential fields in the connection config contain a secret reference
+func HasReferences(cc *mgmtv1alpha1.ConnectionConfig) bool {
+	if cc == nil {
+		return false
+	}
+	found := false
+	walkCredentialFields(cc.ProtoReflect(), func(msg protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+		if IsReference(msg.Get(fd).String()) {
+			found = true
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// Returns the distinct secret references found in the credential fields of the connection config
+func GetReferences(cc *mgmtv1alpha1.ConnectionConfig) []string {
+	if cc == nil {
+		return nil
+	}
+	references := []string{}
+	seen := map[string]struct{}{}
+	walkCredentialFields(cc.ProtoReflect(), func(msg protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+		value := msg.Get(fd).String()
+		if _, ok := seen[value]; ok || !IsReference(value) {
+			return true
+		}
+		seen[value] = struct{}{}
+		references = append(references, value)
+		return true
+	})
+	return references
+}
+
+// Where each account's secrets live for the providers that are shared by every account
+type AccountReferenceConfig struct {
+	// The prefix that env references must begin with
+	EnvPrefix string
+	// The vault path that each account's secrets are nested under
+	VaultAccountPathPrefix string
+}
+
+// Returns an error if a reference in the connection config does not belong to the account.
+// Env references must begin with <prefix><ACCOUNT ID>_, file references must be nested under <account id>/, and vault references under <prefix>/<account id>/.
+// Must be checked whenever a connection config is supplied by a caller, as the providers can not tell which account a reference is being resolved for.
+func VerifyAccountReferences(cc *mgmtv1alpha1.ConnectionConfig, accountId string, cfg *AccountReferenceConfig) error {
+	if cc == nil {
+		return nil
+	}
+	var verifyErr error
+	walkCredentialFields(cc.ProtoReflect(), func(msg protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+		scheme, path, ok := parseReference(msg.Get(fd).String())
+		if !ok {
+			return true
+		}
+		switch scheme {
+		case EnvScheme:
+			accountPrefix := GetAccountEnvPrefix(cfg.EnvPrefix, accountId)
+			if !strings.HasPrefix(path, accountPrefix) || path == accountPrefix {
+				verifyErr = fmt.Errorf("environment variable %s must begin with the %q prefix of account %s", path, accountPrefix, accountId)
+			}
+		case FileScheme:
+			referenceAccountId, err := getFilePathAccountId(path)
+			if err != nil {
+				verifyErr = err
+			} else if referenceAccountId != accountId {
+				verifyErr = fmt.Errorf("secret file %s does not belong to account %s", path, accountId)
+			}
+		case VaultScheme:
+			secretPath, _, _ := strings.Cut(path, "#")
+			referenceAccountId, err := getVaultPathAccountId(cfg.VaultAccountPathPrefix, strings.Trim(secretPath, "/"))
+			if err != nil {
+				verifyErr = err
+			} else if referenceAccountId != accountId {
+				verifyErr = fmt.Errorf("vault secret path %s does not belong to account %s", secretPath, accountId)
+			}
+		}
+		return verifyErr == nil
+	})
+	return verifyErr
+}
+
+// Returns a copy of the connection config with all secret references in its credential fields replaced by their values.
+// The original connection config is returned if it does not contain any references.
+// The resolved config must only be used in memory and never be persisted or returned to a client.
+func ResolveConnectionConfig(ctx context.Context, resolver Resolver, cc *mgmtv1alpha1.ConnectionConfig) (*mgmtv1alpha1.ConnectionConfig, error) {
+	if !HasReferences(cc) {
+		return cc, nil
+	}
+	if resolver == nil {
+		return nil, ErrResolverNotConfigured
+	}
+
+	resolved, ok := proto.Clone(cc).(*mgmtv1alpha1.ConnectionConfig)
+	if !ok {
+		return nil, errors.New("unable to copy connection config")
+	}
+	var resolveErr error
+	walkCredentialFields(resolved.ProtoReflect(), func(msg protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+		value, err := resolver.Resolve(ctx, msg.Get(fd).String())
+		if err != nil {
+			resolveErr = err
+			return false
+		}
+		msg.Set(fd, protoreflect.ValueOfString(value))
+		return true
+	})
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+	return resolved, nil
+}
+
+// Invokes the callback with each populated credential field. Returning false from the callback stops the walk.
+func walkCredentialFields(msg protoreflect.Message, fn func(msg protoreflect.Message, fd protoreflect.FieldDescriptor) bool) bool {
+	_, isCredentialMessage := credentialMessages[msg.Descriptor().FullName()]
+	keepGoing := true
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			return true
+		case fd.IsList():
+			if fd.Kind() != protoreflect.MessageKind {
+				return true
+			}
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				if !walkCredentialFields(list.Get(i).Message(), fn) {
+					keepGoing = false
+					return false
+				}
+			}
+			return true
+		case fd.Kind() == protoreflect.MessageKind:
+			keepGoing = walkCredentialFields(v.Message(), fn)
+			return keepGoing
+		case fd.Kind() == protoreflect.StringKind:
+			if _, ok := credentialFieldNames[fd.Name()]; ok || isCredentialMessage {
+				keepGoing = fn(msg, fd)
+				return keepGoing
+			}
+		}
+		return true
+	})
+	return keepGoing
+}
@@ -0,0 +1,42 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Resolves env://<NAME> references from the environment of the running process
+type EnvProvider struct {
+	prefix string
+}
+
+var _ Provider = &EnvProvider{}
+
+// Only environment variables that begin with the prefix may be referenced.
+// This prevents a connection from reading arbitrary configuration (like database or auth secrets) out of the server's environment.
+func NewEnvProvider(prefix string) *EnvProvider {
+	return &EnvProvider{prefix: prefix}
+}
+
+func (p *EnvProvider) Scheme() string {
+	return EnvScheme
+}
+
+func (p *EnvProvider) Resolve(ctx context.Context, path string) (string, error) {
+	if p.prefix == "" || !strings.HasPrefix(path, p.prefix) {
+		return "", fmt.Errorf("environment variable must begin with the %q prefix", p.prefix)
+	}
+	value, ok := os.LookupEnv(path)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set: %w", path, ErrSecretNotFound)
+	}
+	return value, nil
+}
+
+// Returns the prefix that the environment variables of an account must begin with.
+// Ex: NEOSYNC_SECRET_0B4C3F1E_6A7D_4F2B_9C8E_5D1A2B3C4D5E_
+func GetAccountEnvPrefix(prefix, accountId string) string {
+	return prefix + strings.ToUpper(strings.ReplaceAll(accountId, "-", "_")) + "_"
+}
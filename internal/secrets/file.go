@@ -0,0 +1,94 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Resolves file://<path> references from files within a single directory.
+// This is intended to be used with secrets that are mounted into the container, like Kubernetes secret volumes.
+type FileProvider struct {
+	dir string
+}
+
+var _ Provider = &FileProvider{}
+
+// Paths are relative to the directory and are not allowed to escape it
+func NewFileProvider(dir string) *FileProvider {
+	return &FileProvider{dir: dir}
+}
+
+func (p *FileProvider) Scheme() string {
+	return FileScheme
+}
+
+func (p *FileProvider) Resolve(ctx context.Context, path string) (string, error) {
+	if p.dir == "" {
+		return "", errors.New("secrets directory has not been configured")
+	}
+	fullpath, err := p.getPath(path)
+	if err != nil {
+		return "", err
+	}
+	contents, err := os.ReadFile(fullpath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("secret file %s does not exist: %w", path, ErrSecretNotFound)
+		}
+		return "", fmt.Errorf("unable to read secret file: %w", err)
+	}
+	// mounted secrets and files written by editors commonly include a trailing newline
+	return strings.TrimRight(string(contents), "\r\n"), nil
+}
+
+func (p *FileProvider) getPath(path string) (string, error) {
+	dir, err := filepath.Abs(p.dir)
+	if err != nil {
+		return "", fmt.Errorf("unable to determine secrets directory: %w", err)
+	}
+	fullpath := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(path, "/")))
+	rel, err := filepath.Rel(dir, fullpath)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("secret file %s must be located within the secrets directory", path)
+	}
+
+	// guard against symlinks within the directory that point outside of it
+	resolvedDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", fmt.Errorf("unable to determine secrets directory: %w", err)
+	}
+	resolved, err := filepath.EvalSymlinks(fullpath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("secret file %s does not exist: %w", path, ErrSecretNotFound)
+		}
+		return "", fmt.Errorf("unable to read secret file: %w", err)
+	}
+	if resolved != resolvedDir && !strings.HasPrefix(resolved, resolvedDir+string(filepath.Separator)) {
+		return "", fmt.Errorf("secret file %s must be located within the secrets directory", path)
+	}
+	return resolved, nil
+}
+
+// Returns the account id that the secret file path is nested under.
+// Paths must be in the format <account id>/<path> and may not contain relative segments.
+func getFilePathAccountId(path string) (string, error) {
+	trimmed := strings.TrimPrefix(path, "/")
+	for _, segment := range strings.Split(trimmed, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", fmt.Errorf("secret file %s must not contain empty or relative segments", path)
+		}
+	}
+	accountId, rest, ok := strings.Cut(trimmed, "/")
+	if !ok || rest == "" || uuid.Validate(accountId) != nil {
+		return "", fmt.Errorf("secret file %s must be nested under the id of the account that it belongs to", path)
+	}
+	return accountId, nil
+}
@@ -0,0 +1,94 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Resolves secret references into their plaintext values.
+// Values that are not secret references are returned unchanged.
+type Resolver interface {
+	Resolve(ctx context.Context, value string) (string, error)
+}
+
+// A secret backend that is responsible for a single reference scheme. Ex: env://
+type Provider interface {
+	// The scheme of the references this provider is responsible for, without the "://" suffix
+	Scheme() string
+	// Returns the secret for the portion of the reference that comes after "<scheme>://"
+	Resolve(ctx context.Context, path string) (string, error)
+}
+
+const (
+	EnvScheme   = "env"
+	FileScheme  = "file"
+	VaultScheme = "vault"
+
+	schemeSeparator = "://"
+)
+
+var (
+	knownSchemes = []string{EnvScheme, FileScheme, VaultScheme}
+
+	ErrResolverNotConfigured = errors.New("connection config contains secret references but no secret resolver has been configured")
+	ErrSecretNotFound        = errors.New("secret not found")
+)
+
+// Returns true if the value is a reference to a secret that must be resolved before use.
+// Ex: env://NEOSYNC_SECRET_PG_PASSWORD, file://pg/password, vault://secret/data/pg#password
+func IsReference(value string) bool {
+	scheme, _, ok := parseReference(value)
+	return ok && isKnownScheme(scheme)
+}
+
+func parseReference(value string) (scheme, path string, ok bool) {
+	scheme, path, ok = strings.Cut(value, schemeSeparator)
+	if !ok || scheme == "" || path == "" {
+		return "", "", false
+	}
+	return scheme, path, true
+}
+
+func isKnownScheme(scheme string) bool {
+	for _, known := range knownSchemes {
+		if scheme == known {
+			return true
+		}
+	}
+	return false
+}
+
+type resolver struct {
+	providers map[string]Provider
+}
+
+var _ Resolver = &resolver{}
+
+// Returns a resolver that dispatches references to the provider that is registered for its scheme.
+// References whose scheme has no provider registered result in an error.
+func New(providers ...Provider) Resolver {
+	providerMap := make(map[string]Provider, len(providers))
+	for _, provider := range providers {
+		providerMap[provider.Scheme()] = provider
+	}
+	return &resolver{providers: providerMap}
+}
+
+func (r *resolver) Resolve(ctx context.Context, value string) (string, error) {
+	if !IsReference(value) {
+		return value, nil
+	}
+	scheme, path, _ := parseReference(value)
+	provider, ok := r.providers[scheme]
+	if !ok {
+		return "", fmt.Errorf("the %s secret provider has not been enabled", scheme)
+	}
+	secret, err := provider.Resolve(ctx, path)
+	if err != nil {
+		// the reference itself is not sensitive and is included to make misconfigurations easier to track down
+		return "", fmt.Errorf("unable to resolve secret reference %s: %w", value, err)
+	}
+	return secret, nil
+}
@@ -0,0 +1,154 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VaultConfig struct {
+	// The address of the Vault server. Ex: https://vault.example.com:8200
+	Address string
+	// The token that is sent with each request
+	Token string
+	// Optional Vault Enterprise namespace
+	Namespace string
+	// The path that each account's secrets are nested under. Ex: secret/data/neosync
+	// Secrets must live under <prefix>/<account id>/ so that an account can only ever reference its own secrets.
+	AccountPathPrefix string
+
+	// Optional, defaults to a client with a 10 second timeout
+	HttpClient *http.Client
+}
+
+// Resolves vault://<path>#<key> references against a HashiCorp Vault compatible HTTP API.
+// The path is the API path of the secret, without the /v1/ prefix. Ex: vault://secret/data/prod/postgres#password
+// Both KV version 1 and version 2 secret engines are supported.
+type VaultProvider struct {
+	address           string
+	token             string
+	namespace         string
+	accountPathPrefix string
+	httpclient        *http.Client
+}
+
+var _ Provider = &VaultProvider{}
+
+func NewVaultProvider(cfg *VaultConfig) *VaultProvider {
+	httpclient := cfg.HttpClient
+	if httpclient == nil {
+		httpclient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &VaultProvider{
+		address:           strings.TrimRight(cfg.Address, "/"),
+		token:             cfg.Token,
+		namespace:         cfg.Namespace,
+		accountPathPrefix: cfg.AccountPathPrefix,
+		httpclient:        httpclient,
+	}
+}
+
+func (p *VaultProvider) Scheme() string {
+	return VaultScheme
+}
+
+type vaultSecretResponse struct {
+	Data map[string]any `json:"data"`
+}
+
+func (p *VaultProvider) Resolve(ctx context.Context, path string) (string, error) {
+	secretPath, key, ok := strings.Cut(path, "#")
+	secretPath = strings.Trim(secretPath, "/")
+	if !ok || secretPath == "" || key == "" {
+		return "", errors.New("vault secret references must be in the format vault://<path>#<key>")
+	}
+	// the token is shared by every account, so the path is the only thing keeping accounts out of each other's secrets
+	if _, err := getVaultPathAccountId(p.accountPathPrefix, secretPath); err != nil {
+		return "", err
+	}
+
+	reqUrl, err := url.JoinPath(p.address, "v1", secretPath)
+	if err != nil {
+		return "", fmt.Errorf("unable to build vault url: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqUrl, http.NoBody)
+	if err != nil {
+		return "", fmt.Errorf("unable to build vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+	req.Header.Set("X-Vault-Request", "true")
+	if p.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.namespace)
+	}
+
+	resp, err := p.httpclient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to request secret from vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("vault secret %s does not exist: %w", secretPath, ErrSecretNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		// the body is intentionally not included as it is not guaranteed to be free of sensitive values
+		return "", fmt.Errorf("vault returned unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("unable to read vault response: %w", err)
+	}
+	var secret vaultSecretResponse
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", fmt.Errorf("unable to decode vault response: %w", err)
+	}
+
+	data := secret.Data
+	// kv v2 nests the secret values within data.data alongside a metadata object
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, hasMetadata := data["metadata"]; hasMetadata {
+			data = nested
+		}
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("vault secret %s does not contain the key %s: %w", secretPath, key, ErrSecretNotFound)
+	}
+	strValue, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("vault secret %s key %s was not a string", secretPath, key)
+	}
+	return strValue, nil
+}
+
+// Returns the account id that the vault secret path is nested under.
+// Paths must be in the format <prefix>/<account id>/<path> and may not contain relative segments.
+func getVaultPathAccountId(accountPathPrefix, secretPath string) (string, error) {
+	for _, segment := range strings.Split(secretPath, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", fmt.Errorf("vault secret path %s must not contain empty or relative segments", secretPath)
+		}
+	}
+	accountPath := secretPath
+	if prefix := strings.Trim(accountPathPrefix, "/"); prefix != "" {
+		var ok bool
+		accountPath, ok = strings.CutPrefix(secretPath, prefix+"/")
+		if !ok {
+			return "", fmt.Errorf("vault secret path %s must be nested under %s/<account id>/", secretPath, prefix)
+		}
+	}
+	accountId, path, ok := strings.Cut(accountPath, "/")
+	if !ok || path == "" || uuid.Validate(accountId) != nil {
+		return "", fmt.Errorf("vault secret path %s must be nested under the id of the account that it belongs to", secretPath)
+	}
+	return accountId, nil
+}
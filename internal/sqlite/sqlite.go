@@ -0,0 +1,142 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+func SqliteSqlRowToMap(rows *sql.Rows) (map[string]any, error) {
+	columnNames, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	cTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	columnDbTypes := []string{}
+	for _, c := range cTypes {
+		columnDbTypes = append(columnDbTypes, c.DatabaseTypeName())
+	}
+
+	values := make([]any, len(columnNames))
+	valuesWrapped := make([]any, 0, len(columnNames))
+	for i := range values {
+		valuesWrapped = append(valuesWrapped, &values[i])
+	}
+	if err := rows.Scan(valuesWrapped...); err != nil {
+		return nil, err
+	}
+	jObj := parseSqliteRowValues(values, columnNames, columnDbTypes)
+
+	return jObj, nil
+}
+
+// SQLite stores values by storage class rather than column type, so the declared column type is used to
+// restore booleans, json and text that was written as a blob.
+func parseSqliteRowValues(values []any, columnNames, columnDbTypes []string) map[string]any {
+	jObj := map[string]any{}
+	for i, v := range values {
+		col := columnNames[i]
+		colDataType := columnDbTypes[i]
+		switch t := v.(type) {
+		case string:
+			if IsJsonDataType(colDataType) {
+				var js any
+				if err := json.Unmarshal([]byte(t), &js); err == nil {
+					jObj[col] = js
+					continue
+				}
+			}
+			jObj[col] = t
+		case []byte:
+			if IsJsonDataType(colDataType) {
+				var js any
+				if err := json.Unmarshal(t, &js); err == nil {
+					jObj[col] = js
+					continue
+				}
+			}
+			if isBinaryDataType(colDataType) {
+				jObj[col] = t
+			} else {
+				jObj[col] = string(t)
+			}
+		case int64:
+			if isBoolDataType(colDataType) {
+				jObj[col] = t != 0
+			} else {
+				jObj[col] = t
+			}
+		default:
+			jObj[col] = t
+		}
+	}
+	return jObj
+}
+
+func getBaseDataType(colDataType string) string {
+	base, _, _ := strings.Cut(colDataType, "(")
+	return strings.ToLower(strings.TrimSpace(base))
+}
+
+func isBoolDataType(colDataType string) bool {
+	base := getBaseDataType(colDataType)
+	return base == "bool" || base == "boolean"
+}
+
+// columns without a declared type have the blob affinity
+func isBinaryDataType(colDataType string) bool {
+	base := getBaseDataType(colDataType)
+	return base == "" || strings.Contains(base, "blob")
+}
+
+func IsJsonDataType(colDataType string) bool {
+	base := getBaseDataType(colDataType)
+	return base == "json" || base == "jsonb"
+}
+
+// SQLite does not support the DEFAULT keyword in inserted values, so columns that should use the database default are removed from the insert
+func FilterOutSqliteDefaultColumns(
+	defaultCols, columnNames []string,
+	argRows [][]any,
+) (columns []string, rows [][]any) {
+	if len(defaultCols) == 0 {
+		return columnNames, argRows
+	}
+	defaultColMap := map[string]struct{}{}
+	for _, col := range defaultCols {
+		defaultColMap[col] = struct{}{}
+	}
+
+	for _, col := range columnNames {
+		if _, ok := defaultColMap[col]; !ok {
+			columns = append(columns, col)
+		}
+	}
+	rows = make([][]any, 0, len(argRows))
+	for _, row := range argRows {
+		newRow := make([]any, 0, len(columns))
+		for idx, arg := range row {
+			if idx >= len(columnNames) {
+				continue
+			}
+			if _, ok := defaultColMap[columnNames[idx]]; ok {
+				continue
+			}
+			newRow = append(newRow, arg)
+		}
+		rows = append(rows, newRow)
+	}
+	return columns, rows
+}
+
+func GetSqliteDefaultValuesInsertSql(table string, rowCount int) string {
+	var sqlStr string
+	for i := 0; i < rowCount; i++ {
+		sqlStr += fmt.Sprintf("INSERT INTO %q DEFAULT VALUES;", table)
+	}
+	return sqlStr
+}
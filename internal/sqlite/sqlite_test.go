@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseSqliteRowValues(t *testing.T) {
+	t.Run("Multiple Columns", func(t *testing.T) {
+		binaryData := []byte{0x01, 0x02, 0x03}
+		createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		values := []any{
+			"Hello",
+			int64(42),
+			int64(1),
+			nil,
+			`{"key": "value"}`,
+			binaryData,
+			[]byte("text stored as blob"),
+			float64(1.5),
+			createdAt,
+		}
+		columnNames := []string{
+			"text_col", "int_col", "bool_col", "nil_col", "json_col", "binary_col", "varchar_col", "real_col", "datetime_col",
+		}
+		cTypes := []string{
+			"TEXT",
+			"INTEGER",
+			"BOOLEAN",
+			"TEXT",
+			"JSON",
+			"BLOB",
+			"VARCHAR(255)",
+			"REAL",
+			"DATETIME",
+		}
+		result := parseSqliteRowValues(values, columnNames, cTypes)
+		expected := map[string]any{
+			"text_col":     "Hello",
+			"int_col":      int64(42),
+			"bool_col":     true,
+			"nil_col":      nil,
+			"json_col":     map[string]any{"key": "value"},
+			"binary_col":   binaryData,
+			"varchar_col":  "text stored as blob",
+			"real_col":     float64(1.5),
+			"datetime_col": createdAt,
+		}
+		require.Equal(t, expected, result)
+	})
+
+	t.Run("Untyped Columns", func(t *testing.T) {
+		result := parseSqliteRowValues([]any{[]byte{0x01}, int64(0)}, []string{"blob_col", "num_col"}, []string{"", "NUMERIC"})
+		require.Equal(t, map[string]any{"blob_col": []byte{0x01}, "num_col": int64(0)}, result)
+	})
+}
@@ -21,19 +21,25 @@ import (
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	neosynclogger "github.com/nucleuscloud/neosync/backend/pkg/logger"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	neosyncotel "github.com/nucleuscloud/neosync/internal/otel"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 	accountstatus_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/account-status"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
 	posttablesync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/post-table-sync"
+	publishjobrunevent_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/publish-job-run-event"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
 	syncactivityopts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-activity-opts"
+	synccheckpoint_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-checkpoint"
 	syncrediscleanup_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-redis-clean-up"
 	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
+	retrievestepoptions_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/pipeline/activities/retrieve-step-options"
+	pipeline_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/pipeline/workflow"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
@@ -261,7 +267,9 @@ func serve(ctx context.Context) error {
 	connclient := mgmtv1alpha1connect.NewConnectionServiceClient(httpclient, neosyncurl, connectInterceptorOption)
 	jobclient := mgmtv1alpha1connect.NewJobServiceClient(httpclient, neosyncurl, connectInterceptorOption)
 	transformerclient := mgmtv1alpha1connect.NewTransformersServiceClient(httpclient, neosyncurl, connectInterceptorOption)
-	sqlconnector := &sqlconnect.SqlOpenConnector{}
+	notificationclient := mgmtv1alpha1connect.NewNotificationServiceClient(httpclient, neosyncurl, connectInterceptorOption)
+	secretResolver := secrets.NewFromEnvironment()
+	sqlconnector := &sqlconnect.SqlOpenConnector{SecretResolver: secretResolver, SqliteAllowedDir: viper.GetString("SQLITE_ALLOWED_DIR")}
 	sqlmanager := sql_manager.NewSqlManager(pgpoolmap, pgquerier, mysqlpoolmap, mysqlquerier, mssqlpoolmap, mssqlquerier, sqlconnector)
 	redisconfig := shared.GetRedisConfig()
 
@@ -272,13 +280,18 @@ func serve(ctx context.Context) error {
 		sqlmanager,
 		redisconfig,
 		otelconfig.IsEnabled,
+		secretResolver,
+		viper.GetString("LOCAL_DIRECTORY_ALLOWED_DIR"),
 	)
 	disableReaper := false
-	syncActivity := sync_activity.New(connclient, jobclient, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, temporalClient, syncActivityMeter, sync_activity.NewBenthosStreamManager(), disableReaper)
+	syncActivity := sync_activity.New(connclient, jobclient, sqlconnector, &sync.Map{}, temporalClient, syncActivityMeter, sync_activity.NewBenthosStreamManager(), disableReaper, viper.GetString("TRANSFORMER_SECRET"), secretResolver)
 	retrieveActivityOpts := syncactivityopts_activity.New(jobclient)
-	runSqlInitTableStatements := runsqlinittablestmts_activity.New(jobclient, connclient, sqlmanager)
+	runSqlInitTableStatements := runsqlinittablestmts_activity.New(jobclient, connclient, sqlmanager, mongoconnect.NewConnector(secretResolver))
 	accountStatusActivity := accountstatus_activity.New(userclient)
 	runPostTableSyncActivity := posttablesync_activity.New(jobclient, sqlmanager, connclient)
+	syncCheckpointActivity := synccheckpoint_activity.New(jobclient)
+	publishJobRunEventActivity := publishjobrunevent_activity.New(notificationclient)
+	retrieveStepOptionsActivity := retrievestepoptions_activity.New(jobclient)
 
 	w.RegisterWorkflow(datasync_workflow.Workflow)
 	w.RegisterActivity(syncActivity.Sync)
@@ -288,6 +301,12 @@ func serve(ctx context.Context) error {
 	w.RegisterActivity(genbenthosActivity.GenerateBenthosConfigs)
 	w.RegisterActivity(accountStatusActivity.CheckAccountStatus)
 	w.RegisterActivity(runPostTableSyncActivity.RunPostTableSync)
+	w.RegisterActivity(syncCheckpointActivity.GetResumeCheckpoint)
+	w.RegisterActivity(syncCheckpointActivity.SetSyncCheckpoint)
+	w.RegisterActivity(publishJobRunEventActivity.PublishJobRunEvent)
+
+	w.RegisterWorkflow(pipeline_workflow.PipelineWorkflow)
+	w.RegisterActivity(retrieveStepOptionsActivity.RetrieveStepOptions)
 
 	if err := w.Start(); err != nil {
 		return fmt.Errorf("unable to start temporal worker: %w", err)
@@ -10,7 +10,7 @@ import (
 )
 
 type ConnectionProvider[T any] interface {
-	GetConnectionClient(connectionConfig *mgmtv1alpha1.ConnectionConfig) (T, error)
+	GetConnectionClient(connection *mgmtv1alpha1.Connection) (T, error)
 	CloseClientConnection(client T) error
 }
 
@@ -70,7 +70,7 @@ func (c *ConnectionTunnelManager[T]) GetConnection(
 		return existingDb, nil
 	}
 
-	connectionClient, err := c.connectionProvider.GetConnectionClient(connection.GetConnectionConfig())
+	connectionClient, err := c.connectionProvider.GetConnectionClient(connection)
 	if err != nil {
 		var result T
 		return result, err
@@ -49,7 +49,7 @@ func Test_ConnectionTunnelManager_GetConnection_Parallel_Sessions_Same_Connectio
 		ConnectionConfig: cc,
 	}
 
-	provider.On("GetConnectionClient", cc).Return(&struct{}{}, nil)
+	provider.On("GetConnectionClient", conn).Return(&struct{}{}, nil)
 
 	errgrp := errgroup.Group{}
 	errgrp.Go(func() error {
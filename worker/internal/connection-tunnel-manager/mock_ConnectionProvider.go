@@ -66,9 +66,9 @@ func (_c *MockConnectionProvider_CloseClientConnection_Call[T]) RunAndReturn(run
 	return _c
 }
 
-// GetConnectionClient provides a mock function with given fields: connectionConfig
-func (_m *MockConnectionProvider[T]) GetConnectionClient(connectionConfig *mgmtv1alpha1.ConnectionConfig) (T, error) {
-	ret := _m.Called(connectionConfig)
+// GetConnectionClient provides a mock function with given fields: connection
+func (_m *MockConnectionProvider[T]) GetConnectionClient(connection *mgmtv1alpha1.Connection) (T, error) {
+	ret := _m.Called(connection)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetConnectionClient")
@@ -76,17 +76,17 @@ func (_m *MockConnectionProvider[T]) GetConnectionClient(connectionConfig *mgmtv
 
 	var r0 T
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*mgmtv1alpha1.ConnectionConfig) (T, error)); ok {
-		return rf(connectionConfig)
+	if rf, ok := ret.Get(0).(func(*mgmtv1alpha1.Connection) (T, error)); ok {
+		return rf(connection)
 	}
-	if rf, ok := ret.Get(0).(func(*mgmtv1alpha1.ConnectionConfig) T); ok {
-		r0 = rf(connectionConfig)
+	if rf, ok := ret.Get(0).(func(*mgmtv1alpha1.Connection) T); ok {
+		r0 = rf(connection)
 	} else {
 		r0 = ret.Get(0).(T)
 	}
 
-	if rf, ok := ret.Get(1).(func(*mgmtv1alpha1.ConnectionConfig) error); ok {
-		r1 = rf(connectionConfig)
+	if rf, ok := ret.Get(1).(func(*mgmtv1alpha1.Connection) error); ok {
+		r1 = rf(connection)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -100,14 +100,14 @@ type MockConnectionProvider_GetConnectionClient_Call[T any] struct {
 }
 
 // GetConnectionClient is a helper method to define mock.On call
-//   - connectionConfig *mgmtv1alpha1.ConnectionConfig
-func (_e *MockConnectionProvider_Expecter[T]) GetConnectionClient(connectionConfig interface{}) *MockConnectionProvider_GetConnectionClient_Call[T] {
-	return &MockConnectionProvider_GetConnectionClient_Call[T]{Call: _e.mock.On("GetConnectionClient", connectionConfig)}
+//   - connection *mgmtv1alpha1.Connection
+func (_e *MockConnectionProvider_Expecter[T]) GetConnectionClient(connection interface{}) *MockConnectionProvider_GetConnectionClient_Call[T] {
+	return &MockConnectionProvider_GetConnectionClient_Call[T]{Call: _e.mock.On("GetConnectionClient", connection)}
 }
 
-func (_c *MockConnectionProvider_GetConnectionClient_Call[T]) Run(run func(connectionConfig *mgmtv1alpha1.ConnectionConfig)) *MockConnectionProvider_GetConnectionClient_Call[T] {
+func (_c *MockConnectionProvider_GetConnectionClient_Call[T]) Run(run func(connection *mgmtv1alpha1.Connection)) *MockConnectionProvider_GetConnectionClient_Call[T] {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*mgmtv1alpha1.ConnectionConfig))
+		run(args[0].(*mgmtv1alpha1.Connection))
 	})
 	return _c
 }
@@ -117,7 +117,7 @@ func (_c *MockConnectionProvider_GetConnectionClient_Call[T]) Return(_a0 T, _a1
 	return _c
 }
 
-func (_c *MockConnectionProvider_GetConnectionClient_Call[T]) RunAndReturn(run func(*mgmtv1alpha1.ConnectionConfig) (T, error)) *MockConnectionProvider_GetConnectionClient_Call[T] {
+func (_c *MockConnectionProvider_GetConnectionClient_Call[T]) RunAndReturn(run func(*mgmtv1alpha1.Connection) (T, error)) *MockConnectionProvider_GetConnectionClient_Call[T] {
 	_c.Call.Return(run)
 	return _c
 }
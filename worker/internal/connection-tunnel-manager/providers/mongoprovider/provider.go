@@ -3,24 +3,33 @@ package mongoprovider
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 	connectiontunnelmanager "github.com/nucleuscloud/neosync/worker/internal/connection-tunnel-manager"
 	neosync_benthos_mongodb "github.com/nucleuscloud/neosync/worker/pkg/benthos/mongodb"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-type Provider struct{}
+type Provider struct {
+	secretResolver secrets.Resolver
+}
 
-func NewProvider() *Provider {
-	return &Provider{}
+// The secret resolver is optional and is used to resolve a secret reference in the connection url
+func NewProvider(secretResolver secrets.Resolver) *Provider {
+	return &Provider{secretResolver: secretResolver}
 }
 
 var _ connectiontunnelmanager.ConnectionProvider[neosync_benthos_mongodb.MongoClient] = &Provider{}
 
 // this is currently untested as it isn't really used anywhere
-func (p *Provider) GetConnectionClient(cc *mgmtv1alpha1.ConnectionConfig) (neosync_benthos_mongodb.MongoClient, error) {
+func (p *Provider) GetConnectionClient(connection *mgmtv1alpha1.Connection) (neosync_benthos_mongodb.MongoClient, error) {
+	cc, err := secrets.ResolveConnectionConfig(context.Background(), p.secretResolver, connection.GetConnectionConfig())
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve connection config secrets: %w", err)
+	}
 	connStr := cc.GetMongoConfig().GetUrl()
 	if connStr == "" {
 		return nil, errors.New("unable to find mongodb url on connection config")
@@ -26,15 +26,16 @@ func NewProvider(
 	}
 }
 
-func (p *Provider) GetConnectionClient(cc *mgmtv1alpha1.ConnectionConfig) (any, error) {
+func (p *Provider) GetConnectionClient(connection *mgmtv1alpha1.Connection) (any, error) {
+	cc := connection.GetConnectionConfig()
 	if cc == nil {
 		cc = &mgmtv1alpha1.ConnectionConfig{}
 	}
 	switch cc.GetConfig().(type) {
 	case *mgmtv1alpha1.ConnectionConfig_MongoConfig:
-		return p.mp.GetConnectionClient(cc)
-	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
-		return p.sp.GetConnectionClient(cc)
+		return p.mp.GetConnectionClient(connection)
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		return p.sp.GetConnectionClient(connection)
 	default:
 		return nil, fmt.Errorf("unsupported connection config: %T", cc.GetConfig())
 	}
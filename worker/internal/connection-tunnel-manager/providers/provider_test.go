@@ -30,8 +30,10 @@ func Test_Provider_GetConnectionClient(t *testing.T) {
 		mockMp.On("GetConnectionClient", mock.Anything, mock.Anything, mock.Anything).
 			Return(&mongo.Client{}, nil)
 
-		result, err := provider.GetConnectionClient(&mgmtv1alpha1.ConnectionConfig{
-			Config: &mgmtv1alpha1.ConnectionConfig_MongoConfig{},
+		result, err := provider.GetConnectionClient(&mgmtv1alpha1.Connection{
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_MongoConfig{},
+			},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, result)
@@ -48,8 +50,10 @@ func Test_Provider_GetConnectionClient(t *testing.T) {
 		mockSp.On("GetConnectionClient", mock.Anything, mock.Anything, mock.Anything).
 			Return(mockDbtx, nil)
 
-		result, err := provider.GetConnectionClient(&mgmtv1alpha1.ConnectionConfig{
-			Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{},
+		result, err := provider.GetConnectionClient(&mgmtv1alpha1.Connection{
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{},
+			},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, result)
@@ -66,8 +70,10 @@ func Test_Provider_GetConnectionClient(t *testing.T) {
 		mockSp.On("GetConnectionClient", mock.Anything, mock.Anything, mock.Anything).
 			Return(mockDbtx, nil)
 
-		result, err := provider.GetConnectionClient(&mgmtv1alpha1.ConnectionConfig{
-			Config: &mgmtv1alpha1.ConnectionConfig_MysqlConfig{},
+		result, err := provider.GetConnectionClient(&mgmtv1alpha1.Connection{
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_MysqlConfig{},
+			},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, result)
@@ -84,8 +90,10 @@ func Test_Provider_GetConnectionClient(t *testing.T) {
 		mockSp.On("GetConnectionClient", mock.Anything, mock.Anything, mock.Anything).
 			Return(mockDbtx, nil)
 
-		result, err := provider.GetConnectionClient(&mgmtv1alpha1.ConnectionConfig{
-			Config: &mgmtv1alpha1.ConnectionConfig_MssqlConfig{},
+		result, err := provider.GetConnectionClient(&mgmtv1alpha1.Connection{
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_MssqlConfig{},
+			},
 		})
 		require.NoError(t, err)
 		require.NotNil(t, result)
@@ -30,8 +30,8 @@ func (s *sqlDbtxWrapper) Close() error {
 	return s.close()
 }
 
-func (p *Provider) GetConnectionClient(cc *mgmtv1alpha1.ConnectionConfig) (neosync_benthos_sql.SqlDbtx, error) {
-	container, err := p.connector.NewDbFromConnectionConfig(cc, nil, slog.Default())
+func (p *Provider) GetConnectionClient(connection *mgmtv1alpha1.Connection) (neosync_benthos_sql.SqlDbtx, error) {
+	container, err := p.connector.NewDbFromConnectionConfig(connection.GetAccountId(), connection.GetConnectionConfig(), nil, slog.Default())
 	if err != nil {
 		return nil, err
 	}
@@ -49,18 +49,75 @@ type RedisCacheConfig struct {
 }
 
 type InputConfig struct {
-	Label  string `json:"label" yaml:"label"`
-	Inputs `json:",inline" yaml:",inline"`
+	Label      string `json:"label" yaml:"label"`
+	Inputs     `json:",inline" yaml:",inline"`
+	Processors []ProcessorConfig `json:"processors,omitempty" yaml:"processors,omitempty"`
 }
 
 type Inputs struct {
-	SqlSelect      *SqlSelect         `json:"sql_select,omitempty" yaml:"sql_select,omitempty"`
-	PooledSqlRaw   *InputPooledSqlRaw `json:"pooled_sql_raw,omitempty" yaml:"pooled_sql_raw,omitempty"`
-	Generate       *Generate          `json:"generate,omitempty" yaml:"generate,omitempty"`
-	OpenAiGenerate *OpenAiGenerate    `json:"openai_generate,omitempty" yaml:"openai_generate,omitempty"`
-	MongoDB        *InputMongoDb      `json:"mongodb,omitempty" yaml:"mongodb,omitempty"`
-	PooledMongoDB  *InputMongoDb      `json:"pooled_mongodb,omitempty" yaml:"pooled_mongodb,omitempty"`
-	AwsDynamoDB    *InputAwsDynamoDB  `json:"aws_dynamodb,omitempty" yaml:"aws_dynamodb,omitempty"`
+	SqlSelect       *SqlSelect         `json:"sql_select,omitempty" yaml:"sql_select,omitempty"`
+	PooledSqlRaw    *InputPooledSqlRaw `json:"pooled_sql_raw,omitempty" yaml:"pooled_sql_raw,omitempty"`
+	Generate        *Generate          `json:"generate,omitempty" yaml:"generate,omitempty"`
+	OpenAiGenerate  *OpenAiGenerate    `json:"openai_generate,omitempty" yaml:"openai_generate,omitempty"`
+	ProfileGenerate *ProfileGenerate   `json:"profile_generate,omitempty" yaml:"profile_generate,omitempty"`
+	MongoDB         *InputMongoDb      `json:"mongodb,omitempty" yaml:"mongodb,omitempty"`
+	PooledMongoDB   *InputMongoDb      `json:"pooled_mongodb,omitempty" yaml:"pooled_mongodb,omitempty"`
+	AwsDynamoDB     *InputAwsDynamoDB  `json:"aws_dynamodb,omitempty" yaml:"aws_dynamodb,omitempty"`
+	NeosyncKafka    *InputNeosyncKafka `json:"neosync_kafka,omitempty" yaml:"neosync_kafka,omitempty"`
+	File            *InputFile         `json:"file,omitempty" yaml:"file,omitempty"`
+}
+
+type InputFile struct {
+	Paths   []string     `json:"paths" yaml:"paths"`
+	Scanner *FileScanner `json:"scanner,omitempty" yaml:"scanner,omitempty"`
+}
+
+type FileScanner struct {
+	Lines      *LinesScanner      `json:"lines,omitempty" yaml:"lines,omitempty"`
+	Csv        *CsvScanner        `json:"csv,omitempty" yaml:"csv,omitempty"`
+	ToTheEnd   *ToTheEndScanner   `json:"to_the_end,omitempty" yaml:"to_the_end,omitempty"`
+	Decompress *DecompressScanner `json:"decompress,omitempty" yaml:"decompress,omitempty"`
+}
+
+type LinesScanner struct{}
+
+type CsvScanner struct {
+	ParseHeaderRow bool `json:"parse_header_row" yaml:"parse_header_row"`
+}
+
+type ToTheEndScanner struct{}
+
+type DecompressScanner struct {
+	Algorithm string       `json:"algorithm" yaml:"algorithm"`
+	Into      *FileScanner `json:"into" yaml:"into"`
+}
+
+type InputNeosyncKafka struct {
+	Brokers []string         `json:"brokers" yaml:"brokers"`
+	Sasl    *KafkaSaslConfig `json:"sasl,omitempty" yaml:"sasl,omitempty"`
+	Tls     *KafkaTlsConfig  `json:"tls,omitempty" yaml:"tls,omitempty"`
+	Topic   string           `json:"topic" yaml:"topic"`
+
+	StartOffset      *int64 `json:"start_offset,omitempty" yaml:"start_offset,omitempty"`
+	EndOffset        *int64 `json:"end_offset,omitempty" yaml:"end_offset,omitempty"`
+	StartTimestampMs *int64 `json:"start_timestamp_ms,omitempty" yaml:"start_timestamp_ms,omitempty"`
+	EndTimestampMs   *int64 `json:"end_timestamp_ms,omitempty" yaml:"end_timestamp_ms,omitempty"`
+
+	Format     string  `json:"format" yaml:"format"`
+	AvroSchema *string `json:"avro_schema,omitempty" yaml:"avro_schema,omitempty"`
+}
+
+type KafkaSaslConfig struct {
+	Mechanism string `json:"mechanism" yaml:"mechanism"`
+	Username  string `json:"username" yaml:"username"`
+	Password  string `json:"password,omitempty" yaml:"password,omitempty"`
+}
+
+type KafkaTlsConfig struct {
+	Enabled    bool   `json:"enabled" yaml:"enabled"`
+	RootCert   string `json:"root_cert,omitempty" yaml:"root_cert,omitempty"`
+	ClientCert string `json:"client_cert,omitempty" yaml:"client_cert,omitempty"`
+	ClientKey  string `json:"client_key,omitempty" yaml:"client_key,omitempty"`
 }
 
 type InputAwsDynamoDB struct {
@@ -135,6 +192,13 @@ type OpenAiGenerate struct {
 	BatchSize  int      `json:"batch_size" yaml:"batch_size"`
 }
 
+type ProfileGenerate struct {
+	// The base64 encoded json of the table profile that rows are sampled from
+	Profile   string `json:"profile" yaml:"profile"`
+	Count     int    `json:"count" yaml:"count"`
+	BatchSize *int   `json:"batch_size,omitempty" yaml:"batch_size,omitempty"`
+}
+
 type Generate struct {
 	Mapping string `json:"mapping" yaml:"mapping"`
 
@@ -179,8 +243,11 @@ type ProcessorConfig struct {
 	Catch                     []*ProcessorConfig               `json:"catch,omitempty" yaml:"catch,omitempty"`
 	While                     *WhileProcessorConfig            `json:"while,omitempty" yaml:"while,omitempty"`
 	NeosyncDefaultTransformer *NeosyncDefaultTransformerConfig `json:"neosync_default_transformer,omitempty" yaml:"neosync_default_transformer,omitempty"`
+	ParquetDecode             *ParquetDecodeConfig             `json:"parquet_decode,omitempty" yaml:"parquet_decode,omitempty"`
 }
 
+type ParquetDecodeConfig struct{}
+
 type NeosyncDefaultTransformerConfig struct {
 	JobSourceOptionsString string   `json:"job_source_options_string" yaml:"job_source_options_string"`
 	MappedKeys             []string `json:"mapped_keys" yaml:"mapped_keys"`
@@ -243,25 +310,46 @@ type OutputConfig struct {
 }
 
 type Outputs struct {
-	SqlInsert       *SqlInsert             `json:"sql_insert,omitempty" yaml:"sql_insert,omitempty"`
-	SqlRaw          *SqlRaw                `json:"sql_raw,omitempty" yaml:"sql_raw,omitempty"`
-	PooledSqlRaw    *PooledSqlRaw          `json:"pooled_sql_raw,omitempty" yaml:"pooled_sql_raw,omitempty"`
-	PooledSqlInsert *PooledSqlInsert       `json:"pooled_sql_insert,omitempty" yaml:"pooled_sql_insert,omitempty"`
-	PooledSqlUpdate *PooledSqlUpdate       `json:"pooled_sql_update,omitempty" yaml:"pooled_sql_update,omitempty"`
-	AwsS3           *AwsS3Insert           `json:"aws_s3,omitempty" yaml:"aws_s3,omitempty"`
-	GcpCloudStorage *GcpCloudStorageOutput `json:"gcp_cloud_storage,omitempty" yaml:"gcp_cloud_storage,omitempty"`
-	Retry           *RetryConfig           `json:"retry,omitempty" yaml:"retry,omitempty"`
-	Broker          *OutputBrokerConfig    `json:"broker,omitempty" yaml:"broker,omitempty"`
-	DropOn          *DropOnConfig          `json:"drop_on,omitempty" yaml:"drop_on,omitempty"`
-	Drop            *DropConfig            `json:"drop,omitempty" yaml:"drop,omitempty"`
-	Resource        string                 `json:"resource,omitempty" yaml:"resource,omitempty"`
-	Fallback        []Outputs              `json:"fallback,omitempty" yaml:"fallback,omitempty"`
-	RedisHashOutput *RedisHashOutputConfig `json:"redis_hash_output,omitempty" yaml:"redis_hash_output,omitempty"`
-	Error           *ErrorOutputConfig     `json:"error,omitempty" yaml:"error,omitempty"`
-	Switch          *SwitchOutputConfig    `json:"switch,omitempty" yaml:"switch,omitempty"`
-	MongoDB         *OutputMongoDb         `json:"mongodb,omitempty" yaml:"mongodb,omitempty"`
-	PooledMongoDB   *OutputMongoDb         `json:"pooled_mongodb,omitempty" yaml:"pooled_mongodb,omitempty"`
-	AwsDynamoDB     *OutputAwsDynamoDB     `json:"aws_dynamodb,omitempty" yaml:"aws_dynamodb,omitempty"`
+	SqlInsert              *SqlInsert                    `json:"sql_insert,omitempty" yaml:"sql_insert,omitempty"`
+	SqlRaw                 *SqlRaw                       `json:"sql_raw,omitempty" yaml:"sql_raw,omitempty"`
+	PooledSqlRaw           *PooledSqlRaw                 `json:"pooled_sql_raw,omitempty" yaml:"pooled_sql_raw,omitempty"`
+	PooledSqlInsert        *PooledSqlInsert              `json:"pooled_sql_insert,omitempty" yaml:"pooled_sql_insert,omitempty"`
+	PooledSqlUpdate        *PooledSqlUpdate              `json:"pooled_sql_update,omitempty" yaml:"pooled_sql_update,omitempty"`
+	AwsS3                  *AwsS3Insert                  `json:"aws_s3,omitempty" yaml:"aws_s3,omitempty"`
+	GcpCloudStorage        *GcpCloudStorageOutput        `json:"gcp_cloud_storage,omitempty" yaml:"gcp_cloud_storage,omitempty"`
+	NeosyncGcpCloudStorage *NeosyncGcpCloudStorageOutput `json:"neosync_gcp_cloud_storage,omitempty" yaml:"neosync_gcp_cloud_storage,omitempty"`
+	Retry                  *RetryConfig                  `json:"retry,omitempty" yaml:"retry,omitempty"`
+	Broker                 *OutputBrokerConfig           `json:"broker,omitempty" yaml:"broker,omitempty"`
+	DropOn                 *DropOnConfig                 `json:"drop_on,omitempty" yaml:"drop_on,omitempty"`
+	Drop                   *DropConfig                   `json:"drop,omitempty" yaml:"drop,omitempty"`
+	Resource               string                        `json:"resource,omitempty" yaml:"resource,omitempty"`
+	Fallback               []Outputs                     `json:"fallback,omitempty" yaml:"fallback,omitempty"`
+	RedisHashOutput        *RedisHashOutputConfig        `json:"redis_hash_output,omitempty" yaml:"redis_hash_output,omitempty"`
+	Error                  *ErrorOutputConfig            `json:"error,omitempty" yaml:"error,omitempty"`
+	Switch                 *SwitchOutputConfig           `json:"switch,omitempty" yaml:"switch,omitempty"`
+	MongoDB                *OutputMongoDb                `json:"mongodb,omitempty" yaml:"mongodb,omitempty"`
+	PooledMongoDB          *OutputMongoDb                `json:"pooled_mongodb,omitempty" yaml:"pooled_mongodb,omitempty"`
+	AwsDynamoDB            *OutputAwsDynamoDB            `json:"aws_dynamodb,omitempty" yaml:"aws_dynamodb,omitempty"`
+	NeosyncKafka           *OutputNeosyncKafka           `json:"neosync_kafka,omitempty" yaml:"neosync_kafka,omitempty"`
+	File                   *FileOutput                   `json:"file,omitempty" yaml:"file,omitempty"`
+}
+
+type FileOutput struct {
+	Path  string `json:"path" yaml:"path"`
+	Codec string `json:"codec" yaml:"codec"`
+}
+
+type OutputNeosyncKafka struct {
+	Brokers []string         `json:"brokers" yaml:"brokers"`
+	Sasl    *KafkaSaslConfig `json:"sasl,omitempty" yaml:"sasl,omitempty"`
+	Tls     *KafkaTlsConfig  `json:"tls,omitempty" yaml:"tls,omitempty"`
+	Topic   string           `json:"topic" yaml:"topic"`
+
+	Format     string  `json:"format" yaml:"format"`
+	AvroSchema *string `json:"avro_schema,omitempty" yaml:"avro_schema,omitempty"`
+
+	MaxInFlight *int      `json:"max_in_flight,omitempty" yaml:"max_in_flight,omitempty"`
+	Batching    *Batching `json:"batching,omitempty" yaml:"batching,omitempty"`
 }
 
 type SwitchOutputConfig struct {
@@ -382,6 +470,8 @@ type PooledSqlInsert struct {
 	ColumnsDataTypes         []string                            `json:"column_data_types" yaml:"column_data_types"`
 	ColumnDefaultProperties  map[string]*ColumnDefaultProperties `json:"column_default_properties" yaml:"column_default_properties"`
 	OnConflictDoNothing      bool                                `json:"on_conflict_do_nothing" yaml:"on_conflict_do_nothing"`
+	ConflictColumns          []string                            `json:"conflict_columns,omitempty" yaml:"conflict_columns,omitempty"`
+	OnConflictUpdate         *PooledSqlOnConflictUpdate          `json:"on_conflict_update,omitempty" yaml:"on_conflict_update,omitempty"`
 	TruncateOnRetry          bool                                `json:"truncate_on_retry" yaml:"truncate_on_retry"`
 	SkipForeignKeyViolations bool                                `json:"skip_foreign_key_violations" yaml:"skip_foreign_key_violations"`
 	ArgsMapping              string                              `json:"args_mapping" yaml:"args_mapping"`
@@ -390,6 +480,11 @@ type PooledSqlInsert struct {
 	Suffix                   *string                             `json:"suffix,omitempty" yaml:"suffix,omitempty"`
 }
 
+type PooledSqlOnConflictUpdate struct {
+	ConflictColumns []string `json:"conflict_columns" yaml:"conflict_columns"`
+	UpdateColumns   []string `json:"update_columns,omitempty" yaml:"update_columns,omitempty"`
+}
+
 type SqlInsert struct {
 	Driver          string    `json:"driver" yaml:"driver"`
 	Dsn             string    `json:"dsn" yaml:"dsn"`
@@ -442,6 +537,19 @@ type GcpCloudStorageOutput struct {
 	Timeout         *string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 }
 
+type NeosyncGcpCloudStorageOutput struct {
+	Bucket      string    `json:"bucket" yaml:"bucket"`
+	Path        string    `json:"path" yaml:"path"`
+	MaxInFlight int       `json:"max_in_flight" yaml:"max_in_flight"`
+	Batching    *Batching `json:"batching,omitempty" yaml:"batching,omitempty"`
+
+	ContentType     *string `json:"content_type,omitempty" yaml:"content_type,omitempty"`
+	ContentEncoding *string `json:"content_encoding,omitempty" yaml:"content_encoding,omitempty"`
+	StorageClass    *string `json:"storage_class,omitempty" yaml:"storage_class,omitempty"`
+	ChunkSize       *int    `json:"chunk_size,omitempty" yaml:"chunk_size,omitempty"`
+	Timeout         *string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
 type Batching struct {
 	Count      int               `json:"count" yaml:"count"`
 	ByteSize   int               `json:"byte_size" yaml:"byte_size"`
@@ -451,8 +559,26 @@ type Batching struct {
 }
 
 type BatchProcessor struct {
-	Archive  *ArchiveProcessor  `json:"archive,omitempty" yaml:"archive,omitempty"`
-	Compress *CompressProcessor `json:"compress,omitempty" yaml:"compress,omitempty"`
+	Archive          *ArchiveProcessor          `json:"archive,omitempty" yaml:"archive,omitempty"`
+	Compress         *CompressProcessor         `json:"compress,omitempty" yaml:"compress,omitempty"`
+	Mapping          *string                    `json:"mapping,omitempty" yaml:"mapping,omitempty"`
+	ParquetEncode    *ParquetEncodeProcessor    `json:"parquet_encode,omitempty" yaml:"parquet_encode,omitempty"`
+	NeosyncCsvEncode *NeosyncCsvEncodeProcessor `json:"neosync_csv_encode,omitempty" yaml:"neosync_csv_encode,omitempty"`
+}
+
+type ParquetEncodeProcessor struct {
+	Schema             []*ParquetSchemaField `json:"schema" yaml:"schema"`
+	DefaultCompression string                `json:"default_compression" yaml:"default_compression"`
+}
+
+type ParquetSchemaField struct {
+	Name     string `json:"name" yaml:"name"`
+	Type     string `json:"type" yaml:"type"`
+	Optional bool   `json:"optional" yaml:"optional"`
+}
+
+type NeosyncCsvEncodeProcessor struct {
+	Columns []string `json:"columns" yaml:"columns"`
 }
 
 type ArchiveProcessor struct {
@@ -465,6 +591,7 @@ type CompressProcessor struct {
 }
 
 type OutputBrokerConfig struct {
-	Pattern string    `json:"pattern" yaml:"pattern"`
-	Outputs []Outputs `json:"outputs" yaml:"outputs"`
+	Pattern  string    `json:"pattern" yaml:"pattern"`
+	Outputs  []Outputs `json:"outputs" yaml:"outputs"`
+	Batching *Batching `json:"batching,omitempty" yaml:"batching,omitempty"`
 }
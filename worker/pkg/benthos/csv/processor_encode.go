@@ -0,0 +1,114 @@
+package neosync_benthos_csv
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func csvEncodeProcessorSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary(`Encodes a batch of structured messages into a single CSV document with a header row`).
+		Field(service.NewStringListField("columns").Description("The columns, in order, that are written to the CSV document"))
+}
+
+// Registers a batch processor on a benthos environment called neosync_csv_encode
+func RegisterCsvEncodeProcessor(env *service.Environment) error {
+	return env.RegisterBatchProcessor(
+		"neosync_csv_encode", csvEncodeProcessorSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchProcessor, error) {
+			proc, err := newCsvEncodeProcessor(conf)
+			if err != nil {
+				return nil, err
+			}
+			return proc, nil
+		})
+}
+
+type csvEncodeProcessor struct {
+	columns []string
+}
+
+func newCsvEncodeProcessor(conf *service.ParsedConfig) (*csvEncodeProcessor, error) {
+	columns, err := conf.FieldStringList("columns")
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("must provide at least one column")
+	}
+	return &csvEncodeProcessor{columns: columns}, nil
+}
+
+func (p *csvEncodeProcessor) ProcessBatch(_ context.Context, batch service.MessageBatch) ([]service.MessageBatch, error) {
+	if len(batch) == 0 {
+		return []service.MessageBatch{}, nil
+	}
+
+	buf := &bytes.Buffer{}
+	writer := csv.NewWriter(buf)
+	if err := writer.Write(p.columns); err != nil {
+		return nil, err
+	}
+
+	record := make([]string, len(p.columns))
+	for _, msg := range batch {
+		root, err := msg.AsStructured()
+		if err != nil {
+			return nil, err
+		}
+		row, ok := root.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("csv encoding requires an object message, received: %T", root)
+		}
+		for idx, col := range p.columns {
+			value, err := toCsvValue(row[col])
+			if err != nil {
+				return nil, fmt.Errorf("unable to encode column %s to csv: %w", col, err)
+			}
+			record[idx] = value
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+
+	out := batch[0].Copy()
+	out.SetBytes(buf.Bytes())
+	return []service.MessageBatch{{out}}, nil
+}
+
+func (p *csvEncodeProcessor) Close(ctx context.Context) error {
+	return nil
+}
+
+// Null values are written as empty fields
+func toCsvValue(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case map[string]any, []any:
+		bits, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(bits), nil
+	default:
+		return fmt.Sprintf("%v", v), nil
+	}
+}
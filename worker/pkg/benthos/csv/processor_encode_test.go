@@ -0,0 +1,50 @@
+package neosync_benthos_csv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func Test_CsvEncodeProcessor(t *testing.T) {
+	conf := `
+columns: ["id", "name", "created_at", "tags"]
+`
+	config, err := csvEncodeProcessorSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+	proc, err := newCsvEncodeProcessor(config)
+	require.NoError(t, err)
+
+	first := service.NewMessage(nil)
+	first.SetStructured(map[string]any{
+		"id":         int64(1),
+		"name":       "Smith, John",
+		"created_at": time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		"tags":       []any{"a", "b"},
+	})
+	second := service.NewMessage(nil)
+	second.SetStructured(map[string]any{
+		"id":   int64(2),
+		"name": nil,
+	})
+
+	batches, err := proc.ProcessBatch(context.Background(), service.MessageBatch{first, second})
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	require.Len(t, batches[0], 1)
+
+	bits, err := batches[0][0].AsBytes()
+	require.NoError(t, err)
+	require.Equal(t, "id,name,created_at,tags\n1,\"Smith, John\",2024-01-02T03:04:05Z,\"[\"\"a\"\",\"\"b\"\"]\"\n2,,,\n", string(bits))
+	require.NoError(t, proc.Close(context.Background()))
+}
+
+func Test_CsvEncodeProcessor_NoColumns(t *testing.T) {
+	config, err := csvEncodeProcessorSpec().ParseYAML(`columns: []`, service.NewEnvironment())
+	require.NoError(t, err)
+	_, err = newCsvEncodeProcessor(config)
+	require.Error(t, err)
+}
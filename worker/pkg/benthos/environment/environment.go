@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"log/slog"
 
+	neosync_benthos_csv "github.com/nucleuscloud/neosync/worker/pkg/benthos/csv"
 	neosync_benthos_defaulttransform "github.com/nucleuscloud/neosync/worker/pkg/benthos/default_transform"
 	neosync_benthos_dynamodb "github.com/nucleuscloud/neosync/worker/pkg/benthos/dynamodb"
 	neosync_benthos_error "github.com/nucleuscloud/neosync/worker/pkg/benthos/error"
+	neosync_benthos_gcp "github.com/nucleuscloud/neosync/worker/pkg/benthos/gcp"
+	neosync_benthos_kafka "github.com/nucleuscloud/neosync/worker/pkg/benthos/kafka"
 	benthos_metrics "github.com/nucleuscloud/neosync/worker/pkg/benthos/metrics"
 	neosync_benthos_mongodb "github.com/nucleuscloud/neosync/worker/pkg/benthos/mongodb"
 	openaigenerate "github.com/nucleuscloud/neosync/worker/pkg/benthos/openai_generate"
+	profilegenerate "github.com/nucleuscloud/neosync/worker/pkg/benthos/profile_generate"
 	neosync_benthos_sql "github.com/nucleuscloud/neosync/worker/pkg/benthos/sql"
 	"github.com/warpstreamlabs/bento/public/bloblang"
 	"github.com/warpstreamlabs/bento/public/service"
@@ -123,6 +127,11 @@ func NewWithEnvironment(env *service.Environment, logger *slog.Logger, opts ...O
 		return nil, fmt.Errorf("unable to register openai_generate input to benthos instance: %w", err)
 	}
 
+	err = profilegenerate.RegisterProfileGenerate(env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register profile_generate input to benthos instance: %w", err)
+	}
+
 	err = neosync_benthos_error.RegisterErrorProcessor(env, config.stopChannel)
 	if err != nil {
 		return nil, fmt.Errorf("unable to register error processor to benthos instance: %w", err)
@@ -143,11 +152,31 @@ func NewWithEnvironment(env *service.Environment, logger *slog.Logger, opts ...O
 		return nil, fmt.Errorf("unable to register dynamodb output to benthos instance: %w", err)
 	}
 
+	err = neosync_benthos_kafka.RegisterKafkaInput(env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register kafka input to benthos instance: %w", err)
+	}
+
+	err = neosync_benthos_kafka.RegisterKafkaOutput(env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register kafka output to benthos instance: %w", err)
+	}
+
 	err = neosync_benthos_defaulttransform.ReisterDefaultTransformerProcessor(env)
 	if err != nil {
 		return nil, fmt.Errorf("unable to register default mapping processor to benthos instance: %w", err)
 	}
 
+	err = neosync_benthos_gcp.RegisterCloudStorageOutput(env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register gcp cloud storage output to benthos instance: %w", err)
+	}
+
+	err = neosync_benthos_csv.RegisterCsvEncodeProcessor(env)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register csv encode processor to benthos instance: %w", err)
+	}
+
 	if config.blobEnv != nil {
 		env.UseBloblangEnvironment(config.blobEnv)
 	}
@@ -0,0 +1,240 @@
+package neosync_benthos_gcp
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+const (
+	csoFieldBucket          = "bucket"
+	csoFieldPath            = "path"
+	csoFieldContentType     = "content_type"
+	csoFieldContentEncoding = "content_encoding"
+	csoFieldStorageClass    = "storage_class"
+	csoFieldChunkSize       = "chunk_size"
+	csoFieldTimeout         = "timeout"
+	csoFieldBatching        = "batching"
+)
+
+// Mirrors the gcp_cloud_storage output with support for setting the storage class of each object.
+// Objects that already exist at the same path are overwritten
+func cloudStorageOutputSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary(`Sends message parts as objects to a Google Cloud Storage bucket.`).
+		Fields(
+			service.NewStringField(csoFieldBucket),
+			service.NewInterpolatedStringField(csoFieldPath).
+				Default(`${!count("files")}-${!timestamp_unix_nano()}.txt`),
+			service.NewInterpolatedStringField(csoFieldContentType).
+				Default("application/octet-stream"),
+			service.NewInterpolatedStringField(csoFieldContentEncoding).
+				Default(""),
+			service.NewStringField(csoFieldStorageClass).
+				Description("The storage class of each object. Uses the bucket's default storage class when empty.").
+				Default(""),
+			service.NewIntField(csoFieldChunkSize).
+				Default(16*1024*1024), // googleapi.DefaultUploadChunkSize
+			service.NewDurationField(csoFieldTimeout).
+				Default("3s"),
+			service.NewOutputMaxInFlightField(),
+			service.NewBatchPolicyField(csoFieldBatching),
+		)
+}
+
+// Registers an output on a benthos environment called neosync_gcp_cloud_storage
+func RegisterCloudStorageOutput(env *service.Environment) error {
+	return env.RegisterBatchOutput(
+		"neosync_gcp_cloud_storage", cloudStorageOutputSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (out service.BatchOutput, batchPolicy service.BatchPolicy, maxInFlight int, err error) {
+			if maxInFlight, err = conf.FieldMaxInFlight(); err != nil {
+				return
+			}
+			if batchPolicy, err = conf.FieldBatchPolicy(csoFieldBatching); err != nil {
+				return
+			}
+			out, err = newCloudStorageOutput(conf, newStorageUploader)
+			return
+		})
+}
+
+type objectUpload struct {
+	Path            string
+	ContentType     string
+	ContentEncoding string
+	StorageClass    string
+	ChunkSize       int
+	Metadata        map[string]string
+	Data            []byte
+}
+
+type uploader interface {
+	Upload(ctx context.Context, bucket string, upload *objectUpload) error
+	Close() error
+}
+
+type storageUploader struct {
+	client *storage.Client
+}
+
+func newStorageUploader(ctx context.Context) (uploader, error) {
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &storageUploader{client: client}, nil
+}
+
+func (s *storageUploader) Upload(ctx context.Context, bucket string, upload *objectUpload) error {
+	w := s.client.Bucket(bucket).Object(upload.Path).NewWriter(ctx)
+	w.ChunkSize = upload.ChunkSize
+	w.ContentType = upload.ContentType
+	w.ContentEncoding = upload.ContentEncoding
+	w.StorageClass = upload.StorageClass
+	w.Metadata = upload.Metadata
+
+	if _, err := w.Write(upload.Data); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (s *storageUploader) Close() error {
+	return s.client.Close()
+}
+
+type cloudStorageOutput struct {
+	bucket          string
+	path            *service.InterpolatedString
+	contentType     *service.InterpolatedString
+	contentEncoding *service.InterpolatedString
+	storageClass    string
+	chunkSize       int
+	timeout         time.Duration
+
+	newUploader func(ctx context.Context) (uploader, error)
+	uploader    uploader
+	connMut     sync.RWMutex
+}
+
+func newCloudStorageOutput(
+	conf *service.ParsedConfig,
+	newUploader func(ctx context.Context) (uploader, error),
+) (*cloudStorageOutput, error) {
+	bucket, err := conf.FieldString(csoFieldBucket)
+	if err != nil {
+		return nil, err
+	}
+	path, err := conf.FieldInterpolatedString(csoFieldPath)
+	if err != nil {
+		return nil, err
+	}
+	contentType, err := conf.FieldInterpolatedString(csoFieldContentType)
+	if err != nil {
+		return nil, err
+	}
+	contentEncoding, err := conf.FieldInterpolatedString(csoFieldContentEncoding)
+	if err != nil {
+		return nil, err
+	}
+	storageClass, err := conf.FieldString(csoFieldStorageClass)
+	if err != nil {
+		return nil, err
+	}
+	chunkSize, err := conf.FieldInt(csoFieldChunkSize)
+	if err != nil {
+		return nil, err
+	}
+	timeout, err := conf.FieldDuration(csoFieldTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return &cloudStorageOutput{
+		bucket:          bucket,
+		path:            path,
+		contentType:     contentType,
+		contentEncoding: contentEncoding,
+		storageClass:    storageClass,
+		chunkSize:       chunkSize,
+		timeout:         timeout,
+		newUploader:     newUploader,
+	}, nil
+}
+
+func (g *cloudStorageOutput) Connect(ctx context.Context) error {
+	g.connMut.Lock()
+	defer g.connMut.Unlock()
+
+	// the client outlives the connect context
+	u, err := g.newUploader(context.Background())
+	if err != nil {
+		return err
+	}
+	g.uploader = u
+	return nil
+}
+
+func (g *cloudStorageOutput) WriteBatch(ctx context.Context, batch service.MessageBatch) error {
+	g.connMut.RLock()
+	u := g.uploader
+	g.connMut.RUnlock()
+
+	if u == nil {
+		return service.ErrNotConnected
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+
+	return batch.WalkWithBatchedErrors(func(i int, msg *service.Message) error {
+		metadata := map[string]string{}
+		_ = msg.MetaWalk(func(k, v string) error {
+			metadata[k] = v
+			return nil
+		})
+
+		outputPath, err := batch.TryInterpolatedString(i, g.path)
+		if err != nil {
+			return fmt.Errorf("path interpolation error: %w", err)
+		}
+		contentType, err := batch.TryInterpolatedString(i, g.contentType)
+		if err != nil {
+			return fmt.Errorf("content type interpolation error: %w", err)
+		}
+		contentEncoding, err := batch.TryInterpolatedString(i, g.contentEncoding)
+		if err != nil {
+			return fmt.Errorf("content encoding interpolation error: %w", err)
+		}
+		data, err := msg.AsBytes()
+		if err != nil {
+			return err
+		}
+
+		return u.Upload(ctx, g.bucket, &objectUpload{
+			Path:            outputPath,
+			ContentType:     contentType,
+			ContentEncoding: contentEncoding,
+			StorageClass:    g.storageClass,
+			ChunkSize:       g.chunkSize,
+			Metadata:        metadata,
+			Data:            data,
+		})
+	})
+}
+
+func (g *cloudStorageOutput) Close(ctx context.Context) error {
+	g.connMut.Lock()
+	defer g.connMut.Unlock()
+
+	var err error
+	if g.uploader != nil {
+		err = g.uploader.Close()
+		g.uploader = nil
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+package neosync_benthos_gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+type fakeUploader struct {
+	uploads []*objectUpload
+	closed  bool
+}
+
+func (f *fakeUploader) Upload(_ context.Context, bucket string, upload *objectUpload) error {
+	f.uploads = append(f.uploads, upload)
+	return nil
+}
+
+func (f *fakeUploader) Close() error {
+	f.closed = true
+	return nil
+}
+
+func Test_CloudStorageOutput(t *testing.T) {
+	conf := `
+bucket: my-bucket
+path: 'data/${! meta("table") }.parquet'
+content_type: application/vnd.apache.parquet
+storage_class: NEARLINE
+`
+	parsed, err := cloudStorageOutputSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+
+	fake := &fakeUploader{}
+	out, err := newCloudStorageOutput(parsed, func(ctx context.Context) (uploader, error) {
+		return fake, nil
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	msg := service.NewMessage([]byte("content"))
+	msg.MetaSet("table", "users")
+	require.ErrorIs(t, out.WriteBatch(ctx, service.MessageBatch{msg}), service.ErrNotConnected)
+
+	require.NoError(t, out.Connect(ctx))
+	require.NoError(t, out.WriteBatch(ctx, service.MessageBatch{msg}))
+	require.Len(t, fake.uploads, 1)
+	require.Equal(t, "data/users.parquet", fake.uploads[0].Path)
+	require.Equal(t, "application/vnd.apache.parquet", fake.uploads[0].ContentType)
+	require.Equal(t, "", fake.uploads[0].ContentEncoding)
+	require.Equal(t, "NEARLINE", fake.uploads[0].StorageClass)
+	require.Equal(t, []byte("content"), fake.uploads[0].Data)
+
+	require.NoError(t, out.Close(ctx))
+	require.True(t, fake.closed)
+}
@@ -0,0 +1,198 @@
+package neosync_benthos_kafka
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/nucleuscloud/neosync/backend/pkg/kafkaconnect"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+const (
+	kFieldBrokers    = "brokers"
+	kFieldSasl       = "sasl"
+	kFieldMechanism  = "mechanism"
+	kFieldUsername   = "username"
+	kFieldPassword   = "password"
+	kFieldTls        = "tls"
+	kFieldEnabled    = "enabled"
+	kFieldRootCert   = "root_cert"
+	kFieldClientCert = "client_cert"
+	kFieldClientKey  = "client_key"
+	kFieldTopic      = "topic"
+	kFieldFormat     = "format"
+	kFieldAvroSchema = "avro_schema"
+
+	FormatJson = "json"
+	FormatAvro = "avro"
+
+	// Metadata keys that are set on consumed messages and used by the output to preserve the original record
+	MetaKey       = "kafka_key"
+	MetaHeaders   = "kafka_headers"
+	MetaTopic     = "kafka_topic"
+	MetaPartition = "kafka_partition"
+	MetaOffset    = "kafka_offset"
+	MetaTimestamp = "kafka_timestamp_unix"
+)
+
+func clientConfigFields() []*service.ConfigField {
+	return []*service.ConfigField{
+		service.NewStringListField(kFieldBrokers).
+			Description("A list of broker addresses to connect to."),
+		service.NewObjectField(kFieldSasl,
+			service.NewStringEnumField(kFieldMechanism,
+				kafkaconnect.SaslMechanismPlain,
+				kafkaconnect.SaslMechanismScramSha256,
+				kafkaconnect.SaslMechanismScramSha512,
+			).Default(kafkaconnect.SaslMechanismPlain),
+			service.NewStringField(kFieldUsername),
+			service.NewStringField(kFieldPassword).Secret().Default(""),
+		).
+			Description("Optional SASL authentication.").
+			Optional(),
+		service.NewObjectField(kFieldTls,
+			service.NewBoolField(kFieldEnabled).Default(false),
+			service.NewStringField(kFieldRootCert).Description("Root certificate in PEM format").Default(""),
+			service.NewStringField(kFieldClientCert).Description("Client certificate in PEM format").Default(""),
+			service.NewStringField(kFieldClientKey).Description("Client key in PEM format").Secret().Default(""),
+		).
+			Description("Optional TLS configuration.").
+			Optional(),
+	}
+}
+
+func formatFields() []*service.ConfigField {
+	return []*service.ConfigField{
+		service.NewStringEnumField(kFieldFormat, FormatJson, FormatAvro).
+			Description("The encoding of the message values.").
+			Default(FormatJson),
+		service.NewStringField(kFieldAvroSchema).
+			Description("The Avro schema of the message values. Required when the format is avro.").
+			Optional(),
+	}
+}
+
+func clientConfigFromParsed(conf *service.ParsedConfig) (*kafkaconnect.ClientConfig, error) {
+	brokers, err := conf.FieldStringList(kFieldBrokers)
+	if err != nil {
+		return nil, err
+	}
+	output := &kafkaconnect.ClientConfig{Brokers: brokers}
+
+	if conf.Contains(kFieldSasl) {
+		saslConf := conf.Namespace(kFieldSasl)
+		if output.SaslMechanism, err = saslConf.FieldString(kFieldMechanism); err != nil {
+			return nil, err
+		}
+		if output.Username, err = saslConf.FieldString(kFieldUsername); err != nil {
+			return nil, err
+		}
+		if output.Password, err = saslConf.FieldString(kFieldPassword); err != nil {
+			return nil, err
+		}
+	}
+
+	if conf.Contains(kFieldTls) {
+		tlsConf := conf.Namespace(kFieldTls)
+		if output.EnableTls, err = tlsConf.FieldBool(kFieldEnabled); err != nil {
+			return nil, err
+		}
+		if output.RootCert, err = tlsConf.FieldString(kFieldRootCert); err != nil {
+			return nil, err
+		}
+		if output.ClientCert, err = tlsConf.FieldString(kFieldClientCert); err != nil {
+			return nil, err
+		}
+		if output.ClientKey, err = tlsConf.FieldString(kFieldClientKey); err != nil {
+			return nil, err
+		}
+	}
+	return output, nil
+}
+
+// Converts message values between their wire format and the structured form used by the transformers
+type valueCodec interface {
+	Decode(value []byte) (any, error)
+	Encode(structured any) ([]byte, error)
+}
+
+func valueCodecFromParsed(conf *service.ParsedConfig) (valueCodec, error) {
+	format, err := conf.FieldString(kFieldFormat)
+	if err != nil {
+		return nil, err
+	}
+	var schema string
+	if conf.Contains(kFieldAvroSchema) {
+		if schema, err = conf.FieldString(kFieldAvroSchema); err != nil {
+			return nil, err
+		}
+	}
+	return newValueCodec(format, schema)
+}
+
+func newValueCodec(format, avroSchema string) (valueCodec, error) {
+	switch format {
+	case "", FormatJson:
+		return jsonCodec{}, nil
+	case FormatAvro:
+		if avroSchema == "" {
+			return nil, errors.New("must provide an avro schema when the message format is avro")
+		}
+		// the standard json codec represents unions as their plain values instead of wrapping them in a type name
+		codec, err := goavro.NewCodecForStandardJSONFull(avroSchema)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse avro schema: %w", err)
+		}
+		return &avroCodec{codec: codec}, nil
+	default:
+		return nil, fmt.Errorf("unsupported kafka message format: %q", format)
+	}
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Decode(value []byte) (any, error) {
+	var output any
+	if err := json.Unmarshal(value, &output); err != nil {
+		return nil, fmt.Errorf("unable to decode json message value: %w", err)
+	}
+	return output, nil
+}
+
+func (jsonCodec) Encode(structured any) ([]byte, error) {
+	return json.Marshal(structured)
+}
+
+type avroCodec struct {
+	codec *goavro.Codec
+}
+
+func (a *avroCodec) Decode(value []byte) (any, error) {
+	native, _, err := a.codec.NativeFromBinary(value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode avro message value: %w", err)
+	}
+	textual, err := a.codec.TextualFromNative(nil, native)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode avro message value: %w", err)
+	}
+	var output any
+	if err := json.Unmarshal(textual, &output); err != nil {
+		return nil, fmt.Errorf("unable to decode avro message value: %w", err)
+	}
+	return output, nil
+}
+
+func (a *avroCodec) Encode(structured any) ([]byte, error) {
+	textual, err := json.Marshal(structured)
+	if err != nil {
+		return nil, err
+	}
+	native, _, err := a.codec.NativeFromTextual(textual)
+	if err != nil {
+		return nil, fmt.Errorf("message does not match avro schema: %w", err)
+	}
+	return a.codec.BinaryFromNative(nil, native)
+}
@@ -0,0 +1,64 @@
+package neosync_benthos_kafka
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testAvroSchema = `{
+  "type": "record",
+  "name": "user",
+  "fields": [
+    {"name": "name", "type": "string"},
+    {"name": "age", "type": "int"},
+    {"name": "email", "type": ["null", "string"], "default": null}
+  ]
+}`
+
+func Test_newValueCodec(t *testing.T) {
+	codec, err := newValueCodec("", "")
+	require.NoError(t, err)
+	require.IsType(t, jsonCodec{}, codec)
+
+	_, err = newValueCodec(FormatAvro, "")
+	require.Error(t, err)
+
+	_, err = newValueCodec(FormatAvro, "not a schema")
+	require.Error(t, err)
+
+	_, err = newValueCodec("protobuf", "")
+	require.Error(t, err)
+}
+
+func Test_jsonCodec_RoundTrip(t *testing.T) {
+	codec := jsonCodec{}
+	structured, err := codec.Decode([]byte(`{"name": "nick", "tags": ["a", "b"]}`))
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"name": "nick", "tags": []any{"a", "b"}}, structured)
+
+	value, err := codec.Encode(structured)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"name": "nick", "tags": ["a", "b"]}`, string(value))
+}
+
+func Test_avroCodec_RoundTrip(t *testing.T) {
+	codec, err := newValueCodec(FormatAvro, testAvroSchema)
+	require.NoError(t, err)
+
+	value, err := codec.Encode(map[string]any{"name": "nick", "age": 30, "email": "nick@example.com"})
+	require.NoError(t, err)
+
+	structured, err := codec.Decode(value)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"name": "nick", "age": float64(30), "email": "nick@example.com"}, structured)
+
+	value, err = codec.Encode(map[string]any{"name": "nick", "age": 30, "email": nil})
+	require.NoError(t, err)
+	structured, err = codec.Decode(value)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"name": "nick", "age": float64(30), "email": nil}, structured)
+
+	_, err = codec.Encode(map[string]any{"name": "nick"})
+	require.Error(t, err, "messages must match the schema")
+}
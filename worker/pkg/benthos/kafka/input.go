@@ -0,0 +1,430 @@
+package neosync_benthos_kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nucleuscloud/neosync/backend/pkg/kafkaconnect"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+const (
+	kiFieldStartOffset      = "start_offset"
+	kiFieldEndOffset        = "end_offset"
+	kiFieldStartTimestampMs = "start_timestamp_ms"
+	kiFieldEndTimestampMs   = "end_timestamp_ms"
+	kiFieldIdleTimeout      = "idle_timeout"
+
+	// special timestamps used by the list offsets api
+	earliestOffsetTimestamp = -2
+	latestOffsetTimestamp   = -1
+)
+
+func kafkaInputConfigSpec() *service.ConfigSpec {
+	spec := service.NewConfigSpec().
+		Categories("Services").
+		Summary("Consumes a bounded range of a kafka topic and creates a message for each record received. The input ends once every partition has been read up to the end of the range.").
+		Fields(clientConfigFields()...).
+		Field(service.NewStringField(kFieldTopic).
+			Description("The topic to consume.")).
+		Field(service.NewIntField(kiFieldStartOffset).
+			Description("The offset, inclusive, to begin consuming each partition from. Defaults to the earliest offset.").
+			Optional()).
+		Field(service.NewIntField(kiFieldEndOffset).
+			Description("The offset, exclusive, to stop consuming each partition at. Defaults to the latest offset at the time of connecting.").
+			Optional()).
+		Field(service.NewIntField(kiFieldStartTimestampMs).
+			Description("Begin consuming each partition at the first record produced at or after this unix timestamp in milliseconds. Can not be combined with the offset range.").
+			Optional()).
+		Field(service.NewIntField(kiFieldEndTimestampMs).
+			Description("Stop consuming each partition at the first record produced at or after this unix timestamp in milliseconds. Can not be combined with the offset range.").
+			Optional()).
+		Field(service.NewDurationField(kiFieldIdleTimeout).
+			Description("How long to wait for new records before considering the remaining partitions exhausted. Covers ranges that end on records that are never delivered, like transaction markers.").
+			Default("10s").
+			Advanced()).
+		Fields(formatFields()...)
+	return spec
+}
+
+func RegisterKafkaInput(env *service.Environment) error {
+	return env.RegisterBatchInput(
+		"neosync_kafka", kafkaInputConfigSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchInput, error) {
+			return newKafkaBatchInput(conf, mgr.Logger())
+		},
+	)
+}
+
+func newKafkaBatchInput(conf *service.ParsedConfig, logger *service.Logger) (service.BatchInput, error) {
+	clientConfig, err := clientConfigFromParsed(conf)
+	if err != nil {
+		return nil, err
+	}
+	clientOpts, err := kafkaconnect.NewClientOpts(clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	topic, err := conf.FieldString(kFieldTopic)
+	if err != nil {
+		return nil, err
+	}
+	bounds, err := rangeBoundsFromParsed(conf)
+	if err != nil {
+		return nil, err
+	}
+	idleTimeout, err := conf.FieldDuration(kiFieldIdleTimeout)
+	if err != nil {
+		return nil, err
+	}
+	codec, err := valueCodecFromParsed(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafkaInput{
+		clientOpts:  clientOpts,
+		logger:      logger,
+		topic:       topic,
+		bounds:      bounds,
+		idleTimeout: idleTimeout,
+		codec:       codec,
+	}, nil
+}
+
+// The requested range of the topic. Either offsets or timestamps are set, never both.
+type rangeBounds struct {
+	startOffset *int64
+	endOffset   *int64
+
+	startTimestampMs *int64
+	endTimestampMs   *int64
+}
+
+func rangeBoundsFromParsed(conf *service.ParsedConfig) (*rangeBounds, error) {
+	bounds := &rangeBounds{}
+	fields := []struct {
+		name  string
+		value **int64
+	}{
+		{kiFieldStartOffset, &bounds.startOffset},
+		{kiFieldEndOffset, &bounds.endOffset},
+		{kiFieldStartTimestampMs, &bounds.startTimestampMs},
+		{kiFieldEndTimestampMs, &bounds.endTimestampMs},
+	}
+	for _, field := range fields {
+		if !conf.Contains(field.name) {
+			continue
+		}
+		value, err := conf.FieldInt(field.name)
+		if err != nil {
+			return nil, err
+		}
+		v := int64(value)
+		*field.value = &v
+	}
+	if (bounds.startOffset != nil || bounds.endOffset != nil) && (bounds.startTimestampMs != nil || bounds.endTimestampMs != nil) {
+		return nil, errors.New("kafka input range must be bounded by either offsets or timestamps, not both")
+	}
+	return bounds, nil
+}
+
+type partitionRange struct {
+	// inclusive
+	start int64
+	// exclusive
+	end int64
+}
+
+type kafkaInput struct {
+	clientOpts []kgo.Opt
+	client     *kgo.Client // lazy
+	logger     *service.Logger
+	readMu     sync.Mutex
+
+	topic       string
+	bounds      *rangeBounds
+	idleTimeout time.Duration
+	codec       valueCodec
+
+	// partitions that still have records left to read within their range
+	remaining map[int32]partitionRange
+}
+
+var _ service.BatchInput = &kafkaInput{}
+
+func (k *kafkaInput) Connect(ctx context.Context) error {
+	k.readMu.Lock()
+	defer k.readMu.Unlock()
+
+	if k.client != nil || k.remaining != nil {
+		return nil
+	}
+
+	adminClient, err := kgo.NewClient(k.clientOpts...)
+	if err != nil {
+		return fmt.Errorf("unable to create kafka client: %w", err)
+	}
+	defer adminClient.Close()
+
+	ranges, err := getPartitionRanges(ctx, adminClient, k.topic, k.bounds)
+	if err != nil {
+		return err
+	}
+	k.remaining = ranges
+	if len(ranges) == 0 {
+		k.logger.Infof("kafka topic %q has no records within the requested range", k.topic)
+		return nil
+	}
+
+	offsets := map[int32]kgo.Offset{}
+	for partition, r := range ranges {
+		offsets[partition] = kgo.NewOffset().At(r.start)
+	}
+	opts := append([]kgo.Opt{}, k.clientOpts...)
+	opts = append(opts,
+		kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{k.topic: offsets}),
+		// matches the isolation level used when listing offsets so the end of the range is the last stable offset
+		kgo.FetchIsolationLevel(kgo.ReadCommitted()),
+	)
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return fmt.Errorf("unable to create kafka consumer: %w", err)
+	}
+	k.client = client
+	return nil
+}
+
+func (k *kafkaInput) ReadBatch(ctx context.Context) (service.MessageBatch, service.AckFunc, error) {
+	k.readMu.Lock()
+	defer k.readMu.Unlock()
+	if k.remaining == nil {
+		return nil, nil, service.ErrNotConnected
+	}
+	if len(k.remaining) == 0 {
+		return nil, nil, service.ErrEndOfInput
+	}
+
+	pollCtx, cancel := context.WithTimeout(ctx, k.idleTimeout)
+	defer cancel()
+	fetches := k.client.PollFetches(pollCtx)
+	if fetches.IsClientClosed() {
+		return nil, nil, service.ErrNotConnected
+	}
+	if ctx.Err() != nil {
+		return nil, nil, ctx.Err()
+	}
+	for _, fetchErr := range fetches.Errors() {
+		if errors.Is(fetchErr.Err, context.DeadlineExceeded) {
+			continue
+		}
+		return nil, nil, fmt.Errorf("unable to fetch kafka topic %q partition %d: %w", fetchErr.Topic, fetchErr.Partition, fetchErr.Err)
+	}
+
+	if fetches.NumRecords() == 0 && pollCtx.Err() != nil {
+		k.logger.Warnf("no records received from kafka topic %q within %s, considering %d remaining partitions to be exhausted", k.topic, k.idleTimeout, len(k.remaining))
+		k.remaining = map[int32]partitionRange{}
+		return nil, nil, service.ErrEndOfInput
+	}
+
+	batch := service.MessageBatch{}
+	finished := []int32{}
+	var decodeErr error
+	fetches.EachRecord(func(record *kgo.Record) {
+		if decodeErr != nil {
+			return
+		}
+		r, ok := k.remaining[record.Partition]
+		if !ok {
+			return
+		}
+		if record.Offset+1 >= r.end {
+			delete(k.remaining, record.Partition)
+			finished = append(finished, record.Partition)
+		}
+		// offsets can skip past the end of the range when the last records in the range were compacted away
+		if record.Offset >= r.end {
+			return
+		}
+		msg, err := k.toMessage(record)
+		if err != nil {
+			decodeErr = err
+			return
+		}
+		batch = append(batch, msg)
+	})
+	if decodeErr != nil {
+		return nil, nil, decodeErr
+	}
+
+	if len(finished) > 0 {
+		k.client.RemoveConsumePartitions(map[string][]int32{k.topic: finished})
+	}
+	return batch, emptyAck, nil
+}
+
+func (k *kafkaInput) toMessage(record *kgo.Record) (*service.Message, error) {
+	msg := service.NewMessage(nil)
+	if record.Value != nil {
+		structured, err := k.codec.Decode(record.Value)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode record at offset %d of kafka topic %q partition %d: %w", record.Offset, record.Topic, record.Partition, err)
+		}
+		msg.SetStructuredMut(structured)
+	}
+	if record.Key != nil {
+		msg.MetaSetMut(MetaKey, record.Key)
+	}
+	if len(record.Headers) > 0 {
+		msg.MetaSetMut(MetaHeaders, record.Headers)
+	}
+	msg.MetaSetMut(MetaTopic, record.Topic)
+	msg.MetaSetMut(MetaPartition, record.Partition)
+	msg.MetaSetMut(MetaOffset, record.Offset)
+	msg.MetaSetMut(MetaTimestamp, record.Timestamp.Unix())
+	return msg, nil
+}
+
+func emptyAck(ctx context.Context, err error) error {
+	return nil
+}
+
+func (k *kafkaInput) Close(ctx context.Context) error {
+	k.readMu.Lock()
+	defer k.readMu.Unlock()
+	if k.client == nil {
+		return nil
+	}
+	k.client.Close()
+	k.client = nil
+	return nil
+}
+
+// Resolves the requested range to a concrete offset range for every partition of the topic.
+// Partitions that have no records within the range are omitted.
+func getPartitionRanges(ctx context.Context, client *kgo.Client, topic string, bounds *rangeBounds) (map[int32]partitionRange, error) {
+	partitions, err := getTopicPartitions(ctx, client, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	earliest, err := listOffsets(ctx, client, topic, partitions, earliestOffsetTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := listOffsets(ctx, client, topic, partitions, latestOffsetTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	var startByTimestamp, endByTimestamp map[int32]int64
+	if bounds.startTimestampMs != nil {
+		if startByTimestamp, err = listOffsets(ctx, client, topic, partitions, *bounds.startTimestampMs); err != nil {
+			return nil, err
+		}
+	}
+	if bounds.endTimestampMs != nil {
+		if endByTimestamp, err = listOffsets(ctx, client, topic, partitions, *bounds.endTimestampMs); err != nil {
+			return nil, err
+		}
+	}
+
+	return computePartitionRanges(partitions, earliest, latest, bounds, startByTimestamp, endByTimestamp), nil
+}
+
+func computePartitionRanges(
+	partitions []int32,
+	earliest, latest map[int32]int64,
+	bounds *rangeBounds,
+	startByTimestamp, endByTimestamp map[int32]int64,
+) map[int32]partitionRange {
+	output := map[int32]partitionRange{}
+	for _, partition := range partitions {
+		r := partitionRange{start: earliest[partition], end: latest[partition]}
+
+		if bounds.startOffset != nil && *bounds.startOffset > r.start {
+			r.start = *bounds.startOffset
+		}
+		if bounds.endOffset != nil && *bounds.endOffset < r.end {
+			r.end = *bounds.endOffset
+		}
+		// a timestamp lookup returns -1 when there are no records at or after the timestamp
+		if startByTimestamp != nil {
+			if offset := startByTimestamp[partition]; offset >= 0 {
+				r.start = max(r.start, offset)
+			} else {
+				r.start = r.end
+			}
+		}
+		if endByTimestamp != nil {
+			if offset := endByTimestamp[partition]; offset >= 0 {
+				r.end = min(r.end, offset)
+			}
+		}
+
+		if r.start < r.end {
+			output[partition] = r
+		}
+	}
+	return output
+}
+
+func getTopicPartitions(ctx context.Context, client *kgo.Client, topic string) ([]int32, error) {
+	req := kmsg.NewPtrMetadataRequest()
+	reqTopic := kmsg.NewMetadataRequestTopic()
+	reqTopic.Topic = kmsg.StringPtr(topic)
+	req.Topics = append(req.Topics, reqTopic)
+
+	resp, err := req.RequestWith(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve metadata for kafka topic %q: %w", topic, err)
+	}
+	if len(resp.Topics) != 1 {
+		return nil, fmt.Errorf("unable to retrieve metadata for kafka topic %q: expected 1 topic but received %d", topic, len(resp.Topics))
+	}
+	if err := kerr.ErrorForCode(resp.Topics[0].ErrorCode); err != nil {
+		return nil, fmt.Errorf("unable to retrieve metadata for kafka topic %q: %w", topic, err)
+	}
+	partitions := make([]int32, 0, len(resp.Topics[0].Partitions))
+	for _, partition := range resp.Topics[0].Partitions {
+		partitions = append(partitions, partition.Partition)
+	}
+	return partitions, nil
+}
+
+// Returns the offset of each partition for the given timestamp, or the earliest/latest offsets when using the special timestamps
+func listOffsets(ctx context.Context, client *kgo.Client, topic string, partitions []int32, timestamp int64) (map[int32]int64, error) {
+	req := kmsg.NewPtrListOffsetsRequest()
+	// only consider committed records so that the range never includes aborted transactions
+	req.IsolationLevel = 1
+	reqTopic := kmsg.NewListOffsetsRequestTopic()
+	reqTopic.Topic = topic
+	for _, partition := range partitions {
+		reqPartition := kmsg.NewListOffsetsRequestTopicPartition()
+		reqPartition.Partition = partition
+		reqPartition.Timestamp = timestamp
+		reqTopic.Partitions = append(reqTopic.Partitions, reqPartition)
+	}
+	req.Topics = append(req.Topics, reqTopic)
+
+	resp, err := req.RequestWith(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list offsets for kafka topic %q: %w", topic, err)
+	}
+	output := map[int32]int64{}
+	for _, respTopic := range resp.Topics {
+		for _, respPartition := range respTopic.Partitions {
+			if err := kerr.ErrorForCode(respPartition.ErrorCode); err != nil {
+				return nil, fmt.Errorf("unable to list offsets for kafka topic %q partition %d: %w", topic, respPartition.Partition, err)
+			}
+			output[respPartition.Partition] = respPartition.Offset
+		}
+	}
+	return output, nil
+}
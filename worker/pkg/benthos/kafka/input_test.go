@@ -0,0 +1,165 @@
+package neosync_benthos_kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func Test_computePartitionRanges(t *testing.T) {
+	partitions := []int32{0, 1, 2}
+	earliest := map[int32]int64{0: 0, 1: 10, 2: 5}
+	latest := map[int32]int64{0: 100, 1: 20, 2: 5}
+
+	t.Run("full topic", func(t *testing.T) {
+		actual := computePartitionRanges(partitions, earliest, latest, &rangeBounds{}, nil, nil)
+		require.Equal(t, map[int32]partitionRange{
+			0: {start: 0, end: 100},
+			1: {start: 10, end: 20},
+		}, actual, "empty partitions must be omitted")
+	})
+
+	t.Run("offsets", func(t *testing.T) {
+		actual := computePartitionRanges(partitions, earliest, latest, &rangeBounds{startOffset: ptr(int64(5)), endOffset: ptr(int64(15))}, nil, nil)
+		require.Equal(t, map[int32]partitionRange{
+			0: {start: 5, end: 15},
+			1: {start: 10, end: 15},
+		}, actual)
+	})
+
+	t.Run("offsets beyond the end of the topic", func(t *testing.T) {
+		actual := computePartitionRanges(partitions, earliest, latest, &rangeBounds{startOffset: ptr(int64(50)), endOffset: ptr(int64(500))}, nil, nil)
+		require.Equal(t, map[int32]partitionRange{
+			0: {start: 50, end: 100},
+		}, actual)
+	})
+
+	t.Run("timestamps", func(t *testing.T) {
+		actual := computePartitionRanges(
+			partitions, earliest, latest,
+			&rangeBounds{startTimestampMs: ptr(int64(1000)), endTimestampMs: ptr(int64(2000))},
+			map[int32]int64{0: 40, 1: -1, 2: -1},
+			map[int32]int64{0: 60, 1: -1, 2: -1},
+		)
+		require.Equal(t, map[int32]partitionRange{
+			0: {start: 40, end: 60},
+		}, actual, "partitions without records after the start timestamp must be omitted")
+	})
+
+	t.Run("start timestamp only", func(t *testing.T) {
+		actual := computePartitionRanges(
+			partitions, earliest, latest,
+			&rangeBounds{startTimestampMs: ptr(int64(1000))},
+			map[int32]int64{0: 40, 1: 15, 2: -1},
+			nil,
+		)
+		require.Equal(t, map[int32]partitionRange{
+			0: {start: 40, end: 100},
+			1: {start: 15, end: 20},
+		}, actual)
+	})
+}
+
+func Test_rangeBoundsFromParsed(t *testing.T) {
+	spec := kafkaInputConfigSpec()
+
+	conf, err := spec.ParseYAML(`
+brokers: ["localhost:9092"]
+topic: users
+start_offset: 5
+end_offset: 10
+`, nil)
+	require.NoError(t, err)
+	bounds, err := rangeBoundsFromParsed(conf)
+	require.NoError(t, err)
+	require.Equal(t, &rangeBounds{startOffset: ptr(int64(5)), endOffset: ptr(int64(10))}, bounds)
+
+	conf, err = spec.ParseYAML(`
+brokers: ["localhost:9092"]
+topic: users
+start_offset: 5
+end_timestamp_ms: 1000
+`, nil)
+	require.NoError(t, err)
+	_, err = rangeBoundsFromParsed(conf)
+	require.Error(t, err)
+}
+
+func Test_newKafkaBatchInput(t *testing.T) {
+	conf, err := kafkaInputConfigSpec().ParseYAML(`
+brokers: ["localhost:9092"]
+topic: users
+sasl:
+  mechanism: SCRAM-SHA-512
+  username: neosync
+  password: hunter2
+format: avro
+avro_schema: '{"type": "record", "name": "user", "fields": [{"name": "name", "type": "string"}]}'
+`, nil)
+	require.NoError(t, err)
+	input, err := newKafkaBatchInput(conf, nil)
+	require.NoError(t, err)
+	require.IsType(t, &avroCodec{}, input.(*kafkaInput).codec)
+	require.Equal(t, 10*time.Second, input.(*kafkaInput).idleTimeout)
+
+	conf, err = kafkaInputConfigSpec().ParseYAML(`
+brokers: ["localhost:9092"]
+topic: users
+format: avro
+`, nil)
+	require.NoError(t, err)
+	_, err = newKafkaBatchInput(conf, nil)
+	require.Error(t, err, "avro requires a schema")
+}
+
+func Test_kafkaInput_ReadBatch_NotConnected(t *testing.T) {
+	input := &kafkaInput{}
+	_, _, err := input.ReadBatch(context.Background())
+	require.Equal(t, service.ErrNotConnected, err)
+}
+
+func Test_kafkaInput_ReadBatch_EndOfInput(t *testing.T) {
+	input := &kafkaInput{remaining: map[int32]partitionRange{}}
+	_, _, err := input.ReadBatch(context.Background())
+	require.Equal(t, service.ErrEndOfInput, err)
+}
+
+func Test_kafkaInput_toMessage(t *testing.T) {
+	input := &kafkaInput{codec: jsonCodec{}}
+	headers := []kgo.RecordHeader{{Key: "trace", Value: []byte("abc")}}
+	msg, err := input.toMessage(&kgo.Record{
+		Key:       []byte("user-1"),
+		Value:     []byte(`{"name": "nick"}`),
+		Headers:   headers,
+		Topic:     "users",
+		Partition: 2,
+		Offset:    42,
+		Timestamp: time.Unix(1700000000, 0),
+	})
+	require.NoError(t, err)
+
+	structured, err := msg.AsStructured()
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"name": "nick"}, structured)
+
+	key, ok := msg.MetaGetMut(MetaKey)
+	require.True(t, ok)
+	require.Equal(t, []byte("user-1"), key)
+	actualHeaders, ok := msg.MetaGetMut(MetaHeaders)
+	require.True(t, ok)
+	require.Equal(t, headers, actualHeaders)
+	offset, ok := msg.MetaGetMut(MetaOffset)
+	require.True(t, ok)
+	require.Equal(t, int64(42), offset)
+
+	_, err = input.toMessage(&kgo.Record{Value: []byte("not json")})
+	require.Error(t, err)
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
@@ -0,0 +1,164 @@
+package neosync_benthos_kafka
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/nucleuscloud/neosync/backend/pkg/kafkaconnect"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+const (
+	koFieldBatching = "batching"
+)
+
+func kafkaOutputConfigSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Categories("Services").
+		Summary("Produces each message to a kafka topic. The record key and headers are carried over from the kafka_key and kafka_headers metadata set by the neosync_kafka input.").
+		Fields(clientConfigFields()...).
+		Field(service.NewStringField(kFieldTopic).
+			Description("The topic to produce to.")).
+		Fields(formatFields()...).
+		Field(service.NewOutputMaxInFlightField()).
+		Field(service.NewBatchPolicyField(koFieldBatching))
+}
+
+func RegisterKafkaOutput(env *service.Environment) error {
+	return env.RegisterBatchOutput(
+		"neosync_kafka", kafkaOutputConfigSpec(),
+		func(conf *service.ParsedConfig, mgr *service.Resources) (out service.BatchOutput, batchPolicy service.BatchPolicy, maxInFlight int, err error) {
+			if maxInFlight, err = conf.FieldMaxInFlight(); err != nil {
+				return
+			}
+			if batchPolicy, err = conf.FieldBatchPolicy(koFieldBatching); err != nil {
+				return
+			}
+			out, err = newKafkaWriter(conf, mgr.Logger())
+			return
+		})
+}
+
+type kafkaWriter struct {
+	clientOpts []kgo.Opt
+	client     *kgo.Client // lazy
+	clientMu   sync.RWMutex
+	logger     *service.Logger
+
+	topic string
+	codec valueCodec
+}
+
+var _ service.BatchOutput = &kafkaWriter{}
+
+func newKafkaWriter(conf *service.ParsedConfig, logger *service.Logger) (*kafkaWriter, error) {
+	clientConfig, err := clientConfigFromParsed(conf)
+	if err != nil {
+		return nil, err
+	}
+	clientOpts, err := kafkaconnect.NewClientOpts(clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	topic, err := conf.FieldString(kFieldTopic)
+	if err != nil {
+		return nil, err
+	}
+	codec, err := valueCodecFromParsed(conf)
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaWriter{
+		clientOpts: clientOpts,
+		logger:     logger,
+		topic:      topic,
+		codec:      codec,
+	}, nil
+}
+
+func (k *kafkaWriter) Connect(ctx context.Context) error {
+	k.clientMu.Lock()
+	defer k.clientMu.Unlock()
+	if k.client != nil {
+		return nil
+	}
+
+	opts := append([]kgo.Opt{}, k.clientOpts...)
+	opts = append(opts, kgo.DefaultProduceTopic(k.topic))
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return fmt.Errorf("unable to create kafka producer: %w", err)
+	}
+	if err := client.Ping(ctx); err != nil {
+		client.Close()
+		return fmt.Errorf("unable to connect to kafka brokers: %w", err)
+	}
+	k.client = client
+	return nil
+}
+
+func (k *kafkaWriter) WriteBatch(ctx context.Context, batch service.MessageBatch) error {
+	k.clientMu.RLock()
+	defer k.clientMu.RUnlock()
+	if k.client == nil {
+		return service.ErrNotConnected
+	}
+
+	records := make([]*kgo.Record, 0, len(batch))
+	for _, msg := range batch {
+		record, err := k.toRecord(msg)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+	return k.client.ProduceSync(ctx, records...).FirstErr()
+}
+
+func (k *kafkaWriter) toRecord(msg *service.Message) (*kgo.Record, error) {
+	record := &kgo.Record{Topic: k.topic}
+
+	structured, err := msg.AsStructured()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get structured message: %w", err)
+	}
+	if structured != nil {
+		value, err := k.codec.Encode(structured)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode message for kafka topic %q: %w", k.topic, err)
+		}
+		record.Value = value
+	}
+
+	if key, ok := msg.MetaGetMut(MetaKey); ok {
+		switch typedKey := key.(type) {
+		case []byte:
+			record.Key = typedKey
+		case string:
+			record.Key = []byte(typedKey)
+		default:
+			return nil, fmt.Errorf("unsupported kafka key type %T", key)
+		}
+	}
+	if headers, ok := msg.MetaGetMut(MetaHeaders); ok {
+		typedHeaders, ok := headers.([]kgo.RecordHeader)
+		if !ok {
+			return nil, fmt.Errorf("unsupported kafka headers type %T", headers)
+		}
+		record.Headers = typedHeaders
+	}
+	return record, nil
+}
+
+func (k *kafkaWriter) Close(ctx context.Context) error {
+	k.clientMu.Lock()
+	defer k.clientMu.Unlock()
+	if k.client == nil {
+		return nil
+	}
+	k.client.Close()
+	k.client = nil
+	return nil
+}
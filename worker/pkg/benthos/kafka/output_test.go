@@ -0,0 +1,59 @@
+package neosync_benthos_kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func Test_kafkaWriter_toRecord(t *testing.T) {
+	writer := &kafkaWriter{topic: "users-anonymized", codec: jsonCodec{}}
+	headers := []kgo.RecordHeader{{Key: "trace", Value: []byte("abc")}}
+
+	msg := service.NewMessage(nil)
+	msg.SetStructuredMut(map[string]any{"name": "alice"})
+	msg.MetaSetMut(MetaKey, []byte("user-1"))
+	msg.MetaSetMut(MetaHeaders, headers)
+
+	record, err := writer.toRecord(msg)
+	require.NoError(t, err)
+	require.Equal(t, "users-anonymized", record.Topic)
+	require.Equal(t, []byte("user-1"), record.Key)
+	require.Equal(t, headers, record.Headers)
+	require.JSONEq(t, `{"name": "alice"}`, string(record.Value))
+}
+
+func Test_kafkaWriter_toRecord_NoKey(t *testing.T) {
+	writer := &kafkaWriter{topic: "users", codec: jsonCodec{}}
+	msg := service.NewMessage(nil)
+	msg.SetStructuredMut(map[string]any{"name": "alice"})
+
+	record, err := writer.toRecord(msg)
+	require.NoError(t, err)
+	require.Nil(t, record.Key)
+	require.Nil(t, record.Headers)
+}
+
+func Test_kafkaWriter_WriteBatch_NotConnected(t *testing.T) {
+	writer := &kafkaWriter{}
+	err := writer.WriteBatch(context.Background(), service.MessageBatch{})
+	require.Equal(t, service.ErrNotConnected, err)
+}
+
+func Test_newKafkaWriter(t *testing.T) {
+	conf, err := kafkaOutputConfigSpec().ParseYAML(`
+brokers: ["localhost:9092"]
+topic: users-anonymized
+tls:
+  enabled: true
+max_in_flight: 4
+`, nil)
+	require.NoError(t, err)
+	writer, err := newKafkaWriter(conf, nil)
+	require.NoError(t, err)
+	require.Equal(t, "users-anonymized", writer.topic)
+	require.IsType(t, jsonCodec{}, writer.codec)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -122,6 +123,10 @@ func newMongoInput(conf *service.ParsedConfig, clientProvider MongoPoolProvider,
 	if err != nil {
 		return nil, err
 	}
+	query, err = toBsonQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert query to bson: %w", err)
+	}
 	if conf.Contains("batch_size") {
 		if batchSize, err = conf.FieldInt("batch_size"); err != nil {
 			return nil, err
@@ -161,6 +166,25 @@ func newMongoInput(conf *service.ParsedConfig, clientProvider MongoPoolProvider,
 	})
 }
 
+// Round trips the query through MongoDB Extended JSON so that values such as {"$oid": "..."} or {"$date": "..."}
+// are sent to MongoDB as their BSON types instead of as plain documents.
+// The query is wrapped in a document as the query of an aggregate operation is an array of stages.
+func toBsonQuery(query any) (any, error) {
+	bits, err := json.Marshal(map[string]any{"query": query})
+	if err != nil {
+		return nil, err
+	}
+	var wrapped bson.D
+	err = bson.UnmarshalExtJSON(bits, false, &wrapped)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) != 1 {
+		return nil, errors.New("expected a single query value")
+	}
+	return wrapped[0].Value, nil
+}
+
 type mongoInput struct {
 	query        any
 	collection   string
@@ -0,0 +1,148 @@
+package profilegenerate
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	transformer_utils "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/pkg/profiler"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func getSpec() *service.ConfigSpec {
+	return service.NewConfigSpec().
+		Summary("Generates rows that follow the distributions of a table profile").
+		Field(service.NewStringField("profile").Description("The base64 encoded json of the table profile")).
+		Field(service.NewIntField("count").Description("The number of rows to generate")).
+		Field(service.NewIntField("batch_size").Default(100)).
+		Field(service.NewIntField("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+}
+
+func RegisterProfileGenerate(env *service.Environment) error {
+	return env.RegisterBatchInput("profile_generate", getSpec(), func(conf *service.ParsedConfig, mgr *service.Resources) (service.BatchInput, error) {
+		rdr, err := newGenerateReader(conf)
+		if err != nil {
+			return nil, err
+		}
+		return service.AutoRetryNacksBatched(rdr), nil
+	})
+}
+
+// Encodes a table profile into the value of the profile field
+func EncodeProfile(profile *profiler.TableProfile) (string, error) {
+	bits, err := json.Marshal(profile)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal table profile: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(bits), nil
+}
+
+func decodeProfile(encoded string) (*profiler.TableProfile, error) {
+	bits, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode table profile: %w", err)
+	}
+	var profile *profiler.TableProfile
+	if err := json.Unmarshal(bits, &profile); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal table profile: %w", err)
+	}
+	return profile, nil
+}
+
+type generateReader struct {
+	// The number of rows to generate. This is progressively decremented as the reads progress
+	count     int
+	batchsize int
+
+	generator *profiler.Generator
+	mu        sync.Mutex
+}
+
+func newGenerateReader(conf *service.ParsedConfig) (*generateReader, error) {
+	encodedProfile, err := conf.FieldString("profile")
+	if err != nil {
+		return nil, err
+	}
+	profile, err := decodeProfile(encodedProfile)
+	if err != nil {
+		return nil, err
+	}
+	count, err := conf.FieldInt("count")
+	if err != nil {
+		return nil, err
+	}
+	batchsize, err := conf.FieldInt("batch_size")
+	if err != nil {
+		return nil, err
+	}
+	if batchsize < 1 {
+		return nil, fmt.Errorf("profile_generate: batch_size must be greater than 0, got %d", batchsize)
+	}
+
+	var seedArg *int64
+	if conf.Contains("seed") {
+		seed, err := conf.FieldInt("seed")
+		if err != nil {
+			return nil, err
+		}
+		seedArg = ptr(int64(seed))
+	}
+	seed, err := transformer_utils.GetSeedOrDefault(seedArg)
+	if err != nil {
+		return nil, err
+	}
+
+	generator, err := profiler.NewGenerator(profile, seed)
+	if err != nil {
+		return nil, err
+	}
+	return &generateReader{
+		count:     count,
+		batchsize: batchsize,
+		generator: generator,
+	}, nil
+}
+
+func (g *generateReader) Connect(ctx context.Context) error {
+	return nil
+}
+
+func (g *generateReader) ReadBatch(ctx context.Context) (service.MessageBatch, service.AckFunc, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.count <= 0 {
+		return nil, nil, service.ErrEndOfInput
+	}
+
+	batchsize := min(g.batchsize, g.count)
+	batch := make(service.MessageBatch, 0, batchsize)
+	for i := 0; i < batchsize; i++ {
+		row, err := g.generator.Next()
+		if err != nil {
+			return nil, nil, fmt.Errorf("profile_generate: unable to generate row from table profile: %w", err)
+		}
+		msg := service.NewMessage(nil)
+		msg.SetStructuredMut(row)
+		batch = append(batch, msg)
+	}
+	g.count -= batchsize
+
+	return batch, emptyAck, nil
+}
+
+func emptyAck(ctx context.Context, err error) error {
+	// Nacks are retried automatically when we use service.AutoRetryNacks
+	return nil
+}
+
+func (g *generateReader) Close(ctx context.Context) error {
+	return nil
+}
+
+func ptr[T any](val T) *T {
+	return &val
+}
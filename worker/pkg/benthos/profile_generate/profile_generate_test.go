@@ -0,0 +1,79 @@
+package profilegenerate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/nucleuscloud/neosync/worker/pkg/profiler"
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/service"
+)
+
+func getTestProfile(t *testing.T) string {
+	t.Helper()
+	rows := []map[string]any{}
+	for i := 0; i < 20; i++ {
+		rows = append(rows, map[string]any{
+			"id":     int64(i + 1),
+			"status": []string{"active", "inactive"}[i%2],
+		})
+	}
+	profile := profiler.BuildTableProfile("public", "users", map[string]*sqlmanager_shared.ColumnInfo{
+		"id":     {OrdinalPosition: 1, DataType: "integer"},
+		"status": {OrdinalPosition: 2, DataType: "text"},
+	}, rows, &profiler.Options{SampleSize: profiler.DefaultSampleSize, HistogramBuckets: profiler.DefaultHistogramBuckets, TopK: profiler.DefaultTopK})
+
+	encoded, err := EncodeProfile(profile)
+	require.NoError(t, err)
+	return encoded
+}
+
+func Test_ProfileGenerate_ReadBatch(t *testing.T) {
+	conf := fmt.Sprintf(`
+profile: %s
+count: 5
+batch_size: 2
+seed: 1
+`, getTestProfile(t))
+	parsedConf, err := getSpec().ParseYAML(conf, service.NewEnvironment())
+	require.NoError(t, err)
+
+	rdr, err := newGenerateReader(parsedConf)
+	require.NoError(t, err)
+	require.NoError(t, rdr.Connect(context.Background()))
+
+	sizes := []int{}
+	ids := []any{}
+	for {
+		batch, _, err := rdr.ReadBatch(context.Background())
+		if err == service.ErrEndOfInput {
+			break
+		}
+		require.NoError(t, err)
+		sizes = append(sizes, len(batch))
+		for _, msg := range batch {
+			structured, err := msg.AsStructured()
+			require.NoError(t, err)
+			row, ok := structured.(map[string]any)
+			require.True(t, ok)
+			require.Contains(t, []any{"active", "inactive"}, row["status"])
+			ids = append(ids, row["id"])
+		}
+	}
+	require.Equal(t, []int{2, 2, 1}, sizes)
+	require.Equal(t, []any{int64(1), int64(2), int64(3), int64(4), int64(5)}, ids)
+	require.NoError(t, rdr.Close(context.Background()))
+}
+
+func Test_ProfileGenerate_InvalidProfile(t *testing.T) {
+	parsedConf, err := getSpec().ParseYAML(`
+profile: not-a-profile
+count: 5
+`, service.NewEnvironment())
+	require.NoError(t, err)
+
+	_, err = newGenerateReader(parsedConf)
+	require.Error(t, err)
+}
@@ -12,6 +12,7 @@ import (
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	myutil "github.com/nucleuscloud/neosync/internal/mysql"
 	pgutil "github.com/nucleuscloud/neosync/internal/postgres"
+	sqliteutil "github.com/nucleuscloud/neosync/internal/sqlite"
 	sqlserverutil "github.com/nucleuscloud/neosync/internal/sqlserver"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
 	"github.com/warpstreamlabs/bento/public/bloblang"
@@ -209,6 +210,8 @@ func sqlRowToMap(rows *sql.Rows, driver string) (map[string]any, error) {
 		return pgutil.SqlRowToPgTypesMap(rows)
 	case sqlmanager_shared.MssqlDriver:
 		return sqlserverutil.SqlRowToSqlServerTypesMap(rows)
+	case sqlmanager_shared.SqliteDriver:
+		return sqliteutil.SqliteSqlRowToMap(rows)
 	default:
 		return myutil.MysqlSqlRowToMap(rows)
 	}
@@ -15,6 +15,7 @@ import (
 	mysql_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/mysql"
 	sqlmanager_postgres "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/postgres"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	sqliteutil "github.com/nucleuscloud/neosync/internal/sqlite"
 	sqlserverutil "github.com/nucleuscloud/neosync/internal/sqlserver"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
 	querybuilder "github.com/nucleuscloud/neosync/worker/pkg/query-builder"
@@ -33,6 +34,11 @@ func sqlInsertOutputSpec() *service.ConfigSpec {
 		Field(service.NewAnyMapField("column_default_properties")).
 		Field(service.NewBloblangField("args_mapping").Optional()).
 		Field(service.NewBoolField("on_conflict_do_nothing").Optional().Default(false)).
+		Field(service.NewStringListField("conflict_columns").Optional()).
+		Field(service.NewObjectField("on_conflict_update",
+			service.NewStringListField("conflict_columns"),
+			service.NewStringListField("update_columns").Optional(),
+		).Optional()).
 		Field(service.NewBoolField("skip_foreign_key_violations").Optional().Default(false)).
 		Field(service.NewBoolField("truncate_on_retry").Optional().Default(false)).
 		Field(service.NewIntField("max_in_flight").Default(64)).
@@ -107,6 +113,8 @@ type pooledInsertOutput struct {
 	columnDataTypes          []string
 	columnDefaultProperties  map[string]*neosync_benthos.ColumnDefaultProperties
 	onConflictDoNothing      bool
+	conflictColumns          []string
+	onConflictUpdate         *querybuilder.OnConflictUpdate
 	skipForeignKeyViolations bool
 	truncateOnRetry          bool
 	prefix                   *string
@@ -176,6 +184,40 @@ func newInsertOutput(conf *service.ParsedConfig, mgr *service.Resources, provide
 		return nil, err
 	}
 
+	var conflictColumns []string
+	if conf.Contains("conflict_columns") {
+		conflictColumns, err = conf.FieldStringList("conflict_columns")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var onConflictUpdate *querybuilder.OnConflictUpdate
+	if conf.Contains("on_conflict_update") {
+		conflictColumns, err := conf.FieldStringList("on_conflict_update", "conflict_columns")
+		if err != nil {
+			return nil, err
+		}
+		var updateColumns []string
+		if conf.Contains("on_conflict_update", "update_columns") {
+			updateColumns, err = conf.FieldStringList("on_conflict_update", "update_columns")
+			if err != nil {
+				return nil, err
+			}
+		}
+		// the parsed config reports the optional object as present even when it is not set
+		if len(conflictColumns) > 0 {
+			onConflictUpdate = &querybuilder.OnConflictUpdate{
+				ConflictColumns: conflictColumns,
+				UpdateColumns:   updateColumns,
+			}
+		}
+	}
+
+	if driver == sqlmanager_shared.MssqlDriver && onConflictDoNothing && onConflictUpdate == nil && len(conflictColumns) == 0 {
+		return nil, fmt.Errorf("on conflict do nothing requires the conflict columns of %s.%s for sqlserver", schema, table)
+	}
+
 	skipForeignKeyViolations, err := conf.FieldBool("skip_foreign_key_violations")
 	if err != nil {
 		return nil, err
@@ -225,6 +267,8 @@ func newInsertOutput(conf *service.ParsedConfig, mgr *service.Resources, provide
 		columnDataTypes:          columnDataTypes,
 		columnDefaultProperties:  columnDefaultProperties,
 		onConflictDoNothing:      onConflictDoNothing,
+		conflictColumns:          conflictColumns,
+		onConflictUpdate:         onConflictUpdate,
 		skipForeignKeyViolations: skipForeignKeyViolations,
 		truncateOnRetry:          truncateOnRetry,
 		prefix:                   prefix,
@@ -249,7 +293,7 @@ func (s *pooledInsertOutput) Connect(ctx context.Context) error {
 	s.db = db
 
 	// truncate table on retry
-	if s.isRetry && s.truncateOnRetry && !s.onConflictDoNothing {
+	if s.isRetry && s.truncateOnRetry && !s.onConflictDoNothing && s.onConflictUpdate == nil {
 		s.logger.Info("retry: truncating table before inserting")
 		query, err := querybuilder.BuildTruncateQuery(s.driver, fmt.Sprintf("%s.%s", s.schema, s.table))
 		if err != nil {
@@ -321,7 +365,7 @@ func (s *pooledInsertOutput) WriteBatch(ctx context.Context, batch service.Messa
 		}
 	}
 
-	insertQuery, args, err := querybuilder.BuildInsertQuery(s.slogger, s.driver, s.schema, s.table, processedCols, s.columnDataTypes, processedRows, &s.onConflictDoNothing, columnDefaults)
+	insertQuery, args, err := s.buildInsertQuery(processedCols, processedRows, columnDefaults)
 	if err != nil {
 		return err
 	}
@@ -329,6 +373,9 @@ func (s *pooledInsertOutput) WriteBatch(ctx context.Context, batch service.Messa
 	if s.driver == sqlmanager_shared.MssqlDriver && len(processedCols) == 0 {
 		insertQuery = sqlserverutil.GeSqlServerDefaultValuesInsertSql(s.schema, s.table, len(rows))
 	}
+	if s.driver == sqlmanager_shared.SqliteDriver && len(processedCols) == 0 {
+		insertQuery = sqliteutil.GetSqliteDefaultValuesInsertSql(s.table, len(rows))
+	}
 
 	if s.driver == sqlmanager_shared.PostgresDriver && shouldOverrideColumnDefault(s.columnDefaultProperties) {
 		insertQuery = sqlmanager_postgres.BuildPgInsertIdentityAlwaysSql(insertQuery)
@@ -350,6 +397,17 @@ func (s *pooledInsertOutput) WriteBatch(ctx context.Context, batch service.Messa
 	return nil
 }
 
+func (s *pooledInsertOutput) buildInsertQuery(
+	columns []string,
+	rows [][]any,
+	columnDefaults []*neosync_benthos.ColumnDefaultProperties,
+) (query string, args []any, err error) {
+	if s.driver == sqlmanager_shared.MssqlDriver && s.onConflictDoNothing && s.onConflictUpdate == nil {
+		return querybuilder.BuildMssqlInsertIgnoreQuery(s.slogger, s.schema, s.table, columns, s.columnDataTypes, rows, s.conflictColumns, columnDefaults)
+	}
+	return querybuilder.BuildInsertQuery(s.slogger, s.driver, s.schema, s.table, columns, s.columnDataTypes, rows, &s.onConflictDoNothing, s.onConflictUpdate, columnDefaults)
+}
+
 func shouldOverrideColumnDefault(columnDefaults map[string]*neosync_benthos.ColumnDefaultProperties) bool {
 	for _, d := range columnDefaults {
 		if !d.HasDefaultTransformer && d.NeedsOverride {
@@ -368,7 +426,7 @@ func (s *pooledInsertOutput) RetryInsertRowByRow(
 	errorCount := 0
 	insertCount := 0
 	for _, row := range rows {
-		insertQuery, args, err := querybuilder.BuildInsertQuery(s.slogger, s.driver, s.schema, s.table, columns, s.columnDataTypes, [][]any{row}, &s.onConflictDoNothing, columnDefaults)
+		insertQuery, args, err := s.buildInsertQuery(columns, [][]any{row}, columnDefaults)
 		if err != nil {
 			return err
 		}
@@ -400,6 +458,14 @@ func (s *pooledInsertOutput) processRows(columnNames []string, dataRows [][]any)
 		}
 		newDataRows := sqlserverutil.GoTypeToSqlServerType(dataRows)
 		return sqlserverutil.FilterOutSqlServerDefaultIdentityColumns(s.driver, defaultIdentityCols, s.columns, newDataRows)
+	case sqlmanager_shared.SqliteDriver:
+		defaultCols := []string{}
+		for cName, d := range s.columnDefaultProperties {
+			if d.HasDefaultTransformer {
+				defaultCols = append(defaultCols, cName)
+			}
+		}
+		return sqliteutil.FilterOutSqliteDefaultColumns(defaultCols, columnNames, dataRows)
 	default:
 		return columnNames, dataRows
 	}
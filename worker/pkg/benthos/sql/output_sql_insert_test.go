@@ -6,6 +6,7 @@ import (
 	"os"
 	"testing"
 
+	querybuilder "github.com/nucleuscloud/neosync/worker/pkg/query-builder"
 	"github.com/stretchr/testify/require"
 	"github.com/warpstreamlabs/bento/public/service"
 )
@@ -29,3 +30,79 @@ args_mapping: 'root = [this.id]'
 	require.NoError(t, err)
 	require.NoError(t, insertOutput.Close(context.Background()))
 }
+
+func Test_SqlInsertOutput_OnConflictUpdate(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	spec := sqlInsertOutputSpec()
+	env := service.NewEnvironment()
+
+	insertConfig, err := spec.ParseYAML(`
+driver: postgres
+dsn: foo
+schema: public
+table: users
+columns: [id, name]
+column_data_types: [int, text]
+column_default_properties: {}
+`, env)
+	require.NoError(t, err)
+	insertOutput, err := newInsertOutput(insertConfig, service.MockResources(), nil, false, logger)
+	require.NoError(t, err)
+	require.Nil(t, insertOutput.onConflictUpdate)
+	require.NoError(t, insertOutput.Close(context.Background()))
+
+	insertConfig, err = spec.ParseYAML(`
+driver: postgres
+dsn: foo
+schema: public
+table: users
+columns: [id, name]
+column_data_types: [int, text]
+column_default_properties: {}
+on_conflict_update:
+  conflict_columns: [id]
+  update_columns: [name]
+`, env)
+	require.NoError(t, err)
+	insertOutput, err = newInsertOutput(insertConfig, service.MockResources(), nil, false, logger)
+	require.NoError(t, err)
+	require.Equal(t, &querybuilder.OnConflictUpdate{ConflictColumns: []string{"id"}, UpdateColumns: []string{"name"}}, insertOutput.onConflictUpdate)
+	require.NoError(t, insertOutput.Close(context.Background()))
+}
+
+func Test_SqlInsertOutput_MssqlOnConflictDoNothing_RequiresConflictColumns(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	spec := sqlInsertOutputSpec()
+	env := service.NewEnvironment()
+
+	insertConfig, err := spec.ParseYAML(`
+driver: sqlserver
+dsn: foo
+schema: dbo
+table: users
+columns: [id]
+column_data_types: [int]
+column_default_properties: {}
+on_conflict_do_nothing: true
+`, env)
+	require.NoError(t, err)
+	_, err = newInsertOutput(insertConfig, service.MockResources(), nil, false, logger)
+	require.Error(t, err)
+
+	insertConfig, err = spec.ParseYAML(`
+driver: sqlserver
+dsn: foo
+schema: dbo
+table: users
+columns: [id]
+column_data_types: [int]
+column_default_properties: {}
+on_conflict_do_nothing: true
+conflict_columns: [id]
+`, env)
+	require.NoError(t, err)
+	insertOutput, err := newInsertOutput(insertConfig, service.MockResources(), nil, false, logger)
+	require.NoError(t, err)
+	require.Equal(t, []string{"id"}, insertOutput.conflictColumns)
+	require.NoError(t, insertOutput.Close(context.Background()))
+}
@@ -0,0 +1,80 @@
+// Code generated by Neosync datagenerator. DO NOT EDIT.
+// source: datasets/fr_FR/first_names.txt
+
+package transformers_dataset
+
+var FrenchFirstNames = []string{
+	"Emma",
+	"Hugo",
+	"Jade",
+	"Léa",
+	"Léo",
+	"Marc",
+	"Zoé",
+	"Alice",
+	"Inès",
+	"Jules",
+	"Louis",
+	"Lucas",
+	"Lucie",
+	"Manon",
+	"Marie",
+	"Théo",
+	"Adrien",
+	"Agathe",
+	"Arthur",
+	"Chloé",
+	"Claire",
+	"Jeanne",
+	"Julien",
+	"Louise",
+	"Maxime",
+	"Pierre",
+	"Romain",
+	"Sophie",
+	"Sylvie",
+	"Thomas",
+	"Victor",
+	"Élise",
+	"Amélie",
+	"Antoine",
+	"Camille",
+	"Céline",
+	"Gabriel",
+	"Margaux",
+	"Nicolas",
+	"Noémie",
+	"Océane",
+	"Olivier",
+	"Pauline",
+	"Vincent",
+	"Émilie",
+	"Clément",
+	"Isabelle",
+	"Mathilde",
+	"Nathalie",
+	"Philippe",
+	"Raphaël",
+	"Valérie",
+	"Étienne",
+	"Charlotte",
+	"Guillaume",
+}
+
+var FrenchFirstNameMap = map[int64][2]int{
+	4: {0, 6},
+	5: {7, 15},
+	6: {16, 31},
+	7: {32, 44},
+	8: {45, 52},
+	9: {53, 54},
+}
+
+var FrenchFirstNameIndices = []int64{
+	4,
+	5,
+	6,
+	7,
+	8,
+	9,
+}
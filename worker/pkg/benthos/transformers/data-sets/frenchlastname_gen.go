@@ -0,0 +1,75 @@
+// Code generated by Neosync datagenerator. DO NOT EDIT.
+// source: datasets/fr_FR/last_names.txt
+
+package transformers_dataset
+
+var FrenchLastNames = []string{
+	"Roux",
+	"Blanc",
+	"David",
+	"Faure",
+	"Henry",
+	"Leroy",
+	"Lopez",
+	"Morel",
+	"Morin",
+	"Petit",
+	"Robin",
+	"Simon",
+	"André",
+	"Bonnet",
+	"Dubois",
+	"Dumont",
+	"Dupont",
+	"Durand",
+	"Garcia",
+	"Girard",
+	"Martin",
+	"Michel",
+	"Moreau",
+	"Muller",
+	"Perrin",
+	"Robert",
+	"Thomas",
+	"Bernard",
+	"Garnier",
+	"Guérin",
+	"Lambert",
+	"Laurent",
+	"Legrand",
+	"Mathieu",
+	"Mercier",
+	"Nicolas",
+	"Richard",
+	"Roussel",
+	"Vincent",
+	"Bertrand",
+	"Clément",
+	"Fontaine",
+	"Fournier",
+	"Gauthier",
+	"Lefebvre",
+	"Lefèvre",
+	"Martinez",
+	"Rousseau",
+	"Chevalier",
+	"François",
+}
+
+var FrenchLastNameMap = map[int64][2]int{
+	4: {0, 0},
+	5: {1, 11},
+	6: {12, 26},
+	7: {27, 38},
+	8: {39, 47},
+	9: {48, 49},
+}
+
+var FrenchLastNameIndices = []int64{
+	4,
+	5,
+	6,
+	7,
+	8,
+	9,
+}
@@ -3,3 +3,11 @@ package transformers_dataset
 //go:generate go run data_generator.go datasets/emaildomains.txt EmailDomain $GOPACKAGE
 //go:generate go run data_generator.go datasets/first_names.txt FirstName $GOPACKAGE
 //go:generate go run data_generator.go datasets/last_names.txt LastName $GOPACKAGE
+//go:generate go run data_generator.go datasets/de_DE/first_names.txt GermanFirstName $GOPACKAGE
+//go:generate go run data_generator.go datasets/de_DE/last_names.txt GermanLastName $GOPACKAGE
+//go:generate go run data_generator.go datasets/fr_FR/first_names.txt FrenchFirstName $GOPACKAGE
+//go:generate go run data_generator.go datasets/fr_FR/last_names.txt FrenchLastName $GOPACKAGE
+//go:generate go run data_generator.go datasets/es_ES/first_names.txt SpanishFirstName $GOPACKAGE
+//go:generate go run data_generator.go datasets/es_ES/last_names.txt SpanishLastName $GOPACKAGE
+//go:generate go run data_generator.go datasets/ja_JP/first_names.txt JapaneseFirstName $GOPACKAGE
+//go:generate go run data_generator.go datasets/ja_JP/last_names.txt JapaneseLastName $GOPACKAGE
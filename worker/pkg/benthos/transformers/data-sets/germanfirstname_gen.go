@@ -0,0 +1,97 @@
+// Code generated by Neosync datagenerator. DO NOT EDIT.
+// source: datasets/de_DE/first_names.txt
+
+package transformers_dataset
+
+var GermanFirstNames = []string{
+	"Ida",
+	"Jan",
+	"Lea",
+	"Mia",
+	"Uwe",
+	"Anja",
+	"Anna",
+	"Emil",
+	"Emma",
+	"Finn",
+	"Hans",
+	"Jana",
+	"Lena",
+	"Leon",
+	"Lina",
+	"Noah",
+	"Paul",
+	"David",
+	"Elias",
+	"Felix",
+	"Frank",
+	"Greta",
+	"Heike",
+	"Helga",
+	"Jonas",
+	"Julia",
+	"Jörg",
+	"Karin",
+	"Klaus",
+	"Luisa",
+	"Lukas",
+	"Maria",
+	"Marie",
+	"Petra",
+	"Birgit",
+	"Daniel",
+	"Dennis",
+	"Dieter",
+	"Emilia",
+	"Hannah",
+	"Markus",
+	"Monika",
+	"Niklas",
+	"Sabine",
+	"Sandra",
+	"Sophie",
+	"Stefan",
+	"Thomas",
+	"Tobias",
+	"Ursula",
+	"Andreas",
+	"Barbara",
+	"Claudia",
+	"Florian",
+	"Johanna",
+	"Jürgen",
+	"Michael",
+	"Susanne",
+	"Benjamin",
+	"Gabriele",
+	"Matthias",
+	"Wolfgang",
+	"Alexander",
+	"Christian",
+	"Christina",
+	"Katharina",
+	"Sebastian",
+	"Maximilian",
+}
+
+var GermanFirstNameMap = map[int64][2]int{
+	3: {0, 4},
+	4: {5, 16},
+	5: {17, 33},
+	6: {34, 49},
+	7: {50, 57},
+	8: {58, 61},
+	9: {62, 66},
+	10: {67, 67},
+}
+
+var GermanFirstNameIndices = []int64{
+	3,
+	4,
+	5,
+	6,
+	7,
+	8,
+	9,
+	10,
+}
@@ -0,0 +1,90 @@
+// Code generated by Neosync datagenerator. DO NOT EDIT.
+// source: datasets/de_DE/last_names.txt
+
+package transformers_dataset
+
+var GermanLastNames = []string{
+	"Beck",
+	"Hahn",
+	"Jung",
+	"Koch",
+	"Lang",
+	"Roth",
+	"Wolf",
+	"Bauer",
+	"Braun",
+	"Frank",
+	"Fuchs",
+	"Huber",
+	"Klein",
+	"Lange",
+	"Maier",
+	"Mayer",
+	"Meier",
+	"Meyer",
+	"Vogel",
+	"Weber",
+	"Weiß",
+	"Becker",
+	"Berger",
+	"Franke",
+	"Kaiser",
+	"Keller",
+	"Krause",
+	"König",
+	"Lorenz",
+	"Peters",
+	"Schmid",
+	"Scholz",
+	"Schulz",
+	"Wagner",
+	"Walter",
+	"Werner",
+	"Baumann",
+	"Fischer",
+	"Hofmann",
+	"Krüger",
+	"Köhler",
+	"Lehmann",
+	"Möller",
+	"Müller",
+	"Neumann",
+	"Richter",
+	"Schmidt",
+	"Schmitt",
+	"Schmitz",
+	"Schulze",
+	"Schwarz",
+	"Winkler",
+	"Albrecht",
+	"Günther",
+	"Hartmann",
+	"Herrmann",
+	"Hoffmann",
+	"Schubert",
+	"Schäfer",
+	"Friedrich",
+	"Schneider",
+	"Schröder",
+	"Zimmermann",
+}
+
+var GermanLastNameMap = map[int64][2]int{
+	4: {0, 6},
+	5: {7, 20},
+	6: {21, 35},
+	7: {36, 51},
+	8: {52, 58},
+	9: {59, 61},
+	10: {62, 62},
+}
+
+var GermanLastNameIndices = []int64{
+	4,
+	5,
+	6,
+	7,
+	8,
+	9,
+	10,
+}
@@ -0,0 +1,59 @@
+// Code generated by Neosync datagenerator. DO NOT EDIT.
+// source: datasets/ja_JP/first_names.txt
+
+package transformers_dataset
+
+var JapaneseFirstNames = []string{
+	"凛",
+	"彩",
+	"恵",
+	"愛",
+	"樹",
+	"浩",
+	"湊",
+	"翔",
+	"葵",
+	"蓮",
+	"誠",
+	"陸",
+	"隼",
+	"七海",
+	"健一",
+	"健太",
+	"優斗",
+	"優花",
+	"大翔",
+	"大輝",
+	"悠人",
+	"悠真",
+	"拓海",
+	"明美",
+	"由美",
+	"直樹",
+	"真央",
+	"結菜",
+	"結衣",
+	"美咲",
+	"美羽",
+	"翔太",
+	"花子",
+	"芽依",
+	"莉子",
+	"裕子",
+	"陽翔",
+	"陽菜",
+	"颯太",
+	"さくら",
+}
+
+var JapaneseFirstNameMap = map[int64][2]int{
+	3: {0, 12},
+	6: {13, 38},
+	9: {39, 39},
+}
+
+var JapaneseFirstNameIndices = []int64{
+	3,
+	6,
+	9,
+}
@@ -0,0 +1,59 @@
+// Code generated by Neosync datagenerator. DO NOT EDIT.
+// source: datasets/ja_JP/last_names.txt
+
+package transformers_dataset
+
+var JapaneseLastNames = []string{
+	"林",
+	"森",
+	"中島",
+	"中村",
+	"井上",
+	"伊藤",
+	"佐藤",
+	"前田",
+	"加藤",
+	"吉田",
+	"坂本",
+	"小川",
+	"小林",
+	"山下",
+	"山口",
+	"山崎",
+	"山本",
+	"山田",
+	"岡田",
+	"後藤",
+	"斎藤",
+	"木村",
+	"村上",
+	"松本",
+	"橋本",
+	"池田",
+	"清水",
+	"渡辺",
+	"田中",
+	"石井",
+	"石川",
+	"藤田",
+	"近藤",
+	"遠藤",
+	"鈴木",
+	"阿部",
+	"青木",
+	"高橋",
+	"佐々木",
+	"長谷川",
+}
+
+var JapaneseLastNameMap = map[int64][2]int{
+	3: {0, 1},
+	6: {2, 37},
+	9: {38, 39},
+}
+
+var JapaneseLastNameIndices = []int64{
+	3,
+	6,
+	9,
+}
@@ -0,0 +1,76 @@
+package transformers_dataset
+
+import "fmt"
+
+var GermanStates = []State{
+	{Code: "BW", FullName: "Baden-Württemberg"},
+	{Code: "BY", FullName: "Bayern"},
+	{Code: "BE", FullName: "Berlin"},
+	{Code: "BB", FullName: "Brandenburg"},
+	{Code: "HB", FullName: "Bremen"},
+	{Code: "HH", FullName: "Hamburg"},
+	{Code: "HE", FullName: "Hessen"},
+	{Code: "MV", FullName: "Mecklenburg-Vorpommern"},
+	{Code: "NI", FullName: "Niedersachsen"},
+	{Code: "NW", FullName: "Nordrhein-Westfalen"},
+	{Code: "RP", FullName: "Rheinland-Pfalz"},
+	{Code: "SL", FullName: "Saarland"},
+	{Code: "SN", FullName: "Sachsen"},
+	{Code: "ST", FullName: "Sachsen-Anhalt"},
+	{Code: "SH", FullName: "Schleswig-Holstein"},
+	{Code: "TH", FullName: "Thüringen"},
+}
+
+var GermanAddresses = []Address{
+	{Address1: "Unter den Linden 77", City: "Berlin", State: "BE", Zipcode: "10117"},
+	{Address1: "Friedrichstraße 43", City: "Berlin", State: "BE", Zipcode: "10969"},
+	{Address1: "Marienplatz 8", City: "München", State: "BY", Zipcode: "80331"},
+	{Address1: "Leopoldstraße 21", City: "München", State: "BY", Zipcode: "80802"},
+	{Address1: "Karolinenstraße 34", City: "Nürnberg", State: "BY", Zipcode: "90402"},
+	{Address1: "Jungfernstieg 7", City: "Hamburg", State: "HH", Zipcode: "20354"},
+	{Address1: "Hohe Straße 52", City: "Köln", State: "NW", Zipcode: "50667"},
+	{Address1: "Königsallee 60", City: "Düsseldorf", State: "NW", Zipcode: "40212"},
+	{Address1: "Kettwiger Straße 20", City: "Essen", State: "NW", Zipcode: "45127"},
+	{Address1: "Westenhellweg 39", City: "Dortmund", State: "NW", Zipcode: "44137"},
+	{Address1: "Remigiusstraße 11", City: "Bonn", State: "NW", Zipcode: "53111"},
+	{Address1: "Zeil 106", City: "Frankfurt am Main", State: "HE", Zipcode: "60313"},
+	{Address1: "Königstraße 28", City: "Stuttgart", State: "BW", Zipcode: "70173"},
+	{Address1: "Hauptstraße 120", City: "Heidelberg", State: "BW", Zipcode: "69117"},
+	{Address1: "Kaiser-Joseph-Straße 193", City: "Freiburg im Breisgau", State: "BW", Zipcode: "79098"},
+	{Address1: "Grimmaische Straße 2", City: "Leipzig", State: "SN", Zipcode: "04109"},
+	{Address1: "Prager Straße 12", City: "Dresden", State: "SN", Zipcode: "01069"},
+	{Address1: "Georgstraße 14", City: "Hannover", State: "NI", Zipcode: "30159"},
+	{Address1: "Obernstraße 45", City: "Bremen", State: "HB", Zipcode: "28195"},
+	{Address1: "Holstenstraße 30", City: "Kiel", State: "SH", Zipcode: "24103"},
+	{Address1: "Anger 25", City: "Erfurt", State: "TH", Zipcode: "99084"},
+	{Address1: "Ludwigstraße 8", City: "Mainz", State: "RP", Zipcode: "55116"},
+	{Address1: "Bahnhofstraße 31", City: "Saarbrücken", State: "SL", Zipcode: "66111"},
+	{Address1: "Breiter Weg 178", City: "Magdeburg", State: "ST", Zipcode: "39104"},
+	{Address1: "Brandenburger Straße 40", City: "Potsdam", State: "BB", Zipcode: "14467"},
+	{Address1: "Kröpeliner Straße 26", City: "Rostock", State: "MV", Zipcode: "18055"},
+}
+
+var DeDEDataset = &LocaleDataset{
+	Locale:     Locale_DeDE,
+	FirstNames: &Corpus{Values: GermanFirstNames, LengthMap: GermanFirstNameMap, Indices: GermanFirstNameIndices},
+	LastNames:  &Corpus{Values: GermanLastNames, LengthMap: GermanLastNameMap, Indices: GermanLastNameIndices},
+	Addresses:  GermanAddresses,
+	States:     GermanStates,
+	FormatAddress: func(address Address) string {
+		return fmt.Sprintf(`%s, %s %s`, address.Address1, address.Zipcode, address.City)
+	},
+	Phone: &PhoneNumberFormat{
+		CountryCode: "49",
+		TrunkPrefix: "0",
+		// the largest cities followed by mobile networks
+		AreaCodes: []string{
+			"30", "40", "69", "89", "201", "211", "221", "228", "231", "341", "351", "361", "421", "431", "511", "711", "761", "911",
+			"151", "152", "157", "160", "162", "163", "170", "171", "172", "173", "174", "175", "176", "177", "178", "179",
+		},
+		NationalNumberLength: 10,
+	},
+}
+
+func init() {
+	RegisterLocaleDataset(DeDEDataset)
+}
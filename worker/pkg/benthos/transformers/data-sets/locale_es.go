@@ -0,0 +1,70 @@
+package transformers_dataset
+
+import "fmt"
+
+var SpanishStates = []State{
+	{Code: "AN", FullName: "Andalucía"},
+	{Code: "AR", FullName: "Aragón"},
+	{Code: "AS", FullName: "Principado de Asturias"},
+	{Code: "IB", FullName: "Illes Balears"},
+	{Code: "CN", FullName: "Canarias"},
+	{Code: "CB", FullName: "Cantabria"},
+	{Code: "CL", FullName: "Castilla y León"},
+	{Code: "CM", FullName: "Castilla-La Mancha"},
+	{Code: "CT", FullName: "Cataluña"},
+	{Code: "EX", FullName: "Extremadura"},
+	{Code: "GA", FullName: "Galicia"},
+	{Code: "RI", FullName: "La Rioja"},
+	{Code: "MD", FullName: "Comunidad de Madrid"},
+	{Code: "MC", FullName: "Región de Murcia"},
+	{Code: "NC", FullName: "Comunidad Foral de Navarra"},
+	{Code: "PV", FullName: "País Vasco"},
+	{Code: "VC", FullName: "Comunitat Valenciana"},
+}
+
+var SpanishAddresses = []Address{
+	{Address1: "Calle Gran Vía 32", City: "Madrid", State: "MD", Zipcode: "28013"},
+	{Address1: "Calle de Alcalá 45", City: "Madrid", State: "MD", Zipcode: "28014"},
+	{Address1: "Passeig de Gràcia 92", City: "Barcelona", State: "CT", Zipcode: "08008"},
+	{Address1: "La Rambla 115", City: "Barcelona", State: "CT", Zipcode: "08002"},
+	{Address1: "Calle de Colón 14", City: "Valencia", State: "VC", Zipcode: "46004"},
+	{Address1: "Rambla de Méndez Núñez 20", City: "Alicante", State: "VC", Zipcode: "03002"},
+	{Address1: "Calle Sierpes 48", City: "Sevilla", State: "AN", Zipcode: "41004"},
+	{Address1: "Calle Marqués de Larios 7", City: "Málaga", State: "AN", Zipcode: "29005"},
+	{Address1: "Calle Cruz Conde 12", City: "Córdoba", State: "AN", Zipcode: "14001"},
+	{Address1: "Paseo de la Independencia 21", City: "Zaragoza", State: "AR", Zipcode: "50001"},
+	{Address1: "Calle Trapería 10", City: "Murcia", State: "MC", Zipcode: "30001"},
+	{Address1: "Passeig del Born 15", City: "Palma", State: "IB", Zipcode: "07012"},
+	{Address1: "Calle Triana 60", City: "Las Palmas de Gran Canaria", State: "CN", Zipcode: "35002"},
+	{Address1: "Gran Vía de Don Diego López de Haro 12", City: "Bilbao", State: "PV", Zipcode: "48001"},
+	{Address1: "Calle Santiago 18", City: "Valladolid", State: "CL", Zipcode: "47001"},
+	{Address1: "Rúa do Príncipe 25", City: "Vigo", State: "GA", Zipcode: "36202"},
+	{Address1: "Calle Corrida 30", City: "Gijón", State: "AS", Zipcode: "33206"},
+	{Address1: "Calle Uría 16", City: "Oviedo", State: "AS", Zipcode: "33003"},
+	{Address1: "Paseo de Pereda 22", City: "Santander", State: "CB", Zipcode: "39004"},
+	{Address1: "Calle Estafeta 40", City: "Pamplona", State: "NC", Zipcode: "31001"},
+	{Address1: "Calle Portales 25", City: "Logroño", State: "RI", Zipcode: "26001"},
+	{Address1: "Calle Comercio 33", City: "Toledo", State: "CM", Zipcode: "45001"},
+	{Address1: "Calle Menacho 20", City: "Badajoz", State: "EX", Zipcode: "06001"},
+}
+
+var EsESDataset = &LocaleDataset{
+	Locale:     Locale_EsES,
+	FirstNames: &Corpus{Values: SpanishFirstNames, LengthMap: SpanishFirstNameMap, Indices: SpanishFirstNameIndices},
+	LastNames:  &Corpus{Values: SpanishLastNames, LengthMap: SpanishLastNameMap, Indices: SpanishLastNameIndices},
+	Addresses:  SpanishAddresses,
+	States:     SpanishStates,
+	FormatAddress: func(address Address) string {
+		return fmt.Sprintf(`%s, %s %s`, address.Address1, address.Zipcode, address.City)
+	},
+	Phone: &PhoneNumberFormat{
+		CountryCode: "34",
+		// the largest provinces followed by mobile networks
+		AreaCodes:            []string{"91", "93", "94", "95", "96", "98", "6", "7"},
+		NationalNumberLength: 9,
+	},
+}
+
+func init() {
+	RegisterLocaleDataset(EsESDataset)
+}
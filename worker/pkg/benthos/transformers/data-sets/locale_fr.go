@@ -0,0 +1,66 @@
+package transformers_dataset
+
+import "fmt"
+
+var FrenchStates = []State{
+	{Code: "ARA", FullName: "Auvergne-Rhône-Alpes"},
+	{Code: "BFC", FullName: "Bourgogne-Franche-Comté"},
+	{Code: "BRE", FullName: "Bretagne"},
+	{Code: "CVL", FullName: "Centre-Val de Loire"},
+	{Code: "COR", FullName: "Corse"},
+	{Code: "GES", FullName: "Grand Est"},
+	{Code: "HDF", FullName: "Hauts-de-France"},
+	{Code: "IDF", FullName: "Île-de-France"},
+	{Code: "NOR", FullName: "Normandie"},
+	{Code: "NAQ", FullName: "Nouvelle-Aquitaine"},
+	{Code: "OCC", FullName: "Occitanie"},
+	{Code: "PDL", FullName: "Pays de la Loire"},
+	{Code: "PAC", FullName: "Provence-Alpes-Côte d'Azur"},
+}
+
+var FrenchAddresses = []Address{
+	{Address1: "12 Rue de Rivoli", City: "Paris", State: "IDF", Zipcode: "75004"},
+	{Address1: "45 Avenue des Champs-Élysées", City: "Paris", State: "IDF", Zipcode: "75008"},
+	{Address1: "8 Boulevard Saint-Michel", City: "Paris", State: "IDF", Zipcode: "75006"},
+	{Address1: "27 La Canebière", City: "Marseille", State: "PAC", Zipcode: "13001"},
+	{Address1: "56 Promenade des Anglais", City: "Nice", State: "PAC", Zipcode: "06000"},
+	{Address1: "14 Rue de la République", City: "Lyon", State: "ARA", Zipcode: "69002"},
+	{Address1: "11 Place Grenette", City: "Grenoble", State: "ARA", Zipcode: "38000"},
+	{Address1: "3 Place du Capitole", City: "Toulouse", State: "OCC", Zipcode: "31000"},
+	{Address1: "5 Place de la Comédie", City: "Montpellier", State: "OCC", Zipcode: "34000"},
+	{Address1: "9 Rue Crébillon", City: "Nantes", State: "PDL", Zipcode: "44000"},
+	{Address1: "6 Rue Lenepveu", City: "Angers", State: "PDL", Zipcode: "49100"},
+	{Address1: "21 Rue du Dôme", City: "Strasbourg", State: "GES", Zipcode: "67000"},
+	{Address1: "2 Place Drouet d'Erlon", City: "Reims", State: "GES", Zipcode: "51100"},
+	{Address1: "40 Cours de l'Intendance", City: "Bordeaux", State: "NAQ", Zipcode: "33000"},
+	{Address1: "18 Rue Esquermoise", City: "Lille", State: "HDF", Zipcode: "59800"},
+	{Address1: "7 Rue de la Monnaie", City: "Rennes", State: "BRE", Zipcode: "35000"},
+	{Address1: "19 Rue de Siam", City: "Brest", State: "BRE", Zipcode: "29200"},
+	{Address1: "23 Rue de la Liberté", City: "Dijon", State: "BFC", Zipcode: "21000"},
+	{Address1: "10 Rue Nationale", City: "Tours", State: "CVL", Zipcode: "37000"},
+	{Address1: "15 Rue Jeanne d'Arc", City: "Orléans", State: "CVL", Zipcode: "45000"},
+	{Address1: "31 Rue du Gros-Horloge", City: "Rouen", State: "NOR", Zipcode: "76000"},
+	{Address1: "4 Cours Napoléon", City: "Ajaccio", State: "COR", Zipcode: "20000"},
+}
+
+var FrFRDataset = &LocaleDataset{
+	Locale:     Locale_FrFR,
+	FirstNames: &Corpus{Values: FrenchFirstNames, LengthMap: FrenchFirstNameMap, Indices: FrenchFirstNameIndices},
+	LastNames:  &Corpus{Values: FrenchLastNames, LengthMap: FrenchLastNameMap, Indices: FrenchLastNameIndices},
+	Addresses:  FrenchAddresses,
+	States:     FrenchStates,
+	FormatAddress: func(address Address) string {
+		return fmt.Sprintf(`%s, %s %s`, address.Address1, address.Zipcode, address.City)
+	},
+	Phone: &PhoneNumberFormat{
+		CountryCode: "33",
+		TrunkPrefix: "0",
+		// 1-5 are the geographic zones, 6 and 7 are mobile networks
+		AreaCodes:            []string{"1", "2", "3", "4", "5", "6", "7"},
+		NationalNumberLength: 9,
+	},
+}
+
+func init() {
+	RegisterLocaleDataset(FrFRDataset)
+}
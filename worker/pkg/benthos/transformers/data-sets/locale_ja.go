@@ -0,0 +1,107 @@
+package transformers_dataset
+
+import "fmt"
+
+// Prefectures keyed by their JIS X 0401 code
+var JapaneseStates = []State{
+	{Code: "01", FullName: "北海道"},
+	{Code: "02", FullName: "青森県"},
+	{Code: "03", FullName: "岩手県"},
+	{Code: "04", FullName: "宮城県"},
+	{Code: "05", FullName: "秋田県"},
+	{Code: "06", FullName: "山形県"},
+	{Code: "07", FullName: "福島県"},
+	{Code: "08", FullName: "茨城県"},
+	{Code: "09", FullName: "栃木県"},
+	{Code: "10", FullName: "群馬県"},
+	{Code: "11", FullName: "埼玉県"},
+	{Code: "12", FullName: "千葉県"},
+	{Code: "13", FullName: "東京都"},
+	{Code: "14", FullName: "神奈川県"},
+	{Code: "15", FullName: "新潟県"},
+	{Code: "16", FullName: "富山県"},
+	{Code: "17", FullName: "石川県"},
+	{Code: "18", FullName: "福井県"},
+	{Code: "19", FullName: "山梨県"},
+	{Code: "20", FullName: "長野県"},
+	{Code: "21", FullName: "岐阜県"},
+	{Code: "22", FullName: "静岡県"},
+	{Code: "23", FullName: "愛知県"},
+	{Code: "24", FullName: "三重県"},
+	{Code: "25", FullName: "滋賀県"},
+	{Code: "26", FullName: "京都府"},
+	{Code: "27", FullName: "大阪府"},
+	{Code: "28", FullName: "兵庫県"},
+	{Code: "29", FullName: "奈良県"},
+	{Code: "30", FullName: "和歌山県"},
+	{Code: "31", FullName: "鳥取県"},
+	{Code: "32", FullName: "島根県"},
+	{Code: "33", FullName: "岡山県"},
+	{Code: "34", FullName: "広島県"},
+	{Code: "35", FullName: "山口県"},
+	{Code: "36", FullName: "徳島県"},
+	{Code: "37", FullName: "香川県"},
+	{Code: "38", FullName: "愛媛県"},
+	{Code: "39", FullName: "高知県"},
+	{Code: "40", FullName: "福岡県"},
+	{Code: "41", FullName: "佐賀県"},
+	{Code: "42", FullName: "長崎県"},
+	{Code: "43", FullName: "熊本県"},
+	{Code: "44", FullName: "大分県"},
+	{Code: "45", FullName: "宮崎県"},
+	{Code: "46", FullName: "鹿児島県"},
+	{Code: "47", FullName: "沖縄県"},
+}
+
+var JapaneseAddresses = []Address{
+	{Address1: "丸の内1-9-1", City: "千代田区", State: "13", Zipcode: "100-0005"},
+	{Address1: "銀座4-6-16", City: "中央区", State: "13", Zipcode: "104-0061"},
+	{Address1: "西新宿2-8-1", City: "新宿区", State: "13", Zipcode: "163-8001"},
+	{Address1: "道玄坂2-24-1", City: "渋谷区", State: "13", Zipcode: "150-0043"},
+	{Address1: "梅田3-1-1", City: "大阪市北区", State: "27", Zipcode: "530-0001"},
+	{Address1: "難波5-1-60", City: "大阪市中央区", State: "27", Zipcode: "542-0076"},
+	{Address1: "東塩小路町721-1", City: "京都市下京区", State: "26", Zipcode: "600-8216"},
+	{Address1: "名駅1-1-4", City: "名古屋市中村区", State: "23", Zipcode: "450-0002"},
+	{Address1: "北5条西2-5", City: "札幌市中央区", State: "01", Zipcode: "060-0005"},
+	{Address1: "博多駅中央街1-1", City: "福岡市博多区", State: "40", Zipcode: "812-0012"},
+	{Address1: "高島2-16-1", City: "横浜市西区", State: "14", Zipcode: "220-0011"},
+	{Address1: "三宮町1-6-1", City: "神戸市中央区", State: "28", Zipcode: "650-0021"},
+	{Address1: "中央1-1-1", City: "仙台市青葉区", State: "04", Zipcode: "980-0021"},
+	{Address1: "紙屋町2-2-2", City: "広島市中区", State: "34", Zipcode: "730-0031"},
+	{Address1: "久茂地3-1-1", City: "那覇市", State: "47", Zipcode: "900-0015"},
+	{Address1: "錦町630", City: "さいたま市大宮区", State: "11", Zipcode: "330-0853"},
+	{Address1: "富士見2-3-1", City: "千葉市中央区", State: "12", Zipcode: "260-0015"},
+}
+
+var JaJPDataset = &LocaleDataset{
+	Locale:        Locale_JaJP,
+	FirstNames:    &Corpus{Values: JapaneseFirstNames, LengthMap: JapaneseFirstNameMap, Indices: JapaneseFirstNameIndices},
+	LastNames:     &Corpus{Values: JapaneseLastNames, LengthMap: JapaneseLastNameMap, Indices: JapaneseLastNameIndices},
+	LastNameFirst: true,
+	Addresses:     JapaneseAddresses,
+	States:        JapaneseStates,
+	FormatAddress: formatJapaneseAddress,
+	Phone: &PhoneNumberFormat{
+		CountryCode: "81",
+		TrunkPrefix: "0",
+		// Tokyo, Osaka, Sapporo, Sendai, Yokohama, Nagoya, Kyoto, Kobe, Hiroshima and Fukuoka
+		AreaCodes:            []string{"3", "6", "11", "22", "45", "52", "75", "78", "82", "92"},
+		NationalNumberLength: 9,
+	},
+}
+
+func init() {
+	RegisterLocaleDataset(JaJPDataset)
+}
+
+// Japanese addresses are written from the largest to the smallest area, starting with the postal code
+func formatJapaneseAddress(address Address) string {
+	prefecture := address.State
+	for _, state := range JapaneseStates {
+		if state.Code == address.State {
+			prefecture = state.FullName
+			break
+		}
+	}
+	return fmt.Sprintf(`〒%s %s%s%s`, address.Zipcode, prefecture, address.City, address.Address1)
+}
@@ -0,0 +1,114 @@
+package transformers_dataset
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// A locale identifies the dataset that names, addresses and phone numbers are generated from
+type Locale string
+
+const (
+	Locale_EnUS Locale = "en_US"
+	Locale_DeDE Locale = "de_DE"
+	Locale_FrFR Locale = "fr_FR"
+	Locale_EsES Locale = "es_ES"
+	Locale_JaJP Locale = "ja_JP"
+
+	DefaultLocale = Locale_EnUS
+)
+
+// A corpus of strings that are sorted by length, then alphabetically.
+// See data_generator.go for how the length map and indices are computed.
+type Corpus struct {
+	Values    []string
+	LengthMap map[int64][2]int
+	Indices   []int64
+}
+
+type PhoneNumberFormat struct {
+	// The country calling code without the leading +
+	CountryCode string
+	// The prefix that is dialed before the area code of a national number
+	TrunkPrefix string
+	// The area codes that numbers are generated in, without the trunk prefix
+	AreaCodes []string
+	// The number of digits of the area code and subscriber number combined
+	NationalNumberLength int
+}
+
+// The values that are used to generate names, addresses and phone numbers for a locale
+type LocaleDataset struct {
+	Locale     Locale
+	FirstNames *Corpus
+	LastNames  *Corpus
+	// Whether the last name is written before the first name in a full name
+	LastNameFirst bool
+	// Real addresses, so that the city, state and zipcode of an address belong together.
+	// The state of every address must be the code of one of the States.
+	Addresses []Address
+	// The states, regions or prefectures of the locale
+	States []State
+	// Formats an address the way it is written in the locale
+	FormatAddress func(address Address) string
+	Phone         *PhoneNumberFormat
+}
+
+var localeDatasets = map[Locale]*LocaleDataset{}
+
+// Registers the dataset of a locale, replacing any dataset that was already registered for it.
+// This is not safe for concurrent use and should be called from an init function.
+func RegisterLocaleDataset(dataset *LocaleDataset) {
+	localeDatasets[dataset.Locale] = dataset
+}
+
+// Returns the dataset of the locale. An empty locale returns the dataset of the default locale.
+func GetLocaleDataset(locale Locale) (*LocaleDataset, error) {
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	dataset, ok := localeDatasets[locale]
+	if !ok {
+		return nil, fmt.Errorf("unsupported locale %q, must be one of %v", locale, GetLocales())
+	}
+	return dataset, nil
+}
+
+// Returns the sorted locales that have a registered dataset
+func GetLocales() []Locale {
+	locales := make([]Locale, 0, len(localeDatasets))
+	for locale := range localeDatasets {
+		locales = append(locales, locale)
+	}
+	slices.Sort(locales)
+	return locales
+}
+
+var EnUSDataset = &LocaleDataset{
+	Locale:     Locale_EnUS,
+	FirstNames: &Corpus{Values: FirstNames, LengthMap: FirstNameMap, Indices: FirstNameIndices},
+	LastNames:  &Corpus{Values: LastNames, LengthMap: LastNameMap, Indices: LastNameIndices},
+	Addresses:  Addresses,
+	States:     States,
+	FormatAddress: func(address Address) string {
+		return fmt.Sprintf(`%s %s %s, %s`, address.Address1, address.City, address.State, address.Zipcode)
+	},
+	Phone: &PhoneNumberFormat{
+		CountryCode:          "1",
+		AreaCodes:            toStrings(AreaCodes),
+		NationalNumberLength: 10,
+	},
+}
+
+func init() {
+	RegisterLocaleDataset(EnUSDataset)
+}
+
+func toStrings(values []int64) []string {
+	output := make([]string, 0, len(values))
+	for _, value := range values {
+		output = append(output, strconv.FormatInt(value, 10))
+	}
+	return output
+}
@@ -0,0 +1,70 @@
+package transformers_dataset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetLocaleDataset(t *testing.T) {
+	dataset, err := GetLocaleDataset("")
+	require.NoError(t, err)
+	assert.Equal(t, EnUSDataset, dataset)
+
+	_, err = GetLocaleDataset("xx_XX")
+	assert.Error(t, err)
+}
+
+func Test_GetLocales(t *testing.T) {
+	assert.Equal(t, []Locale{Locale_DeDE, Locale_EnUS, Locale_EsES, Locale_FrFR, Locale_JaJP}, GetLocales())
+}
+
+func Test_LocaleDatasets(t *testing.T) {
+	for _, locale := range GetLocales() {
+		t.Run(string(locale), func(t *testing.T) {
+			dataset, err := GetLocaleDataset(locale)
+			require.NoError(t, err)
+			assert.Equal(t, locale, dataset.Locale)
+
+			assert.NotEmpty(t, dataset.FirstNames.Values)
+			assert.Len(t, dataset.FirstNames.Indices, len(dataset.FirstNames.LengthMap))
+			assert.NotEmpty(t, dataset.LastNames.Values)
+			assert.Len(t, dataset.LastNames.Indices, len(dataset.LastNames.LengthMap))
+
+			assert.NotEmpty(t, dataset.Addresses)
+			assert.NotEmpty(t, dataset.States)
+			require.NotNil(t, dataset.FormatAddress)
+
+			require.NotNil(t, dataset.Phone)
+			assert.NotEmpty(t, dataset.Phone.CountryCode)
+			assert.NotEmpty(t, dataset.Phone.AreaCodes)
+			for _, areaCode := range dataset.Phone.AreaCodes {
+				assert.Less(t, len(areaCode), dataset.Phone.NationalNumberLength)
+			}
+		})
+	}
+}
+
+func Test_LocaleDatasets_AddressStates(t *testing.T) {
+	for _, locale := range GetLocales() {
+		// the us addresses include DC, which is not one of the states
+		if locale == Locale_EnUS {
+			continue
+		}
+		t.Run(string(locale), func(t *testing.T) {
+			dataset, err := GetLocaleDataset(locale)
+			require.NoError(t, err)
+
+			states := map[string]struct{}{}
+			for _, state := range dataset.States {
+				states[state.Code] = struct{}{}
+			}
+			for _, address := range dataset.Addresses {
+				assert.Contains(t, states, address.State, "the state of %s, %s must be one of the states of the locale", address.Address1, address.City)
+				assert.NotEmpty(t, address.Zipcode)
+				assert.NotEmpty(t, address.City)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Code generated by Neosync datagenerator. DO NOT EDIT.
+// source: datasets/es_ES/first_names.txt
+
+package transformers_dataset
+
+var SpanishFirstNames = []string{
+	"Ana",
+	"Alba",
+	"Hugo",
+	"Juan",
+	"Luis",
+	"Rosa",
+	"Sara",
+	"David",
+	"Diego",
+	"Elena",
+	"Jorge",
+	"José",
+	"Laura",
+	"Marta",
+	"Pablo",
+	"Paula",
+	"Pedro",
+	"Pilar",
+	"Andrea",
+	"Carlos",
+	"Carmen",
+	"Daniel",
+	"Isabel",
+	"Javier",
+	"Jesús",
+	"Lucía",
+	"Manuel",
+	"María",
+	"Miguel",
+	"Rafael",
+	"Raquel",
+	"Rocío",
+	"Sergio",
+	"Sofía",
+	"Teresa",
+	"Adrián",
+	"Antonio",
+	"Claudia",
+	"Enrique",
+	"Martina",
+	"Natalia",
+	"Valeria",
+	"Víctor",
+	"Álvaro",
+	"Cristina",
+	"Fernando",
+	"Alejandro",
+	"Francisco",
+}
+
+var SpanishFirstNameMap = map[int64][2]int{
+	3: {0, 0},
+	4: {1, 6},
+	5: {7, 17},
+	6: {18, 34},
+	7: {35, 43},
+	8: {44, 45},
+	9: {46, 47},
+}
+
+var SpanishFirstNameIndices = []int64{
+	3,
+	4,
+	5,
+	6,
+	7,
+	8,
+	9,
+}
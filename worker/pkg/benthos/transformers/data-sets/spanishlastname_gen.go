@@ -0,0 +1,77 @@
+// Code generated by Neosync datagenerator. DO NOT EDIT.
+// source: datasets/es_ES/last_names.txt
+
+package transformers_dataset
+
+var SpanishLastNames = []string{
+	"Gil",
+	"Ruiz",
+	"Sanz",
+	"Díaz",
+	"Ortiz",
+	"Ramos",
+	"Rubio",
+	"Alonso",
+	"Blanco",
+	"Castro",
+	"Gómez",
+	"Lozano",
+	"López",
+	"Marín",
+	"Medina",
+	"Molina",
+	"Moreno",
+	"Muñoz",
+	"Ortega",
+	"Pérez",
+	"Romero",
+	"Santos",
+	"Torres",
+	"Cortés",
+	"Delgado",
+	"García",
+	"Garrido",
+	"Martín",
+	"Morales",
+	"Navarro",
+	"Núñez",
+	"Serrano",
+	"Suárez",
+	"Castillo",
+	"Guerrero",
+	"Iglesias",
+	"Jiménez",
+	"Ramírez",
+	"Sánchez",
+	"Vázquez",
+	"Álvarez",
+	"González",
+	"Martínez",
+	"Domínguez",
+	"Fernández",
+	"Gutiérrez",
+	"Hernández",
+	"Rodríguez",
+}
+
+var SpanishLastNameMap = map[int64][2]int{
+	3: {0, 0},
+	4: {1, 2},
+	5: {3, 6},
+	6: {7, 22},
+	7: {23, 32},
+	8: {33, 40},
+	9: {41, 42},
+	10: {43, 47},
+}
+
+var SpanishLastNameIndices = []int64{
+	3,
+	4,
+	5,
+	6,
+	7,
+	8,
+	9,
+	10,
+}
@@ -0,0 +1,119 @@
+package transformers
+
+import (
+	"errors"
+	"fmt"
+
+	transformer_utils "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/pkg/rng"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+)
+
+// A bloblang function constructor that is handed the account-scoped transformer key.
+// The key is nil unless the function has been registered onto an environment with RegisterAccountKeyedFunctions.
+type accountKeyedFunctionConstructor func(args *bloblang.ParsedParams, accountKey *string) (bloblang.Function, error)
+
+type accountKeyedFunction struct {
+	spec *bloblang.PluginSpec
+	ctor accountKeyedFunctionConstructor
+}
+
+var accountKeyedFunctions = map[string]*accountKeyedFunction{}
+
+// Registers a bloblang function that depends on the account-scoped transformer key.
+// The global registration is never given a key, it is only bound to a key by RegisterAccountKeyedFunctions.
+func registerAccountKeyedFunction(name string, spec *bloblang.PluginSpec, ctor accountKeyedFunctionConstructor) error {
+	accountKeyedFunctions[name] = &accountKeyedFunction{spec: spec, ctor: ctor}
+	return bloblang.RegisterFunctionV2(name, spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+		return ctor(args, nil)
+	})
+}
+
+// Registers the transformers that depend on the account-scoped transformer key onto the environment, bound to the given key.
+// The key is handed to the transformers directly and is never exposed to bloblang or benthos config interpolation,
+// so it can not be read back out by user provided mappings.
+func RegisterAccountKeyedFunctions(env *bloblang.Environment, accountKey string) error {
+	for name, fn := range accountKeyedFunctions {
+		fn := fn
+		err := env.RegisterFunctionV2(name, fn.spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+			return fn.ctor(args, &accountKey)
+		})
+		if err != nil {
+			return fmt.Errorf("unable to register %s with account key: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Returns the seed for a bloblang transformer.
+// If deterministic mode was requested, the seed is derived from the input value using the account key, otherwise the seed arg or a random seed is used.
+func getTransformerSeed(args *bloblang.ParsedParams, seedArg *int64, accountKey *string) (int64, error) {
+	deterministic, err := args.GetOptionalBool("deterministic")
+	if err != nil {
+		return 0, err
+	}
+	if deterministic == nil || !*deterministic {
+		return transformer_utils.GetSeedOrDefault(seedArg)
+	}
+	if accountKey == nil || *accountKey == "" {
+		return 0, errors.New("deterministic mode was requested but no account transformer key is available, ensure the transformer secret has been configured")
+	}
+	value, err := args.Get("value")
+	if err != nil {
+		return 0, err
+	}
+	return transformer_utils.GetDeterministicSeed(*accountKey, value)
+}
+
+// Returns a shallow copy of the transformer opts with the randomizer replaced.
+// Returns false if the opts are not for a transformer that supports deterministic mode.
+func withRandomizer(opts any, randomizer rng.Rand) (any, bool) {
+	switch o := opts.(type) {
+	case *TransformCharacterScrambleOpts:
+		c := *o
+		c.randomizer = randomizer
+		return &c, true
+	case *TransformE164PhoneNumberOpts:
+		c := *o
+		c.randomizer = randomizer
+		return &c, true
+	case *TransformEmailOpts:
+		c := *o
+		c.randomizer = randomizer
+		return &c, true
+	case *TransformFirstNameOpts:
+		c := *o
+		c.randomizer = randomizer
+		return &c, true
+	case *TransformFloat64Opts:
+		c := *o
+		c.randomizer = randomizer
+		return &c, true
+	case *TransformFullNameOpts:
+		c := *o
+		c.randomizer = randomizer
+		return &c, true
+	case *TransformInt64Opts:
+		c := *o
+		c.randomizer = randomizer
+		return &c, true
+	case *TransformInt64PhoneNumberOpts:
+		c := *o
+		c.randomizer = randomizer
+		return &c, true
+	case *TransformLastNameOpts:
+		c := *o
+		c.randomizer = randomizer
+		return &c, true
+	case *TransformStringOpts:
+		c := *o
+		c.randomizer = randomizer
+		return &c, true
+	case *TransformStringPhoneNumberOpts:
+		c := *o
+		c.randomizer = randomizer
+		return &c, true
+	default:
+		return nil, false
+	}
+}
@@ -18,6 +18,7 @@ type GenerateCityOpts struct {
 	randomizer     rng.Rand
 	
 	maxLength int64
+	locale *string
 }
 
 func NewGenerateCity() *GenerateCity {
@@ -27,6 +28,7 @@ func NewGenerateCity() *GenerateCity {
 func NewGenerateCityOpts(
 	maxLength int64,
   seedArg *int64,
+	locale *string,
 ) (*GenerateCityOpts, error) {
 	seed, err := transformer_utils.GetSeedOrDefault(seedArg)
   if err != nil {
@@ -35,14 +37,15 @@ func NewGenerateCityOpts(
 	
 	return &GenerateCityOpts{
 		maxLength: maxLength,
-		randomizer: rng.New(seed),	
+		randomizer: rng.New(seed),
+		locale: locale,	
 	}, nil
 }
 
 func (t *GenerateCity) GetJsTemplateData() (*TemplateData, error) {
 	return &TemplateData{
 		Name: "generateCity",
-		Description: "Randomly selects a city from a list of predefined US cities.",
+		Description: "Randomly selects a city from the dataset of the locale, which defaults to en_US.",
 		Example: "",
 	}, nil
 }
@@ -66,5 +69,11 @@ func (t *GenerateCity) ParseOptions(opts map[string]any) (any, error) {
 	}
 	transformerOpts.randomizer = rng.New(seed)
 
+	var locale *string
+	if arg, ok := opts["locale"].(string); ok {
+		locale = &arg
+	}
+	transformerOpts.locale = locale
+
 	return transformerOpts, nil
 }
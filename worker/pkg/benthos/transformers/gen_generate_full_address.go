@@ -18,6 +18,7 @@ type GenerateFullAddressOpts struct {
 	randomizer     rng.Rand
 	
 	maxLength int64
+	locale *string
 }
 
 func NewGenerateFullAddress() *GenerateFullAddress {
@@ -27,6 +28,7 @@ func NewGenerateFullAddress() *GenerateFullAddress {
 func NewGenerateFullAddressOpts(
 	maxLength int64,
   seedArg *int64,
+	locale *string,
 ) (*GenerateFullAddressOpts, error) {
 	seed, err := transformer_utils.GetSeedOrDefault(seedArg)
   if err != nil {
@@ -35,14 +37,15 @@ func NewGenerateFullAddressOpts(
 	
 	return &GenerateFullAddressOpts{
 		maxLength: maxLength,
-		randomizer: rng.New(seed),	
+		randomizer: rng.New(seed),
+		locale: locale,	
 	}, nil
 }
 
 func (t *GenerateFullAddress) GetJsTemplateData() (*TemplateData, error) {
 	return &TemplateData{
 		Name: "generateFullAddress",
-		Description: "Generates a randomly selected real full address that exists in the United States.",
+		Description: "Generates a randomly selected full address from the dataset of the locale, which defaults to en_US.",
 		Example: "",
 	}, nil
 }
@@ -66,5 +69,11 @@ func (t *GenerateFullAddress) ParseOptions(opts map[string]any) (any, error) {
 	}
 	transformerOpts.randomizer = rng.New(seed)
 
+	var locale *string
+	if arg, ok := opts["locale"].(string); ok {
+		locale = &arg
+	}
+	transformerOpts.locale = locale
+
 	return transformerOpts, nil
 }
@@ -18,6 +18,7 @@ type GenerateFullNameOpts struct {
 	randomizer     rng.Rand
 	
 	maxLength int64
+	locale *string
 }
 
 func NewGenerateFullName() *GenerateFullName {
@@ -27,6 +28,7 @@ func NewGenerateFullName() *GenerateFullName {
 func NewGenerateFullNameOpts(
 	maxLengthArg *int64,
   seedArg *int64,
+	locale *string,
 ) (*GenerateFullNameOpts, error) {
 	maxLength := int64(10000) 
 	if maxLengthArg != nil {
@@ -40,7 +42,8 @@ func NewGenerateFullNameOpts(
 	
 	return &GenerateFullNameOpts{
 		maxLength: maxLength,
-		randomizer: rng.New(seed),	
+		randomizer: rng.New(seed),
+		locale: locale,	
 	}, nil
 }
 
@@ -71,5 +74,11 @@ func (t *GenerateFullName) ParseOptions(opts map[string]any) (any, error) {
 	}
 	transformerOpts.randomizer = rng.New(seed)
 
+	var locale *string
+	if arg, ok := opts["locale"].(string); ok {
+		locale = &arg
+	}
+	transformerOpts.locale = locale
+
 	return transformerOpts, nil
 }
@@ -19,6 +19,7 @@ type GenerateInternationalPhoneNumberOpts struct {
 	
 	min int64
 	max int64
+	locale *string
 }
 
 func NewGenerateInternationalPhoneNumber() *GenerateInternationalPhoneNumber {
@@ -29,6 +30,7 @@ func NewGenerateInternationalPhoneNumberOpts(
 	min int64,
 	max int64,
   seedArg *int64,
+	locale *string,
 ) (*GenerateInternationalPhoneNumberOpts, error) {
 	seed, err := transformer_utils.GetSeedOrDefault(seedArg)
   if err != nil {
@@ -38,7 +40,8 @@ func NewGenerateInternationalPhoneNumberOpts(
 	return &GenerateInternationalPhoneNumberOpts{
 		min: min,
 		max: max,
-		randomizer: rng.New(seed),	
+		randomizer: rng.New(seed),
+		locale: locale,	
 	}, nil
 }
 
@@ -75,5 +78,11 @@ func (t *GenerateInternationalPhoneNumber) ParseOptions(opts map[string]any) (an
 	}
 	transformerOpts.randomizer = rng.New(seed)
 
+	var locale *string
+	if arg, ok := opts["locale"].(string); ok {
+		locale = &arg
+	}
+	transformerOpts.locale = locale
+
 	return transformerOpts, nil
 }
@@ -18,6 +18,7 @@ type GenerateStateOpts struct {
 	randomizer     rng.Rand
 	
 	generateFullName bool
+	locale *string
 }
 
 func NewGenerateState() *GenerateState {
@@ -27,6 +28,7 @@ func NewGenerateState() *GenerateState {
 func NewGenerateStateOpts(
 	generateFullNameArg *bool,
   seedArg *int64,
+	locale *string,
 ) (*GenerateStateOpts, error) {
 	generateFullName := bool(false) 
 	if generateFullNameArg != nil {
@@ -40,14 +42,15 @@ func NewGenerateStateOpts(
 	
 	return &GenerateStateOpts{
 		generateFullName: generateFullName,
-		randomizer: rng.New(seed),	
+		randomizer: rng.New(seed),
+		locale: locale,	
 	}, nil
 }
 
 func (t *GenerateState) GetJsTemplateData() (*TemplateData, error) {
 	return &TemplateData{
 		Name: "generateState",
-		Description: "Randomly selects a US state and by default, returns it as a 2-letter state code.",
+		Description: "Randomly selects a state from the dataset of the locale, which defaults to en_US, and by default, returns it as a 2-letter state code.",
 		Example: "",
 	}, nil
 }
@@ -71,5 +74,11 @@ func (t *GenerateState) ParseOptions(opts map[string]any) (any, error) {
 	}
 	transformerOpts.randomizer = rng.New(seed)
 
+	var locale *string
+	if arg, ok := opts["locale"].(string); ok {
+		locale = &arg
+	}
+	transformerOpts.locale = locale
+
 	return transformerOpts, nil
 }
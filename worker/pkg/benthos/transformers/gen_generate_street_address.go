@@ -18,6 +18,7 @@ type GenerateStreetAddressOpts struct {
 	randomizer     rng.Rand
 	
 	maxLength int64
+	locale *string
 }
 
 func NewGenerateStreetAddress() *GenerateStreetAddress {
@@ -27,6 +28,7 @@ func NewGenerateStreetAddress() *GenerateStreetAddress {
 func NewGenerateStreetAddressOpts(
 	maxLength int64,
   seedArg *int64,
+	locale *string,
 ) (*GenerateStreetAddressOpts, error) {
 	seed, err := transformer_utils.GetSeedOrDefault(seedArg)
   if err != nil {
@@ -35,14 +37,15 @@ func NewGenerateStreetAddressOpts(
 	
 	return &GenerateStreetAddressOpts{
 		maxLength: maxLength,
-		randomizer: rng.New(seed),	
+		randomizer: rng.New(seed),
+		locale: locale,	
 	}, nil
 }
 
 func (t *GenerateStreetAddress) GetJsTemplateData() (*TemplateData, error) {
 	return &TemplateData{
 		Name: "generateStreetAddress",
-		Description: "Randomly generates a street address.",
+		Description: "Randomly generates a street address from the dataset of the locale, which defaults to en_US.",
 		Example: "",
 	}, nil
 }
@@ -66,5 +69,11 @@ func (t *GenerateStreetAddress) ParseOptions(opts map[string]any) (any, error) {
 	}
 	transformerOpts.randomizer = rng.New(seed)
 
+	var locale *string
+	if arg, ok := opts["locale"].(string); ok {
+		locale = &arg
+	}
+	transformerOpts.locale = locale
+
 	return transformerOpts, nil
 }
@@ -19,6 +19,7 @@ type GenerateStringPhoneNumberOpts struct {
 	
 	min int64
 	max int64
+	locale *string
 }
 
 func NewGenerateStringPhoneNumber() *GenerateStringPhoneNumber {
@@ -29,6 +30,7 @@ func NewGenerateStringPhoneNumberOpts(
 	min int64,
 	max int64,
   seedArg *int64,
+	locale *string,
 ) (*GenerateStringPhoneNumberOpts, error) {
 	seed, err := transformer_utils.GetSeedOrDefault(seedArg)
   if err != nil {
@@ -38,14 +40,15 @@ func NewGenerateStringPhoneNumberOpts(
 	return &GenerateStringPhoneNumberOpts{
 		min: min,
 		max: max,
-		randomizer: rng.New(seed),	
+		randomizer: rng.New(seed),
+		locale: locale,	
 	}, nil
 }
 
 func (t *GenerateStringPhoneNumber) GetJsTemplateData() (*TemplateData, error) {
 	return &TemplateData{
 		Name: "generateStringPhoneNumber",
-		Description: "Generates a random 10 digit phone number and returns it as a string with no hyphens.",
+		Description: "Generates a random phone number and returns it as a string with no hyphens. Uses the national numbering plan of the locale when one is provided.",
 		Example: "",
 	}, nil
 }
@@ -75,5 +78,11 @@ func (t *GenerateStringPhoneNumber) ParseOptions(opts map[string]any) (any, erro
 	}
 	transformerOpts.randomizer = rng.New(seed)
 
+	var locale *string
+	if arg, ok := opts["locale"].(string); ok {
+		locale = &arg
+	}
+	transformerOpts.locale = locale
+
 	return transformerOpts, nil
 }
@@ -17,6 +17,7 @@ type GenerateZipcode struct{}
 type GenerateZipcodeOpts struct {
 	randomizer     rng.Rand
 	
+	locale *string
 }
 
 func NewGenerateZipcode() *GenerateZipcode {
@@ -25,6 +26,7 @@ func NewGenerateZipcode() *GenerateZipcode {
 
 func NewGenerateZipcodeOpts(
   seedArg *int64,
+	locale *string,
 ) (*GenerateZipcodeOpts, error) {
 	seed, err := transformer_utils.GetSeedOrDefault(seedArg)
   if err != nil {
@@ -32,14 +34,15 @@ func NewGenerateZipcodeOpts(
 	}
 	
 	return &GenerateZipcodeOpts{
-		randomizer: rng.New(seed),	
+		randomizer: rng.New(seed),
+		locale: locale,	
 	}, nil
 }
 
 func (t *GenerateZipcode) GetJsTemplateData() (*TemplateData, error) {
 	return &TemplateData{
 		Name: "generateZipcode",
-		Description: "Generates a randomly selected US zipcode.",
+		Description: "Generates a randomly selected zipcode from the dataset of the locale, which defaults to en_US.",
 		Example: "",
 	}, nil
 }
@@ -57,5 +60,11 @@ func (t *GenerateZipcode) ParseOptions(opts map[string]any) (any, error) {
 	}
 	transformerOpts.randomizer = rng.New(seed)
 
+	var locale *string
+	if arg, ok := opts["locale"].(string); ok {
+		locale = &arg
+	}
+	transformerOpts.locale = locale
+
 	return transformerOpts, nil
 }
@@ -19,6 +19,7 @@ type TransformLastNameOpts struct {
 	
 	maxLength int64
 	preserveLength bool
+	locale *string
 }
 
 func NewTransformLastName() *TransformLastName {
@@ -29,6 +30,7 @@ func NewTransformLastNameOpts(
 	maxLengthArg *int64,
 	preserveLengthArg *bool,
   seedArg *int64,
+	locale *string,
 ) (*TransformLastNameOpts, error) {
 	maxLength := int64(10000) 
 	if maxLengthArg != nil {
@@ -48,7 +50,8 @@ func NewTransformLastNameOpts(
 	return &TransformLastNameOpts{
 		maxLength: maxLength,
 		preserveLength: preserveLength,
-		randomizer: rng.New(seed),	
+		randomizer: rng.New(seed),
+		locale: locale,	
 	}, nil
 }
 
@@ -85,5 +88,11 @@ func (t *TransformLastName) ParseOptions(opts map[string]any) (any, error) {
 	}
 	transformerOpts.randomizer = rng.New(seed)
 
+	var locale *string
+	if arg, ok := opts["locale"].(string); ok {
+		locale = &arg
+	}
+	transformerOpts.locale = locale
+
 	return transformerOpts, nil
 }
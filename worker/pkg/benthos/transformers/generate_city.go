@@ -13,9 +13,10 @@ import (
 
 func init() {
 	spec := bloblang.NewPluginSpec().
-		Description("Randomly selects a city from a list of predefined US cities.").
+		Description("Randomly selects a city from the dataset of the locale, which defaults to en_US.").
 		Param(bloblang.NewInt64Param("max_length").Description("Specifies the maximum length for the generated data. This field ensures that the output does not exceed a certain number of characters.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewStringParam("locale").Optional().Description("An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US."))
 
 	err := bloblang.RegisterFunctionV2("generate_city", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		maxLength, err := args.GetInt64("max_length")
@@ -34,8 +35,17 @@ func init() {
 
 		randomizer := rng.New(seed)
 
+		locale, err := args.GetOptionalString("locale")
+		if err != nil {
+			return nil, err
+		}
+		dataset, err := getLocaleDataset(locale)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := generateRandomCity(randomizer, maxLength)
+			res, err := generateRandomCity(randomizer, dataset, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run generate_city: %w", err)
 			}
@@ -52,14 +62,18 @@ func (t *GenerateCity) Generate(opts any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid parsed opts: %T", opts)
 	}
+	dataset, err := getLocaleDataset(parsedOpts.locale)
+	if err != nil {
+		return nil, err
+	}
 
-	return generateRandomCity(parsedOpts.randomizer, parsedOpts.maxLength)
+	return generateRandomCity(parsedOpts.randomizer, dataset, parsedOpts.maxLength)
 }
 
-// Generates a randomly selected city that exists in the United States. Accounts for the maxLength of the column and searches for a city that is shorter than the maxLength. If not, it randomly generates a string that len(string) == maxLength
-func generateRandomCity(randomizer rng.Rand, maxLength int64) (string, error) {
+// Generates a randomly selected city that exists in the locale of the dataset. Accounts for the maxLength of the column and searches for a city that is shorter than the maxLength. If not, it randomly generates a string that len(string) == maxLength
+func generateRandomCity(randomizer rng.Rand, dataset *transformers_dataset.LocaleDataset, maxLength int64) (string, error) {
 	var filteredCities []string
-	for _, address := range transformers_dataset.Addresses {
+	for _, address := range dataset.Addresses {
 		if len(address.City) <= int(maxLength) {
 			filteredCities = append(filteredCities, address.City)
 		}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	"github.com/nucleuscloud/neosync/worker/pkg/rng"
 	"github.com/stretchr/testify/assert"
 	"github.com/warpstreamlabs/bento/public/bloblang"
@@ -13,7 +14,7 @@ import (
 var maxLength = int64(20)
 
 func Test_GenerateCity(t *testing.T) {
-	res, err := generateRandomCity(rng.New(time.Now().UnixMilli()), maxLength)
+	res, err := generateRandomCity(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset, maxLength)
 	assert.NoError(t, err)
 
 	assert.IsType(t, "", res, "The returned city should be a string")
@@ -21,7 +22,7 @@ func Test_GenerateCity(t *testing.T) {
 }
 
 func Test_GenerateCityShortMax(t *testing.T) {
-	res, err := generateRandomCity(rng.New(time.Now().UnixMilli()), int64(3))
+	res, err := generateRandomCity(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset, int64(3))
 	assert.NoError(t, err)
 
 	assert.IsType(t, "", res, "The returned city should be a string")
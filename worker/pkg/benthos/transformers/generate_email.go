@@ -158,7 +158,7 @@ func generateNameForEmail(randomizer rng.Rand, minLength *int64, maxLength int64
 	var randomLastName string
 	if maxFirstNameIdx == -1 && maxLastNameIdx == -1 {
 		var err error
-		randomLastName, err = generateRandomLastName(randomizer, minLength, maxLength)
+		randomLastName, err = generateRandomLastName(randomizer, transformers_dataset.EnUSDataset, minLength, maxLength)
 		if err != nil {
 			// we don't want to fail at any cost, so generate a random character string because we've been given a value we can't generate a last name for
 			randomLastName = transformer_utils.GetRandomCharacterString(randomizer, maxLength)
@@ -167,7 +167,7 @@ func generateNameForEmail(randomizer rng.Rand, minLength *int64, maxLength int64
 	if maxFirstNameIdx != -1 {
 		maxFirstNameLength := transformers_dataset.FirstNameIndices[maxFirstNameIdx]
 		var err error
-		randomFirstName, err = generateRandomFirstName(randomizer, nil, maxFirstNameLength)
+		randomFirstName, err = generateRandomFirstName(randomizer, transformers_dataset.EnUSDataset, nil, maxFirstNameLength)
 		if err != nil {
 			return "", err
 		}
@@ -175,7 +175,7 @@ func generateNameForEmail(randomizer rng.Rand, minLength *int64, maxLength int64
 	if maxLastNameIdx != -1 {
 		maxLastNameLength := transformers_dataset.LastNameIndices[maxLastNameIdx]
 		var err error
-		randomLastName, err = generateRandomLastName(randomizer, nil, maxLastNameLength)
+		randomLastName, err = generateRandomLastName(randomizer, transformers_dataset.EnUSDataset, nil, maxLastNameLength)
 		if err != nil {
 			return "", err
 		}
@@ -15,7 +15,8 @@ func init() {
 	spec := bloblang.NewPluginSpec().
 		Description("Generates a random first name between 2 and 12 characters long.").
 		Param(bloblang.NewInt64Param("max_length").Default(10000).Description("Specifies the maximum length for the generated data. This field ensures that the output does not exceed a certain number of characters.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewStringParam("locale").Optional().Description("An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US."))
 
 	err := bloblang.RegisterFunctionV2("generate_first_name", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		maxLength, err := args.GetInt64("max_length")
@@ -34,8 +35,17 @@ func init() {
 
 		randomizer := rng.New(seed)
 
+		locale, err := args.GetOptionalString("locale")
+		if err != nil {
+			return nil, err
+		}
+		dataset, err := getLocaleDataset(locale)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			output, err := generateRandomFirstName(randomizer, nil, maxLength)
+			output, err := generateRandomFirstName(randomizer, dataset, nil, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run generate_first_name: %w", err)
 			}
@@ -52,16 +62,20 @@ func (t *GenerateFirstName) Generate(opts any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid parsed opts: %T", opts)
 	}
+	dataset, err := getLocaleDataset(parsedOpts.locale)
+	if err != nil {
+		return nil, err
+	}
 
-	return generateRandomFirstName(parsedOpts.randomizer, nil, parsedOpts.maxLength)
+	return generateRandomFirstName(parsedOpts.randomizer, dataset, nil, parsedOpts.maxLength)
 }
 
-func generateRandomFirstName(randomizer rng.Rand, minLength *int64, maxLength int64) (string, error) {
+func generateRandomFirstName(randomizer rng.Rand, dataset *transformers_dataset.LocaleDataset, minLength *int64, maxLength int64) (string, error) {
 	return transformer_utils.GenerateStringFromCorpus(
 		randomizer,
-		transformers_dataset.FirstNames,
-		transformers_dataset.FirstNameMap,
-		transformers_dataset.FirstNameIndices,
+		dataset.FirstNames.Values,
+		dataset.FirstNames.LengthMap,
+		dataset.FirstNames.Indices,
 		minLength,
 		maxLength,
 		nil,
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,7 +15,7 @@ import (
 
 func Test_GenerateRandomFirstName(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
-	res, err := generateRandomFirstName(randomizer, nil, maxCharacterLimit)
+	res, err := generateRandomFirstName(randomizer, transformers_dataset.EnUSDataset, nil, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, res)
@@ -24,7 +25,7 @@ func Test_GenerateRandomFirstName(t *testing.T) {
 func Test_GenerateRandomFirstName_Random_Seed(t *testing.T) {
 	seed := time.Now().UnixNano()
 	randomizer := rand.New(rand.NewSource(seed))
-	res, err := generateRandomFirstName(randomizer, nil, maxCharacterLimit)
+	res, err := generateRandomFirstName(randomizer, transformers_dataset.EnUSDataset, nil, maxCharacterLimit)
 
 	assert.NoError(t, err, "failed with seed", "seed", seed)
 	assert.NotEmpty(t, res)
@@ -33,7 +34,7 @@ func Test_GenerateRandomFirstName_Random_Seed(t *testing.T) {
 
 func Test_GenerateRandomFirstName_Clamped(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
-	res, err := generateRandomFirstName(randomizer, shared.Ptr(int64(10)), maxCharacterLimit)
+	res, err := generateRandomFirstName(randomizer, transformers_dataset.EnUSDataset, shared.Ptr(int64(10)), maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, res)
@@ -13,9 +13,10 @@ import (
 
 func init() {
 	spec := bloblang.NewPluginSpec().
-		Description("Generates a randomly selected real full address that exists in the United States.").
+		Description("Generates a randomly selected full address from the dataset of the locale, which defaults to en_US.").
 		Param(bloblang.NewInt64Param("max_length").Description("Specifies the maximum length for the generated data. This field ensures that the output does not exceed a certain number of characters.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewStringParam("locale").Optional().Description("An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US."))
 
 	err := bloblang.RegisterFunctionV2("generate_full_address", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		maxLength, err := args.GetInt64("max_length")
@@ -34,8 +35,17 @@ func init() {
 
 		randomizer := rng.New(seed)
 
+		locale, err := args.GetOptionalString("locale")
+		if err != nil {
+			return nil, err
+		}
+		dataset, err := getLocaleDataset(locale)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := generateRandomFullAddress(randomizer, maxLength)
+			res, err := generateRandomFullAddress(randomizer, dataset, maxLength)
 			if err != nil {
 				return nil, err
 			}
@@ -52,18 +62,20 @@ func (t *GenerateFullAddress) Generate(opts any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid parsed opts: %T", opts)
 	}
+	dataset, err := getLocaleDataset(parsedOpts.locale)
+	if err != nil {
+		return nil, err
+	}
 
-	return generateRandomFullAddress(parsedOpts.randomizer, parsedOpts.maxLength)
+	return generateRandomFullAddress(parsedOpts.randomizer, dataset, parsedOpts.maxLength)
 }
 
-/* Generates a random full address from the US including street address, city, state and zipcode */
-func generateRandomFullAddress(randomizer rng.Rand, maxLength int64) (string, error) {
-	addresses := transformers_dataset.Addresses
-
+/* Generates a random full address from the locale of the dataset including street address, city, state and zipcode */
+func generateRandomFullAddress(randomizer rng.Rand, dataset *transformers_dataset.LocaleDataset, maxLength int64) (string, error) {
 	var filteredAddresses []string
 
-	for _, address := range addresses {
-		addy := fmt.Sprintf(`%s %s %s, %s`, address.Address1, address.City, address.State, address.Zipcode)
+	for _, address := range dataset.Addresses {
+		addy := dataset.FormatAddress(address)
 		if len(addy) <= int(maxLength) {
 			filteredAddresses = append(filteredAddresses, addy)
 		}
@@ -78,20 +90,20 @@ func generateRandomFullAddress(randomizer rng.Rand, maxLength int64) (string, er
 			}
 			return str, nil
 		} else {
-			sa, err := generateRandomStreetAddress(randomizer, 5)
+			sa, err := generateRandomStreetAddress(randomizer, dataset, 5)
 			if err != nil {
 				return "", err
 			}
-			city, err := generateRandomCity(randomizer, 5)
+			city, err := generateRandomCity(randomizer, dataset, 5)
 			if err != nil {
 				return "", err
 			}
 
-			state := generateRandomState(randomizer, false)
+			state := generateRandomState(randomizer, dataset, false)
 
-			zip := generateRandomZipcode(randomizer)
+			zip := generateRandomZipcode(randomizer, dataset)
 
-			return fmt.Sprintf(`%s %s %s, %s`, sa, city, state, zip), nil
+			return dataset.FormatAddress(transformers_dataset.Address{Address1: sa, City: city, State: state, Zipcode: zip}), nil
 		}
 	}
 
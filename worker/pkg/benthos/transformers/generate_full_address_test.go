@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	"github.com/nucleuscloud/neosync/worker/pkg/rng"
 	"github.com/stretchr/testify/assert"
 	"github.com/warpstreamlabs/bento/public/bloblang"
@@ -13,7 +14,7 @@ import (
 var faMaxLength = int64(40)
 
 func Test_GenerateFullAddress(t *testing.T) {
-	res, err := generateRandomFullAddress(rng.New(time.Now().UnixMilli()), faMaxLength)
+	res, err := generateRandomFullAddress(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset, faMaxLength)
 	assert.NoError(t, err)
 
 	assert.IsType(t, "", res, "The returned full address should be a string")
@@ -23,7 +24,7 @@ func Test_GenerateFullAddress(t *testing.T) {
 func Test_GenerateFullAddressVeryShortMax(t *testing.T) {
 	shortMax := int64(15)
 
-	res, err := generateRandomFullAddress(rng.New(time.Now().UnixMilli()), shortMax)
+	res, err := generateRandomFullAddress(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset, shortMax)
 	assert.NoError(t, err)
 
 	assert.IsType(t, "", res, "The returned full address should be a string")
@@ -33,7 +34,7 @@ func Test_GenerateFullAddressVeryShortMax(t *testing.T) {
 func Test_GenerateFullAddressShortMax(t *testing.T) {
 	shortMax := int64(25)
 
-	res, err := generateRandomFullAddress(rng.New(time.Now().UnixMilli()), shortMax)
+	res, err := generateRandomFullAddress(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset, shortMax)
 	assert.NoError(t, err)
 
 	assert.IsType(t, "", res, "The returned full address should be a string")
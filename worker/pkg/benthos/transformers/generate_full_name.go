@@ -15,7 +15,8 @@ func init() {
 	spec := bloblang.NewPluginSpec().
 		Description("Generates a new full name consisting of a first and last name.").
 		Param(bloblang.NewInt64Param("max_length").Default(10000).Description("Specifies the maximum length for the generated data. This field ensures that the output does not exceed a certain number of characters.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewStringParam("locale").Optional().Description("An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US."))
 
 	err := bloblang.RegisterFunctionV2("generate_full_name", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		maxLength, err := args.GetInt64("max_length")
@@ -33,8 +34,17 @@ func init() {
 		}
 		randomizer := rng.New(seed)
 
+		locale, err := args.GetOptionalString("locale")
+		if err != nil {
+			return nil, err
+		}
+		dataset, err := getLocaleDataset(locale)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := generateRandomFullName(randomizer, maxLength)
+			res, err := generateRandomFullName(randomizer, dataset, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run generate_full_name: %w", err)
 			}
@@ -52,34 +62,41 @@ func (t *GenerateFullName) Generate(opts any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid parsed opts: %T", opts)
 	}
+	dataset, err := getLocaleDataset(parsedOpts.locale)
+	if err != nil {
+		return nil, err
+	}
 
-	return generateRandomFullName(parsedOpts.randomizer, parsedOpts.maxLength)
+	return generateRandomFullName(parsedOpts.randomizer, dataset, parsedOpts.maxLength)
 }
 
-/* Generates a random full name */
-func generateRandomFullName(randomizer rng.Rand, maxLength int64) (string, error) {
+/* Generates a random full name in the name order of the locale */
+func generateRandomFullName(randomizer rng.Rand, dataset *transformers_dataset.LocaleDataset, maxLength int64) (string, error) {
 	maxLengthMinusSpace := maxLength - 1
 	if maxLengthMinusSpace <= 0 {
 		return "", fmt.Errorf("unable to generate full name including space with provided max length: %d", maxLength)
 	}
 	maxFirstNameIdx, maxLastNameIdx := transformer_utils.FindClosestPair(
-		transformers_dataset.FirstNameIndices, transformers_dataset.LastNameIndices,
+		dataset.FirstNames.Indices, dataset.LastNames.Indices,
 		maxLengthMinusSpace,
 	)
 	if maxFirstNameIdx == -1 || maxLastNameIdx == -1 {
 		return "", fmt.Errorf("unable to generate a full name with the provided max length: %d", maxLength)
 	}
 
-	maxFirstNameLength := transformers_dataset.FirstNameIndices[maxFirstNameIdx]
-	maxLastNameLength := transformers_dataset.LastNameIndices[maxLastNameIdx]
-	firstname, err := generateRandomFirstName(randomizer, nil, maxFirstNameLength)
+	maxFirstNameLength := dataset.FirstNames.Indices[maxFirstNameIdx]
+	maxLastNameLength := dataset.LastNames.Indices[maxLastNameIdx]
+	firstname, err := generateRandomFirstName(randomizer, dataset, nil, maxFirstNameLength)
 	if err != nil {
 		return "", fmt.Errorf("unable to generate random first name with length: %d", maxFirstNameLength)
 	}
-	lastname, err := generateRandomLastName(randomizer, nil, maxLastNameLength)
+	lastname, err := generateRandomLastName(randomizer, dataset, nil, maxLastNameLength)
 	if err != nil {
 		return "", fmt.Errorf("unable to generate random last name with length: %d", maxLastNameLength)
 	}
 
+	if dataset.LastNameFirst {
+		return fmt.Sprintf("%s %s", lastname, firstname), nil
+	}
 	return fmt.Sprintf("%s %s", firstname, lastname), nil
 }
@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"testing"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/warpstreamlabs/bento/public/bloblang"
@@ -13,7 +14,7 @@ import (
 func Test_GenerateRandomFullName(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
 
-	res, err := generateRandomFullName(randomizer, maxCharacterLimit)
+	res, err := generateRandomFullName(randomizer, transformers_dataset.EnUSDataset, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.NotEmpty(t, res)
@@ -23,7 +24,7 @@ func Test_GenerateRandomFullName(t *testing.T) {
 func Test_generateRandomFullName_MinLength_Error(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
 
-	res, err := generateRandomFullName(randomizer, 1)
+	res, err := generateRandomFullName(randomizer, transformers_dataset.EnUSDataset, 1)
 	assert.Error(t, err)
 	assert.Empty(t, res)
 }
@@ -31,11 +32,11 @@ func Test_generateRandomFullName_MinLength_Error(t *testing.T) {
 func Test_generateRandomFullName_Small(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
 
-	res, err := generateRandomFullName(randomizer, 4)
+	res, err := generateRandomFullName(randomizer, transformers_dataset.EnUSDataset, 4)
 	assert.Error(t, err)
 	assert.Empty(t, res, "cannot generate a full name of length 3 (excluding space) as it must generate a first and last name")
 
-	res, err = generateRandomFullName(randomizer, 5)
+	res, err = generateRandomFullName(randomizer, transformers_dataset.EnUSDataset, 5)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, res)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/utils"
 	"github.com/nucleuscloud/neosync/worker/pkg/rng"
 	"github.com/warpstreamlabs/bento/public/bloblang"
@@ -17,7 +18,8 @@ func init() {
 		Description("Generates a new random international phone number including the + sign and no hyphens.").
 		Param(bloblang.NewInt64Param("min").Description("Specifies the minimum value for the generated phone number.")).
 		Param(bloblang.NewInt64Param("max").Description("Specifies the maximum value for the generated phone number.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewStringParam("locale").Optional().Description("An optional locale, such as de_DE, to generate phone numbers in the national numbering plan of. Random digits are generated if no locale is provided."))
 
 	err := bloblang.RegisterFunctionV2("generate_e164_phone_number", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		min, err := args.GetInt64("min")
@@ -42,8 +44,21 @@ func init() {
 
 		randomizer := rng.New(seed)
 
+		locale, err := args.GetOptionalString("locale")
+		if err != nil {
+			return nil, err
+		}
+		phoneFormat, err := getLocalePhoneNumberFormat(locale)
+		if err != nil {
+			return nil, err
+		}
+		err = validateLocalePhoneNumberLength(phoneFormat, true, min, max)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := generateInternationalPhoneNumber(randomizer, min, max)
+			res, err := generateInternationalPhoneNumber(randomizer, phoneFormat, min, max)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run generate_international_phone_number: %w", err)
 			}
@@ -61,17 +76,42 @@ func (t *GenerateInternationalPhoneNumber) Generate(opts any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid parsed opts: %T", opts)
 	}
+	phoneFormat, err := getLocalePhoneNumberFormat(parsedOpts.locale)
+	if err != nil {
+		return nil, err
+	}
 
-	return generateInternationalPhoneNumber(parsedOpts.randomizer, parsedOpts.min, parsedOpts.max)
+	return generateInternationalPhoneNumber(parsedOpts.randomizer, phoneFormat, parsedOpts.min, parsedOpts.max)
+}
+
+// Returns an error if the phone numbers of the locale can never be generated in the length interval [min, max].
+// Used to reject a transformer config before any values are generated
+func ValidateInternationalPhoneNumberLocale(locale *string, minValue, maxValue int64) error {
+	phoneFormat, err := getLocalePhoneNumberFormat(locale)
+	if err != nil {
+		return err
+	}
+	return validateLocalePhoneNumberLength(phoneFormat, true, minValue, maxValue)
 }
 
-/*  Generates a random phone number in e164 format in the length interval [min, max] with the min length == 9 and the max length == 15.
- */
-func generateInternationalPhoneNumber(randomizer rng.Rand, minValue, maxValue int64) (string, error) {
+/*
+	Generates a random phone number in e164 format in the length interval [min, max] with the min length == 9 and the max length == 15.
+
+If a phone number format is provided, the number is generated with the country code and numbering plan of the locale.
+*/
+func generateInternationalPhoneNumber(randomizer rng.Rand, phoneFormat *transformers_dataset.PhoneNumberFormat, minValue, maxValue int64) (string, error) {
 	if minValue < 9 || maxValue > 15 {
 		return "", errors.New("the length has between 9 and 15 characters long")
 	}
 
+	if phoneFormat != nil {
+		// the length of an e164 number does not include the + sign
+		if err := validateLocalePhoneNumberLength(phoneFormat, true, minValue, maxValue); err != nil {
+			return "", err
+		}
+		return generateLocalePhoneNumber(randomizer, phoneFormat, true), nil
+	}
+
 	val, err := transformer_utils.GenerateRandomInt64InLengthRange(randomizer, minValue, maxValue)
 	if err != nil {
 		return "", nil
@@ -14,7 +14,7 @@ func Test_GenerateInternationalPhoneNumber(t *testing.T) {
 	minValue := int64(9)
 	maxValue := int64(12)
 
-	res, err := generateInternationalPhoneNumber(rng.New(time.Now().UnixNano()), minValue, maxValue)
+	res, err := generateInternationalPhoneNumber(rng.New(time.Now().UnixNano()), nil, minValue, maxValue)
 
 	assert.NoError(t, err)
 	assert.Equal(t, validateE164(res), true, "The actual value should be a valid e164 number")
@@ -26,7 +26,7 @@ func Test_GenerateInternationalPhoneNumberPreserveLength(t *testing.T) {
 	minValue := int64(12)
 	maxValue := int64(12)
 
-	res, err := generateInternationalPhoneNumber(rng.New(time.Now().UnixNano()), minValue, maxValue)
+	res, err := generateInternationalPhoneNumber(rng.New(time.Now().UnixNano()), nil, minValue, maxValue)
 
 	assert.NoError(t, err)
 	assert.Equal(t, validateE164(res), true, "The actual value should be a valid e164 number")
@@ -12,9 +12,10 @@ import (
 // +neosyncTransformerBuilder:generate:generateState
 
 func init() {
-	spec := bloblang.NewPluginSpec().Description("Randomly selects a US state and by default, returns it as a 2-letter state code.").
+	spec := bloblang.NewPluginSpec().Description("Randomly selects a state from the dataset of the locale, which defaults to en_US, and by default, returns it as a 2-letter state code.").
 		Param(bloblang.NewBoolParam("generate_full_name").Default(false).Description("If true returns the full state name instead of the two character state code.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewStringParam("locale").Optional().Description("An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US."))
 
 	err := bloblang.RegisterFunctionV2("generate_state", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		generateFullName, err := args.GetBool("generate_full_name")
@@ -32,8 +33,17 @@ func init() {
 		}
 		randomizer := rng.New(seed)
 
+		locale, err := args.GetOptionalString("locale")
+		if err != nil {
+			return nil, err
+		}
+		dataset, err := getLocaleDataset(locale)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			return generateRandomState(randomizer, generateFullName), nil
+			return generateRandomState(randomizer, dataset, generateFullName), nil
 		}, nil
 	})
 	if err != nil {
@@ -46,18 +56,22 @@ func (t *GenerateState) Generate(opts any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid parsed opts: %T", opts)
 	}
-	return generateRandomState(parsedOpts.randomizer, parsedOpts.generateFullName), nil
+	dataset, err := getLocaleDataset(parsedOpts.locale)
+	if err != nil {
+		return nil, err
+	}
+	return generateRandomState(parsedOpts.randomizer, dataset, parsedOpts.generateFullName), nil
 }
 
 /*
-Generates a randomly selected state that exists in the United States.
+Generates a randomly selected state that exists in the locale of the dataset, such as a US state, German state or Japanese prefecture.
 
 By default, it returns the 2-letter state code i.e. California will return CA. However, this is configurable using the Generate Full Name parameter which, when set to true, will return the full name of the state starting with a capitalized letter.
 */
-func generateRandomState(randomizer rng.Rand, generateFullName bool) string {
-	randomIndex := randomizer.Intn(len(transformers_dataset.States))
+func generateRandomState(randomizer rng.Rand, dataset *transformers_dataset.LocaleDataset, generateFullName bool) string {
+	randomIndex := randomizer.Intn(len(dataset.States))
 	if generateFullName {
-		return transformers_dataset.States[randomIndex].FullName
+		return dataset.States[randomIndex].FullName
 	}
-	return transformers_dataset.States[randomIndex].Code
+	return dataset.States[randomIndex].Code
 }
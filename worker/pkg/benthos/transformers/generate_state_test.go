@@ -11,7 +11,7 @@ import (
 )
 
 func Test_GenerateState(t *testing.T) {
-	res := generateRandomState(rng.New(time.Now().UnixMilli()), false)
+	res := generateRandomState(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset, false)
 
 	assert.IsType(t, "", res, "The returned state should be a string")
 
@@ -27,7 +27,7 @@ func Test_GenerateState(t *testing.T) {
 }
 
 func Test_GenerateStateCodeLength(t *testing.T) {
-	res := generateRandomState(rng.New(time.Now().UnixMilli()), false)
+	res := generateRandomState(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset, false)
 
 	assert.IsType(t, "", res, "The returned state should be a string")
 
@@ -44,7 +44,7 @@ func Test_GenerateStateCodeLength(t *testing.T) {
 }
 
 func Test_GenerateStateCodeFullName(t *testing.T) {
-	res := generateRandomState(rng.New(time.Now().UnixMilli()), true)
+	res := generateRandomState(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset, true)
 
 	assert.IsType(t, "", res, "The returned state should be a string")
 
@@ -21,9 +21,10 @@ type Address struct {
 
 func init() {
 	spec := bloblang.NewPluginSpec().
-		Description("Randomly generates a street address.").
+		Description("Randomly generates a street address from the dataset of the locale, which defaults to en_US.").
 		Param(bloblang.NewInt64Param("max_length").Description("Specifies the maximum length for the generated data. This field ensures that the output does not exceed a certain number of characters.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewStringParam("locale").Optional().Description("An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US."))
 
 	err := bloblang.RegisterFunctionV2("generate_street_address", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		maxLength, err := args.GetInt64("max_length")
@@ -42,8 +43,17 @@ func init() {
 
 		randomizer := rng.New(seed)
 
+		locale, err := args.GetOptionalString("locale")
+		if err != nil {
+			return nil, err
+		}
+		dataset, err := getLocaleDataset(locale)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := generateRandomStreetAddress(randomizer, maxLength)
+			res, err := generateRandomStreetAddress(randomizer, dataset, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run generate_street_address: %w", err)
 			}
@@ -60,14 +70,18 @@ func (t *GenerateStreetAddress) Generate(opts any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid parsed opts: %T", opts)
 	}
+	dataset, err := getLocaleDataset(parsedOpts.locale)
+	if err != nil {
+		return nil, err
+	}
 
-	return generateRandomStreetAddress(parsedOpts.randomizer, parsedOpts.maxLength)
+	return generateRandomStreetAddress(parsedOpts.randomizer, dataset, parsedOpts.maxLength)
 }
 
-/* Generates a random street address in the United States in the format <house_number> <street name> <street ending>*/
-func generateRandomStreetAddress(randomizer rng.Rand, maxLength int64) (string, error) {
+/* Generates a random street address from the locale of the dataset in the format <house_number> <street name> <street ending>*/
+func generateRandomStreetAddress(randomizer rng.Rand, dataset *transformers_dataset.LocaleDataset, maxLength int64) (string, error) {
 	var filteredAddresses []string
-	for _, address := range transformers_dataset.Addresses {
+	for _, address := range dataset.Addresses {
 		if len(address.Address1) <= int(maxLength) {
 			filteredAddresses = append(filteredAddresses, address.Address1)
 		}
@@ -5,13 +5,14 @@ import (
 	"testing"
 	"time"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	"github.com/nucleuscloud/neosync/worker/pkg/rng"
 	"github.com/stretchr/testify/assert"
 	"github.com/warpstreamlabs/bento/public/bloblang"
 )
 
 func Test_GenerateStreetAddress(t *testing.T) {
-	res, err := generateRandomStreetAddress(rng.New(time.Now().UnixMilli()), maxLength)
+	res, err := generateRandomStreetAddress(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset, maxLength)
 	assert.NoError(t, err)
 
 	assert.IsType(t, "", res, "The returned street address should be a string")
@@ -20,7 +21,7 @@ func Test_GenerateStreetAddress(t *testing.T) {
 }
 
 func Test_GenerateStreetAddressShortMax(t *testing.T) {
-	res, err := generateRandomStreetAddress(rng.New(time.Now().UnixMilli()), int64(5))
+	res, err := generateRandomStreetAddress(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset, int64(5))
 	assert.NoError(t, err)
 
 	assert.IsType(t, "", res, "The returned street address should be a string")
@@ -29,7 +30,7 @@ func Test_GenerateStreetAddressShortMax(t *testing.T) {
 }
 
 func Test_GenerateStreetAddressSVeryhortMax(t *testing.T) {
-	res, err := generateRandomStreetAddress(rng.New(time.Now().UnixMilli()), int64(2))
+	res, err := generateRandomStreetAddress(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset, int64(2))
 	assert.NoError(t, err)
 
 	assert.IsType(t, "", res, "The returned street address should be a string")
@@ -3,6 +3,7 @@ package transformers
 import (
 	"fmt"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/utils"
 	"github.com/nucleuscloud/neosync/worker/pkg/rng"
 	"github.com/warpstreamlabs/bento/public/bloblang"
@@ -12,10 +13,11 @@ import (
 
 func init() {
 	spec := bloblang.NewPluginSpec().
-		Description("Generates a random 10 digit phone number and returns it as a string with no hyphens.").
+		Description("Generates a random phone number and returns it as a string with no hyphens. Uses the national numbering plan of the locale when one is provided.").
 		Param(bloblang.NewInt64Param("min").Description("Specifies the minimum length for the generated phone number.")).
 		Param(bloblang.NewInt64Param("max").Description("Specifies the maximum length for the generated phone number.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewStringParam("locale").Optional().Description("An optional locale, such as de_DE, to generate phone numbers in the national numbering plan of. Random digits are generated if no locale is provided."))
 
 	err := bloblang.RegisterFunctionV2("generate_string_phone_number", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		min, err := args.GetInt64("min")
@@ -40,8 +42,22 @@ func init() {
 
 		randomizer := rng.New(seed)
 
+		locale, err := args.GetOptionalString("locale")
+		if err != nil {
+			return nil, err
+		}
+		phoneFormat, err := getLocalePhoneNumberFormat(locale)
+		if err != nil {
+			return nil, err
+		}
+		minLength, maxLength := getStringPhoneNumberLengthInterval(min, max)
+		err = validateLocalePhoneNumberLength(phoneFormat, false, minLength, maxLength)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := generateStringPhoneNumber(randomizer, min, max)
+			res, err := generateStringPhoneNumber(randomizer, phoneFormat, min, max)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run generate_string_phone_number: %w", err)
 			}
@@ -59,15 +75,44 @@ func (t *GenerateStringPhoneNumber) Generate(opts any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid parsed opts: %T", opts)
 	}
+	phoneFormat, err := getLocalePhoneNumberFormat(parsedOpts.locale)
+	if err != nil {
+		return nil, err
+	}
+
+	return generateStringPhoneNumber(parsedOpts.randomizer, phoneFormat, parsedOpts.min, parsedOpts.max)
+}
 
-	return generateStringPhoneNumber(parsedOpts.randomizer, parsedOpts.min, parsedOpts.max)
+// Returns an error if the phone numbers of the locale can never be generated in the length interval [min, max].
+// Used to reject a transformer config before any values are generated
+func ValidateStringPhoneNumberLocale(locale *string, minValue, maxValue int64) error {
+	phoneFormat, err := getLocalePhoneNumberFormat(locale)
+	if err != nil {
+		return err
+	}
+	minLength, maxLength := getStringPhoneNumberLengthInterval(minValue, maxValue)
+	return validateLocalePhoneNumberLength(phoneFormat, false, minLength, maxLength)
 }
 
-/*  Generates a string phone number in the length interval [min, max] with the min length == 9 and the max length == 15.
- */
-func generateStringPhoneNumber(randomizer rng.Rand, minValue, maxValue int64) (string, error) {
-	minValue = transformer_utils.Floor(minValue, 9)
-	maxValue = transformer_utils.Ceil(maxValue, 15)
+// The length interval is clamped so that the min length is at least 9 and the max length is at most 15
+func getStringPhoneNumberLengthInterval(minValue, maxValue int64) (minLength, maxLength int64) {
+	return transformer_utils.Floor(minValue, 9), transformer_utils.Ceil(maxValue, 15)
+}
+
+/*
+	Generates a string phone number in the length interval [min, max] with the min length == 9 and the max length == 15.
+
+If a phone number format is provided, a national number of the locale is generated instead of random digits.
+*/
+func generateStringPhoneNumber(randomizer rng.Rand, phoneFormat *transformers_dataset.PhoneNumberFormat, minValue, maxValue int64) (string, error) {
+	minValue, maxValue = getStringPhoneNumberLengthInterval(minValue, maxValue)
+
+	if phoneFormat != nil {
+		if err := validateLocalePhoneNumberLength(phoneFormat, false, minValue, maxValue); err != nil {
+			return "", err
+		}
+		return generateLocalePhoneNumber(randomizer, phoneFormat, false), nil
+	}
 
 	val, err := transformer_utils.GenerateRandomInt64InLengthRange(randomizer, minValue, maxValue)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/utils"
 	"github.com/nucleuscloud/neosync/worker/pkg/rng"
 	"github.com/warpstreamlabs/bento/public/bloblang"
@@ -60,7 +61,7 @@ func (t *GenerateUsername) Generate(opts any) (any, error) {
 func generateUsername(randomizer rng.Rand, maxLength int64) (string, error) {
 	fn := transformer_utils.GetRandomCharacterString(randomizer, 1)
 
-	ln, err := generateRandomLastName(randomizer, nil, maxLength-1)
+	ln, err := generateRandomLastName(randomizer, transformers_dataset.EnUSDataset, nil, maxLength-1)
 	if err != nil {
 		return "", err
 	}
@@ -12,8 +12,9 @@ import (
 // +neosyncTransformerBuilder:generate:generateZipcode
 
 func init() {
-	spec := bloblang.NewPluginSpec().Description("Generates a randomly selected US zipcode.").
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+	spec := bloblang.NewPluginSpec().Description("Generates a randomly selected zipcode from the dataset of the locale, which defaults to en_US.").
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewStringParam("locale").Optional().Description("An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US."))
 
 	err := bloblang.RegisterFunctionV2("generate_zipcode", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
 		seedArg, err := args.GetOptionalInt64("seed")
@@ -28,8 +29,17 @@ func init() {
 
 		randomizer := rng.New(seed)
 
+		locale, err := args.GetOptionalString("locale")
+		if err != nil {
+			return nil, err
+		}
+		dataset, err := getLocaleDataset(locale)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			return generateRandomZipcode(randomizer), nil
+			return generateRandomZipcode(randomizer, dataset), nil
 		}, nil
 	})
 	if err != nil {
@@ -42,11 +52,15 @@ func (t *GenerateZipcode) Generate(opts any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid parsed opts: %T", opts)
 	}
-	return generateRandomZipcode(parsedOpts.randomizer), nil
+	dataset, err := getLocaleDataset(parsedOpts.locale)
+	if err != nil {
+		return nil, err
+	}
+	return generateRandomZipcode(parsedOpts.randomizer, dataset), nil
 }
 
-// Generates a randomly selected zip code that exists in the United States.
-func generateRandomZipcode(randomizer rng.Rand) string {
-	randomIndex := randomizer.Intn(len(transformers_dataset.Addresses))
-	return transformers_dataset.Addresses[randomIndex].Zipcode
+// Generates a randomly selected zip code that exists in the locale of the dataset.
+func generateRandomZipcode(randomizer rng.Rand, dataset *transformers_dataset.LocaleDataset) string {
+	randomIndex := randomizer.Intn(len(dataset.Addresses))
+	return dataset.Addresses[randomIndex].Zipcode
 }
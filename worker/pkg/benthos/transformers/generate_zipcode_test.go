@@ -11,7 +11,7 @@ import (
 )
 
 func Test_GenerateZipcode(t *testing.T) {
-	res := generateRandomZipcode(rng.New(time.Now().UnixMilli()))
+	res := generateRandomZipcode(rng.New(time.Now().UnixMilli()), transformers_dataset.EnUSDataset)
 
 	assert.IsType(t, "", res, "The returned zipcode should be a string")
 
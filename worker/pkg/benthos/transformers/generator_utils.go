@@ -129,6 +129,10 @@ func ParseBloblangSpec(benthosSpec *BenthosSpec) (*ParsedBenthosSpec, error) {
 		if strings.HasPrefix(line, "(") {
 			matches := paramRegex.FindStringSubmatch(line)
 			if len(matches) > 0 {
+				// deterministic mode is driven by the transformer config and is not a user facing transformer option
+				if matches[2] == "deterministic" {
+					continue
+				}
 				defaultVal := matches[3]
 				description := matches[4]
 				// seed hack
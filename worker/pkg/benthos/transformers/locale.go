@@ -0,0 +1,96 @@
+package transformers
+
+import (
+	"fmt"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
+	"github.com/nucleuscloud/neosync/worker/pkg/rng"
+)
+
+// Returns the dataset of the locale, falling back to the default locale if none was provided
+func getLocaleDataset(locale *string) (*transformers_dataset.LocaleDataset, error) {
+	if locale == nil {
+		return transformers_dataset.GetLocaleDataset(transformers_dataset.DefaultLocale)
+	}
+	return transformers_dataset.GetLocaleDataset(transformers_dataset.Locale(*locale))
+}
+
+// Generates a phone number in the numbering plan of the locale.
+// National numbers start with the trunk prefix, international numbers start with the + sign and the country code.
+func generateLocalePhoneNumber(randomizer rng.Rand, format *transformers_dataset.PhoneNumberFormat, international bool) string {
+	areaCode := format.AreaCodes[randomizer.Intn(len(format.AreaCodes))]
+
+	var sb strings.Builder
+	if international {
+		sb.WriteString("+")
+		sb.WriteString(format.CountryCode)
+	} else {
+		sb.WriteString(format.TrunkPrefix)
+	}
+	sb.WriteString(areaCode)
+	for i := len(areaCode); i < format.NationalNumberLength; i++ {
+		// subscriber numbers do not start with 0 or 1 as those are reserved for prefixes and special services
+		if i == len(areaCode) {
+			sb.WriteString(fmt.Sprintf("%d", randomizer.Intn(8)+2))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("%d", randomizer.Intn(10)))
+	}
+	return sb.String()
+}
+
+// Returns the length of the phone numbers that are generated in the numbering plan of the locale.
+// National numbers include the trunk prefix, international numbers include the country code but not the + sign.
+func getLocalePhoneNumberLength(format *transformers_dataset.PhoneNumberFormat, international bool) int64 {
+	if international {
+		return int64(len(format.CountryCode) + format.NationalNumberLength)
+	}
+	return int64(len(format.TrunkPrefix) + format.NationalNumberLength)
+}
+
+// Numbering plans have a fixed length, so a locale can only be used when its numbers fit in the length interval
+func validateLocalePhoneNumberLength(format *transformers_dataset.PhoneNumberFormat, international bool, minValue, maxValue int64) error {
+	if format == nil {
+		return nil
+	}
+	length := getLocalePhoneNumberLength(format, international)
+	if length < minValue || length > maxValue {
+		return fmt.Errorf("phone numbers of the locale are %d digits long, which is outside of the length interval [%d, %d]", length, minValue, maxValue)
+	}
+	return nil
+}
+
+// Returns the phone number format of the locale, or nil if no locale was provided
+func getLocalePhoneNumberFormat(locale *string) (*transformers_dataset.PhoneNumberFormat, error) {
+	if locale == nil {
+		return nil, nil
+	}
+	dataset, err := getLocaleDataset(locale)
+	if err != nil {
+		return nil, err
+	}
+	return dataset.Phone, nil
+}
+
+// Returns the locale of the dataset that corresponds to the transformer locale, or nil if the locale is unspecified
+func LocaleFromDto(dto mgmtv1alpha1.TransformerLocale) *string {
+	var locale transformers_dataset.Locale
+	switch dto {
+	case mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_EN_US:
+		locale = transformers_dataset.Locale_EnUS
+	case mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_DE_DE:
+		locale = transformers_dataset.Locale_DeDE
+	case mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_FR_FR:
+		locale = transformers_dataset.Locale_FrFR
+	case mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_ES_ES:
+		locale = transformers_dataset.Locale_EsES
+	case mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_JA_JP:
+		locale = transformers_dataset.Locale_JaJP
+	default:
+		return nil
+	}
+	output := string(locale)
+	return &output
+}
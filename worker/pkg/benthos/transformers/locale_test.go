@@ -0,0 +1,165 @@
+package transformers
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+)
+
+func Test_LocaleFromDto(t *testing.T) {
+	assert.Nil(t, LocaleFromDto(mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_UNSPECIFIED))
+	assert.Equal(t, "en_US", *LocaleFromDto(mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_EN_US))
+	assert.Equal(t, "de_DE", *LocaleFromDto(mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_DE_DE))
+	assert.Equal(t, "fr_FR", *LocaleFromDto(mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_FR_FR))
+	assert.Equal(t, "es_ES", *LocaleFromDto(mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_ES_ES))
+	assert.Equal(t, "ja_JP", *LocaleFromDto(mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_JA_JP))
+}
+
+func Test_getLocaleDataset(t *testing.T) {
+	dataset, err := getLocaleDataset(nil)
+	require.NoError(t, err)
+	assert.Equal(t, transformers_dataset.EnUSDataset, dataset)
+
+	dataset, err = getLocaleDataset(shared.Ptr("de_DE"))
+	require.NoError(t, err)
+	assert.Equal(t, transformers_dataset.DeDEDataset, dataset)
+
+	_, err = getLocaleDataset(shared.Ptr("xx_XX"))
+	assert.Error(t, err)
+}
+
+func Test_GenerateRandomFullName_LastNameFirst(t *testing.T) {
+	randomizer := rand.New(rand.NewSource(1))
+
+	res, err := generateRandomFullName(randomizer, transformers_dataset.JaJPDataset, maxCharacterLimit)
+	require.NoError(t, err)
+
+	names := strings.Split(res, " ")
+	require.Len(t, names, 2)
+	assert.Contains(t, transformers_dataset.JapaneseLastNames, names[0], "the last name should be written first")
+	assert.Contains(t, transformers_dataset.JapaneseFirstNames, names[1])
+}
+
+func Test_GenerateFirstName_Locale(t *testing.T) {
+	opts, err := NewGenerateFirstNameOpts(&maxCharacterLimit, shared.Ptr(int64(1)), shared.Ptr("fr_FR"))
+	require.NoError(t, err)
+
+	res, err := new(GenerateFirstName).Generate(opts)
+	require.NoError(t, err)
+	assert.Contains(t, transformers_dataset.FrenchFirstNames, res)
+}
+
+func Test_GenerateState_Locale(t *testing.T) {
+	fullName := true
+	opts, err := NewGenerateStateOpts(&fullName, shared.Ptr(int64(1)), shared.Ptr("ja_JP"))
+	require.NoError(t, err)
+
+	res, err := new(GenerateState).Generate(opts)
+	require.NoError(t, err)
+	assert.True(t, slices.ContainsFunc(transformers_dataset.JapaneseStates, func(state transformers_dataset.State) bool {
+		return state.FullName == res
+	}), "the state should be a japanese prefecture")
+}
+
+func Test_GenerateZipcode_Locale(t *testing.T) {
+	opts, err := NewGenerateZipcodeOpts(shared.Ptr(int64(1)), shared.Ptr("es_ES"))
+	require.NoError(t, err)
+
+	res, err := new(GenerateZipcode).Generate(opts)
+	require.NoError(t, err)
+	assert.True(t, slices.ContainsFunc(transformers_dataset.SpanishAddresses, func(address transformers_dataset.Address) bool {
+		return address.Zipcode == res
+	}), "the zipcode should be a spanish zipcode")
+}
+
+func Test_GenerateFullAddressTransformer_Locale(t *testing.T) {
+	mapping := `root = generate_full_address(max_length:100,locale:"de_DE")`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the full address transformer")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+
+	var expected []string
+	for _, address := range transformers_dataset.GermanAddresses {
+		expected = append(expected, transformers_dataset.DeDEDataset.FormatAddress(address))
+	}
+	assert.Contains(t, expected, res)
+}
+
+func Test_GenerateCityTransformer_InvalidLocale(t *testing.T) {
+	mapping := fmt.Sprintf(`root = generate_city(max_length:%d,locale:"xx_XX")`, maxCharacterLimit)
+	_, err := bloblang.Parse(mapping)
+	assert.Error(t, err, "an unsupported locale should fail to parse")
+}
+
+func Test_GenerateStringPhoneNumber_Locale(t *testing.T) {
+	randomizer := rand.New(rand.NewSource(1))
+
+	res, err := generateStringPhoneNumber(randomizer, transformers_dataset.DeDEDataset.Phone, 9, 15)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(res, "0"), "a german national number should start with the trunk prefix")
+	assert.Len(t, res, 11)
+
+	_, err = generateStringPhoneNumber(randomizer, transformers_dataset.DeDEDataset.Phone, 9, 10)
+	assert.Error(t, err, "a german national number does not fit in the length interval")
+}
+
+func Test_GenerateInternationalPhoneNumber_Locale(t *testing.T) {
+	randomizer := rand.New(rand.NewSource(1))
+
+	res, err := generateInternationalPhoneNumber(randomizer, transformers_dataset.FrFRDataset.Phone, 9, 15)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(res, "+33"))
+	assert.Len(t, res, 12)
+	assert.True(t, validateE164(res))
+}
+
+func Test_GenerateInternationalPhoneNumberTransformer_Locale(t *testing.T) {
+	mapping := `root = generate_e164_phone_number(min:9,max:15,locale:"ja_JP")`
+	ex, err := bloblang.Parse(mapping)
+	require.NoError(t, err, "failed to parse the e164 phone number transformer")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+
+	resStr, ok := res.(string)
+	require.True(t, ok)
+	assert.True(t, strings.HasPrefix(resStr, "+81"))
+	assert.Len(t, resStr, 12)
+}
+
+func Test_PhoneNumberTransformers_RejectLocaleOutsideLengthInterval(t *testing.T) {
+	_, err := bloblang.Parse(`root = generate_string_phone_number(min:9,max:10,locale:"de_DE")`)
+	assert.Error(t, err, "a german national number is 11 digits long and should be rejected before any values are generated")
+	_, err = bloblang.Parse(`root = generate_e164_phone_number(min:9,max:10,locale:"ja_JP")`)
+	assert.Error(t, err, "a japanese international number is 11 digits long and should be rejected before any values are generated")
+	_, err = bloblang.Parse(`root = generate_string_phone_number(min:9,max:15,locale:"de_DE")`)
+	assert.NoError(t, err)
+
+	assert.NoError(t, ValidateStringPhoneNumberLocale(nil, 9, 10), "random digits are generated without a locale")
+	assert.NoError(t, ValidateStringPhoneNumberLocale(shared.Ptr("de_DE"), 11, 11))
+	assert.Error(t, ValidateStringPhoneNumberLocale(shared.Ptr("de_DE"), 12, 15))
+	assert.NoError(t, ValidateInternationalPhoneNumberLocale(shared.Ptr("en_US"), 11, 11))
+	assert.Error(t, ValidateInternationalPhoneNumberLocale(shared.Ptr("en_US"), 9, 10))
+
+	_, err = InitializeTransformerByConfigType(&mgmtv1alpha1.TransformerConfig{
+		Config: &mgmtv1alpha1.TransformerConfig_GenerateStringPhoneNumberConfig{
+			GenerateStringPhoneNumberConfig: &mgmtv1alpha1.GenerateStringPhoneNumber{
+				Min:    9,
+				Max:    10,
+				Locale: mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_DE_DE.Enum(),
+			},
+		},
+	})
+	assert.Error(t, err)
+}
@@ -24,9 +24,10 @@ func init() {
 		Description(`Anonymizes and transforms an existing string value by scrambling the characters while maintaining the format based on a user provided regular expression. Letters will be replaced with letters, numbers with numbers and non-number or letter ASCII characters such as "!&\*" with other characters.`).
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewStringParam("user_provided_regex").Optional().Description("A custom regular expression. This regex is used to manipulate input data during the transformation process.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewBoolParam("deterministic").Optional().Description("Whether the randomizer should be derived from the input value using the account transformer key so that the same input always produces the same output."))
 
-	err := bloblang.RegisterFunctionV2("transform_character_scramble", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+	err := registerAccountKeyedFunction("transform_character_scramble", spec, func(args *bloblang.ParsedParams, accountKey *string) (bloblang.Function, error) {
 		value, err := args.GetOptionalString("value")
 		if err != nil {
 			return nil, err
@@ -47,7 +48,7 @@ func init() {
 			return nil, err
 		}
 
-		seed, err := transformer_utils.GetSeedOrDefault(seedArg)
+		seed, err := getTransformerSeed(args, seedArg, accountKey)
 		if err != nil {
 			return nil, err
 		}
@@ -18,9 +18,10 @@ func init() {
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewBoolParam("preserve_length").Description("Whether the original length of the input data should be preserved during transformation. If set to true, the transformation logic will ensure that the output data has the same length as the input data.")).
 		Param(bloblang.NewInt64Param("max_length").Optional().Description("Specifies the maximum length for the transformed data. This field ensures that the output does not exceed a certain number of characters.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewBoolParam("deterministic").Optional().Description("Whether the randomizer should be derived from the input value using the account transformer key so that the same input always produces the same output."))
 
-	err := bloblang.RegisterFunctionV2("transform_e164_phone_number", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+	err := registerAccountKeyedFunction("transform_e164_phone_number", spec, func(args *bloblang.ParsedParams, accountKey *string) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalString("value")
 		if err != nil {
 			return nil, err
@@ -46,7 +47,7 @@ func init() {
 			return nil, err
 		}
 
-		seed, err := transformer_utils.GetSeedOrDefault(seedArg)
+		seed, err := getTransformerSeed(args, seedArg, accountKey)
 		if err != nil {
 			return nil, err
 		}
@@ -103,7 +104,7 @@ func transformE164PhoneNumber(randomizer rng.Rand, phone string, preserveLength
 			maxValue = *maxLength
 		}
 
-		res, err := generateInternationalPhoneNumber(randomizer, minValue, maxValue)
+		res, err := generateInternationalPhoneNumber(randomizer, nil, minValue, maxValue)
 		if err != nil {
 			return nil, err
 		}
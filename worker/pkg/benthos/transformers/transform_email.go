@@ -1,6 +1,7 @@
 package transformers
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math"
@@ -44,10 +45,11 @@ func init() {
 		Param(bloblang.NewAnyParam("excluded_domains").Default([]any{}).Description("A list of domains that should be excluded from the transformation")).
 		Param(bloblang.NewInt64Param("max_length").Default(10000).Description("Whether the original length of the input data should be preserved during transformation. If set to true, the transformation logic will ensure that the output data has the same length as the input data.")).
 		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used for generating deterministic transformations.")).
+		Param(bloblang.NewBoolParam("deterministic").Optional().Description("Whether the randomizer should be derived from the input value using the account transformer key so that the same input always produces the same output.")).
 		Param(bloblang.NewStringParam("email_type").Default(GenerateEmailType_UuidV4.String()).Description("Specifies the type of email to transform, with options including `uuidv4`, `fullname`, or `any`.")).
 		Param(bloblang.NewStringParam("invalid_email_action").Default(InvalidEmailAction_Reject.String()).Description("Specifies the action to take when an invalid email is encountered, with options including `reject`, `passthrough`, `null`, or `generate`."))
 
-	err := bloblang.RegisterFunctionV2("transform_email", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+	err := registerAccountKeyedFunction("transform_email", spec, func(args *bloblang.ParsedParams, accountKey *string) (bloblang.Function, error) {
 		emailPtr, err := args.GetOptionalString("value")
 		if err != nil {
 			return nil, err
@@ -104,7 +106,7 @@ func init() {
 			return nil, err
 		}
 
-		seed, err := transformer_utils.GetSeedOrDefault(seedArg)
+		seed, err := getTransformerSeed(args, seedArg, accountKey)
 		if err != nil {
 			return nil, err
 		}
@@ -279,7 +281,11 @@ func transformEmail(
 
 	var newname string
 	if emailType == GenerateEmailType_UuidV4 {
-		newuuid := strings.ReplaceAll(uuid.NewString(), "-", "")
+		newuuid, err := newUuidFromRandomizer(randomizer)
+		if err != nil {
+			return nil, err
+		}
+		newuuid = strings.ReplaceAll(newuuid, "-", "")
 		trimmeduuid := transformer_utils.TrimStringIfExceeds(newuuid, maxNameLength)
 		if trimmeduuid == "" {
 			return nil, fmt.Errorf("for the given max length, unable to use uuid to generate transformed email: %d", maxNameLength)
@@ -296,3 +302,16 @@ func transformEmail(
 	generatedemail := fmt.Sprintf("%s@%s", newname, newdomain)
 	return &generatedemail, nil
 }
+
+// Generates a v4 uuid using the randomizer so that it follows the seed of the transformer
+func newUuidFromRandomizer(randomizer rng.Rand) (string, error) {
+	randomBytes := make([]byte, 16)
+	for idx := range randomBytes {
+		randomBytes[idx] = byte(randomizer.Intn(256))
+	}
+	newuuid, err := uuid.NewRandomFromReader(bytes.NewReader(randomBytes))
+	if err != nil {
+		return "", fmt.Errorf("unable to generate uuid: %w", err)
+	}
+	return newuuid.String(), nil
+}
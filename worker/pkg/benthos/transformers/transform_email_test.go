@@ -421,3 +421,32 @@ func Test_fromAnyToStringSlice(t *testing.T) {
 	require.NoError(t, err)
 	require.Empty(t, output)
 }
+
+func Test_Bloblang_transform_email_deterministic(t *testing.T) {
+	env := bloblang.NewEnvironment()
+	require.NoError(t, RegisterAccountKeyedFunctions(env, "key"))
+
+	mapping := `root = [transform_email(value:this.a,deterministic:true), transform_email(value:this.b,deterministic:true), transform_email(value:this.c,deterministic:true)]`
+	ex, err := env.Parse(mapping)
+	require.NoError(t, err, "failed to parse the email transformer")
+
+	res, err := ex.Query(map[string]any{"a": email, "b": email, "c": "other@example.com"})
+	require.NoError(t, err)
+
+	results, ok := res.([]any)
+	require.True(t, ok)
+	require.Len(t, results, 3)
+	require.Equal(t, *results[0].(*string), *results[1].(*string), "the same input should produce the same output")
+	require.NotEqual(t, *results[0].(*string), *results[2].(*string), "different inputs should produce different outputs")
+}
+
+func Test_Bloblang_transform_email_deterministic_no_account_key(t *testing.T) {
+	mapping := fmt.Sprintf(`root = transform_email(value:%q,deterministic:true)`, email)
+	_, err := bloblang.Parse(mapping)
+	require.Error(t, err, "the global environment is never bound to an account key")
+
+	env := bloblang.NewEnvironment()
+	require.NoError(t, RegisterAccountKeyedFunctions(env, ""))
+	_, err = env.Parse(mapping)
+	require.Error(t, err)
+}
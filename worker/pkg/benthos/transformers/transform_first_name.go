@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/utils"
 	"github.com/nucleuscloud/neosync/worker/pkg/rng"
 	"github.com/warpstreamlabs/bento/public/bloblang"
@@ -17,9 +18,11 @@ func init() {
 		Param(bloblang.NewInt64Param("max_length").Default(10000).Description("Specifies the maximum length for the transformed data. This field ensures that the output does not exceed a certain number of characters.")).
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewBoolParam("preserve_length").Default(false).Description("Whether the original length of the input data should be preserved during transformation. If set to true, the transformation logic will ensure that the output data has the same length as the input data.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used for generating deterministic transformations."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used for generating deterministic transformations.")).
+		Param(bloblang.NewBoolParam("deterministic").Optional().Description("Whether the randomizer should be derived from the input value using the account transformer key so that the same input always produces the same output.")).
+		Param(bloblang.NewStringParam("locale").Optional().Description("An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US."))
 
-	err := bloblang.RegisterFunctionV2("transform_first_name", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+	err := registerAccountKeyedFunction("transform_first_name", spec, func(args *bloblang.ParsedParams, accountKey *string) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalString("value")
 		if err != nil {
 			return nil, err
@@ -45,15 +48,24 @@ func init() {
 			return nil, err
 		}
 
-		seed, err := transformer_utils.GetSeedOrDefault(seedArg)
+		seed, err := getTransformerSeed(args, seedArg, accountKey)
 		if err != nil {
 			return nil, err
 		}
 
 		randomizer := rng.New(seed)
 
+		locale, err := args.GetOptionalString("locale")
+		if err != nil {
+			return nil, err
+		}
+		dataset, err := getLocaleDataset(locale)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := transformFirstName(randomizer, value, preserveLength, maxLength)
+			res, err := transformFirstName(randomizer, dataset, value, preserveLength, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_first_name: %w", err)
 			}
@@ -71,17 +83,21 @@ func (t *TransformFirstName) Transform(value, opts any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid parsed opts: %T", opts)
 	}
+	dataset, err := getLocaleDataset(parsedOpts.locale)
+	if err != nil {
+		return nil, err
+	}
 
 	valueStr, ok := value.(string)
 	if !ok {
 		return nil, errors.New("value is not a string")
 	}
 
-	return transformFirstName(parsedOpts.randomizer, valueStr, parsedOpts.preserveLength, parsedOpts.maxLength)
+	return transformFirstName(parsedOpts.randomizer, dataset, valueStr, parsedOpts.preserveLength, parsedOpts.maxLength)
 }
 
 // Generates a random first name which can be of either random length or as long as the input name
-func transformFirstName(randomizer rng.Rand, value string, preserveLength bool, maxLength int64) (*string, error) {
+func transformFirstName(randomizer rng.Rand, dataset *transformers_dataset.LocaleDataset, value string, preserveLength bool, maxLength int64) (*string, error) {
 	if value == "" {
 		return &value, nil
 	}
@@ -93,13 +109,13 @@ func transformFirstName(randomizer rng.Rand, value string, preserveLength bool,
 	// as with this algorithm, it will only ever use values from the underlying map that are that specific size
 	if preserveLength {
 		maxValue = int64(len(value))
-		output, err := generateRandomFirstName(randomizer, &maxValue, maxValue)
+		output, err := generateRandomFirstName(randomizer, dataset, &maxValue, maxValue)
 		if err == nil {
 			return &output, nil
 		}
 	}
 
-	output, err := generateRandomFirstName(randomizer, nil, maxValue)
+	output, err := generateRandomFirstName(randomizer, dataset, nil, maxValue)
 	if err != nil {
 		return nil, err
 	}
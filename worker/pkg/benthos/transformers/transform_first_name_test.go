@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	"github.com/stretchr/testify/assert"
 	"github.com/warpstreamlabs/bento/public/bloblang"
 )
@@ -18,7 +19,7 @@ func Test_TranformFirstNameEmptyName(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
 	emptyName := ""
 
-	res, err := transformFirstName(randomizer, emptyName, false, maxCharacterLimit)
+	res, err := transformFirstName(randomizer, transformers_dataset.EnUSDataset, emptyName, false, maxCharacterLimit)
 	assert.NoError(t, err)
 	assert.Empty(t, res, "The response should be empty")
 }
@@ -26,7 +27,7 @@ func Test_TranformFirstNameEmptyName(t *testing.T) {
 func Test_TranformFirstName_Random(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(time.Now().UnixNano()))
 
-	res, err := transformFirstName(randomizer, "foo", false, maxCharacterLimit)
+	res, err := transformFirstName(randomizer, transformers_dataset.EnUSDataset, "foo", false, maxCharacterLimit)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, res)
 }
@@ -36,7 +37,7 @@ func Test_TransformFirstName_Preserve_True(t *testing.T) {
 
 	nameLength := int64(len(name))
 
-	res, err := transformFirstName(randomizer, name, true, maxCharacterLimit)
+	res, err := transformFirstName(randomizer, transformers_dataset.EnUSDataset, name, true, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.Equal(t, nameLength, int64(len(*res)), "The first name output should be the same length as the input")
@@ -55,7 +56,7 @@ func Test_TransformFirstName_Preserve_True_With_Padding(t *testing.T) {
 
 	name := buffer.String()
 
-	res, err := transformFirstName(randomizer, name, true, 500)
+	res, err := transformFirstName(randomizer, transformers_dataset.EnUSDataset, name, true, 500)
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(len(name)), int64(len(*res)), "The first name output should be the same length as the input")
@@ -65,7 +66,7 @@ func Test_TransformFirstName_Preserve_True_With_Padding(t *testing.T) {
 func Test_TransformFirstName_Preserve_False(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
 
-	res, err := transformFirstName(randomizer, name, false, maxCharacterLimit)
+	res, err := transformFirstName(randomizer, transformers_dataset.EnUSDataset, name, false, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.LessOrEqual(t, int64(len(*res)), maxCharacterLimit, "The last name output should be the same length as the input")
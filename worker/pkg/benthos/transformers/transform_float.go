@@ -21,9 +21,10 @@ func init() {
 		Param(bloblang.NewFloat64Param("randomization_range_max").Description("Specifies the maximum value for the randomization range of the float.")).
 		Param(bloblang.NewInt64Param("precision").Optional().Description("An optional parameter that defines the number of significant digits for the float.")).
 		Param(bloblang.NewInt64Param("scale").Optional().Description("An optional parameter that defines the number of decimal places for the float.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used for generating deterministic transformations."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used for generating deterministic transformations.")).
+		Param(bloblang.NewBoolParam("deterministic").Optional().Description("Whether the randomizer should be derived from the input value using the account transformer key so that the same input always produces the same output."))
 
-	err := bloblang.RegisterFunctionV2("transform_float64", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+	err := registerAccountKeyedFunction("transform_float64", spec, func(args *bloblang.ParsedParams, accountKey *string) (bloblang.Function, error) {
 		value, err := args.Get("value")
 		if err != nil {
 			return nil, err
@@ -52,7 +53,7 @@ func init() {
 			return nil, err
 		}
 
-		seed, err := transformer_utils.GetSeedOrDefault(seedArg)
+		seed, err := getTransformerSeed(args, seedArg, accountKey)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,160 @@
+package transformers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/internal/fpe"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+)
+
+const (
+	fpeNumericAlphabet      = "0123456789"
+	fpeAlphanumericAlphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	// domain separator so that the encryption key differs from the key used for deterministic transformers
+	fpeKeyContext = "neosync-transform-fpe"
+)
+
+func init() {
+	spec := bloblang.NewPluginSpec().
+		Description("Reversibly encrypts an existing string value using FF3-1 format-preserving encryption. The output has the same length and is made up of the same alphabet as the input. Characters that are not part of the alphabet are left in place.").
+		Param(bloblang.NewAnyParam("value").Optional()).
+		Param(bloblang.NewStringParam("alphabet").Description("The characters that values are made up of.")).
+		Param(bloblang.NewStringParam("tweak").Default("").Description("An optional tweak that changes the output for the same input. The same tweak must be used to decrypt the value.")).
+		Param(bloblang.NewBoolParam("pass_through_short_values").Default(false).Description("Passes values with too few characters from the alphabet to be encrypted through unchanged instead of returning an error."))
+
+	// the encryption key is derived from the account key, which is only ever provided from go
+	err := registerAccountKeyedFunction("transform_fpe", spec, func(args *bloblang.ParsedParams, accountKey *string) (bloblang.Function, error) {
+		value, err := args.GetOptionalString("value")
+		if err != nil {
+			return nil, err
+		}
+		alphabet, err := args.GetString("alphabet")
+		if err != nil {
+			return nil, err
+		}
+		tweak, err := args.GetString("tweak")
+		if err != nil {
+			return nil, err
+		}
+		passThroughShortValues, err := args.GetBool("pass_through_short_values")
+		if err != nil {
+			return nil, err
+		}
+		var key string
+		if accountKey != nil {
+			key = *accountKey
+		}
+
+		transformer, err := newFpeTransformer(key, alphabet, tweak, passThroughShortValues)
+		if err != nil {
+			return nil, err
+		}
+
+		return func() (any, error) {
+			if value == nil {
+				return nil, nil
+			}
+			res, err := transformer.Encrypt(*value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to run transform_fpe: %w", err)
+			}
+			return res, nil
+		}, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Encrypts and decrypts values with FF3-1 using a key derived from the account-scoped transformer key
+type FpeTransformer struct {
+	cipher                 *fpe.Cipher
+	passThroughShortValues bool
+}
+
+func NewFpeTransformer(accountKey string, config *mgmtv1alpha1.TransformFpe) (*FpeTransformer, error) {
+	alphabet, err := GetFpeAlphabet(config)
+	if err != nil {
+		return nil, err
+	}
+	return newFpeTransformer(accountKey, alphabet, config.GetTweak(), config.GetPassThroughShortValues())
+}
+
+func newFpeTransformer(accountKey, alphabet, tweak string, passThroughShortValues bool) (*FpeTransformer, error) {
+	if accountKey == "" {
+		return nil, errors.New("format preserving encryption requires an account key, ensure the transformer secret has been configured")
+	}
+	mac := hmac.New(sha256.New, []byte(accountKey))
+	mac.Write([]byte(fpeKeyContext))
+	tweakSum := sha256.Sum256([]byte(tweak))
+
+	cipher, err := fpe.NewCipher(mac.Sum(nil), tweakSum[:fpe.TweakLength], alphabet)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize format preserving encryption: %w", err)
+	}
+	return &FpeTransformer{cipher: cipher, passThroughShortValues: passThroughShortValues}, nil
+}
+
+// Returns the characters that make up the alphabet of the config
+func GetFpeAlphabet(config *mgmtv1alpha1.TransformFpe) (string, error) {
+	switch config.GetAlphabet() {
+	case mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_NUMERIC:
+		return fpeNumericAlphabet, nil
+	case mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_ALPHANUMERIC, mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_UNSPECIFIED:
+		return fpeAlphanumericAlphabet, nil
+	case mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_CUSTOM:
+		if config.GetCustomAlphabet() == "" {
+			return "", errors.New("a custom alphabet must be provided when the alphabet is custom")
+		}
+		return config.GetCustomAlphabet(), nil
+	default:
+		return "", fmt.Errorf("unsupported format preserving encryption alphabet: %s", config.GetAlphabet())
+	}
+}
+
+func (t *FpeTransformer) Encrypt(value string) (string, error) {
+	return t.run(value, t.cipher.Encrypt)
+}
+
+func (t *FpeTransformer) Decrypt(value string) (string, error) {
+	return t.run(value, t.cipher.Decrypt)
+}
+
+// Runs the cipher over the characters that are part of the alphabet and leaves the remaining characters in place
+func (t *FpeTransformer) run(value string, fn func(string) (string, error)) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+	chars := []rune(value)
+	positions := []int{}
+	domain := make([]rune, 0, len(chars))
+	for idx, char := range chars {
+		if t.cipher.IsInAlphabet(char) {
+			positions = append(positions, idx)
+			domain = append(domain, char)
+		}
+	}
+
+	// FF3-1 can not encrypt values with too few characters from the alphabet. These are only passed through unchanged
+	// when the transformer opts in, and the same rule applies on decrypt so that these values round trip.
+	if minLen, _ := t.cipher.LengthBounds(); len(domain) < minLen {
+		if t.passThroughShortValues {
+			return value, nil
+		}
+		return "", fmt.Errorf("value has %d characters from the alphabet but format preserving encryption requires at least %d, enable pass through short values to leave these values unchanged", len(domain), minLen)
+	}
+
+	output, err := fn(string(domain))
+	if err != nil {
+		return "", err
+	}
+	for idx, char := range []rune(output) {
+		chars[positions[idx]] = char
+	}
+	return string(chars), nil
+}
@@ -0,0 +1,184 @@
+package transformers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+)
+
+const testFpeAccountKey = "account-key"
+
+func Test_FpeTransformer_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *mgmtv1alpha1.TransformFpe
+		value  string
+	}{
+		{"numeric", &mgmtv1alpha1.TransformFpe{Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_NUMERIC}, "4111111111111111"},
+		{"numeric_with_separators", &mgmtv1alpha1.TransformFpe{Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_NUMERIC}, "4111-1111-1111-1111"},
+		{"alphanumeric", &mgmtv1alpha1.TransformFpe{Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_ALPHANUMERIC}, "ACCT-00x91Zq"},
+		{"custom", &mgmtv1alpha1.TransformFpe{Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_CUSTOM, CustomAlphabet: shared.Ptr("ABCDEF0123456789")}, "DEADBEEF"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transformer, err := NewFpeTransformer(testFpeAccountKey, tt.config)
+			require.NoError(t, err)
+
+			encrypted, err := transformer.Encrypt(tt.value)
+			require.NoError(t, err)
+			require.NotEqual(t, tt.value, encrypted)
+			require.Len(t, encrypted, len(tt.value), "the output should be as long as the input")
+
+			alphabet, err := GetFpeAlphabet(tt.config)
+			require.NoError(t, err)
+			for idx, char := range encrypted {
+				original := rune(tt.value[idx])
+				if transformer.cipher.IsInAlphabet(original) {
+					require.Contains(t, alphabet, string(char))
+				} else {
+					require.Equal(t, original, char, "characters outside of the alphabet should be left in place")
+				}
+			}
+
+			again, err := transformer.Encrypt(tt.value)
+			require.NoError(t, err)
+			require.Equal(t, encrypted, again, "encryption should be deterministic")
+
+			decrypted, err := transformer.Decrypt(encrypted)
+			require.NoError(t, err)
+			require.Equal(t, tt.value, decrypted)
+		})
+	}
+}
+
+func Test_FpeTransformer_KeyAndTweak(t *testing.T) {
+	config := &mgmtv1alpha1.TransformFpe{Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_NUMERIC}
+	value := "1234567890"
+
+	transformer, err := NewFpeTransformer(testFpeAccountKey, config)
+	require.NoError(t, err)
+	encrypted, err := transformer.Encrypt(value)
+	require.NoError(t, err)
+
+	otherAccount, err := NewFpeTransformer("other-account-key", config)
+	require.NoError(t, err)
+	otherEncrypted, err := otherAccount.Encrypt(value)
+	require.NoError(t, err)
+	require.NotEqual(t, encrypted, otherEncrypted, "different accounts should produce different outputs")
+
+	tweaked, err := NewFpeTransformer(testFpeAccountKey, &mgmtv1alpha1.TransformFpe{Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_NUMERIC, Tweak: shared.Ptr("users.ssn")})
+	require.NoError(t, err)
+	tweakedEncrypted, err := tweaked.Encrypt(value)
+	require.NoError(t, err)
+	require.NotEqual(t, encrypted, tweakedEncrypted, "different tweaks should produce different outputs")
+}
+
+func Test_FpeTransformer_Errors(t *testing.T) {
+	_, err := NewFpeTransformer("", &mgmtv1alpha1.TransformFpe{})
+	require.Error(t, err, "an account key is required")
+
+	_, err = NewFpeTransformer(testFpeAccountKey, &mgmtv1alpha1.TransformFpe{Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_CUSTOM})
+	require.Error(t, err, "a custom alphabet is required")
+
+	transformer, err := NewFpeTransformer(testFpeAccountKey, &mgmtv1alpha1.TransformFpe{Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_NUMERIC})
+	require.NoError(t, err)
+	_, err = transformer.Encrypt(strings.Repeat("1", 100))
+	require.Error(t, err, "values with too many characters in the alphabet can not be encrypted")
+}
+
+func Test_FpeTransformer_ShortValues(t *testing.T) {
+	transformer, err := NewFpeTransformer(testFpeAccountKey, &mgmtv1alpha1.TransformFpe{Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_NUMERIC})
+	require.NoError(t, err)
+
+	for _, value := range []string{"---", "12-34", "12345", "a"} {
+		_, err := transformer.Encrypt(value)
+		require.Error(t, err, "values with too few characters in the alphabet fail by default")
+
+		_, err = transformer.Decrypt(value)
+		require.Error(t, err)
+	}
+
+	encrypted, err := transformer.Encrypt("123456")
+	require.NoError(t, err)
+	require.NotEqual(t, "123456", encrypted, "values at the minimum length are encrypted")
+}
+
+func Test_FpeTransformer_ShortValues_PassThrough(t *testing.T) {
+	transformer, err := NewFpeTransformer(testFpeAccountKey, &mgmtv1alpha1.TransformFpe{
+		Alphabet:               mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_NUMERIC,
+		PassThroughShortValues: true,
+	})
+	require.NoError(t, err)
+
+	for _, value := range []string{"---", "12-34", "12345", "a"} {
+		encrypted, err := transformer.Encrypt(value)
+		require.NoError(t, err)
+		require.Equal(t, value, encrypted, "values with too few characters in the alphabet are passed through unchanged when enabled")
+
+		decrypted, err := transformer.Decrypt(encrypted)
+		require.NoError(t, err)
+		require.Equal(t, value, decrypted)
+	}
+
+	encrypted, err := transformer.Encrypt("123456")
+	require.NoError(t, err)
+	require.NotEqual(t, "123456", encrypted, "values at the minimum length are encrypted")
+}
+
+func Test_TransformFpeTransformer(t *testing.T) {
+	value := "555-12-3456"
+	env := bloblang.NewEnvironment()
+	require.NoError(t, RegisterAccountKeyedFunctions(env, testFpeAccountKey))
+
+	mapping := fmt.Sprintf(`root = transform_fpe(value:%q,alphabet:%q)`, value, fpeNumericAlphabet)
+	ex, err := env.Parse(mapping)
+	require.NoError(t, err, "failed to parse the fpe transformer")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+
+	transformer, err := NewFpeTransformer(testFpeAccountKey, &mgmtv1alpha1.TransformFpe{Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_NUMERIC})
+	require.NoError(t, err)
+	decrypted, err := transformer.Decrypt(res.(string))
+	require.NoError(t, err)
+	require.Equal(t, value, decrypted)
+}
+
+func Test_TransformFpeTransformer_ShortValues(t *testing.T) {
+	env := bloblang.NewEnvironment()
+	require.NoError(t, RegisterAccountKeyedFunctions(env, testFpeAccountKey))
+
+	ex, err := env.Parse(fmt.Sprintf(`root = transform_fpe(value:"12-34",alphabet:%q)`, fpeNumericAlphabet))
+	require.NoError(t, err, "failed to parse the fpe transformer")
+	_, err = ex.Query(nil)
+	require.Error(t, err, "short values fail by default")
+
+	ex, err = env.Parse(fmt.Sprintf(`root = transform_fpe(value:"12-34",alphabet:%q,pass_through_short_values:true)`, fpeNumericAlphabet))
+	require.NoError(t, err, "failed to parse the fpe transformer")
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	require.Equal(t, "12-34", res)
+}
+
+func Test_TransformFpeTransformer_NilValue(t *testing.T) {
+	env := bloblang.NewEnvironment()
+	require.NoError(t, RegisterAccountKeyedFunctions(env, testFpeAccountKey))
+
+	ex, err := env.Parse(fmt.Sprintf(`root = transform_fpe(alphabet:%q)`, fpeNumericAlphabet))
+	require.NoError(t, err, "failed to parse the fpe transformer")
+
+	res, err := ex.Query(nil)
+	require.NoError(t, err)
+	require.Nil(t, res, "The response was not nil")
+}
+
+func Test_TransformFpeTransformer_NoAccountKey(t *testing.T) {
+	_, err := bloblang.Parse(fmt.Sprintf(`root = transform_fpe(value:"555-12-3456",alphabet:%q)`, fpeNumericAlphabet))
+	require.Error(t, err, "the global environment is never bound to an account key")
+}
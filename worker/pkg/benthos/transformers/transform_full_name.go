@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/utils"
 	"github.com/nucleuscloud/neosync/worker/pkg/rng"
 	"github.com/warpstreamlabs/bento/public/bloblang"
@@ -18,9 +19,11 @@ func init() {
 		Param(bloblang.NewInt64Param("max_length").Default(10000).Default("Specifies the maximum length for the transformed data. This field ensures that the output does not exceed a certain number of characters.")).
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewBoolParam("preserve_length").Default(false).Description("Whether the original length of the input data should be preserved during transformation. If set to true, the transformation logic will ensure that the output data has the same length as the input data.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used for generating deterministic transformations."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used for generating deterministic transformations.")).
+		Param(bloblang.NewBoolParam("deterministic").Optional().Description("Whether the randomizer should be derived from the input value using the account transformer key so that the same input always produces the same output.")).
+		Param(bloblang.NewStringParam("locale").Optional().Description("An optional locale of the dataset that values are generated from, such as de_DE. Defaults to en_US."))
 
-	err := bloblang.RegisterFunctionV2("transform_full_name", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+	err := registerAccountKeyedFunction("transform_full_name", spec, func(args *bloblang.ParsedParams, accountKey *string) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalString("value")
 		if err != nil {
 			return nil, err
@@ -46,15 +49,24 @@ func init() {
 			return nil, err
 		}
 
-		seed, err := transformer_utils.GetSeedOrDefault(seedArg)
+		seed, err := getTransformerSeed(args, seedArg, accountKey)
 		if err != nil {
 			return nil, err
 		}
 
 		randomizer := rng.New(seed)
 
+		locale, err := args.GetOptionalString("locale")
+		if err != nil {
+			return nil, err
+		}
+		dataset, err := getLocaleDataset(locale)
+		if err != nil {
+			return nil, err
+		}
+
 		return func() (any, error) {
-			res, err := transformFullName(randomizer, value, preserveLength, maxLength)
+			res, err := transformFullName(randomizer, dataset, value, preserveLength, maxLength)
 			if err != nil {
 				return nil, fmt.Errorf("unable to run transform_full_name: %w", err)
 			}
@@ -72,16 +84,20 @@ func (t *TransformFullName) Transform(value, opts any) (any, error) {
 	if !ok {
 		return nil, fmt.Errorf("invalid parsed opts: %T", opts)
 	}
+	dataset, err := getLocaleDataset(parsedOpts.locale)
+	if err != nil {
+		return nil, err
+	}
 
 	valueStr, ok := value.(string)
 	if !ok {
 		return nil, errors.New("value is not a string")
 	}
 
-	return transformFullName(parsedOpts.randomizer, valueStr, parsedOpts.preserveLength, parsedOpts.maxLength)
+	return transformFullName(parsedOpts.randomizer, dataset, valueStr, parsedOpts.preserveLength, parsedOpts.maxLength)
 }
 
-func transformFullName(randomizer rng.Rand, name string, preserveLength bool, maxLength int64) (*string, error) {
+func transformFullName(randomizer rng.Rand, dataset *transformers_dataset.LocaleDataset, name string, preserveLength bool, maxLength int64) (*string, error) {
 	if name == "" {
 		return nil, nil
 	}
@@ -91,28 +107,31 @@ func transformFullName(randomizer rng.Rand, name string, preserveLength bool, ma
 	if preserveLength {
 		firstname, lastname := splitEvenly(name)
 		minFirst := int64(len(firstname))
-		newfirstname, _ := generateRandomFirstName(randomizer, &minFirst, minFirst)
+		newfirstname, _ := generateRandomFirstName(randomizer, dataset, &minFirst, minFirst)
 		if newfirstname == "" {
-			newfirstname, _ = generateRandomFirstName(randomizer, nil, minFirst)
+			newfirstname, _ = generateRandomFirstName(randomizer, dataset, nil, minFirst)
 			if int64(len(newfirstname)) != minFirst {
 				newfirstname += transformer_utils.GetRandomCharacterString(randomizer, minFirst-int64(len(newfirstname)))
 			}
 		}
 		minLast := int64(len(lastname))
-		newlastname, _ := generateRandomLastName(randomizer, &minLast, minLast)
+		newlastname, _ := generateRandomLastName(randomizer, dataset, &minLast, minLast)
 		if newlastname == "" {
-			newfirstname, _ = generateRandomLastName(randomizer, nil, minLast)
+			newfirstname, _ = generateRandomLastName(randomizer, dataset, nil, minLast)
 			if int64(len(newlastname)) != minLast {
 				newlastname += transformer_utils.GetRandomCharacterString(randomizer, minFirst-int64(len(newlastname)))
 			}
 		}
 		if newfirstname != "" && newlastname != "" {
 			fullname := fmt.Sprintf("%s %s", newfirstname, newlastname)
+			if dataset.LastNameFirst {
+				fullname = fmt.Sprintf("%s %s", newlastname, newfirstname)
+			}
 			return &fullname, nil
 		}
 	}
 
-	output, err := generateRandomFullName(randomizer, maxValue)
+	output, err := generateRandomFullName(randomizer, dataset, maxValue)
 	if err != nil {
 		return nil, err
 	}
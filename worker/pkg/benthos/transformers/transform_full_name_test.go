@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"testing"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	"github.com/stretchr/testify/assert"
 	"github.com/warpstreamlabs/bento/public/bloblang"
 )
@@ -15,7 +16,7 @@ func Test_TranformFullNameEmptyName(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
 	emptyName := ""
 
-	res, err := transformFullName(randomizer, emptyName, true, maxCharacterLimit)
+	res, err := transformFullName(randomizer, transformers_dataset.EnUSDataset, emptyName, true, maxCharacterLimit)
 	assert.NoError(t, err)
 	assert.Nil(t, res, "The response should be nil")
 }
@@ -25,7 +26,7 @@ func Test_TransformFullNamePreserveLengthTrue(t *testing.T) {
 
 	nameLength := int64(len(fullName))
 
-	res, err := transformFullName(randomizer, fullName, true, maxCharacterLimit)
+	res, err := transformFullName(randomizer, transformers_dataset.EnUSDataset, fullName, true, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.Equal(t, nameLength, int64(len(*res)), "The first name output should be the same length as the input")
@@ -34,7 +35,7 @@ func Test_TransformFullNamePreserveLengthTrue(t *testing.T) {
 
 func Test_TransformFullNameMaxLengthBetween12And5(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
-	res, err := transformFullName(randomizer, fullName, false, 10)
+	res, err := transformFullName(randomizer, transformers_dataset.EnUSDataset, fullName, false, 10)
 
 	assert.NoError(t, err)
 	assert.True(t, len(*res) >= 6, "The name should be greater than the min length name")
@@ -17,9 +17,10 @@ func init() {
 		Param(bloblang.NewAnyParam("value").Optional()).
 		Param(bloblang.NewInt64Param("randomization_range_min").Description("Specifies the minimum value for the range of the int.")).
 		Param(bloblang.NewInt64Param("randomization_range_max").Description("Specifies the maximum value for the range of the int.")).
-		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs."))
+		Param(bloblang.NewInt64Param("seed").Optional().Description("An optional seed value used to generate deterministic outputs.")).
+		Param(bloblang.NewBoolParam("deterministic").Optional().Description("Whether the randomizer should be derived from the input value using the account transformer key so that the same input always produces the same output."))
 
-	err := bloblang.RegisterFunctionV2("transform_int64", spec, func(args *bloblang.ParsedParams) (bloblang.Function, error) {
+	err := registerAccountKeyedFunction("transform_int64", spec, func(args *bloblang.ParsedParams, accountKey *string) (bloblang.Function, error) {
 		valuePtr, err := args.GetOptionalInt64("value")
 		if err != nil {
 			return nil, err
@@ -40,7 +41,7 @@ func init() {
 			return nil, err
 		}
 
-		seed, err := transformer_utils.GetSeedOrDefault(seedArg)
+		seed, err := getTransformerSeed(args, seedArg, accountKey)
 		if err != nil {
 			return nil, err
 		}
@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"testing"
 
+	transformers_dataset "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/data-sets"
 	"github.com/stretchr/testify/assert"
 	"github.com/warpstreamlabs/bento/public/bloblang"
 )
@@ -14,7 +15,7 @@ func Test_TranformLastNameEmptyName(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
 	emptyName := ""
 
-	res, err := transformLastName(randomizer, emptyName, true, maxCharacterLimit)
+	res, err := transformLastName(randomizer, transformers_dataset.EnUSDataset, emptyName, true, maxCharacterLimit)
 	assert.NoError(t, err)
 	assert.Nil(t, res, "The response should be nil")
 }
@@ -23,7 +24,7 @@ func Test_TransformLastName_Preserve_True(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
 	nameLength := int64(len(name))
 
-	res, err := transformLastName(randomizer, name, true, maxCharacterLimit)
+	res, err := transformLastName(randomizer, transformers_dataset.EnUSDataset, name, true, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.Equal(t, nameLength, int64(len(*res)), "The last name output should be the same length as the input")
@@ -42,7 +43,7 @@ func Test_TransformLastName_Preserve_True_With_Padding(t *testing.T) {
 
 	name := buffer.String()
 
-	res, err := transformLastName(randomizer, name, true, 500)
+	res, err := transformLastName(randomizer, transformers_dataset.EnUSDataset, name, true, 500)
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(len(name)), int64(len(*res)), "The last name output should be the same length as the input")
@@ -52,7 +53,7 @@ func Test_TransformLastName_Preserve_True_With_Padding(t *testing.T) {
 func Test_TransformLastName_Preserve_False(t *testing.T) {
 	randomizer := rand.New(rand.NewSource(1))
 
-	res, err := transformLastName(randomizer, name, false, maxCharacterLimit)
+	res, err := transformLastName(randomizer, transformers_dataset.EnUSDataset, name, false, maxCharacterLimit)
 
 	assert.NoError(t, err)
 	assert.LessOrEqual(t, int64(len(*res)), maxCharacterLimit, "The last name output should be the same length as the input")
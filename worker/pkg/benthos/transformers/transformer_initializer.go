@@ -9,6 +9,7 @@ import (
 	presidioapi "github.com/nucleuscloud/neosync/internal/ee/presidio"
 	ee_transformer_fns "github.com/nucleuscloud/neosync/internal/ee/transformers/functions"
 	transformer_utils "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/utils"
+	"github.com/nucleuscloud/neosync/worker/pkg/rng"
 )
 
 type TransformerExecutor struct {
@@ -20,6 +21,7 @@ type TransformerExecutorOption func(c *TransformerExecutorConfig)
 
 type TransformerExecutorConfig struct {
 	transformPiiText *transformPiiTextConfig
+	deterministicKey string
 }
 
 type transformPiiTextConfig struct {
@@ -36,6 +38,14 @@ func WithTransformPiiTextConfig(analyze presidioapi.AnalyzeInterface, anonymize
 	}
 }
 
+// Sets the account-scoped key that is used to derive the randomizer from the input value for transformers configured as deterministic.
+// This key is also used to derive the encryption key for format preserving encryption.
+func WithDeterministicKey(key string) TransformerExecutorOption {
+	return func(c *TransformerExecutorConfig) {
+		c.deterministicKey = key
+	}
+}
+
 func InitializeTransformer(transformerMapping *mgmtv1alpha1.JobMappingTransformer, opts ...TransformerExecutorOption) (*TransformerExecutor, error) {
 	return InitializeTransformerByConfigType(transformerMapping.GetConfig(), opts...)
 }
@@ -46,6 +56,36 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 		opt(execCfg)
 	}
 
+	executor, err := initializeTransformerByConfigType(transformerConfig, execCfg)
+	if err != nil {
+		return nil, err
+	}
+	if !transformerConfig.GetDeterministic() {
+		return executor, nil
+	}
+	if _, ok := withRandomizer(executor.Opts, nil); !ok {
+		// deterministic mode only applies to transformers that operate on an existing input value
+		return executor, nil
+	}
+	if execCfg.deterministicKey == "" {
+		return nil, errors.New("transformer is configured as deterministic but no deterministic key was provided, ensure the transformer secret has been configured")
+	}
+	mutate := executor.Mutate
+	executor.Mutate = func(value, opts any) (any, error) {
+		seed, err := transformer_utils.GetDeterministicSeed(execCfg.deterministicKey, value)
+		if err != nil {
+			return nil, err
+		}
+		deterministicOpts, ok := withRandomizer(opts, rng.New(seed))
+		if !ok {
+			return nil, fmt.Errorf("invalid parsed opts for deterministic transformer: %T", opts)
+		}
+		return mutate(value, deterministicOpts)
+	}
+	return executor, nil
+}
+
+func initializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.TransformerConfig, execCfg *TransformerExecutorConfig) (*TransformerExecutor, error) {
 	maxLength := int64(10000) // TODO: update this based on colInfo if available
 	switch transformerConfig.GetConfig().(type) {
 	case *mgmtv1alpha1.TransformerConfig_PassthroughConfig:
@@ -113,7 +153,7 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 
 	case *mgmtv1alpha1.TransformerConfig_TransformFullNameConfig:
 		pl := transformerConfig.GetTransformFullNameConfig().GetPreserveLength()
-		opts, err := NewTransformFullNameOpts(&maxLength, &pl, nil)
+		opts, err := NewTransformFullNameOpts(&maxLength, &pl, nil, LocaleFromDto(transformerConfig.GetTransformFullNameConfig().GetLocale()))
 		if err != nil {
 			return nil, err
 		}
@@ -192,7 +232,7 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 		}, nil
 
 	case *mgmtv1alpha1.TransformerConfig_GenerateCityConfig:
-		opts, err := NewGenerateCityOpts(maxLength, nil)
+		opts, err := NewGenerateCityOpts(maxLength, nil, LocaleFromDto(transformerConfig.GetGenerateCityConfig().GetLocale()))
 		if err != nil {
 			return nil, err
 		}
@@ -207,7 +247,12 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 	case *mgmtv1alpha1.TransformerConfig_GenerateE164PhoneNumberConfig:
 		minValue := transformerConfig.GetGenerateE164PhoneNumberConfig().GetMin()
 		maxValue := transformerConfig.GetGenerateE164PhoneNumberConfig().GetMax()
-		opts, err := NewGenerateInternationalPhoneNumberOpts(minValue, maxValue, nil)
+		locale := LocaleFromDto(transformerConfig.GetGenerateE164PhoneNumberConfig().GetLocale())
+		err := ValidateInternationalPhoneNumberLocale(locale, minValue, maxValue)
+		if err != nil {
+			return nil, err
+		}
+		opts, err := NewGenerateInternationalPhoneNumberOpts(minValue, maxValue, nil, locale)
 		if err != nil {
 			return nil, err
 		}
@@ -219,7 +264,7 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 			},
 		}, nil
 	case *mgmtv1alpha1.TransformerConfig_GenerateFirstNameConfig:
-		opts, err := NewGenerateFirstNameOpts(&maxLength, nil)
+		opts, err := NewGenerateFirstNameOpts(&maxLength, nil, LocaleFromDto(transformerConfig.GetGenerateFirstNameConfig().GetLocale()))
 		if err != nil {
 			return nil, err
 		}
@@ -253,7 +298,7 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 		}, nil
 
 	case *mgmtv1alpha1.TransformerConfig_GenerateFullAddressConfig:
-		opts, err := NewGenerateFullAddressOpts(maxLength, nil)
+		opts, err := NewGenerateFullAddressOpts(maxLength, nil, LocaleFromDto(transformerConfig.GetGenerateFullAddressConfig().GetLocale()))
 		if err != nil {
 			return nil, err
 		}
@@ -266,7 +311,7 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 		}, nil
 
 	case *mgmtv1alpha1.TransformerConfig_GenerateFullNameConfig:
-		opts, err := NewGenerateFullNameOpts(&maxLength, nil)
+		opts, err := NewGenerateFullNameOpts(&maxLength, nil, LocaleFromDto(transformerConfig.GetGenerateFullNameConfig().GetLocale()))
 		if err != nil {
 			return nil, err
 		}
@@ -320,7 +365,7 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 		}, nil
 
 	case *mgmtv1alpha1.TransformerConfig_GenerateLastNameConfig:
-		opts, err := NewGenerateLastNameOpts(&maxLength, nil)
+		opts, err := NewGenerateLastNameOpts(&maxLength, nil, LocaleFromDto(transformerConfig.GetGenerateLastNameConfig().GetLocale()))
 		if err != nil {
 			return nil, err
 		}
@@ -360,7 +405,7 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 
 	case *mgmtv1alpha1.TransformerConfig_GenerateStateConfig:
 		generateFullName := transformerConfig.GetGenerateStateConfig().GetGenerateFullName()
-		opts, err := NewGenerateStateOpts(&generateFullName, nil)
+		opts, err := NewGenerateStateOpts(&generateFullName, nil, LocaleFromDto(transformerConfig.GetGenerateStateConfig().GetLocale()))
 		if err != nil {
 			return nil, err
 		}
@@ -373,7 +418,7 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 		}, nil
 
 	case *mgmtv1alpha1.TransformerConfig_GenerateStreetAddressConfig:
-		opts, err := NewGenerateStreetAddressOpts(maxLength, nil)
+		opts, err := NewGenerateStreetAddressOpts(maxLength, nil, LocaleFromDto(transformerConfig.GetGenerateStreetAddressConfig().GetLocale()))
 		if err != nil {
 			return nil, err
 		}
@@ -390,7 +435,12 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 		maxValue := transformerConfig.GetGenerateStringPhoneNumberConfig().GetMax()
 		minValue = transformer_utils.MinInt(minValue, maxLength)
 		maxValue = transformer_utils.Ceil(maxValue, maxLength)
-		opts, err := NewGenerateStringPhoneNumberOpts(minValue, maxValue, nil)
+		locale := LocaleFromDto(transformerConfig.GetGenerateStringPhoneNumberConfig().GetLocale())
+		err := ValidateStringPhoneNumberLocale(locale, minValue, maxValue)
+		if err != nil {
+			return nil, err
+		}
+		opts, err := NewGenerateStringPhoneNumberOpts(minValue, maxValue, nil, locale)
 		if err != nil {
 			return nil, err
 		}
@@ -470,7 +520,7 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 		}, nil
 
 	case *mgmtv1alpha1.TransformerConfig_GenerateZipcodeConfig:
-		opts, err := NewGenerateZipcodeOpts(nil)
+		opts, err := NewGenerateZipcodeOpts(nil, LocaleFromDto(transformerConfig.GetGenerateZipcodeConfig().GetLocale()))
 		if err != nil {
 			return nil, err
 		}
@@ -498,7 +548,7 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 
 	case *mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig:
 		config := transformerConfig.GetTransformFirstNameConfig()
-		opts, err := NewTransformFirstNameOpts(&maxLength, &config.PreserveLength, nil)
+		opts, err := NewTransformFirstNameOpts(&maxLength, &config.PreserveLength, nil, LocaleFromDto(config.GetLocale()))
 		if err != nil {
 			return nil, err
 		}
@@ -546,7 +596,7 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 
 	case *mgmtv1alpha1.TransformerConfig_TransformLastNameConfig:
 		config := transformerConfig.GetTransformLastNameConfig()
-		opts, err := NewTransformLastNameOpts(&maxLength, &config.PreserveLength, nil)
+		opts, err := NewTransformLastNameOpts(&maxLength, &config.PreserveLength, nil, LocaleFromDto(config.GetLocale()))
 		if err != nil {
 			return nil, err
 		}
@@ -637,6 +687,28 @@ func InitializeTransformerByConfigType(transformerConfig *mgmtv1alpha1.Transform
 			},
 		}, nil
 
+	case *mgmtv1alpha1.TransformerConfig_TransformFpeConfig:
+		transformer, err := NewFpeTransformer(execCfg.deterministicKey, transformerConfig.GetTransformFpeConfig())
+		if err != nil {
+			return nil, err
+		}
+		return &TransformerExecutor{
+			Opts: nil,
+			Mutate: func(value, opts any) (any, error) {
+				switch v := value.(type) {
+				case string:
+					return transformer.Encrypt(v)
+				case *string:
+					if v == nil {
+						return nil, nil
+					}
+					return transformer.Encrypt(*v)
+				default:
+					return nil, fmt.Errorf("transform_fpe: value is not string or *string, got %T", value)
+				}
+			},
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("unsupported transformer: %v", transformerConfig)
 	}
@@ -0,0 +1,57 @@
+package transformers
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_InitializeTransformerByConfigType_Deterministic(t *testing.T) {
+	config := &mgmtv1alpha1.TransformerConfig{
+		Config: &mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig{
+			TransformFirstNameConfig: &mgmtv1alpha1.TransformFirstName{},
+		},
+		Deterministic: true,
+	}
+
+	executor1, err := InitializeTransformerByConfigType(config, WithDeterministicKey("key"))
+	require.NoError(t, err)
+	executor2, err := InitializeTransformerByConfigType(config, WithDeterministicKey("key"))
+	require.NoError(t, err)
+
+	first, err := executor1.Mutate("john", executor1.Opts)
+	require.NoError(t, err)
+	second, err := executor1.Mutate("john", executor1.Opts)
+	require.NoError(t, err)
+	third, err := executor2.Mutate("john", executor2.Opts)
+	require.NoError(t, err)
+
+	require.Equal(t, first, second)
+	require.Equal(t, first, third)
+}
+
+func Test_InitializeTransformerByConfigType_Deterministic_MissingKey(t *testing.T) {
+	config := &mgmtv1alpha1.TransformerConfig{
+		Config: &mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig{
+			TransformFirstNameConfig: &mgmtv1alpha1.TransformFirstName{},
+		},
+		Deterministic: true,
+	}
+
+	_, err := InitializeTransformerByConfigType(config)
+	require.Error(t, err)
+}
+
+func Test_InitializeTransformerByConfigType_Deterministic_Generator(t *testing.T) {
+	config := &mgmtv1alpha1.TransformerConfig{
+		Config: &mgmtv1alpha1.TransformerConfig_GenerateBoolConfig{
+			GenerateBoolConfig: &mgmtv1alpha1.GenerateBool{},
+		},
+		Deterministic: true,
+	}
+
+	executor, err := InitializeTransformerByConfigType(config)
+	require.NoError(t, err)
+	require.NotNil(t, executor)
+}
@@ -0,0 +1,47 @@
+package transformer_utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Derives the account-scoped key used for deterministic transformers from the global transformer secret.
+// The same secret and account id will always result in the same key.
+func DeriveAccountTransformerKey(secret, accountId string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(accountId))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Returns a seed that is derived from an HMAC of the input value keyed by the provided key.
+// The same key and value will always result in the same seed.
+func GetDeterministicSeed(key string, value any) (int64, error) {
+	if key == "" {
+		return 0, errors.New("a non-empty key is required to generate a deterministic seed")
+	}
+
+	var input []byte
+	switch v := value.(type) {
+	case string:
+		input = []byte(v)
+	case []byte:
+		input = v
+	default:
+		bits, err := json.Marshal(v)
+		if err != nil {
+			return 0, fmt.Errorf("unable to marshal value for deterministic seed: %w", err)
+		}
+		input = bits
+	}
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(input)
+	sum := mac.Sum(nil)
+	// mask off the sign bit so that the seed is always positive
+	return int64(binary.BigEndian.Uint64(sum[:8]) & (1<<63 - 1)), nil //nolint:gosec
+}
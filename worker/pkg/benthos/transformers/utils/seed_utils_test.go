@@ -0,0 +1,47 @@
+package transformer_utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DeriveAccountTransformerKey(t *testing.T) {
+	key1 := DeriveAccountTransformerKey("secret", "account-1")
+	key2 := DeriveAccountTransformerKey("secret", "account-1")
+	key3 := DeriveAccountTransformerKey("secret", "account-2")
+
+	assert.Equal(t, key1, key2)
+	assert.NotEqual(t, key1, key3)
+	assert.NotEmpty(t, key1)
+}
+
+func Test_GetDeterministicSeed(t *testing.T) {
+	seed1, err := GetDeterministicSeed("key", "foo@example.com")
+	require.NoError(t, err)
+	seed2, err := GetDeterministicSeed("key", "foo@example.com")
+	require.NoError(t, err)
+	seed3, err := GetDeterministicSeed("key", "bar@example.com")
+	require.NoError(t, err)
+	seed4, err := GetDeterministicSeed("other-key", "foo@example.com")
+	require.NoError(t, err)
+
+	assert.Equal(t, seed1, seed2)
+	assert.NotEqual(t, seed1, seed3)
+	assert.NotEqual(t, seed1, seed4)
+	assert.GreaterOrEqual(t, seed1, int64(0))
+}
+
+func Test_GetDeterministicSeed_NonString(t *testing.T) {
+	seed1, err := GetDeterministicSeed("key", int64(123))
+	require.NoError(t, err)
+	seed2, err := GetDeterministicSeed("key", int64(123))
+	require.NoError(t, err)
+	assert.Equal(t, seed1, seed2)
+}
+
+func Test_GetDeterministicSeed_EmptyKey(t *testing.T) {
+	_, err := GetDeterministicSeed("", "foo")
+	require.Error(t, err)
+}
@@ -57,6 +57,7 @@ func IsForeignKeyViolationError(errMsg string) bool {
 		"violates foreign key constraint",
 		"a foreign key constraint fails",
 		"insert statement conflicted with the foreign key constraint",
+		"foreign key constraint failed",
 	}
 
 	for _, errStr := range foreignKeyViolationErrors {
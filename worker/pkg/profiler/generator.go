@@ -0,0 +1,279 @@
+package profiler
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const textAlphabet = "abcdefghijklmnopqrstuvwxyz"
+
+// Generates rows that follow the distributions and correlations of a table profile
+type Generator struct {
+	profile    *TableProfile
+	randomizer *rand.Rand
+
+	// the next value of each sequence column
+	sequences map[string]int64
+
+	numericPairs     []*numericPair
+	categoricalPairs []*CategoricalCorrelation
+}
+
+type numericPair struct {
+	columns [2]string
+	// the correlation of the normal distributions that the pair is sampled from
+	correlation float64
+}
+
+func NewGenerator(profile *TableProfile, seed int64) (*Generator, error) {
+	if profile == nil {
+		return nil, errors.New("a table profile is required to generate rows")
+	}
+	g := &Generator{
+		profile:    profile,
+		randomizer: rand.New(rand.NewSource(seed)), //nolint:gosec
+		sequences:  map[string]int64{},
+	}
+
+	// a column is only sampled together with the column that it is most correlated with
+	paired := map[string]bool{}
+	for _, correlation := range profile.CategoricalCorrelations {
+		if paired[correlation.Columns[0]] || paired[correlation.Columns[1]] || len(correlation.Frequencies) == 0 {
+			continue
+		}
+		paired[correlation.Columns[0]] = true
+		paired[correlation.Columns[1]] = true
+		g.categoricalPairs = append(g.categoricalPairs, correlation)
+	}
+	for _, correlation := range profile.NumericCorrelations {
+		if paired[correlation.Columns[0]] || paired[correlation.Columns[1]] {
+			continue
+		}
+		paired[correlation.Columns[0]] = true
+		paired[correlation.Columns[1]] = true
+		g.numericPairs = append(g.numericPairs, &numericPair{
+			columns: correlation.Columns,
+			// converts the rank correlation to the correlation of a gaussian copula
+			correlation: 2 * math.Sin(math.Pi*correlation.Spearman/6),
+		})
+	}
+
+	for _, col := range profile.Columns {
+		if col.Distribution == DistributionSequence && col.Min != nil {
+			g.sequences[col.Name] = int64(*col.Min)
+		}
+	}
+	return g, nil
+}
+
+// Generates the next row, keyed by column name
+func (g *Generator) Next() (map[string]any, error) {
+	row := map[string]any{}
+	isNull := map[string]bool{}
+	for _, col := range g.profile.Columns {
+		isNull[col.Name] = col.Distribution == DistributionUnsupported || col.Count == 0 || g.randomizer.Float64() < col.NullRatio
+		if isNull[col.Name] {
+			row[col.Name] = nil
+		}
+	}
+
+	for _, pair := range g.categoricalPairs {
+		if isNull[pair.Columns[0]] || isNull[pair.Columns[1]] {
+			continue
+		}
+		weights := make([]int64, len(pair.Frequencies))
+		for idx, freq := range pair.Frequencies {
+			weights[idx] = freq.Count
+		}
+		freq := pair.Frequencies[g.pickWeighted(weights)]
+		for idx, name := range pair.Columns {
+			value, err := g.decodeValue(name, freq.Values[idx])
+			if err != nil {
+				return nil, err
+			}
+			row[name] = value
+		}
+	}
+
+	for _, pair := range g.numericPairs {
+		if isNull[pair.columns[0]] || isNull[pair.columns[1]] {
+			continue
+		}
+		z1 := g.randomizer.NormFloat64()
+		z2 := pair.correlation*z1 + math.Sqrt(1-pair.correlation*pair.correlation)*g.randomizer.NormFloat64()
+		for idx, z := range []float64{z1, z2} {
+			col := g.profile.GetColumn(pair.columns[idx])
+			row[col.Name] = g.histogramValue(col, normalCdf(z))
+		}
+	}
+
+	for _, col := range g.profile.Columns {
+		if _, ok := row[col.Name]; ok {
+			continue
+		}
+		value, err := g.generateValue(col)
+		if err != nil {
+			return nil, err
+		}
+		row[col.Name] = value
+	}
+	return row, nil
+}
+
+func (g *Generator) generateValue(col *ColumnProfile) (any, error) {
+	switch col.Distribution {
+	case DistributionCategorical:
+		if len(col.TopValues) == 0 {
+			return nil, nil
+		}
+		return g.decodeValue(col.Name, col.TopValues[g.pickTopValue(col.TopValues)].Value)
+	case DistributionHistogram:
+		return g.histogramValue(col, g.randomizer.Float64()), nil
+	case DistributionSequence:
+		value := g.sequences[col.Name]
+		g.sequences[col.Name]++
+		return value, nil
+	case DistributionText:
+		return g.randomText(col), nil
+	case DistributionUuid:
+		var bits [16]byte
+		for idx := range bits {
+			bits[idx] = byte(g.randomizer.Intn(256))
+		}
+		// sets the version 4 and variant bits
+		bits[6] = (bits[6] & 0x0f) | 0x40
+		bits[8] = (bits[8] & 0x3f) | 0x80
+		return uuid.UUID(bits).String(), nil
+	case DistributionUnsupported:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unsupported distribution %q for column %s", col.Distribution, col.Name)
+}
+
+// Returns the value of the histogram at the given quantile, converted to the value type of the column
+func (g *Generator) histogramValue(col *ColumnProfile, quantile float64) any {
+	value := histogramQuantile(col.Histogram, quantile)
+	switch col.ValueType {
+	case ValueTypeInteger:
+		value = math.Round(value)
+		if col.Min != nil && col.Max != nil {
+			value = math.Min(math.Max(value, *col.Min), *col.Max)
+		}
+		return int64(value)
+	case ValueTypeTimestamp:
+		return fromUnixSeconds(value)
+	case ValueTypeDate:
+		return fromUnixSeconds(value).Truncate(24 * time.Hour)
+	default:
+		if col.Scale != nil {
+			pow := math.Pow10(*col.Scale)
+			value = math.Round(value*pow) / pow
+		}
+		return value
+	}
+}
+
+// Returns the value below which the given fraction of the values of the histogram fall.
+// Values are assumed to be spread evenly within a bucket.
+func histogramQuantile(histogram []*HistogramBucket, quantile float64) float64 {
+	var total int64
+	for _, bucket := range histogram {
+		total += bucket.Count
+	}
+	if total == 0 {
+		if len(histogram) == 0 {
+			return 0
+		}
+		return histogram[0].Lower
+	}
+	target := quantile * float64(total)
+	var cumulative float64
+	for _, bucket := range histogram {
+		if bucket.Count == 0 {
+			continue
+		}
+		next := cumulative + float64(bucket.Count)
+		if target <= next {
+			fraction := (target - cumulative) / float64(bucket.Count)
+			return bucket.Lower + (bucket.Upper-bucket.Lower)*fraction
+		}
+		cumulative = next
+	}
+	return histogram[len(histogram)-1].Upper
+}
+
+func (g *Generator) randomText(col *ColumnProfile) string {
+	var minLength, maxLength int64
+	if col.MinLength != nil {
+		minLength = *col.MinLength
+	}
+	if col.MaxLength != nil {
+		maxLength = *col.MaxLength
+	}
+	length := minLength
+	if maxLength > minLength {
+		length += g.randomizer.Int63n(maxLength - minLength + 1)
+	}
+	var sb strings.Builder
+	for i := int64(0); i < length; i++ {
+		sb.WriteByte(textAlphabet[g.randomizer.Intn(len(textAlphabet))])
+	}
+	return sb.String()
+}
+
+func (g *Generator) pickTopValue(values []*ValueFrequency) int {
+	weights := make([]int64, len(values))
+	for idx, value := range values {
+		weights[idx] = value.Count
+	}
+	return g.pickWeighted(weights)
+}
+
+// Returns a random index, where the probability of each index is proportional to its weight
+func (g *Generator) pickWeighted(weights []int64) int {
+	var total int64
+	for _, weight := range weights {
+		total += weight
+	}
+	if total <= 0 {
+		return g.randomizer.Intn(len(weights))
+	}
+	target := g.randomizer.Int63n(total)
+	for idx, weight := range weights {
+		if target < weight {
+			return idx
+		}
+		target -= weight
+	}
+	return len(weights) - 1
+}
+
+// Converts a value stored in the profile back to the value type of the column
+func (g *Generator) decodeValue(column string, value any) (any, error) {
+	col := g.profile.GetColumn(column)
+	if col == nil {
+		return nil, fmt.Errorf("column %s does not exist in the profile", column)
+	}
+	if value == nil {
+		return nil, nil
+	}
+	normalized, ok := normalizeValue(value, col.ValueType)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert the profiled value of column %s to %s", column, col.ValueType)
+	}
+	if col.ValueType == ValueTypeDate {
+		return normalized.(time.Time).Truncate(24 * time.Hour), nil
+	}
+	return normalized, nil
+}
+
+// The cumulative distribution function of the standard normal distribution
+func normalCdf(z float64) float64 {
+	return 0.5 * (1 + math.Erf(z/math.Sqrt2))
+}
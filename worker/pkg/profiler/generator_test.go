@@ -0,0 +1,149 @@
+package profiler
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewGenerator_NilProfile(t *testing.T) {
+	_, err := NewGenerator(nil, 1)
+	assert.Error(t, err)
+}
+
+func Test_Generator_Next(t *testing.T) {
+	profile := getStoredTestProfile(t)
+	generator, err := NewGenerator(profile, 1)
+	require.NoError(t, err)
+
+	statusCounts := map[any]int{}
+	nullEmails := 0
+	for i := 0; i < 1000; i++ {
+		row, err := generator.Next()
+		require.NoError(t, err)
+		require.Len(t, row, 10)
+
+		assert.Equal(t, int64(i+1), row["id"], "unique integers should be generated in order")
+
+		statusCounts[row["status"]]++
+
+		age, ok := row["age"].(int64)
+		require.True(t, ok)
+		assert.GreaterOrEqual(t, age, int64(20))
+		assert.LessOrEqual(t, age, int64(69))
+
+		income, ok := row["income"].(float64)
+		require.True(t, ok)
+		assert.Equal(t, math.Round(income*100)/100, income, "the income should be rounded to the scale of the column")
+
+		_, ok = row["created_at"].(time.Time)
+		assert.True(t, ok)
+		_, ok = row["active"].(bool)
+		assert.True(t, ok)
+
+		if row["email"] == nil {
+			nullEmails++
+		} else {
+			email, ok := row["email"].(string)
+			require.True(t, ok)
+			assert.GreaterOrEqual(t, len(email), 17)
+			assert.LessOrEqual(t, len(email), 19)
+		}
+
+		assert.Nil(t, row["data"], "unsupported columns should always be null")
+		assert.Equal(t, "happy", row["mood"])
+	}
+
+	assert.Len(t, statusCounts, 2)
+	assert.InDelta(t, 750, statusCounts["active"], 60)
+	assert.InDelta(t, 200, nullEmails, 60)
+}
+
+func Test_Generator_Next_Correlations(t *testing.T) {
+	profile := getStoredTestProfile(t)
+	generator, err := NewGenerator(profile, 1)
+	require.NoError(t, err)
+
+	ages, incomes := []float64{}, []float64{}
+	for i := 0; i < 500; i++ {
+		row, err := generator.Next()
+		require.NoError(t, err)
+
+		if row["status"] == "active" {
+			assert.Equal(t, "paid", row["plan"])
+		} else {
+			assert.Equal(t, "free", row["plan"])
+		}
+		ages = append(ages, float64(row["age"].(int64)))
+		incomes = append(incomes, row["income"].(float64))
+	}
+	assert.Greater(t, spearman(ages, incomes), 0.9)
+}
+
+func Test_Generator_Next_Deterministic(t *testing.T) {
+	profile := getStoredTestProfile(t)
+	first, err := NewGenerator(profile, 7)
+	require.NoError(t, err)
+	second, err := NewGenerator(profile, 7)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		left, err := first.Next()
+		require.NoError(t, err)
+		right, err := second.Next()
+		require.NoError(t, err)
+		assert.Equal(t, left, right)
+	}
+}
+
+func Test_Generator_Next_Uuid(t *testing.T) {
+	profile := BuildTableProfile("public", "users", map[string]*sqlmanager_shared.ColumnInfo{
+		"id":  {OrdinalPosition: 1, DataType: "uuid"},
+		"day": {OrdinalPosition: 2, DataType: "date"},
+	}, []map[string]any{
+		{"id": "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "day": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"id": "6ba7b811-9dad-11d1-80b4-00c04fd430c8", "day": time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}, testOpts)
+	generator, err := NewGenerator(profile, 1)
+	require.NoError(t, err)
+
+	row, err := generator.Next()
+	require.NoError(t, err)
+	id, err := uuid.Parse(row["id"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Version(4), id.Version())
+
+	day, ok := row["day"].(time.Time)
+	require.True(t, ok)
+	assert.Equal(t, day.Truncate(24*time.Hour), day)
+}
+
+func Test_histogramQuantile(t *testing.T) {
+	histogram := []*HistogramBucket{
+		{Lower: 0, Upper: 10, Count: 1},
+		{Lower: 10, Upper: 20, Count: 0},
+		{Lower: 20, Upper: 30, Count: 3},
+	}
+	assert.Equal(t, float64(0), histogramQuantile(histogram, 0))
+	assert.Equal(t, float64(10), histogramQuantile(histogram, 0.25))
+	assert.Equal(t, float64(25), histogramQuantile(histogram, 0.625))
+	assert.Equal(t, float64(30), histogramQuantile(histogram, 1))
+	assert.Equal(t, float64(0), histogramQuantile(nil, 0.5))
+}
+
+// Builds the test profile and round trips it through json, as it is when stored between job runs
+func getStoredTestProfile(t *testing.T) *TableProfile {
+	t.Helper()
+	profile := BuildTableProfile("public", "users", getTestColumnInfo(), getTestRows(100), testOpts)
+	bits, err := json.Marshal(profile)
+	require.NoError(t, err)
+	var stored *TableProfile
+	require.NoError(t, json.Unmarshal(bits, &stored))
+	return stored
+}
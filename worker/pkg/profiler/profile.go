@@ -0,0 +1,657 @@
+package profiler
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+)
+
+const (
+	DefaultSampleSize       int64 = 10000
+	DefaultHistogramBuckets       = 10
+	DefaultTopK                   = 20
+
+	// The k-anonymity threshold of a profile. Values that occur fewer times than this in the sample are never stored in a profile.
+	// Rare values can identify a row, so only values that are shared by at least this many rows are copied to the generated data.
+	minValueFrequency = 10
+	// The version of the contents of a profile. Profiles stored with an older version are rebuilt instead of reused.
+	profileVersion = 1
+	// Column pairs that are less associated than this are generated independently
+	minCorrelation = 0.3
+	// The minimum number of rows where both columns have a value that are needed to measure the correlation of a pair
+	minCorrelationSamples = 10
+)
+
+type ValueType string
+
+const (
+	ValueTypeInteger     ValueType = "integer"
+	ValueTypeFloat       ValueType = "float"
+	ValueTypeBoolean     ValueType = "boolean"
+	ValueTypeTimestamp   ValueType = "timestamp"
+	ValueTypeDate        ValueType = "date"
+	ValueTypeString      ValueType = "string"
+	ValueTypeUuid        ValueType = "uuid"
+	ValueTypeUnsupported ValueType = "unsupported"
+)
+
+// Describes how the values of a column are generated
+type Distribution string
+
+const (
+	// Values are sampled from the frequencies of the most common values
+	DistributionCategorical Distribution = "categorical"
+	// Values are sampled from the histogram of a numeric, timestamp or date column
+	DistributionHistogram Distribution = "histogram"
+	// Unique integers, such as identifiers, are generated in order starting at the minimum value
+	DistributionSequence Distribution = "sequence"
+	// Values are random text between the minimum and maximum length. No values of the column are stored.
+	DistributionText Distribution = "text"
+	// Random uuids are generated
+	DistributionUuid Distribution = "uuid"
+	// The column is not generated and is always null. Columns such as json or binary columns should be given a transformer instead.
+	DistributionUnsupported Distribution = "unsupported"
+)
+
+type Options struct {
+	// The maximum number of rows that are sampled from the table
+	SampleSize       int64 `json:"sampleSize"`
+	HistogramBuckets int   `json:"histogramBuckets"`
+	TopK             int   `json:"topK"`
+}
+
+// The statistical profile of a sample of the rows of a table.
+// A profile only contains aggregates of the sample and the values of low cardinality columns that are shared by many rows, never whole rows.
+type TableProfile struct {
+	Version int    `json:"version"`
+	Schema  string `json:"schema"`
+	Table   string `json:"table"`
+	// The number of rows that were sampled
+	SampleSize int64     `json:"sampleSize"`
+	Options    *Options  `json:"options"`
+	CreatedAt  time.Time `json:"createdAt"`
+
+	Columns                 []*ColumnProfile          `json:"columns"`
+	NumericCorrelations     []*NumericCorrelation     `json:"numericCorrelations,omitempty"`
+	CategoricalCorrelations []*CategoricalCorrelation `json:"categoricalCorrelations,omitempty"`
+}
+
+type ColumnProfile struct {
+	Name         string       `json:"name"`
+	ValueType    ValueType    `json:"valueType"`
+	Distribution Distribution `json:"distribution"`
+
+	// The number of non-null values in the sample
+	Count     int64   `json:"count"`
+	NullRatio float64 `json:"nullRatio"`
+	// The number of distinct non-null values in the sample
+	Cardinality int64 `json:"cardinality"`
+	// True if every non-null value in the sample is distinct
+	Unique bool `json:"unique"`
+
+	// The range of numeric columns. Timestamps and dates are stored as unix seconds.
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+	// The number of decimal digits that generated float values are rounded to
+	Scale     *int               `json:"scale,omitempty"`
+	Histogram []*HistogramBucket `json:"histogram,omitempty"`
+
+	// The most frequent values of categorical columns, ordered by descending count
+	TopValues []*ValueFrequency `json:"topValues,omitempty"`
+
+	// The range of the character lengths of string columns
+	MinLength *int64 `json:"minLength,omitempty"`
+	MaxLength *int64 `json:"maxLength,omitempty"`
+}
+
+type HistogramBucket struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count int64   `json:"count"`
+}
+
+type ValueFrequency struct {
+	Value any   `json:"value"`
+	Count int64 `json:"count"`
+}
+
+// The rank correlation of two histogram columns
+type NumericCorrelation struct {
+	Columns  [2]string `json:"columns"`
+	Spearman float64   `json:"spearman"`
+}
+
+// The association of two categorical columns along with the frequencies of their value combinations
+type CategoricalCorrelation struct {
+	Columns     [2]string         `json:"columns"`
+	CramersV    float64           `json:"cramersV"`
+	Frequencies []*JointFrequency `json:"frequencies"`
+}
+
+type JointFrequency struct {
+	Values [2]any `json:"values"`
+	Count  int64  `json:"count"`
+}
+
+func (p *TableProfile) GetColumn(name string) *ColumnProfile {
+	for _, col := range p.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// Returns true if the profile covers every given column and was built by this version with the same options
+func (p *TableProfile) IsCompatible(columns []string, opts *Options) bool {
+	if p.Version != profileVersion || p.Options == nil || opts == nil || *p.Options != *opts {
+		return false
+	}
+	for _, col := range columns {
+		if p.GetColumn(col) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Builds the profile of a table from a sample of its rows.
+// The columns that are profiled are the columns in the column info map, whose data types decide how the values are interpreted.
+func BuildTableProfile(
+	schema, table string,
+	columnInfo map[string]*sqlmanager_shared.ColumnInfo,
+	rows []map[string]any,
+	opts *Options,
+) *TableProfile {
+	columnNames := make([]string, 0, len(columnInfo))
+	for name := range columnInfo {
+		columnNames = append(columnNames, name)
+	}
+	sort.Slice(columnNames, func(i, j int) bool {
+		return columnInfo[columnNames[i]].OrdinalPosition < columnInfo[columnNames[j]].OrdinalPosition
+	})
+
+	profile := &TableProfile{
+		Version:    profileVersion,
+		Schema:     schema,
+		Table:      table,
+		SampleSize: int64(len(rows)),
+		Options:    opts,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	// the normalized values of each column, indexed by row. nil for null values
+	columnValues := map[string][]any{}
+	for _, name := range columnNames {
+		col, values := profileColumn(name, columnInfo[name], rows, opts)
+		profile.Columns = append(profile.Columns, col)
+		columnValues[name] = values
+	}
+
+	profile.NumericCorrelations = getNumericCorrelations(profile.Columns, columnValues)
+	profile.CategoricalCorrelations = getCategoricalCorrelations(profile.Columns, columnValues)
+	return profile
+}
+
+func profileColumn(
+	name string,
+	colInfo *sqlmanager_shared.ColumnInfo,
+	rows []map[string]any,
+	opts *Options,
+) (*ColumnProfile, []any) {
+	valueType, ok := getValueTypeFromDataType(colInfo.DataType)
+	if !ok {
+		valueType = getValueTypeFromValues(name, rows)
+	}
+	col := &ColumnProfile{
+		Name:      name,
+		ValueType: valueType,
+	}
+	if valueType == ValueTypeFloat && colInfo.NumericScale != nil && *colInfo.NumericScale > 0 {
+		col.Scale = colInfo.NumericScale
+	}
+
+	values := make([]any, len(rows))
+	var nulls int64
+	for idx, row := range rows {
+		value := row[name]
+		if value == nil {
+			nulls++
+			continue
+		}
+		if valueType == ValueTypeUnsupported {
+			continue
+		}
+		normalized, ok := normalizeValue(value, valueType)
+		if !ok {
+			// the values do not match the data type of the column so nothing is assumed about them
+			valueType = ValueTypeUnsupported
+			col.ValueType = valueType
+			continue
+		}
+		values[idx] = normalized
+	}
+	if len(rows) > 0 {
+		col.NullRatio = float64(nulls) / float64(len(rows))
+	}
+	if valueType == ValueTypeUnsupported {
+		col.Distribution = DistributionUnsupported
+		return col, make([]any, len(rows))
+	}
+	col.Count = int64(len(rows)) - nulls
+
+	frequencies := getValueFrequencies(values)
+	col.Cardinality = int64(len(frequencies))
+	col.Unique = col.Count > 1 && col.Cardinality == col.Count
+
+	if valueType == ValueTypeString {
+		minLength, maxLength := getLengthRange(values)
+		col.MinLength = minLength
+		col.MaxLength = maxLength
+	}
+
+	switch {
+	case valueType == ValueTypeUuid:
+		col.Distribution = DistributionUuid
+	case valueType == ValueTypeBoolean:
+		// a boolean can only be one of two values so it can not identify a row on its own
+		col.Distribution = DistributionCategorical
+		col.TopValues = getTopValues(frequencies, opts.TopK, 1)
+	case valueType == ValueTypeInteger && col.Unique:
+		col.Distribution = DistributionSequence
+		col.Min, col.Max = getRange(values)
+	case isLowCardinality(col, opts.TopK) && hasFrequentValues(frequencies):
+		col.Distribution = DistributionCategorical
+		col.TopValues = getTopValues(frequencies, opts.TopK, minValueFrequency)
+	case valueType == ValueTypeString:
+		col.Distribution = DistributionText
+	default:
+		col.Distribution = DistributionHistogram
+		col.Min, col.Max = getRange(values)
+		col.Histogram = buildHistogram(values, col.Min, col.Max, opts.HistogramBuckets)
+	}
+	return col, values
+}
+
+// Data types whose values are structured and can not be generated from their distributions
+var unsupportedDataTypes = []string{
+	"bytea", "image", "xml", "hstore", "tsvector", "tsquery", "inet", "cidr", "macaddr", "macaddr8",
+	"point", "line", "lseg", "box", "path", "polygon", "circle", "geometry", "geography",
+}
+
+// Maps the data type of a column to the type of its values. Returns false if the data type is not recognized.
+func getValueTypeFromDataType(dataType string) (ValueType, bool) {
+	dt := strings.ToLower(strings.TrimSpace(dataType))
+	if base, _, found := strings.Cut(dt, "("); found {
+		dt = strings.TrimSpace(base)
+	}
+	switch {
+	case dt == "":
+		return "", false
+	case strings.HasPrefix(dt, "_") || strings.HasSuffix(dt, "[]") || dt == "array":
+		return ValueTypeUnsupported, true
+	case strings.Contains(dt, "json") || strings.Contains(dt, "binary") || strings.Contains(dt, "blob") ||
+		slices.Contains(unsupportedDataTypes, dt):
+		return ValueTypeUnsupported, true
+	case strings.Contains(dt, "bool") || dt == "bit":
+		return ValueTypeBoolean, true
+	case dt == "uuid" || dt == "uniqueidentifier":
+		return ValueTypeUuid, true
+	case strings.Contains(dt, "char") || strings.Contains(dt, "text") || dt == "citext" || dt == "enum" || dt == "clob":
+		return ValueTypeString, true
+	case strings.HasPrefix(dt, "timestamp") || strings.Contains(dt, "datetime"):
+		return ValueTypeTimestamp, true
+	case dt == "date":
+		return ValueTypeDate, true
+	case strings.HasPrefix(dt, "time") || strings.HasPrefix(dt, "interval"):
+		return ValueTypeUnsupported, true
+	case strings.Contains(dt, "int") || strings.HasSuffix(dt, "serial"):
+		return ValueTypeInteger, true
+	case dt == "numeric" || dt == "decimal" || dt == "real" || dt == "float" || strings.HasPrefix(dt, "double") ||
+		dt == "money" || dt == "smallmoney":
+		return ValueTypeFloat, true
+	}
+	return "", false
+}
+
+// Infers the type of the values of a column whose data type is not recognized, such as a postgres enum
+func getValueTypeFromValues(column string, rows []map[string]any) ValueType {
+	for _, row := range rows {
+		switch row[column].(type) {
+		case nil:
+			continue
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return ValueTypeInteger
+		case float32, float64:
+			return ValueTypeFloat
+		case bool:
+			return ValueTypeBoolean
+		case time.Time:
+			return ValueTypeTimestamp
+		case string:
+			return ValueTypeString
+		default:
+			return ValueTypeUnsupported
+		}
+	}
+	return ValueTypeUnsupported
+}
+
+type valueFrequency struct {
+	value any
+	count int64
+}
+
+// Returns the frequency of each distinct non-null value, keyed by its formatted value
+func getValueFrequencies(values []any) map[string]*valueFrequency {
+	frequencies := map[string]*valueFrequency{}
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		key := formatKey(value)
+		if freq, ok := frequencies[key]; ok {
+			freq.count++
+		} else {
+			frequencies[key] = &valueFrequency{value: value, count: 1}
+		}
+	}
+	return frequencies
+}
+
+// Returns true if the column has few distinct values and each of them is shared by enough rows on average to be stored
+func isLowCardinality(col *ColumnProfile, topK int) bool {
+	return !col.Unique && col.Cardinality <= int64(topK) && col.Cardinality*minValueFrequency <= col.Count
+}
+
+func hasFrequentValues(frequencies map[string]*valueFrequency) bool {
+	for _, freq := range frequencies {
+		if freq.count >= minValueFrequency {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the k most frequent values that occur at least minFrequency times
+func getTopValues(frequencies map[string]*valueFrequency, k int, minFrequency int64) []*ValueFrequency {
+	keys := make([]string, 0, len(frequencies))
+	for key, freq := range frequencies {
+		if freq.count >= minFrequency {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ci, cj := frequencies[keys[i]].count, frequencies[keys[j]].count
+		if ci != cj {
+			return ci > cj
+		}
+		return keys[i] < keys[j]
+	})
+	if len(keys) > k {
+		keys = keys[:k]
+	}
+	output := make([]*ValueFrequency, 0, len(keys))
+	for _, key := range keys {
+		freq := frequencies[key]
+		output = append(output, &ValueFrequency{Value: toStoredValue(freq.value), Count: freq.count})
+	}
+	return output
+}
+
+func getLengthRange(values []any) (minLength, maxLength *int64) {
+	for _, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		length := int64(utf8.RuneCountInString(str))
+		if minLength == nil || length < *minLength {
+			minLength = &length
+		}
+		if maxLength == nil || length > *maxLength {
+			maxLength = &length
+		}
+	}
+	return minLength, maxLength
+}
+
+func getRange(values []any) (minValue, maxValue *float64) {
+	for _, value := range values {
+		num, ok := toFloat(value)
+		if !ok {
+			continue
+		}
+		if minValue == nil || num < *minValue {
+			minValue = &num
+		}
+		if maxValue == nil || num > *maxValue {
+			maxValue = &num
+		}
+	}
+	return minValue, maxValue
+}
+
+// Builds a histogram with buckets of equal width between the minimum and maximum values
+func buildHistogram(values []any, minValue, maxValue *float64, buckets int) []*HistogramBucket {
+	if minValue == nil || maxValue == nil {
+		return nil
+	}
+	if *minValue == *maxValue || buckets < 1 {
+		buckets = 1
+	}
+	width := (*maxValue - *minValue) / float64(buckets)
+	histogram := make([]*HistogramBucket, buckets)
+	for idx := range histogram {
+		histogram[idx] = &HistogramBucket{
+			Lower: *minValue + width*float64(idx),
+			Upper: *minValue + width*float64(idx+1),
+		}
+	}
+	histogram[buckets-1].Upper = *maxValue
+
+	for _, value := range values {
+		num, ok := toFloat(value)
+		if !ok {
+			continue
+		}
+		idx := buckets - 1
+		if width > 0 {
+			idx = min(int((num-*minValue)/width), buckets-1)
+		}
+		histogram[idx].Count++
+	}
+	return histogram
+}
+
+func getNumericCorrelations(columns []*ColumnProfile, columnValues map[string][]any) []*NumericCorrelation {
+	histogramColumns := []*ColumnProfile{}
+	for _, col := range columns {
+		if col.Distribution == DistributionHistogram {
+			histogramColumns = append(histogramColumns, col)
+		}
+	}
+
+	correlations := []*NumericCorrelation{}
+	for i := 0; i < len(histogramColumns); i++ {
+		for j := i + 1; j < len(histogramColumns); j++ {
+			left := columnValues[histogramColumns[i].Name]
+			right := columnValues[histogramColumns[j].Name]
+			xs, ys := []float64{}, []float64{}
+			for idx := range left {
+				x, xok := toFloat(left[idx])
+				y, yok := toFloat(right[idx])
+				if xok && yok {
+					xs = append(xs, x)
+					ys = append(ys, y)
+				}
+			}
+			if len(xs) < minCorrelationSamples {
+				continue
+			}
+			rho := spearman(xs, ys)
+			if math.IsNaN(rho) || math.Abs(rho) < minCorrelation {
+				continue
+			}
+			correlations = append(correlations, &NumericCorrelation{
+				Columns:  [2]string{histogramColumns[i].Name, histogramColumns[j].Name},
+				Spearman: rho,
+			})
+		}
+	}
+	sort.SliceStable(correlations, func(i, j int) bool {
+		return math.Abs(correlations[i].Spearman) > math.Abs(correlations[j].Spearman)
+	})
+	return correlations
+}
+
+func getCategoricalCorrelations(columns []*ColumnProfile, columnValues map[string][]any) []*CategoricalCorrelation {
+	categoricalColumns := []*ColumnProfile{}
+	for _, col := range columns {
+		if col.Distribution == DistributionCategorical && len(col.TopValues) > 1 {
+			categoricalColumns = append(categoricalColumns, col)
+		}
+	}
+
+	correlations := []*CategoricalCorrelation{}
+	for i := 0; i < len(categoricalColumns); i++ {
+		for j := i + 1; j < len(categoricalColumns); j++ {
+			correlation := getCategoricalCorrelation(
+				categoricalColumns[i].Name, categoricalColumns[j].Name,
+				columnValues[categoricalColumns[i].Name], columnValues[categoricalColumns[j].Name],
+			)
+			if correlation != nil {
+				correlations = append(correlations, correlation)
+			}
+		}
+	}
+	sort.SliceStable(correlations, func(i, j int) bool {
+		return correlations[i].CramersV > correlations[j].CramersV
+	})
+	return correlations
+}
+
+func getCategoricalCorrelation(leftName, rightName string, left, right []any) *CategoricalCorrelation {
+	type pairKey struct{ left, right string }
+	joint := map[pairKey]int64{}
+	jointValues := map[pairKey][2]any{}
+	leftCounts := map[string]int64{}
+	rightCounts := map[string]int64{}
+	var total int64
+	for idx := range left {
+		if left[idx] == nil || right[idx] == nil {
+			continue
+		}
+		key := pairKey{formatKey(left[idx]), formatKey(right[idx])}
+		joint[key]++
+		jointValues[key] = [2]any{left[idx], right[idx]}
+		leftCounts[key.left]++
+		rightCounts[key.right]++
+		total++
+	}
+	if total < minCorrelationSamples || len(leftCounts) < 2 || len(rightCounts) < 2 {
+		return nil
+	}
+
+	var chi2 float64
+	for l, lc := range leftCounts {
+		for r, rc := range rightCounts {
+			expected := float64(lc) * float64(rc) / float64(total)
+			diff := float64(joint[pairKey{l, r}]) - expected
+			chi2 += diff * diff / expected
+		}
+	}
+	cramersV := math.Sqrt(chi2 / (float64(total) * float64(min(len(leftCounts), len(rightCounts))-1)))
+	if math.IsNaN(cramersV) || cramersV < minCorrelation {
+		return nil
+	}
+
+	frequencies := []*JointFrequency{}
+	for key, count := range joint {
+		if count < minValueFrequency {
+			continue
+		}
+		values := jointValues[key]
+		frequencies = append(frequencies, &JointFrequency{
+			Values: [2]any{toStoredValue(values[0]), toStoredValue(values[1])},
+			Count:  count,
+		})
+	}
+	if len(frequencies) < 2 {
+		return nil
+	}
+	slices.SortFunc(frequencies, func(a, b *JointFrequency) int {
+		if a.Count != b.Count {
+			return int(b.Count - a.Count)
+		}
+		return strings.Compare(formatKey(a.Values[0])+formatKey(a.Values[1]), formatKey(b.Values[0])+formatKey(b.Values[1]))
+	})
+	return &CategoricalCorrelation{
+		Columns:     [2]string{leftName, rightName},
+		CramersV:    cramersV,
+		Frequencies: frequencies,
+	}
+}
+
+// The spearman rank correlation, which is the pearson correlation of the ranks of the values
+func spearman(xs, ys []float64) float64 {
+	return pearson(rank(xs), rank(ys))
+}
+
+// Ranks the values, giving tied values the average of their ranks
+func rank(values []float64) []float64 {
+	indices := make([]int, len(values))
+	for idx := range indices {
+		indices[idx] = idx
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return values[indices[i]] < values[indices[j]]
+	})
+	ranks := make([]float64, len(values))
+	for i := 0; i < len(indices); {
+		j := i
+		for j+1 < len(indices) && values[indices[j+1]] == values[indices[i]] {
+			j++
+		}
+		avg := float64(i+j)/2 + 1
+		for k := i; k <= j; k++ {
+			ranks[indices[k]] = avg
+		}
+		i = j + 1
+	}
+	return ranks
+}
+
+func pearson(xs, ys []float64) float64 {
+	n := float64(len(xs))
+	var sumX, sumY float64
+	for idx := range xs {
+		sumX += xs[idx]
+		sumY += ys[idx]
+	}
+	meanX, meanY := sumX/n, sumY/n
+	var cov, varX, varY float64
+	for idx := range xs {
+		dx, dy := xs[idx]-meanX, ys[idx]-meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+	if varX == 0 || varY == 0 {
+		return math.NaN()
+	}
+	return cov / math.Sqrt(varX*varY)
+}
+
+func formatKey(value any) string {
+	if t, ok := value.(time.Time); ok {
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+	return fmt.Sprintf("%v", value)
+}
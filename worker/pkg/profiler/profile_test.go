@@ -0,0 +1,250 @@
+package profiler
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testOpts = &Options{SampleSize: DefaultSampleSize, HistogramBuckets: DefaultHistogramBuckets, TopK: DefaultTopK}
+
+func getTestColumnInfo() map[string]*sqlmanager_shared.ColumnInfo {
+	scale := 2
+	return map[string]*sqlmanager_shared.ColumnInfo{
+		"id":         {OrdinalPosition: 1, DataType: "integer"},
+		"status":     {OrdinalPosition: 2, DataType: "character varying(20)"},
+		"plan":       {OrdinalPosition: 3, DataType: "text"},
+		"age":        {OrdinalPosition: 4, DataType: "smallint"},
+		"income":     {OrdinalPosition: 5, DataType: "numeric(10,2)", NumericScale: &scale},
+		"created_at": {OrdinalPosition: 6, DataType: "timestamp without time zone"},
+		"active":     {OrdinalPosition: 7, DataType: "boolean"},
+		"email":      {OrdinalPosition: 8, DataType: "text"},
+		"data":       {OrdinalPosition: 9, DataType: "jsonb"},
+		"mood":       {OrdinalPosition: 10, DataType: "mood"},
+	}
+}
+
+func getTestRows(n int) []map[string]any {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	statuses := []string{"active", "active", "active", "inactive"}
+	rows := make([]map[string]any, 0, n)
+	for i := 0; i < n; i++ {
+		status := statuses[i%len(statuses)]
+		plan := "free"
+		if status == "active" {
+			plan = "paid"
+		}
+		var email any
+		if i%5 != 0 {
+			email = fmt.Sprintf("user%d@example.com", i)
+		}
+		age := 20 + i%50
+		rows = append(rows, map[string]any{
+			"id":         int64(i + 1),
+			"status":     status,
+			"plan":       plan,
+			"age":        int64(age),
+			"income":     fmt.Sprintf("%d.50", age*1000),
+			"created_at": start.Add(time.Duration(i) * time.Hour),
+			"active":     i%2 == 0,
+			"email":      email,
+			"data":       map[string]any{"a": i},
+			"mood":       "happy",
+		})
+	}
+	return rows
+}
+
+func Test_BuildTableProfile(t *testing.T) {
+	profile := BuildTableProfile("public", "users", getTestColumnInfo(), getTestRows(100), testOpts)
+
+	assert.Equal(t, int64(100), profile.SampleSize)
+	require.Len(t, profile.Columns, 10)
+	assert.Equal(t, "id", profile.Columns[0].Name, "columns should be ordered by their position")
+
+	id := profile.GetColumn("id")
+	assert.Equal(t, ValueTypeInteger, id.ValueType)
+	assert.Equal(t, DistributionSequence, id.Distribution)
+	assert.True(t, id.Unique)
+	assert.Equal(t, float64(1), *id.Min)
+	assert.Equal(t, float64(100), *id.Max)
+
+	status := profile.GetColumn("status")
+	assert.Equal(t, DistributionCategorical, status.Distribution)
+	assert.Equal(t, int64(2), status.Cardinality)
+	require.Len(t, status.TopValues, 2)
+	assert.Equal(t, &ValueFrequency{Value: "active", Count: 75}, status.TopValues[0])
+	assert.Equal(t, &ValueFrequency{Value: "inactive", Count: 25}, status.TopValues[1])
+	assert.Equal(t, int64(6), *status.MinLength)
+	assert.Equal(t, int64(8), *status.MaxLength)
+
+	income := profile.GetColumn("income")
+	assert.Equal(t, ValueTypeFloat, income.ValueType)
+	assert.Equal(t, DistributionHistogram, income.Distribution)
+	assert.Equal(t, 20000.5, *income.Min)
+	assert.Equal(t, 69000.5, *income.Max)
+	assert.Equal(t, 2, *income.Scale)
+	require.Len(t, income.Histogram, DefaultHistogramBuckets)
+	var total int64
+	for _, bucket := range income.Histogram {
+		total += bucket.Count
+	}
+	assert.Equal(t, int64(100), total)
+
+	createdAt := profile.GetColumn("created_at")
+	assert.Equal(t, ValueTypeTimestamp, createdAt.ValueType)
+	assert.Equal(t, DistributionHistogram, createdAt.Distribution)
+
+	active := profile.GetColumn("active")
+	assert.Equal(t, ValueTypeBoolean, active.ValueType)
+	assert.Equal(t, DistributionCategorical, active.Distribution)
+
+	email := profile.GetColumn("email")
+	assert.Equal(t, DistributionText, email.Distribution)
+	assert.InDelta(t, 0.2, email.NullRatio, 0.0001)
+	assert.Equal(t, int64(80), email.Count)
+	assert.True(t, email.Unique)
+	assert.Empty(t, email.TopValues, "values that only occur once should never be stored")
+
+	assert.Equal(t, DistributionUnsupported, profile.GetColumn("data").Distribution)
+
+	mood := profile.GetColumn("mood")
+	assert.Equal(t, ValueTypeString, mood.ValueType, "unknown data types should be inferred from the values")
+	assert.Equal(t, DistributionCategorical, mood.Distribution)
+}
+
+func Test_BuildTableProfile_Correlations(t *testing.T) {
+	profile := BuildTableProfile("public", "users", getTestColumnInfo(), getTestRows(100), testOpts)
+
+	require.NotEmpty(t, profile.NumericCorrelations)
+	assert.Equal(t, [2]string{"age", "income"}, profile.NumericCorrelations[0].Columns)
+	assert.InDelta(t, 1, profile.NumericCorrelations[0].Spearman, 0.0001)
+
+	require.NotEmpty(t, profile.CategoricalCorrelations)
+	assert.Equal(t, [2]string{"status", "plan"}, profile.CategoricalCorrelations[0].Columns)
+	assert.InDelta(t, 1, profile.CategoricalCorrelations[0].CramersV, 0.0001)
+	assert.Len(t, profile.CategoricalCorrelations[0].Frequencies, 2)
+}
+
+func Test_BuildTableProfile_RareValues(t *testing.T) {
+	rows := make([]map[string]any, 0, 56)
+	for i := 0; i < 56; i++ {
+		rows = append(rows, map[string]any{
+			// each value is shared by at most 3 rows, which is below the k-anonymity threshold
+			"name": fmt.Sprintf("name%d", i%20),
+			// each value is shared by 12 rows, except for the last value that is only shared by 8 rows
+			"region": fmt.Sprintf("region%d", i/12),
+		})
+	}
+	profile := BuildTableProfile("public", "users", map[string]*sqlmanager_shared.ColumnInfo{
+		"name":   {OrdinalPosition: 1, DataType: "text"},
+		"region": {OrdinalPosition: 2, DataType: "text"},
+	}, rows, testOpts)
+
+	name := profile.GetColumn("name")
+	assert.Equal(t, DistributionText, name.Distribution)
+	assert.Empty(t, name.TopValues, "text columns should never store their values")
+
+	region := profile.GetColumn("region")
+	assert.Equal(t, DistributionCategorical, region.Distribution)
+	require.Len(t, region.TopValues, 4)
+	for _, value := range region.TopValues {
+		assert.GreaterOrEqual(t, value.Count, int64(minValueFrequency))
+	}
+}
+
+func Test_BuildTableProfile_MismatchedValues(t *testing.T) {
+	profile := BuildTableProfile("public", "users", map[string]*sqlmanager_shared.ColumnInfo{
+		"total": {DataType: "integer"},
+	}, []map[string]any{{"total": int64(1)}, {"total": "not a number"}}, testOpts)
+
+	assert.Equal(t, DistributionUnsupported, profile.GetColumn("total").Distribution)
+}
+
+func Test_BuildTableProfile_Empty(t *testing.T) {
+	profile := BuildTableProfile("public", "users", getTestColumnInfo(), nil, testOpts)
+
+	require.Len(t, profile.Columns, 10)
+	assert.Equal(t, int64(0), profile.GetColumn("status").Count)
+}
+
+func Test_TableProfile_IsCompatible(t *testing.T) {
+	profile := BuildTableProfile("public", "users", getTestColumnInfo(), getTestRows(10), testOpts)
+
+	assert.True(t, profile.IsCompatible([]string{"id", "status"}, &Options{SampleSize: DefaultSampleSize, HistogramBuckets: DefaultHistogramBuckets, TopK: DefaultTopK}))
+	assert.False(t, profile.IsCompatible([]string{"id", "missing"}, testOpts))
+	assert.False(t, profile.IsCompatible([]string{"id"}, &Options{SampleSize: DefaultSampleSize, HistogramBuckets: 5, TopK: DefaultTopK}))
+	assert.False(t, profile.IsCompatible([]string{"id"}, &Options{SampleSize: 100, HistogramBuckets: DefaultHistogramBuckets, TopK: DefaultTopK}))
+
+	profile.Version = 0
+	assert.False(t, profile.IsCompatible([]string{"id", "status"}, testOpts), "profiles built by an older version should be rebuilt")
+}
+
+func Test_TableProfile_Json(t *testing.T) {
+	profile := BuildTableProfile("public", "users", getTestColumnInfo(), getTestRows(100), testOpts)
+
+	bits, err := json.Marshal(profile)
+	require.NoError(t, err)
+	assert.NotContains(t, string(bits), "user1@example.com")
+
+	var decoded *TableProfile
+	require.NoError(t, json.Unmarshal(bits, &decoded))
+	assert.Len(t, decoded.Columns, 10)
+}
+
+func Test_getValueTypeFromDataType(t *testing.T) {
+	tests := map[string]ValueType{
+		"integer":                     ValueTypeInteger,
+		"bigint":                      ValueTypeInteger,
+		"tinyint(4)":                  ValueTypeInteger,
+		"bigserial":                   ValueTypeInteger,
+		"numeric(10,2)":               ValueTypeFloat,
+		"double precision":            ValueTypeFloat,
+		"boolean":                     ValueTypeBoolean,
+		"bit":                         ValueTypeBoolean,
+		"character varying(255)":      ValueTypeString,
+		"nvarchar":                    ValueTypeString,
+		"tinytext":                    ValueTypeString,
+		"timestamp with time zone":    ValueTypeTimestamp,
+		"datetime2":                   ValueTypeTimestamp,
+		"date":                        ValueTypeDate,
+		"uuid":                        ValueTypeUuid,
+		"uniqueidentifier":            ValueTypeUuid,
+		"jsonb":                       ValueTypeUnsupported,
+		"bytea":                       ValueTypeUnsupported,
+		"varbinary":                   ValueTypeUnsupported,
+		"_int4":                       ValueTypeUnsupported,
+		"time without time zone":      ValueTypeUnsupported,
+		"point":                       ValueTypeUnsupported,
+		"interval":                    ValueTypeUnsupported,
+		"integer[]":                   ValueTypeUnsupported,
+		"timestamp(6) with time zone": ValueTypeTimestamp,
+	}
+	for dataType, expected := range tests {
+		t.Run(dataType, func(t *testing.T) {
+			actual, ok := getValueTypeFromDataType(dataType)
+			require.True(t, ok)
+			assert.Equal(t, expected, actual)
+		})
+	}
+
+	_, ok := getValueTypeFromDataType("mood")
+	assert.False(t, ok)
+}
+
+func Test_spearman(t *testing.T) {
+	xs := []float64{1, 2, 3, 4, 5}
+	assert.InDelta(t, 1, spearman(xs, []float64{1, 4, 9, 16, 25}), 0.0001)
+	assert.InDelta(t, -1, spearman(xs, []float64{5, 4, 3, 2, 1}), 0.0001)
+	assert.True(t, math.IsNaN(spearman(xs, []float64{1, 1, 1, 1, 1})))
+}
+
+func Test_rank(t *testing.T) {
+	assert.Equal(t, []float64{1, 2.5, 2.5, 4}, rank([]float64{1, 5, 5, 9}))
+}
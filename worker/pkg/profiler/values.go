@@ -0,0 +1,159 @@
+package profiler
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	time.DateOnly,
+}
+
+// Converts a value read from a database, or decoded from a stored profile, to the go type of its value type.
+// Integers are returned as int64, floats as float64, timestamps and dates as time.Time and uuids as strings.
+func normalizeValue(value any, valueType ValueType) (any, bool) {
+	if bits, ok := value.([]byte); ok && valueType != ValueTypeUuid {
+		value = string(bits)
+	}
+	switch valueType {
+	case ValueTypeInteger:
+		switch v := value.(type) {
+		case string:
+			i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			return i, err == nil
+		case float32, float64:
+			f, _ := toFloat(v)
+			if f != math.Trunc(f) {
+				return nil, false
+			}
+			return int64(f), true
+		case bool:
+			if v {
+				return int64(1), true
+			}
+			return int64(0), true
+		default:
+			f, ok := toFloat(v)
+			if !ok {
+				return nil, false
+			}
+			return int64(f), true
+		}
+	case ValueTypeFloat:
+		if str, ok := value.(string); ok {
+			f, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+			return f, err == nil
+		}
+		return toFloat(value)
+	case ValueTypeBoolean:
+		switch v := value.(type) {
+		case bool:
+			return v, true
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(v))
+			return b, err == nil
+		default:
+			f, ok := toFloat(v)
+			if !ok {
+				return nil, false
+			}
+			return f != 0, true
+		}
+	case ValueTypeTimestamp, ValueTypeDate:
+		switch v := value.(type) {
+		case time.Time:
+			return v.UTC(), true
+		case string:
+			for _, layout := range timeLayouts {
+				if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+					return t.UTC(), true
+				}
+			}
+			return nil, false
+		default:
+			return nil, false
+		}
+	case ValueTypeString:
+		switch v := value.(type) {
+		case string:
+			return v, true
+		case time.Time:
+			return nil, false
+		default:
+			return fmt.Sprintf("%v", v), true
+		}
+	case ValueTypeUuid:
+		switch v := value.(type) {
+		case string:
+			_, err := uuid.Parse(v)
+			return v, err == nil
+		case []byte:
+			u, err := uuid.FromBytes(v)
+			if err != nil {
+				u, err = uuid.ParseBytes(v)
+			}
+			return u.String(), err == nil
+		case [16]byte:
+			return uuid.UUID(v).String(), true
+		default:
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// Converts numeric values to float64. Timestamps are converted to unix seconds.
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case time.Time:
+		return float64(v.UnixNano()) / float64(time.Second), true
+	}
+	return 0, false
+}
+
+// Returns the representation of a value that is stored in the profile. Timestamps are stored as RFC3339 strings.
+func toStoredValue(value any) any {
+	if t, ok := value.(time.Time); ok {
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+	return value
+}
+
+func fromUnixSeconds(seconds float64) time.Time {
+	sec, frac := math.Modf(seconds)
+	return time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC()
+}
@@ -2,8 +2,10 @@ package querybuilder
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/lib/pq"
@@ -13,14 +15,36 @@ import (
 	// import the dialect
 	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/doug-martin/goqu/v9/dialect/sqlite3"
 	_ "github.com/doug-martin/goqu/v9/dialect/sqlserver"
 	"github.com/doug-martin/goqu/v9/exp"
 	gotypeutil "github.com/nucleuscloud/neosync/internal/gotypeutil"
 	mysqlutil "github.com/nucleuscloud/neosync/internal/mysql"
 	pgutil "github.com/nucleuscloud/neosync/internal/postgres"
+	sqliteutil "github.com/nucleuscloud/neosync/internal/sqlite"
 )
 
-const defaultStr = "DEFAULT"
+const (
+	defaultStr = "DEFAULT"
+
+	sqliteGoquDialect = "neosync_sqlite3"
+)
+
+func init() {
+	// goqu renders INSERT OR IGNORE for sqlite whenever a conflict clause is present, which also ignores not null and check constraint failures.
+	// Registering the dialect without it keeps conflicts scoped to unique constraints the same as postgres.
+	opts := sqlite3.DialectOptions()
+	opts.SupportsInsertIgnoreSyntax = false
+	goqu.RegisterDialect(sqliteGoquDialect, opts)
+}
+
+// Configures an insert to update rows that conflict with an existing row instead of failing
+type OnConflictUpdate struct {
+	// The primary key or unique columns that identify a conflicting row
+	ConflictColumns []string
+	// The columns that are updated on conflict. When empty, every inserted column that is not a conflict column is updated
+	UpdateColumns []string
+}
 
 type SubsetReferenceKey struct {
 	Table         string
@@ -37,9 +61,20 @@ func getGoquDialect(driver string) goqu.DialectWrapper {
 	if driver == sqlmanager_shared.PostgresDriver {
 		return goqu.Dialect("postgres")
 	}
+	if driver == sqlmanager_shared.SqliteDriver {
+		return goqu.Dialect(sqliteGoquDialect)
+	}
 	return goqu.Dialect(driver)
 }
 
+// sqlite destinations have no schemas, so every table is written to the main schema
+func getGoquTable(driver, schema, table string) exp.IdentifierExpression {
+	if driver == sqlmanager_shared.SqliteDriver {
+		return goqu.T(table)
+	}
+	return goqu.S(schema).Table(table)
+}
+
 func BuildSelectQuery(
 	driver, table string,
 	columns []string,
@@ -89,6 +124,9 @@ func getGoquVals(logger *slog.Logger, driver string, row []any, columnDataTypes
 	if driver == sqlmanager_shared.MysqlDriver {
 		return getMysqlGoquVals(logger, row, columnDataTypes, columnDefaultProperties)
 	}
+	if driver == sqlmanager_shared.SqliteDriver {
+		return getSqliteGoquVals(logger, row, columnDataTypes)
+	}
 	gval := goqu.Vals{}
 	for idx, a := range row {
 		var colDefaults *neosync_benthos.ColumnDefaultProperties
@@ -140,6 +178,53 @@ func getMysqlGoquVals(logger *slog.Logger, row []any, columnDataTypes []string,
 	return gval
 }
 
+// SQLite has no json or array types, so maps and slices are stored as json text
+func getSqliteGoquVals(logger *slog.Logger, row []any, columnDataTypes []string) goqu.Vals {
+	gval := goqu.Vals{}
+	for idx, a := range row {
+		var colDataType string
+		if idx < len(columnDataTypes) {
+			colDataType = columnDataTypes[idx]
+		}
+		if bits, ok := a.([]byte); ok {
+			// sqlite stores []byte args as blobs regardless of the column affinity, so anything that isn't a binary column is written as text
+			if isBinaryDataType(colDataType) {
+				gval = append(gval, bits)
+			} else {
+				gval = append(gval, string(bits))
+			}
+			continue
+		}
+		if sqliteutil.IsJsonDataType(colDataType) || gotypeutil.IsMap(a) || gotypeutil.IsSlice(a) {
+			if a == nil {
+				gval = append(gval, a)
+				continue
+			}
+			bits, err := json.Marshal(a)
+			if err != nil {
+				logger.Error("unable to marshal JSON", "error", err.Error())
+				gval = append(gval, a)
+				continue
+			}
+			gval = append(gval, string(bits))
+		} else {
+			gval = append(gval, a)
+		}
+	}
+	return gval
+}
+
+// column data types may come from any source driver
+func isBinaryDataType(colDataType string) bool {
+	base, _, _ := strings.Cut(strings.ToLower(colDataType), "(")
+	switch strings.TrimSpace(base) {
+	case "bytea", "binary", "varbinary", "image", "blob", "tinyblob", "mediumblob", "longblob":
+		return true
+	default:
+		return false
+	}
+}
+
 func getPgGoquVals(logger *slog.Logger, row []any, columnDataTypes []string, columnDefaultProperties []*neosync_benthos.ColumnDefaultProperties) goqu.Vals {
 	gval := goqu.Vals{}
 	for i, a := range row {
@@ -185,10 +270,15 @@ func BuildInsertQuery(
 	columnDataTypes []string,
 	values [][]any,
 	onConflictDoNothing *bool,
+	onConflictUpdate *OnConflictUpdate,
 	columnDefaultProperties []*neosync_benthos.ColumnDefaultProperties,
 ) (sql string, args []any, err error) {
+	if onConflictUpdate != nil && driver == sqlmanager_shared.MssqlDriver {
+		return buildMssqlMergeQuery(logger, schema, table, columns, columnDataTypes, values, onConflictUpdate.ConflictColumns, onConflictUpdate, columnDefaultProperties)
+	}
+
 	builder := getGoquDialect(driver)
-	sqltable := goqu.S(schema).Table(table)
+	sqltable := getGoquTable(driver, schema, table)
 	insertCols := make([]any, len(columns))
 	for i, col := range columns {
 		insertCols[i] = col
@@ -198,8 +288,25 @@ func BuildInsertQuery(
 		gval := getGoquVals(logger, driver, row, columnDataTypes, columnDefaultProperties)
 		insert = insert.Vals(gval)
 	}
-	// adds on conflict do nothing to insert query
-	if *onConflictDoNothing {
+	var suffix string
+	if onConflictUpdate != nil {
+		switch driver {
+		case sqlmanager_shared.PostgresDriver, sqlmanager_shared.SqliteDriver:
+			conflictExp, err := getPgOnConflictUpdateExpression(columns, columnDefaultProperties, onConflictUpdate)
+			if err != nil {
+				return "", nil, err
+			}
+			insert = insert.OnConflict(conflictExp)
+		case sqlmanager_shared.MysqlDriver:
+			suffix = getMysqlOnDuplicateKeyUpdateClause(columns, columnDefaultProperties, onConflictUpdate)
+			if suffix == "" {
+				insert = insert.OnConflict(goqu.DoNothing())
+			}
+		default:
+			return "", nil, fmt.Errorf("on conflict update is not currently supported for driver: %s", driver)
+		}
+	} else if onConflictDoNothing != nil && *onConflictDoNothing {
+		// adds on conflict do nothing to insert query
 		insert = insert.OnConflict(goqu.DoNothing())
 	}
 
@@ -207,7 +314,182 @@ func BuildInsertQuery(
 	if err != nil {
 		return "", nil, err
 	}
-	return query, args, nil
+	return query + suffix, args, nil
+}
+
+// Returns the inserted columns that should be updated on conflict.
+// Conflict columns, columns that use the database default, and columns whose value must be overridden such as identity columns are never updated.
+func getOnConflictUpdateColumns(
+	columns []string,
+	columnDefaultProperties []*neosync_benthos.ColumnDefaultProperties,
+	onConflictUpdate *OnConflictUpdate,
+) []string {
+	excluded := map[string]struct{}{}
+	for _, col := range onConflictUpdate.ConflictColumns {
+		excluded[col] = struct{}{}
+	}
+	for idx, col := range columns {
+		if idx < len(columnDefaultProperties) && columnDefaultProperties[idx] != nil &&
+			(columnDefaultProperties[idx].HasDefaultTransformer || columnDefaultProperties[idx].NeedsOverride) {
+			excluded[col] = struct{}{}
+		}
+	}
+	var allowed map[string]struct{}
+	if len(onConflictUpdate.UpdateColumns) > 0 {
+		allowed = map[string]struct{}{}
+		for _, col := range onConflictUpdate.UpdateColumns {
+			allowed[col] = struct{}{}
+		}
+	}
+
+	updateCols := []string{}
+	for _, col := range columns {
+		if _, ok := excluded[col]; ok {
+			continue
+		}
+		if allowed != nil {
+			if _, ok := allowed[col]; !ok {
+				continue
+			}
+		}
+		updateCols = append(updateCols, col)
+	}
+	return updateCols
+}
+
+func getPgOnConflictUpdateExpression(
+	columns []string,
+	columnDefaultProperties []*neosync_benthos.ColumnDefaultProperties,
+	onConflictUpdate *OnConflictUpdate,
+) (exp.ConflictExpression, error) {
+	if len(onConflictUpdate.ConflictColumns) == 0 {
+		return nil, errors.New("on conflict update requires at least one conflict column")
+	}
+	updateCols := getOnConflictUpdateColumns(columns, columnDefaultProperties, onConflictUpdate)
+	if len(updateCols) == 0 {
+		// every column identifies the row so there is nothing left to update
+		return goqu.DoNothing(), nil
+	}
+	updateRecord := goqu.Record{}
+	for _, col := range updateCols {
+		updateRecord[col] = goqu.T("excluded").Col(col)
+	}
+	quotedConflictCols := make([]string, len(onConflictUpdate.ConflictColumns))
+	for i, col := range onConflictUpdate.ConflictColumns {
+		quotedConflictCols[i] = pq.QuoteIdentifier(col)
+	}
+	return goqu.DoUpdate(strings.Join(quotedConflictCols, ", "), updateRecord), nil
+}
+
+// goqu always renders INSERT IGNORE for mysql when a conflict expression is present,
+// which would silently swallow unrelated errors, so the clause is built by hand
+func getMysqlOnDuplicateKeyUpdateClause(
+	columns []string,
+	columnDefaultProperties []*neosync_benthos.ColumnDefaultProperties,
+	onConflictUpdate *OnConflictUpdate,
+) string {
+	updateCols := getOnConflictUpdateColumns(columns, columnDefaultProperties, onConflictUpdate)
+	if len(updateCols) == 0 {
+		return ""
+	}
+	assignments := make([]string, len(updateCols))
+	for i, col := range updateCols {
+		quotedCol := fmt.Sprintf("`%s`", strings.ReplaceAll(col, "`", "``"))
+		assignments[i] = fmt.Sprintf("%s=VALUES(%s)", quotedCol, quotedCol)
+	}
+	return fmt.Sprintf(" ON DUPLICATE KEY UPDATE %s", strings.Join(assignments, ","))
+}
+
+// SQL Server has no insert conflict clause, so rows that conflict with an existing row are skipped
+// by merging the inserted rows into the table on the conflict columns without updating the matched rows.
+func BuildMssqlInsertIgnoreQuery(
+	logger *slog.Logger,
+	schema, table string,
+	columns []string,
+	columnDataTypes []string,
+	values [][]any,
+	conflictColumns []string,
+	columnDefaultProperties []*neosync_benthos.ColumnDefaultProperties,
+) (sql string, args []any, err error) {
+	return buildMssqlMergeQuery(logger, schema, table, columns, columnDataTypes, values, conflictColumns, nil, columnDefaultProperties)
+}
+
+// SQL Server has no insert conflict clause so upserts are written as a MERGE of the inserted rows into the table.
+// Matched rows are left untouched when onConflictUpdate is nil.
+// Columns that use the database default are left out of the merge so that the default is applied to new rows.
+func buildMssqlMergeQuery(
+	logger *slog.Logger,
+	schema, table string,
+	columns []string,
+	columnDataTypes []string,
+	values [][]any,
+	conflictColumns []string,
+	onConflictUpdate *OnConflictUpdate,
+	columnDefaultProperties []*neosync_benthos.ColumnDefaultProperties,
+) (sql string, args []any, err error) {
+	if len(conflictColumns) == 0 {
+		return "", nil, errors.New("merging rows into a sqlserver table requires at least one conflict column")
+	}
+
+	mergeColIdxs := []int{}
+	for idx := range columns {
+		if idx < len(columnDefaultProperties) && columnDefaultProperties[idx] != nil && columnDefaultProperties[idx].HasDefaultTransformer {
+			continue
+		}
+		mergeColIdxs = append(mergeColIdxs, idx)
+	}
+	mergeCols := make([]string, len(mergeColIdxs))
+	mergeColDefaults := make([]*neosync_benthos.ColumnDefaultProperties, len(mergeColIdxs))
+	quotedCols := make([]string, len(mergeColIdxs))
+	sourceCols := make([]string, len(mergeColIdxs))
+	for i, idx := range mergeColIdxs {
+		mergeCols[i] = columns[idx]
+		if idx < len(columnDefaultProperties) {
+			mergeColDefaults[i] = columnDefaultProperties[idx]
+		}
+		quotedCols[i] = quoteMssqlIdentifier(columns[idx])
+		sourceCols[i] = fmt.Sprintf("%s.%s", quoteMssqlIdentifier("source"), quotedCols[i])
+	}
+
+	args = []any{}
+	valueRows := make([]string, len(values))
+	for rowIdx, row := range values {
+		gval := getGoquVals(logger, sqlmanager_shared.MssqlDriver, row, columnDataTypes, columnDefaultProperties)
+		placeholders := make([]string, len(mergeColIdxs))
+		for i, idx := range mergeColIdxs {
+			args = append(args, gval[idx])
+			placeholders[i] = fmt.Sprintf("@p%d", len(args))
+		}
+		valueRows[rowIdx] = fmt.Sprintf("(%s)", strings.Join(placeholders, ", "))
+	}
+
+	onConditions := make([]string, len(conflictColumns))
+	for i, col := range conflictColumns {
+		onConditions[i] = fmt.Sprintf("%s.%s = %s.%s", quoteMssqlIdentifier("target"), quoteMssqlIdentifier(col), quoteMssqlIdentifier("source"), quoteMssqlIdentifier(col))
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "MERGE INTO %s.%s WITH (HOLDLOCK) AS %s", quoteMssqlIdentifier(schema), quoteMssqlIdentifier(table), quoteMssqlIdentifier("target"))
+	fmt.Fprintf(&builder, " USING (VALUES %s) AS %s (%s)", strings.Join(valueRows, ", "), quoteMssqlIdentifier("source"), strings.Join(quotedCols, ", "))
+	fmt.Fprintf(&builder, " ON (%s)", strings.Join(onConditions, " AND "))
+
+	updateCols := []string{}
+	if onConflictUpdate != nil {
+		updateCols = getOnConflictUpdateColumns(mergeCols, mergeColDefaults, onConflictUpdate)
+	}
+	if len(updateCols) > 0 {
+		assignments := make([]string, len(updateCols))
+		for i, col := range updateCols {
+			assignments[i] = fmt.Sprintf("%s.%s = %s.%s", quoteMssqlIdentifier("target"), quoteMssqlIdentifier(col), quoteMssqlIdentifier("source"), quoteMssqlIdentifier(col))
+		}
+		fmt.Fprintf(&builder, " WHEN MATCHED THEN UPDATE SET %s", strings.Join(assignments, ", "))
+	}
+	fmt.Fprintf(&builder, " WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s);", strings.Join(quotedCols, ", "), strings.Join(sourceCols, ", "))
+	return builder.String(), args, nil
+}
+
+func quoteMssqlIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
 }
 
 func BuildUpdateQuery(
@@ -217,7 +499,7 @@ func BuildUpdateQuery(
 	columnValueMap map[string]any,
 ) (string, error) {
 	builder := getGoquDialect(driver)
-	sqltable := goqu.S(schema).Table(table)
+	sqltable := getGoquTable(driver, schema, table)
 
 	updateRecord := goqu.Record{}
 	for _, col := range insertColumns {
@@ -245,6 +527,22 @@ func BuildUpdateQuery(
 func BuildTruncateQuery(
 	driver, table string,
 ) (string, error) {
+	// sql server can not truncate a table that is referenced by a foreign key, so its rows are deleted instead
+	if driver == sqlmanager_shared.MssqlDriver {
+		query, _, err := getGoquDialect(driver).Delete(goqu.I(table)).ToSQL()
+		if err != nil {
+			return "", err
+		}
+		return query, nil
+	}
+	if driver == sqlmanager_shared.SqliteDriver {
+		_, tableName := sqlmanager_shared.SplitTableKey(table)
+		query, _, err := getGoquDialect(driver).Delete(goqu.T(tableName)).ToSQL()
+		if err != nil {
+			return "", err
+		}
+		return query, nil
+	}
 	builder := getGoquDialect(driver)
 	sqltable := goqu.I(table)
 	truncate := builder.Truncate(sqltable)
@@ -121,11 +121,14 @@ func Test_BuildInsertQuery(t *testing.T) {
 		{"Multiple Columns mysql", "mysql", "public", "users", []string{"name", "email"}, []string{}, [][]any{{"Alice", "alice@fake.com"}, {"Bob", "bob@fake.com"}}, true, []*neosync_benthos.ColumnDefaultProperties{}, "INSERT IGNORE INTO `public`.`users` (`name`, `email`) VALUES (?, ?), (?, ?)", []any{"Alice", "alice@fake.com", "Bob", "bob@fake.com"}},
 		{"Single Column postgres", "postgres", "public", "users", []string{"name"}, []string{}, [][]any{{"Alice"}, {"Bob"}}, false, []*neosync_benthos.ColumnDefaultProperties{}, `INSERT INTO "public"."users" ("name") VALUES ($1), ($2)`, []any{"Alice", "Bob"}},
 		{"Multiple Columns postgres", "postgres", "public", "users", []string{"name", "email"}, []string{}, [][]any{{"Alice", "alice@fake.com"}, {"Bob", "bob@fake.com"}}, true, []*neosync_benthos.ColumnDefaultProperties{}, `INSERT INTO "public"."users" ("name", "email") VALUES ($1, $2), ($3, $4) ON CONFLICT DO NOTHING`, []any{"Alice", "alice@fake.com", "Bob", "bob@fake.com"}},
+		{"Single Column sqlite", "sqlite", "public", "users", []string{"name"}, []string{}, [][]any{{"Alice"}, {"Bob"}}, false, []*neosync_benthos.ColumnDefaultProperties{}, "INSERT INTO `users` (`name`) VALUES (?), (?)", []any{"Alice", "Bob"}},
+		{"Multiple Columns sqlite", "sqlite", "main", "users", []string{"name", "tags"}, []string{"text", "json"}, [][]any{{"Alice", map[string]any{"a": 1}}}, true, []*neosync_benthos.ColumnDefaultProperties{}, "INSERT INTO `users` (`name`, `tags`) VALUES (?, ?) ON CONFLICT  DO NOTHING ", []any{"Alice", `{"a":1}`}},
+		{"Byte Columns sqlite", "sqlite", "public", "users", []string{"name", "avatar"}, []string{"character varying(255)", "bytea"}, [][]any{{[]byte("Alice"), []byte{0x1}}}, false, []*neosync_benthos.ColumnDefaultProperties{}, "INSERT INTO `users` (`name`, `avatar`) VALUES (?, ?)", []any{"Alice", []byte{0x1}}},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actual, args, err := BuildInsertQuery(logger, tt.driver, tt.schema, tt.table, tt.columns, tt.columnDataTypes, tt.values, &tt.onConflictDoNothing, tt.columnDefaultProperties)
+			actual, args, err := BuildInsertQuery(logger, tt.driver, tt.schema, tt.table, tt.columns, tt.columnDataTypes, tt.values, &tt.onConflictDoNothing, nil, tt.columnDefaultProperties)
 			require.NoError(t, err)
 			require.Equal(t, tt.expected, actual)
 			require.Equal(t, tt.expectedArgs, args)
@@ -133,6 +136,72 @@ func Test_BuildInsertQuery(t *testing.T) {
 	}
 }
 
+func Test_BuildInsertQuery_OnConflictUpdate(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	columns := []string{"id", "name", "email"}
+	values := [][]any{{1, "Alice", "alice@fake.com"}}
+	onConflictDoNothing := false
+
+	t.Run("postgres", func(t *testing.T) {
+		query, args, err := BuildInsertQuery(logger, sqlmanager_shared.PostgresDriver, "public", "users", columns, []string{}, values, &onConflictDoNothing, &OnConflictUpdate{ConflictColumns: []string{"id"}}, []*neosync_benthos.ColumnDefaultProperties{})
+		require.NoError(t, err)
+		require.Equal(t, `INSERT INTO "public"."users" ("id", "name", "email") VALUES ($1, $2, $3) ON CONFLICT ("id") DO UPDATE SET "email"="excluded"."email","name"="excluded"."name"`, query)
+		require.Equal(t, []any{int64(1), "Alice", "alice@fake.com"}, args)
+	})
+
+	t.Run("postgres update columns", func(t *testing.T) {
+		query, _, err := BuildInsertQuery(logger, sqlmanager_shared.PostgresDriver, "public", "users", columns, []string{}, values, &onConflictDoNothing, &OnConflictUpdate{ConflictColumns: []string{"id"}, UpdateColumns: []string{"email"}}, []*neosync_benthos.ColumnDefaultProperties{})
+		require.NoError(t, err)
+		require.Equal(t, `INSERT INTO "public"."users" ("id", "name", "email") VALUES ($1, $2, $3) ON CONFLICT ("id") DO UPDATE SET "email"="excluded"."email"`, query)
+	})
+
+	t.Run("postgres only conflict columns", func(t *testing.T) {
+		query, _, err := BuildInsertQuery(logger, sqlmanager_shared.PostgresDriver, "public", "users", []string{"id"}, []string{}, [][]any{{1}}, &onConflictDoNothing, &OnConflictUpdate{ConflictColumns: []string{"id"}}, []*neosync_benthos.ColumnDefaultProperties{})
+		require.NoError(t, err)
+		require.Equal(t, `INSERT INTO "public"."users" ("id") VALUES ($1) ON CONFLICT DO NOTHING`, query)
+	})
+
+	t.Run("mysql", func(t *testing.T) {
+		query, _, err := BuildInsertQuery(logger, sqlmanager_shared.MysqlDriver, "public", "users", columns, []string{}, values, &onConflictDoNothing, &OnConflictUpdate{ConflictColumns: []string{"id"}}, []*neosync_benthos.ColumnDefaultProperties{})
+		require.NoError(t, err)
+		require.Equal(t, "INSERT INTO `public`.`users` (`id`, `name`, `email`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `name`=VALUES(`name`),`email`=VALUES(`email`)", query)
+	})
+
+	t.Run("mysql update columns", func(t *testing.T) {
+		query, _, err := BuildInsertQuery(logger, sqlmanager_shared.MysqlDriver, "public", "users", columns, []string{}, values, &onConflictDoNothing, &OnConflictUpdate{ConflictColumns: []string{"id"}, UpdateColumns: []string{"email", "missing"}}, []*neosync_benthos.ColumnDefaultProperties{})
+		require.NoError(t, err)
+		require.Equal(t, "INSERT INTO `public`.`users` (`id`, `name`, `email`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `email`=VALUES(`email`)", query)
+	})
+
+	t.Run("postgres skips identity and default columns", func(t *testing.T) {
+		columnDefaults := []*neosync_benthos.ColumnDefaultProperties{{NeedsOverride: true, NeedsReset: true}, {HasDefaultTransformer: true}, nil}
+		query, _, err := BuildInsertQuery(logger, sqlmanager_shared.PostgresDriver, "public", "users", columns, []string{}, values, &onConflictDoNothing, &OnConflictUpdate{ConflictColumns: []string{"email"}}, columnDefaults)
+		require.NoError(t, err)
+		require.Equal(t, `INSERT INTO "public"."users" ("id", "name", "email") VALUES ($1, DEFAULT, $2) ON CONFLICT DO NOTHING`, query)
+	})
+
+	t.Run("sqlite", func(t *testing.T) {
+		query, _, err := BuildInsertQuery(logger, sqlmanager_shared.SqliteDriver, "public", "users", columns, []string{}, values, &onConflictDoNothing, &OnConflictUpdate{ConflictColumns: []string{"id"}}, []*neosync_benthos.ColumnDefaultProperties{})
+		require.NoError(t, err)
+		require.Equal(t, "INSERT INTO `users` (`id`, `name`, `email`) VALUES (?, ?, ?) ON CONFLICT  (\"id\") DO UPDATE SET `email`=`excluded`.`email`,`name`=`excluded`.`name`", query)
+	})
+
+	t.Run("mssql", func(t *testing.T) {
+		query, args, err := BuildInsertQuery(logger, sqlmanager_shared.MssqlDriver, "dbo", "users", columns, []string{}, [][]any{{1, "Alice", "alice@fake.com"}, {2, "Bob", "bob@fake.com"}}, &onConflictDoNothing, &OnConflictUpdate{ConflictColumns: []string{"id"}}, []*neosync_benthos.ColumnDefaultProperties{})
+		require.NoError(t, err)
+		require.Equal(t, `MERGE INTO "dbo"."users" WITH (HOLDLOCK) AS "target" USING (VALUES (@p1, @p2, @p3), (@p4, @p5, @p6)) AS "source" ("id", "name", "email") ON ("target"."id" = "source"."id") WHEN MATCHED THEN UPDATE SET "target"."name" = "source"."name", "target"."email" = "source"."email" WHEN NOT MATCHED THEN INSERT ("id", "name", "email") VALUES ("source"."id", "source"."name", "source"."email");`, query)
+		require.Equal(t, []any{1, "Alice", "alice@fake.com", 2, "Bob", "bob@fake.com"}, args)
+	})
+
+	t.Run("mssql skips default columns", func(t *testing.T) {
+		columnDefaults := []*neosync_benthos.ColumnDefaultProperties{{NeedsOverride: true, NeedsReset: true}, {HasDefaultTransformer: true}, nil}
+		query, args, err := BuildInsertQuery(logger, sqlmanager_shared.MssqlDriver, "dbo", "users", columns, []string{}, values, &onConflictDoNothing, &OnConflictUpdate{ConflictColumns: []string{"email"}}, columnDefaults)
+		require.NoError(t, err)
+		require.Equal(t, `MERGE INTO "dbo"."users" WITH (HOLDLOCK) AS "target" USING (VALUES (@p1, @p2)) AS "source" ("id", "email") ON ("target"."email" = "source"."email") WHEN NOT MATCHED THEN INSERT ("id", "email") VALUES ("source"."id", "source"."email");`, query)
+		require.Equal(t, []any{1, "alice@fake.com"}, args)
+	})
+}
+
 func Test_BuildInsertQuery_JsonArray(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	driver := sqlmanager_shared.PostgresDriver
@@ -147,7 +216,7 @@ func Test_BuildInsertQuery_JsonArray(t *testing.T) {
 	}
 	onConflictDoNothing := false
 
-	query, _, err := BuildInsertQuery(logger, driver, schema, table, columns, columnDataTypes, values, &onConflictDoNothing, columnDefaultProperties)
+	query, _, err := BuildInsertQuery(logger, driver, schema, table, columns, columnDataTypes, values, &onConflictDoNothing, nil, columnDefaultProperties)
 	require.NoError(t, err)
 	expectedQuery := `INSERT INTO "public"."test_table" ("id", "name", "tags") VALUES ($1, $2, ARRAY['{"tag":"cool"}','{"tag":"awesome"}']::jsonb[]), ($3, $4, ARRAY['{"tag":"smart"}','{"tag":"clever"}']::jsonb[])`
 	require.Equal(t, expectedQuery, query)
@@ -167,13 +236,40 @@ func Test_BuildInsertQuery_Json(t *testing.T) {
 	}
 	onConflictDoNothing := false
 
-	query, args, err := BuildInsertQuery(logger, driver, schema, table, columns, columnDataTypes, values, &onConflictDoNothing, columnDefaultProperties)
+	query, args, err := BuildInsertQuery(logger, driver, schema, table, columns, columnDataTypes, values, &onConflictDoNothing, nil, columnDefaultProperties)
 	require.NoError(t, err)
 	expectedQuery := `INSERT INTO "public"."test_table" ("id", "name", "tags") VALUES ($1, $2, $3), ($4, $5, $6)`
 	require.Equal(t, expectedQuery, query)
 	require.Equal(t, []any{int64(1), "John", []byte{123, 34, 116, 97, 103, 34, 58, 34, 99, 111, 111, 108, 34, 125}, int64(2), "Jane", []byte{123, 34, 116, 97, 103, 34, 58, 34, 115, 109, 97, 114, 116, 34, 125}}, args)
 }
 
+func Test_BuildTruncateQuery(t *testing.T) {
+	query, err := BuildTruncateQuery(sqlmanager_shared.PostgresDriver, "public.users")
+	require.NoError(t, err)
+	require.Equal(t, `TRUNCATE "public"."users"`, query)
+
+	query, err = BuildTruncateQuery(sqlmanager_shared.SqliteDriver, "public.users")
+	require.NoError(t, err)
+	require.Equal(t, "DELETE FROM `users`", query)
+
+	query, err = BuildTruncateQuery(sqlmanager_shared.MssqlDriver, "dbo.users")
+	require.NoError(t, err)
+	require.Equal(t, `DELETE FROM "dbo"."users"`, query)
+}
+
+func Test_BuildMssqlInsertIgnoreQuery(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	columns := []string{"id", "name"}
+
+	query, args, err := BuildMssqlInsertIgnoreQuery(logger, "dbo", "users", columns, []string{}, [][]any{{1, "Alice"}, {2, "Bob"}}, []string{"id"}, []*neosync_benthos.ColumnDefaultProperties{})
+	require.NoError(t, err)
+	require.Equal(t, `MERGE INTO "dbo"."users" WITH (HOLDLOCK) AS "target" USING (VALUES (@p1, @p2), (@p3, @p4)) AS "source" ("id", "name") ON ("target"."id" = "source"."id") WHEN NOT MATCHED THEN INSERT ("id", "name") VALUES ("source"."id", "source"."name");`, query)
+	require.Equal(t, []any{1, "Alice", 2, "Bob"}, args)
+
+	_, _, err = BuildMssqlInsertIgnoreQuery(logger, "dbo", "users", columns, []string{}, [][]any{{1, "Alice"}}, nil, []*neosync_benthos.ColumnDefaultProperties{})
+	require.Error(t, err)
+}
+
 func TestGetGoquVals(t *testing.T) {
 	t.Run("Postgres", func(t *testing.T) {
 		logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
@@ -0,0 +1,54 @@
+package querybuilder2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+
+	// import the dialect
+	_ "github.com/doug-martin/goqu/v9/dialect/mysql"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlserver"
+)
+
+// Builds a where clause that selects the rows whose column value is greater than the lower bound and at most the upper bound.
+// A nil bound leaves that side of the range open. Returns an empty string if both bounds are nil.
+func BuildIncrementalWhereClause(
+	driver, column string,
+	lowerBound, upperBound *string,
+) (string, error) {
+	conditions := []exp.Expression{}
+	if lowerBound != nil {
+		conditions = append(conditions, goqu.C(column).Gt(*lowerBound))
+	}
+	if upperBound != nil {
+		conditions = append(conditions, goqu.C(column).Lte(*upperBound))
+	}
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	sql, _, err := getIncrementalDialect(driver).From(goqu.T("incremental")).Where(conditions...).ToSQL()
+	if err != nil {
+		return "", err
+	}
+	index := strings.Index(sql, " WHERE ")
+	if index == -1 {
+		return "", fmt.Errorf("unable to build incremental where clause for column: %s", column)
+	}
+	return strings.TrimSpace(sql[index+len(" WHERE "):]), nil
+}
+
+// uses the driver's own dialect so that literal values are escaped the way the database expects
+func getIncrementalDialect(driver string) goqu.DialectWrapper {
+	switch driver {
+	case sqlmanager_shared.PostgresDriver:
+		return goqu.Dialect("postgres")
+	case sqlmanager_shared.SqliteDriver:
+		return goqu.Dialect("sqlite3")
+	}
+	return goqu.Dialect(driver)
+}
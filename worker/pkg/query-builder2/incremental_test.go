@@ -0,0 +1,68 @@
+package querybuilder2
+
+import (
+	"testing"
+
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BuildIncrementalWhereClause(t *testing.T) {
+	lower := "2024-01-01 00:00:00"
+	upper := "2024-02-01 00:00:00"
+	tests := []struct {
+		name       string
+		driver     string
+		lowerBound *string
+		upperBound *string
+		expected   string
+	}{
+		{"postgres both bounds", sqlmanager_shared.PostgresDriver, &lower, &upper, `(("updated_at" > '2024-01-01 00:00:00') AND ("updated_at" <= '2024-02-01 00:00:00'))`},
+		{"postgres upper bound", sqlmanager_shared.PostgresDriver, nil, &upper, `("updated_at" <= '2024-02-01 00:00:00')`},
+		{"mysql both bounds", sqlmanager_shared.MysqlDriver, &lower, &upper, "((`updated_at` > '2024-01-01 00:00:00') AND (`updated_at` <= '2024-02-01 00:00:00'))"},
+		{"mssql lower bound", sqlmanager_shared.MssqlDriver, &lower, nil, `("updated_at" > '2024-01-01 00:00:00')`},
+		{"no bounds", sqlmanager_shared.PostgresDriver, nil, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := BuildIncrementalWhereClause(tt.driver, "updated_at", tt.lowerBound, tt.upperBound)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+
+	t.Run("escapes values", func(t *testing.T) {
+		value := "o'brien"
+		actual, err := BuildIncrementalWhereClause(sqlmanager_shared.PostgresDriver, "name", &value, nil)
+		require.NoError(t, err)
+		require.Equal(t, `("name" > 'o''brien')`, actual)
+	})
+}
+
+func Test_BuildSelectQueryMap_RootWhereClauses(t *testing.T) {
+	tableDependencies := map[string][]*sqlmanager_shared.ForeignConstraint{
+		"public.orders": {
+			{Columns: []string{"user_id"}, NotNullable: []bool{true}, ForeignKey: &sqlmanager_shared.ForeignKey{Table: "public.users", Columns: []string{"id"}}},
+		},
+	}
+	runConfigs := []*tabledependency.RunConfig{
+		buildRunConfig("public.users", tabledependency.RunTypeInsert, []string{"id"}, nil, []string{"id", "updated_at"}, []string{"id", "updated_at"}, []*tabledependency.DependsOn{}),
+		buildRunConfig("public.orders", tabledependency.RunTypeInsert, []string{"id"}, nil, []string{"id", "user_id"}, []string{"id", "user_id"}, []*tabledependency.DependsOn{{Table: "public.users", Columns: []string{"id"}}}),
+	}
+	columnInfo := map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users":  {"id": {}, "updated_at": {}},
+		"public.orders": {"id": {}, "user_id": {}},
+	}
+
+	upperBound := "2024-01-01"
+	whereClause, err := BuildIncrementalWhereClause(sqlmanager_shared.PostgresDriver, "updated_at", nil, &upperBound)
+	require.NoError(t, err)
+
+	sqlMap, err := BuildSelectQueryMap(sqlmanager_shared.PostgresDriver, tableDependencies, runConfigs, true, columnInfo, map[string]string{"public.users": whereClause})
+	require.NoError(t, err)
+
+	require.Contains(t, sqlMap["public.users"][tabledependency.RunTypeInsert], `WHERE users.updated_at <= '2024-01-01'`)
+	require.NotContains(t, sqlMap["public.orders"][tabledependency.RunTypeInsert], "WHERE", "root where clauses should not subset related tables")
+}
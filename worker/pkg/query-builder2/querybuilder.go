@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/sqlite3"
 	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/doug-martin/goqu/v9/sqlgen"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
@@ -73,6 +74,8 @@ func (s set) contains(key string) bool {
 type QueryBuilder struct {
 	tables          map[string]*TableInfo
 	whereConditions map[string][]WhereCondition
+	// conditions that only filter the table's own select and are never used to subset related tables
+	rootWhereConditions map[string][]WhereCondition
 	// schema.table -> column -> { column info }
 	columnInfo                    map[string]map[string]*sqlmanager_shared.ColumnInfo
 	defaultSchema                 string
@@ -87,6 +90,7 @@ func NewQueryBuilder(defaultSchema, driver string, subsetByForeignKeyConstraints
 	return &QueryBuilder{
 		tables:                        make(map[string]*TableInfo),
 		whereConditions:               make(map[string][]WhereCondition),
+		rootWhereConditions:           make(map[string][]WhereCondition),
 		defaultSchema:                 defaultSchema,
 		driver:                        driver,
 		subsetByForeignKeyConstraints: subsetByForeignKeyConstraints,
@@ -106,6 +110,8 @@ func (qb *QueryBuilder) getDialect() goqu.DialectWrapper {
 	switch qb.driver {
 	case sqlmanager_shared.MysqlDriver:
 		return goqu.Dialect(mysqlDialect)
+	case sqlmanager_shared.SqliteDriver:
+		return goqu.Dialect("sqlite3")
 	default:
 		return goqu.Dialect(qb.driver)
 	}
@@ -117,6 +123,14 @@ func (qb *QueryBuilder) AddWhereCondition(schema, tableName, condition string, a
 	qb.tablesWithWhereConditions.add(key)
 	qb.clearPathCache()
 }
+
+// Adds a where condition that is only applied when selecting from the table directly.
+// Unlike AddWhereCondition, the condition does not cascade to tables that are subset by foreign key constraints.
+func (qb *QueryBuilder) AddRootWhereCondition(schema, tableName, condition string, args ...any) {
+	key := qb.getTableKey(schema, tableName)
+	qb.rootWhereConditions[key] = append(qb.rootWhereConditions[key], WhereCondition{Condition: condition, Args: args})
+}
+
 func (qb *QueryBuilder) clearPathCache() {
 	qb.pathCache = make(set)
 }
@@ -160,6 +174,11 @@ func (qb *QueryBuilder) buildFlattenedQuery(rootTable *TableInfo) (*goqu.SelectD
 			query = query.Where(goqu.L(cond.Condition, cond.Args...))
 		}
 	}
+	if conditions, ok := qb.rootWhereConditions[qb.getTableKey(rootTable.Schema, rootTable.Name)]; ok {
+		for _, cond := range conditions {
+			query = query.Where(goqu.L(cond.Condition, cond.Args...))
+		}
+	}
 
 	// Flatten and add necessary joins
 	if qb.subsetByForeignKeyConstraints && len(qb.whereConditions) > 0 {
@@ -309,7 +328,8 @@ func (qb *QueryBuilder) qualifyWhereCondition(schema *string, table, condition s
 
 	var updatedSql string
 	switch qb.driver {
-	case sqlmanager_shared.MysqlDriver:
+	case sqlmanager_shared.MysqlDriver, sqlmanager_shared.SqliteDriver:
+		// sqlite quotes identifiers with backticks the same as mysql
 		sql, err := qualifyMysqlWhereColumnNames(sql, schema, table)
 		if err != nil {
 			return "", err
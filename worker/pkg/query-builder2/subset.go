@@ -8,12 +8,14 @@ import (
 )
 
 // returns map of schema.table -> select query
+// tableRootWhereClauses is a map of schema.table -> where clause that only filters the table itself and is not used for subsetting
 func BuildSelectQueryMap(
 	driver string,
 	tableFkConstraints map[string][]*sqlmanager_shared.ForeignConstraint,
 	runConfigs []*tabledependency.RunConfig,
 	subsetByForeignKeyConstraints bool,
 	groupedColumnInfo map[string]map[string]*sqlmanager_shared.ColumnInfo,
+	tableRootWhereClauses map[string]string,
 ) (map[string]map[tabledependency.RunType]string, error) {
 	tableDependencies := map[string]*TableConstraints{}
 	for tableName, fkConstraints := range tableFkConstraints {
@@ -49,6 +51,18 @@ func BuildSelectQueryMap(
 		qb.AddWhereCondition(schema, table, qualifiedWhereCaluse)
 	}
 
+	for tableName, whereClause := range tableRootWhereClauses {
+		if whereClause == "" {
+			continue
+		}
+		schema, table := splitTable(tableName)
+		qualifiedWhereClause, err := qb.qualifyWhereCondition(nil, table, whereClause)
+		if err != nil {
+			return nil, err
+		}
+		qb.AddRootWhereCondition(schema, table, qualifiedWhereClause)
+	}
+
 	querymap := map[string]map[tabledependency.RunType]string{}
 	for _, cfg := range runConfigs {
 		if _, ok := querymap[cfg.Table()]; !ok {
@@ -9,6 +9,7 @@ import (
 	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"go.temporal.io/sdk/activity"
@@ -46,6 +47,7 @@ type BenthosConfigResponse struct {
 	RedisConfig []*BenthosRedisConfig
 
 	primaryKeys []string
+	watermark   *tableWatermark
 
 	metriclabels metrics.MetricLabels
 }
@@ -60,6 +62,11 @@ type Activity struct {
 	redisConfig *shared.RedisConfig
 
 	metricsEnabled bool
+
+	secretResolver secrets.Resolver
+
+	// Local directory connections must be located within this directory. They are disabled when empty.
+	localDirectoryAllowedDir string
 }
 
 func New(
@@ -69,6 +76,8 @@ func New(
 	sqlmanager sql_manager.SqlManagerClient,
 	redisConfig *shared.RedisConfig,
 	metricsEnabled bool,
+	secretResolver secrets.Resolver,
+	localDirectoryAllowedDir string,
 ) *Activity {
 	return &Activity{
 		jobclient:         jobclient,
@@ -77,6 +86,9 @@ func New(
 		sqlmanager:        sqlmanager,
 		redisConfig:       redisConfig,
 		metricsEnabled:    metricsEnabled,
+		secretResolver:    secretResolver,
+
+		localDirectoryAllowedDir: localDirectoryAllowedDir,
 	}
 }
 
@@ -118,6 +130,8 @@ func (a *Activity) GenerateBenthosConfigs(
 		info.WorkflowExecution.RunID,
 		a.redisConfig,
 		a.metricsEnabled,
+		a.secretResolver,
+		a.localDirectoryAllowedDir,
 	)
 	slogger := neosynclogger.NewJsonSLogger().With(loggerKeyVals...)
 	return bbuilder.GenerateBenthosConfigs(ctx, req, &workflowMetadata{WorkflowId: info.WorkflowExecution.ID}, slogger)
@@ -102,6 +102,7 @@ func (b *benthosBuilder) getAiGenerateBenthosConfigResponses(
 		userBatchSize = &ubs
 	}
 	sourceResponses := buildBenthosAiGenerateSourceConfigResponses(
+		sourceConnection.GetId(),
 		openaiConfig,
 		mappings,
 		sourceOptions.GetModelName(),
@@ -123,6 +124,7 @@ func (b *benthosBuilder) getAiGenerateBenthosConfigResponses(
 }
 
 func buildBenthosAiGenerateSourceConfigResponses(
+	connectionId string,
 	openaiconfig *mgmtv1alpha1.OpenAiConnectionConfig,
 	mappings []*aiGenerateMappings,
 	model string,
@@ -152,7 +154,7 @@ func buildBenthosAiGenerateSourceConfigResponses(
 					Inputs: neosync_benthos.Inputs{
 						OpenAiGenerate: &neosync_benthos.OpenAiGenerate{
 							ApiUrl:     openaiconfig.ApiUrl,
-							ApiKey:     openaiconfig.ApiKey,
+							ApiKey:     shared.GetConnectionCredentialEnvVar(connectionId, shared.CredentialOpenAiApiKey, openaiconfig.ApiKey),
 							UserPrompt: userPrompt,
 							Columns:    columns,
 							DataTypes:  dataTypes,
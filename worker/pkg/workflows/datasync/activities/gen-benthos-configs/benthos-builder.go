@@ -14,6 +14,7 @@ import (
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"gopkg.in/yaml.v3"
@@ -39,6 +40,10 @@ type benthosBuilder struct {
 	redisConfig *shared.RedisConfig
 
 	metricsEnabled bool
+
+	secretResolver secrets.Resolver
+
+	localDirectoryAllowedDir string
 }
 
 func newBenthosBuilder(
@@ -53,6 +58,10 @@ func newBenthosBuilder(
 	redisConfig *shared.RedisConfig,
 
 	metricsEnabled bool,
+
+	secretResolver secrets.Resolver,
+
+	localDirectoryAllowedDir string,
 ) *benthosBuilder {
 	return &benthosBuilder{
 		sqlmanagerclient:  sqlmanagerclient,
@@ -64,6 +73,9 @@ func newBenthosBuilder(
 		runId:             runId,
 		redisConfig:       redisConfig,
 		metricsEnabled:    metricsEnabled,
+		secretResolver:    secretResolver,
+
+		localDirectoryAllowedDir: localDirectoryAllowedDir,
 	}
 }
 
@@ -104,7 +116,7 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 			return nil, fmt.Errorf("unable to build benthos Generate source config responses: %w", err)
 		}
 		responses = append(responses, sourceResponses...)
-	case *mgmtv1alpha1.JobSourceOptions_Postgres, *mgmtv1alpha1.JobSourceOptions_Mysql, *mgmtv1alpha1.JobSourceOptions_Mssql:
+	case *mgmtv1alpha1.JobSourceOptions_Postgres, *mgmtv1alpha1.JobSourceOptions_Mysql, *mgmtv1alpha1.JobSourceOptions_Mssql, *mgmtv1alpha1.JobSourceOptions_Sqlite:
 		resp, err := b.getSqlSyncBenthosConfigResponses(ctx, job, slogger)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build benthos sql sync source config responses: %w", err)
@@ -125,6 +137,18 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 			return nil, fmt.Errorf("unable to build benthos dynamodb sync source config responses: %w", err)
 		}
 		responses = append(responses, resp.BenthosConfigs...)
+	case *mgmtv1alpha1.JobSourceOptions_Kafka:
+		resp, err := b.getKafkaSyncBenthosConfigResponses(ctx, job, slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build benthos kafka sync source config responses: %w", err)
+		}
+		responses = append(responses, resp.BenthosConfigs...)
+	case *mgmtv1alpha1.JobSourceOptions_LocalDirectory:
+		resp, err := b.getLocalDirectorySyncBenthosConfigResponses(ctx, job, slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build benthos local directory sync source config responses: %w", err)
+		}
+		responses = append(responses, resp.BenthosConfigs...)
 	default:
 		return nil, fmt.Errorf("unsupported job source: %T", job.GetSource().GetOptions().GetConfig())
 	}
@@ -144,8 +168,8 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 			dsn := fmt.Sprintf("${%s}", dstEnvVarKey)
 
 			switch connection := destinationConnection.ConnectionConfig.Config.(type) {
-			case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
-				driver, err := getSqlDriverFromConnection(destinationConnection)
+			case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+				driver, err := shared.GetSqlDriverFromConnection(destinationConnection)
 				if err != nil {
 					return nil, err
 				}
@@ -163,7 +187,7 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 						return nil, err
 					}
 					resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
-				} else if resp.Config.Input.Generate != nil {
+				} else if resp.Config.Input.Generate != nil || resp.Config.Input.ProfileGenerate != nil {
 					// SQL generate output
 					outputs := b.getSqlGenerateOutput(driver, resp, destination, dsn)
 					resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
@@ -185,7 +209,7 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 				if destinationOpts == nil {
 					return nil, errors.New("destination must have configured aws destination options")
 				}
-				outputs, err := b.getAwsS3SyncBenthosOutput(connection, resp, wfmetadata.WorkflowId, destinationOpts)
+				outputs, err := b.getAwsS3SyncBenthosOutput(destinationConnection.GetId(), connection, resp, job, wfmetadata.WorkflowId, destinationOpts, sqlSourceSchemaColumnInfoMap[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)])
 				if err != nil {
 					return nil, fmt.Errorf("unable to build s3 destination connection: %w", err)
 				}
@@ -194,30 +218,24 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 				if resp.RunType == tabledependency.RunTypeUpdate {
 					continue
 				}
-				output := b.getGcpCloudStorageSyncBenthosOutput(connection, resp, wfmetadata.WorkflowId)
-				resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, output...)
+				outputs, err := b.getGcpCloudStorageSyncBenthosOutput(connection, resp, job, wfmetadata.WorkflowId, destination.GetOptions().GetGcpCloudstorageOptions(), sqlSourceSchemaColumnInfoMap[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)])
+				if err != nil {
+					return nil, fmt.Errorf("unable to build gcp cloud storage destination connection: %w", err)
+				}
+				resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
+			case *mgmtv1alpha1.ConnectionConfig_LocalDirConfig:
+				if resp.RunType == tabledependency.RunTypeUpdate {
+					continue
+				}
+				outputs, err := b.getLocalDirectorySyncBenthosOutput(connection, resp, job, wfmetadata.WorkflowId, destination.GetOptions().GetLocalDirectoryOptions(), sqlSourceSchemaColumnInfoMap[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)])
+				if err != nil {
+					return nil, fmt.Errorf("unable to build local directory destination connection: %w", err)
+				}
+				resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, outputs...)
 			case *mgmtv1alpha1.ConnectionConfig_MongoConfig:
 				resp.BenthosDsns = append(resp.BenthosDsns, &shared.BenthosDsn{EnvVarKey: dstEnvVarKey, ConnectionId: destinationConnection.GetId()})
 				if resp.Config.Input.PooledMongoDB != nil || resp.Config.Input.MongoDB != nil {
-					resp.Config.Output.PooledMongoDB = &neosync_benthos.OutputMongoDb{
-						Url: dsn,
-
-						Database:   resp.TableSchema,
-						Collection: resp.TableName,
-						Operation:  "update-one",
-						Upsert:     true,
-						DocumentMap: `
-						  root = {
-								"$set": this
-							}
-						`,
-						FilterMap: `
-						  root._id = this._id
-						`,
-						WriteConcern: &neosync_benthos.MongoWriteConcern{
-							W: "1",
-						},
-					}
+					resp.Config.Output.PooledMongoDB = buildMongoDbOutput(dsn, resp.TableSchema, resp.TableName, destination.GetOptions().GetMongodbOptions().GetWriteMode())
 				} else {
 					return nil, errors.New("unable to build destination connection due to unsupported source connection")
 				}
@@ -255,9 +273,18 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 
 						Region:      connection.DynamodbConfig.GetRegion(),
 						Endpoint:    connection.DynamodbConfig.GetEndpoint(),
-						Credentials: buildBenthosS3Credentials(connection.DynamodbConfig.GetCredentials()),
+						Credentials: buildBenthosS3Credentials(destinationConnection.GetId(), connection.DynamodbConfig.GetCredentials()),
 					},
 				})
+			case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+				if resp.Config.Input.NeosyncKafka == nil {
+					return nil, errors.New("unable to build destination connection due to unsupported source connection for kafka")
+				}
+				kafkaDestinationOpts := destination.GetOptions().GetKafkaOptions()
+				if kafkaDestinationOpts == nil {
+					return nil, errors.New("destination must have configured kafka options")
+				}
+				resp.Config.Output.Broker.Outputs = append(resp.Config.Output.Broker.Outputs, b.getKafkaSyncBenthosOutput(destinationConnection.GetId(), connection.KafkaConfig, kafkaDestinationOpts))
 			default:
 				return nil, fmt.Errorf("unsupported destination connection config: %T", destinationConnection.GetConnectionConfig().GetConfig())
 			}
@@ -293,7 +320,7 @@ func (b *benthosBuilder) GenerateBenthosConfigs(
 		outputConfigs = responses
 	}
 
-	postTableSyncRunCtx := buildPostTableSyncRunCtx(outputConfigs, job.Destinations)
+	postTableSyncRunCtx := buildPostTableSyncRunCtx(outputConfigs, job.Destinations, b.jobId)
 	err = b.setPostTableSyncRunCtx(ctx, postTableSyncRunCtx, job.GetAccountId())
 	if err != nil {
 		return nil, fmt.Errorf("unable to set all run contexts for post table sync configs: %w", err)
@@ -330,7 +357,7 @@ func (b *benthosBuilder) GetSqlSchemaColumnMap(
 		return schemaColMap
 	}
 	switch destinationConnection.ConnectionConfig.Config.(type) {
-	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
 		destDb, err := b.sqlmanagerclient.NewPooledSqlDb(ctx, slogger, destinationConnection)
 		if err != nil {
 			destDb.Db.Close()
@@ -350,7 +377,7 @@ func (b *benthosBuilder) GetSqlSchemaColumnMap(
 }
 
 func isSyncConfig(input *neosync_benthos.InputConfig) bool {
-	return input.SqlSelect != nil || input.PooledSqlRaw != nil
+	return input.SqlSelect != nil || input.PooledSqlRaw != nil || input.File != nil
 }
 
 // this method modifies the input responses by nilling out the benthos config. it returns the same slice for convenience
@@ -387,7 +414,7 @@ func (b *benthosBuilder) setRunContexts(
 	return responses, nil
 }
 
-func buildPostTableSyncRunCtx(benthosConfigs []*BenthosConfigResponse, destinations []*mgmtv1alpha1.JobDestination) map[string]*shared.PostTableSyncConfig {
+func buildPostTableSyncRunCtx(benthosConfigs []*BenthosConfigResponse, destinations []*mgmtv1alpha1.JobDestination, jobId string) map[string]*shared.PostTableSyncConfig {
 	postTableSyncRunCtx := map[string]*shared.PostTableSyncConfig{} // benthos_config_name -> config
 	for _, bc := range benthosConfigs {
 		destConfigs := map[string]*shared.PostTableSyncDestConfig{}
@@ -405,9 +432,11 @@ func buildPostTableSyncRunCtx(benthosConfigs []*BenthosConfigResponse, destinati
 				}
 			}
 		}
-		if len(destConfigs) != 0 {
+		watermark := buildPostTableSyncWatermark(jobId, bc)
+		if len(destConfigs) != 0 || watermark != nil {
 			postTableSyncRunCtx[bc.Name] = &shared.PostTableSyncConfig{
 				DestinationConfigs: destConfigs,
+				Watermark:          watermark,
 			}
 		}
 	}
@@ -448,7 +477,7 @@ func (b *benthosBuilder) setPostTableSyncRunCtx(
 
 func isOnlyBucketDestinations(destinations []*mgmtv1alpha1.JobDestination) bool {
 	for _, dest := range destinations {
-		if dest.GetOptions().GetAwsS3Options() == nil && dest.GetOptions().GetGcpCloudstorageOptions() == nil {
+		if dest.GetOptions().GetAwsS3Options() == nil && dest.GetOptions().GetGcpCloudstorageOptions() == nil && dest.GetOptions().GetLocalDirectoryOptions() == nil {
 			return false
 		}
 	}
@@ -488,19 +517,6 @@ func groupGenerateSourceOptionsByTable(
 	return groupedMappings
 }
 
-func getSqlDriverFromConnection(conn *mgmtv1alpha1.Connection) (string, error) {
-	switch conn.ConnectionConfig.Config.(type) {
-	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
-		return sqlmanager_shared.PostgresDriver, nil
-	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
-		return sqlmanager_shared.MysqlDriver, nil
-	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
-		return sqlmanager_shared.MssqlDriver, nil
-	default:
-		return "", fmt.Errorf("unsupported sql connection config")
-	}
-}
-
 func groupSqlJobSourceOptionsByTable(
 	sqlSourceOpts *sqlJobSourceOpts,
 ) map[string]*sqlSourceTableOptions {
@@ -510,7 +526,8 @@ func groupSqlJobSourceOptionsByTable(
 			tableOpt := schemaOpt.Tables[tidx]
 			key := neosync_benthos.BuildBenthosTable(schemaOpt.Schema, tableOpt.Table)
 			groupedMappings[key] = &sqlSourceTableOptions{
-				WhereClause: tableOpt.WhereClause,
+				WhereClause:     tableOpt.WhereClause,
+				WatermarkColumn: tableOpt.WatermarkColumn,
 			}
 		}
 	}
@@ -16,6 +16,7 @@ import (
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	pg_models "github.com/nucleuscloud/neosync/backend/sql/postgresql/models"
+	"github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -541,51 +542,56 @@ func Test_buildPlainColumns(t *testing.T) {
 }
 
 func Test_buildBenthosS3Credentials(t *testing.T) {
-	require.Nil(t, buildBenthosS3Credentials(nil))
+	connId := "123"
+	credVar := func(credential string) string {
+		return "${" + shared.GetConnectionCredentialEnvKey(connId, credential) + "}"
+	}
+
+	require.Nil(t, buildBenthosS3Credentials(connId, nil))
 
 	require.Equal(
 		t,
-		buildBenthosS3Credentials(&mgmtv1alpha1.AwsS3Credentials{}),
+		buildBenthosS3Credentials(connId, &mgmtv1alpha1.AwsS3Credentials{}),
 		&neosync_benthos.AwsCredentials{},
 	)
 	require.Equal(
 		t,
-		buildBenthosS3Credentials(&mgmtv1alpha1.AwsS3Credentials{Profile: shared.Ptr("foo")}),
+		buildBenthosS3Credentials(connId, &mgmtv1alpha1.AwsS3Credentials{Profile: shared.Ptr("foo")}),
 		&neosync_benthos.AwsCredentials{Profile: "foo"},
 	)
 	require.Equal(
 		t,
-		buildBenthosS3Credentials(&mgmtv1alpha1.AwsS3Credentials{AccessKeyId: shared.Ptr("foo")}),
-		&neosync_benthos.AwsCredentials{Id: "foo"},
+		buildBenthosS3Credentials(connId, &mgmtv1alpha1.AwsS3Credentials{AccessKeyId: shared.Ptr("foo")}),
+		&neosync_benthos.AwsCredentials{Id: credVar(shared.CredentialAwsAccessKeyId)},
 	)
 	require.Equal(
 		t,
-		buildBenthosS3Credentials(&mgmtv1alpha1.AwsS3Credentials{SecretAccessKey: shared.Ptr("foo")}),
-		&neosync_benthos.AwsCredentials{Secret: "foo"},
+		buildBenthosS3Credentials(connId, &mgmtv1alpha1.AwsS3Credentials{SecretAccessKey: shared.Ptr("foo")}),
+		&neosync_benthos.AwsCredentials{Secret: credVar(shared.CredentialAwsSecretAccessKey)},
 	)
 	require.Equal(
 		t,
-		buildBenthosS3Credentials(&mgmtv1alpha1.AwsS3Credentials{SessionToken: shared.Ptr("foo")}),
-		&neosync_benthos.AwsCredentials{Token: "foo"},
+		buildBenthosS3Credentials(connId, &mgmtv1alpha1.AwsS3Credentials{SessionToken: shared.Ptr("foo")}),
+		&neosync_benthos.AwsCredentials{Token: credVar(shared.CredentialAwsSessionToken)},
 	)
 	require.Equal(
 		t,
-		buildBenthosS3Credentials(&mgmtv1alpha1.AwsS3Credentials{FromEc2Role: shared.Ptr(true)}),
+		buildBenthosS3Credentials(connId, &mgmtv1alpha1.AwsS3Credentials{FromEc2Role: shared.Ptr(true)}),
 		&neosync_benthos.AwsCredentials{FromEc2Role: true},
 	)
 	require.Equal(
 		t,
-		buildBenthosS3Credentials(&mgmtv1alpha1.AwsS3Credentials{RoleArn: shared.Ptr("foo")}),
+		buildBenthosS3Credentials(connId, &mgmtv1alpha1.AwsS3Credentials{RoleArn: shared.Ptr("foo")}),
 		&neosync_benthos.AwsCredentials{Role: "foo"},
 	)
 	require.Equal(
 		t,
-		buildBenthosS3Credentials(&mgmtv1alpha1.AwsS3Credentials{RoleExternalId: shared.Ptr("foo")}),
+		buildBenthosS3Credentials(connId, &mgmtv1alpha1.AwsS3Credentials{RoleExternalId: shared.Ptr("foo")}),
 		&neosync_benthos.AwsCredentials{RoleExternalId: "foo"},
 	)
 	require.Equal(
 		t,
-		buildBenthosS3Credentials(&mgmtv1alpha1.AwsS3Credentials{
+		buildBenthosS3Credentials(connId, &mgmtv1alpha1.AwsS3Credentials{
 			Profile:         shared.Ptr("profile"),
 			AccessKeyId:     shared.Ptr("access-key"),
 			SecretAccessKey: shared.Ptr("secret"),
@@ -596,14 +602,25 @@ func Test_buildBenthosS3Credentials(t *testing.T) {
 		}),
 		&neosync_benthos.AwsCredentials{
 			Profile:        "profile",
-			Id:             "access-key",
-			Secret:         "secret",
-			Token:          "session",
+			Id:             credVar(shared.CredentialAwsAccessKeyId),
+			Secret:         credVar(shared.CredentialAwsSecretAccessKey),
+			Token:          credVar(shared.CredentialAwsSessionToken),
 			FromEc2Role:    false,
 			Role:           "role",
 			RoleExternalId: "foo",
 		},
 	)
+	require.Equal(
+		t,
+		buildBenthosS3Credentials(connId, &mgmtv1alpha1.AwsS3Credentials{
+			AccessKeyId:     shared.Ptr("access-key"),
+			SecretAccessKey: shared.Ptr("env://NEOSYNC_SECRET_AWS"),
+		}),
+		&neosync_benthos.AwsCredentials{
+			Id:     credVar(shared.CredentialAwsAccessKeyId),
+			Secret: "${" + shared.GetSecretReferenceEnvKey("env://NEOSYNC_SECRET_AWS") + "}",
+		},
+	)
 }
 
 func Test_computeMutationFunction_null(t *testing.T) {
@@ -617,7 +634,31 @@ func Test_computeMutationFunction_null(t *testing.T) {
 	require.Equal(t, val, "null")
 }
 
+func Test_computeMutationFunction_PhoneNumberLocaleOutsideLengthInterval(t *testing.T) {
+	_, err := computeMutationFunction(
+		&mgmtv1alpha1.JobMapping{
+			Column: "phone",
+			Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING_PHONE_NUMBER,
+				Config: &mgmtv1alpha1.TransformerConfig{
+					Config: &mgmtv1alpha1.TransformerConfig_GenerateStringPhoneNumberConfig{
+						GenerateStringPhoneNumberConfig: &mgmtv1alpha1.GenerateStringPhoneNumber{
+							Min:    9,
+							Max:    15,
+							Locale: mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_DE_DE.Enum(),
+						},
+					},
+				},
+			},
+		}, &sqlmanager_shared.ColumnInfo{CharacterMaximumLength: shared.Ptr(10)}, false)
+	require.Error(t, err, "a german national number does not fit in a column of 10 characters")
+}
+
 func Test_computeMutationFunction_Validate_Bloblang_Output(t *testing.T) {
+	// transformers that depend on the account key are only available once bound to an account key
+	blobEnv := bloblang.NewEnvironment()
+	require.NoError(t, transformers.RegisterAccountKeyedFunctions(blobEnv, "account-key"))
+
 	uuidEmailType := mgmtv1alpha1.GenerateEmailType_GENERATE_EMAIL_TYPE_UUID_V4
 	transformers := []*mgmtv1alpha1.SystemTransformer{
 		{
@@ -991,6 +1032,16 @@ func Test_computeMutationFunction_Validate_Bloblang_Output(t *testing.T) {
 				},
 			},
 		},
+		{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FPE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformFpeConfig{
+					TransformFpeConfig: &mgmtv1alpha1.TransformFpe{
+						Alphabet: mgmtv1alpha1.FpeAlphabet_FPE_ALPHABET_NUMERIC,
+					},
+				},
+			},
+		},
 	}
 
 	emailColInfo := &sqlmanager_shared.ColumnInfo{
@@ -1014,7 +1065,7 @@ func Test_computeMutationFunction_Validate_Bloblang_Output(t *testing.T) {
 					},
 				}, emailColInfo, false)
 			require.NoError(t, err)
-			ex, err := bloblang.Parse(val)
+			ex, err := blobEnv.Parse(val)
 			require.NoError(t, err, fmt.Sprintf("transformer lint failed, check that the transformer string is being constructed correctly. Failing source: %s", transformer.Source))
 			_, err = ex.Query(nil)
 			require.NoError(t, err)
@@ -1105,6 +1156,83 @@ func Test_computeMutationFunction_handles_Db_Maxlen(t *testing.T) {
 	}
 }
 
+func Test_computeMutationFunction_Deterministic(t *testing.T) {
+	jm := &mgmtv1alpha1.JobMapping{
+		Column: "email",
+		Transformer: &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_TransformCharacterScrambleConfig{
+					TransformCharacterScrambleConfig: &mgmtv1alpha1.TransformCharacterScramble{},
+				},
+				Deterministic: true,
+			},
+		},
+	}
+
+	out, err := computeMutationFunction(jm, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, `transform_character_scramble(value:this."email",deterministic:true)`, out)
+
+	blobEnv := bloblang.NewEnvironment()
+	require.NoError(t, transformers.RegisterAccountKeyedFunctions(blobEnv, "account-key"))
+	ex, err := blobEnv.Parse(out)
+	require.NoError(t, err)
+	_, err = ex.Query(map[string]any{"email": "foo@example.com"})
+	require.NoError(t, err)
+	ex, err = bloblang.Parse(out)
+	require.NoError(t, err)
+	_, err = ex.Query(map[string]any{"email": "foo@example.com"})
+	require.Error(t, err, "deterministic transformers require an environment that has been bound to an account key")
+
+	jm.Transformer.Source = mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BOOL
+	jm.Transformer.Config.Config = &mgmtv1alpha1.TransformerConfig_GenerateBoolConfig{
+		GenerateBoolConfig: &mgmtv1alpha1.GenerateBool{},
+	}
+	out, err = computeMutationFunction(jm, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, "generate_bool()", out, "generators should not be made deterministic")
+}
+
+func Test_computeMutationFunction_Locale(t *testing.T) {
+	locale := mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_DE_DE
+	jm := &mgmtv1alpha1.JobMapping{
+		Column: "zipcode",
+		Transformer: &mgmtv1alpha1.JobMappingTransformer{
+			Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ZIPCODE,
+			Config: &mgmtv1alpha1.TransformerConfig{
+				Config: &mgmtv1alpha1.TransformerConfig_GenerateZipcodeConfig{
+					GenerateZipcodeConfig: &mgmtv1alpha1.GenerateZipcode{Locale: &locale},
+				},
+			},
+		},
+	}
+
+	out, err := computeMutationFunction(jm, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, `generate_zipcode(locale:"de_DE")`, out)
+	_, err = bloblang.Parse(out)
+	require.NoError(t, err)
+
+	locale = mgmtv1alpha1.TransformerLocale_TRANSFORMER_LOCALE_JA_JP
+	jm.Column = "name"
+	jm.Transformer.Source = mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME
+	jm.Transformer.Config = &mgmtv1alpha1.TransformerConfig{
+		Config: &mgmtv1alpha1.TransformerConfig_TransformFirstNameConfig{
+			TransformFirstNameConfig: &mgmtv1alpha1.TransformFirstName{Locale: &locale},
+		},
+		Deterministic: true,
+	}
+	out, err = computeMutationFunction(jm, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, `transform_first_name(value:this."name",preserve_length:false,max_length:10000,locale:"ja_JP",deterministic:true)`, out)
+
+	jm.Transformer.Config.GetTransformFirstNameConfig().Locale = nil
+	out, err = computeMutationFunction(jm, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, `transform_first_name(value:this."name",preserve_length:false,max_length:10000,deterministic:true)`, out, "the locale should be omitted when unspecified")
+}
+
 func Test_buildBranchCacheConfigs_null(t *testing.T) {
 	cols := []*mgmtv1alpha1.JobMapping{
 		{
@@ -1415,7 +1543,7 @@ func Test_getPrimaryKeyDependencyMap_compositekeys(t *testing.T) {
 
 func Test_buildPostTableSyncRunCtx(t *testing.T) {
 	t.Run("Empty input", func(t *testing.T) {
-		result := buildPostTableSyncRunCtx(nil, nil)
+		result := buildPostTableSyncRunCtx(nil, nil, "")
 		require.Empty(t, result, "Expected empty map for empty input")
 	})
 
@@ -1434,7 +1562,7 @@ func Test_buildPostTableSyncRunCtx(t *testing.T) {
 				},
 			},
 		}
-		result := buildPostTableSyncRunCtx(benthosConfigs, destinations)
+		result := buildPostTableSyncRunCtx(benthosConfigs, destinations, "")
 		require.Empty(t, result, "Expected empty map when no statements are generated")
 	})
 
@@ -1474,7 +1602,7 @@ func Test_buildPostTableSyncRunCtx(t *testing.T) {
 			},
 		}
 
-		result := buildPostTableSyncRunCtx(benthosConfigs, destinations)
+		result := buildPostTableSyncRunCtx(benthosConfigs, destinations, "")
 
 		expected := map[string]*shared.PostTableSyncConfig{
 			"config1": {
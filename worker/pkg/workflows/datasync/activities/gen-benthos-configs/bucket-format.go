@@ -0,0 +1,181 @@
+package genbenthosconfigs_activity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+)
+
+const (
+	parquetContentType = "application/vnd.apache.parquet"
+
+	parquetTypeBoolean = "BOOLEAN"
+	parquetTypeInt64   = "INT64"
+	parquetTypeDouble  = "DOUBLE"
+	parquetTypeUtf8    = "UTF8"
+)
+
+// Returns the batch processors that encode a batch of records into a single object of the given format
+func getBucketBatchProcessors(
+	format mgmtv1alpha1.BucketFileFormat,
+	columns []string,
+	colInfoMap map[string]*sqlmanager_shared.ColumnInfo,
+) ([]*neosync_benthos.BatchProcessor, error) {
+	switch format {
+	case mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_UNSPECIFIED, mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_JSON_LINES:
+		return []*neosync_benthos.BatchProcessor{
+			{Archive: &neosync_benthos.ArchiveProcessor{Format: "lines"}},
+			{Compress: &neosync_benthos.CompressProcessor{Algorithm: "gzip"}},
+		}, nil
+	case mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_CSV:
+		if len(columns) == 0 {
+			return nil, fmt.Errorf("csv format requires the columns of the table to be known")
+		}
+		return []*neosync_benthos.BatchProcessor{
+			{NeosyncCsvEncode: &neosync_benthos.NeosyncCsvEncodeProcessor{Columns: columns}},
+			{Compress: &neosync_benthos.CompressProcessor{Algorithm: "gzip"}},
+		}, nil
+	case mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_PARQUET:
+		if len(columns) == 0 {
+			return nil, fmt.Errorf("parquet format requires the columns of the table to be known")
+		}
+		schema := getParquetSchema(columns, colInfoMap)
+		mapping := buildParquetCoercionMapping(schema)
+		return []*neosync_benthos.BatchProcessor{
+			{Mapping: &mapping},
+			{ParquetEncode: &neosync_benthos.ParquetEncodeProcessor{
+				Schema:             schema,
+				DefaultCompression: "snappy",
+			}},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported bucket file format: %s", format.String())
+	}
+}
+
+// Returns the file extension of objects written in the given format
+func getBucketFileExtension(format mgmtv1alpha1.BucketFileFormat, defaultExtension string) string {
+	switch format {
+	case mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_CSV:
+		return "csv.gz"
+	case mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_PARQUET:
+		return "parquet"
+	default:
+		return defaultExtension
+	}
+}
+
+// Returns the format of a file that was written by a bucket destination based on its extension.
+// Files without a known extension are gzipped json lines
+func getBucketFileFormatFromPath(path string) mgmtv1alpha1.BucketFileFormat {
+	switch {
+	case strings.HasSuffix(path, ".csv.gz"):
+		return mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_CSV
+	case strings.HasSuffix(path, ".parquet"):
+		return mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_PARQUET
+	default:
+		return mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_JSON_LINES
+	}
+}
+
+// Builds a parquet schema from the source column info.
+// Columns without column info, such as those from non-sql sources, are written as optional strings
+func getParquetSchema(columns []string, colInfoMap map[string]*sqlmanager_shared.ColumnInfo) []*neosync_benthos.ParquetSchemaField {
+	schema := make([]*neosync_benthos.ParquetSchemaField, 0, len(columns))
+	for _, col := range columns {
+		colInfo, ok := colInfoMap[col]
+		if !ok || colInfo == nil {
+			schema = append(schema, &neosync_benthos.ParquetSchemaField{Name: col, Type: parquetTypeUtf8, Optional: true})
+			continue
+		}
+		schema = append(schema, &neosync_benthos.ParquetSchemaField{
+			Name:     col,
+			Type:     toParquetType(colInfo.DataType),
+			Optional: colInfo.IsNullable,
+		})
+	}
+	return schema
+}
+
+func toParquetType(dataType string) string {
+	dt := strings.ToLower(strings.TrimSpace(dataType))
+	if idx := strings.Index(dt, "("); idx >= 0 {
+		dt = strings.TrimSpace(dt[:idx])
+	}
+	if strings.HasSuffix(dt, "[]") {
+		return parquetTypeUtf8
+	}
+	switch dt {
+	case "smallint", "integer", "int", "bigint", "tinyint", "mediumint", "int2", "int4", "int8",
+		"smallserial", "serial", "bigserial":
+		return parquetTypeInt64
+	case "real", "float", "float4", "float8", "double", "double precision":
+		return parquetTypeDouble
+	case "boolean", "bool":
+		return parquetTypeBoolean
+	default:
+		// numerics and decimals are written as strings to retain their precision
+		return parquetTypeUtf8
+	}
+}
+
+// Builds a bloblang mapping that coerces each column into the type that the parquet schema expects.
+// The sql drivers return values such as timestamps and byte slices that parquet can not encode directly
+func buildParquetCoercionMapping(schema []*neosync_benthos.ParquetSchemaField) string {
+	lines := []string{"root = {}"}
+	for _, field := range schema {
+		col := strconv.Quote(field.Name)
+		var coercion string
+		switch field.Type {
+		case parquetTypeInt64:
+			coercion = "int64()"
+		case parquetTypeDouble:
+			coercion = "number()"
+		case parquetTypeBoolean:
+			coercion = "bool()"
+		default:
+			coercion = "string()"
+		}
+		lines = append(lines, fmt.Sprintf("root.%s = if this.%s == null { null } else { this.%s.%s }", col, col, col, coercion))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Returns the batch count and period of a bucket destination, defaulting to 100 records or 5s
+func getBucketBatchPolicy(batchConfig *mgmtv1alpha1.BatchConfig) (int, string, error) {
+	batchCount := 100
+	batchPeriod := "5s"
+	if batchConfig != nil {
+		batchCount = int(batchConfig.GetCount())
+
+		if batchConfig.GetPeriod() != "" {
+			_, err := time.ParseDuration(batchConfig.GetPeriod())
+			if err != nil {
+				return 0, "", fmt.Errorf("unable to parse batch period: %w", err)
+			}
+		}
+		batchPeriod = batchConfig.GetPeriod()
+	}
+
+	if batchCount == 0 && batchPeriod == "" {
+		return 0, "", fmt.Errorf("must have at least one batch policy configured. Cannot disable both period and count")
+	}
+	return batchCount, batchPeriod, nil
+}
+
+// Validates the upload timeout of a bucket destination, returns an empty string when unset
+func getBucketTimeout(timeout *string) (string, error) {
+	if timeout == nil || *timeout == "" {
+		return "", nil
+	}
+	_, err := time.ParseDuration(*timeout)
+	if err != nil {
+		return "", err
+	}
+	return *timeout, nil
+}
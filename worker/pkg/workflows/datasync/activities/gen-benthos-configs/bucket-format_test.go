@@ -0,0 +1,97 @@
+package genbenthosconfigs_activity
+
+import (
+	"testing"
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+
+	_ "github.com/warpstreamlabs/bento/public/components/pure"
+)
+
+func Test_getBucketBatchProcessors(t *testing.T) {
+	processors, err := getBucketBatchProcessors(mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_UNSPECIFIED, []string{"id"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, "lines", processors[0].Archive.Format)
+	require.Equal(t, "gzip", processors[1].Compress.Algorithm)
+
+	processors, err = getBucketBatchProcessors(mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_CSV, []string{"id", "name"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "name"}, processors[0].NeosyncCsvEncode.Columns)
+	require.Equal(t, "gzip", processors[1].Compress.Algorithm)
+
+	processors, err = getBucketBatchProcessors(mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_PARQUET, []string{"id"}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, processors[0].Mapping)
+	require.Equal(t, []*neosync_benthos.ParquetSchemaField{{Name: "id", Type: "UTF8", Optional: true}}, processors[1].ParquetEncode.Schema)
+
+	_, err = getBucketBatchProcessors(mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_PARQUET, []string{}, nil)
+	require.Error(t, err)
+}
+
+func Test_getBucketFileExtension(t *testing.T) {
+	require.Equal(t, "jsonl.gz", getBucketFileExtension(mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_UNSPECIFIED, "jsonl.gz"))
+	require.Equal(t, "txt.gz", getBucketFileExtension(mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_JSON_LINES, "txt.gz"))
+	require.Equal(t, "csv.gz", getBucketFileExtension(mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_CSV, "txt.gz"))
+	require.Equal(t, "parquet", getBucketFileExtension(mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_PARQUET, "txt.gz"))
+}
+
+func Test_getBucketFileFormatFromPath(t *testing.T) {
+	require.Equal(t, mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_CSV, getBucketFileFormatFromPath("/exports/1.csv.gz"))
+	require.Equal(t, mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_PARQUET, getBucketFileFormatFromPath("/exports/1.parquet"))
+	require.Equal(t, mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_JSON_LINES, getBucketFileFormatFromPath("/exports/1.txt.gz"))
+}
+
+func Test_getParquetSchema(t *testing.T) {
+	schema := getParquetSchema(
+		[]string{"id", "name", "price", "active", "amount", "missing"},
+		map[string]*sqlmanager_shared.ColumnInfo{
+			"id":     {DataType: "bigint", IsNullable: false},
+			"name":   {DataType: "character varying(255)", IsNullable: true},
+			"price":  {DataType: "double precision", IsNullable: true},
+			"active": {DataType: "boolean", IsNullable: false},
+			"amount": {DataType: "numeric(10,2)", IsNullable: true},
+		},
+	)
+	require.Equal(t, []*neosync_benthos.ParquetSchemaField{
+		{Name: "id", Type: "INT64", Optional: false},
+		{Name: "name", Type: "UTF8", Optional: true},
+		{Name: "price", Type: "DOUBLE", Optional: true},
+		{Name: "active", Type: "BOOLEAN", Optional: false},
+		{Name: "amount", Type: "UTF8", Optional: true},
+		{Name: "missing", Type: "UTF8", Optional: true},
+	}, schema)
+}
+
+func Test_buildParquetCoercionMapping(t *testing.T) {
+	mapping := buildParquetCoercionMapping([]*neosync_benthos.ParquetSchemaField{
+		{Name: "id", Type: "INT64"},
+		{Name: "created at", Type: "UTF8", Optional: true},
+		{Name: "data", Type: "UTF8", Optional: true},
+		{Name: "active", Type: "BOOLEAN"},
+		{Name: "price", Type: "DOUBLE", Optional: true},
+	})
+	exe, err := bloblang.Parse(mapping)
+	require.NoError(t, err)
+
+	out, err := exe.Query(map[string]any{
+		"id":         int32(1),
+		"created at": time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		"data":       []byte("hello"),
+		"active":     true,
+		"price":      nil,
+		"extra":      "dropped",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"id":         int64(1),
+		"created at": "2024-01-02T03:04:05Z",
+		"data":       "hello",
+		"active":     true,
+		"price":      nil,
+	}, out)
+}
@@ -0,0 +1,154 @@
+package genbenthosconfigs_activity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+)
+
+const (
+	pathTemplateJobId     = "job_id"
+	pathTemplateJobName   = "job_name"
+	pathTemplateJobRunId  = "job_run_id"
+	pathTemplateSchema    = "schema"
+	pathTemplateTable     = "table"
+	pathTemplateDate      = "date"
+	pathTemplateYear      = "year"
+	pathTemplateMonth     = "month"
+	pathTemplateDay       = "day"
+	pathTemplateHour      = "hour"
+	pathTemplateTimestamp = "timestamp"
+	pathTemplateFileIndex = "file_index"
+	pathTemplateExtension = "extension"
+)
+
+var pathTemplatePlaceholderRegex = regexp.MustCompile(`\{\{\s*([a-z_]+)\s*\}\}`)
+
+// placeholders that are resolved by benthos each time an object is written
+var dynamicPathTemplatePlaceholders = map[string]string{
+	pathTemplateDate:      `${!now().ts_format("2006-01-02", "UTC")}`,
+	pathTemplateYear:      `${!now().ts_format("2006", "UTC")}`,
+	pathTemplateMonth:     `${!now().ts_format("01", "UTC")}`,
+	pathTemplateDay:       `${!now().ts_format("02", "UTC")}`,
+	pathTemplateHour:      `${!now().ts_format("15", "UTC")}`,
+	pathTemplateTimestamp: `${!timestamp_unix_nano()}`,
+	pathTemplateFileIndex: `${!count("files")}`,
+}
+
+var staticPathTemplatePlaceholders = map[string]struct{}{
+	pathTemplateJobId:     {},
+	pathTemplateJobName:   {},
+	pathTemplateJobRunId:  {},
+	pathTemplateSchema:    {},
+	pathTemplateTable:     {},
+	pathTemplateExtension: {},
+}
+
+type bucketPathValues struct {
+	JobId     string
+	JobName   string
+	JobRunId  string
+	Schema    string
+	Table     string
+	Extension string
+}
+
+func (v *bucketPathValues) get(placeholder string) string {
+	switch placeholder {
+	case pathTemplateJobId:
+		return v.JobId
+	case pathTemplateJobName:
+		return v.JobName
+	case pathTemplateJobRunId:
+		return v.JobRunId
+	case pathTemplateSchema:
+		return v.Schema
+	case pathTemplateTable:
+		return v.Table
+	case pathTemplateExtension:
+		return v.Extension
+	default:
+		return ""
+	}
+}
+
+// Validates a user defined object path template for bucket destinations.
+// Templates must include the table and a per object unique placeholder so that objects are never overwritten within a run
+func ValidateBucketPathTemplate(template string) error {
+	if strings.TrimSpace(strings.Trim(template, "/")) == "" {
+		return errors.New("path template must not be empty")
+	}
+	counts := map[string]int{}
+	for _, match := range pathTemplatePlaceholderRegex.FindAllStringSubmatch(template, -1) {
+		placeholder := match[1]
+		_, isStatic := staticPathTemplatePlaceholders[placeholder]
+		_, isDynamic := dynamicPathTemplatePlaceholders[placeholder]
+		if !isStatic && !isDynamic {
+			return fmt.Errorf("path template contains unknown placeholder: {{%s}}", placeholder)
+		}
+		counts[placeholder]++
+	}
+	if counts[pathTemplateTable] == 0 {
+		return fmt.Errorf("path template must include the {{%s}} placeholder", pathTemplateTable)
+	}
+	if counts[pathTemplateFileIndex] == 0 && counts[pathTemplateTimestamp] == 0 {
+		return fmt.Errorf("path template must include the {{%s}} or {{%s}} placeholder", pathTemplateFileIndex, pathTemplateTimestamp)
+	}
+	if counts[pathTemplateFileIndex] > 1 {
+		// every use of the counter increments it
+		return fmt.Errorf("path template may only include the {{%s}} placeholder once", pathTemplateFileIndex)
+	}
+	return nil
+}
+
+// Renders a path template into a benthos interpolated path
+func renderBucketPathTemplate(template string, values *bucketPathValues) (string, error) {
+	if err := ValidateBucketPathTemplate(template); err != nil {
+		return "", err
+	}
+	rendered := pathTemplatePlaceholderRegex.ReplaceAllStringFunc(template, func(match string) string {
+		placeholder := pathTemplatePlaceholderRegex.FindStringSubmatch(match)[1]
+		if interpolation, ok := dynamicPathTemplatePlaceholders[placeholder]; ok {
+			return interpolation
+		}
+		return values.get(placeholder)
+	})
+	return strings.TrimPrefix(rendered, "/"), nil
+}
+
+// Returns the benthos interpolated object path of a bucket destination.
+// Without a path template, objects are written to the layout that Neosync reads bucket sources from
+func getBucketObjectPath(
+	pathPrefix string,
+	pathTemplate *string,
+	defaultFileName string,
+	values *bucketPathValues,
+) (string, error) {
+	pathpieces := []string{}
+	if pathPrefix != "" {
+		pathpieces = append(pathpieces, strings.Trim(pathPrefix, "/"))
+	}
+
+	if pathTemplate != nil && *pathTemplate != "" {
+		rendered, err := renderBucketPathTemplate(*pathTemplate, values)
+		if err != nil {
+			return "", err
+		}
+		pathpieces = append(pathpieces, rendered)
+		return strings.Join(pathpieces, "/"), nil
+	}
+
+	pathpieces = append(
+		pathpieces,
+		"workflows",
+		values.JobRunId,
+		"activities",
+		neosync_benthos.BuildBenthosTable(values.Schema, values.Table),
+		"data",
+		defaultFileName,
+	)
+	return strings.Join(pathpieces, "/"), nil
+}
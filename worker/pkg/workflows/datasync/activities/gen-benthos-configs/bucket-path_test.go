@@ -0,0 +1,59 @@
+package genbenthosconfigs_activity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+
+	_ "github.com/warpstreamlabs/bento/public/components/pure"
+)
+
+func Test_ValidateBucketPathTemplate(t *testing.T) {
+	require.NoError(t, ValidateBucketPathTemplate("exports/{{date}}/{{table}}/part-{{file_index}}.{{extension}}"))
+	require.NoError(t, ValidateBucketPathTemplate("{{ job_name }}/{{schema}}.{{table}}-{{timestamp}}.{{extension}}"))
+
+	require.Error(t, ValidateBucketPathTemplate(""))
+	require.Error(t, ValidateBucketPathTemplate("/"))
+	require.Error(t, ValidateBucketPathTemplate("exports/{{table}}/{{unknown}}-{{file_index}}"))
+	require.Error(t, ValidateBucketPathTemplate("exports/part-{{file_index}}"), "missing table")
+	require.Error(t, ValidateBucketPathTemplate("exports/{{table}}/data.parquet"), "missing unique placeholder")
+	require.Error(t, ValidateBucketPathTemplate("{{table}}/{{file_index}}/{{file_index}}"), "file index used twice")
+}
+
+func Test_getBucketObjectPath(t *testing.T) {
+	values := &bucketPathValues{
+		JobId:     "job-id",
+		JobName:   "nightly-export",
+		JobRunId:  "run-id",
+		Schema:    "public",
+		Table:     "users",
+		Extension: "parquet",
+	}
+
+	t.Run("default", func(t *testing.T) {
+		path, err := getBucketObjectPath("/prefix/", nil, `${!count("files")}.parquet`, values)
+		require.NoError(t, err)
+		require.Equal(t, `prefix/workflows/run-id/activities/public.users/data/${!count("files")}.parquet`, path)
+	})
+
+	t.Run("template", func(t *testing.T) {
+		template := "/{{job_name}}/{{year}}/{{table}}/{{job_id}}-{{job_run_id}}-{{file_index}}.{{extension}}"
+		path, err := getBucketObjectPath("prefix", &template, "unused", values)
+		require.NoError(t, err)
+		require.Equal(t, `prefix/nightly-export/${!now().ts_format("2006", "UTC")}/users/job-id-run-id-${!count("files")}.parquet`, path)
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		template := "{{table}}.parquet"
+		_, err := getBucketObjectPath("", &template, "unused", values)
+		require.Error(t, err)
+	})
+}
+
+func Test_dynamicPathTemplatePlaceholders(t *testing.T) {
+	for placeholder, interpolation := range dynamicPathTemplatePlaceholders {
+		_, err := bloblang.Parse("root = " + interpolation[3:len(interpolation)-1])
+		require.NoError(t, err, placeholder)
+	}
+}
@@ -0,0 +1,136 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/profiler"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+func getProfilerOptions(opts *mgmtv1alpha1.GenerateSourceProfileOptions) *profiler.Options {
+	profilerOpts := &profiler.Options{
+		SampleSize:       profiler.DefaultSampleSize,
+		HistogramBuckets: profiler.DefaultHistogramBuckets,
+		TopK:             profiler.DefaultTopK,
+	}
+	if opts.SampleSize != nil {
+		profilerOpts.SampleSize = opts.GetSampleSize()
+	}
+	if opts.HistogramBuckets != nil {
+		profilerOpts.HistogramBuckets = int(opts.GetHistogramBuckets())
+	}
+	if opts.TopK != nil {
+		profilerOpts.TopK = int(opts.GetTopK())
+	}
+	return profilerOpts
+}
+
+// Returns a map of schema.table -> profile for every generated table.
+// The profile stored by a previous run is reused unless a refresh is requested, the profiler options have changed
+// or the profile is missing columns. Otherwise the table is sampled from the source and the new profile is stored under the job.
+func (b *benthosBuilder) getGenerateTableProfiles(
+	ctx context.Context,
+	db sqlmanager.SqlDatabase,
+	mappings []*tableMapping,
+	groupedSchemas map[string]map[string]*sqlmanager_shared.ColumnInfo,
+	opts *mgmtv1alpha1.GenerateSourceProfileOptions,
+	accountId string,
+	slogger *slog.Logger,
+) (map[string]*profiler.TableProfile, error) {
+	profilerOpts := getProfilerOptions(opts)
+
+	profiles := map[string]*profiler.TableProfile{}
+	for _, tableMapping := range mappings {
+		table := neosync_benthos.BuildBenthosTable(tableMapping.Schema, tableMapping.Table)
+		columnInfo, ok := groupedSchemas[table]
+		if !ok {
+			return nil, fmt.Errorf("unable to profile table %s as it does not exist in the source", table)
+		}
+
+		if !opts.GetRefresh() {
+			stored, err := b.getStoredTableProfile(ctx, accountId, table)
+			if err != nil {
+				return nil, err
+			}
+			if stored != nil && stored.IsCompatible(buildPlainColumns(tableMapping.Mappings), profilerOpts) {
+				profiles[table] = stored
+				continue
+			}
+		}
+
+		rows, err := db.GetTableRowSample(ctx, tableMapping.Schema, tableMapping.Table, profilerOpts.SampleSize)
+		if err != nil {
+			return nil, fmt.Errorf("unable to sample rows of table %s: %w", table, err)
+		}
+		if len(rows) == 0 {
+			slogger.Warn("table has no rows to profile, generating it without a profile", "table", table)
+			continue
+		}
+		profile := profiler.BuildTableProfile(tableMapping.Schema, tableMapping.Table, columnInfo, rows, profilerOpts)
+		err = b.setStoredTableProfile(ctx, accountId, table, profile)
+		if err != nil {
+			return nil, err
+		}
+		slogger.Info("profiled table for generation", "table", table, "sampledRows", len(rows))
+		profiles[table] = profile
+	}
+	return profiles, nil
+}
+
+func (b *benthosBuilder) getStoredTableProfile(
+	ctx context.Context,
+	accountId, table string,
+) (*profiler.TableProfile, error) {
+	resp, err := b.jobclient.GetRunContext(ctx, connect.NewRequest(&mgmtv1alpha1.GetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{
+			JobRunId:   b.jobId,
+			ExternalId: shared.GetGenerateProfileExternalId(table),
+			AccountId:  accountId,
+		},
+	}))
+	if err != nil && shared.IsRunContextNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to retrieve profile for %s: %w", table, err)
+	}
+	if len(resp.Msg.GetValue()) == 0 {
+		return nil, nil
+	}
+	var profile *profiler.TableProfile
+	err = json.Unmarshal(resp.Msg.GetValue(), &profile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal profile for %s: %w", table, err)
+	}
+	return profile, nil
+}
+
+func (b *benthosBuilder) setStoredTableProfile(
+	ctx context.Context,
+	accountId, table string,
+	profile *profiler.TableProfile,
+) error {
+	bits, err := json.Marshal(profile)
+	if err != nil {
+		return fmt.Errorf("unable to marshal profile for %s: %w", table, err)
+	}
+	_, err = b.jobclient.SetRunContext(ctx, connect.NewRequest(&mgmtv1alpha1.SetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{
+			JobRunId:   b.jobId,
+			ExternalId: shared.GetGenerateProfileExternalId(table),
+			AccountId:  accountId,
+		},
+		Value: bits,
+	}))
+	if err != nil {
+		return fmt.Errorf("unable to store profile for %s: %w", table, err)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/nucleuscloud/neosync/worker/pkg/profiler"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestProfileMappings() []*tableMapping {
+	passthrough := &mgmtv1alpha1.JobMappingTransformer{Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH}
+	return []*tableMapping{
+		{
+			Schema: "public",
+			Table:  "users",
+			Mappings: []*mgmtv1alpha1.JobMapping{
+				{Schema: "public", Table: "users", Column: "id", Transformer: passthrough},
+				{Schema: "public", Table: "users", Column: "status", Transformer: passthrough},
+			},
+		},
+	}
+}
+
+func getTestProfileRows() []map[string]any {
+	rows := []map[string]any{}
+	for i := 0; i < 20; i++ {
+		rows = append(rows, map[string]any{"id": int64(i + 1), "status": []string{"active", "inactive"}[i%2]})
+	}
+	return rows
+}
+
+func Test_getGenerateTableProfiles(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	groupedSchemas := map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users": {
+			"id":     {OrdinalPosition: 1, DataType: "integer"},
+			"status": {OrdinalPosition: 2, DataType: "text"},
+		},
+	}
+	defaultOpts := getProfilerOptions(&mgmtv1alpha1.GenerateSourceProfileOptions{})
+
+	t.Run("first run", func(t *testing.T) {
+		jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+		db := sqlmanager.NewMockSqlDatabase(t)
+		b := &benthosBuilder{jobclient: jobclient, jobId: mockJobId}
+
+		jobclient.On("GetRunContext", mock.Anything, mock.Anything).Return(nil, errors.New("no run context exists with the provided key"))
+		db.On("GetTableRowSample", mock.Anything, "public", "users", profiler.DefaultSampleSize).Return(getTestProfileRows(), nil)
+		jobclient.On("SetRunContext", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.SetRunContextRequest]) bool {
+			return req.Msg.GetId().GetJobRunId() == mockJobId &&
+				req.Msg.GetId().GetExternalId() == shared.GetGenerateProfileExternalId("public.users") &&
+				req.Msg.GetId().GetAccountId() == "account"
+		})).Return(connect.NewResponse(&mgmtv1alpha1.SetRunContextResponse{}), nil)
+
+		actual, err := b.getGenerateTableProfiles(ctx, db, getTestProfileMappings(), groupedSchemas, &mgmtv1alpha1.GenerateSourceProfileOptions{}, "account", logger)
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+		profile := actual["public.users"]
+		require.Equal(t, int64(20), profile.SampleSize)
+		require.Equal(t, profiler.DistributionSequence, profile.GetColumn("id").Distribution)
+		require.Equal(t, profiler.DistributionCategorical, profile.GetColumn("status").Distribution)
+	})
+
+	t.Run("stored profile", func(t *testing.T) {
+		jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+		db := sqlmanager.NewMockSqlDatabase(t)
+		b := &benthosBuilder{jobclient: jobclient, jobId: mockJobId}
+
+		stored := profiler.BuildTableProfile("public", "users", groupedSchemas["public.users"], getTestProfileRows(), defaultOpts)
+		storedBits, err := json.Marshal(stored)
+		require.NoError(t, err)
+		jobclient.On("GetRunContext", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetRunContextRequest{
+			Id: &mgmtv1alpha1.RunContextKey{
+				JobRunId:   mockJobId,
+				ExternalId: shared.GetGenerateProfileExternalId("public.users"),
+				AccountId:  "account",
+			},
+		})).Return(connect.NewResponse(&mgmtv1alpha1.GetRunContextResponse{Value: storedBits}), nil)
+
+		actual, err := b.getGenerateTableProfiles(ctx, db, getTestProfileMappings(), groupedSchemas, &mgmtv1alpha1.GenerateSourceProfileOptions{}, "account", logger)
+		require.NoError(t, err)
+		require.Equal(t, stored.CreatedAt, actual["public.users"].CreatedAt)
+		db.AssertNotCalled(t, "GetTableRowSample", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("stored profile with different options", func(t *testing.T) {
+		jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+		db := sqlmanager.NewMockSqlDatabase(t)
+		b := &benthosBuilder{jobclient: jobclient, jobId: mockJobId}
+
+		stored := profiler.BuildTableProfile("public", "users", groupedSchemas["public.users"], getTestProfileRows(), defaultOpts)
+		storedBits, err := json.Marshal(stored)
+		require.NoError(t, err)
+		jobclient.On("GetRunContext", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetRunContextResponse{Value: storedBits}), nil)
+		db.On("GetTableRowSample", mock.Anything, "public", "users", int64(100)).Return(getTestProfileRows(), nil)
+		jobclient.On("SetRunContext", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.SetRunContextResponse{}), nil)
+
+		sampleSize := int64(100)
+		actual, err := b.getGenerateTableProfiles(ctx, db, getTestProfileMappings(), groupedSchemas, &mgmtv1alpha1.GenerateSourceProfileOptions{SampleSize: &sampleSize}, "account", logger)
+		require.NoError(t, err)
+		require.Equal(t, int64(100), actual["public.users"].Options.SampleSize)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+		db := sqlmanager.NewMockSqlDatabase(t)
+		b := &benthosBuilder{jobclient: jobclient, jobId: mockJobId}
+
+		db.On("GetTableRowSample", mock.Anything, "public", "users", profiler.DefaultSampleSize).Return(getTestProfileRows(), nil)
+		jobclient.On("SetRunContext", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.SetRunContextResponse{}), nil)
+
+		actual, err := b.getGenerateTableProfiles(ctx, db, getTestProfileMappings(), groupedSchemas, &mgmtv1alpha1.GenerateSourceProfileOptions{Refresh: true}, "account", logger)
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+		jobclient.AssertNotCalled(t, "GetRunContext", mock.Anything, mock.Anything)
+	})
+
+	t.Run("empty table", func(t *testing.T) {
+		jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+		db := sqlmanager.NewMockSqlDatabase(t)
+		b := &benthosBuilder{jobclient: jobclient, jobId: mockJobId}
+
+		db.On("GetTableRowSample", mock.Anything, "public", "users", profiler.DefaultSampleSize).Return([]map[string]any{}, nil)
+
+		actual, err := b.getGenerateTableProfiles(ctx, db, getTestProfileMappings(), groupedSchemas, &mgmtv1alpha1.GenerateSourceProfileOptions{Refresh: true}, "account", logger)
+		require.NoError(t, err)
+		require.Empty(t, actual)
+	})
+
+	t.Run("missing table", func(t *testing.T) {
+		b := &benthosBuilder{jobId: mockJobId}
+		_, err := b.getGenerateTableProfiles(ctx, sqlmanager.NewMockSqlDatabase(t), getTestProfileMappings(), map[string]map[string]*sqlmanager_shared.ColumnInfo{}, &mgmtv1alpha1.GenerateSourceProfileOptions{}, "account", logger)
+		require.Error(t, err)
+	})
+}
+
+func Test_buildBenthosGenerateSourceConfigResponses_Profile(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	mappings := getTestProfileMappings()
+	groupedSchemas := map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users": {
+			"id":     {OrdinalPosition: 1, DataType: "integer"},
+			"status": {OrdinalPosition: 2, DataType: "text"},
+		},
+	}
+	colTransformers := map[string]map[string]*mgmtv1alpha1.JobMappingTransformer{
+		"public.users": {"id": mappings[0].Mappings[0].Transformer, "status": mappings[0].Mappings[1].Transformer},
+	}
+	profile := profiler.BuildTableProfile("public", "users", groupedSchemas["public.users"], getTestProfileRows(), getProfilerOptions(&mgmtv1alpha1.GenerateSourceProfileOptions{}))
+
+	actual, err := buildBenthosGenerateSourceConfigResponses(
+		logger, context.Background(), nil, mappings,
+		map[string]*generateSourceTableOptions{"public.users": {Count: 10}},
+		groupedSchemas, colTransformers,
+		map[string]*profiler.TableProfile{"public.users": profile},
+		sqlmanager_shared.PostgresDriver, "postgres",
+	)
+	require.NoError(t, err)
+	require.Len(t, actual, 1)
+	input := actual[0].Config.Input
+	require.Nil(t, input.Generate)
+	require.NotNil(t, input.ProfileGenerate)
+	require.Equal(t, 10, input.ProfileGenerate.Count)
+	for _, processor := range actual[0].Processors {
+		require.Nil(t, processor.Mutation, "passthrough columns should keep the values sampled from the profile")
+	}
+
+	actual, err = buildBenthosGenerateSourceConfigResponses(
+		logger, context.Background(), nil, mappings,
+		map[string]*generateSourceTableOptions{"public.users": {Count: 10}},
+		groupedSchemas, colTransformers, nil,
+		sqlmanager_shared.PostgresDriver, "postgres",
+	)
+	require.NoError(t, err)
+	require.NotNil(t, actual[0].Config.Input.Generate)
+	require.Nil(t, actual[0].Config.Input.ProfileGenerate)
+}
@@ -11,6 +11,8 @@ import (
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	profilegenerate "github.com/nucleuscloud/neosync/worker/pkg/benthos/profile_generate"
+	"github.com/nucleuscloud/neosync/worker/pkg/profiler"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 )
 
@@ -48,7 +50,15 @@ func (b *benthosBuilder) getGenerateBenthosConfigResponses(
 		return nil, fmt.Errorf("unable to get database schema for connection: %w", err)
 	}
 
-	sourceResponses, err := buildBenthosGenerateSourceConfigResponses(slogger, ctx, b.transformerclient, groupedMappings, sourceTableOpts, groupedSchemas, colTransformerMap, db.Driver, sourceConnectionType)
+	var tableProfiles map[string]*profiler.TableProfile
+	if sourceOptions.GetProfile() != nil {
+		tableProfiles, err = b.getGenerateTableProfiles(ctx, db.Db, groupedMappings, groupedSchemas, sourceOptions.GetProfile(), job.GetAccountId(), slogger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to profile source tables: %w", err)
+		}
+	}
+
+	sourceResponses, err := buildBenthosGenerateSourceConfigResponses(slogger, ctx, b.transformerclient, groupedMappings, sourceTableOpts, groupedSchemas, colTransformerMap, tableProfiles, db.Driver, sourceConnectionType)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build benthos generate source config responses: %w", err)
 	}
@@ -68,6 +78,7 @@ func buildBenthosGenerateSourceConfigResponses(
 	sourceTableOpts map[string]*generateSourceTableOptions,
 	groupedcolumnInfo map[string]map[string]*sqlmanager_shared.ColumnInfo,
 	groupedColTransformers map[string]map[string]*mgmtv1alpha1.JobMappingTransformer,
+	tableProfiles map[string]*profiler.TableProfile,
 	driver string,
 	sourceConnectionType string,
 ) ([]*BenthosConfigResponse, error) {
@@ -99,13 +110,26 @@ func buildBenthosGenerateSourceConfigResponses(
 		if err != nil {
 			return nil, err
 		}
+		input, err := buildGenerateInput(count, tableProfiles[tableName])
+		if err != nil {
+			return nil, err
+		}
+
 		var processors []*neosync_benthos.ProcessorConfig
-		// for the generate input, benthos requires a mapping, so falling back to a
-		// generic empty object if the mutations are empty
-		if mutations == "" {
-			mutations = "root = {}"
+		if input.ProfileGenerate != nil {
+			// rows sampled from the profile already contain every column, the mutations only override the
+			// columns that have a transformer
+			if mutations != "" {
+				processors = append(processors, &neosync_benthos.ProcessorConfig{Mutation: &mutations})
+			}
+		} else {
+			// for the generate input, benthos requires a mapping, so falling back to a
+			// generic empty object if the mutations are empty
+			if mutations == "" {
+				mutations = "root = {}"
+			}
+			processors = append(processors, &neosync_benthos.ProcessorConfig{Mutation: &mutations})
 		}
-		processors = append(processors, &neosync_benthos.ProcessorConfig{Mutation: &mutations})
 
 		if jsCode != "" {
 			processors = append(processors, &neosync_benthos.ProcessorConfig{NeosyncJavascript: &neosync_benthos.NeosyncJavascriptConfig{Code: jsCode}})
@@ -122,13 +146,7 @@ func buildBenthosGenerateSourceConfigResponses(
 		bc := &neosync_benthos.BenthosConfig{
 			StreamConfig: neosync_benthos.StreamConfig{
 				Input: &neosync_benthos.InputConfig{
-					Inputs: neosync_benthos.Inputs{
-						Generate: &neosync_benthos.Generate{
-							Interval: "",
-							Count:    count,
-							Mapping:  "root = {}",
-						},
-					},
+					Inputs: *input,
 				},
 				Pipeline: &neosync_benthos.PipelineConfig{
 					Threads:    -1,
@@ -175,6 +193,29 @@ func buildBenthosGenerateSourceConfigResponses(
 	return responses, nil
 }
 
+// Builds the input that generates the rows of a table. Rows are sampled from the profile of the table if it has one.
+func buildGenerateInput(count int, profile *profiler.TableProfile) (*neosync_benthos.Inputs, error) {
+	if profile == nil {
+		return &neosync_benthos.Inputs{
+			Generate: &neosync_benthos.Generate{
+				Interval: "",
+				Count:    count,
+				Mapping:  "root = {}",
+			},
+		}, nil
+	}
+	encodedProfile, err := profilegenerate.EncodeProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+	return &neosync_benthos.Inputs{
+		ProfileGenerate: &neosync_benthos.ProfileGenerate{
+			Profile: encodedProfile,
+			Count:   count,
+		},
+	}, nil
+}
+
 func (b *benthosBuilder) getSqlGenerateOutput(
 	driver string,
 	benthosConfig *BenthosConfigResponse,
@@ -0,0 +1,142 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	querybuilder "github.com/nucleuscloud/neosync/worker/pkg/query-builder2"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+type tableWatermark struct {
+	Column string
+	// The watermark recorded by the last successful run. Nil if the table has not been incrementally synced before
+	PreviousValue *string
+	// The max value of the watermark column when the configs were generated. Nil if the table has no rows to sync
+	CurrentValue *string
+}
+
+// Returns a map of schema.table -> watermark for every table that is configured to sync incrementally
+func (b *benthosBuilder) getTableWatermarks(
+	ctx context.Context,
+	db sqlmanager.SqlDatabase,
+	sourceTableOpts map[string]*sqlSourceTableOptions,
+	groupedSchemas map[string]map[string]*sqlmanager_shared.ColumnInfo,
+	accountId string,
+	slogger *slog.Logger,
+) (map[string]*tableWatermark, error) {
+	return getTableWatermarks(ctx, db, sourceTableOpts, groupedSchemas, func(ctx context.Context, table string) (*shared.TableWatermark, error) {
+		return b.getPreviousTableWatermark(ctx, accountId, table)
+	}, slogger)
+}
+
+func getTableWatermarks(
+	ctx context.Context,
+	db sqlmanager.SqlDatabase,
+	sourceTableOpts map[string]*sqlSourceTableOptions,
+	groupedSchemas map[string]map[string]*sqlmanager_shared.ColumnInfo,
+	getPreviousWatermark PreviousWatermarkGetter,
+	slogger *slog.Logger,
+) (map[string]*tableWatermark, error) {
+	watermarks := map[string]*tableWatermark{}
+	for table, opts := range sourceTableOpts {
+		if opts == nil || opts.WatermarkColumn == nil || *opts.WatermarkColumn == "" {
+			continue
+		}
+		column := *opts.WatermarkColumn
+		if _, ok := groupedSchemas[table][column]; !ok {
+			return nil, fmt.Errorf("watermark column %q does not exist in table %s", column, table)
+		}
+
+		previous, err := getPreviousWatermark(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		var previousValue *string
+		if previous != nil {
+			if previous.Column == column {
+				previousValue = &previous.Value
+			} else {
+				slogger.Info("watermark column has changed since the previous run, syncing the full table", "table", table, "previousColumn", previous.Column, "column", column)
+			}
+		}
+
+		schema, tableName := sqlmanager_shared.SplitTableKey(table)
+		currentValue, err := db.GetTableColumnMaxValue(ctx, schema, tableName, column, opts.WhereClause)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get max value of watermark column %q for table %s: %w", column, table, err)
+		}
+
+		watermarks[table] = &tableWatermark{
+			Column:        column,
+			PreviousValue: previousValue,
+			CurrentValue:  currentValue,
+		}
+	}
+	return watermarks, nil
+}
+
+func (b *benthosBuilder) getPreviousTableWatermark(
+	ctx context.Context,
+	accountId, table string,
+) (*shared.TableWatermark, error) {
+	resp, err := b.jobclient.GetRunContext(ctx, connect.NewRequest(&mgmtv1alpha1.GetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{
+			JobRunId:   b.jobId,
+			ExternalId: shared.GetWatermarkExternalId(table),
+			AccountId:  accountId,
+		},
+	}))
+	if err != nil && shared.IsRunContextNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to retrieve watermark for %s: %w", table, err)
+	}
+	if len(resp.Msg.GetValue()) == 0 {
+		return nil, nil
+	}
+	var watermark *shared.TableWatermark
+	err = json.Unmarshal(resp.Msg.GetValue(), &watermark)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal watermark for %s: %w", table, err)
+	}
+	return watermark, nil
+}
+
+// Returns a map of schema.table -> where clause that selects the rows between the previous and current watermarks
+func buildTableWatermarkWhereClauses(
+	driver string,
+	watermarks map[string]*tableWatermark,
+) (map[string]string, error) {
+	whereClauses := map[string]string{}
+	for table, watermark := range watermarks {
+		whereClause, err := querybuilder.BuildIncrementalWhereClause(driver, watermark.Column, watermark.PreviousValue, watermark.CurrentValue)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build watermark where clause for table %s: %w", table, err)
+		}
+		if whereClause != "" {
+			whereClauses[table] = whereClause
+		}
+	}
+	return whereClauses, nil
+}
+
+func buildPostTableSyncWatermark(jobId string, bc *BenthosConfigResponse) *shared.PostTableSyncWatermark {
+	if bc.watermark == nil || bc.watermark.CurrentValue == nil || bc.RunType != tabledependency.RunTypeInsert {
+		return nil
+	}
+	return &shared.PostTableSyncWatermark{
+		JobId:  jobId,
+		Table:  neosync_benthos.BuildBenthosTable(bc.TableSchema, bc.TableName),
+		Column: bc.watermark.Column,
+		Value:  *bc.watermark.CurrentValue,
+	}
+}
@@ -0,0 +1,139 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getTableWatermarks(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	groupedSchemas := map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users":  {"id": {}, "updated_at": {}},
+		"public.orders": {"id": {}},
+	}
+
+	t.Run("previous watermark", func(t *testing.T) {
+		jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+		db := sqlmanager.NewMockSqlDatabase(t)
+		b := &benthosBuilder{jobclient: jobclient, jobId: mockJobId}
+
+		prevBits, err := json.Marshal(&shared.TableWatermark{Column: "updated_at", Value: "2024-01-01"})
+		require.NoError(t, err)
+		jobclient.On("GetRunContext", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetRunContextRequest{
+			Id: &mgmtv1alpha1.RunContextKey{
+				JobRunId:   mockJobId,
+				ExternalId: shared.GetWatermarkExternalId("public.users"),
+				AccountId:  "account",
+			},
+		})).Return(connect.NewResponse(&mgmtv1alpha1.GetRunContextResponse{Value: prevBits}), nil)
+		maxValue := "2024-02-01"
+		db.On("GetTableColumnMaxValue", mock.Anything, "public", "users", "updated_at", (*string)(nil)).Return(&maxValue, nil)
+
+		watermarkCol := "updated_at"
+		actual, err := b.getTableWatermarks(ctx, db, map[string]*sqlSourceTableOptions{
+			"public.users":  {WatermarkColumn: &watermarkCol},
+			"public.orders": {},
+		}, groupedSchemas, "account", logger)
+		require.NoError(t, err)
+		prevValue := "2024-01-01"
+		require.Equal(t, map[string]*tableWatermark{
+			"public.users": {Column: "updated_at", PreviousValue: &prevValue, CurrentValue: &maxValue},
+		}, actual)
+	})
+
+	t.Run("first run", func(t *testing.T) {
+		jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+		db := sqlmanager.NewMockSqlDatabase(t)
+		b := &benthosBuilder{jobclient: jobclient, jobId: mockJobId}
+
+		jobclient.On("GetRunContext", mock.Anything, mock.Anything).Return(nil, errors.New("no run context exists with the provided key"))
+		maxValue := "10"
+		where := "id > 5"
+		db.On("GetTableColumnMaxValue", mock.Anything, "public", "users", "id", &where).Return(&maxValue, nil)
+
+		watermarkCol := "id"
+		actual, err := b.getTableWatermarks(ctx, db, map[string]*sqlSourceTableOptions{
+			"public.users": {WatermarkColumn: &watermarkCol, WhereClause: &where},
+		}, groupedSchemas, "account", logger)
+		require.NoError(t, err)
+		require.Equal(t, map[string]*tableWatermark{
+			"public.users": {Column: "id", CurrentValue: &maxValue},
+		}, actual)
+	})
+
+	t.Run("watermark column changed", func(t *testing.T) {
+		jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+		db := sqlmanager.NewMockSqlDatabase(t)
+		b := &benthosBuilder{jobclient: jobclient, jobId: mockJobId}
+
+		prevBits, err := json.Marshal(&shared.TableWatermark{Column: "updated_at", Value: "2024-01-01"})
+		require.NoError(t, err)
+		jobclient.On("GetRunContext", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetRunContextResponse{Value: prevBits}), nil)
+		db.On("GetTableColumnMaxValue", mock.Anything, "public", "users", "id", (*string)(nil)).Return(nil, nil)
+
+		watermarkCol := "id"
+		actual, err := b.getTableWatermarks(ctx, db, map[string]*sqlSourceTableOptions{
+			"public.users": {WatermarkColumn: &watermarkCol},
+		}, groupedSchemas, "account", logger)
+		require.NoError(t, err)
+		require.Equal(t, map[string]*tableWatermark{
+			"public.users": {Column: "id"},
+		}, actual)
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		b := &benthosBuilder{jobId: mockJobId}
+		watermarkCol := "updated_at"
+		_, err := b.getTableWatermarks(ctx, sqlmanager.NewMockSqlDatabase(t), map[string]*sqlSourceTableOptions{
+			"public.orders": {WatermarkColumn: &watermarkCol},
+		}, groupedSchemas, "account", logger)
+		require.Error(t, err)
+	})
+}
+
+func Test_buildTableWatermarkWhereClauses(t *testing.T) {
+	prevValue := "1"
+	maxValue := "10"
+	actual, err := buildTableWatermarkWhereClauses(sqlmanager_shared.PostgresDriver, map[string]*tableWatermark{
+		"public.users":  {Column: "id", PreviousValue: &prevValue, CurrentValue: &maxValue},
+		"public.orders": {Column: "id"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"public.users": `(("id" > '1') AND ("id" <= '10'))`,
+	}, actual)
+}
+
+func Test_buildPostTableSyncWatermark(t *testing.T) {
+	maxValue := "10"
+	bc := &BenthosConfigResponse{
+		RunType:     tabledependency.RunTypeInsert,
+		TableSchema: "public",
+		TableName:   "users",
+		watermark:   &tableWatermark{Column: "id", CurrentValue: &maxValue},
+	}
+	require.Equal(t, &shared.PostTableSyncWatermark{
+		JobId:  mockJobId,
+		Table:  "public.users",
+		Column: "id",
+		Value:  "10",
+	}, buildPostTableSyncWatermark(mockJobId, bc))
+
+	require.Nil(t, buildPostTableSyncWatermark(mockJobId, &BenthosConfigResponse{RunType: tabledependency.RunTypeInsert, watermark: &tableWatermark{Column: "id"}}))
+	require.Nil(t, buildPostTableSyncWatermark(mockJobId, &BenthosConfigResponse{RunType: tabledependency.RunTypeInsert}))
+}
@@ -0,0 +1,222 @@
+package genbenthosconfigs_activity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	mongoFindOperation      = "find"
+	mongoAggregateOperation = "aggregate"
+
+	mongoDefaultReferencedField = "_id"
+	mongoReferenceFieldPrefix   = "__neosync_ref_"
+)
+
+// The query that is used to read a single collection
+type mongoCollectionQuery struct {
+	Operation string
+	// Bloblang mapping that results in the filter document (find) or the pipeline stages (aggregate)
+	Query string
+	// Only set for find operations, aggregate operations carry the limit as a stage
+	Limit *int32
+}
+
+type mongoReferenceEdge struct {
+	// the referencing collection
+	From      string
+	Reference *mgmtv1alpha1.MongoDBCollectionReference
+}
+
+type mongoSubsetBuilder struct {
+	filters      map[string]json.RawMessage
+	limits       map[string]uint32
+	referencedBy map[string][]*mongoReferenceEdge
+}
+
+func newMongoSubsetBuilder(opts []*mgmtv1alpha1.MongoDBSourceCollectionOption) (*mongoSubsetBuilder, error) {
+	builder := &mongoSubsetBuilder{
+		filters:      map[string]json.RawMessage{},
+		limits:       map[string]uint32{},
+		referencedBy: map[string][]*mongoReferenceEdge{},
+	}
+	for _, opt := range opts {
+		key := toMongoCollectionKey(opt.GetDatabase(), opt.GetCollection())
+		if opt.Filter != nil && strings.TrimSpace(opt.GetFilter()) != "" {
+			filter, err := parseMongoFilter(opt.GetFilter())
+			if err != nil {
+				return nil, fmt.Errorf("invalid filter for mongodb collection %s: %w", key, err)
+			}
+			builder.filters[key] = filter
+		}
+		if opt.Limit != nil {
+			builder.limits[key] = opt.GetLimit()
+		}
+		for _, ref := range opt.GetReferences() {
+			referencedKey := toMongoCollectionKey(opt.GetDatabase(), ref.GetReferencedCollection())
+			builder.referencedBy[referencedKey] = append(builder.referencedBy[referencedKey], &mongoReferenceEdge{From: opt.GetCollection(), Reference: ref})
+		}
+	}
+	for _, opt := range opts {
+		err := builder.checkForCycles(opt.GetDatabase(), opt.GetCollection(), []string{})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return builder, nil
+}
+
+// Returns the query used to read the collection.
+// Collections that are only filtered are read with a find, while collections that are subset by the collections that reference them
+// are read with an aggregate that only keeps documents that are referenced by the subset of at least one referencing collection.
+func (b *mongoSubsetBuilder) BuildQuery(database, collection string) (*mongoCollectionQuery, error) {
+	key := toMongoCollectionKey(database, collection)
+	if !b.isSubsetByReferences(database, collection) {
+		query := &mongoCollectionQuery{Operation: mongoFindOperation, Query: "root = this"}
+		if filter, ok := b.filters[key]; ok {
+			query.Query = toParseJsonMapping(filter)
+		}
+		if limit, ok := b.limits[key]; ok {
+			query.Limit = toInt32Limit(limit)
+		}
+		return query, nil
+	}
+
+	stages := b.buildMatchStages(database, collection, 0)
+	if limit, ok := b.limits[key]; ok {
+		stages = append(stages, map[string]any{"$limit": limit})
+	}
+	pipeline, err := json.Marshal(stages)
+	if err != nil {
+		return nil, err
+	}
+	return &mongoCollectionQuery{Operation: mongoAggregateOperation, Query: toParseJsonMapping(pipeline)}, nil
+}
+
+// Subsetting follows references from a referenced collection to the collections that reference it, which can not loop back around
+func (b *mongoSubsetBuilder) checkForCycles(database, collection string, path []string) error {
+	key := toMongoCollectionKey(database, collection)
+	for _, visited := range path {
+		if visited == key {
+			return fmt.Errorf("circular mongodb collection references are not supported: %s -> %s", strings.Join(path, " -> "), key)
+		}
+	}
+	path = append(path, key)
+	for _, edge := range b.referencedBy[key] {
+		if err := b.checkForCycles(database, edge.From, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A collection is restricted if it is filtered or if it is subset by the collections that reference it
+func (b *mongoSubsetBuilder) isRestricted(database, collection string) bool {
+	if _, ok := b.filters[toMongoCollectionKey(database, collection)]; ok {
+		return true
+	}
+	return b.isSubsetByReferences(database, collection)
+}
+
+// A collection is only subset by its references if every collection that references it is restricted.
+// Otherwise every document in the collection may be referenced and it is read in full.
+func (b *mongoSubsetBuilder) isSubsetByReferences(database, collection string) bool {
+	edges := b.referencedBy[toMongoCollectionKey(database, collection)]
+	if len(edges) == 0 {
+		return false
+	}
+	for _, edge := range edges {
+		if !b.isRestricted(database, edge.From) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the aggregate stages that select the subset of the collection.
+// Each referencing collection is joined with a $lookup whose pipeline selects the referencing collection's own subset.
+func (b *mongoSubsetBuilder) buildMatchStages(database, collection string, depth int) []any {
+	key := toMongoCollectionKey(database, collection)
+	stages := []any{}
+	if filter, ok := b.filters[key]; ok {
+		stages = append(stages, map[string]any{"$match": filter})
+	}
+
+	if !b.isSubsetByReferences(database, collection) {
+		return stages
+	}
+
+	edges := b.referencedBy[key]
+	refVar := "ref" + strconv.Itoa(depth)
+	refFields := make([]string, 0, len(edges))
+	anyReferenced := make([]any, 0, len(edges))
+	for idx, edge := range edges {
+		referencingStages := b.buildMatchStages(database, edge.From, depth+1)
+		referencedField := mongoDefaultReferencedField
+		if edge.Reference.GetReferencedField() != "" {
+			referencedField = edge.Reference.GetReferencedField()
+		}
+
+		lookupPipeline := []any{
+			map[string]any{"$match": map[string]any{"$expr": map[string]any{"$eq": []any{"$" + edge.Reference.GetField(), "$$" + refVar}}}},
+		}
+		lookupPipeline = append(lookupPipeline, referencingStages...)
+		lookupPipeline = append(lookupPipeline,
+			map[string]any{"$limit": 1},
+			map[string]any{"$project": map[string]any{"_id": 1}},
+		)
+
+		refField := mongoReferenceFieldPrefix + strconv.Itoa(idx)
+		refFields = append(refFields, refField)
+		stages = append(stages, map[string]any{
+			"$lookup": map[string]any{
+				"from":     edge.From,
+				"let":      map[string]any{refVar: "$" + referencedField},
+				"pipeline": lookupPipeline,
+				"as":       refField,
+			},
+		})
+		anyReferenced = append(anyReferenced, map[string]any{refField + ".0": map[string]any{"$exists": true}})
+	}
+	stages = append(stages,
+		map[string]any{"$match": map[string]any{"$or": anyReferenced}},
+		map[string]any{"$unset": refFields},
+	)
+	return stages
+}
+
+// Validates that the filter is a MongoDB Extended JSON document
+func parseMongoFilter(filter string) (json.RawMessage, error) {
+	var doc bson.D
+	if err := bson.UnmarshalExtJSON([]byte(filter), false, &doc); err != nil {
+		return nil, err
+	}
+	var raw json.RawMessage
+	if err := json.Unmarshal([]byte(filter), &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// The JSON is parsed by the mapping instead of being written as a bloblang literal so that any valid JSON is supported
+func toParseJsonMapping(value []byte) string {
+	return fmt.Sprintf("root = %s.parse_json()", strconv.Quote(string(value)))
+}
+
+func toInt32Limit(limit uint32) *int32 {
+	if limit > math.MaxInt32 {
+		limit = math.MaxInt32
+	}
+	converted := int32(limit) //nolint:gosec
+	return &converted
+}
+
+func toMongoCollectionKey(database, collection string) string {
+	return fmt.Sprintf("%s.%s", database, collection)
+}
@@ -0,0 +1,168 @@
+package genbenthosconfigs_activity
+
+import (
+	"encoding/json"
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func Test_mongoSubsetBuilder_BuildQuery_NoOptions(t *testing.T) {
+	builder, err := newMongoSubsetBuilder(nil)
+	require.NoError(t, err)
+
+	query, err := builder.BuildQuery("shop", "orders")
+	require.NoError(t, err)
+	require.Equal(t, &mongoCollectionQuery{Operation: mongoFindOperation, Query: "root = this"}, query)
+}
+
+func Test_mongoSubsetBuilder_BuildQuery_FilterAndLimit(t *testing.T) {
+	builder, err := newMongoSubsetBuilder([]*mgmtv1alpha1.MongoDBSourceCollectionOption{
+		{
+			Database:   "shop",
+			Collection: "orders",
+			Filter:     shared.Ptr(`{"customerId": {"$oid": "5f0c3b1f2a1e4b6a7c8d9e0f"}}`),
+			Limit:      shared.Ptr(uint32(500)),
+		},
+	})
+	require.NoError(t, err)
+
+	query, err := builder.BuildQuery("shop", "orders")
+	require.NoError(t, err)
+	require.Equal(t, mongoFindOperation, query.Operation)
+	require.Equal(t, int32(500), *query.Limit)
+	require.Equal(t, map[string]any{"customerId": map[string]any{"$oid": "5f0c3b1f2a1e4b6a7c8d9e0f"}}, execMongoQueryMapping(t, query.Query))
+}
+
+func Test_mongoSubsetBuilder_BuildQuery_InvalidFilter(t *testing.T) {
+	_, err := newMongoSubsetBuilder([]*mgmtv1alpha1.MongoDBSourceCollectionOption{
+		{Database: "shop", Collection: "orders", Filter: shared.Ptr(`{"customerId": `)},
+	})
+	require.Error(t, err)
+
+	_, err = newMongoSubsetBuilder([]*mgmtv1alpha1.MongoDBSourceCollectionOption{
+		{Database: "shop", Collection: "orders", Filter: shared.Ptr(`[{"customerId": 1}]`)},
+	})
+	require.Error(t, err)
+}
+
+func Test_mongoSubsetBuilder_BuildQuery_References(t *testing.T) {
+	builder, err := newMongoSubsetBuilder([]*mgmtv1alpha1.MongoDBSourceCollectionOption{
+		{
+			Database:   "shop",
+			Collection: "orders",
+			Filter:     shared.Ptr(`{"region": "us-east-1"}`),
+			References: []*mgmtv1alpha1.MongoDBCollectionReference{
+				{Field: "customerId", ReferencedCollection: "customers"},
+			},
+		},
+		{
+			Database:   "shop",
+			Collection: "customers",
+			Limit:      shared.Ptr(uint32(10)),
+			References: []*mgmtv1alpha1.MongoDBCollectionReference{
+				{Field: "address.countryCode", ReferencedCollection: "countries", ReferencedField: shared.Ptr("code")},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	query, err := builder.BuildQuery("shop", "orders")
+	require.NoError(t, err)
+	require.Equal(t, mongoFindOperation, query.Operation)
+
+	query, err = builder.BuildQuery("shop", "customers")
+	require.NoError(t, err)
+	require.Equal(t, mongoAggregateOperation, query.Operation)
+	require.Nil(t, query.Limit)
+	require.Equal(t, []any{
+		map[string]any{"$lookup": map[string]any{
+			"from": "orders",
+			"let":  map[string]any{"ref0": "$_id"},
+			"pipeline": []any{
+				map[string]any{"$match": map[string]any{"$expr": map[string]any{"$eq": []any{"$customerId", "$$ref0"}}}},
+				map[string]any{"$match": map[string]any{"region": "us-east-1"}},
+				map[string]any{"$limit": 1.0},
+				map[string]any{"$project": map[string]any{"_id": 1.0}},
+			},
+			"as": "__neosync_ref_0",
+		}},
+		map[string]any{"$match": map[string]any{"$or": []any{map[string]any{"__neosync_ref_0.0": map[string]any{"$exists": true}}}}},
+		map[string]any{"$unset": []any{"__neosync_ref_0"}},
+		map[string]any{"$limit": 10.0},
+	}, execMongoQueryMapping(t, query.Query))
+
+	// countries is subset transitively through customers, which nests the orders lookup
+	query, err = builder.BuildQuery("shop", "countries")
+	require.NoError(t, err)
+	require.Equal(t, mongoAggregateOperation, query.Operation)
+	stages, ok := execMongoQueryMapping(t, query.Query).([]any)
+	require.True(t, ok)
+	require.Len(t, stages, 3)
+	lookup := stages[0].(map[string]any)["$lookup"].(map[string]any)
+	require.Equal(t, "customers", lookup["from"])
+	require.Equal(t, map[string]any{"ref0": "$code"}, lookup["let"])
+	lookupPipeline := lookup["pipeline"].([]any)
+	require.Equal(t, map[string]any{"$match": map[string]any{"$expr": map[string]any{"$eq": []any{"$address.countryCode", "$$ref0"}}}}, lookupPipeline[0])
+	nestedLookup := lookupPipeline[1].(map[string]any)["$lookup"].(map[string]any)
+	require.Equal(t, "orders", nestedLookup["from"])
+	require.Equal(t, map[string]any{"ref1": "$_id"}, nestedLookup["let"])
+}
+
+func Test_mongoSubsetBuilder_BuildQuery_UnrestrictedReferencingCollection(t *testing.T) {
+	builder, err := newMongoSubsetBuilder([]*mgmtv1alpha1.MongoDBSourceCollectionOption{
+		{
+			Database:   "shop",
+			Collection: "orders",
+			Filter:     shared.Ptr(`{"region": "us-east-1"}`),
+			References: []*mgmtv1alpha1.MongoDBCollectionReference{{Field: "customerId", ReferencedCollection: "customers"}},
+		},
+		{
+			Database:   "shop",
+			Collection: "reviews",
+			References: []*mgmtv1alpha1.MongoDBCollectionReference{{Field: "customerId", ReferencedCollection: "customers"}},
+		},
+	})
+	require.NoError(t, err)
+
+	// reviews is read in full so any customer may be referenced
+	query, err := builder.BuildQuery("shop", "customers")
+	require.NoError(t, err)
+	require.Equal(t, &mongoCollectionQuery{Operation: mongoFindOperation, Query: "root = this"}, query)
+}
+
+func Test_newMongoSubsetBuilder_CircularReferences(t *testing.T) {
+	_, err := newMongoSubsetBuilder([]*mgmtv1alpha1.MongoDBSourceCollectionOption{
+		{
+			Database:   "hr",
+			Collection: "employees",
+			Filter:     shared.Ptr(`{"department": "eng"}`),
+			References: []*mgmtv1alpha1.MongoDBCollectionReference{{Field: "managerId", ReferencedCollection: "employees"}},
+		},
+	})
+	require.ErrorContains(t, err, "circular mongodb collection references")
+}
+
+func Test_toInt32Limit(t *testing.T) {
+	require.Equal(t, int32(25), *toInt32Limit(25))
+	require.Equal(t, int32(2147483647), *toInt32Limit(4294967295))
+}
+
+func execMongoQueryMapping(t *testing.T, mapping string) any {
+	t.Helper()
+	exec, err := bloblang.Parse(mapping)
+	require.NoError(t, err)
+	result, err := exec.Query(struct{}{})
+	require.NoError(t, err)
+
+	// the query must also be valid extended json as that is how the mongodb input converts it to bson
+	bits, err := json.Marshal(map[string]any{"query": result})
+	require.NoError(t, err)
+	var doc bson.D
+	require.NoError(t, bson.UnmarshalExtJSON(bits, false, &doc))
+	return result
+}
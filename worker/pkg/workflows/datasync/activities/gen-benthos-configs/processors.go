@@ -432,6 +432,8 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *sqlmanager_s
 	}
 
 	formattedColPath := getBenthosColumnKey(col.Column, splitColumnPath)
+	valueArg := fmt.Sprintf("this.%s", formattedColPath)
+	deterministic := col.GetTransformer().GetConfig().GetDeterministic()
 
 	switch col.Transformer.Source {
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CATEGORICAL:
@@ -462,23 +464,37 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *sqlmanager_s
 			invalidEmailAction = mgmtv1alpha1.InvalidEmailAction_INVALID_EMAIL_ACTION_REJECT
 		}
 
-		return fmt.Sprintf(
-			"transform_email(value:this.%s,preserve_domain:%t,preserve_length:%t,excluded_domains:%v,max_length:%d,email_type:%q,invalid_email_action:%q)",
-			formattedColPath, pd, pl, excludedDomainsStr, maxLen, dtoEmailTypeToBenthosEmailType(emailType), dtoInvalidEmailActionToBenthosInvalidEmailAction(invalidEmailAction),
-		), nil
+		return newBloblangFunction("transform_email").
+			withArg("value", valueArg).
+			withArgf("preserve_domain", "%t", pd).
+			withArgf("preserve_length", "%t", pl).
+			withArg("excluded_domains", excludedDomainsStr).
+			withArgf("max_length", "%d", maxLen).
+			withArgf("email_type", "%q", dtoEmailTypeToBenthosEmailType(emailType)).
+			withArgf("invalid_email_action", "%q", dtoInvalidEmailActionToBenthosInvalidEmailAction(invalidEmailAction)).
+			withDeterministic(deterministic).
+			String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_BOOL:
 		return "generate_bool()", nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CARD_NUMBER:
 		luhn := col.Transformer.Config.GetGenerateCardNumberConfig().ValidLuhn
 		return fmt.Sprintf(`generate_card_number(valid_luhn:%t)`, luhn), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_CITY:
-		return fmt.Sprintf(`generate_city(max_length:%d)`, maxLen), nil
+		return newBloblangFunction("generate_city").withArgf("max_length", "%d", maxLen).withLocale(col.Transformer.Config.GetGenerateCityConfig().GetLocale()).String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_E164_PHONE_NUMBER:
 		minValue := col.Transformer.Config.GetGenerateE164PhoneNumberConfig().Min
 		maxValue := col.Transformer.Config.GetGenerateE164PhoneNumberConfig().Max
-		return fmt.Sprintf(`generate_e164_phone_number(min:%d,max:%d)`, minValue, maxValue), nil
+		locale := col.Transformer.Config.GetGenerateE164PhoneNumberConfig().GetLocale()
+		if err := transformers.ValidateInternationalPhoneNumberLocale(transformers.LocaleFromDto(locale), minValue, maxValue); err != nil {
+			return "", fmt.Errorf("invalid generate e164 phone number config for column %s: %w", col.Column, err)
+		}
+		return newBloblangFunction("generate_e164_phone_number").
+			withArgf("min", "%d", minValue).
+			withArgf("max", "%d", maxValue).
+			withLocale(locale).
+			String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FIRST_NAME:
-		return fmt.Sprintf(`generate_first_name(max_length:%d)`, maxLen), nil
+		return newBloblangFunction("generate_first_name").withArgf("max_length", "%d", maxLen).withLocale(col.Transformer.Config.GetGenerateFirstNameConfig().GetLocale()).String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FLOAT64:
 		randomSign := col.Transformer.Config.GetGenerateFloat64Config().RandomizeSign
 		minValue := col.Transformer.Config.GetGenerateFloat64Config().Min
@@ -514,9 +530,9 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *sqlmanager_s
 		template := fmt.Sprintf("generate_float64(%s)", strings.Join(fnStr, ", "))
 		return fmt.Sprintf(template, params...), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_ADDRESS:
-		return fmt.Sprintf(`generate_full_address(max_length:%d)`, maxLen), nil
+		return newBloblangFunction("generate_full_address").withArgf("max_length", "%d", maxLen).withLocale(col.Transformer.Config.GetGenerateFullAddressConfig().GetLocale()).String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_FULL_NAME:
-		return fmt.Sprintf(`generate_full_name(max_length:%d)`, maxLen), nil
+		return newBloblangFunction("generate_full_name").withArgf("max_length", "%d", maxLen).withLocale(col.Transformer.Config.GetGenerateFullNameConfig().GetLocale()).String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_GENDER:
 		ab := col.Transformer.Config.GetGenerateGenderConfig().Abbreviate
 		return fmt.Sprintf(`generate_gender(abbreviate:%t,max_length:%d)`, ab, maxLen), nil
@@ -528,22 +544,30 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *sqlmanager_s
 		maxValue := col.Transformer.Config.GetGenerateInt64Config().Max
 		return fmt.Sprintf(`generate_int64(randomize_sign:%t,min:%d, max:%d)`, sign, minValue, maxValue), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_LAST_NAME:
-		return fmt.Sprintf(`generate_last_name(max_length:%d)`, maxLen), nil
+		return newBloblangFunction("generate_last_name").withArgf("max_length", "%d", maxLen).withLocale(col.Transformer.Config.GetGenerateLastNameConfig().GetLocale()).String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SHA256HASH:
 		return `generate_sha256hash()`, nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_SSN:
 		return "generate_ssn()", nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STATE:
 		generateFullName := col.Transformer.Config.GetGenerateStateConfig().GenerateFullName
-		return fmt.Sprintf(`generate_state(generate_full_name:%t)`, generateFullName), nil
+		return newBloblangFunction("generate_state").withArgf("generate_full_name", "%t", generateFullName).withLocale(col.Transformer.Config.GetGenerateStateConfig().GetLocale()).String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STREET_ADDRESS:
-		return fmt.Sprintf(`generate_street_address(max_length:%d)`, maxLen), nil
+		return newBloblangFunction("generate_street_address").withArgf("max_length", "%d", maxLen).withLocale(col.Transformer.Config.GetGenerateStreetAddressConfig().GetLocale()).String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_STRING_PHONE_NUMBER:
 		minValue := col.Transformer.Config.GetGenerateStringPhoneNumberConfig().Min
 		maxValue := col.Transformer.Config.GetGenerateStringPhoneNumberConfig().Max
 		minValue = transformer_utils.MinInt(minValue, maxLen)
 		maxValue = transformer_utils.Ceil(maxValue, maxLen)
-		return fmt.Sprintf("generate_string_phone_number(min:%d,max:%d)", minValue, maxValue), nil
+		locale := col.Transformer.Config.GetGenerateStringPhoneNumberConfig().GetLocale()
+		if err := transformers.ValidateStringPhoneNumberLocale(transformers.LocaleFromDto(locale), minValue, maxValue); err != nil {
+			return "", fmt.Errorf("invalid generate string phone number config for column %s: %w", col.Column, err)
+		}
+		return newBloblangFunction("generate_string_phone_number").
+			withArgf("min", "%d", minValue).
+			withArgf("max", "%d", maxValue).
+			withLocale(locale).
+			String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_RANDOM_STRING:
 		minValue := col.Transformer.Config.GetGenerateStringConfig().Min
 		maxValue := col.Transformer.Config.GetGenerateStringConfig().Max
@@ -561,13 +585,24 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *sqlmanager_s
 		ih := col.Transformer.Config.GetGenerateUuidConfig().IncludeHyphens
 		return fmt.Sprintf("generate_uuid(include_hyphens:%t)", ih), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_ZIPCODE:
-		return "generate_zipcode()", nil
+		return newBloblangFunction("generate_zipcode").withLocale(col.Transformer.Config.GetGenerateZipcodeConfig().GetLocale()).String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_E164_PHONE_NUMBER:
 		pl := col.Transformer.Config.GetTransformE164PhoneNumberConfig().PreserveLength
-		return fmt.Sprintf("transform_e164_phone_number(value:this.%s,preserve_length:%t,max_length:%d)", formattedColPath, pl, maxLen), nil
+		return newBloblangFunction("transform_e164_phone_number").
+			withArg("value", valueArg).
+			withArgf("preserve_length", "%t", pl).
+			withArgf("max_length", "%d", maxLen).
+			withDeterministic(deterministic).
+			String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FIRST_NAME:
 		pl := col.Transformer.Config.GetTransformFirstNameConfig().PreserveLength
-		return fmt.Sprintf("transform_first_name(value:this.%s,preserve_length:%t,max_length:%d)", formattedColPath, pl, maxLen), nil
+		return newBloblangFunction("transform_first_name").
+			withArg("value", valueArg).
+			withArgf("preserve_length", "%t", pl).
+			withArgf("max_length", "%d", maxLen).
+			withLocale(col.Transformer.Config.GetTransformFirstNameConfig().GetLocale()).
+			withDeterministic(deterministic).
+			String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FLOAT64:
 		rMin := col.Transformer.Config.GetTransformFloat64Config().RandomizationRangeMin
 		rMax := col.Transformer.Config.GetTransformFloat64Config().RandomizationRangeMax
@@ -584,39 +619,69 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *sqlmanager_s
 			scale = &newScale
 		}
 
-		fnStr := []string{"value:this.%s", "randomization_range_min:%f", "randomization_range_max:%f"}
-		params := []any{formattedColPath, rMin, rMax}
-
+		fn := newBloblangFunction("transform_float64").
+			withArg("value", valueArg).
+			withArgf("randomization_range_min", "%f", rMin).
+			withArgf("randomization_range_max", "%f", rMax)
 		if precision != nil {
-			fnStr = append(fnStr, "precision:%d")
-			params = append(params, *precision)
+			fn.withArgf("precision", "%d", *precision)
 		}
 		if scale != nil {
-			fnStr = append(fnStr, "scale:%d")
-			params = append(params, *scale)
+			fn.withArgf("scale", "%d", *scale)
 		}
-		template := fmt.Sprintf(`transform_float64(%s)`, strings.Join(fnStr, ", "))
-		return fmt.Sprintf(template, params...), nil
+		return fn.withDeterministic(deterministic).String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FULL_NAME:
 		pl := col.Transformer.Config.GetTransformFullNameConfig().PreserveLength
-		return fmt.Sprintf("transform_full_name(value:this.%s,preserve_length:%t,max_length:%d)", formattedColPath, pl, maxLen), nil
+		return newBloblangFunction("transform_full_name").
+			withArg("value", valueArg).
+			withArgf("preserve_length", "%t", pl).
+			withArgf("max_length", "%d", maxLen).
+			withLocale(col.Transformer.Config.GetTransformFullNameConfig().GetLocale()).
+			withDeterministic(deterministic).
+			String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64_PHONE_NUMBER:
 		pl := col.Transformer.Config.GetTransformInt64PhoneNumberConfig().PreserveLength
-		return fmt.Sprintf("transform_int64_phone_number(value:this.%s,preserve_length:%t)", formattedColPath, pl), nil
+		return newBloblangFunction("transform_int64_phone_number").
+			withArg("value", valueArg).
+			withArgf("preserve_length", "%t", pl).
+			withDeterministic(deterministic).
+			String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_INT64:
 		rMin := col.Transformer.Config.GetTransformInt64Config().RandomizationRangeMin
 		rMax := col.Transformer.Config.GetTransformInt64Config().RandomizationRangeMax
-		return fmt.Sprintf(`transform_int64(value:this.%s,randomization_range_min:%d,randomization_range_max:%d)`, formattedColPath, rMin, rMax), nil
+		return newBloblangFunction("transform_int64").
+			withArg("value", valueArg).
+			withArgf("randomization_range_min", "%d", rMin).
+			withArgf("randomization_range_max", "%d", rMax).
+			withDeterministic(deterministic).
+			String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_LAST_NAME:
 		pl := col.Transformer.Config.GetTransformLastNameConfig().PreserveLength
-		return fmt.Sprintf("transform_last_name(value:this.%s,preserve_length:%t,max_length:%d)", formattedColPath, pl, maxLen), nil
+		return newBloblangFunction("transform_last_name").
+			withArg("value", valueArg).
+			withArgf("preserve_length", "%t", pl).
+			withArgf("max_length", "%d", maxLen).
+			withLocale(col.Transformer.Config.GetTransformLastNameConfig().GetLocale()).
+			withDeterministic(deterministic).
+			String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_PHONE_NUMBER:
 		pl := col.Transformer.Config.GetTransformPhoneNumberConfig().PreserveLength
-		return fmt.Sprintf("transform_phone_number(value:this.%s,preserve_length:%t,max_length:%d)", formattedColPath, pl, maxLen), nil
+		return newBloblangFunction("transform_phone_number").
+			withArg("value", valueArg).
+			withArgf("preserve_length", "%t", pl).
+			withArgf("max_length", "%d", maxLen).
+			withDeterministic(deterministic).
+			String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_STRING:
 		pl := col.Transformer.Config.GetTransformStringConfig().PreserveLength
 		minLength := int64(3) // todo: we need to pull in this value from the database schema
-		return fmt.Sprintf(`transform_string(value:this.%s,preserve_length:%t,min_length:%d,max_length:%d)`, formattedColPath, pl, minLength, maxLen), nil
+		return newBloblangFunction("transform_string").
+			withArg("value", valueArg).
+			withArgf("preserve_length", "%t", pl).
+			withArgf("min_length", "%d", minLength).
+			withArgf("max_length", "%d", maxLen).
+			withDeterministic(deterministic).
+			String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_NULL:
 		return shared.NullString, nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_DEFAULT:
@@ -624,21 +689,75 @@ func computeMutationFunction(col *mgmtv1alpha1.JobMapping, colInfo *sqlmanager_s
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_CHARACTER_SCRAMBLE:
 		regex := col.Transformer.Config.GetTransformCharacterScrambleConfig().UserProvidedRegex
 
+		fn := newBloblangFunction("transform_character_scramble").withArg("value", valueArg)
 		if regex != nil {
-			regexValue := *regex
-			return fmt.Sprintf(`transform_character_scramble(value:this.%s,user_provided_regex:%q)`, formattedColPath, regexValue), nil
-		} else {
-			return fmt.Sprintf(`transform_character_scramble(value:this.%s)`, formattedColPath), nil
+			fn.withArgf("user_provided_regex", "%q", *regex)
 		}
+		return fn.withDeterministic(deterministic).String(), nil
 	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_GENERATE_COUNTRY:
 		generateFullName := col.Transformer.Config.GetGenerateCountryConfig().GenerateFullName
 		return fmt.Sprintf(`generate_country(generate_full_name:%t)`, generateFullName), nil
+	case mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_TRANSFORM_FPE:
+		config := col.Transformer.Config.GetTransformFpeConfig()
+		alphabet, err := transformers.GetFpeAlphabet(config)
+		if err != nil {
+			return "", err
+		}
+		// the encryption key is bound to the bloblang environment at sync time so that it never ends up in the benthos config
+		return newBloblangFunction("transform_fpe").
+			withArg("value", valueArg).
+			withArgf("alphabet", "%q", alphabet).
+			withArgf("tweak", "%q", config.GetTweak()).
+			withArgf("pass_through_short_values", "%t", config.GetPassThroughShortValues()).
+			String(), nil
 
 	default:
 		return "", fmt.Errorf("unsupported transformer")
 	}
 }
 
+// A bloblang function call whose arguments are collected before being rendered
+type bloblangFunction struct {
+	name string
+	args []string
+}
+
+func newBloblangFunction(name string) *bloblangFunction {
+	return &bloblangFunction{name: name}
+}
+
+// Adds a named argument whose value is already a valid bloblang expression
+func (f *bloblangFunction) withArg(name, value string) *bloblangFunction {
+	f.args = append(f.args, fmt.Sprintf("%s:%s", name, value))
+	return f
+}
+
+func (f *bloblangFunction) withArgf(name, format string, a ...any) *bloblangFunction {
+	return f.withArg(name, fmt.Sprintf(format, a...))
+}
+
+// Adds the locale arg if a locale is specified so that the default dataset is used otherwise
+func (f *bloblangFunction) withLocale(locale mgmtv1alpha1.TransformerLocale) *bloblangFunction {
+	benthosLocale := transformers.LocaleFromDto(locale)
+	if benthosLocale == nil {
+		return f
+	}
+	return f.withArgf("locale", "%q", *benthosLocale)
+}
+
+// Adds the deterministic arg so that the transformer derives its randomizer from the input value.
+// The account key is bound to the bloblang environment at sync time and never ends up in the benthos config.
+func (f *bloblangFunction) withDeterministic(deterministic bool) *bloblangFunction {
+	if !deterministic {
+		return f
+	}
+	return f.withArg("deterministic", "true")
+}
+
+func (f *bloblangFunction) String() string {
+	return fmt.Sprintf("%s(%s)", f.name, strings.Join(f.args, ","))
+}
+
 func dtoEmailTypeToBenthosEmailType(dto mgmtv1alpha1.GenerateEmailType) transformers.GenerateEmailType {
 	switch dto {
 	case mgmtv1alpha1.GenerateEmailType_GENERATE_EMAIL_TYPE_FULLNAME:
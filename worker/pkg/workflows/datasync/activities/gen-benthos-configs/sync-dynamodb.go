@@ -38,7 +38,7 @@ func (b *benthosBuilder) getDynamoDbSyncBenthosConfigResponses(
 	if dynamoSourceConfig == nil {
 		return nil, fmt.Errorf("source connection was not dynamodb. Got %T", sourceConnection.GetConnectionConfig().Config)
 	}
-	awsManager := awsmanager.New()
+	awsManager := awsmanager.New(b.secretResolver)
 	dynamoClient, err := awsManager.NewDynamoDbClient(ctx, dynamoSourceConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create DynamoDB client: %w", err)
@@ -62,7 +62,7 @@ func (b *benthosBuilder) getDynamoDbSyncBenthosConfigResponses(
 							ConsistentRead: dynamoJobSourceOpts.GetEnableConsistentRead(),
 							Region:         dynamoSourceConfig.GetRegion(),
 							Endpoint:       dynamoSourceConfig.GetEndpoint(),
-							Credentials:    buildBenthosS3Credentials(dynamoSourceConfig.GetCredentials()),
+							Credentials:    buildBenthosS3Credentials(sourceConnection.GetId(), dynamoSourceConfig.GetCredentials()),
 						},
 					},
 				},
@@ -0,0 +1,203 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/kafkaconnect"
+	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	neosync_benthos_kafka "github.com/nucleuscloud/neosync/worker/pkg/benthos/kafka"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+const (
+	// Kafka topics are exposed as tables under this schema in the job mappings
+	kafkaSchema = "kafka"
+)
+
+type kafkaSyncResp struct {
+	BenthosConfigs []*BenthosConfigResponse
+}
+
+func (b *benthosBuilder) getKafkaSyncBenthosConfigResponses(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	slogger *slog.Logger,
+) (*kafkaSyncResp, error) {
+	sourceConnection, err := shared.GetJobSourceConnection(ctx, job.GetSource(), b.connclient)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get source connection by id: %w", err)
+	}
+	sourceConnectionType := shared.GetConnectionType(sourceConnection)
+	slogger = slogger.With(
+		"sourceConnectionType", sourceConnectionType,
+	)
+	_ = slogger
+
+	kafkaSourceConfig := sourceConnection.GetConnectionConfig().GetKafkaConfig()
+	if kafkaSourceConfig == nil {
+		return nil, fmt.Errorf("source connection was not kafka. Got %T", sourceConnection.GetConnectionConfig().Config)
+	}
+	kafkaJobSourceOpts := job.GetSource().GetOptions().GetKafka()
+	topic := kafkaJobSourceOpts.GetTopic()
+
+	columns := []string{}
+	mappings := []*mgmtv1alpha1.JobMapping{}
+	for _, jm := range job.GetMappings() {
+		if jm.GetTable() != topic {
+			continue
+		}
+		columns = append(columns, jm.GetColumn())
+		mappings = append(mappings, jm)
+	}
+
+	input := &neosync_benthos.InputNeosyncKafka{
+		Brokers:    kafkaSourceConfig.GetBrokers(),
+		Sasl:       buildBenthosKafkaSasl(sourceConnection.GetId(), kafkaSourceConfig.GetSasl()),
+		Tls:        buildBenthosKafkaTls(sourceConnection.GetId(), kafkaSourceConfig),
+		Topic:      topic,
+		Format:     toBenthosKafkaFormat(kafkaJobSourceOpts.GetFormat()),
+		AvroSchema: kafkaJobSourceOpts.AvroSchema,
+	}
+	switch r := kafkaJobSourceOpts.GetRange().(type) {
+	case *mgmtv1alpha1.KafkaSourceConnectionOptions_Offsets:
+		input.StartOffset = r.Offsets.Start
+		input.EndOffset = r.Offsets.End
+	case *mgmtv1alpha1.KafkaSourceConnectionOptions_Timestamps:
+		if r.Timestamps.GetStart() != nil {
+			start := r.Timestamps.GetStart().AsTime().UnixMilli()
+			input.StartTimestampMs = &start
+		}
+		if r.Timestamps.GetEnd() != nil {
+			end := r.Timestamps.GetEnd().AsTime().UnixMilli()
+			input.EndTimestampMs = &end
+		}
+	}
+
+	bc := &neosync_benthos.BenthosConfig{
+		StreamConfig: neosync_benthos.StreamConfig{
+			Input: &neosync_benthos.InputConfig{
+				Inputs: neosync_benthos.Inputs{
+					NeosyncKafka: input,
+				},
+			},
+			Pipeline: &neosync_benthos.PipelineConfig{
+				Threads:    -1,
+				Processors: []neosync_benthos.ProcessorConfig{},
+			},
+			Output: &neosync_benthos.OutputConfig{
+				Outputs: neosync_benthos.Outputs{
+					Broker: &neosync_benthos.OutputBrokerConfig{
+						Pattern: "fan_out",
+						Outputs: []neosync_benthos.Outputs{},
+					},
+				},
+			},
+		},
+	}
+
+	splitColumnPaths := true
+	processorConfigs, err := buildProcessorConfigsByRunType(
+		ctx,
+		b.transformerclient,
+		tabledependency.NewRunConfig(topic, tabledependency.RunTypeInsert, []string{}, nil, columns, columns, nil, splitColumnPaths),
+		map[string][]*referenceKey{},
+		map[string][]*referenceKey{},
+		b.jobId,
+		b.runId,
+		&shared.RedisConfig{},
+		mappings,
+		map[string]*sqlmanager_shared.ColumnInfo{},
+		job.GetSource().GetOptions(),
+		columns,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, pc := range processorConfigs {
+		bc.StreamConfig.Pipeline.Processors = append(bc.StreamConfig.Pipeline.Processors, *pc)
+	}
+
+	return &kafkaSyncResp{
+		BenthosConfigs: []*BenthosConfigResponse{
+			{
+				Config:      bc,
+				Name:        fmt.Sprintf("%s.%s", kafkaSchema, topic),
+				TableSchema: kafkaSchema,
+				TableName:   topic,
+				RunType:     tabledependency.RunTypeInsert,
+				DependsOn:   []*tabledependency.DependsOn{},
+				Columns:     columns,
+
+				SourceConnectionType: sourceConnectionType,
+				metriclabels: metrics.MetricLabels{
+					metrics.NewEqLabel(metrics.TableSchemaLabel, kafkaSchema),
+					metrics.NewEqLabel(metrics.TableNameLabel, topic),
+					metrics.NewEqLabel(metrics.JobTypeLabel, "sync"),
+				},
+			},
+		},
+	}, nil
+}
+
+func (b *benthosBuilder) getKafkaSyncBenthosOutput(
+	connectionId string,
+	connection *mgmtv1alpha1.KafkaConnectionConfig,
+	destinationOpts *mgmtv1alpha1.KafkaDestinationConnectionOptions,
+) neosync_benthos.Outputs {
+	var maxInFlight *int
+	if destinationOpts.MaxInFlight != nil {
+		val := int(destinationOpts.GetMaxInFlight())
+		maxInFlight = &val
+	}
+	return neosync_benthos.Outputs{
+		NeosyncKafka: &neosync_benthos.OutputNeosyncKafka{
+			Brokers:     connection.GetBrokers(),
+			Sasl:        buildBenthosKafkaSasl(connectionId, connection.GetSasl()),
+			Tls:         buildBenthosKafkaTls(connectionId, connection),
+			Topic:       destinationOpts.GetTopic(),
+			Format:      toBenthosKafkaFormat(destinationOpts.GetFormat()),
+			AvroSchema:  destinationOpts.AvroSchema,
+			MaxInFlight: maxInFlight,
+			Batching: &neosync_benthos.Batching{
+				Period: "5s",
+				Count:  100,
+			},
+		},
+	}
+}
+
+func buildBenthosKafkaSasl(connectionId string, sasl *mgmtv1alpha1.KafkaSaslConfig) *neosync_benthos.KafkaSaslConfig {
+	if sasl == nil {
+		return nil
+	}
+	return &neosync_benthos.KafkaSaslConfig{
+		Mechanism: kafkaconnect.ToSaslMechanism(sasl.GetMechanism()),
+		Username:  sasl.GetUsername(),
+		Password:  shared.GetConnectionCredentialEnvVar(connectionId, shared.CredentialKafkaSaslPassword, sasl.GetPassword()),
+	}
+}
+
+func buildBenthosKafkaTls(connectionId string, connection *mgmtv1alpha1.KafkaConnectionConfig) *neosync_benthos.KafkaTlsConfig {
+	if !connection.GetEnableTls() {
+		return nil
+	}
+	return &neosync_benthos.KafkaTlsConfig{
+		Enabled:    true,
+		RootCert:   connection.GetClientTls().GetRootCert(),
+		ClientCert: connection.GetClientTls().GetClientCert(),
+		ClientKey:  shared.GetConnectionCredentialEnvVar(connectionId, shared.CredentialKafkaClientKey, connection.GetClientTls().GetClientKey()),
+	}
+}
+
+func toBenthosKafkaFormat(format mgmtv1alpha1.KafkaMessageFormat) string {
+	if format == mgmtv1alpha1.KafkaMessageFormat_KAFKA_MESSAGE_FORMAT_AVRO {
+		return neosync_benthos_kafka.FormatAvro
+	}
+	return neosync_benthos_kafka.FormatJson
+}
@@ -0,0 +1,124 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Test_getKafkaSyncBenthosConfigResponses(t *testing.T) {
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
+	password := "env://KAFKA_PASSWORD"
+	mockConnectionClient.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id: "kafka-source",
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_KafkaConfig{
+					KafkaConfig: &mgmtv1alpha1.KafkaConnectionConfig{
+						Brokers: []string{"localhost:9092"},
+						Sasl: &mgmtv1alpha1.KafkaSaslConfig{
+							Mechanism: mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_SCRAM_SHA_256,
+							Username:  "neosync",
+							Password:  password,
+						},
+					},
+				},
+			},
+		},
+	}), nil)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	job := &mgmtv1alpha1.Job{
+		Source: &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_Kafka{
+					Kafka: &mgmtv1alpha1.KafkaSourceConnectionOptions{
+						ConnectionId: "kafka-source",
+						Topic:        "users",
+						Range: &mgmtv1alpha1.KafkaSourceConnectionOptions_Timestamps{
+							Timestamps: &mgmtv1alpha1.KafkaTimestampRange{Start: timestamppb.New(start)},
+						},
+					},
+				},
+			},
+		},
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "kafka", Table: "users", Column: "name", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+			}},
+			{Schema: "kafka", Table: "orders", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+			}},
+		},
+	}
+
+	builder := newBenthosBuilder(nil, nil, mockConnectionClient, mockTransformerClient, mockJobId, "", mockRunId, nil, false, nil, "")
+	resp, err := builder.getKafkaSyncBenthosConfigResponses(context.Background(), job, slog.Default())
+	require.NoError(t, err)
+	require.Len(t, resp.BenthosConfigs, 1)
+
+	config := resp.BenthosConfigs[0]
+	require.Equal(t, "kafka", config.TableSchema)
+	require.Equal(t, "users", config.TableName)
+	require.Equal(t, []string{"name"}, config.Columns)
+
+	startMs := start.UnixMilli()
+	require.Equal(t, &neosync_benthos.InputNeosyncKafka{
+		Brokers: []string{"localhost:9092"},
+		Sasl: &neosync_benthos.KafkaSaslConfig{
+			Mechanism: "SCRAM-SHA-256",
+			Username:  "neosync",
+			Password:  shared.GetSecretReferenceEnvVar(password),
+		},
+		Topic:            "users",
+		StartTimestampMs: &startMs,
+		Format:           "json",
+	}, config.Config.Input.NeosyncKafka)
+	require.NotEqual(t, password, config.Config.Input.NeosyncKafka.Sasl.Password, "secret references must not be passed through as-is")
+	require.Empty(t, config.Config.Pipeline.Processors, "passthrough mappings do not require processors")
+}
+
+func Test_getKafkaSyncBenthosOutput(t *testing.T) {
+	builder := &benthosBuilder{}
+	maxInFlight := uint32(8)
+	schema := `{"type": "string"}`
+	output := builder.getKafkaSyncBenthosOutput(
+		"123",
+		&mgmtv1alpha1.KafkaConnectionConfig{Brokers: []string{"localhost:9092"}, EnableTls: true},
+		&mgmtv1alpha1.KafkaDestinationConnectionOptions{
+			Topic:       "users-anonymized",
+			Format:      mgmtv1alpha1.KafkaMessageFormat_KAFKA_MESSAGE_FORMAT_AVRO,
+			AvroSchema:  &schema,
+			MaxInFlight: &maxInFlight,
+		},
+	)
+	require.NotNil(t, output.NeosyncKafka)
+	require.Equal(t, "users-anonymized", output.NeosyncKafka.Topic)
+	require.Equal(t, "avro", output.NeosyncKafka.Format)
+	require.Equal(t, &schema, output.NeosyncKafka.AvroSchema)
+	require.Equal(t, 8, *output.NeosyncKafka.MaxInFlight)
+	require.Equal(t, &neosync_benthos.KafkaTlsConfig{Enabled: true}, output.NeosyncKafka.Tls)
+	require.Nil(t, output.NeosyncKafka.Sasl)
+}
+
+func Test_buildBenthosKafkaSasl_InlinePassword(t *testing.T) {
+	sasl := buildBenthosKafkaSasl("kafka-conn", &mgmtv1alpha1.KafkaSaslConfig{
+		Mechanism: mgmtv1alpha1.KafkaSaslMechanism_KAFKA_SASL_MECHANISM_PLAIN,
+		Username:  "neosync",
+		Password:  "inline-password",
+	})
+	require.NotNil(t, sasl)
+	require.Equal(t, "${"+shared.GetConnectionCredentialEnvKey("kafka-conn", shared.CredentialKafkaSaslPassword)+"}", sasl.Password)
+	require.NotContains(t, sasl.Password, "inline-password", "inline credentials must not be stored in the benthos config")
+}
@@ -0,0 +1,266 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	localdirectory "github.com/nucleuscloud/neosync/backend/pkg/local-directory"
+	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+)
+
+type localDirectorySyncResp struct {
+	BenthosConfigs []*BenthosConfigResponse
+}
+
+func (b *benthosBuilder) getLocalDirectorySyncBenthosConfigResponses(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	slogger *slog.Logger,
+) (*localDirectorySyncResp, error) {
+	sourceConnection, err := shared.GetJobSourceConnection(ctx, job.GetSource(), b.connclient)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get source connection by id: %w", err)
+	}
+	sourceConnectionType := shared.GetConnectionType(sourceConnection)
+	slogger = slogger.With(
+		"sourceConnectionType", sourceConnectionType,
+	)
+
+	localDirSourceConfig := sourceConnection.GetConnectionConfig().GetLocalDirConfig()
+	if localDirSourceConfig == nil {
+		return nil, fmt.Errorf("source connection was not a local directory. Got %T", sourceConnection.GetConnectionConfig().Config)
+	}
+	rootPath, err := localdirectory.GetRootPath(localDirSourceConfig.GetPath(), b.localDirectoryAllowedDir, sourceConnection.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+
+	var jobRunId string
+	switch id := job.GetSource().GetOptions().GetLocalDirectory().GetId().(type) {
+	case *mgmtv1alpha1.LocalDirectorySourceConnectionOptions_JobRunId:
+		jobRunId = id.JobRunId
+	case *mgmtv1alpha1.LocalDirectorySourceConnectionOptions_JobId:
+		runId, err := localdirectory.GetLatestJobRunId(rootPath, id.JobId)
+		if err != nil {
+			return nil, err
+		}
+		jobRunId = runId
+	default:
+		return nil, fmt.Errorf("unsupported local directory source id: %T", id)
+	}
+	slogger = slogger.With("sourceJobRunId", jobRunId)
+
+	groupedMappings := groupMappingsByTable(job.GetMappings())
+
+	benthosConfigs := []*BenthosConfigResponse{}
+	for _, tableMapping := range groupedMappings {
+		table := neosync_benthos.BuildBenthosTable(tableMapping.Schema, tableMapping.Table)
+		files, err := localdirectory.ListTableDataFiles(rootPath, jobRunId, table)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			slogger.Warn(fmt.Sprintf("no exported files found for table %s in local directory, skipping", table))
+			continue
+		}
+		// a table is always exported in a single format, any other files in the directory are ignored
+		format := getBucketFileFormatFromPath(files[0])
+		paths := []string{}
+		for _, file := range files {
+			if getBucketFileFormatFromPath(file) == format {
+				paths = append(paths, file)
+			}
+		}
+
+		scanner, inputProcessors := getLocalDirectoryInputScanner(format)
+		bc := &neosync_benthos.BenthosConfig{
+			StreamConfig: neosync_benthos.StreamConfig{
+				Input: &neosync_benthos.InputConfig{
+					Inputs: neosync_benthos.Inputs{
+						File: &neosync_benthos.InputFile{
+							Paths:   paths,
+							Scanner: scanner,
+						},
+					},
+					Processors: inputProcessors,
+				},
+				Pipeline: &neosync_benthos.PipelineConfig{
+					Threads:    -1,
+					Processors: []neosync_benthos.ProcessorConfig{},
+				},
+				Output: &neosync_benthos.OutputConfig{
+					Outputs: neosync_benthos.Outputs{
+						Broker: &neosync_benthos.OutputBrokerConfig{
+							Pattern: "fan_out",
+							Outputs: []neosync_benthos.Outputs{},
+						},
+					},
+				},
+			},
+		}
+
+		columns := []string{}
+		for _, jm := range tableMapping.Mappings {
+			columns = append(columns, jm.Column)
+		}
+
+		splitColumnPaths := false
+		processorConfigs, err := buildProcessorConfigsByRunType(
+			ctx,
+			b.transformerclient,
+			tabledependency.NewRunConfig(table, tabledependency.RunTypeInsert, []string{}, nil, columns, columns, nil, splitColumnPaths),
+			map[string][]*referenceKey{},
+			map[string][]*referenceKey{},
+			b.jobId,
+			b.runId,
+			&shared.RedisConfig{},
+			tableMapping.Mappings,
+			map[string]*sqlmanager_shared.ColumnInfo{},
+			job.GetSource().GetOptions(),
+			columns,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, pc := range processorConfigs {
+			bc.StreamConfig.Pipeline.Processors = append(bc.StreamConfig.Pipeline.Processors, *pc)
+		}
+
+		benthosConfigs = append(benthosConfigs, &BenthosConfigResponse{
+			Config:      bc,
+			Name:        table,
+			TableSchema: tableMapping.Schema,
+			TableName:   tableMapping.Table,
+			RunType:     tabledependency.RunTypeInsert,
+			DependsOn:   []*tabledependency.DependsOn{},
+			Columns:     columns,
+
+			SourceConnectionType: sourceConnectionType,
+			metriclabels: metrics.MetricLabels{
+				metrics.NewEqLabel(metrics.TableSchemaLabel, tableMapping.Schema),
+				metrics.NewEqLabel(metrics.TableNameLabel, tableMapping.Table),
+				metrics.NewEqLabel(metrics.JobTypeLabel, "sync"),
+			},
+		})
+	}
+
+	return &localDirectorySyncResp{
+		BenthosConfigs: benthosConfigs,
+	}, nil
+}
+
+// Returns the file scanner and input processors that read back the records of an exported file
+func getLocalDirectoryInputScanner(format mgmtv1alpha1.BucketFileFormat) (*neosync_benthos.FileScanner, []neosync_benthos.ProcessorConfig) {
+	switch format {
+	case mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_CSV:
+		// csv encoding writes nulls as empty fields
+		nullMapping := `root = this.map_each(kv -> if kv.value == "" { null } else { kv.value })`
+		return &neosync_benthos.FileScanner{
+			Decompress: &neosync_benthos.DecompressScanner{
+				Algorithm: "gzip",
+				Into:      &neosync_benthos.FileScanner{Csv: &neosync_benthos.CsvScanner{ParseHeaderRow: true}},
+			},
+		}, []neosync_benthos.ProcessorConfig{
+			{Mapping: &nullMapping},
+		}
+	case mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_PARQUET:
+		return &neosync_benthos.FileScanner{
+			ToTheEnd: &neosync_benthos.ToTheEndScanner{},
+		}, []neosync_benthos.ProcessorConfig{
+			{ParquetDecode: &neosync_benthos.ParquetDecodeConfig{}},
+		}
+	default:
+		return &neosync_benthos.FileScanner{
+			Decompress: &neosync_benthos.DecompressScanner{
+				Algorithm: "gzip",
+				Into:      &neosync_benthos.FileScanner{Lines: &neosync_benthos.LinesScanner{}},
+			},
+		}, nil
+	}
+}
+
+func (b *benthosBuilder) getLocalDirectorySyncBenthosOutput(
+	connection *mgmtv1alpha1.ConnectionConfig_LocalDirConfig,
+	benthosConfig *BenthosConfigResponse,
+	job *mgmtv1alpha1.Job,
+	workflowId string,
+	destinationOptions *mgmtv1alpha1.LocalDirectoryDestinationConnectionOptions,
+	colInfoMap map[string]*sqlmanager_shared.ColumnInfo,
+) ([]neosync_benthos.Outputs, error) {
+	outputs := []neosync_benthos.Outputs{}
+
+	format := destinationOptions.GetFormat()
+	processors, err := getBucketBatchProcessors(format, benthosConfig.Columns, colInfoMap)
+	if err != nil {
+		return nil, err
+	}
+
+	objectPath, err := getBucketObjectPath(
+		"",
+		destinationOptions.PathTemplate,
+		fmt.Sprintf(`${!count("files")}.%s`, getBucketFileExtension(format, "jsonl.gz")),
+		&bucketPathValues{
+			JobId:     job.GetId(),
+			JobName:   job.GetName(),
+			JobRunId:  workflowId,
+			Schema:    benthosConfig.TableSchema,
+			Table:     benthosConfig.TableName,
+			Extension: getBucketFileExtension(format, "jsonl.gz"),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build local directory file path: %w", err)
+	}
+	rootPath, err := localdirectory.GetRootPath(connection.LocalDirConfig.GetPath(), b.localDirectoryAllowedDir, job.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	// the path template is filled in with job and table names, which must not move the files out of the directory
+	filePath := filepath.Join(rootPath, filepath.FromSlash(objectPath))
+	if !localdirectory.IsWithinDir(rootPath, filePath) || filePath == rootPath {
+		return nil, fmt.Errorf("local directory file path %s must be located within the local directory", objectPath)
+	}
+
+	batchCount, batchPeriod, err := getBucketBatchPolicy(destinationOptions.GetBatch())
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse batch config for local directory destination config: %w", err)
+	}
+
+	outputs = append(outputs, neosync_benthos.Outputs{
+		Fallback: []neosync_benthos.Outputs{
+			{
+				// the file output does not batch on its own, so each batch is encoded into a single file by the broker
+				Broker: &neosync_benthos.OutputBrokerConfig{
+					Pattern: "fan_out",
+					Outputs: []neosync_benthos.Outputs{
+						{File: &neosync_benthos.FileOutput{
+							Path:  filePath,
+							Codec: "all-bytes",
+						}},
+					},
+					Batching: &neosync_benthos.Batching{
+						Count:      batchCount,
+						Period:     batchPeriod,
+						Processors: processors,
+					},
+				},
+			},
+			// kills activity depending on error
+			{Error: &neosync_benthos.ErrorOutputConfig{
+				ErrorMsg: `${! meta("fallback_error")}`,
+				Batching: &neosync_benthos.Batching{
+					Period: batchPeriod,
+					Count:  batchCount,
+				},
+			}},
+		},
+	})
+	return outputs, nil
+}
@@ -0,0 +1,132 @@
+package genbenthosconfigs_activity
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const localDirAccountId = "4b1c6f3e-2d5a-4c8b-9e7f-1a2b3c4d5e6f"
+
+func Test_getLocalDirectorySyncBenthosConfigResponses(t *testing.T) {
+	allowedDir := t.TempDir()
+	root := filepath.Join(allowedDir, localDirAccountId)
+	jobRunId := "source-job-2024-01-02T00:00:00Z"
+	dataPath := filepath.Join(root, "workflows", jobRunId, "activities", "public.users", "data")
+	require.NoError(t, os.MkdirAll(dataPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dataPath, "1.csv.gz"), []byte{}, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dataPath, "2.csv.gz"), []byte{}, 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "workflows", "source-job-2024-01-01T00:00:00Z"), 0o755))
+
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockTransformerClient := mgmtv1alpha1connect.NewMockTransformersServiceClient(t)
+	mockConnectionClient.On("GetConnection", mock.Anything, mock.Anything).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id:        "local-source",
+			AccountId: localDirAccountId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_LocalDirConfig{
+					LocalDirConfig: &mgmtv1alpha1.LocalDirectoryConnectionConfig{Path: root},
+				},
+			},
+		},
+	}), nil)
+
+	job := &mgmtv1alpha1.Job{
+		Source: &mgmtv1alpha1.JobSource{
+			Options: &mgmtv1alpha1.JobSourceOptions{
+				Config: &mgmtv1alpha1.JobSourceOptions_LocalDirectory{
+					LocalDirectory: &mgmtv1alpha1.LocalDirectorySourceConnectionOptions{
+						ConnectionId: "local-source",
+						Id:           &mgmtv1alpha1.LocalDirectorySourceConnectionOptions_JobId{JobId: "source-job"},
+					},
+				},
+			},
+		},
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+			}},
+			{Schema: "public", Table: "orders", Column: "id", Transformer: &mgmtv1alpha1.JobMappingTransformer{
+				Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+			}},
+		},
+	}
+
+	builder := newBenthosBuilder(nil, nil, mockConnectionClient, mockTransformerClient, mockJobId, "", mockRunId, nil, false, nil, allowedDir)
+	resp, err := builder.getLocalDirectorySyncBenthosConfigResponses(context.Background(), job, slog.Default())
+	require.NoError(t, err)
+	require.Len(t, resp.BenthosConfigs, 1, "tables without exported files are skipped")
+
+	config := resp.BenthosConfigs[0]
+	require.Equal(t, "public", config.TableSchema)
+	require.Equal(t, "users", config.TableName)
+	require.Equal(t, []string{"id"}, config.Columns)
+	require.True(t, isSyncConfig(config.Config.Input))
+	require.Equal(t, []string{filepath.Join(dataPath, "1.csv.gz"), filepath.Join(dataPath, "2.csv.gz")}, config.Config.Input.File.Paths)
+	require.True(t, config.Config.Input.File.Scanner.Decompress.Into.Csv.ParseHeaderRow)
+	require.Len(t, config.Config.Input.Processors, 1)
+}
+
+func Test_getLocalDirectorySyncBenthosOutput(t *testing.T) {
+	allowedDir := t.TempDir()
+	builder := &benthosBuilder{localDirectoryAllowedDir: allowedDir}
+	outputs, err := builder.getLocalDirectorySyncBenthosOutput(
+		&mgmtv1alpha1.ConnectionConfig_LocalDirConfig{
+			LocalDirConfig: &mgmtv1alpha1.LocalDirectoryConnectionConfig{Path: "exports/"},
+		},
+		&BenthosConfigResponse{TableSchema: "public", TableName: "users", Columns: []string{"id"}},
+		&mgmtv1alpha1.Job{Id: "job-id", AccountId: localDirAccountId},
+		"job-id-run",
+		&mgmtv1alpha1.LocalDirectoryDestinationConnectionOptions{
+			Format: mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_PARQUET,
+		},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, outputs, 1)
+
+	broker := outputs[0].Fallback[0].Broker
+	require.NotNil(t, broker)
+	require.Equal(t, &neosync_benthos.FileOutput{
+		Path:  filepath.Join(allowedDir, localDirAccountId, `exports/workflows/job-id-run/activities/public.users/data/${!count("files")}.parquet`),
+		Codec: "all-bytes",
+	}, broker.Outputs[0].File)
+	require.Equal(t, 100, broker.Batching.Count)
+	require.NotNil(t, broker.Batching.Processors[1].ParquetEncode)
+	require.NotNil(t, outputs[0].Fallback[1].Error)
+}
+
+func Test_getLocalDirectorySyncBenthosOutput_OutsideAllowedDir(t *testing.T) {
+	allowedDir := t.TempDir()
+	getOutput := func(builder *benthosBuilder, path string, pathTemplate *string) error {
+		_, err := builder.getLocalDirectorySyncBenthosOutput(
+			&mgmtv1alpha1.ConnectionConfig_LocalDirConfig{
+				LocalDirConfig: &mgmtv1alpha1.LocalDirectoryConnectionConfig{Path: path},
+			},
+			&BenthosConfigResponse{TableSchema: "public", TableName: "users", Columns: []string{"id"}},
+			&mgmtv1alpha1.Job{Id: "job-id", AccountId: localDirAccountId},
+			"job-id-run",
+			&mgmtv1alpha1.LocalDirectoryDestinationConnectionOptions{PathTemplate: pathTemplate},
+			nil,
+		)
+		return err
+	}
+
+	require.Error(t, getOutput(&benthosBuilder{}, allowedDir, nil), "local directories are disabled without an allowed directory")
+	builder := &benthosBuilder{localDirectoryAllowedDir: allowedDir}
+	require.Error(t, getOutput(builder, "/etc", nil))
+	require.Error(t, getOutput(builder, "../exports", nil))
+	require.Error(t, getOutput(builder, filepath.Join(allowedDir, "7d6a7f4e-1a6c-4d7e-9f0e-3c1b2a4d5e6f", "exports"), nil), "directories of other accounts are rejected")
+	template := `../../{{table}}/{{file_index}}`
+	require.Error(t, getOutput(builder, "exports", &template))
+}
@@ -32,10 +32,19 @@ func (b *benthosBuilder) getMongoDbSyncBenthosConfigResponses(
 	)
 	_ = slogger
 
+	subsetBuilder, err := newMongoSubsetBuilder(job.GetSource().GetOptions().GetMongodb().GetCollections())
+	if err != nil {
+		return nil, err
+	}
+
 	groupedMappings := groupMappingsByTable(job.GetMappings())
 
 	benthosConfigs := []*BenthosConfigResponse{}
 	for _, tableMapping := range groupedMappings {
+		collectionQuery, err := subsetBuilder.BuildQuery(tableMapping.Schema, tableMapping.Table)
+		if err != nil {
+			return nil, err
+		}
 		bc := &neosync_benthos.BenthosConfig{
 			StreamConfig: neosync_benthos.StreamConfig{
 				Input: &neosync_benthos.InputConfig{
@@ -44,7 +53,9 @@ func (b *benthosBuilder) getMongoDbSyncBenthosConfigResponses(
 							Url:        "${SOURCE_CONNECTION_DSN}",
 							Database:   tableMapping.Schema,
 							Collection: tableMapping.Table,
-							Query:      "root = this",
+							Operation:  &collectionQuery.Operation,
+							Query:      collectionQuery.Query,
+							Limit:      collectionQuery.Limit,
 						},
 					},
 				},
@@ -108,3 +119,46 @@ func (b *benthosBuilder) getMongoDbSyncBenthosConfigResponses(
 		BenthosConfigs: benthosConfigs,
 	}, nil
 }
+
+// Builds the output that writes each document to the collection according to the destination write mode.
+// Documents are upserted by their _id unless configured otherwise.
+func buildMongoDbOutput(dsn, database, collection string, writeMode mgmtv1alpha1.MongoDBWriteMode) *neosync_benthos.OutputMongoDb {
+	output := &neosync_benthos.OutputMongoDb{
+		Url: dsn,
+
+		Database:   database,
+		Collection: collection,
+		WriteConcern: &neosync_benthos.MongoWriteConcern{
+			W: "1",
+		},
+	}
+	switch writeMode {
+	case mgmtv1alpha1.MongoDBWriteMode_MONGO_DB_WRITE_MODE_INSERT:
+		// inserts fail on duplicate _id values instead of overwriting the existing document
+		output.Operation = "insert-one"
+		output.DocumentMap = `
+		  root = this
+		`
+	case mgmtv1alpha1.MongoDBWriteMode_MONGO_DB_WRITE_MODE_REPLACE:
+		output.Operation = "replace-one"
+		output.Upsert = true
+		output.DocumentMap = `
+		  root = this
+		`
+		output.FilterMap = `
+		  root._id = this._id
+		`
+	default:
+		output.Operation = "update-one"
+		output.Upsert = true
+		output.DocumentMap = `
+		  root = {
+				"$set": this
+			}
+		`
+		output.FilterMap = `
+		  root._id = this._id
+		`
+	}
+	return output
+}
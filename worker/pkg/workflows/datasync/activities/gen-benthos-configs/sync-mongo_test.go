@@ -0,0 +1,51 @@
+package genbenthosconfigs_activity
+
+import (
+	"testing"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/bento/public/bloblang"
+)
+
+func Test_buildMongoDbOutput(t *testing.T) {
+	doc := map[string]any{"_id": "abc", "name": "nick"}
+
+	t.Run("upsert by default", func(t *testing.T) {
+		output := buildMongoDbOutput("${DESTINATION_0_CONNECTION_DSN}", "shop", "orders", mgmtv1alpha1.MongoDBWriteMode_MONGO_DB_WRITE_MODE_UNSPECIFIED)
+		require.Equal(t, "update-one", output.Operation)
+		require.True(t, output.Upsert)
+		require.Equal(t, "shop", output.Database)
+		require.Equal(t, "orders", output.Collection)
+		require.Equal(t, map[string]any{"$set": doc}, execMongoWriteMapping(t, output.DocumentMap, doc))
+		require.Equal(t, map[string]any{"_id": "abc"}, execMongoWriteMapping(t, output.FilterMap, doc))
+
+		upsert := buildMongoDbOutput("${DESTINATION_0_CONNECTION_DSN}", "shop", "orders", mgmtv1alpha1.MongoDBWriteMode_MONGO_DB_WRITE_MODE_UPSERT)
+		require.Equal(t, output, upsert)
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		output := buildMongoDbOutput("${DESTINATION_0_CONNECTION_DSN}", "shop", "orders", mgmtv1alpha1.MongoDBWriteMode_MONGO_DB_WRITE_MODE_REPLACE)
+		require.Equal(t, "replace-one", output.Operation)
+		require.True(t, output.Upsert)
+		require.Equal(t, doc, execMongoWriteMapping(t, output.DocumentMap, doc))
+		require.Equal(t, map[string]any{"_id": "abc"}, execMongoWriteMapping(t, output.FilterMap, doc))
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		output := buildMongoDbOutput("${DESTINATION_0_CONNECTION_DSN}", "shop", "orders", mgmtv1alpha1.MongoDBWriteMode_MONGO_DB_WRITE_MODE_INSERT)
+		require.Equal(t, "insert-one", output.Operation)
+		require.False(t, output.Upsert)
+		require.Empty(t, output.FilterMap)
+		require.Equal(t, doc, execMongoWriteMapping(t, output.DocumentMap, doc))
+	})
+}
+
+func execMongoWriteMapping(t *testing.T, mapping string, input any) any {
+	t.Helper()
+	exec, err := bloblang.Parse(mapping)
+	require.NoError(t, err)
+	result, err := exec.Query(input)
+	require.NoError(t, err)
+	return result
+}
@@ -7,7 +7,6 @@ import (
 	"log/slog"
 	"slices"
 	"strings"
-	"time"
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
@@ -43,6 +42,122 @@ func (b *benthosBuilder) getSqlSyncBenthosConfigResponses(
 		"sourceConnectionType", sourceConnectionType,
 	)
 
+	db, err := b.sqlmanagerclient.NewPooledSqlDb(ctx, slogger, sourceConnection)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create new sql db: %w", err)
+	}
+	defer db.Db.Close()
+
+	plan, err := buildSqlSyncPlan(ctx, db.Db, db.Driver, job, func(ctx context.Context, table string) (*shared.TableWatermark, error) {
+		return b.getPreviousTableWatermark(ctx, job.GetAccountId(), table)
+	}, slogger)
+	if err != nil {
+		return nil, err
+	}
+	primaryKeyToForeignKeysMap := getPrimaryKeyDependencyMap(plan.filteredForeignKeysMap)
+
+	sourceResponses, err := buildBenthosSqlSourceConfigResponses(slogger, ctx, b.transformerclient, plan.groupedTableMapping, plan.runConfigs, sourceConnection.Id, db.Driver, plan.selectQueries, plan.groupedSchemas, plan.filteredForeignKeysMap, plan.colTransformerMap, b.jobId, b.runId, b.redisConfig, primaryKeyToForeignKeysMap, sourceConnectionType)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build benthos sql source config responses: %w", err)
+	}
+	for _, resp := range sourceResponses {
+		if resp.RunType != tabledependency.RunTypeInsert {
+			continue
+		}
+		if watermark, ok := plan.tableWatermarks[neosync_benthos.BuildBenthosTable(resp.TableSchema, resp.TableName)]; ok {
+			resp.watermark = watermark
+		}
+	}
+
+	return &sqlSyncResp{
+		BenthosConfigs:             sourceResponses,
+		primaryKeyToForeignKeysMap: primaryKeyToForeignKeysMap,
+		ColumnTransformerMap:       plan.colTransformerMap,
+		SchemaColumnInfoMap:        plan.groupedSchemas,
+	}, nil
+}
+
+// Returns the watermark recorded by the previous successful run of the job for the schema.table, nil if there is none
+type PreviousWatermarkGetter func(ctx context.Context, table string) (*shared.TableWatermark, error)
+
+// The table run configs and select queries that a sql sync job will run with
+type SqlSyncPlan struct {
+	RunConfigs []*tabledependency.RunConfig
+	// schema.table -> run type -> select query
+	SelectQueries map[string]map[tabledependency.RunType]string
+	// schema.table -> where clause that filters the rows the insert select query loads from the table itself.
+	// Tables that are not filtered are not present.
+	TableWhereClauses map[string]string
+}
+
+// Builds the table run configs and select queries of a sql sync job without generating any benthos configs
+func BuildSqlSyncPlan(
+	ctx context.Context,
+	db sqlmanager.SqlDatabase,
+	driver string,
+	job *mgmtv1alpha1.Job,
+	getPreviousWatermark PreviousWatermarkGetter,
+	slogger *slog.Logger,
+) (*SqlSyncPlan, error) {
+	plan, err := buildSqlSyncPlan(ctx, db, driver, job, getPreviousWatermark, slogger)
+	if err != nil {
+		return nil, err
+	}
+	return &SqlSyncPlan{
+		RunConfigs:        plan.runConfigs,
+		SelectQueries:     plan.selectQueries,
+		TableWhereClauses: getTableWhereClauses(plan.runConfigs, plan.tableWatermarkWhereClauses),
+	}, nil
+}
+
+// Combines the configured where clause of each insert run config with the watermark where clause of its table
+func getTableWhereClauses(
+	runConfigs []*tabledependency.RunConfig,
+	tableWatermarkWhereClauses map[string]string,
+) map[string]string {
+	whereClauses := map[string]string{}
+	for _, cfg := range runConfigs {
+		if cfg.RunType() != tabledependency.RunTypeInsert {
+			continue
+		}
+		clauses := []string{}
+		if cfg.WhereClause() != nil && *cfg.WhereClause() != "" {
+			clauses = append(clauses, *cfg.WhereClause())
+		}
+		if watermarkClause, ok := tableWatermarkWhereClauses[cfg.Table()]; ok {
+			clauses = append(clauses, watermarkClause)
+		}
+		switch len(clauses) {
+		case 0:
+		case 1:
+			whereClauses[cfg.Table()] = clauses[0]
+		default:
+			whereClauses[cfg.Table()] = fmt.Sprintf("(%s) AND (%s)", clauses[0], clauses[1])
+		}
+	}
+	return whereClauses
+}
+
+type sqlSyncPlan struct {
+	runConfigs             []*tabledependency.RunConfig
+	selectQueries          map[string]map[tabledependency.RunType]string
+	groupedSchemas         map[string]map[string]*sqlmanager_shared.ColumnInfo
+	groupedTableMapping    map[string]*tableMapping
+	colTransformerMap      map[string]map[string]*mgmtv1alpha1.JobMappingTransformer
+	filteredForeignKeysMap map[string][]*sqlmanager_shared.ForeignConstraint
+	tableWatermarks        map[string]*tableWatermark
+	// schema.table -> where clause that selects the rows between the previous and current watermarks
+	tableWatermarkWhereClauses map[string]string
+}
+
+func buildSqlSyncPlan(
+	ctx context.Context,
+	db sqlmanager.SqlDatabase,
+	driver string,
+	job *mgmtv1alpha1.Job,
+	getPreviousWatermark PreviousWatermarkGetter,
+	slogger *slog.Logger,
+) (*sqlSyncPlan, error) {
 	sqlSourceOpts, err := getSqlJobSourceOpts(job.Source)
 	if err != nil {
 		return nil, err
@@ -52,13 +167,7 @@ func (b *benthosBuilder) getSqlSyncBenthosConfigResponses(
 		sourceTableOpts = groupSqlJobSourceOptionsByTable(sqlSourceOpts)
 	}
 
-	db, err := b.sqlmanagerclient.NewPooledSqlDb(ctx, slogger, sourceConnection)
-	if err != nil {
-		return nil, fmt.Errorf("unable to create new sql db: %w", err)
-	}
-	defer db.Db.Close()
-
-	groupedSchemas, err := db.Db.GetSchemaColumnMap(ctx)
+	groupedSchemas, err := db.GetSchemaColumnMap(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get database schema for connection: %w", err)
 	}
@@ -71,7 +180,7 @@ func (b *benthosBuilder) getSqlSyncBenthosConfigResponses(
 	}
 	uniqueSchemas := shared.GetUniqueSchemasFromMappings(job.Mappings)
 
-	tableConstraints, err := db.Db.GetTableConstraintsBySchema(ctx, uniqueSchemas)
+	tableConstraints, err := db.GetTableConstraintsBySchema(ctx, uniqueSchemas)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve database table constraints: %w", err)
 	}
@@ -89,29 +198,38 @@ func (b *benthosBuilder) getSqlSyncBenthosConfigResponses(
 	colTransformerMap := getColumnTransformerMap(groupedTableMapping) // schema.table ->  column -> transformer
 	filteredForeignKeysMap := filterForeignKeysMap(colTransformerMap, foreignKeysMap)
 
+	tableWatermarks, err := getTableWatermarks(ctx, db, sourceTableOpts, groupedSchemas, getPreviousWatermark, slogger)
+	if err != nil {
+		return nil, err
+	}
+	tableWatermarkWhereClauses, err := buildTableWatermarkWhereClauses(driver, tableWatermarks)
+	if err != nil {
+		return nil, err
+	}
+
 	tableSubsetMap := buildTableSubsetMap(sourceTableOpts, groupedTableMapping)
 	tableColMap := getTableColMapFromMappings(groupedMappings)
 	runConfigs, err := tabledependency.GetRunConfigs(filteredForeignKeysMap, tableSubsetMap, tableConstraints.PrimaryKeyConstraints, tableColMap)
 	if err != nil {
 		return nil, err
 	}
-	primaryKeyToForeignKeysMap := getPrimaryKeyDependencyMap(filteredForeignKeysMap)
 
-	tableRunTypeQueryMap, err := querybuilder.BuildSelectQueryMap(db.Driver, filteredForeignKeysMap, runConfigs, sqlSourceOpts.SubsetByForeignKeyConstraints, groupedSchemas)
+	subsetByForeignKeyConstraints := sqlSourceOpts != nil && sqlSourceOpts.SubsetByForeignKeyConstraints
+	tableRunTypeQueryMap, err := querybuilder.BuildSelectQueryMap(driver, filteredForeignKeysMap, runConfigs, subsetByForeignKeyConstraints, groupedSchemas, tableWatermarkWhereClauses)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build select queries: %w", err)
 	}
 
-	sourceResponses, err := buildBenthosSqlSourceConfigResponses(slogger, ctx, b.transformerclient, groupedTableMapping, runConfigs, sourceConnection.Id, db.Driver, tableRunTypeQueryMap, groupedSchemas, filteredForeignKeysMap, colTransformerMap, b.jobId, b.runId, b.redisConfig, primaryKeyToForeignKeysMap, sourceConnectionType)
-	if err != nil {
-		return nil, fmt.Errorf("unable to build benthos sql source config responses: %w", err)
-	}
+	return &sqlSyncPlan{
+		runConfigs:             runConfigs,
+		selectQueries:          tableRunTypeQueryMap,
+		groupedSchemas:         groupedSchemas,
+		groupedTableMapping:    groupedTableMapping,
+		colTransformerMap:      colTransformerMap,
+		filteredForeignKeysMap: filteredForeignKeysMap,
+		tableWatermarks:        tableWatermarks,
 
-	return &sqlSyncResp{
-		BenthosConfigs:             sourceResponses,
-		primaryKeyToForeignKeysMap: primaryKeyToForeignKeysMap,
-		ColumnTransformerMap:       colTransformerMap,
-		SchemaColumnInfoMap:        groupedSchemas,
+		tableWatermarkWhereClauses: tableWatermarkWhereClauses,
 	}, nil
 }
 
@@ -551,6 +669,16 @@ func (b *benthosBuilder) getSqlSyncBenthosOutput(
 			}
 		}
 
+		onConflictUpdate, err := getOnConflictUpdate(destOpts, benthosConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		conflictColumns, err := getOnConflictDoNothingColumns(driver, destOpts, onConflictUpdate, benthosConfig)
+		if err != nil {
+			return nil, err
+		}
+
 		prefix, suffix := getInsertPrefixAndSuffix(driver, benthosConfig.TableSchema, benthosConfig.TableName, benthosConfig.ColumnDefaultProperties)
 		outputs = append(outputs, neosync_benthos.Outputs{
 			Fallback: []neosync_benthos.Outputs{
@@ -565,6 +693,8 @@ func (b *benthosBuilder) getSqlSyncBenthosOutput(
 						ColumnsDataTypes:         columnTypes,
 						ColumnDefaultProperties:  benthosConfig.ColumnDefaultProperties,
 						OnConflictDoNothing:      destOpts.OnConflictDoNothing,
+						ConflictColumns:          conflictColumns,
+						OnConflictUpdate:         onConflictUpdate,
 						SkipForeignKeyViolations: destOpts.SkipForeignKeyViolations,
 						TruncateOnRetry:          destOpts.Truncate,
 						ArgsMapping:              buildPlainInsertArgs(benthosConfig.Columns),
@@ -592,6 +722,49 @@ func (b *benthosBuilder) getSqlSyncBenthosOutput(
 	return outputs, nil
 }
 
+// Rows are upserted by primary key when the destination is configured to update on conflict
+// or when the table is incrementally synced, as previously synced rows may already exist in the destination.
+func getOnConflictUpdate(
+	destOpts *destinationOptions,
+	benthosConfig *BenthosConfigResponse,
+) (*neosync_benthos.PooledSqlOnConflictUpdate, error) {
+	if destOpts.OnConflictUpdate == nil && benthosConfig.watermark == nil {
+		return nil, nil
+	}
+	table := neosync_benthos.BuildBenthosTable(benthosConfig.TableSchema, benthosConfig.TableName)
+	if len(benthosConfig.primaryKeys) == 0 {
+		if benthosConfig.watermark != nil {
+			return nil, fmt.Errorf("incremental sync requires table %s to have a primary key", table)
+		}
+		return nil, fmt.Errorf("updating on conflict requires table %s to have a primary key", table)
+	}
+	var updateColumns []string
+	if destOpts.OnConflictUpdate != nil {
+		updateColumns = destOpts.OnConflictUpdate.Columns
+	}
+	return &neosync_benthos.PooledSqlOnConflictUpdate{
+		ConflictColumns: benthosConfig.primaryKeys,
+		UpdateColumns:   updateColumns,
+	}, nil
+}
+
+// SQL Server has no insert or ignore, so conflicting rows are skipped with a merge on the primary key.
+func getOnConflictDoNothingColumns(
+	driver string,
+	destOpts *destinationOptions,
+	onConflictUpdate *neosync_benthos.PooledSqlOnConflictUpdate,
+	benthosConfig *BenthosConfigResponse,
+) ([]string, error) {
+	if driver != sqlmanager_shared.MssqlDriver || !destOpts.OnConflictDoNothing || onConflictUpdate != nil {
+		return nil, nil
+	}
+	if len(benthosConfig.primaryKeys) == 0 {
+		table := neosync_benthos.BuildBenthosTable(benthosConfig.TableSchema, benthosConfig.TableName)
+		return nil, fmt.Errorf("skipping conflicting rows requires table %s to have a primary key", table)
+	}
+	return benthosConfig.primaryKeys, nil
+}
+
 func getInsertPrefixAndSuffix(
 	driver, schema, table string,
 	columnDefaultProperties map[string]*neosync_benthos.ColumnDefaultProperties,
@@ -625,59 +798,56 @@ func hasPassthroughIdentityColumn(columnDefaultProperties map[string]*neosync_be
 }
 
 func (b *benthosBuilder) getAwsS3SyncBenthosOutput(
+	connectionId string,
 	connection *mgmtv1alpha1.ConnectionConfig_AwsS3Config,
 	benthosConfig *BenthosConfigResponse,
+	job *mgmtv1alpha1.Job,
 	workflowId string,
 	destinationOptions *mgmtv1alpha1.AwsS3DestinationConnectionOptions,
+	colInfoMap map[string]*sqlmanager_shared.ColumnInfo,
 ) ([]neosync_benthos.Outputs, error) {
 	outputs := []neosync_benthos.Outputs{}
 
-	s3pathpieces := []string{}
-	if connection.AwsS3Config.PathPrefix != nil && *connection.AwsS3Config.PathPrefix != "" {
-		s3pathpieces = append(s3pathpieces, strings.Trim(*connection.AwsS3Config.PathPrefix, "/"))
+	format := destinationOptions.GetFormat()
+	processors, err := getBucketBatchProcessors(format, benthosConfig.Columns, colInfoMap)
+	if err != nil {
+		return nil, err
+	}
+	contentType := "application/gzip"
+	if format == mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_PARQUET {
+		contentType = parquetContentType
 	}
 
-	s3pathpieces = append(
-		s3pathpieces,
-		"workflows",
-		workflowId,
-		"activities",
-		neosync_benthos.BuildBenthosTable(benthosConfig.TableSchema, benthosConfig.TableName),
-		"data",
-		`records-${!count("files")}-${!timestamp_unix_nano()}.jsonl.gz`,
+	objectPath, err := getBucketObjectPath(
+		connection.AwsS3Config.GetPathPrefix(),
+		destinationOptions.PathTemplate,
+		fmt.Sprintf(`records-${!count("files")}-${!timestamp_unix_nano()}.%s`, getBucketFileExtension(format, "jsonl.gz")),
+		&bucketPathValues{
+			JobId:     job.GetId(),
+			JobName:   job.GetName(),
+			JobRunId:  workflowId,
+			Schema:    benthosConfig.TableSchema,
+			Table:     benthosConfig.TableName,
+			Extension: getBucketFileExtension(format, "jsonl.gz"),
+		},
 	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build s3 object path: %w", err)
+	}
 
 	maxInFlight := 64
 	if destinationOptions.GetMaxInFlight() > 0 {
 		maxInFlight = int(destinationOptions.GetMaxInFlight())
 	}
 
-	batchCount := 100
-	batchPeriod := "5s"
-	batchConfig := destinationOptions.GetBatch()
-	if batchConfig != nil {
-		batchCount = int(batchConfig.GetCount())
-
-		if batchConfig.GetPeriod() != "" {
-			_, err := time.ParseDuration(batchConfig.GetPeriod())
-			if err != nil {
-				return nil, fmt.Errorf("unable to parse batch period for s3 destination config: %w", err)
-			}
-		}
-		batchPeriod = batchConfig.GetPeriod()
-	}
-
-	if batchCount == 0 && batchPeriod == "" {
-		return nil, fmt.Errorf("must have at least one batch policy configured. Cannot disable both period and count")
+	batchCount, batchPeriod, err := getBucketBatchPolicy(destinationOptions.GetBatch())
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse batch config for s3 destination config: %w", err)
 	}
 
-	timeout := ""
-	if destinationOptions.GetTimeout() != "" {
-		_, err := time.ParseDuration(destinationOptions.GetTimeout())
-		if err != nil {
-			return nil, fmt.Errorf("unable to parse timeout for s3 destination config: %w", err)
-		}
-		timeout = destinationOptions.GetTimeout()
+	timeout, err := getBucketTimeout(destinationOptions.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse timeout for s3 destination config: %w", err)
 	}
 
 	storageClass := ""
@@ -693,17 +863,14 @@ func (b *benthosBuilder) getAwsS3SyncBenthosOutput(
 					MaxInFlight:  maxInFlight,
 					Timeout:      timeout,
 					StorageClass: storageClass,
-					Path:         strings.Join(s3pathpieces, "/"),
-					ContentType:  "application/gzip",
+					Path:         objectPath,
+					ContentType:  contentType,
 					Batching: &neosync_benthos.Batching{
-						Count:  batchCount,
-						Period: batchPeriod,
-						Processors: []*neosync_benthos.BatchProcessor{
-							{Archive: &neosync_benthos.ArchiveProcessor{Format: "lines"}},
-							{Compress: &neosync_benthos.CompressProcessor{Algorithm: "gzip"}},
-						},
+						Count:      batchCount,
+						Period:     batchPeriod,
+						Processors: processors,
 					},
-					Credentials: buildBenthosS3Credentials(connection.AwsS3Config.Credentials),
+					Credentials: buildBenthosS3Credentials(connectionId, connection.AwsS3Config.Credentials),
 					Region:      connection.AwsS3Config.GetRegion(),
 					Endpoint:    connection.AwsS3Config.GetEndpoint(),
 				},
@@ -771,41 +938,84 @@ func convertToS3StorageClass(protoStorageClass mgmtv1alpha1.AwsS3DestinationConn
 func (b *benthosBuilder) getGcpCloudStorageSyncBenthosOutput(
 	connection *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig,
 	benthosConfig *BenthosConfigResponse,
+	job *mgmtv1alpha1.Job,
 	workflowId string,
-) []neosync_benthos.Outputs {
+	destinationOptions *mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions,
+	colInfoMap map[string]*sqlmanager_shared.ColumnInfo,
+) ([]neosync_benthos.Outputs, error) {
 	outputs := []neosync_benthos.Outputs{}
 
-	pathpieces := []string{}
-	if connection.GcpCloudstorageConfig.GetPathPrefix() != "" {
-		pathpieces = append(pathpieces, strings.Trim(connection.GcpCloudstorageConfig.GetPathPrefix(), "/"))
+	format := destinationOptions.GetFormat()
+	processors, err := getBucketBatchProcessors(format, benthosConfig.Columns, colInfoMap)
+	if err != nil {
+		return nil, err
+	}
+	contentType := shared.Ptr("txt/plain")
+	contentEncoding := shared.Ptr("gzip")
+	switch format {
+	case mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_CSV:
+		contentType = shared.Ptr("text/csv")
+	case mgmtv1alpha1.BucketFileFormat_BUCKET_FILE_FORMAT_PARQUET:
+		contentType = shared.Ptr(parquetContentType)
+		contentEncoding = nil
 	}
 
-	pathpieces = append(
-		pathpieces,
-		"workflows",
-		workflowId,
-		"activities",
-		neosync_benthos.BuildBenthosTable(benthosConfig.TableSchema, benthosConfig.TableName),
-		"data",
-		`${!count("files")}.txt.gz`,
+	objectPath, err := getBucketObjectPath(
+		connection.GcpCloudstorageConfig.GetPathPrefix(),
+		destinationOptions.PathTemplate,
+		fmt.Sprintf(`${!count("files")}.%s`, getBucketFileExtension(format, "txt.gz")),
+		&bucketPathValues{
+			JobId:     job.GetId(),
+			JobName:   job.GetName(),
+			JobRunId:  workflowId,
+			Schema:    benthosConfig.TableSchema,
+			Table:     benthosConfig.TableName,
+			Extension: getBucketFileExtension(format, "txt.gz"),
+		},
 	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build gcs object path: %w", err)
+	}
+
+	maxInFlight := 64
+	if destinationOptions.GetMaxInFlight() > 0 {
+		maxInFlight = int(destinationOptions.GetMaxInFlight())
+	}
+
+	batchCount, batchPeriod, err := getBucketBatchPolicy(destinationOptions.GetBatch())
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse batch config for gcs destination config: %w", err)
+	}
+
+	timeout, err := getBucketTimeout(destinationOptions.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse timeout for gcs destination config: %w", err)
+	}
+	var gcsTimeout *string
+	if timeout != "" {
+		gcsTimeout = &timeout
+	}
+
+	var storageClass *string
+	if destinationOptions.GetStorageClass() != mgmtv1alpha1.GcpCloudStorageDestinationConnectionOptions_STORAGE_CLASS_UNSPECIFIED {
+		storageClass = shared.Ptr(strings.TrimPrefix(destinationOptions.GetStorageClass().String(), "STORAGE_CLASS_"))
+	}
 
 	outputs = append(outputs, neosync_benthos.Outputs{
 		Fallback: []neosync_benthos.Outputs{
 			{
-				GcpCloudStorage: &neosync_benthos.GcpCloudStorageOutput{
+				NeosyncGcpCloudStorage: &neosync_benthos.NeosyncGcpCloudStorageOutput{
 					Bucket:          connection.GcpCloudstorageConfig.GetBucket(),
-					MaxInFlight:     64,
-					Path:            strings.Join(pathpieces, "/"),
-					ContentType:     shared.Ptr("txt/plain"),
-					ContentEncoding: shared.Ptr("gzip"),
+					MaxInFlight:     maxInFlight,
+					Path:            objectPath,
+					ContentType:     contentType,
+					ContentEncoding: contentEncoding,
+					StorageClass:    storageClass,
+					Timeout:         gcsTimeout,
 					Batching: &neosync_benthos.Batching{
-						Count:  100,
-						Period: "5s",
-						Processors: []*neosync_benthos.BatchProcessor{
-							{Archive: &neosync_benthos.ArchiveProcessor{Format: "lines"}},
-							{Compress: &neosync_benthos.CompressProcessor{Algorithm: "gzip"}},
-						},
+						Count:      batchCount,
+						Period:     batchPeriod,
+						Processors: processors,
 					},
 				},
 			},
@@ -813,13 +1023,13 @@ func (b *benthosBuilder) getGcpCloudStorageSyncBenthosOutput(
 			{Error: &neosync_benthos.ErrorOutputConfig{
 				ErrorMsg: `${! meta("fallback_error")}`,
 				Batching: &neosync_benthos.Batching{
-					Period: "5s",
-					Count:  100,
+					Period: batchPeriod,
+					Count:  batchCount,
 				},
 			}},
 		},
 	})
-	return outputs
+	return outputs, nil
 }
 
 func getTableColMapFromMappings(mappings []*tableMapping) map[string][]string {
@@ -898,11 +1108,17 @@ func buildForeignKeySourceMap(tableDeps map[string][]*sqlmanager_shared.ForeignC
 
 type destinationOptions struct {
 	OnConflictDoNothing      bool
+	OnConflictUpdate         *onConflictUpdateOptions
 	Truncate                 bool
 	TruncateCascade          bool
 	SkipForeignKeyViolations bool
 }
 
+type onConflictUpdateOptions struct {
+	// When empty, all non-key columns are updated
+	Columns []string
+}
+
 func getDestinationOptions(dest *mgmtv1alpha1.JobDestination) *destinationOptions {
 	if dest == nil || dest.Options == nil || dest.Options.Config == nil {
 		return &destinationOptions{}
@@ -911,6 +1127,7 @@ func getDestinationOptions(dest *mgmtv1alpha1.JobDestination) *destinationOption
 	case *mgmtv1alpha1.JobDestinationOptions_PostgresOptions:
 		return &destinationOptions{
 			OnConflictDoNothing:      config.PostgresOptions.GetOnConflict().GetDoNothing(),
+			OnConflictUpdate:         getOnConflictUpdateOptions(config.PostgresOptions.GetOnConflict().GetUpdate() != nil, config.PostgresOptions.GetOnConflict().GetUpdate().GetColumns()),
 			Truncate:                 config.PostgresOptions.GetTruncateTable().GetTruncateBeforeInsert(),
 			TruncateCascade:          config.PostgresOptions.GetTruncateTable().GetCascade(),
 			SkipForeignKeyViolations: config.PostgresOptions.GetSkipForeignKeyViolations(),
@@ -918,18 +1135,35 @@ func getDestinationOptions(dest *mgmtv1alpha1.JobDestination) *destinationOption
 	case *mgmtv1alpha1.JobDestinationOptions_MysqlOptions:
 		return &destinationOptions{
 			OnConflictDoNothing:      config.MysqlOptions.GetOnConflict().GetDoNothing(),
+			OnConflictUpdate:         getOnConflictUpdateOptions(config.MysqlOptions.GetOnConflict().GetUpdate() != nil, config.MysqlOptions.GetOnConflict().GetUpdate().GetColumns()),
 			Truncate:                 config.MysqlOptions.GetTruncateTable().GetTruncateBeforeInsert(),
 			SkipForeignKeyViolations: config.MysqlOptions.GetSkipForeignKeyViolations(),
 		}
 	case *mgmtv1alpha1.JobDestinationOptions_MssqlOptions:
 		return &destinationOptions{
+			OnConflictDoNothing:      config.MssqlOptions.GetOnConflict().GetDoNothing(),
+			OnConflictUpdate:         getOnConflictUpdateOptions(config.MssqlOptions.GetOnConflict().GetUpdate() != nil, config.MssqlOptions.GetOnConflict().GetUpdate().GetColumns()),
+			Truncate:                 config.MssqlOptions.GetTruncateTable().GetTruncateBeforeInsert(),
 			SkipForeignKeyViolations: config.MssqlOptions.GetSkipForeignKeyViolations(),
 		}
+	case *mgmtv1alpha1.JobDestinationOptions_SqliteOptions:
+		return &destinationOptions{
+			OnConflictDoNothing:      config.SqliteOptions.GetOnConflict().GetDoNothing(),
+			Truncate:                 config.SqliteOptions.GetTruncateTable().GetTruncateBeforeInsert(),
+			SkipForeignKeyViolations: config.SqliteOptions.GetSkipForeignKeyViolations(),
+		}
 	default:
 		return &destinationOptions{}
 	}
 }
 
+func getOnConflictUpdateOptions(enabled bool, columns []string) *onConflictUpdateOptions {
+	if !enabled {
+		return nil
+	}
+	return &onConflictUpdateOptions{Columns: columns}
+}
+
 type sqlJobSourceOpts struct {
 	HaltOnNewColumnAddition       bool
 	SubsetByForeignKeyConstraints bool
@@ -940,8 +1174,9 @@ type schemaOptions struct {
 	Tables []*tableOptions
 }
 type tableOptions struct {
-	Table       string
-	WhereClause *string
+	Table           string
+	WhereClause     *string
+	WatermarkColumn *string
 }
 
 func getSqlJobSourceOpts(
@@ -957,8 +1192,9 @@ func getSqlJobSourceOpts(
 			tableOpts := []*tableOptions{}
 			for _, t := range opt.GetTables() {
 				tableOpts = append(tableOpts, &tableOptions{
-					Table:       t.Table,
-					WhereClause: t.WhereClause,
+					Table:           t.Table,
+					WhereClause:     t.WhereClause,
+					WatermarkColumn: t.WatermarkColumn,
 				})
 			}
 			schemaOpt = append(schemaOpt, &schemaOptions{
@@ -980,8 +1216,9 @@ func getSqlJobSourceOpts(
 			tableOpts := []*tableOptions{}
 			for _, t := range opt.GetTables() {
 				tableOpts = append(tableOpts, &tableOptions{
-					Table:       t.Table,
-					WhereClause: t.WhereClause,
+					Table:           t.Table,
+					WhereClause:     t.WhereClause,
+					WatermarkColumn: t.WatermarkColumn,
 				})
 			}
 			schemaOpt = append(schemaOpt, &schemaOptions{
@@ -1003,8 +1240,9 @@ func getSqlJobSourceOpts(
 			tableOpts := []*tableOptions{}
 			for _, t := range opt.GetTables() {
 				tableOpts = append(tableOpts, &tableOptions{
-					Table:       t.Table,
-					WhereClause: t.WhereClause,
+					Table:           t.Table,
+					WhereClause:     t.WhereClause,
+					WatermarkColumn: t.WatermarkColumn,
 				})
 			}
 			schemaOpt = append(schemaOpt, &schemaOptions{
@@ -1017,12 +1255,35 @@ func getSqlJobSourceOpts(
 			SubsetByForeignKeyConstraints: jobSourceConfig.Mssql.SubsetByForeignKeyConstraints,
 			SchemaOpt:                     schemaOpt,
 		}, nil
+	case *mgmtv1alpha1.JobSourceOptions_Sqlite:
+		if jobSourceConfig.Sqlite == nil {
+			return nil, nil
+		}
+		schemaOpt := []*schemaOptions{}
+		for _, opt := range jobSourceConfig.Sqlite.Schemas {
+			tableOpts := []*tableOptions{}
+			for _, t := range opt.GetTables() {
+				tableOpts = append(tableOpts, &tableOptions{
+					Table:       t.Table,
+					WhereClause: t.WhereClause,
+				})
+			}
+			schemaOpt = append(schemaOpt, &schemaOptions{
+				Schema: opt.GetSchema(),
+				Tables: tableOpts,
+			})
+		}
+		return &sqlJobSourceOpts{
+			HaltOnNewColumnAddition:       jobSourceConfig.Sqlite.HaltOnNewColumnAddition,
+			SubsetByForeignKeyConstraints: jobSourceConfig.Sqlite.SubsetByForeignKeyConstraints,
+			SchemaOpt:                     schemaOpt,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported job source options type for sql job source: %T", jobSourceConfig)
 	}
 }
 
-func buildBenthosS3Credentials(mgmtCreds *mgmtv1alpha1.AwsS3Credentials) *neosync_benthos.AwsCredentials {
+func buildBenthosS3Credentials(connectionId string, mgmtCreds *mgmtv1alpha1.AwsS3Credentials) *neosync_benthos.AwsCredentials {
 	if mgmtCreds == nil {
 		return nil
 	}
@@ -1031,13 +1292,13 @@ func buildBenthosS3Credentials(mgmtCreds *mgmtv1alpha1.AwsS3Credentials) *neosyn
 		creds.Profile = *mgmtCreds.Profile
 	}
 	if mgmtCreds.AccessKeyId != nil {
-		creds.Id = *mgmtCreds.AccessKeyId
+		creds.Id = shared.GetConnectionCredentialEnvVar(connectionId, shared.CredentialAwsAccessKeyId, *mgmtCreds.AccessKeyId)
 	}
 	if mgmtCreds.SecretAccessKey != nil {
-		creds.Secret = *mgmtCreds.SecretAccessKey
+		creds.Secret = shared.GetConnectionCredentialEnvVar(connectionId, shared.CredentialAwsSecretAccessKey, *mgmtCreds.SecretAccessKey)
 	}
 	if mgmtCreds.SessionToken != nil {
-		creds.Token = *mgmtCreds.SessionToken
+		creds.Token = shared.GetConnectionCredentialEnvVar(connectionId, shared.CredentialAwsSessionToken, *mgmtCreds.SessionToken)
 	}
 	if mgmtCreds.FromEc2Role != nil {
 		creds.FromEc2Role = *mgmtCreds.FromEc2Role
@@ -1133,7 +1394,8 @@ func shouldHaltOnSchemaAddition(
 }
 
 type sqlSourceTableOptions struct {
-	WhereClause *string
+	WhereClause     *string
+	WatermarkColumn *string
 }
 
 func buildTableSubsetMap(tableOpts map[string]*sqlSourceTableOptions, tableMap map[string]*tableMapping) map[string]string {
@@ -9,6 +9,7 @@ import (
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"github.com/stretchr/testify/require"
 )
 
@@ -198,3 +199,150 @@ func Test_BuildMssqlPostTableSyncStatement(t *testing.T) {
 		require.Equal(t, expectedSomeOverride, resultSomeOverride, "Unexpected result when some columns need override")
 	})
 }
+
+func Test_getDestinationOptions_OnConflictUpdate(t *testing.T) {
+	t.Run("postgres", func(t *testing.T) {
+		actual := getDestinationOptions(&mgmtv1alpha1.JobDestination{
+			Options: &mgmtv1alpha1.JobDestinationOptions{
+				Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
+					PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{
+						OnConflict: &mgmtv1alpha1.PostgresOnConflictConfig{
+							Update: &mgmtv1alpha1.PostgresOnConflictUpdateConfig{Columns: []string{"name"}},
+						},
+					},
+				},
+			},
+		})
+		require.Equal(t, &onConflictUpdateOptions{Columns: []string{"name"}}, actual.OnConflictUpdate)
+	})
+
+	t.Run("mssql", func(t *testing.T) {
+		actual := getDestinationOptions(&mgmtv1alpha1.JobDestination{
+			Options: &mgmtv1alpha1.JobDestinationOptions{
+				Config: &mgmtv1alpha1.JobDestinationOptions_MssqlOptions{
+					MssqlOptions: &mgmtv1alpha1.MssqlDestinationConnectionOptions{
+						OnConflict: &mgmtv1alpha1.MssqlOnConflictConfig{
+							Update: &mgmtv1alpha1.MssqlOnConflictUpdateConfig{},
+						},
+					},
+				},
+			},
+		})
+		require.Equal(t, &onConflictUpdateOptions{}, actual.OnConflictUpdate)
+	})
+
+	t.Run("do nothing", func(t *testing.T) {
+		actual := getDestinationOptions(&mgmtv1alpha1.JobDestination{
+			Options: &mgmtv1alpha1.JobDestinationOptions{
+				Config: &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{
+					MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{
+						OnConflict: &mgmtv1alpha1.MysqlOnConflictConfig{DoNothing: true},
+					},
+				},
+			},
+		})
+		require.True(t, actual.OnConflictDoNothing)
+		require.Nil(t, actual.OnConflictUpdate)
+	})
+
+	t.Run("mssql do nothing and truncate", func(t *testing.T) {
+		actual := getDestinationOptions(&mgmtv1alpha1.JobDestination{
+			Options: &mgmtv1alpha1.JobDestinationOptions{
+				Config: &mgmtv1alpha1.JobDestinationOptions_MssqlOptions{
+					MssqlOptions: &mgmtv1alpha1.MssqlDestinationConnectionOptions{
+						OnConflict:    &mgmtv1alpha1.MssqlOnConflictConfig{DoNothing: true},
+						TruncateTable: &mgmtv1alpha1.MssqlTruncateTableConfig{TruncateBeforeInsert: true},
+					},
+				},
+			},
+		})
+		require.True(t, actual.OnConflictDoNothing)
+		require.True(t, actual.Truncate)
+		require.Nil(t, actual.OnConflictUpdate)
+	})
+}
+
+func Test_getOnConflictDoNothingColumns(t *testing.T) {
+	benthosConfig := &BenthosConfigResponse{TableSchema: "dbo", TableName: "users", primaryKeys: []string{"id"}}
+
+	t.Run("mssql", func(t *testing.T) {
+		actual, err := getOnConflictDoNothingColumns(sqlmanager_shared.MssqlDriver, &destinationOptions{OnConflictDoNothing: true}, nil, benthosConfig)
+		require.NoError(t, err)
+		require.Equal(t, []string{"id"}, actual)
+	})
+
+	t.Run("other drivers", func(t *testing.T) {
+		actual, err := getOnConflictDoNothingColumns(sqlmanager_shared.PostgresDriver, &destinationOptions{OnConflictDoNothing: true}, nil, benthosConfig)
+		require.NoError(t, err)
+		require.Nil(t, actual)
+	})
+
+	t.Run("update takes precedence", func(t *testing.T) {
+		actual, err := getOnConflictDoNothingColumns(
+			sqlmanager_shared.MssqlDriver,
+			&destinationOptions{OnConflictDoNothing: true},
+			&neosync_benthos.PooledSqlOnConflictUpdate{ConflictColumns: []string{"id"}},
+			benthosConfig,
+		)
+		require.NoError(t, err)
+		require.Nil(t, actual)
+	})
+
+	t.Run("requires primary key", func(t *testing.T) {
+		_, err := getOnConflictDoNothingColumns(sqlmanager_shared.MssqlDriver, &destinationOptions{OnConflictDoNothing: true}, nil, &BenthosConfigResponse{TableSchema: "dbo", TableName: "users"})
+		require.Error(t, err)
+	})
+}
+
+func Test_getOnConflictUpdate(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		actual, err := getOnConflictUpdate(&destinationOptions{}, &BenthosConfigResponse{})
+		require.NoError(t, err)
+		require.Nil(t, actual)
+	})
+
+	t.Run("update columns", func(t *testing.T) {
+		actual, err := getOnConflictUpdate(
+			&destinationOptions{OnConflictUpdate: &onConflictUpdateOptions{Columns: []string{"name"}}},
+			&BenthosConfigResponse{TableSchema: "public", TableName: "users", primaryKeys: []string{"id"}},
+		)
+		require.NoError(t, err)
+		require.Equal(t, &neosync_benthos.PooledSqlOnConflictUpdate{ConflictColumns: []string{"id"}, UpdateColumns: []string{"name"}}, actual)
+	})
+
+	t.Run("incremental", func(t *testing.T) {
+		actual, err := getOnConflictUpdate(&destinationOptions{}, &BenthosConfigResponse{
+			TableSchema: "public",
+			TableName:   "users",
+			primaryKeys: []string{"id"},
+			watermark:   &tableWatermark{Column: "updated_at"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, &neosync_benthos.PooledSqlOnConflictUpdate{ConflictColumns: []string{"id"}}, actual)
+	})
+
+	t.Run("requires primary key", func(t *testing.T) {
+		_, err := getOnConflictUpdate(
+			&destinationOptions{OnConflictUpdate: &onConflictUpdateOptions{}},
+			&BenthosConfigResponse{TableSchema: "public", TableName: "users"},
+		)
+		require.Error(t, err)
+	})
+}
+
+func Test_getTableWhereClauses(t *testing.T) {
+	runConfigs := []*tabledependency.RunConfig{
+		tabledependency.NewRunConfig("public.users", tabledependency.RunTypeInsert, []string{"id"}, shared.Ptr("name = 'foo'"), nil, nil, nil, false),
+		tabledependency.NewRunConfig("public.users", tabledependency.RunTypeUpdate, []string{"id"}, shared.Ptr("name = 'foo'"), nil, nil, nil, false),
+		tabledependency.NewRunConfig("public.orders", tabledependency.RunTypeInsert, []string{"id"}, nil, nil, nil, nil, false),
+		tabledependency.NewRunConfig("public.accounts", tabledependency.RunTypeInsert, []string{"id"}, nil, nil, nil, nil, false),
+	}
+	actual := getTableWhereClauses(runConfigs, map[string]string{
+		"public.users":  `"updated_at" > '2024-01-01'`,
+		"public.orders": `"id" > 10`,
+	})
+	require.Equal(t, map[string]string{
+		"public.users":  `(name = 'foo') AND ("updated_at" > '2024-01-01')`,
+		"public.orders": `"id" > 10`,
+	}, actual)
+}
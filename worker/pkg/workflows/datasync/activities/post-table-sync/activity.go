@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"connectrpc.com/connect"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
@@ -69,10 +68,10 @@ func (a *Activity) RunPostTableSync(
 			AccountId:  req.AccountId,
 		},
 	}))
-	if err != nil && runContextNotFound(err) {
+	if err != nil && shared.IsRunContextNotFound(err) {
 		slogger.Info("no runcontext found. continuing")
 		return nil, nil
-	} else if err != nil && !runContextNotFound(err) {
+	} else if err != nil && !shared.IsRunContextNotFound(err) {
 		return nil, fmt.Errorf("unable to retrieve posttablesync runcontext for %s: %w", req.Name, err)
 	}
 
@@ -88,7 +87,7 @@ func (a *Activity) RunPostTableSync(
 		return nil, fmt.Errorf("unable to unmarshal posttablesync runcontext for %s: %w", req.Name, err)
 	}
 
-	if len(config.DestinationConfigs) == 0 {
+	if len(config.DestinationConfigs) == 0 && config.Watermark == nil {
 		slogger.Debug("post table sync destination configs empty")
 		return &RunPostTableSyncResponse{}, nil
 	}
@@ -103,7 +102,7 @@ func (a *Activity) RunPostTableSync(
 			return nil, fmt.Errorf("unable to get destination connection (%s) by id: %w", destConnectionId, err)
 		}
 		switch destinationConnection.GetConnectionConfig().GetConfig().(type) {
-		case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
 			destDb, err := a.sqlmanagerclient.NewPooledSqlDb(ctx, slogger, destinationConnection)
 			if err != nil {
 				destDb.Db.Close()
@@ -120,9 +119,37 @@ func (a *Activity) RunPostTableSync(
 		}
 	}
 
+	if config.Watermark != nil {
+		err = a.setTableWatermark(ctx, req.AccountId, config.Watermark)
+		if err != nil {
+			return nil, fmt.Errorf("unable to record watermark for %s: %w", config.Watermark.Table, err)
+		}
+		slogger.Debug("recorded table watermark", "table", config.Watermark.Table, "column", config.Watermark.Column)
+	}
+
 	return &RunPostTableSyncResponse{}, nil
 }
 
-func runContextNotFound(err error) bool {
-	return strings.Contains(err.Error(), "no run context exists")
+// Records the watermark under the job so that the next run of the job only selects rows past it
+func (a *Activity) setTableWatermark(
+	ctx context.Context,
+	accountId string,
+	watermark *shared.PostTableSyncWatermark,
+) error {
+	bits, err := json.Marshal(&shared.TableWatermark{
+		Column: watermark.Column,
+		Value:  watermark.Value,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to marshal table watermark: %w", err)
+	}
+	_, err = a.jobclient.SetRunContext(ctx, connect.NewRequest(&mgmtv1alpha1.SetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{
+			JobRunId:   watermark.JobId,
+			ExternalId: shared.GetWatermarkExternalId(watermark.Table),
+			AccountId:  accountId,
+		},
+		Value: bits,
+	}))
+	return err
 }
@@ -94,6 +94,60 @@ func Test_Activity_Success(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_Activity_RecordsWatermark(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	accountId := uuid.NewString()
+	jobId := uuid.NewString()
+	name := "public.users.insert"
+	configBits, err := json.Marshal(&shared.PostTableSyncConfig{
+		DestinationConfigs: map[string]*shared.PostTableSyncDestConfig{},
+		Watermark: &shared.PostTableSyncWatermark{
+			JobId:  jobId,
+			Table:  "public.users",
+			Column: "updated_at",
+			Value:  "2024-01-01 00:00:00",
+		},
+	})
+	require.NoError(t, err)
+
+	var recorded *mgmtv1alpha1.SetRunContextRequest
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.JobServiceGetRunContextProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.JobServiceGetRunContextProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetRunContextRequest]) (*connect.Response[mgmtv1alpha1.GetRunContextResponse], error) {
+			return connect.NewResponse(&mgmtv1alpha1.GetRunContextResponse{
+				Value: configBits,
+			}), nil
+		},
+	))
+	mux.Handle(mgmtv1alpha1connect.JobServiceSetRunContextProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.JobServiceSetRunContextProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.SetRunContextRequest]) (*connect.Response[mgmtv1alpha1.SetRunContextResponse], error) {
+			recorded = r.Msg
+			return connect.NewResponse(&mgmtv1alpha1.SetRunContextResponse{}), nil
+		},
+	))
+	srv := startHTTPServer(t, mux)
+
+	jobclient := mgmtv1alpha1connect.NewJobServiceClient(srv.Client(), srv.URL)
+	connclient := mgmtv1alpha1connect.NewConnectionServiceClient(srv.Client(), srv.URL)
+
+	activity := New(jobclient, mockSqlManager(), connclient)
+
+	env.RegisterActivity(activity)
+
+	_, err = env.ExecuteActivity(activity.RunPostTableSync, &RunPostTableSyncRequest{Name: name, AccountId: accountId})
+	require.NoError(t, err)
+
+	require.NotNil(t, recorded)
+	require.Equal(t, jobId, recorded.GetId().GetJobRunId())
+	require.Equal(t, shared.GetWatermarkExternalId("public.users"), recorded.GetId().GetExternalId())
+	require.Equal(t, accountId, recorded.GetId().GetAccountId())
+	require.JSONEq(t, `{"column":"updated_at","value":"2024-01-01 00:00:00"}`, string(recorded.GetValue()))
+}
+
 func Test_Activity_RunContextNotFound(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestActivityEnvironment()
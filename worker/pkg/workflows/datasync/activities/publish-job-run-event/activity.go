@@ -0,0 +1,90 @@
+package publishjobrunevent_activity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+)
+
+type Activity struct {
+	notificationclient mgmtv1alpha1connect.NotificationServiceClient
+}
+
+func New(
+	notificationclient mgmtv1alpha1connect.NotificationServiceClient,
+) *Activity {
+	return &Activity{
+		notificationclient: notificationclient,
+	}
+}
+
+type PublishJobRunEventRequest struct {
+	JobId        string
+	JobRunId     string
+	EventType    mgmtv1alpha1.JobRunEventType
+	ErrorMessage *string
+}
+
+type PublishJobRunEventResponse struct {
+	// The number of notification rules the event was delivered to
+	DeliveryCount int
+	// The number of deliveries that did not succeed after retrying
+	FailedDeliveryCount int
+}
+
+func (a *Activity) PublishJobRunEvent(
+	ctx context.Context,
+	req *PublishJobRunEventRequest,
+) (*PublishJobRunEventResponse, error) {
+	activityInfo := activity.GetInfo(ctx)
+	logger := log.With(
+		activity.GetLogger(ctx),
+		"jobId", req.JobId,
+		"eventType", req.EventType.String(),
+		"WorkflowID", activityInfo.WorkflowExecution.ID,
+		"RunID", activityInfo.WorkflowExecution.RunID,
+	)
+
+	go func() {
+		for {
+			select {
+			case <-time.After(30 * time.Second):
+				activity.RecordHeartbeat(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	logger.Debug("publishing job run event")
+
+	resp, err := a.notificationclient.PublishJobRunEvent(ctx, connect.NewRequest(&mgmtv1alpha1.PublishJobRunEventRequest{
+		JobId:        req.JobId,
+		JobRunId:     req.JobRunId,
+		EventType:    req.EventType,
+		ErrorMessage: req.ErrorMessage,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish job run event: %w", err)
+	}
+
+	failed := 0
+	for _, delivery := range resp.Msg.GetDeliveries() {
+		if !delivery.GetSucceeded() {
+			failed++
+			logger.Warn("job run event was not delivered", "ruleId", delivery.GetRuleId(), "error", delivery.GetError())
+		}
+	}
+
+	return &PublishJobRunEventResponse{
+		DeliveryCount:       len(resp.Msg.GetDeliveries()),
+		FailedDeliveryCount: failed,
+	}, nil
+}
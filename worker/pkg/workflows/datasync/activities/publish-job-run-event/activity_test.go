@@ -0,0 +1,92 @@
+package publishjobrunevent_activity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func Test_Activity_PublishJobRunEvent(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	jobId := uuid.NewString()
+	errMsg := "sync failed"
+
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.NotificationServicePublishJobRunEventProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.NotificationServicePublishJobRunEventProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.PublishJobRunEventRequest]) (*connect.Response[mgmtv1alpha1.PublishJobRunEventResponse], error) {
+			require.Equal(t, jobId, r.Msg.GetJobId())
+			require.Equal(t, "run-id", r.Msg.GetJobRunId())
+			require.Equal(t, mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_FAILED, r.Msg.GetEventType())
+			require.Equal(t, errMsg, r.Msg.GetErrorMessage())
+			return connect.NewResponse(&mgmtv1alpha1.PublishJobRunEventResponse{
+				Deliveries: []*mgmtv1alpha1.NotificationDelivery{
+					{RuleId: uuid.NewString(), Succeeded: true},
+					{RuleId: uuid.NewString(), Succeeded: false},
+				},
+			}), nil
+		},
+	))
+	srv := startHTTPServer(t, mux)
+
+	notificationclient := mgmtv1alpha1connect.NewNotificationServiceClient(srv.Client(), srv.URL)
+	activity := New(notificationclient)
+
+	env.RegisterActivity(activity)
+
+	val, err := env.ExecuteActivity(activity.PublishJobRunEvent, &PublishJobRunEventRequest{
+		JobId:        jobId,
+		JobRunId:     "run-id",
+		EventType:    mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_FAILED,
+		ErrorMessage: &errMsg,
+	})
+	require.NoError(t, err)
+	res := &PublishJobRunEventResponse{}
+	err = val.Get(res)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.DeliveryCount)
+	require.Equal(t, 1, res.FailedDeliveryCount)
+}
+
+func Test_Activity_PublishJobRunEvent_Error(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.NotificationServicePublishJobRunEventProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.NotificationServicePublishJobRunEventProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.PublishJobRunEventRequest]) (*connect.Response[mgmtv1alpha1.PublishJobRunEventResponse], error) {
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		},
+	))
+	srv := startHTTPServer(t, mux)
+
+	activity := New(mgmtv1alpha1connect.NewNotificationServiceClient(srv.Client(), srv.URL))
+	env.RegisterActivity(activity)
+
+	_, err := env.ExecuteActivity(activity.PublishJobRunEvent, &PublishJobRunEventRequest{
+		JobId:     uuid.NewString(),
+		JobRunId:  "run-id",
+		EventType: mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_STARTED,
+	})
+	require.Error(t, err)
+}
+
+func startHTTPServer(tb testing.TB, h http.Handler) *httptest.Server {
+	tb.Helper()
+	srv := httptest.NewUnstartedServer(h)
+	srv.EnableHTTP2 = true
+	srv.Start()
+	tb.Cleanup(srv.Close)
+	return srv
+}
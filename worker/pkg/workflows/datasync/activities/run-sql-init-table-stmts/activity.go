@@ -6,6 +6,7 @@ import (
 
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	neosynclogger "github.com/nucleuscloud/neosync/backend/pkg/logger"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/log"
@@ -15,23 +16,29 @@ type Activity struct {
 	jobclient  mgmtv1alpha1connect.JobServiceClient
 	connclient mgmtv1alpha1connect.ConnectionServiceClient
 
-	sqlmanager sql_manager.SqlManagerClient
+	sqlmanager     sql_manager.SqlManagerClient
+	mongoconnector mongoconnect.Interface
 }
 
 func New(
 	jobclient mgmtv1alpha1connect.JobServiceClient,
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
 	sqlmanager sql_manager.SqlManagerClient,
+	mongoconnector mongoconnect.Interface,
 ) *Activity {
 	return &Activity{
-		jobclient:  jobclient,
-		connclient: connclient,
-		sqlmanager: sqlmanager,
+		jobclient:      jobclient,
+		connclient:     connclient,
+		sqlmanager:     sqlmanager,
+		mongoconnector: mongoconnector,
 	}
 }
 
 type RunSqlInitTableStatementsRequest struct {
 	JobId string
+	// When set, schema init is skipped and only the rows of these tables (schema.table) are deleted from the destinations that truncate their tables.
+	// Used when resuming a job run to clear out the tables that were partially written by the resumed run.
+	ClearTables []string
 }
 
 type RunSqlInitTableStatementsResponse struct {
@@ -65,6 +72,7 @@ func (a *Activity) RunSqlInitTableStatements(
 		a.sqlmanager,
 		a.jobclient,
 		a.connclient,
+		a.mongoconnector,
 	)
 	slogger := neosynclogger.NewJsonSLogger().With(
 		"jobId", req.JobId,
@@ -9,11 +9,14 @@ import (
 	"connectrpc.com/connect"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	sqlmanager_mssql "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/mssql"
 	sqlmanager_mysql "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/mysql"
 	sqlmanager_postgres "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/postgres"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	sqlmanager_sqlite "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/sqlite"
+	sqlmanager_translate "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/translate"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 )
@@ -23,20 +26,23 @@ const (
 )
 
 type initStatementBuilder struct {
-	sqlmanager sql_manager.SqlManagerClient
-	jobclient  mgmtv1alpha1connect.JobServiceClient
-	connclient mgmtv1alpha1connect.ConnectionServiceClient
+	sqlmanager     sql_manager.SqlManagerClient
+	jobclient      mgmtv1alpha1connect.JobServiceClient
+	connclient     mgmtv1alpha1connect.ConnectionServiceClient
+	mongoconnector mongoconnect.Interface
 }
 
 func newInitStatementBuilder(
 	sqlmanager sql_manager.SqlManagerClient,
 	jobclient mgmtv1alpha1connect.JobServiceClient,
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	mongoconnector mongoconnect.Interface,
 ) *initStatementBuilder {
 	return &initStatementBuilder{
-		sqlmanager: sqlmanager,
-		jobclient:  jobclient,
-		connclient: connclient,
+		sqlmanager:     sqlmanager,
+		jobclient:      jobclient,
+		connclient:     connclient,
+		mongoconnector: mongoconnector,
 	}
 }
 
@@ -67,7 +73,15 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 		}
 	}
 
-	if sourceConnection.GetConnectionConfig().GetMongoConfig() != nil || sourceConnection.GetConnectionConfig().GetDynamodbConfig() != nil {
+	if sourceConnection.GetConnectionConfig().GetMongoConfig() != nil {
+		err = b.runMongoInitStatements(ctx, job, sourceConnection, req.ClearTables, slogger)
+		if err != nil {
+			return nil, err
+		}
+		return &RunSqlInitTableStatementsResponse{}, nil
+	}
+
+	if sourceConnection.GetConnectionConfig().GetDynamodbConfig() != nil || sourceConnection.GetConnectionConfig().GetKafkaConfig() != nil || sourceConnection.GetConnectionConfig().GetLocalDirConfig() != nil {
 		return &RunSqlInitTableStatementsResponse{}, nil
 	}
 
@@ -77,9 +91,6 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 	}
 	defer sourcedb.Db.Close()
 
-	uniqueTables := shared.GetUniqueTablesMapFromJob(job)
-	uniqueSchemas := shared.GetUniqueSchemasFromJob(job)
-
 	for _, destination := range job.Destinations {
 		destinationConnection, err := shared.GetConnectionById(ctx, b.connclient, destination.ConnectionId)
 		if err != nil {
@@ -93,257 +104,443 @@ func (b *initStatementBuilder) RunSqlInitTableStatements(
 			// nothing to do for Bucket destinations
 			continue
 		}
-		sqlopts, err := shared.GetSqlJobDestinationOpts(destination.GetOptions())
+		sqlopts, err := getDestinationSqlOpts(job, destination, slogger)
 		if err != nil {
 			return nil, err
 		}
 
-		if job.GetSource().GetOptions().GetAiGenerate() != nil {
-			fkSrcConnId := job.GetSource().GetOptions().GetAiGenerate().GetFkSourceConnectionId()
-			if fkSrcConnId == destination.GetConnectionId() && sqlopts.InitSchema {
-				slogger.Warn("cannot init schema when destination connection is the same as the foreign key source connection")
-				sqlopts.InitSchema = false
-			}
-		}
-
-		if job.GetSource().GetOptions().GetGenerate() != nil {
-			fkSrcConnId := job.GetSource().GetOptions().GetGenerate().GetFkSourceConnectionId()
-			if fkSrcConnId == destination.GetConnectionId() && sqlopts.InitSchema {
-				slogger.Warn("cannot init schema when destination connection is the same as the foreign key source connection")
-				sqlopts.InitSchema = false
-			}
+		if len(req.ClearTables) > 0 && !sqlopts.TruncateCascade && !sqlopts.TruncateBeforeInsert {
+			// rows written by the resumed run can not be told apart from existing rows, the destination's on conflict config handles the rerun
+			slogger.Info("skipping clearing of tables as destination does not truncate its tables")
+			continue
 		}
-
-		if !sqlopts.TruncateCascade && !sqlopts.TruncateBeforeInsert && !sqlopts.InitSchema {
+		if len(req.ClearTables) == 0 && !sqlopts.TruncateCascade && !sqlopts.TruncateBeforeInsert && !sqlopts.InitSchema {
 			slogger.Info("skipping truncate and schema init as none were set to true")
 			continue
 		}
 
+		switch destinationConnection.ConnectionConfig.Config.(type) {
+		case *mgmtv1alpha1.ConnectionConfig_PgConfig, *mgmtv1alpha1.ConnectionConfig_MysqlConfig, *mgmtv1alpha1.ConnectionConfig_MssqlConfig, *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		default:
+			return nil, fmt.Errorf("unsupported destination connection config: %T", destinationConnection.ConnectionConfig.Config)
+		}
+
 		destdb, err := b.sqlmanager.NewPooledSqlDb(ctx, slogger, destinationConnection)
 		if err != nil {
 			return nil, fmt.Errorf("unable to create new sql db: %w", err)
 		}
+		if len(req.ClearTables) > 0 {
+			err = execDestinationClearStatements(ctx, sourcedb, destdb, destinationConnection, job, req.ClearTables, slogger)
+		} else {
+			err = b.execDestinationInitStatements(ctx, sourcedb, destdb, destinationConnection, job, sqlopts, slogger)
+		}
+		destdb.Db.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
 
-		switch destinationConnection.ConnectionConfig.Config.(type) {
-		case *mgmtv1alpha1.ConnectionConfig_PgConfig:
-			destPgConfig := destinationConnection.ConnectionConfig.GetPgConfig()
-			if destPgConfig == nil {
-				continue
-			}
+	return &RunSqlInitTableStatementsResponse{}, nil
+}
 
-			if sqlopts.InitSchema {
-				tables := []*sqlmanager_shared.SchemaTable{}
-				for tableKey := range uniqueTables {
-					schema, table := sqlmanager_shared.SplitTableKey(tableKey)
-					tables = append(tables, &sqlmanager_shared.SchemaTable{Schema: schema, Table: table})
-				}
+func (b *initStatementBuilder) execDestinationInitStatements(
+	ctx context.Context,
+	sourcedb, destdb *sql_manager.SqlConnection,
+	destinationConnection *mgmtv1alpha1.Connection,
+	job *mgmtv1alpha1.Job,
+	sqlopts *shared.SqlJobDestinationOpts,
+	slogger *slog.Logger,
+) error {
+	stmts, err := buildDestinationInitStatements(ctx, sourcedb, destdb.Driver, destinationConnection, job, sqlopts, slogger)
+	if err != nil {
+		return err
+	}
 
-				initblocks, err := sourcedb.Db.GetSchemaInitStatements(ctx, tables)
-				if err != nil {
-					return nil, err
-				}
+	for _, block := range stmts.InitStatements {
+		slogger.Info(fmt.Sprintf("[%s] found %d statements to execute during schema initialization", block.Label, len(block.Statements)))
+		if len(block.Statements) == 0 {
+			continue
+		}
+		err = destdb.Db.BatchExec(ctx, batchSizeConst, block.Statements, &sqlmanager_shared.BatchExecOpts{})
+		if err != nil {
+			return fmt.Errorf("unable to exec %s statements: %w", block.Label, err)
+		}
+	}
 
-				for _, block := range initblocks {
-					slogger.Info(fmt.Sprintf("[%s] found %d statements to execute during schema initialization", block.Label, len(block.Statements)))
-					if len(block.Statements) == 0 {
-						continue
-					}
-					err = destdb.Db.BatchExec(ctx, batchSizeConst, block.Statements, &sqlmanager_shared.BatchExecOpts{})
-					if err != nil {
-						destdb.Db.Close()
-						return nil, fmt.Errorf("unable to exec pg %s statements: %w", block.Label, err)
-					}
-				}
+	switch destinationConnection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		if sqlopts.TruncateCascade {
+			slogger.Info(fmt.Sprintf("executing %d sql statements that will truncate cascade tables", len(stmts.TruncateStatements)))
+			err = destdb.Db.BatchExec(ctx, batchSizeConst, stmts.TruncateStatements, &sqlmanager_shared.BatchExecOpts{})
+			if err != nil {
+				return fmt.Errorf("unable to exec truncate cascade statements: %w", err)
 			}
-			// truncate statements
-			if sqlopts.TruncateCascade {
-				tableTruncateStmts := []string{}
-				for table := range uniqueTables {
-					schema, table := sqlmanager_shared.SplitTableKey(table)
-					stmt, err := sqlmanager_postgres.BuildPgTruncateCascadeStatement(schema, table)
-					if err != nil {
-						destdb.Db.Close()
-						return nil, err
-					}
-					tableTruncateStmts = append(tableTruncateStmts, stmt)
-				}
-				slogger.Info(fmt.Sprintf("executing %d sql statements that will truncate cascade tables", len(tableTruncateStmts)))
-				err = destdb.Db.BatchExec(ctx, batchSizeConst, tableTruncateStmts, &sqlmanager_shared.BatchExecOpts{})
+		} else if sqlopts.TruncateBeforeInsert {
+			slogger.Info("executing sql statement that will truncate tables")
+			for _, stmt := range stmts.TruncateStatements {
+				err = destdb.Db.Exec(ctx, stmt)
 				if err != nil {
-					destdb.Db.Close()
-					return nil, fmt.Errorf("unable to exec truncate cascade statements: %w", err)
+					return fmt.Errorf("unable to exec ordered truncate statements: %w", err)
 				}
-			} else if sqlopts.TruncateBeforeInsert {
-				tableDependencies, err := sourcedb.Db.GetTableConstraintsBySchema(ctx, uniqueSchemas)
-				if err != nil {
-					return nil, fmt.Errorf("unable to retrieve database foreign key constraints: %w", err)
-				}
-				slogger.Info(fmt.Sprintf("found %d foreign key constraints for database", len(tableDependencies.ForeignKeyConstraints)))
-				tablePrimaryDependencyMap := getFilteredForeignToPrimaryTableMap(tableDependencies.ForeignKeyConstraints, uniqueTables)
-				orderedTablesResp, err := tabledependency.GetTablesOrderedByDependency(tablePrimaryDependencyMap)
+			}
+		}
+		if len(stmts.ResetStatements) > 0 {
+			err = destdb.Db.BatchExec(ctx, 10, stmts.ResetStatements, &sqlmanager_shared.BatchExecOpts{})
+			// handle not found errors
+			if err != nil && !strings.Contains(err.Error(), `does not exist`) {
+				return fmt.Errorf("unable to exec postgres sequence reset statements: %w", err)
+			}
+		}
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		if len(stmts.TruncateStatements) > 0 {
+			slogger.Info(fmt.Sprintf("executing %d sql statements that will truncate tables", len(stmts.TruncateStatements)))
+			disableFkChecks := sqlmanager_shared.DisableForeignKeyChecks
+			err = destdb.Db.BatchExec(ctx, batchSizeConst, stmts.TruncateStatements, &sqlmanager_shared.BatchExecOpts{Prefix: &disableFkChecks})
+			if err != nil {
+				return err
+			}
+		}
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		if len(stmts.TruncateStatements) > 0 {
+			slogger.Info(fmt.Sprintf("executing %d sql statements that will delete from tables", len(stmts.TruncateStatements)))
+			err = destdb.Db.BatchExec(ctx, 10, stmts.TruncateStatements, &sqlmanager_shared.BatchExecOpts{})
+			if err != nil {
+				return fmt.Errorf("unable to exec ordered delete from statements: %w", err)
+			}
+		}
+		if len(stmts.ResetStatements) > 0 {
+			err = destdb.Db.BatchExec(ctx, 10, stmts.ResetStatements, &sqlmanager_shared.BatchExecOpts{})
+			if err != nil {
+				return fmt.Errorf("unable to exec identity reset statements: %w", err)
+			}
+		}
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		if len(stmts.TruncateStatements) > 0 {
+			slogger.Info(fmt.Sprintf("executing %d sql statements that will delete from tables", len(stmts.TruncateStatements)))
+			err = destdb.Db.BatchExec(ctx, batchSizeConst, stmts.TruncateStatements, &sqlmanager_shared.BatchExecOpts{})
+			if err != nil {
+				return fmt.Errorf("unable to exec ordered delete from statements: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// The statements that are run against a sql destination before the sync begins
+type DestinationInitStatements struct {
+	// Schema initialization statements, grouped into blocks that are executed in order
+	InitStatements []*sqlmanager_shared.InitSchemaStatements
+	// Statements that clear the destination tables, in the order they are executed
+	TruncateStatements []string
+	// Statements that reset sequences and identity columns once the tables have been cleared
+	ResetStatements []string
+	// Parts of the source schema that could not be represented exactly when translating it into the destination dialect
+	LossyConversions []*sqlmanager_translate.LossyConversion
+}
+
+// Builds the schema init and truncate statements for a sql destination of the job without executing them.
+// Returns nil if the destination is not configured to init or truncate its tables
+func BuildDestinationInitStatements(
+	ctx context.Context,
+	sourcedb *sql_manager.SqlConnection,
+	destinationDriver string,
+	destinationConnection *mgmtv1alpha1.Connection,
+	job *mgmtv1alpha1.Job,
+	destination *mgmtv1alpha1.JobDestination,
+	slogger *slog.Logger,
+) (*DestinationInitStatements, error) {
+	sqlopts, err := getDestinationSqlOpts(job, destination, slogger)
+	if err != nil {
+		return nil, err
+	}
+	if !sqlopts.TruncateCascade && !sqlopts.TruncateBeforeInsert && !sqlopts.InitSchema {
+		return nil, nil
+	}
+	return buildDestinationInitStatements(ctx, sourcedb, destinationDriver, destinationConnection, job, sqlopts, slogger)
+}
+
+func getDestinationSqlOpts(
+	job *mgmtv1alpha1.Job,
+	destination *mgmtv1alpha1.JobDestination,
+	slogger *slog.Logger,
+) (*shared.SqlJobDestinationOpts, error) {
+	sqlopts, err := shared.GetSqlJobDestinationOpts(destination.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	if job.GetSource().GetOptions().GetAiGenerate() != nil {
+		fkSrcConnId := job.GetSource().GetOptions().GetAiGenerate().GetFkSourceConnectionId()
+		if fkSrcConnId == destination.GetConnectionId() && sqlopts.InitSchema {
+			slogger.Warn("cannot init schema when destination connection is the same as the foreign key source connection")
+			sqlopts.InitSchema = false
+		}
+	}
+
+	if job.GetSource().GetOptions().GetGenerate() != nil {
+		fkSrcConnId := job.GetSource().GetOptions().GetGenerate().GetFkSourceConnectionId()
+		if fkSrcConnId == destination.GetConnectionId() && sqlopts.InitSchema {
+			slogger.Warn("cannot init schema when destination connection is the same as the foreign key source connection")
+			sqlopts.InitSchema = false
+		}
+	}
+	return sqlopts, nil
+}
+
+func buildDestinationInitStatements(
+	ctx context.Context,
+	sourcedb *sql_manager.SqlConnection,
+	destinationDriver string,
+	destinationConnection *mgmtv1alpha1.Connection,
+	job *mgmtv1alpha1.Job,
+	sqlopts *shared.SqlJobDestinationOpts,
+	slogger *slog.Logger,
+) (*DestinationInitStatements, error) {
+	uniqueTables := shared.GetUniqueTablesMapFromJob(job)
+	uniqueSchemas := shared.GetUniqueSchemasFromJob(job)
+	stmts := &DestinationInitStatements{}
+
+	if sqlopts.InitSchema {
+		tables := []*sqlmanager_shared.SchemaTable{}
+		for tableKey := range uniqueTables {
+			schema, table := sqlmanager_shared.SplitTableKey(tableKey)
+			tables = append(tables, &sqlmanager_shared.SchemaTable{Schema: schema, Table: table})
+		}
+
+		initblocks, lossyConversions, err := getSchemaInitStatements(ctx, sourcedb, destinationDriver, tables, uniqueSchemas, slogger)
+		if err != nil {
+			return nil, err
+		}
+		stmts.InitStatements = initblocks
+		stmts.LossyConversions = lossyConversions
+	}
+
+	switch destinationConnection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		if sqlopts.TruncateCascade {
+			for table := range uniqueTables {
+				schema, table := sqlmanager_shared.SplitTableKey(table)
+				stmt, err := sqlmanager_postgres.BuildPgTruncateCascadeStatement(schema, table)
 				if err != nil {
-					destdb.Db.Close()
 					return nil, err
 				}
+				stmts.TruncateStatements = append(stmts.TruncateStatements, stmt)
+			}
+		} else if sqlopts.TruncateBeforeInsert {
+			orderedTables, err := getTablesOrderedByDependency(ctx, sourcedb, uniqueTables, uniqueSchemas, slogger)
+			if err != nil {
+				return nil, err
+			}
+			truncateStmt, err := sqlmanager_postgres.BuildPgTruncateStatement(orderedTables)
+			if err != nil {
+				return nil, fmt.Errorf("unable to build postgres truncate statement: %w", err)
+			}
+			stmts.TruncateStatements = append(stmts.TruncateStatements, truncateStmt)
+		}
+		// reset serial counts
+		// identity counts are automatically reset with truncate identity restart clause
+		// sequences are looked up on the source, so they are only reset when it is also postgres.
+		// tables translated from another dialect use identity columns rather than serials
+		if (sqlopts.TruncateBeforeInsert || sqlopts.TruncateCascade) && sourcedb.Driver == destinationDriver {
+			schemaTableMap := map[string][]string{}
+			for schemaTable := range uniqueTables {
+				schema, table := sqlmanager_shared.SplitTableKey(schemaTable)
+				schemaTableMap[schema] = append(schemaTableMap[schema], table)
+			}
 
-				slogger.Info(fmt.Sprintf("executing %d sql statements that will truncate tables", len(orderedTablesResp.OrderedTables)))
-				truncateStmt, err := sqlmanager_postgres.BuildPgTruncateStatement(orderedTablesResp.OrderedTables)
+			for schema, tables := range schemaTableMap {
+				sequences, err := sourcedb.Db.GetSequencesByTables(ctx, schema, tables)
 				if err != nil {
-					slogger.Error(fmt.Sprint("unable to build postgres truncate statement: %w", err))
 					return nil, err
 				}
-				err = destdb.Db.Exec(ctx, truncateStmt)
+				for _, seq := range sequences {
+					stmts.ResetStatements = append(stmts.ResetStatements, sqlmanager_postgres.BuildPgResetSequenceSql(seq.Name))
+				}
+			}
+		}
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		if sqlopts.TruncateBeforeInsert {
+			for table := range uniqueTables {
+				schema, table := sqlmanager_shared.SplitTableKey(table)
+				stmt, err := sqlmanager_mysql.BuildMysqlTruncateStatement(schema, table)
 				if err != nil {
-					destdb.Db.Close()
-					return nil, fmt.Errorf("unable to exec ordered truncate statements: %w", err)
+					return nil, err
 				}
+				stmts.TruncateStatements = append(stmts.TruncateStatements, stmt)
 			}
-			if sqlopts.TruncateBeforeInsert || sqlopts.TruncateCascade {
-				// reset serial counts
-				// identity counts are automatically reset with truncate identity restart clause
-				schemaTableMap := map[string][]string{}
-				for schemaTable := range uniqueTables {
-					schema, table := sqlmanager_shared.SplitTableKey(schemaTable)
-					schemaTableMap[schema] = append(schemaTableMap[schema], table)
+		}
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		if sqlopts.TruncateBeforeInsert {
+			orderedTables, err := getTablesOrderedByDependency(ctx, sourcedb, uniqueTables, uniqueSchemas, slogger)
+			if err != nil {
+				return nil, err
+			}
+			for _, st := range orderedTables {
+				stmt, err := sqlmanager_mssql.BuildMssqlDeleteStatement(st.Schema, st.Table)
+				if err != nil {
+					return nil, err
 				}
+				stmts.TruncateStatements = append(stmts.TruncateStatements, stmt)
+			}
 
-				resetSeqStmts := []string{}
-				for schema, tables := range schemaTableMap {
-					sequences, err := sourcedb.Db.GetSequencesByTables(ctx, schema, tables)
-					if err != nil {
-						destdb.Db.Close()
-						return nil, err
-					}
-					for _, seq := range sequences {
-						resetSeqStmts = append(resetSeqStmts, sqlmanager_postgres.BuildPgResetSequenceSql(seq.Name))
-					}
+			// reset identity column counts
+			schemaColMap, err := sourcedb.Db.GetSchemaColumnMap(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for table, cols := range schemaColMap {
+				if _, ok := uniqueTables[table]; !ok {
+					continue
 				}
-				if len(resetSeqStmts) > 0 {
-					err = destdb.Db.BatchExec(ctx, 10, resetSeqStmts, &sqlmanager_shared.BatchExecOpts{})
-					if err != nil {
-						// handle not found errors
-						if !strings.Contains(err.Error(), `does not exist`) {
-							destdb.Db.Close()
-							return nil, fmt.Errorf("unable to exec postgres sequence reset statements: %w", err)
-						}
+				for _, c := range cols {
+					if c.IdentityGeneration != nil && *c.IdentityGeneration != "" {
+						schema, table := sqlmanager_shared.SplitTableKey(table)
+						stmts.ResetStatements = append(stmts.ResetStatements, sqlmanager_mssql.BuildMssqlIdentityColumnResetStatement(schema, table, *c.IdentityGeneration))
 					}
 				}
 			}
-			destdb.Db.Close()
-		case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
-			if sqlopts.InitSchema {
-				if sqlopts.InitSchema {
-					tables := []*sqlmanager_shared.SchemaTable{}
-					for tableKey := range uniqueTables {
-						schema, table := sqlmanager_shared.SplitTableKey(tableKey)
-						tables = append(tables, &sqlmanager_shared.SchemaTable{Schema: schema, Table: table})
-					}
-
-					initblocks, err := sourcedb.Db.GetSchemaInitStatements(ctx, tables)
-					if err != nil {
-						return nil, err
-					}
-
-					for _, block := range initblocks {
-						slogger.Info(fmt.Sprintf("[%s] found %d statements to execute during schema initialization", block.Label, len(block.Statements)))
-						if len(block.Statements) == 0 {
-							continue
-						}
-						err = destdb.Db.BatchExec(ctx, batchSizeConst, block.Statements, &sqlmanager_shared.BatchExecOpts{})
-						if err != nil {
-							destdb.Db.Close()
-							return nil, fmt.Errorf("unable to exec mysql %s statements: %w", block.Label, err)
-						}
-					}
-				}
+		}
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		if sqlopts.TruncateBeforeInsert {
+			orderedTables, err := getTablesOrderedByDependency(ctx, sourcedb, uniqueTables, uniqueSchemas, slogger)
+			if err != nil {
+				return nil, err
 			}
-			// truncate statements
-			if sqlopts.TruncateBeforeInsert {
-				tableTruncate := []string{}
-				for table := range uniqueTables {
-					schema, table := sqlmanager_shared.SplitTableKey(table)
-					stmt, err := sqlmanager_mysql.BuildMysqlTruncateStatement(schema, table)
-					if err != nil {
-						destdb.Db.Close()
-						return nil, err
-					}
-					tableTruncate = append(tableTruncate, stmt)
-				}
-				slogger.Info(fmt.Sprintf("executing %d sql statements that will truncate tables", len(tableTruncate)))
-				disableFkChecks := sqlmanager_shared.DisableForeignKeyChecks
-				err = destdb.Db.BatchExec(ctx, batchSizeConst, tableTruncate, &sqlmanager_shared.BatchExecOpts{Prefix: &disableFkChecks})
+			// sqlite has no truncate so rows are deleted from child tables before their parents to satisfy foreign keys
+			for i := len(orderedTables) - 1; i >= 0; i-- {
+				stmt, err := sqlmanager_sqlite.BuildSqliteTruncateStatement(orderedTables[i].Table)
 				if err != nil {
-					destdb.Db.Close()
 					return nil, err
 				}
+				stmts.TruncateStatements = append(stmts.TruncateStatements, stmt)
 			}
-			destdb.Db.Close()
-		case *mgmtv1alpha1.ConnectionConfig_AwsS3Config, *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig:
-			// nothing to do here
-		case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
-			if sqlopts.TruncateBeforeInsert {
-				tableDependencies, err := sourcedb.Db.GetTableConstraintsBySchema(ctx, uniqueSchemas)
-				if err != nil {
-					return nil, fmt.Errorf("unable to retrieve database foreign key constraints: %w", err)
-				}
-				slogger.Info(fmt.Sprintf("found %d foreign key constraints for database", len(tableDependencies.ForeignKeyConstraints)))
-				tablePrimaryDependencyMap := getFilteredForeignToPrimaryTableMap(tableDependencies.ForeignKeyConstraints, uniqueTables)
-				orderedTablesResp, err := tabledependency.GetTablesOrderedByDependency(tablePrimaryDependencyMap)
-				if err != nil {
-					destdb.Db.Close()
-					return nil, err
-				}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported destination connection config: %T", destinationConnection.GetConnectionConfig().GetConfig())
+	}
+	return stmts, nil
+}
 
-				orderedTableDelete := []string{}
-				for _, st := range orderedTablesResp.OrderedTables {
-					stmt, err := sqlmanager_mssql.BuildMssqlDeleteStatement(st.Schema, st.Table)
-					if err != nil {
-						return nil, err
-					}
-					orderedTableDelete = append(orderedTableDelete, stmt)
-				}
-				slogger.Info(fmt.Sprintf("executing %d sql statements that will delete from tables", len(orderedTableDelete)))
-				err = destdb.Db.BatchExec(ctx, 10, orderedTableDelete, &sqlmanager_shared.BatchExecOpts{})
-				if err != nil {
-					destdb.Db.Close()
-					return nil, fmt.Errorf("unable to exec ordered delete from statements: %w", err)
-				}
+// Deletes the rows of the provided tables from the destination, child tables are cleared before their parents to satisfy foreign keys
+func execDestinationClearStatements(
+	ctx context.Context,
+	sourcedb *sql_manager.SqlConnection,
+	destdb *sql_manager.SqlConnection,
+	destinationConnection *mgmtv1alpha1.Connection,
+	job *mgmtv1alpha1.Job,
+	tables []string,
+	slogger *slog.Logger,
+) error {
+	stmts, err := buildDestinationClearStatements(ctx, sourcedb, destinationConnection, job, tables, slogger)
+	if err != nil {
+		return err
+	}
+	slogger.Info(fmt.Sprintf("executing %d sql statements that will clear partially synced tables", len(stmts)))
+	for _, stmt := range stmts {
+		err = destdb.Db.Exec(ctx, stmt)
+		if err != nil {
+			return fmt.Errorf("unable to exec clear table statement: %w", err)
+		}
+	}
+	return nil
+}
 
-				// reset identity column counts
-				schemaColMap, err := sourcedb.Db.GetSchemaColumnMap(ctx)
-				if err != nil {
-					destdb.Db.Close()
-					return nil, err
-				}
+func buildDestinationClearStatements(
+	ctx context.Context,
+	sourcedb *sql_manager.SqlConnection,
+	destinationConnection *mgmtv1alpha1.Connection,
+	job *mgmtv1alpha1.Job,
+	tables []string,
+	slogger *slog.Logger,
+) ([]string, error) {
+	clearTables := map[string]struct{}{}
+	for _, table := range tables {
+		clearTables[table] = struct{}{}
+	}
+	orderedTables, err := getTablesOrderedByDependency(ctx, sourcedb, clearTables, shared.GetUniqueSchemasFromJob(job), slogger)
+	if err != nil {
+		return nil, err
+	}
 
-				identityStmts := []string{}
-				for table, cols := range schemaColMap {
-					if _, ok := uniqueTables[table]; !ok {
-						continue
-					}
-					for _, c := range cols {
-						if c.IdentityGeneration != nil && *c.IdentityGeneration != "" {
-							schema, table := sqlmanager_shared.SplitTableKey(table)
-							identityResetStatement := sqlmanager_mssql.BuildMssqlIdentityColumnResetStatement(schema, table, *c.IdentityGeneration)
-							identityStmts = append(identityStmts, identityResetStatement)
-						}
-					}
-				}
-				if len(identityStmts) > 0 {
-					err = destdb.Db.BatchExec(ctx, 10, identityStmts, &sqlmanager_shared.BatchExecOpts{})
-					if err != nil {
-						destdb.Db.Close()
-						return nil, fmt.Errorf("unable to exec identity reset statements: %w", err)
-					}
-				}
-			}
-			destdb.Db.Close()
+	stmts := []string{}
+	for i := len(orderedTables) - 1; i >= 0; i-- {
+		st := orderedTables[i]
+		var stmt string
+		switch destinationConnection.GetConnectionConfig().GetConfig().(type) {
+		case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+			stmt, err = sqlmanager_postgres.BuildPgDeleteStatement(st.Schema, st.Table)
+		case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+			stmt, err = sqlmanager_mysql.BuildMysqlDeleteStatement(st.Schema, st.Table)
+		case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+			stmt, err = sqlmanager_mssql.BuildMssqlDeleteStatement(st.Schema, st.Table)
+		case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+			stmt, err = sqlmanager_sqlite.BuildSqliteTruncateStatement(st.Table)
 		default:
-			return nil, fmt.Errorf("unsupported destination connection config: %T", destinationConnection.ConnectionConfig.Config)
+			return nil, fmt.Errorf("unsupported destination connection config: %T", destinationConnection.GetConnectionConfig().GetConfig())
+		}
+		if err != nil {
+			return nil, err
 		}
+		stmts = append(stmts, stmt)
 	}
+	return stmts, nil
+}
 
-	return &RunSqlInitTableStatementsResponse{}, nil
+func getTablesOrderedByDependency(
+	ctx context.Context,
+	sourcedb *sql_manager.SqlConnection,
+	uniqueTables map[string]struct{},
+	uniqueSchemas []string,
+	slogger *slog.Logger,
+) ([]*sqlmanager_shared.SchemaTable, error) {
+	tableDependencies, err := sourcedb.Db.GetTableConstraintsBySchema(ctx, uniqueSchemas)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve database foreign key constraints: %w", err)
+	}
+	slogger.Info(fmt.Sprintf("found %d foreign key constraints for database", len(tableDependencies.ForeignKeyConstraints)))
+	tablePrimaryDependencyMap := getFilteredForeignToPrimaryTableMap(tableDependencies.ForeignKeyConstraints, uniqueTables)
+	orderedTablesResp, err := tabledependency.GetTablesOrderedByDependency(tablePrimaryDependencyMap)
+	if err != nil {
+		return nil, err
+	}
+	return orderedTablesResp.OrderedTables, nil
+}
+
+// Returns the source schema init statements, translating them into the destination dialect when the drivers differ.
+// Any lossy conversions made during the translation are returned alongside the statements.
+func getSchemaInitStatements(
+	ctx context.Context,
+	sourcedb *sql_manager.SqlConnection,
+	destinationDriver string,
+	tables []*sqlmanager_shared.SchemaTable,
+	schemas []string,
+	slogger *slog.Logger,
+) ([]*sqlmanager_shared.InitSchemaStatements, []*sqlmanager_translate.LossyConversion, error) {
+	if sourcedb.Driver == destinationDriver {
+		initblocks, err := sourcedb.Db.GetSchemaInitStatements(ctx, tables)
+		if err != nil {
+			return nil, nil, err
+		}
+		return initblocks, nil, nil
+	}
+
+	slogger.Info(fmt.Sprintf("translating schema from %s to %s", sourcedb.Driver, destinationDriver))
+	columns, err := sourcedb.Db.GetSchemaColumnMap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to retrieve source column info: %w", err)
+	}
+	constraints, err := sourcedb.Db.GetTableConstraintsBySchema(ctx, schemas)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to retrieve source table constraints: %w", err)
+	}
+	translation, err := sqlmanager_translate.TranslateSchema(sourcedb.Driver, destinationDriver, tables, columns, constraints)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to translate schema from %s to %s: %w", sourcedb.Driver, destinationDriver, err)
+	}
+	for _, lossy := range translation.LossyConversions {
+		slogger.Warn(fmt.Sprintf("lossy schema conversion: %s", lossy.String()))
+	}
+	return translation.InitStatements, translation.LossyConversions, nil
 }
 
 func (b *initStatementBuilder) getJobById(
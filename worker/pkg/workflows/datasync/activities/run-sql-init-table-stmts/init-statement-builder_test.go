@@ -155,7 +155,7 @@ func Test_InitStatementBuilder_Pg_Generate_InitSchema(t *testing.T) {
 	mockSqlDb.On("BatchExec", mock.Anything, mock.Anything, []string{"test-idx-statement"}, &sqlmanager_shared.BatchExecOpts{}).Return(nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, nil)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123"},
@@ -256,7 +256,7 @@ func Test_InitStatementBuilder_Pg_Generate_NoInitStatement(t *testing.T) {
 	mockSqlManager.On("NewPooledSqlDb", mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: mockSqlDb}, nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, nil)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123"},
@@ -388,7 +388,7 @@ func Test_InitStatementBuilder_Pg_TruncateCascade(t *testing.T) {
 	mockSqlDb.On("BatchExec", mock.Anything, mock.Anything, mock.MatchedBy(func(query []string) bool { return compareSlices(query, stmts) }), &sqlmanager_shared.BatchExecOpts{}).Return(nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, nil)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123"},
@@ -528,7 +528,7 @@ func Test_InitStatementBuilder_Pg_Truncate(t *testing.T) {
 	mockSqlDb.On("Exec", mock.Anything, "TRUNCATE \"public\".\"accounts\", \"public\".\"users\" RESTART IDENTITY;").Return(nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, nil)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123"},
@@ -673,7 +673,7 @@ func Test_InitStatementBuilder_Pg_InitSchema(t *testing.T) {
 	mockSqlDb.On("BatchExec", mock.Anything, mock.Anything, []string{"test-idx-statement"}, &sqlmanager_shared.BatchExecOpts{}).Return(nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, nil)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123"},
@@ -784,7 +784,7 @@ func Test_InitStatementBuilder_Mysql_Generate(t *testing.T) {
 	mockSqlManager.On("NewPooledSqlDb", mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: mockSqlDb}, nil)
 	mockSqlDb.On("Close").Return(nil)
 
-	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient)
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, nil)
 	_, err := bbuilder.RunSqlInitTableStatements(
 		context.Background(),
 		&RunSqlInitTableStatementsRequest{JobId: "123"},
@@ -793,6 +793,266 @@ func Test_InitStatementBuilder_Mysql_Generate(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_InitStatementBuilder_Pg_To_Mysql_InitSchema(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockSourceDb := sqlmanager.NewMockSqlDatabase(t)
+	mockDestDb := sqlmanager.NewMockSqlDatabase(t)
+	mockSqlManager := sqlmanager.NewMockSqlManagerClient(t)
+
+	mockJobClient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+			Job: &mgmtv1alpha1.Job{
+				Source: &mgmtv1alpha1.JobSource{
+					Options: &mgmtv1alpha1.JobSourceOptions{
+						Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+							Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{
+								Schemas: []*mgmtv1alpha1.PostgresSourceSchemaOption{
+									{
+										Schema: "public",
+										Tables: []*mgmtv1alpha1.PostgresSourceTableOption{
+											{
+												Table: "users",
+											},
+										},
+									},
+								},
+								ConnectionId: "123",
+							},
+						},
+					},
+				},
+				Mappings: []*mgmtv1alpha1.JobMapping{
+					{
+						Schema: "public",
+						Table:  "users",
+						Column: "id",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{
+							Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+						},
+					},
+				},
+				Destinations: []*mgmtv1alpha1.JobDestination{
+					{
+						ConnectionId: "456",
+						Options: &mgmtv1alpha1.JobDestinationOptions{
+							Config: &mgmtv1alpha1.JobDestinationOptions_MysqlOptions{
+								MysqlOptions: &mgmtv1alpha1.MysqlDestinationConnectionOptions{
+									TruncateTable: &mgmtv1alpha1.MysqlTruncateTableConfig{
+										TruncateBeforeInsert: false,
+									},
+									InitTableSchema: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		}), nil)
+
+	mockConnectionClient.On(
+		"GetConnection",
+		mock.Anything,
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: "123",
+		}),
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id:   "123",
+			Name: "prod",
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+					PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+						ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{
+							Url: "fake-prod-url",
+						},
+					},
+				},
+			},
+		},
+	}), nil)
+	mockConnectionClient.On(
+		"GetConnection",
+		mock.Anything,
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: "456",
+		}),
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id:   "456",
+			Name: "reporting",
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_MysqlConfig{
+					MysqlConfig: &mgmtv1alpha1.MysqlConnectionConfig{
+						ConnectionConfig: &mgmtv1alpha1.MysqlConnectionConfig_Url{
+							Url: "fake-reporting-url",
+						},
+					},
+				},
+			},
+		},
+	}), nil)
+
+	mockSqlManager.On("NewPooledSqlDb", mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: mockSourceDb, Driver: sqlmanager_shared.PostgresDriver}, nil).Once()
+	mockSqlManager.On("NewPooledSqlDb", mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: mockDestDb, Driver: sqlmanager_shared.MysqlDriver}, nil).Once()
+	mockSourceDb.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users": {
+			"id": {OrdinalPosition: 1, DataType: "uuid", ColumnDefault: "gen_random_uuid()"},
+		},
+	}, nil)
+	mockSourceDb.On("GetTableConstraintsBySchema", mock.Anything, []string{"public"}).Return(&sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{"public.users": {"id"}},
+	}, nil)
+	mockSourceDb.On("Close").Return(nil)
+	mockDestDb.On("BatchExec", mock.Anything, mock.Anything, []string{
+		"CREATE DATABASE IF NOT EXISTS `public`;",
+	}, &sqlmanager_shared.BatchExecOpts{}).Return(nil).Once()
+	mockDestDb.On("BatchExec", mock.Anything, mock.Anything, []string{
+		"CREATE TABLE IF NOT EXISTS `public`.`users` (`id` char(36) NOT NULL DEFAULT (uuid()), PRIMARY KEY (`id`));",
+	}, &sqlmanager_shared.BatchExecOpts{}).Return(nil).Once()
+	mockDestDb.On("Close").Return(nil)
+
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, nil)
+	_, err := bbuilder.RunSqlInitTableStatements(
+		context.Background(),
+		&RunSqlInitTableStatementsRequest{JobId: "123"},
+		slog.Default(),
+	)
+	assert.Nil(t, err)
+	mockSourceDb.AssertNotCalled(t, "GetSchemaInitStatements", mock.Anything, mock.Anything)
+}
+
+func Test_InitStatementBuilder_Mysql_To_Pg_InitSchema_Truncate(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockSourceDb := sqlmanager.NewMockSqlDatabase(t)
+	mockDestDb := sqlmanager.NewMockSqlDatabase(t)
+	mockSqlManager := sqlmanager.NewMockSqlManagerClient(t)
+
+	mockJobClient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+			Job: &mgmtv1alpha1.Job{
+				Source: &mgmtv1alpha1.JobSource{
+					Options: &mgmtv1alpha1.JobSourceOptions{
+						Config: &mgmtv1alpha1.JobSourceOptions_Mysql{
+							Mysql: &mgmtv1alpha1.MysqlSourceConnectionOptions{
+								Schemas: []*mgmtv1alpha1.MysqlSourceSchemaOption{
+									{
+										Schema: "shop",
+										Tables: []*mgmtv1alpha1.MysqlSourceTableOption{
+											{
+												Table: "orders",
+											},
+										},
+									},
+								},
+								ConnectionId: "123",
+							},
+						},
+					},
+				},
+				Mappings: []*mgmtv1alpha1.JobMapping{
+					{
+						Schema: "shop",
+						Table:  "orders",
+						Column: "id",
+						Transformer: &mgmtv1alpha1.JobMappingTransformer{
+							Source: mgmtv1alpha1.TransformerSource_TRANSFORMER_SOURCE_PASSTHROUGH,
+						},
+					},
+				},
+				Destinations: []*mgmtv1alpha1.JobDestination{
+					{
+						ConnectionId: "456",
+						Options: &mgmtv1alpha1.JobDestinationOptions{
+							Config: &mgmtv1alpha1.JobDestinationOptions_PostgresOptions{
+								PostgresOptions: &mgmtv1alpha1.PostgresDestinationConnectionOptions{
+									TruncateTable: &mgmtv1alpha1.PostgresTruncateTableConfig{
+										TruncateBeforeInsert: true,
+									},
+									InitTableSchema: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		}), nil)
+
+	mockConnectionClient.On(
+		"GetConnection",
+		mock.Anything,
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: "123",
+		}),
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id:   "123",
+			Name: "prod",
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_MysqlConfig{
+					MysqlConfig: &mgmtv1alpha1.MysqlConnectionConfig{
+						ConnectionConfig: &mgmtv1alpha1.MysqlConnectionConfig_Url{
+							Url: "fake-prod-url",
+						},
+					},
+				},
+			},
+		},
+	}), nil)
+	mockConnectionClient.On(
+		"GetConnection",
+		mock.Anything,
+		connect.NewRequest(&mgmtv1alpha1.GetConnectionRequest{
+			Id: "456",
+		}),
+	).Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+		Connection: &mgmtv1alpha1.Connection{
+			Id:   "456",
+			Name: "reporting",
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{
+					PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{
+						ConnectionConfig: &mgmtv1alpha1.PostgresConnectionConfig_Url{
+							Url: "fake-reporting-url",
+						},
+					},
+				},
+			},
+		},
+	}), nil)
+
+	identity := "auto_increment"
+	mockSqlManager.On("NewPooledSqlDb", mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: mockSourceDb, Driver: sqlmanager_shared.MysqlDriver}, nil).Once()
+	mockSqlManager.On("NewPooledSqlDb", mock.Anything, mock.Anything, mock.Anything).Return(&sqlmanager.SqlConnection{Db: mockDestDb, Driver: sqlmanager_shared.PostgresDriver}, nil).Once()
+	mockSourceDb.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"shop.orders": {
+			"id": {OrdinalPosition: 1, DataType: "bigint", IdentityGeneration: &identity},
+		},
+	}, nil)
+	mockSourceDb.On("GetTableConstraintsBySchema", mock.Anything, []string{"shop"}).Return(&sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{"shop.orders": {"id"}},
+	}, nil)
+	mockSourceDb.On("Close").Return(nil)
+	mockDestDb.On("BatchExec", mock.Anything, mock.Anything, []string{
+		`CREATE SCHEMA IF NOT EXISTS "shop";`,
+	}, &sqlmanager_shared.BatchExecOpts{}).Return(nil).Once()
+	mockDestDb.On("BatchExec", mock.Anything, mock.Anything, []string{
+		`CREATE TABLE IF NOT EXISTS "shop"."orders" ("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, PRIMARY KEY ("id"));`,
+	}, &sqlmanager_shared.BatchExecOpts{}).Return(nil).Once()
+	mockDestDb.On("Exec", mock.Anything, `TRUNCATE "shop"."orders" RESTART IDENTITY;`).Return(nil)
+	mockDestDb.On("Close").Return(nil)
+
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, nil)
+	_, err := bbuilder.RunSqlInitTableStatements(
+		context.Background(),
+		&RunSqlInitTableStatementsRequest{JobId: "123"},
+		slog.Default(),
+	)
+	assert.Nil(t, err)
+	mockSourceDb.AssertNotCalled(t, "GetSequencesByTables", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func Test_getFilteredForeignToPrimaryTableMap(t *testing.T) {
 	tables := map[string]struct{}{
 		"public.regions":     {},
@@ -883,3 +1143,70 @@ func compareSlices(slice1, slice2 []string) bool {
 	}
 	return true
 }
+
+func Test_getSchemaInitStatements_LossyConversions(t *testing.T) {
+	mockSourceDb := sqlmanager.NewMockSqlDatabase(t)
+	mockSourceDb.On("GetSchemaColumnMap", mock.Anything).Return(map[string]map[string]*sqlmanager_shared.ColumnInfo{
+		"public.users": {
+			"id":   {OrdinalPosition: 1, DataType: "integer"},
+			"tags": {OrdinalPosition: 2, DataType: "text[]", IsNullable: true},
+		},
+	}, nil)
+	mockSourceDb.On("GetTableConstraintsBySchema", mock.Anything, []string{"public"}).Return(&sqlmanager_shared.TableConstraints{
+		PrimaryKeyConstraints: map[string][]string{"public.users": {"id"}},
+	}, nil)
+
+	initblocks, lossy, err := getSchemaInitStatements(
+		context.Background(),
+		&sqlmanager.SqlConnection{Db: mockSourceDb, Driver: sqlmanager_shared.PostgresDriver},
+		sqlmanager_shared.MysqlDriver,
+		[]*sqlmanager_shared.SchemaTable{{Schema: "public", Table: "users"}},
+		[]string{"public"},
+		slog.Default(),
+	)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, initblocks)
+	assert.Len(t, lossy, 1)
+	assert.Equal(t, "public.users", lossy[0].Table)
+	assert.Equal(t, "tags", lossy[0].Column)
+}
+
+func Test_buildDestinationClearStatements(t *testing.T) {
+	mockSourceDb := sqlmanager.NewMockSqlDatabase(t)
+	mockSourceDb.On("GetTableConstraintsBySchema", mock.Anything, []string{"public"}).Return(&sqlmanager_shared.TableConstraints{
+		ForeignKeyConstraints: map[string][]*sqlmanager_shared.ForeignConstraint{
+			"public.users": {{
+				Columns:     []string{"account_id"},
+				NotNullable: []bool{true},
+				ForeignKey:  &sqlmanager_shared.ForeignKey{Table: "public.accounts", Columns: []string{"id"}},
+			}},
+		},
+	}, nil)
+	job := &mgmtv1alpha1.Job{
+		Mappings: []*mgmtv1alpha1.JobMapping{
+			{Schema: "public", Table: "users", Column: "id"},
+			{Schema: "public", Table: "accounts", Column: "id"},
+		},
+	}
+	destination := &mgmtv1alpha1.Connection{
+		ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+			Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{PgConfig: &mgmtv1alpha1.PostgresConnectionConfig{}},
+		},
+	}
+
+	stmts, err := buildDestinationClearStatements(
+		context.Background(),
+		&sqlmanager.SqlConnection{Db: mockSourceDb},
+		destination,
+		job,
+		[]string{"public.accounts", "public.users"},
+		slog.Default(),
+	)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]string{"DELETE FROM \"public\".\"users\";", "DELETE FROM \"public\".\"accounts\";"},
+		stmts,
+		"child tables must be cleared before their parents",
+	)
+}
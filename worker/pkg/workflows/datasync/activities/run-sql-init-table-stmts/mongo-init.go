@@ -0,0 +1,205 @@
+package runsqlinittablestmts_activity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongomanager"
+	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Truncates and initializes the collections of each MongoDB destination before any documents are synced.
+// Truncating happens first so that a dropped collection is recreated with the options and indexes of the source collection.
+// When clear collections (database.collection) are provided the job run is being resumed, so only the documents of those collections
+// are deleted from the destinations that truncate their collections and every other collection is left untouched.
+func (b *initStatementBuilder) runMongoInitStatements(
+	ctx context.Context,
+	job *mgmtv1alpha1.Job,
+	sourceConnection *mgmtv1alpha1.Connection,
+	clearCollections []string,
+	slogger *slog.Logger,
+) error {
+	collectionsByDatabase := getMongoCollectionsByDatabase(job.GetMappings())
+	if len(clearCollections) > 0 {
+		collectionsByDatabase = filterMongoCollections(collectionsByDatabase, clearCollections)
+	}
+	if len(collectionsByDatabase) == 0 {
+		return nil
+	}
+
+	for _, destination := range job.GetDestinations() {
+		mongoopts := destination.GetOptions().GetMongodbOptions()
+		truncateopts := mongoopts.GetTruncateCollection()
+		if len(clearCollections) > 0 && !truncateopts.GetTruncateBeforeInsert() {
+			// documents written by the resumed run can not be told apart from existing documents
+			slogger.Info("skipping clearing of collections as destination does not truncate its collections", "destinationConnectionId", destination.GetConnectionId())
+			continue
+		}
+		if !truncateopts.GetTruncateBeforeInsert() && !mongoopts.GetInitCollectionSchema() {
+			continue
+		}
+
+		destinationConnection, err := shared.GetConnectionById(ctx, b.connclient, destination.GetConnectionId())
+		if err != nil {
+			return fmt.Errorf("unable to get destination connection by id (%s): %w", destination.GetConnectionId(), err)
+		}
+		if destinationConnection.GetConnectionConfig().GetMongoConfig() == nil {
+			slogger.Warn("skipping mongodb truncate and collection init as the destination is not a mongodb connection", "destinationConnectionId", destination.GetConnectionId())
+			continue
+		}
+		if b.mongoconnector == nil {
+			return errors.New("unable to truncate or initialize mongodb collections as no mongodb connector was provided")
+		}
+
+		if len(clearCollections) > 0 {
+			err = b.execMongoDestinationClear(ctx, destinationConnection, collectionsByDatabase, slogger)
+		} else {
+			err = b.execMongoDestinationInit(ctx, sourceConnection, destinationConnection, collectionsByDatabase, mongoopts, slogger)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Deletes the documents of the collections without dropping them, so that their options and indexes are kept for the resumed run
+func (b *initStatementBuilder) execMongoDestinationClear(
+	ctx context.Context,
+	destinationConnection *mgmtv1alpha1.Connection,
+	collectionsByDatabase map[string][]string,
+	slogger *slog.Logger,
+) error {
+	destclient, closeDest, err := b.openMongoClient(ctx, destinationConnection, slogger)
+	if err != nil {
+		return fmt.Errorf("unable to open mongodb destination connection: %w", err)
+	}
+	defer closeDest()
+
+	for _, database := range getSortedDatabases(collectionsByDatabase) {
+		collections := collectionsByDatabase[database]
+		slogger.Info(fmt.Sprintf("clearing %d collections in %s", len(collections), database))
+		for _, collection := range collections {
+			if err := mongomanager.TruncateCollection(ctx, destclient.Database(database), collection, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (b *initStatementBuilder) execMongoDestinationInit(
+	ctx context.Context,
+	sourceConnection, destinationConnection *mgmtv1alpha1.Connection,
+	collectionsByDatabase map[string][]string,
+	mongoopts *mgmtv1alpha1.MongoDBDestinationConnectionOptions,
+	slogger *slog.Logger,
+) error {
+	destclient, closeDest, err := b.openMongoClient(ctx, destinationConnection, slogger)
+	if err != nil {
+		return fmt.Errorf("unable to open mongodb destination connection: %w", err)
+	}
+	defer closeDest()
+
+	var sourceclient *mongo.Client
+	if mongoopts.GetInitCollectionSchema() {
+		client, closeSource, err := b.openMongoClient(ctx, sourceConnection, slogger)
+		if err != nil {
+			return fmt.Errorf("unable to open mongodb source connection: %w", err)
+		}
+		defer closeSource()
+		sourceclient = client
+	}
+
+	for _, database := range getSortedDatabases(collectionsByDatabase) {
+		collections := collectionsByDatabase[database]
+		destdb := destclient.Database(database)
+
+		if mongoopts.GetTruncateCollection().GetTruncateBeforeInsert() {
+			drop := mongoopts.GetTruncateCollection().GetDrop()
+			slogger.Info(fmt.Sprintf("truncating %d collections in %s", len(collections), database), "drop", drop)
+			for _, collection := range collections {
+				if err := mongomanager.TruncateCollection(ctx, destdb, collection, drop); err != nil {
+					return err
+				}
+			}
+		}
+
+		if mongoopts.GetInitCollectionSchema() {
+			schemas, err := mongomanager.GetCollectionSchemas(ctx, sourceclient.Database(database), collections)
+			if err != nil {
+				return err
+			}
+			slogger.Info(fmt.Sprintf("initializing %d collections in %s", len(schemas), database))
+			for _, schema := range schemas {
+				if err := mongomanager.InitCollection(ctx, destdb, schema); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (b *initStatementBuilder) openMongoClient(
+	ctx context.Context,
+	connection *mgmtv1alpha1.Connection,
+	slogger *slog.Logger,
+) (*mongo.Client, func(), error) {
+	container, err := b.mongoconnector.NewFromConnectionConfig(connection.GetConnectionConfig(), slogger)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := container.Open(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, func() {
+		if err := container.Close(ctx); err != nil {
+			slogger.Error("unable to close mongodb connection", "error", err)
+		}
+	}, nil
+}
+
+// Returns the sorted, unique collections of the job mappings keyed by their database
+func getMongoCollectionsByDatabase(mappings []*mgmtv1alpha1.JobMapping) map[string][]string {
+	collectionsByDatabase := map[string][]string{}
+	for _, mapping := range mappings {
+		collections := collectionsByDatabase[mapping.GetSchema()]
+		if !slices.Contains(collections, mapping.GetTable()) {
+			collectionsByDatabase[mapping.GetSchema()] = append(collections, mapping.GetTable())
+		}
+	}
+	for _, collections := range collectionsByDatabase {
+		slices.Sort(collections)
+	}
+	return collectionsByDatabase
+}
+
+// Returns the collections that are also one of the given collections (database.collection)
+func filterMongoCollections(collectionsByDatabase map[string][]string, collections []string) map[string][]string {
+	output := map[string][]string{}
+	for database, databaseCollections := range collectionsByDatabase {
+		for _, collection := range databaseCollections {
+			if slices.Contains(collections, sqlmanager_shared.BuildTable(database, collection)) {
+				output[database] = append(output[database], collection)
+			}
+		}
+	}
+	return output
+}
+
+func getSortedDatabases(collectionsByDatabase map[string][]string) []string {
+	databases := make([]string, 0, len(collectionsByDatabase))
+	for database := range collectionsByDatabase {
+		databases = append(databases, database)
+	}
+	slices.Sort(databases)
+	return databases
+}
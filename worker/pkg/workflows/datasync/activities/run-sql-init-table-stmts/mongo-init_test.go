@@ -0,0 +1,151 @@
+package runsqlinittablestmts_activity
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
+	"github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_InitStatementBuilder_Mongo_NoDestinationOptions(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockSqlManager := sqlmanager.NewMockSqlManagerClient(t)
+	mockMongoConnector := mongoconnect.NewMockInterface(t)
+
+	mockJobClient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+			Job: &mgmtv1alpha1.Job{
+				Source: &mgmtv1alpha1.JobSource{
+					Options: &mgmtv1alpha1.JobSourceOptions{
+						Config: &mgmtv1alpha1.JobSourceOptions_Mongodb{
+							Mongodb: &mgmtv1alpha1.MongoDBSourceConnectionOptions{ConnectionId: "123"},
+						},
+					},
+				},
+				Mappings: []*mgmtv1alpha1.JobMapping{
+					{Schema: "shop", Table: "orders", Column: "_id"},
+				},
+				Destinations: []*mgmtv1alpha1.JobDestination{
+					{
+						ConnectionId: "456",
+						Options: &mgmtv1alpha1.JobDestinationOptions{
+							Config: &mgmtv1alpha1.JobDestinationOptions_MongodbOptions{
+								MongodbOptions: &mgmtv1alpha1.MongoDBDestinationConnectionOptions{
+									TruncateCollection: &mgmtv1alpha1.MongoDBTruncateCollectionConfig{TruncateBeforeInsert: false},
+								},
+							},
+						},
+					},
+					{ConnectionId: "789"},
+				},
+			},
+		}), nil)
+	mockConnectionClient.On("GetConnection", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{
+				Id: "123",
+				ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+					Config: &mgmtv1alpha1.ConnectionConfig_MongoConfig{
+						MongoConfig: &mgmtv1alpha1.MongoConnectionConfig{},
+					},
+				},
+			},
+		}), nil).Once()
+
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockMongoConnector)
+	_, err := bbuilder.RunSqlInitTableStatements(
+		context.Background(),
+		&RunSqlInitTableStatementsRequest{JobId: "123"},
+		slog.Default(),
+	)
+	require.NoError(t, err)
+}
+
+func Test_getMongoCollectionsByDatabase(t *testing.T) {
+	actual := getMongoCollectionsByDatabase([]*mgmtv1alpha1.JobMapping{
+		{Schema: "shop", Table: "orders", Column: "_id"},
+		{Schema: "shop", Table: "orders", Column: "total"},
+		{Schema: "shop", Table: "customers", Column: "_id"},
+		{Schema: "hr", Table: "employees", Column: "_id"},
+	})
+	require.Equal(t, map[string][]string{
+		"shop": {"customers", "orders"},
+		"hr":   {"employees"},
+	}, actual)
+}
+
+func Test_InitStatementBuilder_Mongo_Resume_DoesNotTruncateCompletedCollections(t *testing.T) {
+	mockJobClient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	mockConnectionClient := mgmtv1alpha1connect.NewMockConnectionServiceClient(t)
+	mockSqlManager := sqlmanager.NewMockSqlManagerClient(t)
+	mockMongoConnector := mongoconnect.NewMockInterface(t)
+
+	mockJobClient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+			Job: &mgmtv1alpha1.Job{
+				Source: &mgmtv1alpha1.JobSource{
+					Options: &mgmtv1alpha1.JobSourceOptions{
+						Config: &mgmtv1alpha1.JobSourceOptions_Mongodb{
+							Mongodb: &mgmtv1alpha1.MongoDBSourceConnectionOptions{ConnectionId: "123"},
+						},
+					},
+				},
+				Mappings: []*mgmtv1alpha1.JobMapping{
+					{Schema: "shop", Table: "orders", Column: "_id"},
+				},
+				Destinations: []*mgmtv1alpha1.JobDestination{
+					{
+						ConnectionId: "456",
+						Options: &mgmtv1alpha1.JobDestinationOptions{
+							Config: &mgmtv1alpha1.JobDestinationOptions_MongodbOptions{
+								MongodbOptions: &mgmtv1alpha1.MongoDBDestinationConnectionOptions{
+									TruncateCollection: &mgmtv1alpha1.MongoDBTruncateCollectionConfig{TruncateBeforeInsert: true, Drop: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		}), nil)
+	mockConnectionClient.On("GetConnection", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+			Connection: &mgmtv1alpha1.Connection{
+				Id: "123",
+				ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+					Config: &mgmtv1alpha1.ConnectionConfig_MongoConfig{
+						MongoConfig: &mgmtv1alpha1.MongoConnectionConfig{},
+					},
+				},
+			},
+		}), nil).Once()
+
+	bbuilder := newInitStatementBuilder(mockSqlManager, mockJobClient, mockConnectionClient, mockMongoConnector)
+	// shop.orders completed in the resumed run, so only the partially written shop.customers is cleared, which this job does not map
+	_, err := bbuilder.RunSqlInitTableStatements(
+		context.Background(),
+		&RunSqlInitTableStatementsRequest{JobId: "123", ClearTables: []string{"shop.customers"}},
+		slog.Default(),
+	)
+	require.NoError(t, err)
+	mockMongoConnector.AssertNotCalled(t, "NewFromConnectionConfig", mock.Anything, mock.Anything)
+}
+
+func Test_filterMongoCollections(t *testing.T) {
+	collectionsByDatabase := map[string][]string{
+		"shop": {"customers", "orders"},
+		"hr":   {"employees"},
+	}
+	// shop.orders completed in the resumed run while shop.customers was partially written
+	actual := filterMongoCollections(collectionsByDatabase, []string{"shop.customers"})
+	require.Equal(t, map[string][]string{"shop": {"customers"}}, actual)
+
+	require.Empty(t, filterMongoCollections(collectionsByDatabase, []string{"shop.missing"}))
+}
@@ -2,13 +2,16 @@ package shared
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"connectrpc.com/connect"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
 
 	http_client "github.com/nucleuscloud/neosync/worker/pkg/http/client"
@@ -19,10 +22,92 @@ const (
 	// The benthos value for null
 	NullString = "null"
 
+	// The prefix of the env vars that secret references are made available under at sync time
+	SecretReferenceEnvKeyPrefix = "NEOSYNC_SECRET_REF_"
+	// The prefix of the env vars that the inline credentials of a connection are made available under at sync time
+	ConnectionCredentialEnvKeyPrefix = "NEOSYNC_CONN_CRED_"
+
 	runContext_ExternalId_BenthosConfig       = "benthosconfig"
 	runContext_ExternalId_PostTableSyncConfig = "posttablesync"
+	runContext_ExternalId_Watermark           = "watermark"
+	runContext_ExternalId_ResumeRun           = "resumerun"
+	runContext_ExternalId_SyncCheckpoint      = "synccheckpoint"
+	runContext_ExternalId_ResumedBy           = "resumedby"
+	runContext_ExternalId_GenerateProfile     = "generateprofile"
+)
+
+// Returns the value unchanged, or an env var interpolation if the value is a secret reference.
+// Benthos configs are persisted as run contexts, so secrets are only resolved by the sync activity when the stream is built.
+func GetSecretReferenceEnvVar(value string) string {
+	if !secrets.IsReference(value) {
+		return value
+	}
+	return fmt.Sprintf("${%s}", GetSecretReferenceEnvKey(value))
+}
+
+// Returns the env var key that the secret reference is made available under at sync time
+func GetSecretReferenceEnvKey(reference string) string {
+	return SecretReferenceEnvKeyPrefix + hex.EncodeToString([]byte(reference))
+}
+
+// The credentials of a connection that are placed into benthos configs
+const (
+	CredentialKafkaSaslPassword  = "kafka.sasl.password"
+	CredentialKafkaClientKey     = "kafka.tls.client_key"
+	CredentialAwsAccessKeyId     = "aws.access_key_id"
+	CredentialAwsSecretAccessKey = "aws.secret_access_key"
+	CredentialAwsSessionToken    = "aws.session_token"
+	CredentialOpenAiApiKey       = "openai.api_key"
 )
 
+// Returns an env var interpolation for a credential of the connection, so that the credential is never written into the benthos config.
+// Secret references are made available under the env var of the reference, and inline credentials under an env var of the connection.
+// Both are populated by the sync activity when the stream is built.
+func GetConnectionCredentialEnvVar(connectionId, credential, value string) string {
+	if value == "" {
+		return ""
+	}
+	if secrets.IsReference(value) {
+		return GetSecretReferenceEnvVar(value)
+	}
+	return fmt.Sprintf("${%s}", GetConnectionCredentialEnvKey(connectionId, credential))
+}
+
+// Returns the env var key that the inline credential of the connection is made available under at sync time
+func GetConnectionCredentialEnvKey(connectionId, credential string) string {
+	return ConnectionCredentialEnvKeyPrefix + hex.EncodeToString([]byte(connectionId+"/"+credential))
+}
+
+// Returns the inline credentials of the connection keyed by the env var that they are made available under at sync time.
+// Secret references are not included as they are resolved separately.
+func GetConnectionCredentialEnvVars(connection *mgmtv1alpha1.Connection) map[string]string {
+	output := map[string]string{}
+	add := func(credential, value string) {
+		if value == "" || secrets.IsReference(value) {
+			return
+		}
+		output[GetConnectionCredentialEnvKey(connection.GetId(), credential)] = value
+	}
+	addAws := func(creds *mgmtv1alpha1.AwsS3Credentials) {
+		add(CredentialAwsAccessKeyId, creds.GetAccessKeyId())
+		add(CredentialAwsSecretAccessKey, creds.GetSecretAccessKey())
+		add(CredentialAwsSessionToken, creds.GetSessionToken())
+	}
+
+	switch config := connection.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		add(CredentialKafkaSaslPassword, config.KafkaConfig.GetSasl().GetPassword())
+		add(CredentialKafkaClientKey, config.KafkaConfig.GetClientTls().GetClientKey())
+	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
+		addAws(config.AwsS3Config.GetCredentials())
+	case *mgmtv1alpha1.ConnectionConfig_DynamodbConfig:
+		addAws(config.DynamodbConfig.GetCredentials())
+	case *mgmtv1alpha1.ConnectionConfig_OpenaiConfig:
+		add(CredentialOpenAiApiKey, config.OpenaiConfig.GetApiKey())
+	}
+	return output
+}
+
 func GetBenthosConfigExternalId(identifier string) string {
 	return fmt.Sprintf("%s-%s", runContext_ExternalId_BenthosConfig, identifier)
 }
@@ -31,8 +116,72 @@ func GetPostTableSyncConfigExternalId(identifier string) string {
 	return fmt.Sprintf("%s-%s", runContext_ExternalId_PostTableSyncConfig, identifier)
 }
 
+// Returns the run context external id that the watermark of an incrementally synced table is stored under.
+// Watermarks are stored with the job id as the run context job run id so that they persist across job runs.
+func GetWatermarkExternalId(table string) string {
+	return fmt.Sprintf("%s-%s", runContext_ExternalId_Watermark, table)
+}
+
+// Returns the run context external id that the profile of a generated table is stored under.
+// Profiles are stored with the job id as the run context job run id so that later runs sample from the same profile.
+func GetGenerateProfileExternalId(table string) string {
+	return fmt.Sprintf("%s-%s", runContext_ExternalId_GenerateProfile, table)
+}
+
+// Returns the run context external id that a requested resume of a previous run is stored under.
+// The resume request is stored with the job id as the run context job run id and is consumed by the next run of the job.
+func GetResumeRunExternalId() string {
+	return runContext_ExternalId_ResumeRun
+}
+
+// Returns the run context external id that records which job run resumed a previous run.
+// The record is stored with the id of the resumed run as the run context job run id so that a run is only ever resumed once.
+func GetResumedByExternalId() string {
+	return runContext_ExternalId_ResumedBy
+}
+
+// Returns the run context external id that the sync checkpoint of a job run is stored under
+func GetSyncCheckpointExternalId() string {
+	return runContext_ExternalId_SyncCheckpoint
+}
+
+// A request to resume a previous job run
+type ResumeRun struct {
+	RunId string `json:"runId"`
+}
+
+// Records the job run that resumed a previous job run
+type ResumedBy struct {
+	RunId string `json:"runId"`
+}
+
+// Tracks the progress of a job run so that it can be resumed if it fails or is canceled
+type SyncCheckpoint struct {
+	// The names of the benthos configs that have successfully synced
+	CompletedConfigs []string `json:"completedConfigs"`
+}
+
+// The high watermark of an incrementally synced table
+type TableWatermark struct {
+	Column string `json:"column"`
+	Value  string `json:"value"`
+}
+
 type PostTableSyncConfig struct {
 	DestinationConfigs map[string]*PostTableSyncDestConfig `json:"destinationConfigs"`
+	// Set when the table is synced incrementally and the watermark should be recorded once the table has synced
+	Watermark *PostTableSyncWatermark `json:"watermark,omitempty"`
+}
+
+type PostTableSyncWatermark struct {
+	JobId  string `json:"jobId"`
+	Table  string `json:"table"` // schema.table
+	Column string `json:"column"`
+	Value  string `json:"value"`
+}
+
+func IsRunContextNotFound(err error) bool {
+	return strings.Contains(err.Error(), "no run context exists")
 }
 
 type PostTableSyncDestConfig struct {
@@ -210,6 +359,20 @@ func GetJobSourceConnection(
 	jobSource *mgmtv1alpha1.JobSource,
 	connclient mgmtv1alpha1connect.ConnectionServiceClient,
 ) (*mgmtv1alpha1.Connection, error) {
+	connectionId, err := GetJobSourceConnectionId(jobSource)
+	if err != nil {
+		return nil, err
+	}
+	sourceConnection, err := GetConnectionById(ctx, connclient, connectionId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get connection by id (%s): %w", connectionId, err)
+	}
+	return sourceConnection, nil
+}
+
+// Returns the id of the connection that the job source reads from.
+// Generate sources return an empty id if they have not been given a connection to read foreign keys from.
+func GetJobSourceConnectionId(jobSource *mgmtv1alpha1.JobSource) (string, error) {
 	var connectionId string
 	switch jobSourceConfig := jobSource.GetOptions().GetConfig().(type) {
 	case *mgmtv1alpha1.JobSourceOptions_Postgres:
@@ -218,6 +381,8 @@ func GetJobSourceConnection(
 		connectionId = jobSourceConfig.Mysql.GetConnectionId()
 	case *mgmtv1alpha1.JobSourceOptions_Mssql:
 		connectionId = jobSourceConfig.Mssql.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Sqlite:
+		connectionId = jobSourceConfig.Sqlite.GetConnectionId()
 	case *mgmtv1alpha1.JobSourceOptions_Generate:
 		connectionId = jobSourceConfig.Generate.GetFkSourceConnectionId()
 	case *mgmtv1alpha1.JobSourceOptions_AiGenerate:
@@ -226,14 +391,30 @@ func GetJobSourceConnection(
 		connectionId = jobSourceConfig.Mongodb.GetConnectionId()
 	case *mgmtv1alpha1.JobSourceOptions_Dynamodb:
 		connectionId = jobSourceConfig.Dynamodb.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_Kafka:
+		connectionId = jobSourceConfig.Kafka.GetConnectionId()
+	case *mgmtv1alpha1.JobSourceOptions_LocalDirectory:
+		connectionId = jobSourceConfig.LocalDirectory.GetConnectionId()
 	default:
-		return nil, fmt.Errorf("unsupported job source options type for job source connection: %T", jobSourceConfig)
+		return "", fmt.Errorf("unsupported job source options type for job source connection: %T", jobSourceConfig)
 	}
-	sourceConnection, err := GetConnectionById(ctx, connclient, connectionId)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get connection by id (%s): %w", connectionId, err)
+	return connectionId, nil
+}
+
+// Returns the sql driver of the connection or an error if the connection is not a sql connection
+func GetSqlDriverFromConnection(conn *mgmtv1alpha1.Connection) (string, error) {
+	switch conn.GetConnectionConfig().GetConfig().(type) {
+	case *mgmtv1alpha1.ConnectionConfig_PgConfig:
+		return sqlmanager_shared.PostgresDriver, nil
+	case *mgmtv1alpha1.ConnectionConfig_MysqlConfig:
+		return sqlmanager_shared.MysqlDriver, nil
+	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
+		return sqlmanager_shared.MssqlDriver, nil
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		return sqlmanager_shared.SqliteDriver, nil
+	default:
+		return "", fmt.Errorf("unsupported sql connection config")
 	}
-	return sourceConnection, nil
 }
 
 func GetConnectionType(connection *mgmtv1alpha1.Connection) string {
@@ -244,6 +425,8 @@ func GetConnectionType(connection *mgmtv1alpha1.Connection) string {
 		return "mysql"
 	case *mgmtv1alpha1.ConnectionConfig_MssqlConfig:
 		return "sqlserver"
+	case *mgmtv1alpha1.ConnectionConfig_SqliteConfig:
+		return "sqlite"
 	case *mgmtv1alpha1.ConnectionConfig_AwsS3Config:
 		return "aws-s3"
 	case *mgmtv1alpha1.ConnectionConfig_GcpCloudstorageConfig:
@@ -256,6 +439,8 @@ func GetConnectionType(connection *mgmtv1alpha1.Connection) string {
 		return "local-directory"
 	case *mgmtv1alpha1.ConnectionConfig_OpenaiConfig:
 		return "openai"
+	case *mgmtv1alpha1.ConnectionConfig_KafkaConfig:
+		return "kafka"
 	default:
 		return "unknown"
 	}
@@ -303,6 +488,11 @@ func GetSqlJobDestinationOpts(
 			TruncateBeforeInsert: opts.MssqlOptions.GetTruncateTable().GetTruncateBeforeInsert(),
 			InitSchema:           opts.MssqlOptions.GetInitTableSchema(),
 		}, nil
+	case *mgmtv1alpha1.JobDestinationOptions_SqliteOptions:
+		return &SqlJobDestinationOpts{
+			TruncateBeforeInsert: opts.SqliteOptions.GetTruncateTable().GetTruncateBeforeInsert(),
+			InitSchema:           opts.SqliteOptions.GetInitTableSchema(),
+		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported job destination options type: %T", opts)
 	}
@@ -0,0 +1,179 @@
+package synccheckpoint_activity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+)
+
+type Activity struct {
+	jobclient mgmtv1alpha1connect.JobServiceClient
+}
+
+func New(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+) *Activity {
+	return &Activity{
+		jobclient: jobclient,
+	}
+}
+
+type GetResumeCheckpointRequest struct {
+	JobId     string
+	AccountId string
+}
+
+type GetResumeCheckpointResponse struct {
+	// The id of the job run that is being resumed. Empty if this run is not resuming a previous run
+	ResumeFromRunId string
+	// The checkpoint of the run that is being resumed. Nil if the previous run did not get far enough to record one
+	Checkpoint *shared.SyncCheckpoint
+}
+
+// Consumes a pending request to resume a previous run of the job and returns the checkpoint of that run
+func (a *Activity) GetResumeCheckpoint(
+	ctx context.Context,
+	req *GetResumeCheckpointRequest,
+) (*GetResumeCheckpointResponse, error) {
+	activityInfo := activity.GetInfo(ctx)
+	logger := log.With(
+		activity.GetLogger(ctx),
+		"jobId", req.JobId,
+		"accountId", req.AccountId,
+		"WorkflowID", activityInfo.WorkflowExecution.ID,
+		"RunID", activityInfo.WorkflowExecution.RunID,
+	)
+
+	resumeBits, err := a.getRunContext(ctx, req.JobId, shared.GetResumeRunExternalId(), req.AccountId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve resume request: %w", err)
+	}
+	if len(resumeBits) == 0 {
+		return &GetResumeCheckpointResponse{}, nil
+	}
+	var resume *shared.ResumeRun
+	err = json.Unmarshal(resumeBits, &resume)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal resume request: %w", err)
+	}
+
+	// clear the resume request so that it only applies to this run
+	_, err = a.jobclient.SetRunContext(ctx, connect.NewRequest(&mgmtv1alpha1.SetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{
+			JobRunId:   req.JobId,
+			ExternalId: shared.GetResumeRunExternalId(),
+			AccountId:  req.AccountId,
+		},
+		Value: []byte{},
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to clear resume request: %w", err)
+	}
+	if resume == nil || resume.RunId == "" {
+		return &GetResumeCheckpointResponse{}, nil
+	}
+
+	// claims the resumed run under its own id so that no other run can resume it as well.
+	// runs of a job do not overlap, so the claim can not race with another run of the job.
+	resumedByBits, err := a.getRunContext(ctx, resume.RunId, shared.GetResumedByExternalId(), req.AccountId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve resume claim of job run %s: %w", resume.RunId, err)
+	}
+	if len(resumedByBits) > 0 {
+		var resumedBy *shared.ResumedBy
+		err = json.Unmarshal(resumedByBits, &resumedBy)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unmarshal resume claim of job run %s: %w", resume.RunId, err)
+		}
+		if resumedBy != nil && resumedBy.RunId != "" && resumedBy.RunId != activityInfo.WorkflowExecution.ID {
+			logger.Warn("job run has already been resumed, running all tables", "resumeFromRunId", resume.RunId, "resumedByRunId", resumedBy.RunId)
+			return &GetResumeCheckpointResponse{}, nil
+		}
+	}
+	claimBits, err := json.Marshal(&shared.ResumedBy{RunId: activityInfo.WorkflowExecution.ID})
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal resume claim: %w", err)
+	}
+	_, err = a.jobclient.SetRunContext(ctx, connect.NewRequest(&mgmtv1alpha1.SetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{
+			JobRunId:   resume.RunId,
+			ExternalId: shared.GetResumedByExternalId(),
+			AccountId:  req.AccountId,
+		},
+		Value: claimBits,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to claim job run %s for resume: %w", resume.RunId, err)
+	}
+	logger.Info("resuming from previous job run", "resumeFromRunId", resume.RunId)
+
+	checkpointBits, err := a.getRunContext(ctx, resume.RunId, shared.GetSyncCheckpointExternalId(), req.AccountId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve sync checkpoint of job run %s: %w", resume.RunId, err)
+	}
+	if len(checkpointBits) == 0 {
+		logger.Info("previous job run has no sync checkpoint, running all tables", "resumeFromRunId", resume.RunId)
+		return &GetResumeCheckpointResponse{ResumeFromRunId: resume.RunId}, nil
+	}
+	var checkpoint *shared.SyncCheckpoint
+	err = json.Unmarshal(checkpointBits, &checkpoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal sync checkpoint of job run %s: %w", resume.RunId, err)
+	}
+	return &GetResumeCheckpointResponse{ResumeFromRunId: resume.RunId, Checkpoint: checkpoint}, nil
+}
+
+type SetSyncCheckpointRequest struct {
+	AccountId        string
+	CompletedConfigs []string
+}
+
+type SetSyncCheckpointResponse struct{}
+
+// Records the benthos configs that have completed in this run so that the run can be resumed
+func (a *Activity) SetSyncCheckpoint(
+	ctx context.Context,
+	req *SetSyncCheckpointRequest,
+) (*SetSyncCheckpointResponse, error) {
+	activityInfo := activity.GetInfo(ctx)
+	bits, err := json.Marshal(&shared.SyncCheckpoint{CompletedConfigs: req.CompletedConfigs})
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal sync checkpoint: %w", err)
+	}
+	_, err = a.jobclient.SetRunContext(ctx, connect.NewRequest(&mgmtv1alpha1.SetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{
+			JobRunId:   activityInfo.WorkflowExecution.ID,
+			ExternalId: shared.GetSyncCheckpointExternalId(),
+			AccountId:  req.AccountId,
+		},
+		Value: bits,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to set sync checkpoint: %w", err)
+	}
+	return &SetSyncCheckpointResponse{}, nil
+}
+
+// Returns nil if the run context does not exist
+func (a *Activity) getRunContext(ctx context.Context, jobRunId, externalId, accountId string) ([]byte, error) {
+	resp, err := a.jobclient.GetRunContext(ctx, connect.NewRequest(&mgmtv1alpha1.GetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{
+			JobRunId:   jobRunId,
+			ExternalId: externalId,
+			AccountId:  accountId,
+		},
+	}))
+	if err != nil && shared.IsRunContextNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetValue(), nil
+}
@@ -0,0 +1,150 @@
+package synccheckpoint_activity
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func Test_New(t *testing.T) {
+	a := New(mgmtv1alpha1connect.NewMockJobServiceClient(t))
+	require.NotNil(t, a)
+}
+
+func Test_GetResumeCheckpoint_NoResumeRequest(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	jobclient.On("GetRunContext", mock.Anything, mock.Anything).
+		Return(nil, connect.NewError(connect.CodeNotFound, errors.New("no run context exists with the provided key")))
+
+	activity := New(jobclient)
+	env.RegisterActivity(activity)
+
+	val, err := env.ExecuteActivity(activity.GetResumeCheckpoint, &GetResumeCheckpointRequest{JobId: uuid.NewString(), AccountId: uuid.NewString()})
+	require.NoError(t, err)
+	res := &GetResumeCheckpointResponse{}
+	err = val.Get(res)
+	require.NoError(t, err)
+	require.Empty(t, res.ResumeFromRunId)
+	require.Nil(t, res.Checkpoint)
+}
+
+func Test_GetResumeCheckpoint_Resume(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	jobId := uuid.NewString()
+	accountId := uuid.NewString()
+	previousRunId := "previous-run"
+
+	resumeBits, err := json.Marshal(&shared.ResumeRun{RunId: previousRunId})
+	require.NoError(t, err)
+	checkpointBits, err := json.Marshal(&shared.SyncCheckpoint{CompletedConfigs: []string{"public.users"}})
+	require.NoError(t, err)
+
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	jobclient.On("GetRunContext", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{JobRunId: jobId, ExternalId: shared.GetResumeRunExternalId(), AccountId: accountId},
+	})).Return(connect.NewResponse(&mgmtv1alpha1.GetRunContextResponse{Value: resumeBits}), nil)
+	jobclient.On("GetRunContext", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{JobRunId: previousRunId, ExternalId: shared.GetSyncCheckpointExternalId(), AccountId: accountId},
+	})).Return(connect.NewResponse(&mgmtv1alpha1.GetRunContextResponse{Value: checkpointBits}), nil)
+	jobclient.On("GetRunContext", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{JobRunId: previousRunId, ExternalId: shared.GetResumedByExternalId(), AccountId: accountId},
+	})).Return(nil, connect.NewError(connect.CodeNotFound, errors.New("no run context exists with the provided key")))
+	jobclient.On("SetRunContext", mock.Anything, mock.MatchedBy(func(req *connect.Request[mgmtv1alpha1.SetRunContextRequest]) bool {
+		return req.Msg.GetId().GetJobRunId() == previousRunId && req.Msg.GetId().GetExternalId() == shared.GetResumedByExternalId() && len(req.Msg.GetValue()) > 0
+	})).Return(connect.NewResponse(&mgmtv1alpha1.SetRunContextResponse{}), nil).Once()
+	jobclient.On("SetRunContext", mock.Anything, connect.NewRequest(&mgmtv1alpha1.SetRunContextRequest{
+		Id:    &mgmtv1alpha1.RunContextKey{JobRunId: jobId, ExternalId: shared.GetResumeRunExternalId(), AccountId: accountId},
+		Value: []byte{},
+	})).Return(connect.NewResponse(&mgmtv1alpha1.SetRunContextResponse{}), nil)
+
+	activity := New(jobclient)
+	env.RegisterActivity(activity)
+
+	val, err := env.ExecuteActivity(activity.GetResumeCheckpoint, &GetResumeCheckpointRequest{JobId: jobId, AccountId: accountId})
+	require.NoError(t, err)
+	res := &GetResumeCheckpointResponse{}
+	err = val.Get(res)
+	require.NoError(t, err)
+	require.Equal(t, previousRunId, res.ResumeFromRunId)
+	require.Equal(t, &shared.SyncCheckpoint{CompletedConfigs: []string{"public.users"}}, res.Checkpoint)
+}
+
+func Test_GetResumeCheckpoint_AlreadyResumed(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	jobId := uuid.NewString()
+	accountId := uuid.NewString()
+	previousRunId := "previous-run"
+
+	resumeBits, err := json.Marshal(&shared.ResumeRun{RunId: previousRunId})
+	require.NoError(t, err)
+	resumedByBits, err := json.Marshal(&shared.ResumedBy{RunId: "other-run"})
+	require.NoError(t, err)
+
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	jobclient.On("GetRunContext", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{JobRunId: jobId, ExternalId: shared.GetResumeRunExternalId(), AccountId: accountId},
+	})).Return(connect.NewResponse(&mgmtv1alpha1.GetRunContextResponse{Value: resumeBits}), nil)
+	jobclient.On("GetRunContext", mock.Anything, connect.NewRequest(&mgmtv1alpha1.GetRunContextRequest{
+		Id: &mgmtv1alpha1.RunContextKey{JobRunId: previousRunId, ExternalId: shared.GetResumedByExternalId(), AccountId: accountId},
+	})).Return(connect.NewResponse(&mgmtv1alpha1.GetRunContextResponse{Value: resumedByBits}), nil)
+	jobclient.On("SetRunContext", mock.Anything, connect.NewRequest(&mgmtv1alpha1.SetRunContextRequest{
+		Id:    &mgmtv1alpha1.RunContextKey{JobRunId: jobId, ExternalId: shared.GetResumeRunExternalId(), AccountId: accountId},
+		Value: []byte{},
+	})).Return(connect.NewResponse(&mgmtv1alpha1.SetRunContextResponse{}), nil)
+
+	activity := New(jobclient)
+	env.RegisterActivity(activity)
+
+	val, err := env.ExecuteActivity(activity.GetResumeCheckpoint, &GetResumeCheckpointRequest{JobId: jobId, AccountId: accountId})
+	require.NoError(t, err)
+	res := &GetResumeCheckpointResponse{}
+	err = val.Get(res)
+	require.NoError(t, err)
+	require.Empty(t, res.ResumeFromRunId, "a run that was already resumed by another run must not be resumed again")
+	require.Nil(t, res.Checkpoint)
+}
+
+func Test_SetSyncCheckpoint(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	accountId := uuid.NewString()
+	var stored []byte
+
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	jobclient.On("SetRunContext", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			req := args.Get(1).(*connect.Request[mgmtv1alpha1.SetRunContextRequest])
+			require.Equal(t, accountId, req.Msg.GetId().GetAccountId())
+			require.Equal(t, shared.GetSyncCheckpointExternalId(), req.Msg.GetId().GetExternalId())
+			stored = req.Msg.GetValue()
+		}).
+		Return(connect.NewResponse(&mgmtv1alpha1.SetRunContextResponse{}), nil)
+
+	activity := New(jobclient)
+	env.RegisterActivity(activity)
+
+	_, err := env.ExecuteActivity(activity.SetSyncCheckpoint, &SetSyncCheckpointRequest{AccountId: accountId, CompletedConfigs: []string{"public.users", "public.orders"}})
+	require.NoError(t, err)
+
+	var checkpoint *shared.SyncCheckpoint
+	err = json.Unmarshal(stored, &checkpoint)
+	require.NoError(t, err)
+	require.Equal(t, []string{"public.users", "public.orders"}, checkpoint.CompletedConfigs)
+}
@@ -21,13 +21,15 @@ import (
 	neosynclogger "github.com/nucleuscloud/neosync/backend/pkg/logger"
 	"github.com/nucleuscloud/neosync/backend/pkg/metrics"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 	connectiontunnelmanager "github.com/nucleuscloud/neosync/worker/internal/connection-tunnel-manager"
 	"github.com/nucleuscloud/neosync/worker/internal/connection-tunnel-manager/providers"
 	"github.com/nucleuscloud/neosync/worker/internal/connection-tunnel-manager/providers/mongoprovider"
 	"github.com/nucleuscloud/neosync/worker/internal/connection-tunnel-manager/providers/sqlprovider"
 	benthos_environment "github.com/nucleuscloud/neosync/worker/pkg/benthos/environment"
 	_ "github.com/nucleuscloud/neosync/worker/pkg/benthos/redis"
-	_ "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers"
+	"github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers"
+	transformer_utils "github.com/nucleuscloud/neosync/worker/pkg/benthos/transformers/utils"
 
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
@@ -57,6 +59,8 @@ type SyncRequest struct {
 	// Identifier that is used in combination with the AccountId to retrieve the benthos config
 	Name      string
 	AccountId string
+	// The job whose connections the secret references in the benthos config are resolved from
+	JobId string
 }
 type SyncResponse struct {
 	Schema string
@@ -72,6 +76,8 @@ func New(
 	meter metric.Meter,
 	benthosStreamManager BenthosStreamManagerClient,
 	disableReaper bool,
+	transformerSecret string,
+	secretResolver secrets.Resolver,
 ) *Activity {
 	return &Activity{
 		connclient:           connclient,
@@ -82,6 +88,8 @@ func New(
 		meter:                meter,
 		benthosStreamManager: benthosStreamManager,
 		disableReaper:        disableReaper,
+		transformerSecret:    transformerSecret,
+		secretResolver:       secretResolver,
 	}
 }
 
@@ -94,11 +102,13 @@ type Activity struct {
 	meter                metric.Meter // optional
 	benthosStreamManager BenthosStreamManagerClient
 	disableReaper        bool
+	transformerSecret    string           // optional, used to derive the account key for deterministic transformers
+	secretResolver       secrets.Resolver // optional, used to resolve secret references in connection configs
 }
 
 func (a *Activity) getTunnelManagerByRunId(wfId, runId string) (connectiontunnelmanager.Interface[any], error) {
 	connectionProvider := providers.NewProvider(
-		mongoprovider.NewProvider(),
+		mongoprovider.NewProvider(a.secretResolver),
 		sqlprovider.NewProvider(a.sqlconnector),
 	)
 	val, loaded := a.tunnelmanagermap.LoadOrStore(runId, connectiontunnelmanager.NewConnectionTunnelManager[any](connectionProvider))
@@ -262,6 +272,15 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 		return nil, fmt.Errorf("was unable to build connection details for some or all connections: %w", err)
 	}
 
+	blobEnv := bloblang.NewEnvironment()
+	if a.transformerSecret != "" && req.AccountId != "" {
+		// the account key is handed to the transformers directly so that it is never reachable through config interpolation
+		err = transformers.RegisterAccountKeyedFunctions(blobEnv, transformer_utils.DeriveAccountTransformerKey(a.transformerSecret, req.AccountId))
+		if err != nil {
+			return nil, fmt.Errorf("unable to register account keyed transformers: %w", err)
+		}
+	}
+
 	benenv, err := benthos_environment.NewEnvironment(
 		slogger,
 		benthos_environment.WithMeter(a.meter),
@@ -273,7 +292,7 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 			Provider: newMongoPoolProvider(getMongoPoolProviderGetter(tunnelmanager, &dsnToConnectionIdMap, connectionMap, session, slogger)),
 		}),
 		benthos_environment.WithStopChannel(stopActivityChan),
-		benthos_environment.WithBlobEnv(bloblang.NewEnvironment()),
+		benthos_environment.WithBlobEnv(blobEnv),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to instantiate benthos environment: %w", err)
@@ -283,6 +302,20 @@ func (a *Activity) Sync(ctx context.Context, req *SyncRequest, metadata *SyncMet
 	envKeyMap[metrics.TemporalWorkflowIdEnvKey] = info.WorkflowExecution.ID
 	envKeyMap[metrics.TemporalRunIdEnvKey] = info.WorkflowExecution.RunID
 	envKeyMap[metrics.NeosyncDateEnvKey] = time.Now().UTC().Format(metrics.NeosyncDateFormat)
+	secretConnections := connections
+	if req.JobId != "" {
+		secretConnections, err = getJobConnections(ctx, a.jobclient, a.connclient, req.JobId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	secretEnvKeyMap, err := getSecretReferenceEnvVars(ctx, a.secretResolver, req.AccountId, secretConnections)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range secretEnvKeyMap {
+		envKeyMap[key] = value
+	}
 
 	streamBuilderMu.Lock()
 	streambldr := benenv.NewStreamBuilder()
@@ -371,6 +404,94 @@ func getEnvVarLookupFn(input map[string]string) func(key string) (string, bool)
 	}
 }
 
+// Returns the source and destination connections of the job
+func getJobConnections(
+	ctx context.Context,
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+	connclient mgmtv1alpha1connect.ConnectionServiceClient,
+	jobId string,
+) ([]*mgmtv1alpha1.Connection, error) {
+	jobResp, err := jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: jobId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve job: %w", err)
+	}
+	job := jobResp.Msg.GetJob()
+
+	connectionIds := []string{}
+	sourceConnectionId, err := shared.GetJobSourceConnectionId(job.GetSource())
+	if err != nil {
+		return nil, err
+	}
+	if sourceConnectionId != "" {
+		connectionIds = append(connectionIds, sourceConnectionId)
+	}
+	for _, destination := range job.GetDestinations() {
+		connectionIds = append(connectionIds, destination.GetConnectionId())
+	}
+
+	connections := make([]*mgmtv1alpha1.Connection, len(connectionIds))
+	errgrp, errctx := errgroup.WithContext(ctx)
+	for idx, connectionId := range connectionIds {
+		idx := idx
+		connectionId := connectionId
+		errgrp.Go(func() error {
+			connection, err := shared.GetConnectionById(errctx, connclient, connectionId)
+			if err != nil {
+				return fmt.Errorf("failed to retrieve connection: %w", err)
+			}
+			connections[idx] = connection
+			return nil
+		})
+	}
+	if err := errgrp.Wait(); err != nil {
+		return nil, fmt.Errorf("unable to retrieve all or some job connections: %w", err)
+	}
+	return connections, nil
+}
+
+// Resolves the secret references and inline credentials of the given connections into the env vars that they are placed under in the benthos config.
+// Only references stored in the job's own connections are resolved, so a reference that has been injected into the config through any other value is never looked up.
+// Inline credentials are never written to the benthos config, so they are always provided here from the connection itself.
+func getSecretReferenceEnvVars(
+	ctx context.Context,
+	resolver secrets.Resolver,
+	accountId string,
+	connections []*mgmtv1alpha1.Connection,
+) (map[string]string, error) {
+	output := map[string]string{}
+	for _, connection := range connections {
+		references := secrets.GetReferences(connection.GetConnectionConfig())
+		credentials := shared.GetConnectionCredentialEnvVars(connection)
+		if len(references) == 0 && len(credentials) == 0 {
+			continue
+		}
+		if accountId != "" && connection.GetAccountId() != accountId {
+			return nil, fmt.Errorf("connection %s does not belong to account %s", connection.GetId(), accountId)
+		}
+		for key, value := range credentials {
+			output[key] = value
+		}
+		if len(references) == 0 {
+			continue
+		}
+		if resolver == nil {
+			return nil, secrets.ErrResolverNotConfigured
+		}
+		for _, reference := range references {
+			key := shared.GetSecretReferenceEnvKey(reference)
+			if _, ok := output[key]; ok {
+				continue
+			}
+			value, err := resolver.Resolve(ctx, reference)
+			if err != nil {
+				return nil, fmt.Errorf("unable to resolve benthos config secrets: %w", err)
+			}
+			output[key] = value
+		}
+	}
+	return output, nil
+}
+
 func syncMapToStringMap(incoming *sync.Map) map[string]string {
 	out := map[string]string{}
 	if incoming == nil {
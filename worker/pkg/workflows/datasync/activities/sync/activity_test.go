@@ -17,6 +17,7 @@ import (
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
+	"github.com/nucleuscloud/neosync/internal/secrets"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -60,7 +61,7 @@ output:
 
 	jobclient := mgmtv1alpha1connect.NewJobServiceClient(srv.Client(), srv.URL)
 
-	activity := New(nil, jobclient, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true)
+	activity := New(nil, jobclient, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true, "", nil)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -80,7 +81,7 @@ func Test_Sync_Run_No_BenthosConfig(t *testing.T) {
 
 	benthosStreamManager := NewBenthosStreamManager()
 
-	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true)
+	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true, "", nil)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -94,7 +95,7 @@ func Test_Sync_Run_Success(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true)
+	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true, "", nil)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -124,7 +125,7 @@ func Test_Sync_Run_Metrics_Success(t *testing.T) {
 	meterProvider := metricsdk.NewMeterProvider()
 	meter := meterProvider.Meter("test")
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, meter, benthosStreamManager, true)
+	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, meter, benthosStreamManager, true, "", nil)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -154,7 +155,7 @@ func Test_Sync_Fake_Mutation_Success(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true)
+	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true, "", nil)
 	env.RegisterActivity(activity.Sync)
 
 	val, err := env.ExecuteActivity(activity.Sync, &SyncRequest{
@@ -186,7 +187,7 @@ func Test_Sync_Run_Success_Javascript(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true)
+	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true, "", nil)
 	env.RegisterActivity(activity.Sync)
 
 	tmpFile, err := os.CreateTemp("", "test")
@@ -242,7 +243,7 @@ func Test_Sync_Run_Success_MutataionAndJavascript(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestActivityEnvironment()
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true)
+	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true, "", nil)
 	env.RegisterActivity(activity.Sync)
 
 	tmpFile, err := os.CreateTemp("", "test")
@@ -301,7 +302,7 @@ func Test_Sync_Run_Processor_Error(t *testing.T) {
 	env := testSuite.NewTestActivityEnvironment()
 
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true)
+	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true, "", nil)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -332,7 +333,7 @@ func Test_Sync_Run_Output_Error(t *testing.T) {
 
 	mockBenthosStreamManager := NewMockBenthosStreamManagerClient(t)
 	mockBenthosStream := NewMockBenthosStreamClient(t)
-	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, mockBenthosStreamManager, true)
+	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, mockBenthosStreamManager, true, "", nil)
 
 	env.RegisterActivity(activity.Sync)
 
@@ -383,7 +384,7 @@ output:
 	mockBenthosStream.On("Run", mock.Anything).After(5 * time.Second).Return(nil)
 	mockBenthosStream.On("StopWithin", mock.Anything).Return(nil)
 
-	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, mockBenthosStreamManager, true)
+	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, mockBenthosStreamManager, true, "", nil)
 	env.RegisterActivity(activity.Sync)
 
 	stopCh := make(chan struct{})
@@ -406,7 +407,7 @@ func Test_Sync_Run_ActivityWorkerStop(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestActivityEnvironment()
 	benthosStreamManager := NewBenthosStreamManager()
-	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true)
+	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, benthosStreamManager, true, "", nil)
 
 	env.RegisterActivity(activity.Sync)
 	stopCh := make(chan struct{})
@@ -457,7 +458,7 @@ output:
 	mockBenthosStream.On("Run", mock.Anything).Return(errors.New(errmsg))
 	mockBenthosStream.On("StopWithin", mock.Anything).Return(nil).Maybe()
 
-	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, mockBenthosStreamManager, true)
+	activity := New(nil, nil, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, nil, nil, mockBenthosStreamManager, true, "", nil)
 
 	env.RegisterActivity(activity.Sync)
 	_, err := env.ExecuteActivity(activity.Sync, &SyncRequest{
@@ -485,6 +486,120 @@ func Test_getEnvVarLookupFn(t *testing.T) {
 	assert.Empty(t, val)
 }
 
+func Test_getSecretReferenceEnvVars(t *testing.T) {
+	t.Setenv("NEOSYNC_SECRET_AWS", "secret")
+	t.Setenv("NEOSYNC_SECRET_OTHER", "other")
+	key := shared.GetSecretReferenceEnvKey("env://NEOSYNC_SECRET_AWS")
+	accountId := uuid.NewString()
+	s3ConnId := uuid.NewString()
+	kafkaConnId := uuid.NewString()
+	connections := []*mgmtv1alpha1.Connection{
+		{
+			Id:        s3ConnId,
+			AccountId: accountId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_AwsS3Config{
+					AwsS3Config: &mgmtv1alpha1.AwsS3ConnectionConfig{
+						Credentials: &mgmtv1alpha1.AwsS3Credentials{
+							AccessKeyId:     shared.Ptr("access-key"),
+							SecretAccessKey: shared.Ptr("env://NEOSYNC_SECRET_AWS"),
+							SessionToken:    shared.Ptr("env://NEOSYNC_SECRET_AWS"),
+						},
+					},
+				},
+			},
+		},
+		{Id: uuid.NewString(), AccountId: accountId, ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{Config: &mgmtv1alpha1.ConnectionConfig_PgConfig{}}},
+		{
+			Id:        kafkaConnId,
+			AccountId: accountId,
+			ConnectionConfig: &mgmtv1alpha1.ConnectionConfig{
+				Config: &mgmtv1alpha1.ConnectionConfig_KafkaConfig{
+					KafkaConfig: &mgmtv1alpha1.KafkaConnectionConfig{
+						Sasl: &mgmtv1alpha1.KafkaSaslConfig{Username: "neosync", Password: "kafka-password"},
+					},
+				},
+			},
+		},
+	}
+	resolver := secrets.New(secrets.NewEnvProvider("NEOSYNC_SECRET_"))
+
+	out, err := getSecretReferenceEnvVars(context.Background(), resolver, accountId, connections)
+	require.NoError(t, err)
+	// references that are not stored in the job's connections are never resolved
+	require.Equal(t, map[string]string{
+		key: "secret",
+		shared.GetConnectionCredentialEnvKey(s3ConnId, shared.CredentialAwsAccessKeyId):       "access-key",
+		shared.GetConnectionCredentialEnvKey(kafkaConnId, shared.CredentialKafkaSaslPassword): "kafka-password",
+	}, out)
+
+	_, err = getSecretReferenceEnvVars(context.Background(), nil, accountId, connections)
+	require.ErrorIs(t, err, secrets.ErrResolverNotConfigured)
+
+	_, err = getSecretReferenceEnvVars(context.Background(), resolver, uuid.NewString(), connections)
+	require.Error(t, err)
+
+	// inline credentials do not require a resolver
+	out, err = getSecretReferenceEnvVars(context.Background(), nil, accountId, connections[1:])
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		shared.GetConnectionCredentialEnvKey(kafkaConnId, shared.CredentialKafkaSaslPassword): "kafka-password",
+	}, out)
+
+	out, err = getSecretReferenceEnvVars(context.Background(), nil, accountId, connections[1:2])
+	require.NoError(t, err)
+	require.Empty(t, out)
+}
+
+func Test_getJobConnections(t *testing.T) {
+	jobId := uuid.NewString()
+	sourceId := uuid.NewString()
+	destinationId := uuid.NewString()
+
+	mux := http.NewServeMux()
+	mux.Handle(mgmtv1alpha1connect.JobServiceGetJobProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.JobServiceGetJobProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetJobRequest]) (*connect.Response[mgmtv1alpha1.GetJobResponse], error) {
+			if r.Msg.GetId() != jobId {
+				return nil, errors.New("invalid test job id")
+			}
+			return connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+				Job: &mgmtv1alpha1.Job{
+					Id: jobId,
+					Source: &mgmtv1alpha1.JobSource{
+						Options: &mgmtv1alpha1.JobSourceOptions{
+							Config: &mgmtv1alpha1.JobSourceOptions_Postgres{
+								Postgres: &mgmtv1alpha1.PostgresSourceConnectionOptions{ConnectionId: sourceId},
+							},
+						},
+					},
+					Destinations: []*mgmtv1alpha1.JobDestination{{ConnectionId: destinationId}},
+				},
+			}), nil
+		},
+	))
+	mux.Handle(mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.ConnectionServiceGetConnectionProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.GetConnectionRequest]) (*connect.Response[mgmtv1alpha1.GetConnectionResponse], error) {
+			return connect.NewResponse(&mgmtv1alpha1.GetConnectionResponse{
+				Connection: &mgmtv1alpha1.Connection{Id: r.Msg.GetId()},
+			}), nil
+		},
+	))
+	srv := startHTTPServer(t, mux)
+
+	connections, err := getJobConnections(
+		context.Background(),
+		mgmtv1alpha1connect.NewJobServiceClient(srv.Client(), srv.URL),
+		mgmtv1alpha1connect.NewConnectionServiceClient(srv.Client(), srv.URL),
+		jobId,
+	)
+	require.NoError(t, err)
+	require.Len(t, connections, 2)
+	require.Equal(t, sourceId, connections[0].GetId())
+	require.Equal(t, destinationId, connections[1].GetId())
+}
+
 func Test_syncMapToStringMap(t *testing.T) {
 	syncmap := sync.Map{}
 
@@ -8,13 +8,18 @@ import (
 	"sync"
 	"time"
 
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
 	accountstatus_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/account-status"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
 	posttablesync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/post-table-sync"
+	publishjobrunevent_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/publish-job-run-event"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
+	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
 	syncactivityopts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-activity-opts"
+	synccheckpoint_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-checkpoint"
 	syncrediscleanup_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-redis-clean-up"
 	"github.com/spf13/viper"
 	"go.temporal.io/sdk/log"
@@ -54,7 +59,51 @@ func withCheckAccountStatusActivityOptions(ctx workflow.Context) workflow.Contex
 	})
 }
 
+func withSyncCheckpointActivityOptions(ctx workflow.Context) workflow.Context {
+	return workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+		HeartbeatTimeout: 1 * time.Minute,
+	})
+}
+
 func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse, error) {
+	logger := log.With(workflow.GetLogger(wfctx), "jobId", req.JobId)
+
+	// runs started before job run events were introduced must replay without the publish activities
+	eventsVersion := workflow.GetVersion(wfctx, "job-run-events", workflow.DefaultVersion, 1)
+	isEventsEnabled := eventsVersion != workflow.DefaultVersion
+
+	if isEventsEnabled {
+		runPublishJobRunEventActivity(wfctx, logger, req.JobId, mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_STARTED, nil)
+	}
+	resp, err := runDataSync(wfctx, req)
+
+	if isEventsEnabled {
+		// the workflow context is canceled when the run is canceled, so the final event is published from a disconnected context
+		eventctx, cancel := workflow.NewDisconnectedContext(wfctx)
+		defer cancel()
+		eventType, errMsg := getJobRunEventTypeFromResult(wfctx, err)
+		runPublishJobRunEventActivity(eventctx, logger, req.JobId, eventType, errMsg)
+	}
+	return resp, err
+}
+
+// Returns the lifecycle event that describes how the workflow ended
+func getJobRunEventTypeFromResult(wfctx workflow.Context, err error) (mgmtv1alpha1.JobRunEventType, *string) {
+	if err == nil {
+		return mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_SUCCEEDED, nil
+	}
+	if wfctx.Err() != nil {
+		return mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_CANCELED, nil
+	}
+	errMsg := err.Error()
+	return mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_FAILED, &errMsg
+}
+
+func runDataSync(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse, error) {
 	ctx, cancelHandler := workflow.WithCancel(wfctx)
 	logger := workflow.GetLogger(ctx)
 
@@ -114,20 +163,68 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 		return &WorkflowResponse{}, nil
 	}
 
-	logger.Info("scheduling RunSqlInitTableStatements for execution.")
-	var resp *runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse
-	var runSqlInitTableStatements *runsqlinittablestmts_activity.Activity
-	err = workflow.ExecuteActivity(
-		workflow.WithActivityOptions(ctx, *actOptResp.SyncActivityOptions),
-		runSqlInitTableStatements.RunSqlInitTableStatements,
-		&runsqlinittablestmts_activity.RunSqlInitTableStatementsRequest{
-			JobId: req.JobId,
-		}).
-		Get(ctx, &resp)
-	if err != nil {
-		return nil, err
+	// runs started before sync checkpoints were introduced must replay without the checkpoint activities
+	checkpointVersion := workflow.GetVersion(ctx, "sync-checkpoint", workflow.DefaultVersion, 1)
+	isCheckpointEnabled := checkpointVersion != workflow.DefaultVersion
+
+	skippedConfigs := map[string]struct{}{}
+	completedConfigs := []string{}
+	if !isCheckpointEnabled {
+		err = runSqlInitTableStatementsActivity(ctx, logger, actOptResp, req.JobId, nil)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		logger.Info("scheduling GetResumeCheckpoint for execution.")
+		var checkpointResp *synccheckpoint_activity.GetResumeCheckpointResponse
+		var checkpointActivity *synccheckpoint_activity.Activity
+		err = workflow.ExecuteActivity(
+			withSyncCheckpointActivityOptions(ctx),
+			checkpointActivity.GetResumeCheckpoint,
+			&synccheckpoint_activity.GetResumeCheckpointRequest{
+				JobId:     req.JobId,
+				AccountId: actOptResp.AccountId,
+			}).
+			Get(ctx, &checkpointResp)
+		if err != nil {
+			return nil, err
+		}
+		skippedConfigs = getSkippableConfigs(bcResp.BenthosConfigs, checkpointResp.Checkpoint)
+
+		if checkpointResp.Checkpoint != nil {
+			// the previous run already initialized the destination, running the init statements again would truncate the completed tables.
+			// only the tables that are synced again are cleared so that the rows the previous run partially wrote are not duplicated
+			clearTables := getTablesToClear(bcResp.BenthosConfigs, skippedConfigs)
+			logger.Info(
+				"resuming from previous job run.",
+				"resumeFromRunId", checkpointResp.ResumeFromRunId,
+				"skippedConfigs", len(skippedConfigs),
+				"clearedTables", len(clearTables),
+			)
+			if len(clearTables) > 0 {
+				err = runSqlInitTableStatementsActivity(ctx, logger, actOptResp, req.JobId, clearTables)
+				if err != nil {
+					return nil, err
+				}
+			}
+		} else {
+			err = runSqlInitTableStatementsActivity(ctx, logger, actOptResp, req.JobId, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, cfg := range bcResp.BenthosConfigs {
+			if _, ok := skippedConfigs[cfg.Name]; ok {
+				completedConfigs = append(completedConfigs, cfg.Name)
+			}
+		}
+		// recording the checkpoint up front marks the destination as initialized for future resumes
+		err = runSetSyncCheckpointActivity(ctx, logger, actOptResp.AccountId, completedConfigs)
+		if err != nil {
+			logger.Error("set sync checkpoint activity did not complete", "error", err)
+		}
 	}
-	logger.Info("completed RunSqlInitTableStatements.")
 
 	redisDependsOn := map[string]map[string][]string{} // schema.table -> dependson
 	redisConfigs := map[string]*genbenthosconfigs_activity.BenthosRedisConfig{}
@@ -212,8 +309,21 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 	started := sync.Map{}
 	completed := sync.Map{}
 
+	for _, bc := range bcResp.BenthosConfigs {
+		if _, ok := skippedConfigs[bc.Name]; !ok {
+			continue
+		}
+		logger.Info("skipping config completed in previous job run", "name", bc.Name)
+		started.Store(bc.Name, struct{}{})
+		err = updateCompletedMap(neosync_benthos.BuildBenthosTable(bc.TableSchema, bc.TableName), &completed, bc.Columns)
+		if err != nil {
+			return nil, err
+		}
+		delete(redisDependsOn, bc.Name)
+	}
+
 	executeSyncActivity := func(bc *genbenthosconfigs_activity.BenthosConfigResponse, logger log.Logger) {
-		future := invokeSync(bc, ctx, &started, &completed, logger, &bcResp.AccountId, actOptResp.SyncActivityOptions)
+		future := invokeSync(bc, ctx, &started, &completed, logger, &bcResp.AccountId, req.JobId, actOptResp.SyncActivityOptions)
 		workselector.AddFuture(future, func(f workflow.Future) {
 			var result sync_activity.SyncResponse
 			err := f.Get(ctx, &result)
@@ -230,6 +340,13 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 				return
 			}
 			logger.Info("config sync completed", "name", bc.Name)
+			if isCheckpointEnabled {
+				completedConfigs = append(completedConfigs, bc.Name)
+				err = runSetSyncCheckpointActivity(ctx, logger, actOptResp.AccountId, completedConfigs)
+				if err != nil {
+					logger.Error("set sync checkpoint activity did not complete", "error", err)
+				}
+			}
 			err = runPostTableSyncActivity(ctx, logger, actOptResp, bc.Name)
 			if err != nil {
 				logger.Error("post table sync activity did not complete", "schema", bc.TableSchema, "table", bc.TableName)
@@ -243,6 +360,9 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 	}
 
 	for _, bc := range splitConfigs.Root {
+		if _, ok := skippedConfigs[bc.Name]; ok {
+			continue
+		}
 		logger := log.With(logger, withBenthosConfigResponseLoggerTags(bc)...)
 		executeSyncActivity(bc, logger)
 
@@ -254,29 +374,14 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 		}
 	}
 
-	logger.Info("all root tables spawned, moving on to children")
-	for i := 0; i < len(bcResp.BenthosConfigs); i++ {
-		logger.Debug("*** blocking select ***", "i", i)
-		workselector.Select(ctx)
-		if activityErr != nil {
-			return nil, activityErr
-		}
-		logger.Debug("*** post select ***", "i", i)
-
-		if ctx.Err() != nil {
-			if errors.Is(ctx.Err(), context.Canceled) {
-				return nil, fmt.Errorf("workflow canceled due to error or stop signal: %w", ctx.Err())
-			}
-			return nil, fmt.Errorf("exiting workflow in root sync due to err: %w", ctx.Err())
-		}
-
+	executeReadyDependents := func() error {
 		// todo: deadlock detection
 		for _, bc := range splitConfigs.Dependents {
 			if ctx.Err() != nil {
 				if errors.Is(ctx.Err(), context.Canceled) {
-					return nil, fmt.Errorf("workflow canceled due to error or stop signal: %w", ctx.Err())
+					return fmt.Errorf("workflow canceled due to error or stop signal: %w", ctx.Err())
 				}
-				return nil, fmt.Errorf("exiting workflow in dependent sync due err: %w", ctx.Err())
+				return fmt.Errorf("exiting workflow in dependent sync due err: %w", ctx.Err())
 			}
 			bc := bc
 			if _, configStarted := started.Load(bc.Name); configStarted {
@@ -284,7 +389,7 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 			}
 			isReady, err := isConfigReady(bc, &completed)
 			if err != nil {
-				return nil, err
+				return err
 			}
 
 			if !isReady {
@@ -293,6 +398,34 @@ func Workflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse,
 
 			executeSyncActivity(bc, log.With(logger, withBenthosConfigResponseLoggerTags(bc)...))
 		}
+		return nil
+	}
+
+	logger.Info("all root tables spawned, moving on to children")
+	// dependents of configs that were skipped when resuming are ready before anything has completed in this run
+	err = executeReadyDependents()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(bcResp.BenthosConfigs)-len(skippedConfigs); i++ {
+		logger.Debug("*** blocking select ***", "i", i)
+		workselector.Select(ctx)
+		if activityErr != nil {
+			return nil, activityErr
+		}
+		logger.Debug("*** post select ***", "i", i)
+
+		if ctx.Err() != nil {
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil, fmt.Errorf("workflow canceled due to error or stop signal: %w", ctx.Err())
+			}
+			return nil, fmt.Errorf("exiting workflow in root sync due to err: %w", ctx.Err())
+		}
+
+		err = executeReadyDependents()
+		if err != nil {
+			return nil, err
+		}
 	}
 	logger.Info("data sync workflow completed")
 	return &WorkflowResponse{}, nil
@@ -355,6 +488,170 @@ func runPostTableSyncActivity(
 	return nil
 }
 
+// Publishes the job run event to the account's notification rules.
+// Notifications are best effort and never fail the workflow.
+func runPublishJobRunEventActivity(
+	ctx workflow.Context,
+	logger log.Logger,
+	jobId string,
+	eventType mgmtv1alpha1.JobRunEventType,
+	errMsg *string,
+) {
+	logger.Debug("executing publish job run event activity", "eventType", eventType.String())
+	var resp *publishjobrunevent_activity.PublishJobRunEventResponse
+	var publishActivity *publishjobrunevent_activity.Activity
+	err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			StartToCloseTimeout: 2 * time.Minute,
+			RetryPolicy: &temporal.RetryPolicy{
+				MaximumAttempts: 2,
+			},
+			HeartbeatTimeout: 1 * time.Minute,
+		}),
+		publishActivity.PublishJobRunEvent,
+		&publishjobrunevent_activity.PublishJobRunEventRequest{
+			JobId:        jobId,
+			JobRunId:     workflow.GetInfo(ctx).WorkflowExecution.ID,
+			EventType:    eventType,
+			ErrorMessage: errMsg,
+		}).Get(ctx, &resp)
+	if err != nil {
+		logger.Error("publish job run event activity did not complete", "eventType", eventType.String(), "error", err)
+	}
+}
+
+func runSqlInitTableStatementsActivity(
+	ctx workflow.Context,
+	logger log.Logger,
+	actOptResp *syncactivityopts_activity.RetrieveActivityOptionsResponse,
+	jobId string,
+	clearTables []string,
+) error {
+	logger.Info("scheduling RunSqlInitTableStatements for execution.")
+	var resp *runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse
+	var runSqlInitTableStatements *runsqlinittablestmts_activity.Activity
+	err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, *actOptResp.SyncActivityOptions),
+		runSqlInitTableStatements.RunSqlInitTableStatements,
+		&runsqlinittablestmts_activity.RunSqlInitTableStatementsRequest{
+			JobId:       jobId,
+			ClearTables: clearTables,
+		}).
+		Get(ctx, &resp)
+	if err != nil {
+		return err
+	}
+	logger.Info("completed RunSqlInitTableStatements.")
+	return nil
+}
+
+func runSetSyncCheckpointActivity(
+	ctx workflow.Context,
+	logger log.Logger,
+	accountId string,
+	completedConfigs []string,
+) error {
+	logger.Debug("executing set sync checkpoint activity")
+	var resp *synccheckpoint_activity.SetSyncCheckpointResponse
+	var checkpointActivity *synccheckpoint_activity.Activity
+	return workflow.ExecuteActivity(
+		withSyncCheckpointActivityOptions(ctx),
+		checkpointActivity.SetSyncCheckpoint,
+		&synccheckpoint_activity.SetSyncCheckpointRequest{
+			AccountId:        accountId,
+			CompletedConfigs: slices.Clone(completedConfigs),
+		}).Get(ctx, &resp)
+}
+
+// Returns the names of the configs that completed in the job run being resumed and do not need to run again.
+// Completed configs that produce redis values that a config that still has to run depends on are run again,
+// as the redis values are cleaned up when a job run fails.
+// Completed configs of tables that are cleared before they are synced again, or that depend on such a table, are run again as well.
+func getSkippableConfigs(
+	configs []*genbenthosconfigs_activity.BenthosConfigResponse,
+	checkpoint *shared.SyncCheckpoint,
+) map[string]struct{} {
+	skippable := map[string]struct{}{}
+	if checkpoint == nil {
+		return skippable
+	}
+	for _, cfg := range configs {
+		if slices.Contains(checkpoint.CompletedConfigs, cfg.Name) {
+			skippable[cfg.Name] = struct{}{}
+		}
+	}
+
+	for {
+		pendingDependsOn := map[string]map[string][]string{}
+		for _, cfg := range configs {
+			if _, ok := skippable[cfg.Name]; !ok {
+				pendingDependsOn[cfg.Name] = cfg.RedisDependsOn
+			}
+		}
+		clearedTables := map[string]struct{}{}
+		for _, table := range getTablesToClear(configs, skippable) {
+			clearedTables[table] = struct{}{}
+		}
+		changed := false
+		for _, cfg := range configs {
+			if _, ok := skippable[cfg.Name]; !ok {
+				continue
+			}
+			if isConfigAffectedByClearedTables(cfg, clearedTables) {
+				delete(skippable, cfg.Name)
+				changed = true
+				continue
+			}
+			for _, redisCfg := range cfg.RedisConfig {
+				if !isReadyForCleanUp(redisCfg.Table, redisCfg.Column, pendingDependsOn) {
+					delete(skippable, cfg.Name)
+					changed = true
+					break
+				}
+			}
+		}
+		if !changed {
+			return skippable
+		}
+	}
+}
+
+// Returns the tables (schema.table) whose rows are inserted again when resuming a job run.
+// Any rows the resumed run wrote to these tables have to be cleared before they are synced again.
+func getTablesToClear(
+	configs []*genbenthosconfigs_activity.BenthosConfigResponse,
+	skippable map[string]struct{},
+) []string {
+	tables := []string{}
+	for _, cfg := range configs {
+		if _, ok := skippable[cfg.Name]; ok || cfg.RunType == tabledependency.RunTypeUpdate {
+			continue
+		}
+		table := neosync_benthos.BuildBenthosTable(cfg.TableSchema, cfg.TableName)
+		if !slices.Contains(tables, table) {
+			tables = append(tables, table)
+		}
+	}
+	slices.Sort(tables)
+	return tables
+}
+
+// A config is affected when its own table is cleared or when it depends on a table that is cleared
+func isConfigAffectedByClearedTables(
+	cfg *genbenthosconfigs_activity.BenthosConfigResponse,
+	clearedTables map[string]struct{},
+) bool {
+	if _, ok := clearedTables[neosync_benthos.BuildBenthosTable(cfg.TableSchema, cfg.TableName)]; ok {
+		return true
+	}
+	for _, dep := range cfg.DependsOn {
+		if _, ok := clearedTables[dep.Table]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 func runRedisCleanUpActivity(
 	ctx workflow.Context,
 	logger log.Logger,
@@ -431,6 +728,7 @@ func invokeSync(
 	started, completed *sync.Map,
 	logger log.Logger,
 	accountId *string,
+	jobId string,
 	syncActivityOptions *workflow.ActivityOptions,
 ) workflow.Future {
 	metadata := getSyncMetadata(config)
@@ -459,7 +757,7 @@ func invokeSync(
 		err := workflow.ExecuteActivity(
 			workflow.WithActivityOptions(ctx, *syncActivityOptions),
 			activity.Sync,
-			&sync_activity.SyncRequest{BenthosConfig: benthosConfig, AccountId: accId, Name: config.Name, BenthosDsns: config.BenthosDsns}, metadata).Get(ctx, &result)
+			&sync_activity.SyncRequest{BenthosConfig: benthosConfig, AccountId: accId, Name: config.Name, BenthosDsns: config.BenthosDsns, JobId: jobId}, metadata).Get(ctx, &result)
 		if err == nil {
 			tn := neosync_benthos.BuildBenthosTable(config.TableSchema, config.TableName)
 			err = updateCompletedMap(tn, completed, config.Columns)
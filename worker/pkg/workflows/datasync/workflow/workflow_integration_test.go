@@ -15,16 +15,19 @@ import (
 	pg_queries "github.com/nucleuscloud/neosync/backend/gen/go/db/dbschemas/postgresql"
 	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/nucleuscloud/neosync/backend/pkg/mongoconnect"
 	mssql_queries "github.com/nucleuscloud/neosync/backend/pkg/mssql-querier"
 	"github.com/nucleuscloud/neosync/backend/pkg/sqlconnect"
 	sql_manager "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager"
 	sqlmanager_shared "github.com/nucleuscloud/neosync/backend/pkg/sqlmanager/shared"
 	accountstatus_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/account-status"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	publishjobrunevent_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/publish-job-run-event"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
 	syncactivityopts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-activity-opts"
+	synccheckpoint_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-checkpoint"
 	syncrediscleanup_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-redis-clean-up"
 	workflow_testdata "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow/testdata"
 	testdata_javascripttransformers "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow/testdata/javascript-transformers"
@@ -418,12 +421,22 @@ func addRunContextProcedureMux(mux *http.ServeMux) {
 			defer rcmu.RUnlock()
 			val, ok := rcmap[toRunContextKeyString(r.Msg.GetId())]
 			if !ok {
-				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unable to find key: %s", toRunContextKeyString(r.Msg.GetId())))
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no run context exists with the provided key: %s", toRunContextKeyString(r.Msg.GetId())))
 			}
 			return connect.NewResponse(&mgmtv1alpha1.GetRunContextResponse{Value: val}), nil
 		},
 	))
 
+	mux.Handle(mgmtv1alpha1connect.JobServiceSetRunContextProcedure, connect.NewUnaryHandler(
+		mgmtv1alpha1connect.JobServiceSetRunContextProcedure,
+		func(ctx context.Context, r *connect.Request[mgmtv1alpha1.SetRunContextRequest]) (*connect.Response[mgmtv1alpha1.SetRunContextResponse], error) {
+			rcmu.Lock()
+			defer rcmu.Unlock()
+			rcmap[toRunContextKeyString(r.Msg.GetId())] = r.Msg.GetValue()
+			return connect.NewResponse(&mgmtv1alpha1.SetRunContextResponse{}), nil
+		},
+	))
+
 	mux.Handle(mgmtv1alpha1connect.JobServiceSetRunContextsProcedure, connect.NewClientStreamHandler(
 		mgmtv1alpha1connect.JobServiceSetRunContextsProcedure,
 		func(ctx context.Context, cs *connect.ClientStream[mgmtv1alpha1.SetRunContextsRequest]) (*connect.Response[mgmtv1alpha1.SetRunContextsResponse], error) {
@@ -1536,13 +1549,17 @@ func executeWorkflow(
 		sqlmanager,
 		redisconfig,
 		false,
+		nil,
+		"",
 	)
 	var activityMeter metric.Meter
 	disableReaper := true
-	syncActivity := sync_activity.New(connclient, jobclient, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, temporalClientMock, activityMeter, sync_activity.NewBenthosStreamManager(), disableReaper)
+	syncActivity := sync_activity.New(connclient, jobclient, &sqlconnect.SqlOpenConnector{}, &sync.Map{}, temporalClientMock, activityMeter, sync_activity.NewBenthosStreamManager(), disableReaper, "", nil)
 	retrieveActivityOpts := syncactivityopts_activity.New(jobclient)
-	runSqlInitTableStatements := runsqlinittablestmts_activity.New(jobclient, connclient, sqlmanager)
+	runSqlInitTableStatements := runsqlinittablestmts_activity.New(jobclient, connclient, sqlmanager, mongoconnect.NewConnector(nil))
 	accountStatusActivity := accountstatus_activity.New(userclient)
+	syncCheckpointActivity := synccheckpoint_activity.New(jobclient)
+	publishJobRunEventActivity := publishjobrunevent_activity.New(mgmtv1alpha1connect.NewNotificationServiceClient(srv.Client(), srv.URL))
 	env.RegisterWorkflow(Workflow)
 	env.RegisterActivity(syncActivity.Sync)
 	env.RegisterActivity(retrieveActivityOpts.RetrieveActivityOptions)
@@ -1550,6 +1567,9 @@ func executeWorkflow(
 	env.RegisterActivity(syncrediscleanup_activity.DeleteRedisHash)
 	env.RegisterActivity(genbenthosActivity.GenerateBenthosConfigs)
 	env.RegisterActivity(accountStatusActivity.CheckAccountStatus)
+	env.RegisterActivity(syncCheckpointActivity.GetResumeCheckpoint)
+	env.RegisterActivity(syncCheckpointActivity.SetSyncCheckpoint)
+	env.RegisterActivity(publishJobRunEventActivity.PublishJobRunEvent)
 	env.SetTestTimeout(600 * time.Second) // increase the test timeout
 
 	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: jobId})
@@ -8,14 +8,17 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
 	tabledependency "github.com/nucleuscloud/neosync/backend/pkg/table-dependency"
 	neosync_benthos "github.com/nucleuscloud/neosync/worker/pkg/benthos"
 	accountstatus_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/account-status"
 	genbenthosconfigs_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/gen-benthos-configs"
+	publishjobrunevent_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/publish-job-run-event"
 	runsqlinittablestmts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/run-sql-init-table-stmts"
 	"github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/shared"
 	sync_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync"
 	syncactivityopts_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-activity-opts"
+	synccheckpoint_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-checkpoint"
 	syncrediscleanup_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/activities/sync-redis-clean-up"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -119,6 +122,9 @@ func Test_Workflow_Succeeds_SingleSync(t *testing.T) {
 				Config:    &neosync_benthos.BenthosConfig{},
 			},
 		}}, nil)
+	var checkpointActivity *synccheckpoint_activity.Activity
+	env.OnActivity(checkpointActivity.GetResumeCheckpoint, mock.Anything, mock.Anything).
+		Return(&synccheckpoint_activity.GetResumeCheckpointResponse{}, nil)
 	var sqlInitActivity *runsqlinittablestmts_activity.Activity
 	env.OnActivity(sqlInitActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
@@ -140,6 +146,114 @@ func Test_Workflow_Succeeds_SingleSync(t *testing.T) {
 	env.AssertExpectations(t)
 }
 
+func Test_Workflow_PublishesJobRunEvents(t *testing.T) {
+	tests := []struct {
+		name           string
+		genConfigsErr  error
+		expectedEvents []mgmtv1alpha1.JobRunEventType
+	}{
+		{
+			name: "succeeded",
+			expectedEvents: []mgmtv1alpha1.JobRunEventType{
+				mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_STARTED,
+				mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_SUCCEEDED,
+			},
+		},
+		{
+			name:          "failed",
+			genConfigsErr: errors.New("TestFailure"),
+			expectedEvents: []mgmtv1alpha1.JobRunEventType{
+				mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_STARTED,
+				mgmtv1alpha1.JobRunEventType_JOB_RUN_EVENT_TYPE_FAILED,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+
+			var activityOpts *syncactivityopts_activity.Activity
+			env.OnActivity(activityOpts.RetrieveActivityOptions, mock.Anything, mock.Anything).
+				Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+					SyncActivityOptions: &workflow.ActivityOptions{
+						StartToCloseTimeout: time.Minute,
+					},
+					AccountId: uuid.NewString(),
+				}, nil)
+			var accStatsActivity *accountstatus_activity.Activity
+			env.OnActivity(accStatsActivity.CheckAccountStatus, mock.Anything, mock.Anything).
+				Return(&accountstatus_activity.CheckAccountStatusResponse{IsValid: true}, nil)
+			var genact *genbenthosconfigs_activity.Activity
+			if tt.genConfigsErr != nil {
+				env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).Return(nil, tt.genConfigsErr)
+			} else {
+				env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+					Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{}}, nil)
+			}
+
+			events := []*publishjobrunevent_activity.PublishJobRunEventRequest{}
+			var publishActivity *publishjobrunevent_activity.Activity
+			env.OnActivity(publishActivity.PublishJobRunEvent, mock.Anything, mock.Anything).
+				Return(func(_ context.Context, req *publishjobrunevent_activity.PublishJobRunEventRequest) (*publishjobrunevent_activity.PublishJobRunEventResponse, error) {
+					events = append(events, req)
+					return &publishjobrunevent_activity.PublishJobRunEventResponse{}, nil
+				})
+
+			env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
+			assert.True(t, env.IsWorkflowCompleted())
+
+			eventTypes := []mgmtv1alpha1.JobRunEventType{}
+			for _, event := range events {
+				assert.Equal(t, "job-id", event.JobId)
+				assert.NotEmpty(t, event.JobRunId)
+				eventTypes = append(eventTypes, event.EventType)
+			}
+			assert.Equal(t, tt.expectedEvents, eventTypes)
+			if tt.genConfigsErr != nil {
+				assert.Contains(t, *events[1].ErrorMessage, "TestFailure")
+			} else {
+				assert.Nil(t, events[1].ErrorMessage)
+			}
+		})
+	}
+}
+
+func Test_Workflow_JobRunEvents_DefaultVersion(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.OnGetVersion("job-run-events", workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+
+	var activityOpts *syncactivityopts_activity.Activity
+	env.OnActivity(activityOpts.RetrieveActivityOptions, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+			AccountId: uuid.NewString(),
+		}, nil)
+	var accStatsActivity *accountstatus_activity.Activity
+	env.OnActivity(accStatsActivity.CheckAccountStatus, mock.Anything, mock.Anything).
+		Return(&accountstatus_activity.CheckAccountStatusResponse{IsValid: true}, nil)
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{}}, nil)
+
+	publishCount := 0
+	var publishActivity *publishjobrunevent_activity.Activity
+	env.OnActivity(publishActivity.PublishJobRunEvent, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ *publishjobrunevent_activity.PublishJobRunEventRequest) (*publishjobrunevent_activity.PublishJobRunEventResponse, error) {
+			publishCount++
+			return &publishjobrunevent_activity.PublishJobRunEventResponse{}, nil
+		}).Maybe()
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{JobId: "job-id"})
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+	assert.Zero(t, publishCount, "runs started before job run events were introduced must not schedule the publish activities on replay")
+}
+
 func Test_Workflow_Follows_Synchronous_DependentFlow(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
@@ -195,6 +309,9 @@ func Test_Workflow_Follows_Synchronous_DependentFlow(t *testing.T) {
 				StartToCloseTimeout: time.Minute,
 			},
 		}, nil)
+	var checkpointActivity *synccheckpoint_activity.Activity
+	env.OnActivity(checkpointActivity.GetResumeCheckpoint, mock.Anything, mock.Anything).
+		Return(&synccheckpoint_activity.GetResumeCheckpointResponse{}, nil)
 	var sqlInitActivity *runsqlinittablestmts_activity.Activity
 	env.OnActivity(sqlInitActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
@@ -303,6 +420,9 @@ func Test_Workflow_Follows_Multiple_Dependents(t *testing.T) {
 				StartToCloseTimeout: time.Minute,
 			},
 		}, nil)
+	var checkpointActivity *synccheckpoint_activity.Activity
+	env.OnActivity(checkpointActivity.GetResumeCheckpoint, mock.Anything, mock.Anything).
+		Return(&synccheckpoint_activity.GetResumeCheckpointResponse{}, nil)
 	var sqlInitActivity *runsqlinittablestmts_activity.Activity
 	env.OnActivity(sqlInitActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
@@ -430,6 +550,9 @@ func Test_Workflow_Follows_Multiple_Dependent_Redis_Cleanup(t *testing.T) {
 				StartToCloseTimeout: time.Minute,
 			},
 		}, nil)
+	var checkpointActivity *synccheckpoint_activity.Activity
+	env.OnActivity(checkpointActivity.GetResumeCheckpoint, mock.Anything, mock.Anything).
+		Return(&synccheckpoint_activity.GetResumeCheckpointResponse{}, nil)
 	var sqlInitActivity *runsqlinittablestmts_activity.Activity
 	env.OnActivity(sqlInitActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
@@ -538,6 +661,9 @@ func Test_Workflow_Halts_Activities_OnError(t *testing.T) {
 				},
 			},
 		}}, nil)
+	var checkpointActivity *synccheckpoint_activity.Activity
+	env.OnActivity(checkpointActivity.GetResumeCheckpoint, mock.Anything, mock.Anything).
+		Return(&synccheckpoint_activity.GetResumeCheckpointResponse{}, nil)
 	var sqlInitActivity *runsqlinittablestmts_activity.Activity
 	env.OnActivity(sqlInitActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
@@ -637,6 +763,9 @@ func Test_Workflow_Halts_Activities_On_InvalidAccountStatus(t *testing.T) {
 				},
 			},
 		}}, nil)
+	var checkpointActivity *synccheckpoint_activity.Activity
+	env.OnActivity(checkpointActivity.GetResumeCheckpoint, mock.Anything, mock.Anything).
+		Return(&synccheckpoint_activity.GetResumeCheckpointResponse{}, nil)
 	var sqlInitActivity *runsqlinittablestmts_activity.Activity
 	env.OnActivity(sqlInitActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
@@ -746,6 +875,9 @@ func Test_Workflow_Cleans_Up_Redis_OnError(t *testing.T) {
 				},
 			},
 		}}, nil)
+	var checkpointActivity *synccheckpoint_activity.Activity
+	env.OnActivity(checkpointActivity.GetResumeCheckpoint, mock.Anything, mock.Anything).
+		Return(&synccheckpoint_activity.GetResumeCheckpointResponse{}, nil)
 	var sqlInitActivity *runsqlinittablestmts_activity.Activity
 	env.OnActivity(sqlInitActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
 		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
@@ -923,3 +1055,205 @@ func Test_Workflow_Initial_AccountStatus(t *testing.T) {
 
 	env.AssertExpectations(t)
 }
+
+func Test_Workflow_Resumes_From_Checkpoint(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var accStatsActivity *accountstatus_activity.Activity
+	env.OnActivity(accStatsActivity.CheckAccountStatus, mock.Anything, mock.Anything).
+		Return(&accountstatus_activity.CheckAccountStatusResponse{IsValid: true}, nil)
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+			{
+				Name:        "public.users",
+				DependsOn:   []*tabledependency.DependsOn{},
+				Config:      &neosync_benthos.BenthosConfig{},
+				TableSchema: "public",
+				TableName:   "users",
+				Columns:     []string{"id"},
+			},
+			{
+				Name:        "public.foo",
+				DependsOn:   []*tabledependency.DependsOn{{Table: "public.users", Columns: []string{"id"}}},
+				Config:      &neosync_benthos.BenthosConfig{},
+				TableSchema: "public",
+				TableName:   "foo",
+				Columns:     []string{"id"},
+			},
+		}}, nil)
+	var activityOpts *syncactivityopts_activity.Activity
+	env.OnActivity(activityOpts.RetrieveActivityOptions, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+		}, nil)
+	var checkpointActivity *synccheckpoint_activity.Activity
+	env.OnActivity(checkpointActivity.GetResumeCheckpoint, mock.Anything, mock.Anything).
+		Return(&synccheckpoint_activity.GetResumeCheckpointResponse{
+			ResumeFromRunId: "previous-run",
+			Checkpoint:      &shared.SyncCheckpoint{CompletedConfigs: []string{"public.users"}},
+		}, nil)
+	clearedTables := [][]string{}
+	var sqlInitActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(sqlInitActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, req *runsqlinittablestmts_activity.RunSqlInitTableStatementsRequest) (*runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse, error) {
+			clearedTables = append(clearedTables, req.ClearTables)
+			return &runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil
+		})
+	checkpoints := [][]string{}
+	env.OnActivity(checkpointActivity.SetSyncCheckpoint, mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, req *synccheckpoint_activity.SetSyncCheckpointRequest) (*synccheckpoint_activity.SetSyncCheckpointResponse, error) {
+			checkpoints = append(checkpoints, req.CompletedConfigs)
+			return &synccheckpoint_activity.SetSyncCheckpointResponse{}, nil
+		})
+	syncedTables := []string{}
+	syncActivity := sync_activity.Activity{}
+	env.
+		OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, req *sync_activity.SyncRequest, metadata *sync_activity.SyncMetadata) (*sync_activity.SyncResponse, error) {
+			syncedTables = append(syncedTables, metadata.Table)
+			return &sync_activity.SyncResponse{}, nil
+		})
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"foo"}, syncedTables, "only the table that did not complete in the previous run should sync")
+	assert.Equal(t, [][]string{{"public.users"}, {"public.users", "public.foo"}}, checkpoints)
+	assert.Equal(t, [][]string{{"public.foo"}}, clearedTables, "the table that is synced again must be cleared of rows written by the previous run")
+
+	env.AssertExpectations(t)
+}
+
+func Test_Workflow_SyncCheckpoint_DefaultVersion(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.OnGetVersion("sync-checkpoint", workflow.DefaultVersion, 1).Return(workflow.DefaultVersion)
+
+	var accStatsActivity *accountstatus_activity.Activity
+	env.OnActivity(accStatsActivity.CheckAccountStatus, mock.Anything, mock.Anything).
+		Return(&accountstatus_activity.CheckAccountStatusResponse{IsValid: true}, nil)
+
+	var genact *genbenthosconfigs_activity.Activity
+	env.OnActivity(genact.GenerateBenthosConfigs, mock.Anything, mock.Anything).
+		Return(&genbenthosconfigs_activity.GenerateBenthosConfigsResponse{BenthosConfigs: []*genbenthosconfigs_activity.BenthosConfigResponse{
+			{
+				Name:        "public.users",
+				DependsOn:   []*tabledependency.DependsOn{},
+				Config:      &neosync_benthos.BenthosConfig{},
+				TableSchema: "public",
+				TableName:   "users",
+				Columns:     []string{"id"},
+			},
+		}}, nil)
+	var activityOpts *syncactivityopts_activity.Activity
+	env.OnActivity(activityOpts.RetrieveActivityOptions, mock.Anything, mock.Anything).
+		Return(&syncactivityopts_activity.RetrieveActivityOptionsResponse{
+			SyncActivityOptions: &workflow.ActivityOptions{
+				StartToCloseTimeout: time.Minute,
+			},
+		}, nil)
+	var sqlInitActivity *runsqlinittablestmts_activity.Activity
+	env.OnActivity(sqlInitActivity.RunSqlInitTableStatements, mock.Anything, mock.Anything).
+		Return(&runsqlinittablestmts_activity.RunSqlInitTableStatementsResponse{}, nil)
+	syncActivity := sync_activity.Activity{}
+	env.OnActivity(syncActivity.Sync, mock.Anything, mock.Anything, mock.Anything).
+		Return(&sync_activity.SyncResponse{}, nil)
+
+	env.ExecuteWorkflow(Workflow, &WorkflowRequest{})
+
+	assert.True(t, env.IsWorkflowCompleted())
+	// the checkpoint activities are not registered, so the workflow only succeeds when they are not scheduled
+	assert.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func Test_getTablesToClear(t *testing.T) {
+	configs := []*genbenthosconfigs_activity.BenthosConfigResponse{
+		{Name: "public.users", TableSchema: "public", TableName: "users", RunType: tabledependency.RunTypeInsert},
+		{Name: "public.users.update", TableSchema: "public", TableName: "users", RunType: tabledependency.RunTypeUpdate},
+		{Name: "public.orders", TableSchema: "public", TableName: "orders", RunType: tabledependency.RunTypeInsert},
+	}
+
+	assert.Equal(t, []string{"public.orders"}, getTablesToClear(configs, map[string]struct{}{"public.users": {}}))
+	assert.Empty(
+		t,
+		getTablesToClear(configs, map[string]struct{}{"public.users": {}, "public.orders": {}}),
+		"tables whose update config runs again are not cleared",
+	)
+}
+
+func Test_getSkippableConfigs_ClearedTables(t *testing.T) {
+	configs := []*genbenthosconfigs_activity.BenthosConfigResponse{
+		{Name: "public.users", TableSchema: "public", TableName: "users", RunType: tabledependency.RunTypeInsert},
+		{
+			Name:        "public.users.update",
+			TableSchema: "public",
+			TableName:   "users",
+			RunType:     tabledependency.RunTypeUpdate,
+			DependsOn:   []*tabledependency.DependsOn{{Table: "public.users", Columns: []string{"id"}}},
+		},
+		{
+			Name:        "public.orders",
+			TableSchema: "public",
+			TableName:   "orders",
+			RunType:     tabledependency.RunTypeInsert,
+			DependsOn:   []*tabledependency.DependsOn{{Table: "public.users", Columns: []string{"id"}}},
+		},
+		{Name: "public.accounts", TableSchema: "public", TableName: "accounts", RunType: tabledependency.RunTypeInsert},
+	}
+
+	assert.Equal(
+		t,
+		map[string]struct{}{"public.accounts": {}},
+		getSkippableConfigs(configs, &shared.SyncCheckpoint{CompletedConfigs: []string{"public.users.update", "public.orders", "public.accounts"}}),
+		"configs of a cleared table and configs that depend on a cleared table must run again",
+	)
+}
+
+func Test_getSkippableConfigs(t *testing.T) {
+	configs := []*genbenthosconfigs_activity.BenthosConfigResponse{
+		{
+			Name:        "public.users",
+			TableSchema: "public",
+			TableName:   "users",
+			RedisConfig: []*genbenthosconfigs_activity.BenthosRedisConfig{
+				{Key: "users-id", Table: "public.users", Column: "id"},
+			},
+		},
+		{
+			Name:           "public.accounts",
+			TableSchema:    "public",
+			TableName:      "accounts",
+			RedisDependsOn: map[string][]string{},
+		},
+		{
+			Name:           "public.orders",
+			TableSchema:    "public",
+			TableName:      "orders",
+			RedisDependsOn: map[string][]string{"public.users": {"id"}},
+		},
+	}
+
+	assert.Empty(t, getSkippableConfigs(configs, nil), "nothing is skipped when not resuming")
+
+	assert.Equal(
+		t,
+		map[string]struct{}{"public.accounts": {}},
+		getSkippableConfigs(configs, &shared.SyncCheckpoint{CompletedConfigs: []string{"public.users", "public.accounts"}}),
+		"completed configs whose redis values are needed by a pending config should run again",
+	)
+
+	assert.Equal(
+		t,
+		map[string]struct{}{"public.users": {}, "public.accounts": {}, "public.orders": {}},
+		getSkippableConfigs(configs, &shared.SyncCheckpoint{CompletedConfigs: []string{"public.users", "public.accounts", "public.orders"}}),
+	)
+}
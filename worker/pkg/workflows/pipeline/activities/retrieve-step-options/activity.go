@@ -0,0 +1,63 @@
+package retrievestepoptions_activity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/log"
+)
+
+type Activity struct {
+	jobclient mgmtv1alpha1connect.JobServiceClient
+}
+
+func New(
+	jobclient mgmtv1alpha1connect.JobServiceClient,
+) *Activity {
+	return &Activity{
+		jobclient: jobclient,
+	}
+}
+
+type RetrieveStepOptionsRequest struct {
+	JobId string
+}
+
+type RetrieveStepOptionsResponse struct {
+	// The run timeout configured on the step's job, if any
+	WorkflowRunTimeout *time.Duration
+}
+
+// Retrieves the options that the job run of a pipeline step is started with.
+// This is called right before the step runs so that the step picks up the latest version of the job.
+func (a *Activity) RetrieveStepOptions(
+	ctx context.Context,
+	req *RetrieveStepOptionsRequest,
+) (*RetrieveStepOptionsResponse, error) {
+	activityInfo := activity.GetInfo(ctx)
+	logger := log.With(
+		activity.GetLogger(ctx),
+		"jobId", req.JobId,
+		"WorkflowID", activityInfo.WorkflowExecution.ID,
+		"RunID", activityInfo.WorkflowExecution.RunID,
+	)
+	logger.Debug("retrieving pipeline step options")
+
+	jobResp, err := a.jobclient.GetJob(ctx, connect.NewRequest(&mgmtv1alpha1.GetJobRequest{Id: req.JobId}))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get job by id: %w", err)
+	}
+
+	resp := &RetrieveStepOptionsResponse{}
+	runTimeout := jobResp.Msg.GetJob().GetWorkflowOptions().GetRunTimeout()
+	if runTimeout > 0 {
+		timeout := time.Duration(runTimeout)
+		resp.WorkflowRunTimeout = &timeout
+	}
+	return resp, nil
+}
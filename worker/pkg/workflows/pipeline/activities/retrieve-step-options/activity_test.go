@@ -0,0 +1,72 @@
+package retrievestepoptions_activity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	"github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1/mgmtv1alpha1connect"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func Test_RetrieveStepOptions(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	activity := New(jobclient)
+	env.RegisterActivity(activity.RetrieveStepOptions)
+
+	runTimeout := int64(10 * time.Minute)
+	jobclient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{
+			Job: &mgmtv1alpha1.Job{
+				Id:              "job-id",
+				WorkflowOptions: &mgmtv1alpha1.WorkflowOptions{RunTimeout: &runTimeout},
+			},
+		}), nil)
+
+	val, err := env.ExecuteActivity(activity.RetrieveStepOptions, &RetrieveStepOptionsRequest{JobId: "job-id"})
+	require.NoError(t, err)
+	var resp *RetrieveStepOptionsResponse
+	require.NoError(t, val.Get(&resp))
+	require.NotNil(t, resp.WorkflowRunTimeout)
+	require.Equal(t, 10*time.Minute, *resp.WorkflowRunTimeout)
+}
+
+func Test_RetrieveStepOptions_NoTimeout(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	activity := New(jobclient)
+	env.RegisterActivity(activity.RetrieveStepOptions)
+
+	jobclient.On("GetJob", mock.Anything, mock.Anything).
+		Return(connect.NewResponse(&mgmtv1alpha1.GetJobResponse{Job: &mgmtv1alpha1.Job{Id: "job-id"}}), nil)
+
+	val, err := env.ExecuteActivity(activity.RetrieveStepOptions, &RetrieveStepOptionsRequest{JobId: "job-id"})
+	require.NoError(t, err)
+	var resp *RetrieveStepOptionsResponse
+	require.NoError(t, val.Get(&resp))
+	require.Nil(t, resp.WorkflowRunTimeout)
+}
+
+func Test_RetrieveStepOptions_JobNotFound(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+
+	jobclient := mgmtv1alpha1connect.NewMockJobServiceClient(t)
+	activity := New(jobclient)
+	env.RegisterActivity(activity.RetrieveStepOptions)
+
+	jobclient.On("GetJob", mock.Anything, mock.Anything).
+		Return(nil, connect.NewError(connect.CodeNotFound, errors.New("job not found")))
+
+	_, err := env.ExecuteActivity(activity.RetrieveStepOptions, &RetrieveStepOptionsRequest{JobId: "job-id"})
+	require.Error(t, err)
+}
@@ -0,0 +1,138 @@
+package pipeline_workflow
+
+import (
+	"fmt"
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
+	retrievestepoptions_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/pipeline/activities/retrieve-step-options"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// Job runs are looked up by the id of the schedule that started them.
+// The job runs that a pipeline starts are tagged with their job id so that they show up alongside the job's scheduled runs.
+var jobIdSearchAttribute = temporal.NewSearchAttributeKeyKeyword("TemporalScheduledById")
+
+type WorkflowRequest struct {
+	PipelineId string
+	Steps      []*Step
+}
+
+type Step struct {
+	JobId         string
+	FailurePolicy mgmtv1alpha1.PipelineStepFailurePolicy
+}
+
+type WorkflowResponse struct {
+	Steps []*StepResult
+}
+
+type StepResult struct {
+	JobId string
+	// The id of the job run that was started for the step. Empty if the job run could not be started
+	JobRunId string
+	// The error the job run ended with, if it did not succeed
+	Error *string
+}
+
+func withRetrieveStepOptionsActivityOptions(ctx workflow.Context) workflow.Context {
+	return workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 2 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+}
+
+// Runs the job of each step one after another as child workflows.
+// A step whose job run does not succeed halts the pipeline unless the step's failure policy is set to continue.
+// Named PipelineWorkflow as temporal registers workflows by function name and the datasync workflow is already registered as Workflow.
+func PipelineWorkflow(wfctx workflow.Context, req *WorkflowRequest) (*WorkflowResponse, error) {
+	logger := log.With(workflow.GetLogger(wfctx), "pipelineId", req.PipelineId)
+	logger.Info("pipeline workflow starting", "steps", len(req.Steps))
+
+	resp := &WorkflowResponse{Steps: []*StepResult{}}
+	failedSteps := 0
+	for idx, step := range req.Steps {
+		stepLogger := log.With(logger, "step", idx+1, "jobId", step.JobId)
+		stepLogger.Info("starting pipeline step")
+
+		result := runStep(wfctx, idx, step)
+		resp.Steps = append(resp.Steps, result)
+
+		if wfctx.Err() != nil {
+			stepLogger.Info("pipeline run was canceled")
+			return resp, wfctx.Err()
+		}
+		if result.Error == nil {
+			stepLogger.Info("pipeline step completed", "jobRunId", result.JobRunId)
+			continue
+		}
+
+		failedSteps++
+		stepLogger.Warn("pipeline step did not succeed", "jobRunId", result.JobRunId, "error", *result.Error)
+		if step.FailurePolicy != mgmtv1alpha1.PipelineStepFailurePolicy_PIPELINE_STEP_FAILURE_POLICY_CONTINUE {
+			return resp, fmt.Errorf("halting pipeline as step %d (job %s) did not succeed: %s", idx+1, step.JobId, *result.Error)
+		}
+	}
+
+	if failedSteps > 0 {
+		return resp, fmt.Errorf("%d of %d pipeline steps did not succeed", failedSteps, len(req.Steps))
+	}
+	logger.Info("pipeline workflow completed")
+	return resp, nil
+}
+
+func runStep(wfctx workflow.Context, idx int, step *Step) *StepResult {
+	result := &StepResult{JobId: step.JobId}
+
+	var optsResp *retrievestepoptions_activity.RetrieveStepOptionsResponse
+	var a *retrievestepoptions_activity.Activity
+	err := workflow.ExecuteActivity(
+		withRetrieveStepOptionsActivityOptions(wfctx),
+		a.RetrieveStepOptions,
+		&retrievestepoptions_activity.RetrieveStepOptionsRequest{JobId: step.JobId},
+	).Get(wfctx, &optsResp)
+	if err != nil {
+		errMsg := err.Error()
+		result.Error = &errMsg
+		return result
+	}
+
+	childOpts := workflow.ChildWorkflowOptions{
+		WorkflowID: fmt.Sprintf("%s-%s-%d", step.JobId, workflow.GetInfo(wfctx).WorkflowExecution.RunID, idx+1),
+		// gives the job run the chance to publish its canceled event when the pipeline run is canceled
+		ParentClosePolicy:     enums.PARENT_CLOSE_POLICY_REQUEST_CANCEL,
+		WaitForCancellation:   true,
+		TypedSearchAttributes: temporal.NewSearchAttributes(jobIdSearchAttribute.ValueSet(step.JobId)),
+	}
+	if optsResp.WorkflowRunTimeout != nil {
+		childOpts.WorkflowRunTimeout = *optsResp.WorkflowRunTimeout
+	}
+
+	future := workflow.ExecuteChildWorkflow(
+		workflow.WithChildOptions(wfctx, childOpts),
+		datasync_workflow.Workflow,
+		&datasync_workflow.WorkflowRequest{JobId: step.JobId},
+	)
+	var execution workflow.Execution
+	err = future.GetChildWorkflowExecution().Get(wfctx, &execution)
+	if err != nil {
+		errMsg := fmt.Sprintf("unable to start job run: %s", err.Error())
+		result.Error = &errMsg
+		return result
+	}
+	result.JobRunId = execution.ID
+
+	var childResp *datasync_workflow.WorkflowResponse
+	err = future.Get(wfctx, &childResp)
+	if err != nil {
+		errMsg := err.Error()
+		result.Error = &errMsg
+	}
+	return result
+}
@@ -0,0 +1,145 @@
+package pipeline_workflow
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	mgmtv1alpha1 "github.com/nucleuscloud/neosync/backend/gen/go/protos/mgmt/v1alpha1"
+	datasync_workflow "github.com/nucleuscloud/neosync/worker/pkg/workflows/datasync/workflow"
+	retrievestepoptions_activity "github.com/nucleuscloud/neosync/worker/pkg/workflows/pipeline/activities/retrieve-step-options"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func Test_Workflow_RunsStepsInOrder(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var stepOpts *retrievestepoptions_activity.Activity
+	runTimeout := 5 * time.Minute
+	env.OnActivity(stepOpts.RetrieveStepOptions, mock.Anything, mock.Anything).
+		Return(&retrievestepoptions_activity.RetrieveStepOptionsResponse{WorkflowRunTimeout: &runTimeout}, nil)
+
+	env.RegisterWorkflow(datasync_workflow.Workflow)
+	runOrder := []string{}
+	env.OnWorkflow(datasync_workflow.Workflow, mock.Anything, mock.Anything).
+		Return(func(ctx workflow.Context, req *datasync_workflow.WorkflowRequest) (*datasync_workflow.WorkflowResponse, error) {
+			runOrder = append(runOrder, req.JobId)
+			return &datasync_workflow.WorkflowResponse{}, nil
+		})
+
+	env.ExecuteWorkflow(PipelineWorkflow, &WorkflowRequest{
+		PipelineId: "pipeline-id",
+		Steps: []*Step{
+			{JobId: "generate"},
+			{JobId: "sync"},
+			{JobId: "post-process"},
+		},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.Equal(t, []string{"generate", "sync", "post-process"}, runOrder)
+
+	var resp *WorkflowResponse
+	require.NoError(t, env.GetWorkflowResult(&resp))
+	require.Len(t, resp.Steps, 3)
+	for _, step := range resp.Steps {
+		require.NotEmpty(t, step.JobRunId)
+		require.Nil(t, step.Error)
+	}
+}
+
+func Test_Workflow_HaltsOnFailure(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var stepOpts *retrievestepoptions_activity.Activity
+	env.OnActivity(stepOpts.RetrieveStepOptions, mock.Anything, mock.Anything).
+		Return(&retrievestepoptions_activity.RetrieveStepOptionsResponse{}, nil)
+
+	env.RegisterWorkflow(datasync_workflow.Workflow)
+	runOrder := []string{}
+	env.OnWorkflow(datasync_workflow.Workflow, mock.Anything, mock.Anything).
+		Return(func(ctx workflow.Context, req *datasync_workflow.WorkflowRequest) (*datasync_workflow.WorkflowResponse, error) {
+			runOrder = append(runOrder, req.JobId)
+			if req.JobId == "sync" {
+				return nil, errors.New("sync failed")
+			}
+			return &datasync_workflow.WorkflowResponse{}, nil
+		})
+
+	env.ExecuteWorkflow(PipelineWorkflow, &WorkflowRequest{
+		PipelineId: "pipeline-id",
+		Steps: []*Step{
+			{JobId: "generate"},
+			{JobId: "sync", FailurePolicy: mgmtv1alpha1.PipelineStepFailurePolicy_PIPELINE_STEP_FAILURE_POLICY_HALT},
+			{JobId: "post-process"},
+		},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	require.ErrorContains(t, err, "halting pipeline as step 2 (job sync) did not succeed")
+	require.Equal(t, []string{"generate", "sync"}, runOrder)
+}
+
+func Test_Workflow_ContinuesOnFailure(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var stepOpts *retrievestepoptions_activity.Activity
+	env.OnActivity(stepOpts.RetrieveStepOptions, mock.Anything, mock.Anything).
+		Return(&retrievestepoptions_activity.RetrieveStepOptionsResponse{}, nil)
+
+	env.RegisterWorkflow(datasync_workflow.Workflow)
+	runOrder := []string{}
+	env.OnWorkflow(datasync_workflow.Workflow, mock.Anything, mock.Anything).
+		Return(func(ctx workflow.Context, req *datasync_workflow.WorkflowRequest) (*datasync_workflow.WorkflowResponse, error) {
+			runOrder = append(runOrder, req.JobId)
+			if req.JobId == "sync" {
+				return nil, errors.New("sync failed")
+			}
+			return &datasync_workflow.WorkflowResponse{}, nil
+		})
+
+	env.ExecuteWorkflow(PipelineWorkflow, &WorkflowRequest{
+		PipelineId: "pipeline-id",
+		Steps: []*Step{
+			{JobId: "generate"},
+			{JobId: "sync", FailurePolicy: mgmtv1alpha1.PipelineStepFailurePolicy_PIPELINE_STEP_FAILURE_POLICY_CONTINUE},
+			{JobId: "post-process"},
+		},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	require.ErrorContains(t, err, "1 of 3 pipeline steps did not succeed")
+	require.Equal(t, []string{"generate", "sync", "post-process"}, runOrder)
+}
+
+func Test_Workflow_StepJobNotFound(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var stepOpts *retrievestepoptions_activity.Activity
+	env.OnActivity(stepOpts.RetrieveStepOptions, mock.Anything, &retrievestepoptions_activity.RetrieveStepOptionsRequest{JobId: "deleted"}).
+		Return(nil, errors.New("unable to get job by id: not found"))
+
+	env.RegisterWorkflow(datasync_workflow.Workflow)
+
+	env.ExecuteWorkflow(PipelineWorkflow, &WorkflowRequest{
+		PipelineId: "pipeline-id",
+		Steps:      []*Step{{JobId: "deleted"}, {JobId: "sync"}},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	require.ErrorContains(t, err, "halting pipeline as step 1 (job deleted) did not succeed")
+}